@@ -3,7 +3,9 @@ package blobv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,10 +16,65 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field BlobQuotaExemptAddresses as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_gas_per_blob_byte   protoreflect.FieldDescriptor
-	fd_Params_gov_max_square_size protoreflect.FieldDescriptor
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_gas_per_blob_byte                protoreflect.FieldDescriptor
+	fd_Params_gov_max_square_size              protoreflect.FieldDescriptor
+	fd_Params_blob_lane_square_share           protoreflect.FieldDescriptor
+	fd_Params_min_blob_base_fee                protoreflect.FieldDescriptor
+	fd_Params_blob_base_fee_change_denominator protoreflect.FieldDescriptor
+	fd_Params_target_square_utilisation        protoreflect.FieldDescriptor
+	fd_Params_blob_quota_window                protoreflect.FieldDescriptor
+	fd_Params_account_blob_quota               protoreflect.FieldDescriptor
+	fd_Params_namespace_blob_quota             protoreflect.FieldDescriptor
+	fd_Params_blob_quota_exempt_addresses      protoreflect.FieldDescriptor
+	fd_Params_namespace_registration_deposit   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +82,15 @@ func init() {
 	md_Params = File_sunrise_blob_v1_params_proto.Messages().ByName("Params")
 	fd_Params_gas_per_blob_byte = md_Params.Fields().ByName("gas_per_blob_byte")
 	fd_Params_gov_max_square_size = md_Params.Fields().ByName("gov_max_square_size")
+	fd_Params_blob_lane_square_share = md_Params.Fields().ByName("blob_lane_square_share")
+	fd_Params_min_blob_base_fee = md_Params.Fields().ByName("min_blob_base_fee")
+	fd_Params_blob_base_fee_change_denominator = md_Params.Fields().ByName("blob_base_fee_change_denominator")
+	fd_Params_target_square_utilisation = md_Params.Fields().ByName("target_square_utilisation")
+	fd_Params_blob_quota_window = md_Params.Fields().ByName("blob_quota_window")
+	fd_Params_account_blob_quota = md_Params.Fields().ByName("account_blob_quota")
+	fd_Params_namespace_blob_quota = md_Params.Fields().ByName("namespace_blob_quota")
+	fd_Params_blob_quota_exempt_addresses = md_Params.Fields().ByName("blob_quota_exempt_addresses")
+	fd_Params_namespace_registration_deposit = md_Params.Fields().ByName("namespace_registration_deposit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -104,6 +170,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.BlobLaneSquareShare != "" {
+		value := protoreflect.ValueOfString(x.BlobLaneSquareShare)
+		if !f(fd_Params_blob_lane_square_share, value) {
+			return
+		}
+	}
+	if x.MinBlobBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBlobBaseFee)
+		if !f(fd_Params_min_blob_base_fee, value) {
+			return
+		}
+	}
+	if x.BlobBaseFeeChangeDenominator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlobBaseFeeChangeDenominator)
+		if !f(fd_Params_blob_base_fee_change_denominator, value) {
+			return
+		}
+	}
+	if x.TargetSquareUtilisation != "" {
+		value := protoreflect.ValueOfString(x.TargetSquareUtilisation)
+		if !f(fd_Params_target_square_utilisation, value) {
+			return
+		}
+	}
+	if x.BlobQuotaWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlobQuotaWindow)
+		if !f(fd_Params_blob_quota_window, value) {
+			return
+		}
+	}
+	if x.AccountBlobQuota != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccountBlobQuota)
+		if !f(fd_Params_account_blob_quota, value) {
+			return
+		}
+	}
+	if x.NamespaceBlobQuota != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NamespaceBlobQuota)
+		if !f(fd_Params_namespace_blob_quota, value) {
+			return
+		}
+	}
+	if len(x.BlobQuotaExemptAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.BlobQuotaExemptAddresses})
+		if !f(fd_Params_blob_quota_exempt_addresses, value) {
+			return
+		}
+	}
+	if x.NamespaceRegistrationDeposit != nil {
+		value := protoreflect.ValueOfMessage(x.NamespaceRegistrationDeposit.ProtoReflect())
+		if !f(fd_Params_namespace_registration_deposit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +243,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.GasPerBlobByte != uint32(0)
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		return x.GovMaxSquareSize != uint64(0)
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		return x.BlobLaneSquareShare != ""
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		return x.MinBlobBaseFee != ""
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		return x.BlobBaseFeeChangeDenominator != uint64(0)
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		return x.TargetSquareUtilisation != ""
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		return x.BlobQuotaWindow != uint64(0)
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		return x.AccountBlobQuota != uint64(0)
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		return x.NamespaceBlobQuota != uint64(0)
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		return len(x.BlobQuotaExemptAddresses) != 0
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		return x.NamespaceRegistrationDeposit != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -143,6 +281,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.GasPerBlobByte = uint32(0)
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		x.GovMaxSquareSize = uint64(0)
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		x.BlobLaneSquareShare = ""
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		x.MinBlobBaseFee = ""
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		x.BlobBaseFeeChangeDenominator = uint64(0)
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		x.TargetSquareUtilisation = ""
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		x.BlobQuotaWindow = uint64(0)
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		x.AccountBlobQuota = uint64(0)
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		x.NamespaceBlobQuota = uint64(0)
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		x.BlobQuotaExemptAddresses = nil
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		x.NamespaceRegistrationDeposit = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -165,6 +321,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		value := x.GovMaxSquareSize
 		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		value := x.BlobLaneSquareShare
+		return protoreflect.ValueOfString(value)
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		value := x.MinBlobBaseFee
+		return protoreflect.ValueOfString(value)
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		value := x.BlobBaseFeeChangeDenominator
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		value := x.TargetSquareUtilisation
+		return protoreflect.ValueOfString(value)
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		value := x.BlobQuotaWindow
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		value := x.AccountBlobQuota
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		value := x.NamespaceBlobQuota
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		if len(x.BlobQuotaExemptAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.BlobQuotaExemptAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		value := x.NamespaceRegistrationDeposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -189,6 +375,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.GasPerBlobByte = uint32(value.Uint())
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		x.GovMaxSquareSize = value.Uint()
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		x.BlobLaneSquareShare = value.Interface().(string)
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		x.MinBlobBaseFee = value.Interface().(string)
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		x.BlobBaseFeeChangeDenominator = value.Uint()
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		x.TargetSquareUtilisation = value.Interface().(string)
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		x.BlobQuotaWindow = value.Uint()
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		x.AccountBlobQuota = value.Uint()
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		x.NamespaceBlobQuota = value.Uint()
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.BlobQuotaExemptAddresses = *clv.list
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		x.NamespaceRegistrationDeposit = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -209,10 +415,35 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		if x.BlobQuotaExemptAddresses == nil {
+			x.BlobQuotaExemptAddresses = []string{}
+		}
+		value := &_Params_10_list{list: &x.BlobQuotaExemptAddresses}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		if x.NamespaceRegistrationDeposit == nil {
+			x.NamespaceRegistrationDeposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.NamespaceRegistrationDeposit.ProtoReflect())
 	case "sunrise.blob.v1.Params.gas_per_blob_byte":
 		panic(fmt.Errorf("field gas_per_blob_byte of message sunrise.blob.v1.Params is not mutable"))
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		panic(fmt.Errorf("field gov_max_square_size of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		panic(fmt.Errorf("field blob_lane_square_share of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		panic(fmt.Errorf("field min_blob_base_fee of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		panic(fmt.Errorf("field blob_base_fee_change_denominator of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		panic(fmt.Errorf("field target_square_utilisation of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		panic(fmt.Errorf("field blob_quota_window of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		panic(fmt.Errorf("field account_blob_quota of message sunrise.blob.v1.Params is not mutable"))
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		panic(fmt.Errorf("field namespace_blob_quota of message sunrise.blob.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -230,6 +461,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "sunrise.blob.v1.Params.gov_max_square_size":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blob.v1.Params.blob_lane_square_share":
+		return protoreflect.ValueOfString("")
+	case "sunrise.blob.v1.Params.min_blob_base_fee":
+		return protoreflect.ValueOfString("")
+	case "sunrise.blob.v1.Params.blob_base_fee_change_denominator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blob.v1.Params.target_square_utilisation":
+		return protoreflect.ValueOfString("")
+	case "sunrise.blob.v1.Params.blob_quota_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blob.v1.Params.account_blob_quota":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blob.v1.Params.namespace_blob_quota":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blob.v1.Params.blob_quota_exempt_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "sunrise.blob.v1.Params.namespace_registration_deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blob.v1.Params"))
@@ -305,6 +556,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.GovMaxSquareSize != 0 {
 			n += 1 + runtime.Sov(uint64(x.GovMaxSquareSize))
 		}
+		l = len(x.BlobLaneSquareShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBlobBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlobBaseFeeChangeDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlobBaseFeeChangeDenominator))
+		}
+		l = len(x.TargetSquareUtilisation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlobQuotaWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlobQuotaWindow))
+		}
+		if x.AccountBlobQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountBlobQuota))
+		}
+		if x.NamespaceBlobQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.NamespaceBlobQuota))
+		}
+		if len(x.BlobQuotaExemptAddresses) > 0 {
+			for _, s := range x.BlobQuotaExemptAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NamespaceRegistrationDeposit != nil {
+			l = options.Size(x.NamespaceRegistrationDeposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -334,6 +619,70 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NamespaceRegistrationDeposit != nil {
+			encoded, err := options.Marshal(x.NamespaceRegistrationDeposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.BlobQuotaExemptAddresses) > 0 {
+			for iNdEx := len(x.BlobQuotaExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlobQuotaExemptAddresses[iNdEx])
+				copy(dAtA[i:], x.BlobQuotaExemptAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlobQuotaExemptAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.NamespaceBlobQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NamespaceBlobQuota))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.AccountBlobQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountBlobQuota))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.BlobQuotaWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlobQuotaWindow))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.TargetSquareUtilisation) > 0 {
+			i -= len(x.TargetSquareUtilisation)
+			copy(dAtA[i:], x.TargetSquareUtilisation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetSquareUtilisation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlobBaseFeeChangeDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlobBaseFeeChangeDenominator))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MinBlobBaseFee) > 0 {
+			i -= len(x.MinBlobBaseFee)
+			copy(dAtA[i:], x.MinBlobBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBlobBaseFee)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BlobLaneSquareShare) > 0 {
+			i -= len(x.BlobLaneSquareShare)
+			copy(dAtA[i:], x.BlobLaneSquareShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlobLaneSquareShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.GovMaxSquareSize != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.GovMaxSquareSize))
 			i--
@@ -431,6 +780,246 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlobLaneSquareShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlobLaneSquareShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBlobBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBlobBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFeeChangeDenominator", wireType)
+				}
+				x.BlobBaseFeeChangeDenominator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlobBaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilisation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetSquareUtilisation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlobQuotaWindow", wireType)
+				}
+				x.BlobQuotaWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlobQuotaWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountBlobQuota", wireType)
+				}
+				x.AccountBlobQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountBlobQuota |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NamespaceBlobQuota", wireType)
+				}
+				x.NamespaceBlobQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NamespaceBlobQuota |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlobQuotaExemptAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlobQuotaExemptAddresses = append(x.BlobQuotaExemptAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NamespaceRegistrationDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NamespaceRegistrationDeposit == nil {
+					x.NamespaceRegistrationDeposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NamespaceRegistrationDeposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -487,6 +1076,31 @@ type Params struct {
 
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty"`
+	// Ratio of the shares of the square reserved for the transactions of the
+	// blob lane
+	BlobLaneSquareShare string `protobuf:"bytes,3,opt,name=blob_lane_square_share,json=blobLaneSquareShare,proto3" json:"blob_lane_square_share,omitempty"`
+	// Lower bound of the blob base fee, the minimum price per blob byte in the
+	// fee denom
+	MinBlobBaseFee string `protobuf:"bytes,4,opt,name=min_blob_base_fee,json=minBlobBaseFee,proto3" json:"min_blob_base_fee,omitempty"`
+	// The blob base fee changes by at most 1 / blob_base_fee_change_denominator
+	// per block
+	BlobBaseFeeChangeDenominator uint64 `protobuf:"varint,5,opt,name=blob_base_fee_change_denominator,json=blobBaseFeeChangeDenominator,proto3" json:"blob_base_fee_change_denominator,omitempty"`
+	// Ratio of the shares of a square of the max square size used by blobs at
+	// which the blob base fee stays unchanged
+	TargetSquareUtilisation string `protobuf:"bytes,6,opt,name=target_square_utilisation,json=targetSquareUtilisation,proto3" json:"target_square_utilisation,omitempty"`
+	// Number of blocks of the windows over which the blob quotas apply
+	BlobQuotaWindow uint64 `protobuf:"varint,7,opt,name=blob_quota_window,json=blobQuotaWindow,proto3" json:"blob_quota_window,omitempty"`
+	// Number of blob bytes an account can pay for per window. Zero disables the
+	// quota.
+	AccountBlobQuota uint64 `protobuf:"varint,8,opt,name=account_blob_quota,json=accountBlobQuota,proto3" json:"account_blob_quota,omitempty"`
+	// Number of blob bytes that can be paid for in a namespace per window. Zero
+	// disables the quota.
+	NamespaceBlobQuota uint64 `protobuf:"varint,9,opt,name=namespace_blob_quota,json=namespaceBlobQuota,proto3" json:"namespace_blob_quota,omitempty"`
+	// Addresses of the rollup sequencers exempt from the blob quotas. Their
+	// blobs are not counted in the namespace quotas either.
+	BlobQuotaExemptAddresses []string `protobuf:"bytes,10,rep,name=blob_quota_exempt_addresses,json=blobQuotaExemptAddresses,proto3" json:"blob_quota_exempt_addresses,omitempty"`
+	// Deposit escrowed to register a namespace
+	NamespaceRegistrationDeposit *v1beta1.Coin `protobuf:"bytes,11,opt,name=namespace_registration_deposit,json=namespaceRegistrationDeposit,proto3" json:"namespace_registration_deposit,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -523,6 +1137,69 @@ func (x *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (x *Params) GetBlobLaneSquareShare() string {
+	if x != nil {
+		return x.BlobLaneSquareShare
+	}
+	return ""
+}
+
+func (x *Params) GetMinBlobBaseFee() string {
+	if x != nil {
+		return x.MinBlobBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetBlobBaseFeeChangeDenominator() uint64 {
+	if x != nil {
+		return x.BlobBaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *Params) GetTargetSquareUtilisation() string {
+	if x != nil {
+		return x.TargetSquareUtilisation
+	}
+	return ""
+}
+
+func (x *Params) GetBlobQuotaWindow() uint64 {
+	if x != nil {
+		return x.BlobQuotaWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAccountBlobQuota() uint64 {
+	if x != nil {
+		return x.AccountBlobQuota
+	}
+	return 0
+}
+
+func (x *Params) GetNamespaceBlobQuota() uint64 {
+	if x != nil {
+		return x.NamespaceBlobQuota
+	}
+	return 0
+}
+
+func (x *Params) GetBlobQuotaExemptAddresses() []string {
+	if x != nil {
+		return x.BlobQuotaExemptAddresses
+	}
+	return nil
+}
+
+func (x *Params) GetNamespaceRegistrationDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.NamespaceRegistrationDeposit
+	}
+	return nil
+}
+
 var File_sunrise_blob_v1_params_proto protoreflect.FileDescriptor
 
 var file_sunrise_blob_v1_params_proto_rawDesc = []byte{
@@ -530,32 +1207,101 @@ var file_sunrise_blob_v1_params_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
 	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x76, 0x31, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
-	0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c,
-	0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x52, 0x0e, 0x67, 0x61,
-	0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x62, 0x42, 0x79, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x13,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xde, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47,
+	0x0a, 0x11, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x52, 0x0e, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x62, 0x42, 0x79, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x67, 0x6f, 0x76, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
 	0x67, 0x6f, 0x76, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x6f, 0x76, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x71, 0x75,
-	0x61, 0x72, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x4d, 0x61,
-	0x78, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x21, 0xe8, 0xa0, 0x1f,
-	0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f,
-	0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa9,
-	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62,
-	0x6c, 0x6f, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x62, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0f, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x42,
-	0x6c, 0x6f, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x53, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x69, 0x7a, 0x65, 0x22, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x4d, 0x61, 0x78, 0x53, 0x71, 0x75, 0x61,
+	0x72, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x62, 0x5f,
+	0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f,
+	0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x62, 0x6c, 0x6f, 0x62, 0x4c, 0x61, 0x6e, 0x65, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x7d, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x62, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x12, 0x73, 0x0a, 0x20, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2b,
+	0xf2, 0xde, 0x1f, 0x27, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x1c, 0x62, 0x6c, 0x6f,
+	0x62, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x96, 0x01, 0x0a, 0x19, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2,
+	0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x0f, 0x62, 0x6c, 0x6f,
+	0x62, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4b, 0x0a, 0x12,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x6c, 0x6f, 0x62, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x14, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x7d, 0x0a, 0x1b,
+	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x3e, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x18, 0x62, 0x6c, 0x6f, 0x62, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x1e,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x32, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0xa9, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c,
+	0x6f, 0x62, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x0f, 0x53, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1b, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -572,14 +1318,16 @@ func file_sunrise_blob_v1_params_proto_rawDescGZIP() []byte {
 
 var file_sunrise_blob_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_sunrise_blob_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: sunrise.blob.v1.Params
+	(*Params)(nil),       // 0: sunrise.blob.v1.Params
+	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
 }
 var file_sunrise_blob_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: sunrise.blob.v1.Params.namespace_registration_deposit:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_blob_v1_params_proto_init() }
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/sunrise.blob.v1.Query/Params"
+	Query_Params_FullMethodName             = "/sunrise.blob.v1.Query/Params"
+	Query_BlobBaseFee_FullMethodName        = "/sunrise.blob.v1.Query/BlobBaseFee"
+	Query_AccountBlobQuota_FullMethodName   = "/sunrise.blob.v1.Query/AccountBlobQuota"
+	Query_NamespaceBlobQuota_FullMethodName = "/sunrise.blob.v1.Query/NamespaceBlobQuota"
+	Query_Namespace_FullMethodName          = "/sunrise.blob.v1.Query/Namespace"
+	Query_NamespacesByOwner_FullMethodName  = "/sunrise.blob.v1.Query/NamespacesByOwner"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +33,19 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the current blob base fee, the minimum price per blob
+	// byte.
+	BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error)
+	// AccountBlobQuota queries the blob quota of an account in the current
+	// window.
+	AccountBlobQuota(ctx context.Context, in *QueryAccountBlobQuotaRequest, opts ...grpc.CallOption) (*QueryAccountBlobQuotaResponse, error)
+	// NamespaceBlobQuota queries the blob quota of a namespace in the current
+	// window.
+	NamespaceBlobQuota(ctx context.Context, in *QueryNamespaceBlobQuotaRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobQuotaResponse, error)
+	// Namespace queries a registered namespace.
+	Namespace(ctx context.Context, in *QueryNamespaceRequest, opts ...grpc.CallOption) (*QueryNamespaceResponse, error)
+	// NamespacesByOwner queries the namespaces registered by an owner.
+	NamespacesByOwner(ctx context.Context, in *QueryNamespacesByOwnerRequest, opts ...grpc.CallOption) (*QueryNamespacesByOwnerResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +65,70 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error) {
+	out := new(QueryBlobBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_BlobBaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBlobQuota(ctx context.Context, in *QueryAccountBlobQuotaRequest, opts ...grpc.CallOption) (*QueryAccountBlobQuotaResponse, error) {
+	out := new(QueryAccountBlobQuotaResponse)
+	err := c.cc.Invoke(ctx, Query_AccountBlobQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceBlobQuota(ctx context.Context, in *QueryNamespaceBlobQuotaRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobQuotaResponse, error) {
+	out := new(QueryNamespaceBlobQuotaResponse)
+	err := c.cc.Invoke(ctx, Query_NamespaceBlobQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Namespace(ctx context.Context, in *QueryNamespaceRequest, opts ...grpc.CallOption) (*QueryNamespaceResponse, error) {
+	out := new(QueryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Query_Namespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespacesByOwner(ctx context.Context, in *QueryNamespacesByOwnerRequest, opts ...grpc.CallOption) (*QueryNamespacesByOwnerResponse, error) {
+	out := new(QueryNamespacesByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_NamespacesByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the current blob base fee, the minimum price per blob
+	// byte.
+	BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error)
+	// AccountBlobQuota queries the blob quota of an account in the current
+	// window.
+	AccountBlobQuota(context.Context, *QueryAccountBlobQuotaRequest) (*QueryAccountBlobQuotaResponse, error)
+	// NamespaceBlobQuota queries the blob quota of a namespace in the current
+	// window.
+	NamespaceBlobQuota(context.Context, *QueryNamespaceBlobQuotaRequest) (*QueryNamespaceBlobQuotaResponse, error)
+	// Namespace queries a registered namespace.
+	Namespace(context.Context, *QueryNamespaceRequest) (*QueryNamespaceResponse, error)
+	// NamespacesByOwner queries the namespaces registered by an owner.
+	NamespacesByOwner(context.Context, *QueryNamespacesByOwnerRequest) (*QueryNamespacesByOwnerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +139,21 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobBaseFee not implemented")
+}
+func (UnimplementedQueryServer) AccountBlobQuota(context.Context, *QueryAccountBlobQuotaRequest) (*QueryAccountBlobQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBlobQuota not implemented")
+}
+func (UnimplementedQueryServer) NamespaceBlobQuota(context.Context, *QueryNamespaceBlobQuotaRequest) (*QueryNamespaceBlobQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceBlobQuota not implemented")
+}
+func (UnimplementedQueryServer) Namespace(context.Context, *QueryNamespaceRequest) (*QueryNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespace not implemented")
+}
+func (UnimplementedQueryServer) NamespacesByOwner(context.Context, *QueryNamespacesByOwnerRequest) (*QueryNamespacesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespacesByOwner not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +185,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlobBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlobBaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobBaseFee(ctx, req.(*QueryBlobBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBlobQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBlobQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBlobQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountBlobQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBlobQuota(ctx, req.(*QueryAccountBlobQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceBlobQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceBlobQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceBlobQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamespaceBlobQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceBlobQuota(ctx, req.(*QueryNamespaceBlobQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Namespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Namespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Namespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Namespace(ctx, req.(*QueryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespacesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespacesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespacesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamespacesByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespacesByOwner(ctx, req.(*QueryNamespacesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +286,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlobBaseFee",
+			Handler:    _Query_BlobBaseFee_Handler,
+		},
+		{
+			MethodName: "AccountBlobQuota",
+			Handler:    _Query_AccountBlobQuota_Handler,
+		},
+		{
+			MethodName: "NamespaceBlobQuota",
+			Handler:    _Query_NamespaceBlobQuota_Handler,
+		},
+		{
+			MethodName: "Namespace",
+			Handler:    _Query_Namespace_Handler,
+		},
+		{
+			MethodName: "NamespacesByOwner",
+			Handler:    _Query_NamespacesByOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/blob/v1/query.proto",
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/sunrise.blob.v1.Msg/UpdateParams"
-	Msg_PayForBlobs_FullMethodName  = "/sunrise.blob.v1.Msg/PayForBlobs"
+	Msg_UpdateParams_FullMethodName           = "/sunrise.blob.v1.Msg/UpdateParams"
+	Msg_PayForBlobs_FullMethodName            = "/sunrise.blob.v1.Msg/PayForBlobs"
+	Msg_RegisterNamespace_FullMethodName      = "/sunrise.blob.v1.Msg/RegisterNamespace"
+	Msg_DeregisterNamespace_FullMethodName    = "/sunrise.blob.v1.Msg/DeregisterNamespace"
+	Msg_TransferNamespace_FullMethodName      = "/sunrise.blob.v1.Msg/TransferNamespace"
+	Msg_AddNamespacePoster_FullMethodName     = "/sunrise.blob.v1.Msg/AddNamespacePoster"
+	Msg_RemoveNamespacePoster_FullMethodName  = "/sunrise.blob.v1.Msg/RemoveNamespacePoster"
+	Msg_SetNamespaceRestricted_FullMethodName = "/sunrise.blob.v1.Msg/SetNamespaceRestricted"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +37,21 @@ type MsgClient interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
+	// RegisterNamespace registers a namespace owned by the signer against the
+	// namespace registration deposit.
+	RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error)
+	// DeregisterNamespace deregisters a namespace and returns its deposit to
+	// the owner.
+	DeregisterNamespace(ctx context.Context, in *MsgDeregisterNamespace, opts ...grpc.CallOption) (*MsgDeregisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace.
+	TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error)
+	// AddNamespacePoster delegates the posting rights of a namespace.
+	AddNamespacePoster(ctx context.Context, in *MsgAddNamespacePoster, opts ...grpc.CallOption) (*MsgAddNamespacePosterResponse, error)
+	// RemoveNamespacePoster revokes the posting rights of a namespace.
+	RemoveNamespacePoster(ctx context.Context, in *MsgRemoveNamespacePoster, opts ...grpc.CallOption) (*MsgRemoveNamespacePosterResponse, error)
+	// SetNamespaceRestricted sets whether only the owner and the posters of a
+	// namespace can pay for blobs in it.
+	SetNamespaceRestricted(ctx context.Context, in *MsgSetNamespaceRestricted, opts ...grpc.CallOption) (*MsgSetNamespaceRestrictedResponse, error)
 }
 
 type msgClient struct {
@@ -59,6 +80,60 @@ func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ..
 	return out, nil
 }
 
+func (c *msgClient) RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error) {
+	out := new(MsgRegisterNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterNamespace(ctx context.Context, in *MsgDeregisterNamespace, opts ...grpc.CallOption) (*MsgDeregisterNamespaceResponse, error) {
+	out := new(MsgDeregisterNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error) {
+	out := new(MsgTransferNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddNamespacePoster(ctx context.Context, in *MsgAddNamespacePoster, opts ...grpc.CallOption) (*MsgAddNamespacePosterResponse, error) {
+	out := new(MsgAddNamespacePosterResponse)
+	err := c.cc.Invoke(ctx, Msg_AddNamespacePoster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNamespacePoster(ctx context.Context, in *MsgRemoveNamespacePoster, opts ...grpc.CallOption) (*MsgRemoveNamespacePosterResponse, error) {
+	out := new(MsgRemoveNamespacePosterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveNamespacePoster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNamespaceRestricted(ctx context.Context, in *MsgSetNamespaceRestricted, opts ...grpc.CallOption) (*MsgSetNamespaceRestrictedResponse, error) {
+	out := new(MsgSetNamespaceRestrictedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetNamespaceRestricted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -67,6 +142,21 @@ type MsgServer interface {
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
+	// RegisterNamespace registers a namespace owned by the signer against the
+	// namespace registration deposit.
+	RegisterNamespace(context.Context, *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error)
+	// DeregisterNamespace deregisters a namespace and returns its deposit to
+	// the owner.
+	DeregisterNamespace(context.Context, *MsgDeregisterNamespace) (*MsgDeregisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace.
+	TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error)
+	// AddNamespacePoster delegates the posting rights of a namespace.
+	AddNamespacePoster(context.Context, *MsgAddNamespacePoster) (*MsgAddNamespacePosterResponse, error)
+	// RemoveNamespacePoster revokes the posting rights of a namespace.
+	RemoveNamespacePoster(context.Context, *MsgRemoveNamespacePoster) (*MsgRemoveNamespacePosterResponse, error)
+	// SetNamespaceRestricted sets whether only the owner and the posters of a
+	// namespace can pay for blobs in it.
+	SetNamespaceRestricted(context.Context, *MsgSetNamespaceRestricted) (*MsgSetNamespaceRestrictedResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -80,6 +170,24 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
 }
+func (UnimplementedMsgServer) RegisterNamespace(context.Context, *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNamespace not implemented")
+}
+func (UnimplementedMsgServer) DeregisterNamespace(context.Context, *MsgDeregisterNamespace) (*MsgDeregisterNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterNamespace not implemented")
+}
+func (UnimplementedMsgServer) TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespace not implemented")
+}
+func (UnimplementedMsgServer) AddNamespacePoster(context.Context, *MsgAddNamespacePoster) (*MsgAddNamespacePosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamespacePoster not implemented")
+}
+func (UnimplementedMsgServer) RemoveNamespacePoster(context.Context, *MsgRemoveNamespacePoster) (*MsgRemoveNamespacePosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamespacePoster not implemented")
+}
+func (UnimplementedMsgServer) SetNamespaceRestricted(context.Context, *MsgSetNamespaceRestricted) (*MsgSetNamespaceRestrictedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceRestricted not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +237,114 @@ func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterNamespace(ctx, req.(*MsgRegisterNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterNamespace(ctx, req.(*MsgDeregisterNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNamespace(ctx, req.(*MsgTransferNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddNamespacePoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNamespacePoster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddNamespacePoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddNamespacePoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNamespacePoster(ctx, req.(*MsgAddNamespacePoster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNamespacePoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNamespacePoster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNamespacePoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveNamespacePoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNamespacePoster(ctx, req.(*MsgRemoveNamespacePoster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNamespaceRestricted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNamespaceRestricted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNamespaceRestricted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetNamespaceRestricted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNamespaceRestricted(ctx, req.(*MsgSetNamespaceRestricted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +360,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayForBlobs",
 			Handler:    _Msg_PayForBlobs_Handler,
 		},
+		{
+			MethodName: "RegisterNamespace",
+			Handler:    _Msg_RegisterNamespace_Handler,
+		},
+		{
+			MethodName: "DeregisterNamespace",
+			Handler:    _Msg_DeregisterNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespace",
+			Handler:    _Msg_TransferNamespace_Handler,
+		},
+		{
+			MethodName: "AddNamespacePoster",
+			Handler:    _Msg_AddNamespacePoster_Handler,
+		},
+		{
+			MethodName: "RemoveNamespacePoster",
+			Handler:    _Msg_RemoveNamespacePoster_Handler,
+		},
+		{
+			MethodName: "SetNamespaceRestricted",
+			Handler:    _Msg_SetNamespaceRestricted_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/blob/v1/tx.proto",
@@ -27,6 +27,7 @@ const (
 	Query_LatestUnbondingHeight_FullMethodName          = "/sunrise.blobstream.v1.Query/LatestUnbondingHeight"
 	Query_DataCommitmentRangeForHeight_FullMethodName   = "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight"
 	Query_LatestDataCommitment_FullMethodName           = "/sunrise.blobstream.v1.Query/LatestDataCommitment"
+	Query_DataRootInclusionProof_FullMethodName         = "/sunrise.blobstream.v1.Query/DataRootInclusionProof"
 	Query_EvmAddress_FullMethodName                     = "/sunrise.blobstream.v1.Query/EvmAddress"
 )
 
@@ -56,6 +57,9 @@ type QueryClient interface {
 	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootInclusionProof returns the inclusion proof of the data root tuple
+	// of the provided height to the data commitment that includes it
+	DataRootInclusionProof(ctx context.Context, in *QueryDataRootInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootInclusionProofResponse, error)
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error)
@@ -141,6 +145,15 @@ func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestD
 	return out, nil
 }
 
+func (c *queryClient) DataRootInclusionProof(ctx context.Context, in *QueryDataRootInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootInclusionProofResponse, error) {
+	out := new(QueryDataRootInclusionProofResponse)
+	err := c.cc.Invoke(ctx, Query_DataRootInclusionProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error) {
 	out := new(QueryEvmAddressResponse)
 	err := c.cc.Invoke(ctx, Query_EvmAddress_FullMethodName, in, out, opts...)
@@ -176,6 +189,9 @@ type QueryServer interface {
 	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootInclusionProof returns the inclusion proof of the data root tuple
+	// of the provided height to the data commitment that includes it
+	DataRootInclusionProof(context.Context, *QueryDataRootInclusionProofRequest) (*QueryDataRootInclusionProofResponse, error)
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error)
@@ -210,6 +226,9 @@ func (UnimplementedQueryServer) DataCommitmentRangeForHeight(context.Context, *Q
 func (UnimplementedQueryServer) LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
 }
+func (UnimplementedQueryServer) DataRootInclusionProof(context.Context, *QueryDataRootInclusionProofRequest) (*QueryDataRootInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootInclusionProof not implemented")
+}
 func (UnimplementedQueryServer) EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvmAddress not implemented")
 }
@@ -370,6 +389,24 @@ func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataRootInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataRootInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DataRootInclusionProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataRootInclusionProof(ctx, req.(*QueryDataRootInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EvmAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEvmAddressRequest)
 	if err := dec(in); err != nil {
@@ -427,6 +464,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LatestDataCommitment",
 			Handler:    _Query_LatestDataCommitment_Handler,
 		},
+		{
+			MethodName: "DataRootInclusionProof",
+			Handler:    _Query_DataRootInclusionProof_Handler,
+		},
 		{
 			MethodName: "EvmAddress",
 			Handler:    _Query_EvmAddress_Handler,
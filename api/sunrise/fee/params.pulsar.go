@@ -62,10 +62,19 @@ func (x *_Params_3_list) IsValid() bool {
 }
 
 var (
-	md_Params               protoreflect.MessageDescriptor
-	fd_Params_fee_denom     protoreflect.FieldDescriptor
-	fd_Params_burn_ratio    protoreflect.FieldDescriptor
-	fd_Params_bypass_denoms protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_fee_denom                      protoreflect.FieldDescriptor
+	fd_Params_burn_ratio                     protoreflect.FieldDescriptor
+	fd_Params_bypass_denoms                  protoreflect.FieldDescriptor
+	fd_Params_treasury_burn_ratio            protoreflect.FieldDescriptor
+	fd_Params_treasury_community_pool_ratio  protoreflect.FieldDescriptor
+	fd_Params_swap_treasury_tax_to_fee_denom protoreflect.FieldDescriptor
+	fd_Params_fee_swap_slippage              protoreflect.FieldDescriptor
+	fd_Params_fee_swap_twap_period           protoreflect.FieldDescriptor
+	fd_Params_fee_swap_min_liquidity         protoreflect.FieldDescriptor
+	fd_Params_min_base_fee                   protoreflect.FieldDescriptor
+	fd_Params_base_fee_change_denominator    protoreflect.FieldDescriptor
+	fd_Params_target_block_gas               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -74,6 +83,15 @@ func init() {
 	fd_Params_fee_denom = md_Params.Fields().ByName("fee_denom")
 	fd_Params_burn_ratio = md_Params.Fields().ByName("burn_ratio")
 	fd_Params_bypass_denoms = md_Params.Fields().ByName("bypass_denoms")
+	fd_Params_treasury_burn_ratio = md_Params.Fields().ByName("treasury_burn_ratio")
+	fd_Params_treasury_community_pool_ratio = md_Params.Fields().ByName("treasury_community_pool_ratio")
+	fd_Params_swap_treasury_tax_to_fee_denom = md_Params.Fields().ByName("swap_treasury_tax_to_fee_denom")
+	fd_Params_fee_swap_slippage = md_Params.Fields().ByName("fee_swap_slippage")
+	fd_Params_fee_swap_twap_period = md_Params.Fields().ByName("fee_swap_twap_period")
+	fd_Params_fee_swap_min_liquidity = md_Params.Fields().ByName("fee_swap_min_liquidity")
+	fd_Params_min_base_fee = md_Params.Fields().ByName("min_base_fee")
+	fd_Params_base_fee_change_denominator = md_Params.Fields().ByName("base_fee_change_denominator")
+	fd_Params_target_block_gas = md_Params.Fields().ByName("target_block_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -159,6 +177,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.TreasuryBurnRatio != "" {
+		value := protoreflect.ValueOfString(x.TreasuryBurnRatio)
+		if !f(fd_Params_treasury_burn_ratio, value) {
+			return
+		}
+	}
+	if x.TreasuryCommunityPoolRatio != "" {
+		value := protoreflect.ValueOfString(x.TreasuryCommunityPoolRatio)
+		if !f(fd_Params_treasury_community_pool_ratio, value) {
+			return
+		}
+	}
+	if x.SwapTreasuryTaxToFeeDenom != false {
+		value := protoreflect.ValueOfBool(x.SwapTreasuryTaxToFeeDenom)
+		if !f(fd_Params_swap_treasury_tax_to_fee_denom, value) {
+			return
+		}
+	}
+	if x.FeeSwapSlippage != "" {
+		value := protoreflect.ValueOfString(x.FeeSwapSlippage)
+		if !f(fd_Params_fee_swap_slippage, value) {
+			return
+		}
+	}
+	if x.FeeSwapTwapPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeSwapTwapPeriod)
+		if !f(fd_Params_fee_swap_twap_period, value) {
+			return
+		}
+	}
+	if x.FeeSwapMinLiquidity != "" {
+		value := protoreflect.ValueOfString(x.FeeSwapMinLiquidity)
+		if !f(fd_Params_fee_swap_min_liquidity, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_Params_min_base_fee, value) {
+			return
+		}
+	}
+	if x.BaseFeeChangeDenominator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeChangeDenominator)
+		if !f(fd_Params_base_fee_change_denominator, value) {
+			return
+		}
+	}
+	if x.TargetBlockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetBlockGas)
+		if !f(fd_Params_target_block_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -180,6 +252,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BurnRatio != ""
 	case "sunrise.fee.Params.bypass_denoms":
 		return len(x.BypassDenoms) != 0
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		return x.TreasuryBurnRatio != ""
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		return x.TreasuryCommunityPoolRatio != ""
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		return x.SwapTreasuryTaxToFeeDenom != false
+	case "sunrise.fee.Params.fee_swap_slippage":
+		return x.FeeSwapSlippage != ""
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		return x.FeeSwapTwapPeriod != uint64(0)
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		return x.FeeSwapMinLiquidity != ""
+	case "sunrise.fee.Params.min_base_fee":
+		return x.MinBaseFee != ""
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		return x.BaseFeeChangeDenominator != uint64(0)
+	case "sunrise.fee.Params.target_block_gas":
+		return x.TargetBlockGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -202,6 +292,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.BurnRatio = ""
 	case "sunrise.fee.Params.bypass_denoms":
 		x.BypassDenoms = nil
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		x.TreasuryBurnRatio = ""
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		x.TreasuryCommunityPoolRatio = ""
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		x.SwapTreasuryTaxToFeeDenom = false
+	case "sunrise.fee.Params.fee_swap_slippage":
+		x.FeeSwapSlippage = ""
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		x.FeeSwapTwapPeriod = uint64(0)
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		x.FeeSwapMinLiquidity = ""
+	case "sunrise.fee.Params.min_base_fee":
+		x.MinBaseFee = ""
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = uint64(0)
+	case "sunrise.fee.Params.target_block_gas":
+		x.TargetBlockGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -230,6 +338,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_3_list{list: &x.BypassDenoms}
 		return protoreflect.ValueOfList(listValue)
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		value := x.TreasuryBurnRatio
+		return protoreflect.ValueOfString(value)
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		value := x.TreasuryCommunityPoolRatio
+		return protoreflect.ValueOfString(value)
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		value := x.SwapTreasuryTaxToFeeDenom
+		return protoreflect.ValueOfBool(value)
+	case "sunrise.fee.Params.fee_swap_slippage":
+		value := x.FeeSwapSlippage
+		return protoreflect.ValueOfString(value)
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		value := x.FeeSwapTwapPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		value := x.FeeSwapMinLiquidity
+		return protoreflect.ValueOfString(value)
+	case "sunrise.fee.Params.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		value := x.BaseFeeChangeDenominator
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.fee.Params.target_block_gas":
+		value := x.TargetBlockGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -258,6 +393,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_3_list)
 		x.BypassDenoms = *clv.list
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		x.TreasuryBurnRatio = value.Interface().(string)
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		x.TreasuryCommunityPoolRatio = value.Interface().(string)
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		x.SwapTreasuryTaxToFeeDenom = value.Bool()
+	case "sunrise.fee.Params.fee_swap_slippage":
+		x.FeeSwapSlippage = value.Interface().(string)
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		x.FeeSwapTwapPeriod = value.Uint()
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		x.FeeSwapMinLiquidity = value.Interface().(string)
+	case "sunrise.fee.Params.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = value.Uint()
+	case "sunrise.fee.Params.target_block_gas":
+		x.TargetBlockGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -288,6 +441,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field fee_denom of message sunrise.fee.Params is not mutable"))
 	case "sunrise.fee.Params.burn_ratio":
 		panic(fmt.Errorf("field burn_ratio of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		panic(fmt.Errorf("field treasury_burn_ratio of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		panic(fmt.Errorf("field treasury_community_pool_ratio of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		panic(fmt.Errorf("field swap_treasury_tax_to_fee_denom of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.fee_swap_slippage":
+		panic(fmt.Errorf("field fee_swap_slippage of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		panic(fmt.Errorf("field fee_swap_twap_period of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		panic(fmt.Errorf("field fee_swap_min_liquidity of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		panic(fmt.Errorf("field base_fee_change_denominator of message sunrise.fee.Params is not mutable"))
+	case "sunrise.fee.Params.target_block_gas":
+		panic(fmt.Errorf("field target_block_gas of message sunrise.fee.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -308,6 +479,24 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "sunrise.fee.Params.bypass_denoms":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "sunrise.fee.Params.treasury_burn_ratio":
+		return protoreflect.ValueOfString("")
+	case "sunrise.fee.Params.treasury_community_pool_ratio":
+		return protoreflect.ValueOfString("")
+	case "sunrise.fee.Params.swap_treasury_tax_to_fee_denom":
+		return protoreflect.ValueOfBool(false)
+	case "sunrise.fee.Params.fee_swap_slippage":
+		return protoreflect.ValueOfString("")
+	case "sunrise.fee.Params.fee_swap_twap_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.fee.Params.fee_swap_min_liquidity":
+		return protoreflect.ValueOfString("")
+	case "sunrise.fee.Params.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "sunrise.fee.Params.base_fee_change_denominator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.fee.Params.target_block_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.fee.Params"))
@@ -391,6 +580,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.TreasuryBurnRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TreasuryCommunityPoolRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SwapTreasuryTaxToFeeDenom {
+			n += 2
+		}
+		l = len(x.FeeSwapSlippage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeSwapTwapPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeSwapTwapPeriod))
+		}
+		l = len(x.FeeSwapMinLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeChangeDenominator))
+		}
+		if x.TargetBlockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetBlockGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -420,6 +641,66 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TargetBlockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetBlockGas))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.BaseFeeChangeDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeChangeDenominator))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.FeeSwapMinLiquidity) > 0 {
+			i -= len(x.FeeSwapMinLiquidity)
+			copy(dAtA[i:], x.FeeSwapMinLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeSwapMinLiquidity)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.FeeSwapTwapPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeSwapTwapPeriod))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FeeSwapSlippage) > 0 {
+			i -= len(x.FeeSwapSlippage)
+			copy(dAtA[i:], x.FeeSwapSlippage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeSwapSlippage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.SwapTreasuryTaxToFeeDenom {
+			i--
+			if x.SwapTreasuryTaxToFeeDenom {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TreasuryCommunityPoolRatio) > 0 {
+			i -= len(x.TreasuryCommunityPoolRatio)
+			copy(dAtA[i:], x.TreasuryCommunityPoolRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TreasuryCommunityPoolRatio)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TreasuryBurnRatio) > 0 {
+			i -= len(x.TreasuryBurnRatio)
+			copy(dAtA[i:], x.TreasuryBurnRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TreasuryBurnRatio)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.BypassDenoms) > 0 {
 			for iNdEx := len(x.BypassDenoms) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.BypassDenoms[iNdEx])
@@ -588,6 +869,243 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.BypassDenoms = append(x.BypassDenoms, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryBurnRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TreasuryBurnRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryCommunityPoolRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TreasuryCommunityPoolRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapTreasuryTaxToFeeDenom", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapTreasuryTaxToFeeDenom = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSwapSlippage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSwapSlippage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSwapTwapPeriod", wireType)
+				}
+				x.FeeSwapTwapPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeSwapTwapPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSwapMinLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSwapMinLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+				}
+				x.BaseFeeChangeDenominator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+				}
+				x.TargetBlockGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetBlockGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -645,6 +1163,30 @@ type Params struct {
 	FeeDenom     string   `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
 	BurnRatio    string   `protobuf:"bytes,2,opt,name=burn_ratio,json=burnRatio,proto3" json:"burn_ratio,omitempty"`
 	BypassDenoms []string `protobuf:"bytes,3,rep,name=bypass_denoms,json=bypassDenoms,proto3" json:"bypass_denoms,omitempty"`
+	// Ratio of the swap treasury tax burnt
+	TreasuryBurnRatio string `protobuf:"bytes,4,opt,name=treasury_burn_ratio,json=treasuryBurnRatio,proto3" json:"treasury_burn_ratio,omitempty"`
+	// Ratio of the swap treasury tax sent to the community pool.
+	// The rest is paid as vote rewards.
+	TreasuryCommunityPoolRatio string `protobuf:"bytes,5,opt,name=treasury_community_pool_ratio,json=treasuryCommunityPoolRatio,proto3" json:"treasury_community_pool_ratio,omitempty"`
+	// If true the burnt treasury tax in other denoms is swapped to fee_denom
+	// before burning
+	SwapTreasuryTaxToFeeDenom bool `protobuf:"varint,6,opt,name=swap_treasury_tax_to_fee_denom,json=swapTreasuryTaxToFeeDenom,proto3" json:"swap_treasury_tax_to_fee_denom,omitempty"`
+	// Slippage buffer deducted from the quoted value of fees paid in a denom
+	// other than fee_denom
+	FeeSwapSlippage string `protobuf:"bytes,7,opt,name=fee_swap_slippage,json=feeSwapSlippage,proto3" json:"fee_swap_slippage,omitempty"`
+	// Period in seconds of the TWAP used to quote fees paid in a denom other
+	// than fee_denom. If zero only the spot price is used.
+	FeeSwapTwapPeriod uint64 `protobuf:"varint,8,opt,name=fee_swap_twap_period,json=feeSwapTwapPeriod,proto3" json:"fee_swap_twap_period,omitempty"`
+	// Minimum current tick liquidity of a pool against fee_denom to accept fees
+	// in its other denom
+	FeeSwapMinLiquidity string `protobuf:"bytes,9,opt,name=fee_swap_min_liquidity,json=feeSwapMinLiquidity,proto3" json:"fee_swap_min_liquidity,omitempty"`
+	// Lower bound of the base fee, the minimum gas price in fee_denom
+	// enforced by consensus
+	MinBaseFee string `protobuf:"bytes,10,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// The base fee changes by at most 1 / base_fee_change_denominator per block
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,11,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// Block gas used at which the base fee stays unchanged
+	TargetBlockGas uint64 `protobuf:"varint,12,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -688,6 +1230,69 @@ func (x *Params) GetBypassDenoms() []string {
 	return nil
 }
 
+func (x *Params) GetTreasuryBurnRatio() string {
+	if x != nil {
+		return x.TreasuryBurnRatio
+	}
+	return ""
+}
+
+func (x *Params) GetTreasuryCommunityPoolRatio() string {
+	if x != nil {
+		return x.TreasuryCommunityPoolRatio
+	}
+	return ""
+}
+
+func (x *Params) GetSwapTreasuryTaxToFeeDenom() bool {
+	if x != nil {
+		return x.SwapTreasuryTaxToFeeDenom
+	}
+	return false
+}
+
+func (x *Params) GetFeeSwapSlippage() string {
+	if x != nil {
+		return x.FeeSwapSlippage
+	}
+	return ""
+}
+
+func (x *Params) GetFeeSwapTwapPeriod() uint64 {
+	if x != nil {
+		return x.FeeSwapTwapPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetFeeSwapMinLiquidity() string {
+	if x != nil {
+		return x.FeeSwapMinLiquidity
+	}
+	return ""
+}
+
+func (x *Params) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetBaseFeeChangeDenominator() uint64 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *Params) GetTargetBlockGas() uint64 {
+	if x != nil {
+		return x.TargetBlockGas
+	}
+	return 0
+}
+
 var File_sunrise_fee_params_proto protoreflect.FileDescriptor
 
 var file_sunrise_fee_params_proto_rawDesc = []byte{
@@ -697,7 +1302,7 @@ var file_sunrise_fee_params_proto_rawDesc = []byte{
 	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x01, 0x0a, 0x06,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x07, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65,
 	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65,
 	0x6e, 0x6f, 0x6d, 0x12, 0x55, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69,
@@ -707,19 +1312,65 @@ var file_sunrise_fee_params_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
 	0x09, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79,
 	0x70, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x3a,
-	0x1d, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x89,
-	0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x66,
-	0x65, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x66, 0x65, 0x65, 0xa2,
-	0x02, 0x03, 0x53, 0x46, 0x58, 0xaa, 0x02, 0x0b, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x46, 0x65, 0x65, 0xca, 0x02, 0x0b, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x46, 0x65,
-	0x65, 0xe2, 0x02, 0x17, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x46, 0x65, 0x65, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x53, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x09, 0x52, 0x0c, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x66, 0x0a, 0x13, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0x75,
+	0x72, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x79, 0x0a, 0x1d, 0x74, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x41, 0x0a, 0x1e, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x73, 0x77, 0x61, 0x70,
+	0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x54, 0x61, 0x78, 0x54, 0x6f, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x62, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x65, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x77, 0x61, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x6b, 0x0a, 0x16, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x13, 0x66, 0x65, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x69, 0x6e, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f, 0x66,
+	0x65, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x66, 0x65, 0x65, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x66, 0x65, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x46, 0x58,
+	0xaa, 0x02, 0x0b, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x46, 0x65, 0x65, 0xca, 0x02,
+	0x0b, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x46, 0x65, 0x65, 0xe2, 0x02, 0x17, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x46, 0x65, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x3a, 0x3a, 0x46, 0x65, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/sunrise.liquidityincentive.Query/Params"
-	Query_Epoch_FullMethodName  = "/sunrise.liquidityincentive.Query/Epoch"
-	Query_Epochs_FullMethodName = "/sunrise.liquidityincentive.Query/Epochs"
-	Query_Gauge_FullMethodName  = "/sunrise.liquidityincentive.Query/Gauge"
-	Query_Gauges_FullMethodName = "/sunrise.liquidityincentive.Query/Gauges"
+	Query_Params_FullMethodName              = "/sunrise.liquidityincentive.Query/Params"
+	Query_Epoch_FullMethodName               = "/sunrise.liquidityincentive.Query/Epoch"
+	Query_Epochs_FullMethodName              = "/sunrise.liquidityincentive.Query/Epochs"
+	Query_Gauge_FullMethodName               = "/sunrise.liquidityincentive.Query/Gauge"
+	Query_Gauges_FullMethodName              = "/sunrise.liquidityincentive.Query/Gauges"
+	Query_VoteRewards_FullMethodName         = "/sunrise.liquidityincentive.Query/VoteRewards"
+	Query_PositionIncentives_FullMethodName  = "/sunrise.liquidityincentive.Query/PositionIncentives"
+	Query_PositionsIncentives_FullMethodName = "/sunrise.liquidityincentive.Query/PositionsIncentives"
+	Query_AddressIncentives_FullMethodName   = "/sunrise.liquidityincentive.Query/AddressIncentives"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +42,12 @@ type QueryClient interface {
 	// Queries a list of Gauge items.
 	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
 	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// Queries the vote rewards claimable by an address.
+	VoteRewards(ctx context.Context, in *QueryVoteRewardsRequest, opts ...grpc.CallOption) (*QueryVoteRewardsResponse, error)
+	// Queries the incentives claimable by positions or an address.
+	PositionIncentives(ctx context.Context, in *QueryPositionIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionIncentivesResponse, error)
+	PositionsIncentives(ctx context.Context, in *QueryPositionsIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionsIncentivesResponse, error)
+	AddressIncentives(ctx context.Context, in *QueryAddressIncentivesRequest, opts ...grpc.CallOption) (*QueryAddressIncentivesResponse, error)
 }
 
 type queryClient struct {
@@ -93,6 +103,42 @@ func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VoteRewards(ctx context.Context, in *QueryVoteRewardsRequest, opts ...grpc.CallOption) (*QueryVoteRewardsResponse, error) {
+	out := new(QueryVoteRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_VoteRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionIncentives(ctx context.Context, in *QueryPositionIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionIncentivesResponse, error) {
+	out := new(QueryPositionIncentivesResponse)
+	err := c.cc.Invoke(ctx, Query_PositionIncentives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionsIncentives(ctx context.Context, in *QueryPositionsIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionsIncentivesResponse, error) {
+	out := new(QueryPositionsIncentivesResponse)
+	err := c.cc.Invoke(ctx, Query_PositionsIncentives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressIncentives(ctx context.Context, in *QueryAddressIncentivesRequest, opts ...grpc.CallOption) (*QueryAddressIncentivesResponse, error) {
+	out := new(QueryAddressIncentivesResponse)
+	err := c.cc.Invoke(ctx, Query_AddressIncentives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -105,6 +151,12 @@ type QueryServer interface {
 	// Queries a list of Gauge items.
 	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
 	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// Queries the vote rewards claimable by an address.
+	VoteRewards(context.Context, *QueryVoteRewardsRequest) (*QueryVoteRewardsResponse, error)
+	// Queries the incentives claimable by positions or an address.
+	PositionIncentives(context.Context, *QueryPositionIncentivesRequest) (*QueryPositionIncentivesResponse, error)
+	PositionsIncentives(context.Context, *QueryPositionsIncentivesRequest) (*QueryPositionsIncentivesResponse, error)
+	AddressIncentives(context.Context, *QueryAddressIncentivesRequest) (*QueryAddressIncentivesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -127,6 +179,18 @@ func (UnimplementedQueryServer) Gauge(context.Context, *QueryGaugeRequest) (*Que
 func (UnimplementedQueryServer) Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
 }
+func (UnimplementedQueryServer) VoteRewards(context.Context, *QueryVoteRewardsRequest) (*QueryVoteRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRewards not implemented")
+}
+func (UnimplementedQueryServer) PositionIncentives(context.Context, *QueryPositionIncentivesRequest) (*QueryPositionIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionIncentives not implemented")
+}
+func (UnimplementedQueryServer) PositionsIncentives(context.Context, *QueryPositionsIncentivesRequest) (*QueryPositionsIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionsIncentives not implemented")
+}
+func (UnimplementedQueryServer) AddressIncentives(context.Context, *QueryAddressIncentivesRequest) (*QueryAddressIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressIncentives not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +294,78 @@ func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteRewards(ctx, req.(*QueryVoteRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PositionIncentives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionIncentives(ctx, req.(*QueryPositionIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionsIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionsIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PositionsIncentives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionsIncentives(ctx, req.(*QueryPositionsIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressIncentives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressIncentives(ctx, req.(*QueryAddressIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -257,6 +393,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Gauges",
 			Handler:    _Query_Gauges_Handler,
 		},
+		{
+			MethodName: "VoteRewards",
+			Handler:    _Query_VoteRewards_Handler,
+		},
+		{
+			MethodName: "PositionIncentives",
+			Handler:    _Query_PositionIncentives_Handler,
+		},
+		{
+			MethodName: "PositionsIncentives",
+			Handler:    _Query_PositionsIncentives_Handler,
+		},
+		{
+			MethodName: "AddressIncentives",
+			Handler:    _Query_AddressIncentives_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquidityincentive/query.proto",
@@ -3,6 +3,7 @@ package liquidityincentive
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -2205,25 +2206,1172 @@ func (x *fastReflection_MsgCollectVoteRewards) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_MsgCollectVoteRewardsResponse_1_list)(nil)
+
+type _MsgCollectVoteRewardsResponse_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCollectVoteRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCollectVoteRewardsResponse           protoreflect.MessageDescriptor
+	fd_MsgCollectVoteRewardsResponse_collected protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquidityincentive_tx_proto_init()
+	md_MsgCollectVoteRewardsResponse = File_sunrise_liquidityincentive_tx_proto.Messages().ByName("MsgCollectVoteRewardsResponse")
+	fd_MsgCollectVoteRewardsResponse_collected = md_MsgCollectVoteRewardsResponse.Fields().ByName("collected")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCollectVoteRewardsResponse)(nil)
+
+type fastReflection_MsgCollectVoteRewardsResponse MsgCollectVoteRewardsResponse
+
+func (x *MsgCollectVoteRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCollectVoteRewardsResponse)(x)
+}
+
+func (x *MsgCollectVoteRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCollectVoteRewardsResponse_messageType fastReflection_MsgCollectVoteRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCollectVoteRewardsResponse_messageType{}
+
+type fastReflection_MsgCollectVoteRewardsResponse_messageType struct{}
+
+func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCollectVoteRewardsResponse)(nil)
+}
+func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectVoteRewardsResponse)
+}
+func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectVoteRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectVoteRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCollectVoteRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectVoteRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCollectVoteRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Collected) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCollectVoteRewardsResponse_1_list{list: &x.Collected})
+		if !f(fd_MsgCollectVoteRewardsResponse_collected, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		return len(x.Collected) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		x.Collected = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		if len(x.Collected) == 0 {
+			return protoreflect.ValueOfList(&_MsgCollectVoteRewardsResponse_1_list{})
+		}
+		listValue := &_MsgCollectVoteRewardsResponse_1_list{list: &x.Collected}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		lv := value.List()
+		clv := lv.(*_MsgCollectVoteRewardsResponse_1_list)
+		x.Collected = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		if x.Collected == nil {
+			x.Collected = []*v1beta1.Coin{}
+		}
+		value := &_MsgCollectVoteRewardsResponse_1_list{list: &x.Collected}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCollectVoteRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquidityincentive.MsgCollectVoteRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Collected) > 0 {
+			for _, e := range x.Collected {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Collected) > 0 {
+			for iNdEx := len(x.Collected) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Collected[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectVoteRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectVoteRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collected = append(x.Collected, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collected[len(x.Collected)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgCollectIncentiveRewards_2_list)(nil)
+
+type _MsgCollectIncentiveRewards_2_list struct {
+	list *[]uint64
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCollectIncentiveRewards at list field PoolIds as it is not of Message kind"))
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgCollectIncentiveRewards_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCollectIncentiveRewards          protoreflect.MessageDescriptor
+	fd_MsgCollectIncentiveRewards_sender   protoreflect.FieldDescriptor
+	fd_MsgCollectIncentiveRewards_pool_ids protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquidityincentive_tx_proto_init()
+	md_MsgCollectIncentiveRewards = File_sunrise_liquidityincentive_tx_proto.Messages().ByName("MsgCollectIncentiveRewards")
+	fd_MsgCollectIncentiveRewards_sender = md_MsgCollectIncentiveRewards.Fields().ByName("sender")
+	fd_MsgCollectIncentiveRewards_pool_ids = md_MsgCollectIncentiveRewards.Fields().ByName("pool_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCollectIncentiveRewards)(nil)
+
+type fastReflection_MsgCollectIncentiveRewards MsgCollectIncentiveRewards
+
+func (x *MsgCollectIncentiveRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCollectIncentiveRewards)(x)
+}
+
+func (x *MsgCollectIncentiveRewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCollectIncentiveRewards_messageType fastReflection_MsgCollectIncentiveRewards_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCollectIncentiveRewards_messageType{}
+
+type fastReflection_MsgCollectIncentiveRewards_messageType struct{}
+
+func (x fastReflection_MsgCollectIncentiveRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCollectIncentiveRewards)(nil)
+}
+func (x fastReflection_MsgCollectIncentiveRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectIncentiveRewards)
+}
+func (x fastReflection_MsgCollectIncentiveRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectIncentiveRewards
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCollectIncentiveRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectIncentiveRewards
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCollectIncentiveRewards) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCollectIncentiveRewards_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCollectIncentiveRewards) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectIncentiveRewards)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCollectIncentiveRewards) Interface() protoreflect.ProtoMessage {
+	return (*MsgCollectIncentiveRewards)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCollectIncentiveRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCollectIncentiveRewards_sender, value) {
+			return
+		}
+	}
+	if len(x.PoolIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCollectIncentiveRewards_2_list{list: &x.PoolIds})
+		if !f(fd_MsgCollectIncentiveRewards_pool_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCollectIncentiveRewards) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		return x.Sender != ""
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		return len(x.PoolIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectIncentiveRewards) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		x.Sender = ""
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		x.PoolIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCollectIncentiveRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		if len(x.PoolIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgCollectIncentiveRewards_2_list{})
+		}
+		listValue := &_MsgCollectIncentiveRewards_2_list{list: &x.PoolIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectIncentiveRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		x.Sender = value.Interface().(string)
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		lv := value.List()
+		clv := lv.(*_MsgCollectIncentiveRewards_2_list)
+		x.PoolIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectIncentiveRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		if x.PoolIds == nil {
+			x.PoolIds = []uint64{}
+		}
+		value := &_MsgCollectIncentiveRewards_2_list{list: &x.PoolIds}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		panic(fmt.Errorf("field sender of message sunrise.liquidityincentive.MsgCollectIncentiveRewards is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCollectIncentiveRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.sender":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewards.pool_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgCollectIncentiveRewards_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewards"))
+		}
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewards does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCollectIncentiveRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquidityincentive.MsgCollectIncentiveRewards", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCollectIncentiveRewards) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCollectIncentiveRewards) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCollectIncentiveRewards) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCollectIncentiveRewards) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCollectIncentiveRewards)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PoolIds) > 0 {
+			l = 0
+			for _, e := range x.PoolIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCollectIncentiveRewards)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PoolIds) > 0 {
+			var pksize2 int
+			for _, num := range x.PoolIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PoolIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCollectIncentiveRewards)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectIncentiveRewards: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectIncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PoolIds = append(x.PoolIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PoolIds) == 0 {
+						x.PoolIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PoolIds = append(x.PoolIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgCollectIncentiveRewardsResponse_1_list)(nil)
+
+type _MsgCollectIncentiveRewardsResponse_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCollectIncentiveRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCollectVoteRewardsResponse protoreflect.MessageDescriptor
+	md_MsgCollectIncentiveRewardsResponse           protoreflect.MessageDescriptor
+	fd_MsgCollectIncentiveRewardsResponse_collected protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquidityincentive_tx_proto_init()
-	md_MsgCollectVoteRewardsResponse = File_sunrise_liquidityincentive_tx_proto.Messages().ByName("MsgCollectVoteRewardsResponse")
+	md_MsgCollectIncentiveRewardsResponse = File_sunrise_liquidityincentive_tx_proto.Messages().ByName("MsgCollectIncentiveRewardsResponse")
+	fd_MsgCollectIncentiveRewardsResponse_collected = md_MsgCollectIncentiveRewardsResponse.Fields().ByName("collected")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCollectVoteRewardsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCollectIncentiveRewardsResponse)(nil)
 
-type fastReflection_MsgCollectVoteRewardsResponse MsgCollectVoteRewardsResponse
+type fastReflection_MsgCollectIncentiveRewardsResponse MsgCollectIncentiveRewardsResponse
 
-func (x *MsgCollectVoteRewardsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCollectVoteRewardsResponse)(x)
+func (x *MsgCollectIncentiveRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCollectIncentiveRewardsResponse)(x)
 }
 
-func (x *MsgCollectVoteRewardsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[5]
+func (x *MsgCollectIncentiveRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,43 +3382,43 @@ func (x *MsgCollectVoteRewardsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCollectVoteRewardsResponse_messageType fastReflection_MsgCollectVoteRewardsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCollectVoteRewardsResponse_messageType{}
+var _fastReflection_MsgCollectIncentiveRewardsResponse_messageType fastReflection_MsgCollectIncentiveRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCollectIncentiveRewardsResponse_messageType{}
 
-type fastReflection_MsgCollectVoteRewardsResponse_messageType struct{}
+type fastReflection_MsgCollectIncentiveRewardsResponse_messageType struct{}
 
-func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCollectVoteRewardsResponse)(nil)
+func (x fastReflection_MsgCollectIncentiveRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCollectIncentiveRewardsResponse)(nil)
 }
-func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCollectVoteRewardsResponse)
+func (x fastReflection_MsgCollectIncentiveRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectIncentiveRewardsResponse)
 }
-func (x fastReflection_MsgCollectVoteRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCollectVoteRewardsResponse
+func (x fastReflection_MsgCollectIncentiveRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectIncentiveRewardsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCollectVoteRewardsResponse
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCollectIncentiveRewardsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCollectVoteRewardsResponse_messageType
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCollectIncentiveRewardsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCollectVoteRewardsResponse)
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCollectIncentiveRewardsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCollectVoteRewardsResponse)(x)
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCollectIncentiveRewardsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2278,7 +3426,13 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Collected) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCollectIncentiveRewardsResponse_1_list{list: &x.Collected})
+		if !f(fd_MsgCollectIncentiveRewardsResponse_collected, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2292,13 +3446,15 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		return len(x.Collected) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2308,13 +3464,15 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		x.Collected = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2324,13 +3482,19 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		if len(x.Collected) == 0 {
+			return protoreflect.ValueOfList(&_MsgCollectIncentiveRewardsResponse_1_list{})
+		}
+		listValue := &_MsgCollectIncentiveRewardsResponse_1_list{list: &x.Collected}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2344,13 +3508,17 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		lv := value.List()
+		clv := lv.(*_MsgCollectIncentiveRewardsResponse_1_list)
+		x.Collected = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2364,36 +3532,45 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		if x.Collected == nil {
+			x.Collected = []*v1beta1.Coin{}
+		}
+		value := &_MsgCollectIncentiveRewardsResponse_1_list{list: &x.Collected}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgCollectIncentiveRewardsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectVoteRewardsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquidityincentive.MsgCollectVoteRewardsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2401,7 +3578,7 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2412,7 +3589,7 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2424,7 +3601,7 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) IsValid() bool {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2434,9 +3611,9 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCollectIncentiveRewardsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		x := input.Message.Interface().(*MsgCollectIncentiveRewardsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2448,6 +3625,12 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		if len(x.Collected) > 0 {
+			for _, e := range x.Collected {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2458,7 +3641,7 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		x := input.Message.Interface().(*MsgCollectIncentiveRewardsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2477,6 +3660,22 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Collected) > 0 {
+			for iNdEx := len(x.Collected) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Collected[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2488,7 +3687,7 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCollectVoteRewardsResponse)
+		x := input.Message.Interface().(*MsgCollectIncentiveRewardsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2520,12 +3719,46 @@ func (x *fastReflection_MsgCollectVoteRewardsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectVoteRewardsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectIncentiveRewardsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectVoteRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCollectIncentiveRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collected = append(x.Collected, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collected[len(x.Collected)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2757,6 +3990,8 @@ type MsgCollectVoteRewardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Collected []*v1beta1.Coin `protobuf:"bytes,1,rep,name=collected,proto3" json:"collected,omitempty"`
 }
 
 func (x *MsgCollectVoteRewardsResponse) Reset() {
@@ -2779,6 +4014,91 @@ func (*MsgCollectVoteRewardsResponse) Descriptor() ([]byte, []int) {
 	return file_sunrise_liquidityincentive_tx_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *MsgCollectVoteRewardsResponse) GetCollected() []*v1beta1.Coin {
+	if x != nil {
+		return x.Collected
+	}
+	return nil
+}
+
+type MsgCollectIncentiveRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+}
+
+func (x *MsgCollectIncentiveRewards) Reset() {
+	*x = MsgCollectIncentiveRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCollectIncentiveRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCollectIncentiveRewards) ProtoMessage() {}
+
+// Deprecated: Use MsgCollectIncentiveRewards.ProtoReflect.Descriptor instead.
+func (*MsgCollectIncentiveRewards) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquidityincentive_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgCollectIncentiveRewards) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgCollectIncentiveRewards) GetPoolIds() []uint64 {
+	if x != nil {
+		return x.PoolIds
+	}
+	return nil
+}
+
+type MsgCollectIncentiveRewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collected []*v1beta1.Coin `protobuf:"bytes,1,rep,name=collected,proto3" json:"collected,omitempty"`
+}
+
+func (x *MsgCollectIncentiveRewardsResponse) Reset() {
+	*x = MsgCollectIncentiveRewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquidityincentive_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCollectIncentiveRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCollectIncentiveRewardsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCollectIncentiveRewardsResponse.ProtoReflect.Descriptor instead.
+func (*MsgCollectIncentiveRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquidityincentive_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgCollectIncentiveRewardsResponse) GetCollected() []*v1beta1.Coin {
+	if x != nil {
+		return x.Collected
+	}
+	return nil
+}
+
 var File_sunrise_liquidityincentive_tx_proto protoreflect.FileDescriptor
 
 var file_sunrise_liquidityincentive_tx_proto_rawDesc = []byte{
@@ -2791,7 +4111,9 @@ var file_sunrise_liquidityincentive_tx_proto_rawDesc = []byte{
 	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27,
 	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
 	0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
@@ -2825,30 +4147,61 @@ var file_sunrise_liquidityincentive_tx_proto_rawDesc = []byte{
 	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
 	0x6e, 0x64, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x56,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xec, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x70, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e,
-	0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x33, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e,
-	0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x09,
-	0x56, 0x6f, 0x74, 0x65, 0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63,
-	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x47, 0x61,
-	0x75, 0x67, 0x65, 0x1a, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
+	0x72, 0x22, 0x8a, 0x01, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x5c,
+	0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x73, 0x3a,
+	0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a,
+	0x22, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x32, 0x80,
+	0x04, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x70, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x33, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
 	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65,
-	0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x31, 0x2e, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a,
-	0x39, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65,
+	0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x47, 0x61, 0x75, 0x67, 0x65, 0x1a,
+	0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
 	0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x56, 0x6f, 0x74, 0x65, 0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x39, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x36, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3e, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
 	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a,
 	0x01, 0x42, 0xdf, 0x01, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x69, 0x6e, 0x63, 0x65, 0x6e,
@@ -2879,31 +4232,38 @@ func file_sunrise_liquidityincentive_tx_proto_rawDescGZIP() []byte {
 	return file_sunrise_liquidityincentive_tx_proto_rawDescData
 }
 
-var file_sunrise_liquidityincentive_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sunrise_liquidityincentive_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_sunrise_liquidityincentive_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),               // 0: sunrise.liquidityincentive.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),       // 1: sunrise.liquidityincentive.MsgUpdateParamsResponse
-	(*MsgVoteGauge)(nil),                  // 2: sunrise.liquidityincentive.MsgVoteGauge
-	(*MsgVoteGaugeResponse)(nil),          // 3: sunrise.liquidityincentive.MsgVoteGaugeResponse
-	(*MsgCollectVoteRewards)(nil),         // 4: sunrise.liquidityincentive.MsgCollectVoteRewards
-	(*MsgCollectVoteRewardsResponse)(nil), // 5: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse
-	(*Params)(nil),                        // 6: sunrise.liquidityincentive.Params
-	(*PoolWeight)(nil),                    // 7: sunrise.liquidityincentive.PoolWeight
+	(*MsgUpdateParams)(nil),                    // 0: sunrise.liquidityincentive.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 1: sunrise.liquidityincentive.MsgUpdateParamsResponse
+	(*MsgVoteGauge)(nil),                       // 2: sunrise.liquidityincentive.MsgVoteGauge
+	(*MsgVoteGaugeResponse)(nil),               // 3: sunrise.liquidityincentive.MsgVoteGaugeResponse
+	(*MsgCollectVoteRewards)(nil),              // 4: sunrise.liquidityincentive.MsgCollectVoteRewards
+	(*MsgCollectVoteRewardsResponse)(nil),      // 5: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse
+	(*MsgCollectIncentiveRewards)(nil),         // 6: sunrise.liquidityincentive.MsgCollectIncentiveRewards
+	(*MsgCollectIncentiveRewardsResponse)(nil), // 7: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse
+	(*Params)(nil),                             // 8: sunrise.liquidityincentive.Params
+	(*PoolWeight)(nil),                         // 9: sunrise.liquidityincentive.PoolWeight
+	(*v1beta1.Coin)(nil),                       // 10: cosmos.base.v1beta1.Coin
 }
 var file_sunrise_liquidityincentive_tx_proto_depIdxs = []int32{
-	6, // 0: sunrise.liquidityincentive.MsgUpdateParams.params:type_name -> sunrise.liquidityincentive.Params
-	7, // 1: sunrise.liquidityincentive.MsgVoteGauge.weights:type_name -> sunrise.liquidityincentive.PoolWeight
-	0, // 2: sunrise.liquidityincentive.Msg.UpdateParams:input_type -> sunrise.liquidityincentive.MsgUpdateParams
-	2, // 3: sunrise.liquidityincentive.Msg.VoteGauge:input_type -> sunrise.liquidityincentive.MsgVoteGauge
-	4, // 4: sunrise.liquidityincentive.Msg.CollectVoteRewards:input_type -> sunrise.liquidityincentive.MsgCollectVoteRewards
-	1, // 5: sunrise.liquidityincentive.Msg.UpdateParams:output_type -> sunrise.liquidityincentive.MsgUpdateParamsResponse
-	3, // 6: sunrise.liquidityincentive.Msg.VoteGauge:output_type -> sunrise.liquidityincentive.MsgVoteGaugeResponse
-	5, // 7: sunrise.liquidityincentive.Msg.CollectVoteRewards:output_type -> sunrise.liquidityincentive.MsgCollectVoteRewardsResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: sunrise.liquidityincentive.MsgUpdateParams.params:type_name -> sunrise.liquidityincentive.Params
+	9,  // 1: sunrise.liquidityincentive.MsgVoteGauge.weights:type_name -> sunrise.liquidityincentive.PoolWeight
+	10, // 2: sunrise.liquidityincentive.MsgCollectVoteRewardsResponse.collected:type_name -> cosmos.base.v1beta1.Coin
+	10, // 3: sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse.collected:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 4: sunrise.liquidityincentive.Msg.UpdateParams:input_type -> sunrise.liquidityincentive.MsgUpdateParams
+	2,  // 5: sunrise.liquidityincentive.Msg.VoteGauge:input_type -> sunrise.liquidityincentive.MsgVoteGauge
+	4,  // 6: sunrise.liquidityincentive.Msg.CollectVoteRewards:input_type -> sunrise.liquidityincentive.MsgCollectVoteRewards
+	6,  // 7: sunrise.liquidityincentive.Msg.CollectIncentiveRewards:input_type -> sunrise.liquidityincentive.MsgCollectIncentiveRewards
+	1,  // 8: sunrise.liquidityincentive.Msg.UpdateParams:output_type -> sunrise.liquidityincentive.MsgUpdateParamsResponse
+	3,  // 9: sunrise.liquidityincentive.Msg.VoteGauge:output_type -> sunrise.liquidityincentive.MsgVoteGaugeResponse
+	5,  // 10: sunrise.liquidityincentive.Msg.CollectVoteRewards:output_type -> sunrise.liquidityincentive.MsgCollectVoteRewardsResponse
+	7,  // 11: sunrise.liquidityincentive.Msg.CollectIncentiveRewards:output_type -> sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_liquidityincentive_tx_proto_init() }
@@ -2986,6 +4346,30 @@ func file_sunrise_liquidityincentive_tx_proto_init() {
 				return nil
 			}
 		}
+		file_sunrise_liquidityincentive_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCollectIncentiveRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquidityincentive_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCollectIncentiveRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2993,7 +4377,7 @@ func file_sunrise_liquidityincentive_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sunrise_liquidityincentive_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName       = "/sunrise.liquidityincentive.Msg/UpdateParams"
-	Msg_VoteGauge_FullMethodName          = "/sunrise.liquidityincentive.Msg/VoteGauge"
-	Msg_CollectVoteRewards_FullMethodName = "/sunrise.liquidityincentive.Msg/CollectVoteRewards"
+	Msg_UpdateParams_FullMethodName            = "/sunrise.liquidityincentive.Msg/UpdateParams"
+	Msg_VoteGauge_FullMethodName               = "/sunrise.liquidityincentive.Msg/VoteGauge"
+	Msg_CollectVoteRewards_FullMethodName      = "/sunrise.liquidityincentive.Msg/CollectVoteRewards"
+	Msg_CollectIncentiveRewards_FullMethodName = "/sunrise.liquidityincentive.Msg/CollectIncentiveRewards"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +34,7 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	VoteGauge(ctx context.Context, in *MsgVoteGauge, opts ...grpc.CallOption) (*MsgVoteGaugeResponse, error)
 	CollectVoteRewards(ctx context.Context, in *MsgCollectVoteRewards, opts ...grpc.CallOption) (*MsgCollectVoteRewardsResponse, error)
+	CollectIncentiveRewards(ctx context.Context, in *MsgCollectIncentiveRewards, opts ...grpc.CallOption) (*MsgCollectIncentiveRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +72,15 @@ func (c *msgClient) CollectVoteRewards(ctx context.Context, in *MsgCollectVoteRe
 	return out, nil
 }
 
+func (c *msgClient) CollectIncentiveRewards(ctx context.Context, in *MsgCollectIncentiveRewards, opts ...grpc.CallOption) (*MsgCollectIncentiveRewardsResponse, error) {
+	out := new(MsgCollectIncentiveRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_CollectIncentiveRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +90,7 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	VoteGauge(context.Context, *MsgVoteGauge) (*MsgVoteGaugeResponse, error)
 	CollectVoteRewards(context.Context, *MsgCollectVoteRewards) (*MsgCollectVoteRewardsResponse, error)
+	CollectIncentiveRewards(context.Context, *MsgCollectIncentiveRewards) (*MsgCollectIncentiveRewardsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +107,9 @@ func (UnimplementedMsgServer) VoteGauge(context.Context, *MsgVoteGauge) (*MsgVot
 func (UnimplementedMsgServer) CollectVoteRewards(context.Context, *MsgCollectVoteRewards) (*MsgCollectVoteRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectVoteRewards not implemented")
 }
+func (UnimplementedMsgServer) CollectIncentiveRewards(context.Context, *MsgCollectIncentiveRewards) (*MsgCollectIncentiveRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectIncentiveRewards not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +177,24 @@ func _Msg_CollectVoteRewards_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CollectIncentiveRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectIncentiveRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectIncentiveRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CollectIncentiveRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectIncentiveRewards(ctx, req.(*MsgCollectIncentiveRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +214,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectVoteRewards",
 			Handler:    _Msg_CollectVoteRewards_Handler,
 		},
+		{
+			MethodName: "CollectIncentiveRewards",
+			Handler:    _Msg_CollectIncentiveRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquidityincentive/tx.proto",
@@ -6,12 +6,14 @@ import (
 	v1beta11 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -3249,25 +3251,27 @@ func (x *fastReflection_QueryPoolsResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryPositionRequest    protoreflect.MessageDescriptor
-	fd_QueryPositionRequest_id protoreflect.FieldDescriptor
+	md_QueryPoolsByDenomsRequest             protoreflect.MessageDescriptor
+	fd_QueryPoolsByDenomsRequest_denom_base  protoreflect.FieldDescriptor
+	fd_QueryPoolsByDenomsRequest_denom_quote protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionRequest")
-	fd_QueryPositionRequest_id = md_QueryPositionRequest.Fields().ByName("id")
+	md_QueryPoolsByDenomsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolsByDenomsRequest")
+	fd_QueryPoolsByDenomsRequest_denom_base = md_QueryPoolsByDenomsRequest.Fields().ByName("denom_base")
+	fd_QueryPoolsByDenomsRequest_denom_quote = md_QueryPoolsByDenomsRequest.Fields().ByName("denom_quote")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPositionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByDenomsRequest)(nil)
 
-type fastReflection_QueryPositionRequest QueryPositionRequest
+type fastReflection_QueryPoolsByDenomsRequest QueryPoolsByDenomsRequest
 
-func (x *QueryPositionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionRequest)(x)
+func (x *QueryPoolsByDenomsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomsRequest)(x)
 }
 
-func (x *QueryPositionRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolsByDenomsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3279,43 +3283,43 @@ func (x *QueryPositionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPositionRequest_messageType fastReflection_QueryPositionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionRequest_messageType{}
+var _fastReflection_QueryPoolsByDenomsRequest_messageType fastReflection_QueryPoolsByDenomsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByDenomsRequest_messageType{}
 
-type fastReflection_QueryPositionRequest_messageType struct{}
+type fastReflection_QueryPoolsByDenomsRequest_messageType struct{}
 
-func (x fastReflection_QueryPositionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionRequest)(nil)
+func (x fastReflection_QueryPoolsByDenomsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomsRequest)(nil)
 }
-func (x fastReflection_QueryPositionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionRequest)
+func (x fastReflection_QueryPoolsByDenomsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomsRequest)
 }
-func (x fastReflection_QueryPositionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionRequest
+func (x fastReflection_QueryPoolsByDenomsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionRequest
+func (x *fastReflection_QueryPoolsByDenomsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionRequest_messageType
+func (x *fastReflection_QueryPoolsByDenomsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByDenomsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionRequest)
+func (x *fastReflection_QueryPoolsByDenomsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionRequest)(x)
+func (x *fastReflection_QueryPoolsByDenomsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByDenomsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3323,10 +3327,16 @@ func (x *fastReflection_QueryPositionRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_QueryPositionRequest_id, value) {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DenomBase != "" {
+		value := protoreflect.ValueOfString(x.DenomBase)
+		if !f(fd_QueryPoolsByDenomsRequest_denom_base, value) {
+			return
+		}
+	}
+	if x.DenomQuote != "" {
+		value := protoreflect.ValueOfString(x.DenomQuote)
+		if !f(fd_QueryPoolsByDenomsRequest_denom_quote, value) {
 			return
 		}
 	}
@@ -3343,15 +3353,17 @@ func (x *fastReflection_QueryPositionRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		return x.Id != uint64(0)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		return x.DenomBase != ""
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		return x.DenomQuote != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3361,15 +3373,17 @@ func (x *fastReflection_QueryPositionRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		x.Id = uint64(0)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		x.DenomBase = ""
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		x.DenomQuote = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3379,16 +3393,19 @@ func (x *fastReflection_QueryPositionRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		value := x.DenomBase
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		value := x.DenomQuote
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3402,15 +3419,17 @@ func (x *fastReflection_QueryPositionRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		x.Id = value.Uint()
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		x.DenomBase = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		x.DenomQuote = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3424,40 +3443,44 @@ func (x *fastReflection_QueryPositionRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryPositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		panic(fmt.Errorf("field denom_base of message sunrise.liquiditypool.QueryPoolsByDenomsRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		panic(fmt.Errorf("field denom_quote of message sunrise.liquiditypool.QueryPoolsByDenomsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_base":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryPoolsByDenomsRequest.denom_quote":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolsByDenomsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolsByDenomsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3465,7 +3488,7 @@ func (x *fastReflection_QueryPositionRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolsByDenomsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3476,7 +3499,7 @@ func (x *fastReflection_QueryPositionRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolsByDenomsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3488,7 +3511,7 @@ func (x *fastReflection_QueryPositionRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionRequest) IsValid() bool {
+func (x *fastReflection_QueryPoolsByDenomsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3498,9 +3521,9 @@ func (x *fastReflection_QueryPositionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolsByDenomsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionRequest)
+		x := input.Message.Interface().(*QueryPoolsByDenomsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3512,8 +3535,13 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		l = len(x.DenomBase)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DenomQuote)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3525,7 +3553,7 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionRequest)
+		x := input.Message.Interface().(*QueryPoolsByDenomsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3544,10 +3572,19 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.DenomQuote) > 0 {
+			i -= len(x.DenomQuote)
+			copy(dAtA[i:], x.DenomQuote)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomQuote)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if len(x.DenomBase) > 0 {
+			i -= len(x.DenomBase)
+			copy(dAtA[i:], x.DenomBase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomBase)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3560,7 +3597,7 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionRequest)
+		x := input.Message.Interface().(*QueryPoolsByDenomsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3592,17 +3629,17 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomBase", wireType)
 				}
-				x.Id = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3612,11 +3649,56 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomBase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomQuote", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomQuote = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3652,30 +3734,77 @@ func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryPoolsByDenomsResponse_1_list)(nil)
+
+type _QueryPoolsByDenomsResponse_1_list struct {
+	list *[]*PoolInfo
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PoolInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PoolInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_PositionInfo             protoreflect.MessageDescriptor
-	fd_PositionInfo_position    protoreflect.FieldDescriptor
-	fd_PositionInfo_token_base  protoreflect.FieldDescriptor
-	fd_PositionInfo_token_quote protoreflect.FieldDescriptor
+	md_QueryPoolsByDenomsResponse       protoreflect.MessageDescriptor
+	fd_QueryPoolsByDenomsResponse_pools protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_PositionInfo = File_sunrise_liquiditypool_query_proto.Messages().ByName("PositionInfo")
-	fd_PositionInfo_position = md_PositionInfo.Fields().ByName("position")
-	fd_PositionInfo_token_base = md_PositionInfo.Fields().ByName("token_base")
-	fd_PositionInfo_token_quote = md_PositionInfo.Fields().ByName("token_quote")
+	md_QueryPoolsByDenomsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolsByDenomsResponse")
+	fd_QueryPoolsByDenomsResponse_pools = md_QueryPoolsByDenomsResponse.Fields().ByName("pools")
 }
 
-var _ protoreflect.Message = (*fastReflection_PositionInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByDenomsResponse)(nil)
 
-type fastReflection_PositionInfo PositionInfo
+type fastReflection_QueryPoolsByDenomsResponse QueryPoolsByDenomsResponse
 
-func (x *PositionInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PositionInfo)(x)
+func (x *QueryPoolsByDenomsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomsResponse)(x)
 }
 
-func (x *PositionInfo) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolsByDenomsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3687,43 +3816,43 @@ func (x *PositionInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PositionInfo_messageType fastReflection_PositionInfo_messageType
-var _ protoreflect.MessageType = fastReflection_PositionInfo_messageType{}
+var _fastReflection_QueryPoolsByDenomsResponse_messageType fastReflection_QueryPoolsByDenomsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByDenomsResponse_messageType{}
 
-type fastReflection_PositionInfo_messageType struct{}
+type fastReflection_QueryPoolsByDenomsResponse_messageType struct{}
 
-func (x fastReflection_PositionInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PositionInfo)(nil)
+func (x fastReflection_QueryPoolsByDenomsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomsResponse)(nil)
 }
-func (x fastReflection_PositionInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_PositionInfo)
+func (x fastReflection_QueryPoolsByDenomsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomsResponse)
 }
-func (x fastReflection_PositionInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PositionInfo
+func (x fastReflection_QueryPoolsByDenomsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PositionInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_PositionInfo
+func (x *fastReflection_QueryPoolsByDenomsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PositionInfo) Type() protoreflect.MessageType {
-	return _fastReflection_PositionInfo_messageType
+func (x *fastReflection_QueryPoolsByDenomsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByDenomsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PositionInfo) New() protoreflect.Message {
-	return new(fastReflection_PositionInfo)
+func (x *fastReflection_QueryPoolsByDenomsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PositionInfo) Interface() protoreflect.ProtoMessage {
-	return (*PositionInfo)(x)
+func (x *fastReflection_QueryPoolsByDenomsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByDenomsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3731,22 +3860,10 @@ func (x *fastReflection_PositionInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PositionInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Position != nil {
-		value := protoreflect.ValueOfMessage(x.Position.ProtoReflect())
-		if !f(fd_PositionInfo_position, value) {
-			return
-		}
-	}
-	if x.TokenBase != nil {
-		value := protoreflect.ValueOfMessage(x.TokenBase.ProtoReflect())
-		if !f(fd_PositionInfo_token_base, value) {
-			return
-		}
-	}
-	if x.TokenQuote != nil {
-		value := protoreflect.ValueOfMessage(x.TokenQuote.ProtoReflect())
-		if !f(fd_PositionInfo_token_quote, value) {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pools) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolsByDenomsResponse_1_list{list: &x.Pools})
+		if !f(fd_QueryPoolsByDenomsResponse_pools, value) {
 			return
 		}
 	}
@@ -3763,19 +3880,15 @@ func (x *fastReflection_PositionInfo) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PositionInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		return x.Position != nil
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		return x.TokenBase != nil
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		return x.TokenQuote != nil
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		return len(x.Pools) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3785,19 +3898,15 @@ func (x *fastReflection_PositionInfo) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PositionInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		x.Position = nil
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		x.TokenBase = nil
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		x.TokenQuote = nil
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		x.Pools = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3807,22 +3916,19 @@ func (x *fastReflection_PositionInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PositionInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		value := x.Position
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		value := x.TokenBase
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		value := x.TokenQuote
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		if len(x.Pools) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolsByDenomsResponse_1_list{})
+		}
+		listValue := &_QueryPoolsByDenomsResponse_1_list{list: &x.Pools}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3836,19 +3942,17 @@ func (x *fastReflection_PositionInfo) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PositionInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		x.Position = value.Message().Interface().(*Position)
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		x.TokenBase = value.Message().Interface().(*v1beta1.Coin)
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		x.TokenQuote = value.Message().Interface().(*v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		lv := value.List()
+		clv := lv.(*_QueryPoolsByDenomsResponse_1_list)
+		x.Pools = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3862,60 +3966,45 @@ func (x *fastReflection_PositionInfo) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PositionInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		if x.Position == nil {
-			x.Position = new(Position)
-		}
-		return protoreflect.ValueOfMessage(x.Position.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		if x.TokenBase == nil {
-			x.TokenBase = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.TokenBase.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		if x.TokenQuote == nil {
-			x.TokenQuote = new(v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		if x.Pools == nil {
+			x.Pools = []*PoolInfo{}
 		}
-		return protoreflect.ValueOfMessage(x.TokenQuote.ProtoReflect())
+		value := &_QueryPoolsByDenomsResponse_1_list{list: &x.Pools}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PositionInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolsByDenomsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.PositionInfo.position":
-		m := new(Position)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_base":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.liquiditypool.PositionInfo.token_quote":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools":
+		list := []*PoolInfo{}
+		return protoreflect.ValueOfList(&_QueryPoolsByDenomsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolsByDenomsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolsByDenomsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PositionInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolsByDenomsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.PositionInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolsByDenomsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3923,7 +4012,7 @@ func (x *fastReflection_PositionInfo) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PositionInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolsByDenomsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3934,7 +4023,7 @@ func (x *fastReflection_PositionInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PositionInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolsByDenomsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3946,7 +4035,7 @@ func (x *fastReflection_PositionInfo) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PositionInfo) IsValid() bool {
+func (x *fastReflection_QueryPoolsByDenomsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3956,9 +4045,9 @@ func (x *fastReflection_PositionInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolsByDenomsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PositionInfo)
+		x := input.Message.Interface().(*QueryPoolsByDenomsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3970,17 +4059,11 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Position != nil {
-			l = options.Size(x.Position)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TokenBase != nil {
-			l = options.Size(x.TokenBase)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TokenQuote != nil {
-			l = options.Size(x.TokenQuote)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Pools) > 0 {
+			for _, e := range x.Pools {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3992,7 +4075,7 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PositionInfo)
+		x := input.Message.Interface().(*QueryPoolsByDenomsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4011,47 +4094,21 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TokenQuote != nil {
-			encoded, err := options.Marshal(x.TokenQuote)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.TokenBase != nil {
-			encoded, err := options.Marshal(x.TokenBase)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Position != nil {
-			encoded, err := options.Marshal(x.Position)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Pools) > 0 {
+			for iNdEx := len(x.Pools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4064,7 +4121,7 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PositionInfo)
+		x := input.Message.Interface().(*QueryPoolsByDenomsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4096,87 +4153,15 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Position == nil {
-					x.Position = &Position{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Position); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenBase", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TokenBase == nil {
-					x.TokenBase = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenBase); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenQuote", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4203,10 +4188,8 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TokenQuote == nil {
-					x.TokenQuote = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenQuote); err != nil {
+				x.Pools = append(x.Pools, &PoolInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pools[len(x.Pools)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4246,25 +4229,25 @@ func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryPositionResponse          protoreflect.MessageDescriptor
-	fd_QueryPositionResponse_position protoreflect.FieldDescriptor
+	md_QueryPositionRequest    protoreflect.MessageDescriptor
+	fd_QueryPositionRequest_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionResponse")
-	fd_QueryPositionResponse_position = md_QueryPositionResponse.Fields().ByName("position")
+	md_QueryPositionRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionRequest")
+	fd_QueryPositionRequest_id = md_QueryPositionRequest.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPositionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPositionRequest)(nil)
 
-type fastReflection_QueryPositionResponse QueryPositionResponse
+type fastReflection_QueryPositionRequest QueryPositionRequest
 
-func (x *QueryPositionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionResponse)(x)
+func (x *QueryPositionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionRequest)(x)
 }
 
-func (x *QueryPositionResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPositionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4276,43 +4259,43 @@ func (x *QueryPositionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPositionResponse_messageType fastReflection_QueryPositionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionResponse_messageType{}
+var _fastReflection_QueryPositionRequest_messageType fastReflection_QueryPositionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionRequest_messageType{}
 
-type fastReflection_QueryPositionResponse_messageType struct{}
+type fastReflection_QueryPositionRequest_messageType struct{}
 
-func (x fastReflection_QueryPositionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionResponse)(nil)
+func (x fastReflection_QueryPositionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionRequest)(nil)
 }
-func (x fastReflection_QueryPositionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionResponse)
+func (x fastReflection_QueryPositionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionRequest)
 }
-func (x fastReflection_QueryPositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionResponse
+func (x fastReflection_QueryPositionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionResponse
+func (x *fastReflection_QueryPositionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionResponse_messageType
+func (x *fastReflection_QueryPositionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionResponse)
+func (x *fastReflection_QueryPositionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionResponse)(x)
+func (x *fastReflection_QueryPositionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4320,10 +4303,10 @@ func (x *fastReflection_QueryPositionResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Position != nil {
-		value := protoreflect.ValueOfMessage(x.Position.ProtoReflect())
-		if !f(fd_QueryPositionResponse_position, value) {
+func (x *fastReflection_QueryPositionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryPositionRequest_id, value) {
 			return
 		}
 	}
@@ -4340,15 +4323,15 @@ func (x *fastReflection_QueryPositionResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		return x.Position != nil
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		return x.Id != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4358,15 +4341,15 @@ func (x *fastReflection_QueryPositionResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		x.Position = nil
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		x.Id = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4376,16 +4359,16 @@ func (x *fastReflection_QueryPositionResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		value := x.Position
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4399,15 +4382,15 @@ func (x *fastReflection_QueryPositionResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		x.Position = value.Message().Interface().(*PositionInfo)
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		x.Id = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4421,44 +4404,40 @@ func (x *fastReflection_QueryPositionResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		if x.Position == nil {
-			x.Position = new(PositionInfo)
-		}
-		return protoreflect.ValueOfMessage(x.Position.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryPositionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionResponse.position":
-		m := new(PositionInfo)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPositionRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4466,7 +4445,7 @@ func (x *fastReflection_QueryPositionResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4477,7 +4456,7 @@ func (x *fastReflection_QueryPositionResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4489,7 +4468,7 @@ func (x *fastReflection_QueryPositionResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionResponse) IsValid() bool {
+func (x *fastReflection_QueryPositionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4499,9 +4478,9 @@ func (x *fastReflection_QueryPositionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionResponse)
+		x := input.Message.Interface().(*QueryPositionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4513,9 +4492,8 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Position != nil {
-			l = options.Size(x.Position)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4527,7 +4505,7 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionResponse)
+		x := input.Message.Interface().(*QueryPositionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4546,19 +4524,10 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Position != nil {
-			encoded, err := options.Marshal(x.Position)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4571,7 +4540,7 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionResponse)
+		x := input.Message.Interface().(*QueryPositionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4603,17 +4572,17 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var msglen int
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4623,28 +4592,11 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Position == nil {
-					x.Position = &PositionInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Position); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4681,25 +4633,29 @@ func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryPositionsRequest            protoreflect.MessageDescriptor
-	fd_QueryPositionsRequest_pagination protoreflect.FieldDescriptor
+	md_PositionInfo             protoreflect.MessageDescriptor
+	fd_PositionInfo_position    protoreflect.FieldDescriptor
+	fd_PositionInfo_token_base  protoreflect.FieldDescriptor
+	fd_PositionInfo_token_quote protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionsRequest")
-	fd_QueryPositionsRequest_pagination = md_QueryPositionsRequest.Fields().ByName("pagination")
+	md_PositionInfo = File_sunrise_liquiditypool_query_proto.Messages().ByName("PositionInfo")
+	fd_PositionInfo_position = md_PositionInfo.Fields().ByName("position")
+	fd_PositionInfo_token_base = md_PositionInfo.Fields().ByName("token_base")
+	fd_PositionInfo_token_quote = md_PositionInfo.Fields().ByName("token_quote")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPositionsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_PositionInfo)(nil)
 
-type fastReflection_QueryPositionsRequest QueryPositionsRequest
+type fastReflection_PositionInfo PositionInfo
 
-func (x *QueryPositionsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionsRequest)(x)
+func (x *PositionInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PositionInfo)(x)
 }
 
-func (x *QueryPositionsRequest) slowProtoReflect() protoreflect.Message {
+func (x *PositionInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4711,43 +4667,43 @@ func (x *QueryPositionsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPositionsRequest_messageType fastReflection_QueryPositionsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionsRequest_messageType{}
+var _fastReflection_PositionInfo_messageType fastReflection_PositionInfo_messageType
+var _ protoreflect.MessageType = fastReflection_PositionInfo_messageType{}
 
-type fastReflection_QueryPositionsRequest_messageType struct{}
+type fastReflection_PositionInfo_messageType struct{}
 
-func (x fastReflection_QueryPositionsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionsRequest)(nil)
+func (x fastReflection_PositionInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PositionInfo)(nil)
 }
-func (x fastReflection_QueryPositionsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionsRequest)
+func (x fastReflection_PositionInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_PositionInfo)
 }
-func (x fastReflection_QueryPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionsRequest
+func (x fastReflection_PositionInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PositionInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionsRequest
+func (x *fastReflection_PositionInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_PositionInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionsRequest_messageType
+func (x *fastReflection_PositionInfo) Type() protoreflect.MessageType {
+	return _fastReflection_PositionInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionsRequest)
+func (x *fastReflection_PositionInfo) New() protoreflect.Message {
+	return new(fastReflection_PositionInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionsRequest)(x)
+func (x *fastReflection_PositionInfo) Interface() protoreflect.ProtoMessage {
+	return (*PositionInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4755,10 +4711,22 @@ func (x *fastReflection_QueryPositionsRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryPositionsRequest_pagination, value) {
+func (x *fastReflection_PositionInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Position != nil {
+		value := protoreflect.ValueOfMessage(x.Position.ProtoReflect())
+		if !f(fd_PositionInfo_position, value) {
+			return
+		}
+	}
+	if x.TokenBase != nil {
+		value := protoreflect.ValueOfMessage(x.TokenBase.ProtoReflect())
+		if !f(fd_PositionInfo_token_base, value) {
+			return
+		}
+	}
+	if x.TokenQuote != nil {
+		value := protoreflect.ValueOfMessage(x.TokenQuote.ProtoReflect())
+		if !f(fd_PositionInfo_token_quote, value) {
 			return
 		}
 	}
@@ -4775,15 +4743,19 @@ func (x *fastReflection_QueryPositionsRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PositionInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		return x.Pagination != nil
+	case "sunrise.liquiditypool.PositionInfo.position":
+		return x.Position != nil
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		return x.TokenBase != nil
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		return x.TokenQuote != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4793,15 +4765,19 @@ func (x *fastReflection_QueryPositionsRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PositionInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		x.Pagination = nil
+	case "sunrise.liquiditypool.PositionInfo.position":
+		x.Position = nil
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		x.TokenBase = nil
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		x.TokenQuote = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4811,16 +4787,22 @@ func (x *fastReflection_QueryPositionsRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PositionInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		value := x.Pagination
+	case "sunrise.liquiditypool.PositionInfo.position":
+		value := x.Position
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		value := x.TokenBase
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		value := x.TokenQuote
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4834,15 +4816,19 @@ func (x *fastReflection_QueryPositionsRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PositionInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "sunrise.liquiditypool.PositionInfo.position":
+		x.Position = value.Message().Interface().(*Position)
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		x.TokenBase = value.Message().Interface().(*v1beta1.Coin)
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		x.TokenQuote = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4856,44 +4842,60 @@ func (x *fastReflection_QueryPositionsRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PositionInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageRequest)
+	case "sunrise.liquiditypool.PositionInfo.position":
+		if x.Position == nil {
+			x.Position = new(Position)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Position.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		if x.TokenBase == nil {
+			x.TokenBase = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TokenBase.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		if x.TokenQuote == nil {
+			x.TokenQuote = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TokenQuote.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PositionInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
-		m := new(v1beta11.PageRequest)
+	case "sunrise.liquiditypool.PositionInfo.position":
+		m := new(Position)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_base":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.PositionInfo.token_quote":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.PositionInfo"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.PositionInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PositionInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.PositionInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4901,7 +4903,7 @@ func (x *fastReflection_QueryPositionsRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PositionInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4912,7 +4914,7 @@ func (x *fastReflection_QueryPositionsRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PositionInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4924,7 +4926,7 @@ func (x *fastReflection_QueryPositionsRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionsRequest) IsValid() bool {
+func (x *fastReflection_PositionInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -4934,9 +4936,9 @@ func (x *fastReflection_QueryPositionsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PositionInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionsRequest)
+		x := input.Message.Interface().(*PositionInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4948,8 +4950,16 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Position != nil {
+			l = options.Size(x.Position)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TokenBase != nil {
+			l = options.Size(x.TokenBase)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TokenQuote != nil {
+			l = options.Size(x.TokenQuote)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4962,7 +4972,7 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionsRequest)
+		x := input.Message.Interface().(*PositionInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4981,8 +4991,36 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.TokenQuote != nil {
+			encoded, err := options.Marshal(x.TokenQuote)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TokenBase != nil {
+			encoded, err := options.Marshal(x.TokenBase)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Position != nil {
+			encoded, err := options.Marshal(x.Position)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5006,7 +5044,7 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionsRequest)
+		x := input.Message.Interface().(*PositionInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5038,15 +5076,15 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5073,10 +5111,82 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageRequest{}
+				if x.Position == nil {
+					x.Position = &Position{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Position); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenBase", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TokenBase == nil {
+					x.TokenBase = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenBase); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenQuote", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TokenQuote == nil {
+					x.TokenQuote = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenQuote); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5115,127 +5225,74 @@ func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_QueryPositionsResponse_1_list)(nil)
+var (
+	md_QueryPositionResponse          protoreflect.MessageDescriptor
+	fd_QueryPositionResponse_position protoreflect.FieldDescriptor
+)
 
-type _QueryPositionsResponse_1_list struct {
-	list *[]*PositionInfo
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryPositionResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionResponse")
+	fd_QueryPositionResponse_position = md_QueryPositionResponse.Fields().ByName("position")
 }
 
-func (x *_QueryPositionsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryPositionResponse)(nil)
 
-func (x *_QueryPositionsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+type fastReflection_QueryPositionResponse QueryPositionResponse
+
+func (x *QueryPositionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionResponse)(x)
 }
 
-func (x *_QueryPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
-	(*x.list)[i] = concreteValue
+func (x *QueryPositionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryPositionsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryPositionsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(PositionInfo)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryPositionsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryPositionsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(PositionInfo)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryPositionsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryPositionsResponse            protoreflect.MessageDescriptor
-	fd_QueryPositionsResponse_positions  protoreflect.FieldDescriptor
-	fd_QueryPositionsResponse_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionsResponse")
-	fd_QueryPositionsResponse_positions = md_QueryPositionsResponse.Fields().ByName("positions")
-	fd_QueryPositionsResponse_pagination = md_QueryPositionsResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryPositionsResponse)(nil)
-
-type fastReflection_QueryPositionsResponse QueryPositionsResponse
-
-func (x *QueryPositionsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionsResponse)(x)
-}
-
-func (x *QueryPositionsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryPositionsResponse_messageType fastReflection_QueryPositionsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionsResponse_messageType{}
+var _fastReflection_QueryPositionResponse_messageType fastReflection_QueryPositionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionResponse_messageType{}
 
-type fastReflection_QueryPositionsResponse_messageType struct{}
+type fastReflection_QueryPositionResponse_messageType struct{}
 
-func (x fastReflection_QueryPositionsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionsResponse)(nil)
+func (x fastReflection_QueryPositionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionResponse)(nil)
 }
-func (x fastReflection_QueryPositionsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionsResponse)
+func (x fastReflection_QueryPositionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionResponse)
 }
-func (x fastReflection_QueryPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionsResponse
+func (x fastReflection_QueryPositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionsResponse
+func (x *fastReflection_QueryPositionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionsResponse_messageType
+func (x *fastReflection_QueryPositionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionsResponse)
+func (x *fastReflection_QueryPositionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionsResponse)(x)
+func (x *fastReflection_QueryPositionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5243,16 +5300,10 @@ func (x *fastReflection_QueryPositionsResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Positions) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{list: &x.Positions})
-		if !f(fd_QueryPositionsResponse_positions, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryPositionsResponse_pagination, value) {
+func (x *fastReflection_QueryPositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Position != nil {
+		value := protoreflect.ValueOfMessage(x.Position.ProtoReflect())
+		if !f(fd_QueryPositionResponse_position, value) {
 			return
 		}
 	}
@@ -5269,17 +5320,15 @@ func (x *fastReflection_QueryPositionsResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		return len(x.Positions) != 0
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		return x.Pagination != nil
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		return x.Position != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5289,17 +5338,15 @@ func (x *fastReflection_QueryPositionsResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		x.Positions = nil
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		x.Pagination = nil
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		x.Position = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5309,22 +5356,16 @@ func (x *fastReflection_QueryPositionsResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		if len(x.Positions) == 0 {
-			return protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{})
-		}
-		listValue := &_QueryPositionsResponse_1_list{list: &x.Positions}
-		return protoreflect.ValueOfList(listValue)
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		value := x.Pagination
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		value := x.Position
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5338,19 +5379,15 @@ func (x *fastReflection_QueryPositionsResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		lv := value.List()
-		clv := lv.(*_QueryPositionsResponse_1_list)
-		x.Positions = *clv.list
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		x.Position = value.Message().Interface().(*PositionInfo)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5364,53 +5401,44 @@ func (x *fastReflection_QueryPositionsResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		if x.Positions == nil {
-			x.Positions = []*PositionInfo{}
-		}
-		value := &_QueryPositionsResponse_1_list{list: &x.Positions}
-		return protoreflect.ValueOfList(value)
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageResponse)
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		if x.Position == nil {
+			x.Position = new(PositionInfo)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Position.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
-		list := []*PositionInfo{}
-		return protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{list: &list})
-	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
-		m := new(v1beta11.PageResponse)
+	case "sunrise.liquiditypool.QueryPositionResponse.position":
+		m := new(PositionInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5418,7 +5446,7 @@ func (x *fastReflection_QueryPositionsResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5429,7 +5457,7 @@ func (x *fastReflection_QueryPositionsResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5441,7 +5469,7 @@ func (x *fastReflection_QueryPositionsResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionsResponse) IsValid() bool {
+func (x *fastReflection_QueryPositionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5451,9 +5479,9 @@ func (x *fastReflection_QueryPositionsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5465,14 +5493,8 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Positions) > 0 {
-			for _, e := range x.Positions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Position != nil {
+			l = options.Size(x.Position)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5485,7 +5507,7 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5504,8 +5526,8 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Position != nil {
+			encoded, err := options.Marshal(x.Position)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5516,23 +5538,7 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Positions) > 0 {
-			for iNdEx := len(x.Positions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Positions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5545,7 +5551,7 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5577,15 +5583,15 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5612,44 +5618,10 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Positions = append(x.Positions, &PositionInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Positions[len(x.Positions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if x.Position == nil {
+					x.Position = &PositionInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Position); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5689,25 +5661,25 @@ func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryPoolPositionsRequest         protoreflect.MessageDescriptor
-	fd_QueryPoolPositionsRequest_pool_id protoreflect.FieldDescriptor
+	md_QueryPositionsRequest            protoreflect.MessageDescriptor
+	fd_QueryPositionsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPoolPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolPositionsRequest")
-	fd_QueryPoolPositionsRequest_pool_id = md_QueryPoolPositionsRequest.Fields().ByName("pool_id")
+	md_QueryPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionsRequest")
+	fd_QueryPositionsRequest_pagination = md_QueryPositionsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolPositionsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPositionsRequest)(nil)
 
-type fastReflection_QueryPoolPositionsRequest QueryPoolPositionsRequest
+type fastReflection_QueryPositionsRequest QueryPositionsRequest
 
-func (x *QueryPoolPositionsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolPositionsRequest)(x)
+func (x *QueryPositionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionsRequest)(x)
 }
 
-func (x *QueryPoolPositionsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPositionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5719,43 +5691,43 @@ func (x *QueryPoolPositionsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolPositionsRequest_messageType fastReflection_QueryPoolPositionsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolPositionsRequest_messageType{}
+var _fastReflection_QueryPositionsRequest_messageType fastReflection_QueryPositionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionsRequest_messageType{}
 
-type fastReflection_QueryPoolPositionsRequest_messageType struct{}
+type fastReflection_QueryPositionsRequest_messageType struct{}
 
-func (x fastReflection_QueryPoolPositionsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolPositionsRequest)(nil)
+func (x fastReflection_QueryPositionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionsRequest)(nil)
 }
-func (x fastReflection_QueryPoolPositionsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolPositionsRequest)
+func (x fastReflection_QueryPositionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionsRequest)
 }
-func (x fastReflection_QueryPoolPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolPositionsRequest
+func (x fastReflection_QueryPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolPositionsRequest
+func (x *fastReflection_QueryPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolPositionsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolPositionsRequest_messageType
+func (x *fastReflection_QueryPositionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolPositionsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolPositionsRequest)
+func (x *fastReflection_QueryPositionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolPositionsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolPositionsRequest)(x)
+func (x *fastReflection_QueryPositionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5763,10 +5735,10 @@ func (x *fastReflection_QueryPoolPositionsRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PoolId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PoolId)
-		if !f(fd_QueryPoolPositionsRequest_pool_id, value) {
+func (x *fastReflection_QueryPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPositionsRequest_pagination, value) {
 			return
 		}
 	}
@@ -5783,15 +5755,15 @@ func (x *fastReflection_QueryPoolPositionsRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		return x.PoolId != uint64(0)
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5801,15 +5773,15 @@ func (x *fastReflection_QueryPoolPositionsRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		x.PoolId = uint64(0)
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5819,16 +5791,16 @@ func (x *fastReflection_QueryPoolPositionsRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		value := x.PoolId
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5842,15 +5814,15 @@ func (x *fastReflection_QueryPoolPositionsRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		x.PoolId = value.Uint()
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5864,40 +5836,44 @@ func (x *fastReflection_QueryPoolPositionsRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryPoolPositionsRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryPositionsRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolPositionsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5905,7 +5881,7 @@ func (x *fastReflection_QueryPoolPositionsRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolPositionsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5916,7 +5892,7 @@ func (x *fastReflection_QueryPoolPositionsRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5928,7 +5904,7 @@ func (x *fastReflection_QueryPoolPositionsRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolPositionsRequest) IsValid() bool {
+func (x *fastReflection_QueryPositionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5938,9 +5914,9 @@ func (x *fastReflection_QueryPoolPositionsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolPositionsRequest)
+		x := input.Message.Interface().(*QueryPositionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5952,8 +5928,9 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.PoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.PoolId))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5965,7 +5942,7 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolPositionsRequest)
+		x := input.Message.Interface().(*QueryPositionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5984,10 +5961,19 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6000,7 +5986,7 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolPositionsRequest)
+		x := input.Message.Interface().(*QueryPositionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6032,17 +6018,17 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.PoolId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6052,11 +6038,28 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PoolId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6092,77 +6095,79 @@ func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_QueryPoolPositionsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPositionsResponse_1_list)(nil)
 
-type _QueryPoolPositionsResponse_1_list struct {
+type _QueryPositionsResponse_1_list struct {
 	list *[]*PositionInfo
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) Len() int {
+func (x *_QueryPositionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPositionsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPositionsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryPositionsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(PositionInfo)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) Truncate(n int) {
+func (x *_QueryPositionsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryPositionsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(PositionInfo)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPoolPositionsResponse_1_list) IsValid() bool {
+func (x *_QueryPositionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryPoolPositionsResponse           protoreflect.MessageDescriptor
-	fd_QueryPoolPositionsResponse_positions protoreflect.FieldDescriptor
+	md_QueryPositionsResponse            protoreflect.MessageDescriptor
+	fd_QueryPositionsResponse_positions  protoreflect.FieldDescriptor
+	fd_QueryPositionsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPoolPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolPositionsResponse")
-	fd_QueryPoolPositionsResponse_positions = md_QueryPoolPositionsResponse.Fields().ByName("positions")
-}
+	md_QueryPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionsResponse")
+	fd_QueryPositionsResponse_positions = md_QueryPositionsResponse.Fields().ByName("positions")
+	fd_QueryPositionsResponse_pagination = md_QueryPositionsResponse.Fields().ByName("pagination")
+}
 
-var _ protoreflect.Message = (*fastReflection_QueryPoolPositionsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPositionsResponse)(nil)
 
-type fastReflection_QueryPoolPositionsResponse QueryPoolPositionsResponse
+type fastReflection_QueryPositionsResponse QueryPositionsResponse
 
-func (x *QueryPoolPositionsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPoolPositionsResponse)(x)
+func (x *QueryPositionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionsResponse)(x)
 }
 
-func (x *QueryPoolPositionsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPositionsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6174,43 +6179,43 @@ func (x *QueryPoolPositionsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPoolPositionsResponse_messageType fastReflection_QueryPoolPositionsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPoolPositionsResponse_messageType{}
+var _fastReflection_QueryPositionsResponse_messageType fastReflection_QueryPositionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionsResponse_messageType{}
 
-type fastReflection_QueryPoolPositionsResponse_messageType struct{}
+type fastReflection_QueryPositionsResponse_messageType struct{}
 
-func (x fastReflection_QueryPoolPositionsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPoolPositionsResponse)(nil)
+func (x fastReflection_QueryPositionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionsResponse)(nil)
 }
-func (x fastReflection_QueryPoolPositionsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolPositionsResponse)
+func (x fastReflection_QueryPositionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionsResponse)
 }
-func (x fastReflection_QueryPoolPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolPositionsResponse
+func (x fastReflection_QueryPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPoolPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPoolPositionsResponse
+func (x *fastReflection_QueryPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPoolPositionsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPoolPositionsResponse_messageType
+func (x *fastReflection_QueryPositionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPoolPositionsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPoolPositionsResponse)
+func (x *fastReflection_QueryPositionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPoolPositionsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPoolPositionsResponse)(x)
+func (x *fastReflection_QueryPositionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6218,10 +6223,16 @@ func (x *fastReflection_QueryPoolPositionsResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPoolPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Positions) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{list: &x.Positions})
-		if !f(fd_QueryPoolPositionsResponse_positions, value) {
+		value := protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{list: &x.Positions})
+		if !f(fd_QueryPositionsResponse_positions, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPositionsResponse_pagination, value) {
 			return
 		}
 	}
@@ -6238,15 +6249,17 @@ func (x *fastReflection_QueryPoolPositionsResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPoolPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		return len(x.Positions) != 0
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6256,15 +6269,17 @@ func (x *fastReflection_QueryPoolPositionsResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		x.Positions = nil
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6274,19 +6289,22 @@ func (x *fastReflection_QueryPoolPositionsResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPoolPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		if len(x.Positions) == 0 {
-			return protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{})
 		}
-		listValue := &_QueryPoolPositionsResponse_1_list{list: &x.Positions}
+		listValue := &_QueryPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(listValue)
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6300,17 +6318,19 @@ func (x *fastReflection_QueryPoolPositionsResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		lv := value.List()
-		clv := lv.(*_QueryPoolPositionsResponse_1_list)
+		clv := lv.(*_QueryPositionsResponse_1_list)
 		x.Positions = *clv.list
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6324,45 +6344,53 @@ func (x *fastReflection_QueryPoolPositionsResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		if x.Positions == nil {
 			x.Positions = []*PositionInfo{}
 		}
-		value := &_QueryPoolPositionsResponse_1_list{list: &x.Positions}
+		value := &_QueryPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(value)
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPoolPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPositionsResponse.positions":
 		list := []*PositionInfo{}
-		return protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{list: &list})
+		return protoreflect.ValueOfList(&_QueryPositionsResponse_1_list{list: &list})
+	case "sunrise.liquiditypool.QueryPositionsResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPoolPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolPositionsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6370,7 +6398,7 @@ func (x *fastReflection_QueryPoolPositionsResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPoolPositionsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6381,7 +6409,7 @@ func (x *fastReflection_QueryPoolPositionsResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPoolPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6393,7 +6421,7 @@ func (x *fastReflection_QueryPoolPositionsResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPoolPositionsResponse) IsValid() bool {
+func (x *fastReflection_QueryPositionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6403,9 +6431,9 @@ func (x *fastReflection_QueryPoolPositionsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPoolPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6423,6 +6451,10 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6433,7 +6465,7 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6452,6 +6484,20 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Positions) > 0 {
 			for iNdEx := len(x.Positions) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Positions[iNdEx])
@@ -6479,7 +6525,7 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPoolPositionsResponse)
+		x := input.Message.Interface().(*QueryPositionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6511,10 +6557,10 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6551,6 +6597,42 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6587,25 +6669,25 @@ func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryAddressPositionsRequest         protoreflect.MessageDescriptor
-	fd_QueryAddressPositionsRequest_address protoreflect.FieldDescriptor
+	md_QueryPoolPositionsRequest         protoreflect.MessageDescriptor
+	fd_QueryPoolPositionsRequest_pool_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryAddressPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressPositionsRequest")
-	fd_QueryAddressPositionsRequest_address = md_QueryAddressPositionsRequest.Fields().ByName("address")
+	md_QueryPoolPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolPositionsRequest")
+	fd_QueryPoolPositionsRequest_pool_id = md_QueryPoolPositionsRequest.Fields().ByName("pool_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAddressPositionsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolPositionsRequest)(nil)
 
-type fastReflection_QueryAddressPositionsRequest QueryAddressPositionsRequest
+type fastReflection_QueryPoolPositionsRequest QueryPoolPositionsRequest
 
-func (x *QueryAddressPositionsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAddressPositionsRequest)(x)
+func (x *QueryPoolPositionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolPositionsRequest)(x)
 }
 
-func (x *QueryAddressPositionsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolPositionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6617,43 +6699,43 @@ func (x *QueryAddressPositionsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAddressPositionsRequest_messageType fastReflection_QueryAddressPositionsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAddressPositionsRequest_messageType{}
+var _fastReflection_QueryPoolPositionsRequest_messageType fastReflection_QueryPoolPositionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolPositionsRequest_messageType{}
 
-type fastReflection_QueryAddressPositionsRequest_messageType struct{}
+type fastReflection_QueryPoolPositionsRequest_messageType struct{}
 
-func (x fastReflection_QueryAddressPositionsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAddressPositionsRequest)(nil)
+func (x fastReflection_QueryPoolPositionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolPositionsRequest)(nil)
 }
-func (x fastReflection_QueryAddressPositionsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAddressPositionsRequest)
+func (x fastReflection_QueryPoolPositionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolPositionsRequest)
 }
-func (x fastReflection_QueryAddressPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAddressPositionsRequest
+func (x fastReflection_QueryPoolPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolPositionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAddressPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAddressPositionsRequest
+func (x *fastReflection_QueryPoolPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolPositionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAddressPositionsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAddressPositionsRequest_messageType
+func (x *fastReflection_QueryPoolPositionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolPositionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAddressPositionsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAddressPositionsRequest)
+func (x *fastReflection_QueryPoolPositionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolPositionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAddressPositionsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAddressPositionsRequest)(x)
+func (x *fastReflection_QueryPoolPositionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolPositionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6661,10 +6743,10 @@ func (x *fastReflection_QueryAddressPositionsRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAddressPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryAddressPositionsRequest_address, value) {
+func (x *fastReflection_QueryPoolPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_QueryPoolPositionsRequest_pool_id, value) {
 			return
 		}
 	}
@@ -6681,15 +6763,15 @@ func (x *fastReflection_QueryAddressPositionsRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAddressPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		return x.Address != ""
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		return x.PoolId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6699,15 +6781,15 @@ func (x *fastReflection_QueryAddressPositionsRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		x.Address = ""
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		x.PoolId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6717,16 +6799,16 @@ func (x *fastReflection_QueryAddressPositionsRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAddressPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6740,15 +6822,15 @@ func (x *fastReflection_QueryAddressPositionsRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		x.Address = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		x.PoolId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6762,40 +6844,40 @@ func (x *fastReflection_QueryAddressPositionsRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		panic(fmt.Errorf("field address of message sunrise.liquiditypool.QueryAddressPositionsRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryPoolPositionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAddressPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
-		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryPoolPositionsRequest.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAddressPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressPositionsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolPositionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6803,7 +6885,7 @@ func (x *fastReflection_QueryAddressPositionsRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAddressPositionsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolPositionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6814,7 +6896,7 @@ func (x *fastReflection_QueryAddressPositionsRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6826,7 +6908,7 @@ func (x *fastReflection_QueryAddressPositionsRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAddressPositionsRequest) IsValid() bool {
+func (x *fastReflection_QueryPoolPositionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6836,9 +6918,9 @@ func (x *fastReflection_QueryAddressPositionsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolPositionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAddressPositionsRequest)
+		x := input.Message.Interface().(*QueryPoolPositionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6850,9 +6932,8 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6864,7 +6945,7 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAddressPositionsRequest)
+		x := input.Message.Interface().(*QueryPoolPositionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6883,12 +6964,10 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6901,7 +6980,7 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAddressPositionsRequest)
+		x := input.Message.Interface().(*QueryPoolPositionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6933,17 +7012,17 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 				}
-				var stringLen uint64
+				x.PoolId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6953,24 +7032,11 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PoolId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7006,77 +7072,77 @@ func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryAddressPositionsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPoolPositionsResponse_1_list)(nil)
 
-type _QueryAddressPositionsResponse_1_list struct {
+type _QueryPoolPositionsResponse_1_list struct {
 	list *[]*PositionInfo
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) Len() int {
+func (x *_QueryPoolPositionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPoolPositionsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPoolPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPoolPositionsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryPoolPositionsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(PositionInfo)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) Truncate(n int) {
+func (x *_QueryPoolPositionsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryPoolPositionsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(PositionInfo)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryAddressPositionsResponse_1_list) IsValid() bool {
+func (x *_QueryPoolPositionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryAddressPositionsResponse           protoreflect.MessageDescriptor
-	fd_QueryAddressPositionsResponse_positions protoreflect.FieldDescriptor
+	md_QueryPoolPositionsResponse           protoreflect.MessageDescriptor
+	fd_QueryPoolPositionsResponse_positions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryAddressPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressPositionsResponse")
-	fd_QueryAddressPositionsResponse_positions = md_QueryAddressPositionsResponse.Fields().ByName("positions")
+	md_QueryPoolPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolPositionsResponse")
+	fd_QueryPoolPositionsResponse_positions = md_QueryPoolPositionsResponse.Fields().ByName("positions")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAddressPositionsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPoolPositionsResponse)(nil)
 
-type fastReflection_QueryAddressPositionsResponse QueryAddressPositionsResponse
+type fastReflection_QueryPoolPositionsResponse QueryPoolPositionsResponse
 
-func (x *QueryAddressPositionsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAddressPositionsResponse)(x)
+func (x *QueryPoolPositionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolPositionsResponse)(x)
 }
 
-func (x *QueryAddressPositionsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPoolPositionsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7088,43 +7154,43 @@ func (x *QueryAddressPositionsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAddressPositionsResponse_messageType fastReflection_QueryAddressPositionsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAddressPositionsResponse_messageType{}
+var _fastReflection_QueryPoolPositionsResponse_messageType fastReflection_QueryPoolPositionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolPositionsResponse_messageType{}
 
-type fastReflection_QueryAddressPositionsResponse_messageType struct{}
+type fastReflection_QueryPoolPositionsResponse_messageType struct{}
 
-func (x fastReflection_QueryAddressPositionsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAddressPositionsResponse)(nil)
+func (x fastReflection_QueryPoolPositionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolPositionsResponse)(nil)
 }
-func (x fastReflection_QueryAddressPositionsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAddressPositionsResponse)
+func (x fastReflection_QueryPoolPositionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolPositionsResponse)
 }
-func (x fastReflection_QueryAddressPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAddressPositionsResponse
+func (x fastReflection_QueryPoolPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolPositionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAddressPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAddressPositionsResponse
+func (x *fastReflection_QueryPoolPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolPositionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAddressPositionsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAddressPositionsResponse_messageType
+func (x *fastReflection_QueryPoolPositionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolPositionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAddressPositionsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAddressPositionsResponse)
+func (x *fastReflection_QueryPoolPositionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolPositionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAddressPositionsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAddressPositionsResponse)(x)
+func (x *fastReflection_QueryPoolPositionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolPositionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7132,10 +7198,10 @@ func (x *fastReflection_QueryAddressPositionsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAddressPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryPoolPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Positions) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{list: &x.Positions})
-		if !f(fd_QueryAddressPositionsResponse_positions, value) {
+		value := protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{list: &x.Positions})
+		if !f(fd_QueryPoolPositionsResponse_positions, value) {
 			return
 		}
 	}
@@ -7152,15 +7218,15 @@ func (x *fastReflection_QueryAddressPositionsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAddressPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPoolPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		return len(x.Positions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7170,15 +7236,15 @@ func (x *fastReflection_QueryAddressPositionsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPoolPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		x.Positions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7188,19 +7254,19 @@ func (x *fastReflection_QueryAddressPositionsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAddressPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		if len(x.Positions) == 0 {
-			return protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{})
 		}
-		listValue := &_QueryAddressPositionsResponse_1_list{list: &x.Positions}
+		listValue := &_QueryPoolPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7214,17 +7280,17 @@ func (x *fastReflection_QueryAddressPositionsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPoolPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		lv := value.List()
-		clv := lv.(*_QueryAddressPositionsResponse_1_list)
+		clv := lv.(*_QueryPoolPositionsResponse_1_list)
 		x.Positions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7238,45 +7304,45 @@ func (x *fastReflection_QueryAddressPositionsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		if x.Positions == nil {
 			x.Positions = []*PositionInfo{}
 		}
-		value := &_QueryAddressPositionsResponse_1_list{list: &x.Positions}
+		value := &_QueryPoolPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAddressPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPoolPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+	case "sunrise.liquiditypool.QueryPoolPositionsResponse.positions":
 		list := []*PositionInfo{}
-		return protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{list: &list})
+		return protoreflect.ValueOfList(&_QueryPoolPositionsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAddressPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPoolPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressPositionsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolPositionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7284,7 +7350,7 @@ func (x *fastReflection_QueryAddressPositionsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAddressPositionsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPoolPositionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7295,7 +7361,7 @@ func (x *fastReflection_QueryAddressPositionsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAddressPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPoolPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7307,7 +7373,7 @@ func (x *fastReflection_QueryAddressPositionsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAddressPositionsResponse) IsValid() bool {
+func (x *fastReflection_QueryPoolPositionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7317,9 +7383,9 @@ func (x *fastReflection_QueryAddressPositionsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPoolPositionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAddressPositionsResponse)
+		x := input.Message.Interface().(*QueryPoolPositionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7347,7 +7413,7 @@ func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAddressPositionsResponse)
+		x := input.Message.Interface().(*QueryPoolPositionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7393,7 +7459,7 @@ func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAddressPositionsResponse)
+		x := input.Message.Interface().(*QueryPoolPositionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7425,10 +7491,10 @@ func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7501,25 +7567,25 @@ func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryPositionFeesRequest    protoreflect.MessageDescriptor
-	fd_QueryPositionFeesRequest_id protoreflect.FieldDescriptor
+	md_QueryAddressPositionsRequest         protoreflect.MessageDescriptor
+	fd_QueryAddressPositionsRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionFeesRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionFeesRequest")
-	fd_QueryPositionFeesRequest_id = md_QueryPositionFeesRequest.Fields().ByName("id")
+	md_QueryAddressPositionsRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressPositionsRequest")
+	fd_QueryAddressPositionsRequest_address = md_QueryAddressPositionsRequest.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPositionFeesRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAddressPositionsRequest)(nil)
 
-type fastReflection_QueryPositionFeesRequest QueryPositionFeesRequest
+type fastReflection_QueryAddressPositionsRequest QueryAddressPositionsRequest
 
-func (x *QueryPositionFeesRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionFeesRequest)(x)
+func (x *QueryAddressPositionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAddressPositionsRequest)(x)
 }
 
-func (x *QueryPositionFeesRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAddressPositionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7531,43 +7597,43 @@ func (x *QueryPositionFeesRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPositionFeesRequest_messageType fastReflection_QueryPositionFeesRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionFeesRequest_messageType{}
+var _fastReflection_QueryAddressPositionsRequest_messageType fastReflection_QueryAddressPositionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAddressPositionsRequest_messageType{}
 
-type fastReflection_QueryPositionFeesRequest_messageType struct{}
+type fastReflection_QueryAddressPositionsRequest_messageType struct{}
 
-func (x fastReflection_QueryPositionFeesRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionFeesRequest)(nil)
+func (x fastReflection_QueryAddressPositionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAddressPositionsRequest)(nil)
 }
-func (x fastReflection_QueryPositionFeesRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionFeesRequest)
+func (x fastReflection_QueryAddressPositionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressPositionsRequest)
 }
-func (x fastReflection_QueryPositionFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionFeesRequest
+func (x fastReflection_QueryAddressPositionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressPositionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionFeesRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionFeesRequest
+func (x *fastReflection_QueryAddressPositionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressPositionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionFeesRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionFeesRequest_messageType
+func (x *fastReflection_QueryAddressPositionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAddressPositionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionFeesRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionFeesRequest)
+func (x *fastReflection_QueryAddressPositionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressPositionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionFeesRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionFeesRequest)(x)
+func (x *fastReflection_QueryAddressPositionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAddressPositionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7575,10 +7641,10 @@ func (x *fastReflection_QueryPositionFeesRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_QueryPositionFeesRequest_id, value) {
+func (x *fastReflection_QueryAddressPositionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryAddressPositionsRequest_address, value) {
 			return
 		}
 	}
@@ -7595,15 +7661,15 @@ func (x *fastReflection_QueryPositionFeesRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAddressPositionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		return x.Id != uint64(0)
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7613,15 +7679,15 @@ func (x *fastReflection_QueryPositionFeesRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAddressPositionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		x.Id = uint64(0)
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7631,16 +7697,16 @@ func (x *fastReflection_QueryPositionFeesRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7654,15 +7720,15 @@ func (x *fastReflection_QueryPositionFeesRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAddressPositionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		x.Id = value.Uint()
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7676,40 +7742,40 @@ func (x *fastReflection_QueryPositionFeesRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryPositionFeesRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		panic(fmt.Errorf("field address of message sunrise.liquiditypool.QueryAddressPositionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryAddressPositionsRequest.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAddressPositionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionFeesRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressPositionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7717,7 +7783,7 @@ func (x *fastReflection_QueryPositionFeesRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionFeesRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAddressPositionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7728,7 +7794,7 @@ func (x *fastReflection_QueryPositionFeesRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAddressPositionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7740,7 +7806,7 @@ func (x *fastReflection_QueryPositionFeesRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionFeesRequest) IsValid() bool {
+func (x *fastReflection_QueryAddressPositionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7750,9 +7816,9 @@ func (x *fastReflection_QueryPositionFeesRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAddressPositionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionFeesRequest)
+		x := input.Message.Interface().(*QueryAddressPositionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7764,8 +7830,9 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7777,7 +7844,7 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionFeesRequest)
+		x := input.Message.Interface().(*QueryAddressPositionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7796,10 +7863,12 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7812,7 +7881,7 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionFeesRequest)
+		x := input.Message.Interface().(*QueryAddressPositionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7844,17 +7913,17 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				x.Id = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7864,11 +7933,24 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7904,77 +7986,77 @@ func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryPositionFeesResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryAddressPositionsResponse_1_list)(nil)
 
-type _QueryPositionFeesResponse_1_list struct {
-	list *[]*v1beta1.Coin
+type _QueryAddressPositionsResponse_1_list struct {
+	list *[]*PositionInfo
 }
 
-func (x *_QueryPositionFeesResponse_1_list) Len() int {
+func (x *_QueryAddressPositionsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryPositionFeesResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryAddressPositionsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryPositionFeesResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryAddressPositionsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryPositionFeesResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryAddressPositionsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*PositionInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryPositionFeesResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_QueryAddressPositionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PositionInfo)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPositionFeesResponse_1_list) Truncate(n int) {
+func (x *_QueryAddressPositionsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryPositionFeesResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_QueryAddressPositionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PositionInfo)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryPositionFeesResponse_1_list) IsValid() bool {
+func (x *_QueryAddressPositionsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryPositionFeesResponse      protoreflect.MessageDescriptor
-	fd_QueryPositionFeesResponse_fees protoreflect.FieldDescriptor
+	md_QueryAddressPositionsResponse           protoreflect.MessageDescriptor
+	fd_QueryAddressPositionsResponse_positions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryPositionFeesResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionFeesResponse")
-	fd_QueryPositionFeesResponse_fees = md_QueryPositionFeesResponse.Fields().ByName("fees")
+	md_QueryAddressPositionsResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressPositionsResponse")
+	fd_QueryAddressPositionsResponse_positions = md_QueryAddressPositionsResponse.Fields().ByName("positions")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryPositionFeesResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAddressPositionsResponse)(nil)
 
-type fastReflection_QueryPositionFeesResponse QueryPositionFeesResponse
+type fastReflection_QueryAddressPositionsResponse QueryAddressPositionsResponse
 
-func (x *QueryPositionFeesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryPositionFeesResponse)(x)
+func (x *QueryAddressPositionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAddressPositionsResponse)(x)
 }
 
-func (x *QueryPositionFeesResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAddressPositionsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7986,43 +8068,43 @@ func (x *QueryPositionFeesResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryPositionFeesResponse_messageType fastReflection_QueryPositionFeesResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryPositionFeesResponse_messageType{}
+var _fastReflection_QueryAddressPositionsResponse_messageType fastReflection_QueryAddressPositionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAddressPositionsResponse_messageType{}
 
-type fastReflection_QueryPositionFeesResponse_messageType struct{}
+type fastReflection_QueryAddressPositionsResponse_messageType struct{}
 
-func (x fastReflection_QueryPositionFeesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryPositionFeesResponse)(nil)
+func (x fastReflection_QueryAddressPositionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAddressPositionsResponse)(nil)
 }
-func (x fastReflection_QueryPositionFeesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionFeesResponse)
+func (x fastReflection_QueryAddressPositionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressPositionsResponse)
 }
-func (x fastReflection_QueryPositionFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionFeesResponse
+func (x fastReflection_QueryAddressPositionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressPositionsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryPositionFeesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryPositionFeesResponse
+func (x *fastReflection_QueryAddressPositionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressPositionsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryPositionFeesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryPositionFeesResponse_messageType
+func (x *fastReflection_QueryAddressPositionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAddressPositionsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryPositionFeesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryPositionFeesResponse)
+func (x *fastReflection_QueryAddressPositionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressPositionsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryPositionFeesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryPositionFeesResponse)(x)
+func (x *fastReflection_QueryAddressPositionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAddressPositionsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8030,10 +8112,10 @@ func (x *fastReflection_QueryPositionFeesResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryPositionFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Fees) != 0 {
-		value := protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{list: &x.Fees})
-		if !f(fd_QueryPositionFeesResponse_fees, value) {
+func (x *fastReflection_QueryAddressPositionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Positions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{list: &x.Positions})
+		if !f(fd_QueryAddressPositionsResponse_positions, value) {
 			return
 		}
 	}
@@ -8050,15 +8132,15 @@ func (x *fastReflection_QueryPositionFeesResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryPositionFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAddressPositionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
-		return len(x.Fees) != 0
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+		return len(x.Positions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8068,15 +8150,15 @@ func (x *fastReflection_QueryPositionFeesResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAddressPositionsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
-		x.Fees = nil
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+		x.Positions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8086,19 +8168,19 @@ func (x *fastReflection_QueryPositionFeesResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryPositionFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
-		if len(x.Fees) == 0 {
-			return protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{})
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+		if len(x.Positions) == 0 {
+			return protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{})
 		}
-		listValue := &_QueryPositionFeesResponse_1_list{list: &x.Fees}
+		listValue := &_QueryAddressPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8112,17 +8194,17 @@ func (x *fastReflection_QueryPositionFeesResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAddressPositionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
 		lv := value.List()
-		clv := lv.(*_QueryPositionFeesResponse_1_list)
-		x.Fees = *clv.list
+		clv := lv.(*_QueryAddressPositionsResponse_1_list)
+		x.Positions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8136,45 +8218,45 @@ func (x *fastReflection_QueryPositionFeesResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
-		if x.Fees == nil {
-			x.Fees = []*v1beta1.Coin{}
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+		if x.Positions == nil {
+			x.Positions = []*PositionInfo{}
 		}
-		value := &_QueryPositionFeesResponse_1_list{list: &x.Fees}
+		value := &_QueryAddressPositionsResponse_1_list{list: &x.Positions}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryPositionFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAddressPositionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{list: &list})
+	case "sunrise.liquiditypool.QueryAddressPositionsResponse.positions":
+		list := []*PositionInfo{}
+		return protoreflect.ValueOfList(&_QueryAddressPositionsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressPositionsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressPositionsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryPositionFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAddressPositionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionFeesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressPositionsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8182,7 +8264,7 @@ func (x *fastReflection_QueryPositionFeesResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryPositionFeesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAddressPositionsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8193,7 +8275,7 @@ func (x *fastReflection_QueryPositionFeesResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryPositionFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAddressPositionsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8205,7 +8287,7 @@ func (x *fastReflection_QueryPositionFeesResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryPositionFeesResponse) IsValid() bool {
+func (x *fastReflection_QueryAddressPositionsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8215,9 +8297,9 @@ func (x *fastReflection_QueryPositionFeesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAddressPositionsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryPositionFeesResponse)
+		x := input.Message.Interface().(*QueryAddressPositionsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8229,8 +8311,8 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Fees) > 0 {
-			for _, e := range x.Fees {
+		if len(x.Positions) > 0 {
+			for _, e := range x.Positions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -8245,7 +8327,7 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionFeesResponse)
+		x := input.Message.Interface().(*QueryAddressPositionsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8264,9 +8346,9 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Fees) > 0 {
-			for iNdEx := len(x.Fees) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Fees[iNdEx])
+		if len(x.Positions) > 0 {
+			for iNdEx := len(x.Positions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Positions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8291,7 +8373,7 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryPositionFeesResponse)
+		x := input.Message.Interface().(*QueryAddressPositionsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8323,15 +8405,15 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8358,8 +8440,8 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Fees = append(x.Fees, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees[len(x.Fees)-1]); err != nil {
+				x.Positions = append(x.Positions, &PositionInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Positions[len(x.Positions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8399,33 +8481,25 @@ func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryCalculationCreatePositionRequest            protoreflect.MessageDescriptor
-	fd_QueryCalculationCreatePositionRequest_pool_id    protoreflect.FieldDescriptor
-	fd_QueryCalculationCreatePositionRequest_lower_tick protoreflect.FieldDescriptor
-	fd_QueryCalculationCreatePositionRequest_upper_tick protoreflect.FieldDescriptor
-	fd_QueryCalculationCreatePositionRequest_amount     protoreflect.FieldDescriptor
-	fd_QueryCalculationCreatePositionRequest_denom      protoreflect.FieldDescriptor
+	md_QueryPositionFeesRequest    protoreflect.MessageDescriptor
+	fd_QueryPositionFeesRequest_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryCalculationCreatePositionRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationCreatePositionRequest")
-	fd_QueryCalculationCreatePositionRequest_pool_id = md_QueryCalculationCreatePositionRequest.Fields().ByName("pool_id")
-	fd_QueryCalculationCreatePositionRequest_lower_tick = md_QueryCalculationCreatePositionRequest.Fields().ByName("lower_tick")
-	fd_QueryCalculationCreatePositionRequest_upper_tick = md_QueryCalculationCreatePositionRequest.Fields().ByName("upper_tick")
-	fd_QueryCalculationCreatePositionRequest_amount = md_QueryCalculationCreatePositionRequest.Fields().ByName("amount")
-	fd_QueryCalculationCreatePositionRequest_denom = md_QueryCalculationCreatePositionRequest.Fields().ByName("denom")
+	md_QueryPositionFeesRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionFeesRequest")
+	fd_QueryPositionFeesRequest_id = md_QueryPositionFeesRequest.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationCreatePositionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPositionFeesRequest)(nil)
 
-type fastReflection_QueryCalculationCreatePositionRequest QueryCalculationCreatePositionRequest
+type fastReflection_QueryPositionFeesRequest QueryPositionFeesRequest
 
-func (x *QueryCalculationCreatePositionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationCreatePositionRequest)(x)
+func (x *QueryPositionFeesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionFeesRequest)(x)
 }
 
-func (x *QueryCalculationCreatePositionRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPositionFeesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8437,43 +8511,43 @@ func (x *QueryCalculationCreatePositionRequest) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationCreatePositionRequest_messageType fastReflection_QueryCalculationCreatePositionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationCreatePositionRequest_messageType{}
+var _fastReflection_QueryPositionFeesRequest_messageType fastReflection_QueryPositionFeesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionFeesRequest_messageType{}
 
-type fastReflection_QueryCalculationCreatePositionRequest_messageType struct{}
+type fastReflection_QueryPositionFeesRequest_messageType struct{}
 
-func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationCreatePositionRequest)(nil)
+func (x fastReflection_QueryPositionFeesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionFeesRequest)(nil)
 }
-func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationCreatePositionRequest)
+func (x fastReflection_QueryPositionFeesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionFeesRequest)
 }
-func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationCreatePositionRequest
+func (x fastReflection_QueryPositionFeesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionFeesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationCreatePositionRequest
+func (x *fastReflection_QueryPositionFeesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionFeesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationCreatePositionRequest_messageType
+func (x *fastReflection_QueryPositionFeesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionFeesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationCreatePositionRequest)
+func (x *fastReflection_QueryPositionFeesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionFeesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationCreatePositionRequest)(x)
+func (x *fastReflection_QueryPositionFeesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionFeesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8481,34 +8555,10 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PoolId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PoolId)
-		if !f(fd_QueryCalculationCreatePositionRequest_pool_id, value) {
-			return
-		}
-	}
-	if x.LowerTick != "" {
-		value := protoreflect.ValueOfString(x.LowerTick)
-		if !f(fd_QueryCalculationCreatePositionRequest_lower_tick, value) {
-			return
-		}
-	}
-	if x.UpperTick != "" {
-		value := protoreflect.ValueOfString(x.UpperTick)
-		if !f(fd_QueryCalculationCreatePositionRequest_upper_tick, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_QueryCalculationCreatePositionRequest_amount, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_QueryCalculationCreatePositionRequest_denom, value) {
+func (x *fastReflection_QueryPositionFeesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryPositionFeesRequest_id, value) {
 			return
 		}
 	}
@@ -8525,23 +8575,15 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionFeesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
-		return x.PoolId != uint64(0)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		return x.LowerTick != ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		return x.UpperTick != ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		return x.Amount != ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		return x.Denom != ""
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
+		return x.Id != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8551,23 +8593,15 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionFeesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
-		x.PoolId = uint64(0)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		x.LowerTick = ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		x.UpperTick = ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		x.Amount = ""
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		x.Denom = ""
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
+		x.Id = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8577,28 +8611,16 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
-		value := x.PoolId
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
+		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		value := x.LowerTick
-		return protoreflect.ValueOfString(value)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		value := x.UpperTick
-		return protoreflect.ValueOfString(value)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8612,23 +8634,15 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionFeesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
-		x.PoolId = value.Uint()
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		x.LowerTick = value.Interface().(string)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		x.UpperTick = value.Interface().(string)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		x.Amount = value.Interface().(string)
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		x.Denom = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
+		x.Id = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8642,56 +8656,40 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
-		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		panic(fmt.Errorf("field lower_tick of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		panic(fmt.Errorf("field upper_tick of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		panic(fmt.Errorf("field amount of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		panic(fmt.Errorf("field denom of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
+		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryPositionFeesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+	case "sunrise.liquiditypool.QueryPositionFeesRequest.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
-		return protoreflect.ValueOfString("")
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
-		return protoreflect.ValueOfString("")
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
-		return protoreflect.ValueOfString("")
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionFeesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationCreatePositionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionFeesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8699,7 +8697,7 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionFeesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8710,7 +8708,7 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionFeesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8722,7 +8720,7 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) IsValid() bool {
+func (x *fastReflection_QueryPositionFeesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8732,9 +8730,9 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionFeesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		x := input.Message.Interface().(*QueryPositionFeesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8746,24 +8744,8 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		if x.PoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.PoolId))
-		}
-		l = len(x.LowerTick)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.UpperTick)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8775,7 +8757,7 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		x := input.Message.Interface().(*QueryPositionFeesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8794,36 +8776,8 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.UpperTick) > 0 {
-			i -= len(x.UpperTick)
-			copy(dAtA[i:], x.UpperTick)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpperTick)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.LowerTick) > 0 {
-			i -= len(x.LowerTick)
-			copy(dAtA[i:], x.LowerTick)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LowerTick)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.PoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -8838,7 +8792,7 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		x := input.Message.Interface().(*QueryPositionFeesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8870,68 +8824,17 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-				}
-				x.PoolId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PoolId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LowerTick = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8941,102 +8844,25 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UpperTick = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -9058,26 +8884,77 @@ func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *p
 	}
 }
 
+var _ protoreflect.List = (*_QueryPositionFeesResponse_1_list)(nil)
+
+type _QueryPositionFeesResponse_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_QueryPositionFeesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPositionFeesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPositionFeesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPositionFeesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPositionFeesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPositionFeesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPositionFeesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPositionFeesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCalculationCreatePositionResponse        protoreflect.MessageDescriptor
-	fd_QueryCalculationCreatePositionResponse_amount protoreflect.FieldDescriptor
+	md_QueryPositionFeesResponse      protoreflect.MessageDescriptor
+	fd_QueryPositionFeesResponse_fees protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryCalculationCreatePositionResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationCreatePositionResponse")
-	fd_QueryCalculationCreatePositionResponse_amount = md_QueryCalculationCreatePositionResponse.Fields().ByName("amount")
+	md_QueryPositionFeesResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPositionFeesResponse")
+	fd_QueryPositionFeesResponse_fees = md_QueryPositionFeesResponse.Fields().ByName("fees")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationCreatePositionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPositionFeesResponse)(nil)
 
-type fastReflection_QueryCalculationCreatePositionResponse QueryCalculationCreatePositionResponse
+type fastReflection_QueryPositionFeesResponse QueryPositionFeesResponse
 
-func (x *QueryCalculationCreatePositionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationCreatePositionResponse)(x)
+func (x *QueryPositionFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPositionFeesResponse)(x)
 }
 
-func (x *QueryCalculationCreatePositionResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryPositionFeesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9089,43 +8966,43 @@ func (x *QueryCalculationCreatePositionResponse) slowProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationCreatePositionResponse_messageType fastReflection_QueryCalculationCreatePositionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationCreatePositionResponse_messageType{}
+var _fastReflection_QueryPositionFeesResponse_messageType fastReflection_QueryPositionFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPositionFeesResponse_messageType{}
 
-type fastReflection_QueryCalculationCreatePositionResponse_messageType struct{}
+type fastReflection_QueryPositionFeesResponse_messageType struct{}
 
-func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationCreatePositionResponse)(nil)
+func (x fastReflection_QueryPositionFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPositionFeesResponse)(nil)
 }
-func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationCreatePositionResponse)
+func (x fastReflection_QueryPositionFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionFeesResponse)
 }
-func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationCreatePositionResponse
+func (x fastReflection_QueryPositionFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionFeesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationCreatePositionResponse
+func (x *fastReflection_QueryPositionFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPositionFeesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationCreatePositionResponse_messageType
+func (x *fastReflection_QueryPositionFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPositionFeesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationCreatePositionResponse)
+func (x *fastReflection_QueryPositionFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPositionFeesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationCreatePositionResponse)(x)
+func (x *fastReflection_QueryPositionFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPositionFeesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9133,10 +9010,10 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Interface() prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_QueryCalculationCreatePositionResponse_amount, value) {
+func (x *fastReflection_QueryPositionFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Fees) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{list: &x.Fees})
+		if !f(fd_QueryPositionFeesResponse_fees, value) {
 			return
 		}
 	}
@@ -9153,15 +9030,15 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Range(f func(pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPositionFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		return x.Amount != nil
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		return len(x.Fees) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9171,15 +9048,15 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Has(fd protorefl
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPositionFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		x.Amount = nil
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		x.Fees = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9189,16 +9066,19 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Clear(fd protore
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		value := x.Amount
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		if len(x.Fees) == 0 {
+			return protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{})
+		}
+		listValue := &_QueryPositionFeesResponse_1_list{list: &x.Fees}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9212,15 +9092,17 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Get(descriptor p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPositionFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		lv := value.List()
+		clv := lv.(*_QueryPositionFeesResponse_1_list)
+		x.Fees = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9234,44 +9116,45 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) Set(fd protorefl
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		if x.Fees == nil {
+			x.Fees = []*v1beta1.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		value := &_QueryPositionFeesResponse_1_list{list: &x.Fees}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPositionFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.QueryPositionFeesResponse.fees":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_QueryPositionFeesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPositionFeesResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPositionFeesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPositionFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationCreatePositionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPositionFeesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9279,7 +9162,7 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) WhichOneof(d pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPositionFeesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9290,7 +9173,7 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) GetUnknown() pro
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPositionFeesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9302,7 +9185,7 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) SetUnknown(field
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) IsValid() bool {
+func (x *fastReflection_QueryPositionFeesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9312,9 +9195,9 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPositionFeesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		x := input.Message.Interface().(*QueryPositionFeesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9326,9 +9209,11 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Fees) > 0 {
+			for _, e := range x.Fees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9340,7 +9225,7 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		x := input.Message.Interface().(*QueryPositionFeesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9359,19 +9244,21 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Fees) > 0 {
+			for iNdEx := len(x.Fees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9384,7 +9271,7 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		x := input.Message.Interface().(*QueryPositionFeesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9416,15 +9303,15 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPositionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9451,10 +9338,8 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				x.Fees = append(x.Fees, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees[len(x.Fees)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9494,29 +9379,25 @@ func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *
 }
 
 var (
-	md_QueryCalculationIncreaseLiquidityRequest           protoreflect.MessageDescriptor
-	fd_QueryCalculationIncreaseLiquidityRequest_id        protoreflect.FieldDescriptor
-	fd_QueryCalculationIncreaseLiquidityRequest_amount_in protoreflect.FieldDescriptor
-	fd_QueryCalculationIncreaseLiquidityRequest_denom_in  protoreflect.FieldDescriptor
+	md_QueryLimitOrderRequest    protoreflect.MessageDescriptor
+	fd_QueryLimitOrderRequest_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryCalculationIncreaseLiquidityRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationIncreaseLiquidityRequest")
-	fd_QueryCalculationIncreaseLiquidityRequest_id = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("id")
-	fd_QueryCalculationIncreaseLiquidityRequest_amount_in = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("amount_in")
-	fd_QueryCalculationIncreaseLiquidityRequest_denom_in = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("denom_in")
+	md_QueryLimitOrderRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryLimitOrderRequest")
+	fd_QueryLimitOrderRequest_id = md_QueryLimitOrderRequest.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLimitOrderRequest)(nil)
 
-type fastReflection_QueryCalculationIncreaseLiquidityRequest QueryCalculationIncreaseLiquidityRequest
+type fastReflection_QueryLimitOrderRequest QueryLimitOrderRequest
 
-func (x *QueryCalculationIncreaseLiquidityRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(x)
+func (x *QueryLimitOrderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLimitOrderRequest)(x)
 }
 
-func (x *QueryCalculationIncreaseLiquidityRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryLimitOrderRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9528,43 +9409,43 @@ func (x *QueryCalculationIncreaseLiquidityRequest) slowProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType{}
+var _fastReflection_QueryLimitOrderRequest_messageType fastReflection_QueryLimitOrderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLimitOrderRequest_messageType{}
 
-type fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType struct{}
+type fastReflection_QueryLimitOrderRequest_messageType struct{}
 
-func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(nil)
+func (x fastReflection_QueryLimitOrderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLimitOrderRequest)(nil)
 }
-func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationIncreaseLiquidityRequest)
+func (x fastReflection_QueryLimitOrderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLimitOrderRequest)
 }
-func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationIncreaseLiquidityRequest
+func (x fastReflection_QueryLimitOrderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLimitOrderRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationIncreaseLiquidityRequest
+func (x *fastReflection_QueryLimitOrderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLimitOrderRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType
+func (x *fastReflection_QueryLimitOrderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLimitOrderRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationIncreaseLiquidityRequest)
+func (x *fastReflection_QueryLimitOrderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLimitOrderRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationIncreaseLiquidityRequest)(x)
+func (x *fastReflection_QueryLimitOrderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLimitOrderRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9572,22 +9453,10 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Interface() pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLimitOrderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_QueryCalculationIncreaseLiquidityRequest_id, value) {
-			return
-		}
-	}
-	if x.AmountIn != "" {
-		value := protoreflect.ValueOfString(x.AmountIn)
-		if !f(fd_QueryCalculationIncreaseLiquidityRequest_amount_in, value) {
-			return
-		}
-	}
-	if x.DenomIn != "" {
-		value := protoreflect.ValueOfString(x.DenomIn)
-		if !f(fd_QueryCalculationIncreaseLiquidityRequest_denom_in, value) {
+		if !f(fd_QueryLimitOrderRequest_id, value) {
 			return
 		}
 	}
@@ -9604,19 +9473,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Range(f func(p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLimitOrderRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
 		return x.Id != uint64(0)
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		return x.AmountIn != ""
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		return x.DenomIn != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9626,19 +9491,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Has(fd protore
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLimitOrderRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
 		x.Id = uint64(0)
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		x.AmountIn = ""
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		x.DenomIn = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9648,22 +9509,16 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Clear(fd proto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		value := x.AmountIn
-		return protoreflect.ValueOfString(value)
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		value := x.DenomIn
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9677,19 +9532,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLimitOrderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
 		x.Id = value.Uint()
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		x.AmountIn = value.Interface().(string)
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		x.DenomIn = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9703,48 +9554,40 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Set(fd protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
-		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		panic(fmt.Errorf("field amount_in of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		panic(fmt.Errorf("field denom_in of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
+		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryLimitOrderRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+	case "sunrise.liquiditypool.QueryLimitOrderRequest.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
-		return protoreflect.ValueOfString("")
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLimitOrderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryLimitOrderRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9752,7 +9595,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) WhichOneof(d p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLimitOrderRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9763,7 +9606,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) GetUnknown() p
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLimitOrderRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9775,7 +9618,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) SetUnknown(fie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) IsValid() bool {
+func (x *fastReflection_QueryLimitOrderRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9785,9 +9628,9 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLimitOrderRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		x := input.Message.Interface().(*QueryLimitOrderRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9802,14 +9645,6 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.AmountIn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DenomIn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9820,7 +9655,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		x := input.Message.Interface().(*QueryLimitOrderRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9839,20 +9674,6 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DenomIn) > 0 {
-			i -= len(x.DenomIn)
-			copy(dAtA[i:], x.DenomIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomIn)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.AmountIn) > 0 {
-			i -= len(x.AmountIn)
-			copy(dAtA[i:], x.AmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -9869,7 +9690,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		x := input.Message.Interface().(*QueryLimitOrderRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9901,10 +9722,10 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLimitOrderRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9926,84 +9747,20 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 						break
 					}
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AmountIn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DenomIn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -10026,25 +9783,25 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods()
 }
 
 var (
-	md_QueryCalculationIncreaseLiquidityResponse                protoreflect.MessageDescriptor
-	fd_QueryCalculationIncreaseLiquidityResponse_token_required protoreflect.FieldDescriptor
+	md_QueryLimitOrderResponse             protoreflect.MessageDescriptor
+	fd_QueryLimitOrderResponse_limit_order protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_liquiditypool_query_proto_init()
-	md_QueryCalculationIncreaseLiquidityResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationIncreaseLiquidityResponse")
-	fd_QueryCalculationIncreaseLiquidityResponse_token_required = md_QueryCalculationIncreaseLiquidityResponse.Fields().ByName("token_required")
+	md_QueryLimitOrderResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryLimitOrderResponse")
+	fd_QueryLimitOrderResponse_limit_order = md_QueryLimitOrderResponse.Fields().ByName("limit_order")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLimitOrderResponse)(nil)
 
-type fastReflection_QueryCalculationIncreaseLiquidityResponse QueryCalculationIncreaseLiquidityResponse
+type fastReflection_QueryLimitOrderResponse QueryLimitOrderResponse
 
-func (x *QueryCalculationIncreaseLiquidityResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(x)
+func (x *QueryLimitOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLimitOrderResponse)(x)
 }
 
-func (x *QueryCalculationIncreaseLiquidityResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryLimitOrderResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10056,43 +9813,43 @@ func (x *QueryCalculationIncreaseLiquidityResponse) slowProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType{}
+var _fastReflection_QueryLimitOrderResponse_messageType fastReflection_QueryLimitOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLimitOrderResponse_messageType{}
 
-type fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType struct{}
+type fastReflection_QueryLimitOrderResponse_messageType struct{}
 
-func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(nil)
+func (x fastReflection_QueryLimitOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLimitOrderResponse)(nil)
 }
-func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationIncreaseLiquidityResponse)
+func (x fastReflection_QueryLimitOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLimitOrderResponse)
 }
-func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationIncreaseLiquidityResponse
+func (x fastReflection_QueryLimitOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLimitOrderResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationIncreaseLiquidityResponse
+func (x *fastReflection_QueryLimitOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLimitOrderResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType
+func (x *fastReflection_QueryLimitOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLimitOrderResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationIncreaseLiquidityResponse)
+func (x *fastReflection_QueryLimitOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLimitOrderResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationIncreaseLiquidityResponse)(x)
+func (x *fastReflection_QueryLimitOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLimitOrderResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10100,10 +9857,10 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Interface() p
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TokenRequired != nil {
-		value := protoreflect.ValueOfMessage(x.TokenRequired.ProtoReflect())
-		if !f(fd_QueryCalculationIncreaseLiquidityResponse_token_required, value) {
+func (x *fastReflection_QueryLimitOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LimitOrder != nil {
+		value := protoreflect.ValueOfMessage(x.LimitOrder.ProtoReflect())
+		if !f(fd_QueryLimitOrderResponse_limit_order, value) {
 			return
 		}
 	}
@@ -10120,15 +9877,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Range(f func(
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLimitOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		return x.TokenRequired != nil
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		return x.LimitOrder != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10138,15 +9895,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Has(fd protor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLimitOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		x.TokenRequired = nil
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		x.LimitOrder = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10156,16 +9913,16 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Clear(fd prot
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		value := x.TokenRequired
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		value := x.LimitOrder
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10179,15 +9936,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Get(descripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLimitOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		x.TokenRequired = value.Message().Interface().(*v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		x.LimitOrder = value.Message().Interface().(*LimitOrder)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10201,44 +9958,44 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Set(fd protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		if x.TokenRequired == nil {
-			x.TokenRequired = new(v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		if x.LimitOrder == nil {
+			x.LimitOrder = new(LimitOrder)
 		}
-		return protoreflect.ValueOfMessage(x.TokenRequired.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.LimitOrder.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLimitOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
-		m := new(v1beta1.Coin)
+	case "sunrise.liquiditypool.QueryLimitOrderResponse.limit_order":
+		m := new(LimitOrder)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryLimitOrderResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryLimitOrderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLimitOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryLimitOrderResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10246,7 +10003,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLimitOrderResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10257,7 +10014,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLimitOrderResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10269,7 +10026,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) SetUnknown(fi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) IsValid() bool {
+func (x *fastReflection_QueryLimitOrderResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10279,9 +10036,9 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) IsValid() boo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLimitOrderResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		x := input.Message.Interface().(*QueryLimitOrderResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10293,8 +10050,8 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 		var n int
 		var l int
 		_ = l
-		if x.TokenRequired != nil {
-			l = options.Size(x.TokenRequired)
+		if x.LimitOrder != nil {
+			l = options.Size(x.LimitOrder)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10307,7 +10064,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		x := input.Message.Interface().(*QueryLimitOrderResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10326,8 +10083,8 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TokenRequired != nil {
-			encoded, err := options.Marshal(x.TokenRequired)
+		if x.LimitOrder != nil {
+			encoded, err := options.Marshal(x.LimitOrder)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10351,7 +10108,7 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		x := input.Message.Interface().(*QueryLimitOrderResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10383,15 +10140,15 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLimitOrderResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenRequired", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10418,10 +10175,10 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TokenRequired == nil {
-					x.TokenRequired = &v1beta1.Coin{}
+				if x.LimitOrder == nil {
+					x.LimitOrder = &LimitOrder{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenRequired); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrder); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10460,160 +10217,5296 @@ func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods(
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: sunrise/liquiditypool/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryPoolLimitOrdersRequest             protoreflect.MessageDescriptor
+	fd_QueryPoolLimitOrdersRequest_pool_id     protoreflect.FieldDescriptor
+	fd_QueryPoolLimitOrdersRequest_only_open   protoreflect.FieldDescriptor
+	fd_QueryPoolLimitOrdersRequest_only_filled protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryPoolLimitOrdersRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolLimitOrdersRequest")
+	fd_QueryPoolLimitOrdersRequest_pool_id = md_QueryPoolLimitOrdersRequest.Fields().ByName("pool_id")
+	fd_QueryPoolLimitOrdersRequest_only_open = md_QueryPoolLimitOrdersRequest.Fields().ByName("only_open")
+	fd_QueryPoolLimitOrdersRequest_only_filled = md_QueryPoolLimitOrdersRequest.Fields().ByName("only_filled")
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{0}
-}
+var _ protoreflect.Message = (*fastReflection_QueryPoolLimitOrdersRequest)(nil)
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_QueryPoolLimitOrdersRequest QueryPoolLimitOrdersRequest
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (x *QueryPoolLimitOrdersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolLimitOrdersRequest)(x)
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[1]
+func (x *QueryPoolLimitOrdersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryParamsResponse) ProtoMessage() {}
+var _fastReflection_QueryPoolLimitOrdersRequest_messageType fastReflection_QueryPoolLimitOrdersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolLimitOrdersRequest_messageType{}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{1}
-}
+type fastReflection_QueryPoolLimitOrdersRequest_messageType struct{}
 
-func (x *QueryParamsResponse) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+func (x fastReflection_QueryPoolLimitOrdersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolLimitOrdersRequest)(nil)
 }
-
-type QueryPoolRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x fastReflection_QueryPoolLimitOrdersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolLimitOrdersRequest)
 }
-
-func (x *QueryPoolRequest) Reset() {
-	*x = QueryPoolRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_QueryPoolLimitOrdersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolLimitOrdersRequest
 }
 
-func (x *QueryPoolRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolLimitOrdersRequest
 }
 
-func (*QueryPoolRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryPoolRequest.ProtoReflect.Descriptor instead.
-func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{2}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolLimitOrdersRequest_messageType
 }
 
-func (x *QueryPoolRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolLimitOrdersRequest)
 }
 
-type PoolInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pool       *Pool         `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	TokenBase  *v1beta1.Coin `protobuf:"bytes,2,opt,name=token_base,json=tokenBase,proto3" json:"token_base,omitempty"`
-	TokenQuote *v1beta1.Coin `protobuf:"bytes,3,opt,name=token_quote,json=tokenQuote,proto3" json:"token_quote,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolLimitOrdersRequest)(x)
 }
 
-func (x *PoolInfo) Reset() {
-	*x = PoolInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_QueryPoolLimitOrdersRequest_pool_id, value) {
+			return
+		}
 	}
-}
-
-func (x *PoolInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PoolInfo) ProtoMessage() {}
-
-// Deprecated: Use PoolInfo.ProtoReflect.Descriptor instead.
-func (*PoolInfo) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *PoolInfo) GetPool() *Pool {
-	if x != nil {
-		return x.Pool
+	if x.OnlyOpen != false {
+		value := protoreflect.ValueOfBool(x.OnlyOpen)
+		if !f(fd_QueryPoolLimitOrdersRequest_only_open, value) {
+			return
+		}
 	}
-	return nil
-}
-
-func (x *PoolInfo) GetTokenBase() *v1beta1.Coin {
-	if x != nil {
-		return x.TokenBase
+	if x.OnlyFilled != false {
+		value := protoreflect.ValueOfBool(x.OnlyFilled)
+		if !f(fd_QueryPoolLimitOrdersRequest_only_filled, value) {
+			return
+		}
 	}
-	return nil
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		return x.PoolId != uint64(0)
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		return x.OnlyOpen != false
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		return x.OnlyFilled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		x.PoolId = uint64(0)
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		x.OnlyOpen = false
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		x.OnlyFilled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		value := x.OnlyOpen
+		return protoreflect.ValueOfBool(value)
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		value := x.OnlyFilled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		x.PoolId = value.Uint()
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		x.OnlyOpen = value.Bool()
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		x.OnlyFilled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryPoolLimitOrdersRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		panic(fmt.Errorf("field only_open of message sunrise.liquiditypool.QueryPoolLimitOrdersRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		panic(fmt.Errorf("field only_filled of message sunrise.liquiditypool.QueryPoolLimitOrdersRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_open":
+		return protoreflect.ValueOfBool(false)
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersRequest.only_filled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolLimitOrdersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolLimitOrdersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.OnlyOpen {
+			n += 2
+		}
+		if x.OnlyFilled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OnlyFilled {
+			i--
+			if x.OnlyFilled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.OnlyOpen {
+			i--
+			if x.OnlyOpen {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolLimitOrdersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnlyOpen", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnlyOpen = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnlyFilled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnlyFilled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolLimitOrdersResponse_1_list)(nil)
+
+type _QueryPoolLimitOrdersResponse_1_list struct {
+	list *[]*LimitOrder
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LimitOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LimitOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolLimitOrdersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolLimitOrdersResponse              protoreflect.MessageDescriptor
+	fd_QueryPoolLimitOrdersResponse_limit_orders protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryPoolLimitOrdersResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryPoolLimitOrdersResponse")
+	fd_QueryPoolLimitOrdersResponse_limit_orders = md_QueryPoolLimitOrdersResponse.Fields().ByName("limit_orders")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolLimitOrdersResponse)(nil)
+
+type fastReflection_QueryPoolLimitOrdersResponse QueryPoolLimitOrdersResponse
+
+func (x *QueryPoolLimitOrdersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolLimitOrdersResponse)(x)
+}
+
+func (x *QueryPoolLimitOrdersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolLimitOrdersResponse_messageType fastReflection_QueryPoolLimitOrdersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolLimitOrdersResponse_messageType{}
+
+type fastReflection_QueryPoolLimitOrdersResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolLimitOrdersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolLimitOrdersResponse)(nil)
+}
+func (x fastReflection_QueryPoolLimitOrdersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolLimitOrdersResponse)
+}
+func (x fastReflection_QueryPoolLimitOrdersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolLimitOrdersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolLimitOrdersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolLimitOrdersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolLimitOrdersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolLimitOrdersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.LimitOrders) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolLimitOrdersResponse_1_list{list: &x.LimitOrders})
+		if !f(fd_QueryPoolLimitOrdersResponse_limit_orders, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		return len(x.LimitOrders) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		x.LimitOrders = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		if len(x.LimitOrders) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolLimitOrdersResponse_1_list{})
+		}
+		listValue := &_QueryPoolLimitOrdersResponse_1_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		lv := value.List()
+		clv := lv.(*_QueryPoolLimitOrdersResponse_1_list)
+		x.LimitOrders = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		if x.LimitOrders == nil {
+			x.LimitOrders = []*LimitOrder{}
+		}
+		value := &_QueryPoolLimitOrdersResponse_1_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders":
+		list := []*LimitOrder{}
+		return protoreflect.ValueOfList(&_QueryPoolLimitOrdersResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryPoolLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryPoolLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryPoolLimitOrdersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolLimitOrdersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.LimitOrders) > 0 {
+			for _, e := range x.LimitOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LimitOrders) > 0 {
+			for iNdEx := len(x.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LimitOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolLimitOrdersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolLimitOrdersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LimitOrders = append(x.LimitOrders, &LimitOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrders[len(x.LimitOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAddressLimitOrdersRequest             protoreflect.MessageDescriptor
+	fd_QueryAddressLimitOrdersRequest_address     protoreflect.FieldDescriptor
+	fd_QueryAddressLimitOrdersRequest_only_open   protoreflect.FieldDescriptor
+	fd_QueryAddressLimitOrdersRequest_only_filled protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryAddressLimitOrdersRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressLimitOrdersRequest")
+	fd_QueryAddressLimitOrdersRequest_address = md_QueryAddressLimitOrdersRequest.Fields().ByName("address")
+	fd_QueryAddressLimitOrdersRequest_only_open = md_QueryAddressLimitOrdersRequest.Fields().ByName("only_open")
+	fd_QueryAddressLimitOrdersRequest_only_filled = md_QueryAddressLimitOrdersRequest.Fields().ByName("only_filled")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAddressLimitOrdersRequest)(nil)
+
+type fastReflection_QueryAddressLimitOrdersRequest QueryAddressLimitOrdersRequest
+
+func (x *QueryAddressLimitOrdersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAddressLimitOrdersRequest)(x)
+}
+
+func (x *QueryAddressLimitOrdersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAddressLimitOrdersRequest_messageType fastReflection_QueryAddressLimitOrdersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAddressLimitOrdersRequest_messageType{}
+
+type fastReflection_QueryAddressLimitOrdersRequest_messageType struct{}
+
+func (x fastReflection_QueryAddressLimitOrdersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAddressLimitOrdersRequest)(nil)
+}
+func (x fastReflection_QueryAddressLimitOrdersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressLimitOrdersRequest)
+}
+func (x fastReflection_QueryAddressLimitOrdersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressLimitOrdersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressLimitOrdersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAddressLimitOrdersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressLimitOrdersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAddressLimitOrdersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryAddressLimitOrdersRequest_address, value) {
+			return
+		}
+	}
+	if x.OnlyOpen != false {
+		value := protoreflect.ValueOfBool(x.OnlyOpen)
+		if !f(fd_QueryAddressLimitOrdersRequest_only_open, value) {
+			return
+		}
+	}
+	if x.OnlyFilled != false {
+		value := protoreflect.ValueOfBool(x.OnlyFilled)
+		if !f(fd_QueryAddressLimitOrdersRequest_only_filled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		return x.Address != ""
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		return x.OnlyOpen != false
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		return x.OnlyFilled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		x.Address = ""
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		x.OnlyOpen = false
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		x.OnlyFilled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		value := x.OnlyOpen
+		return protoreflect.ValueOfBool(value)
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		value := x.OnlyFilled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		x.Address = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		x.OnlyOpen = value.Bool()
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		x.OnlyFilled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		panic(fmt.Errorf("field address of message sunrise.liquiditypool.QueryAddressLimitOrdersRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		panic(fmt.Errorf("field only_open of message sunrise.liquiditypool.QueryAddressLimitOrdersRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		panic(fmt.Errorf("field only_filled of message sunrise.liquiditypool.QueryAddressLimitOrdersRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.address":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_open":
+		return protoreflect.ValueOfBool(false)
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersRequest.only_filled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressLimitOrdersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAddressLimitOrdersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OnlyOpen {
+			n += 2
+		}
+		if x.OnlyFilled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OnlyFilled {
+			i--
+			if x.OnlyFilled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.OnlyOpen {
+			i--
+			if x.OnlyOpen {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressLimitOrdersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnlyOpen", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnlyOpen = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnlyFilled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnlyFilled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAddressLimitOrdersResponse_1_list)(nil)
+
+type _QueryAddressLimitOrdersResponse_1_list struct {
+	list *[]*LimitOrder
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LimitOrder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LimitOrder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LimitOrder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAddressLimitOrdersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAddressLimitOrdersResponse              protoreflect.MessageDescriptor
+	fd_QueryAddressLimitOrdersResponse_limit_orders protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryAddressLimitOrdersResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryAddressLimitOrdersResponse")
+	fd_QueryAddressLimitOrdersResponse_limit_orders = md_QueryAddressLimitOrdersResponse.Fields().ByName("limit_orders")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAddressLimitOrdersResponse)(nil)
+
+type fastReflection_QueryAddressLimitOrdersResponse QueryAddressLimitOrdersResponse
+
+func (x *QueryAddressLimitOrdersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAddressLimitOrdersResponse)(x)
+}
+
+func (x *QueryAddressLimitOrdersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAddressLimitOrdersResponse_messageType fastReflection_QueryAddressLimitOrdersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAddressLimitOrdersResponse_messageType{}
+
+type fastReflection_QueryAddressLimitOrdersResponse_messageType struct{}
+
+func (x fastReflection_QueryAddressLimitOrdersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAddressLimitOrdersResponse)(nil)
+}
+func (x fastReflection_QueryAddressLimitOrdersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressLimitOrdersResponse)
+}
+func (x fastReflection_QueryAddressLimitOrdersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressLimitOrdersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAddressLimitOrdersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAddressLimitOrdersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAddressLimitOrdersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAddressLimitOrdersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.LimitOrders) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAddressLimitOrdersResponse_1_list{list: &x.LimitOrders})
+		if !f(fd_QueryAddressLimitOrdersResponse_limit_orders, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		return len(x.LimitOrders) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		x.LimitOrders = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		if len(x.LimitOrders) == 0 {
+			return protoreflect.ValueOfList(&_QueryAddressLimitOrdersResponse_1_list{})
+		}
+		listValue := &_QueryAddressLimitOrdersResponse_1_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		lv := value.List()
+		clv := lv.(*_QueryAddressLimitOrdersResponse_1_list)
+		x.LimitOrders = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		if x.LimitOrders == nil {
+			x.LimitOrders = []*LimitOrder{}
+		}
+		value := &_QueryAddressLimitOrdersResponse_1_list{list: &x.LimitOrders}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders":
+		list := []*LimitOrder{}
+		return protoreflect.ValueOfList(&_QueryAddressLimitOrdersResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryAddressLimitOrdersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryAddressLimitOrdersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryAddressLimitOrdersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAddressLimitOrdersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.LimitOrders) > 0 {
+			for _, e := range x.LimitOrders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LimitOrders) > 0 {
+			for iNdEx := len(x.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LimitOrders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAddressLimitOrdersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressLimitOrdersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAddressLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LimitOrders = append(x.LimitOrders, &LimitOrder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitOrders[len(x.LimitOrders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTwapRequest         protoreflect.MessageDescriptor
+	fd_QueryTwapRequest_pool_id protoreflect.FieldDescriptor
+	fd_QueryTwapRequest_start   protoreflect.FieldDescriptor
+	fd_QueryTwapRequest_end     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryTwapRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryTwapRequest")
+	fd_QueryTwapRequest_pool_id = md_QueryTwapRequest.Fields().ByName("pool_id")
+	fd_QueryTwapRequest_start = md_QueryTwapRequest.Fields().ByName("start")
+	fd_QueryTwapRequest_end = md_QueryTwapRequest.Fields().ByName("end")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTwapRequest)(nil)
+
+type fastReflection_QueryTwapRequest QueryTwapRequest
+
+func (x *QueryTwapRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTwapRequest)(x)
+}
+
+func (x *QueryTwapRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTwapRequest_messageType fastReflection_QueryTwapRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTwapRequest_messageType{}
+
+type fastReflection_QueryTwapRequest_messageType struct{}
+
+func (x fastReflection_QueryTwapRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTwapRequest)(nil)
+}
+func (x fastReflection_QueryTwapRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTwapRequest)
+}
+func (x fastReflection_QueryTwapRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTwapRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTwapRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTwapRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTwapRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTwapRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTwapRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTwapRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTwapRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTwapRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTwapRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_QueryTwapRequest_pool_id, value) {
+			return
+		}
+	}
+	if x.Start != nil {
+		value := protoreflect.ValueOfMessage(x.Start.ProtoReflect())
+		if !f(fd_QueryTwapRequest_start, value) {
+			return
+		}
+	}
+	if x.End != nil {
+		value := protoreflect.ValueOfMessage(x.End.ProtoReflect())
+		if !f(fd_QueryTwapRequest_end, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTwapRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		return x.PoolId != uint64(0)
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		return x.Start != nil
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		return x.End != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		x.PoolId = uint64(0)
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		x.Start = nil
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		x.End = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTwapRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		value := x.Start
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		value := x.End
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		x.PoolId = value.Uint()
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		x.Start = value.Message().Interface().(*timestamppb.Timestamp)
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		x.End = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		if x.Start == nil {
+			x.Start = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Start.ProtoReflect())
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		if x.End == nil {
+			x.End = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.End.ProtoReflect())
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryTwapRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTwapRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapRequest.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryTwapRequest.start":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.liquiditypool.QueryTwapRequest.end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTwapRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryTwapRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTwapRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTwapRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTwapRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTwapRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.Start != nil {
+			l = options.Size(x.Start)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.End != nil {
+			l = options.Size(x.End)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTwapRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.End != nil {
+			encoded, err := options.Marshal(x.End)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Start != nil {
+			encoded, err := options.Marshal(x.Start)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTwapRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTwapRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Start == nil {
+					x.Start = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Start); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.End == nil {
+					x.End = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.End); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTwapResponse                   protoreflect.MessageDescriptor
+	fd_QueryTwapResponse_price             protoreflect.FieldDescriptor
+	fd_QueryTwapResponse_average_liquidity protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryTwapResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryTwapResponse")
+	fd_QueryTwapResponse_price = md_QueryTwapResponse.Fields().ByName("price")
+	fd_QueryTwapResponse_average_liquidity = md_QueryTwapResponse.Fields().ByName("average_liquidity")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTwapResponse)(nil)
+
+type fastReflection_QueryTwapResponse QueryTwapResponse
+
+func (x *QueryTwapResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTwapResponse)(x)
+}
+
+func (x *QueryTwapResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTwapResponse_messageType fastReflection_QueryTwapResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTwapResponse_messageType{}
+
+type fastReflection_QueryTwapResponse_messageType struct{}
+
+func (x fastReflection_QueryTwapResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTwapResponse)(nil)
+}
+func (x fastReflection_QueryTwapResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTwapResponse)
+}
+func (x fastReflection_QueryTwapResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTwapResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTwapResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTwapResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTwapResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTwapResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTwapResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTwapResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTwapResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTwapResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTwapResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_QueryTwapResponse_price, value) {
+			return
+		}
+	}
+	if x.AverageLiquidity != "" {
+		value := protoreflect.ValueOfString(x.AverageLiquidity)
+		if !f(fd_QueryTwapResponse_average_liquidity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTwapResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		return x.Price != ""
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		return x.AverageLiquidity != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		x.Price = ""
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		x.AverageLiquidity = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTwapResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		value := x.AverageLiquidity
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		x.Price = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		x.AverageLiquidity = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		panic(fmt.Errorf("field price of message sunrise.liquiditypool.QueryTwapResponse is not mutable"))
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		panic(fmt.Errorf("field average_liquidity of message sunrise.liquiditypool.QueryTwapResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTwapResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryTwapResponse.price":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryTwapResponse.average_liquidity":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryTwapResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryTwapResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTwapResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryTwapResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTwapResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTwapResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTwapResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTwapResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTwapResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AverageLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTwapResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AverageLiquidity) > 0 {
+			i -= len(x.AverageLiquidity)
+			copy(dAtA[i:], x.AverageLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AverageLiquidity)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTwapResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTwapResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AverageLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculationCreatePositionRequest            protoreflect.MessageDescriptor
+	fd_QueryCalculationCreatePositionRequest_pool_id    protoreflect.FieldDescriptor
+	fd_QueryCalculationCreatePositionRequest_lower_tick protoreflect.FieldDescriptor
+	fd_QueryCalculationCreatePositionRequest_upper_tick protoreflect.FieldDescriptor
+	fd_QueryCalculationCreatePositionRequest_amount     protoreflect.FieldDescriptor
+	fd_QueryCalculationCreatePositionRequest_denom      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryCalculationCreatePositionRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationCreatePositionRequest")
+	fd_QueryCalculationCreatePositionRequest_pool_id = md_QueryCalculationCreatePositionRequest.Fields().ByName("pool_id")
+	fd_QueryCalculationCreatePositionRequest_lower_tick = md_QueryCalculationCreatePositionRequest.Fields().ByName("lower_tick")
+	fd_QueryCalculationCreatePositionRequest_upper_tick = md_QueryCalculationCreatePositionRequest.Fields().ByName("upper_tick")
+	fd_QueryCalculationCreatePositionRequest_amount = md_QueryCalculationCreatePositionRequest.Fields().ByName("amount")
+	fd_QueryCalculationCreatePositionRequest_denom = md_QueryCalculationCreatePositionRequest.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculationCreatePositionRequest)(nil)
+
+type fastReflection_QueryCalculationCreatePositionRequest QueryCalculationCreatePositionRequest
+
+func (x *QueryCalculationCreatePositionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationCreatePositionRequest)(x)
+}
+
+func (x *QueryCalculationCreatePositionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculationCreatePositionRequest_messageType fastReflection_QueryCalculationCreatePositionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationCreatePositionRequest_messageType{}
+
+type fastReflection_QueryCalculationCreatePositionRequest_messageType struct{}
+
+func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationCreatePositionRequest)(nil)
+}
+func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationCreatePositionRequest)
+}
+func (x fastReflection_QueryCalculationCreatePositionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationCreatePositionRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationCreatePositionRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationCreatePositionRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationCreatePositionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationCreatePositionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_QueryCalculationCreatePositionRequest_pool_id, value) {
+			return
+		}
+	}
+	if x.LowerTick != "" {
+		value := protoreflect.ValueOfString(x.LowerTick)
+		if !f(fd_QueryCalculationCreatePositionRequest_lower_tick, value) {
+			return
+		}
+	}
+	if x.UpperTick != "" {
+		value := protoreflect.ValueOfString(x.UpperTick)
+		if !f(fd_QueryCalculationCreatePositionRequest_upper_tick, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_QueryCalculationCreatePositionRequest_amount, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryCalculationCreatePositionRequest_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		return x.PoolId != uint64(0)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		return x.LowerTick != ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		return x.UpperTick != ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		return x.Amount != ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		x.PoolId = uint64(0)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		x.LowerTick = ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		x.UpperTick = ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		x.Amount = ""
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		value := x.LowerTick
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		value := x.UpperTick
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		x.PoolId = value.Uint()
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		x.LowerTick = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		x.UpperTick = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		x.Amount = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		panic(fmt.Errorf("field lower_tick of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		panic(fmt.Errorf("field upper_tick of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		panic(fmt.Errorf("field amount of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		panic(fmt.Errorf("field denom of message sunrise.liquiditypool.QueryCalculationCreatePositionRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.lower_tick":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.upper_tick":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.amount":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionRequest.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationCreatePositionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationCreatePositionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.LowerTick)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UpperTick)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.UpperTick) > 0 {
+			i -= len(x.UpperTick)
+			copy(dAtA[i:], x.UpperTick)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpperTick)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LowerTick) > 0 {
+			i -= len(x.LowerTick)
+			copy(dAtA[i:], x.LowerTick)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LowerTick)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LowerTick = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpperTick = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculationCreatePositionResponse        protoreflect.MessageDescriptor
+	fd_QueryCalculationCreatePositionResponse_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryCalculationCreatePositionResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationCreatePositionResponse")
+	fd_QueryCalculationCreatePositionResponse_amount = md_QueryCalculationCreatePositionResponse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculationCreatePositionResponse)(nil)
+
+type fastReflection_QueryCalculationCreatePositionResponse QueryCalculationCreatePositionResponse
+
+func (x *QueryCalculationCreatePositionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationCreatePositionResponse)(x)
+}
+
+func (x *QueryCalculationCreatePositionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculationCreatePositionResponse_messageType fastReflection_QueryCalculationCreatePositionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationCreatePositionResponse_messageType{}
+
+type fastReflection_QueryCalculationCreatePositionResponse_messageType struct{}
+
+func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationCreatePositionResponse)(nil)
+}
+func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationCreatePositionResponse)
+}
+func (x fastReflection_QueryCalculationCreatePositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationCreatePositionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationCreatePositionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationCreatePositionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationCreatePositionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationCreatePositionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QueryCalculationCreatePositionResponse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationCreatePositionResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationCreatePositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationCreatePositionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationCreatePositionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationCreatePositionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationCreatePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculationIncreaseLiquidityRequest           protoreflect.MessageDescriptor
+	fd_QueryCalculationIncreaseLiquidityRequest_id        protoreflect.FieldDescriptor
+	fd_QueryCalculationIncreaseLiquidityRequest_amount_in protoreflect.FieldDescriptor
+	fd_QueryCalculationIncreaseLiquidityRequest_denom_in  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryCalculationIncreaseLiquidityRequest = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationIncreaseLiquidityRequest")
+	fd_QueryCalculationIncreaseLiquidityRequest_id = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("id")
+	fd_QueryCalculationIncreaseLiquidityRequest_amount_in = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("amount_in")
+	fd_QueryCalculationIncreaseLiquidityRequest_denom_in = md_QueryCalculationIncreaseLiquidityRequest.Fields().ByName("denom_in")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(nil)
+
+type fastReflection_QueryCalculationIncreaseLiquidityRequest QueryCalculationIncreaseLiquidityRequest
+
+func (x *QueryCalculationIncreaseLiquidityRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(x)
+}
+
+func (x *QueryCalculationIncreaseLiquidityRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType{}
+
+type fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType struct{}
+
+func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationIncreaseLiquidityRequest)(nil)
+}
+func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationIncreaseLiquidityRequest)
+}
+func (x fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationIncreaseLiquidityRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationIncreaseLiquidityRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationIncreaseLiquidityRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationIncreaseLiquidityRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationIncreaseLiquidityRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryCalculationIncreaseLiquidityRequest_id, value) {
+			return
+		}
+	}
+	if x.AmountIn != "" {
+		value := protoreflect.ValueOfString(x.AmountIn)
+		if !f(fd_QueryCalculationIncreaseLiquidityRequest_amount_in, value) {
+			return
+		}
+	}
+	if x.DenomIn != "" {
+		value := protoreflect.ValueOfString(x.DenomIn)
+		if !f(fd_QueryCalculationIncreaseLiquidityRequest_denom_in, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		return x.Id != uint64(0)
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		return x.AmountIn != ""
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		return x.DenomIn != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		x.Id = uint64(0)
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		x.AmountIn = ""
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		x.DenomIn = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		value := x.AmountIn
+		return protoreflect.ValueOfString(value)
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		value := x.DenomIn
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		x.Id = value.Uint()
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		x.AmountIn = value.Interface().(string)
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		x.DenomIn = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		panic(fmt.Errorf("field id of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		panic(fmt.Errorf("field amount_in of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		panic(fmt.Errorf("field denom_in of message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.amount_in":
+		return protoreflect.ValueOfString("")
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest.denom_in":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.AmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DenomIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DenomIn) > 0 {
+			i -= len(x.DenomIn)
+			copy(dAtA[i:], x.DenomIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomIn)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AmountIn) > 0 {
+			i -= len(x.AmountIn)
+			copy(dAtA[i:], x.AmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculationIncreaseLiquidityResponse                protoreflect.MessageDescriptor
+	fd_QueryCalculationIncreaseLiquidityResponse_token_required protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_liquiditypool_query_proto_init()
+	md_QueryCalculationIncreaseLiquidityResponse = File_sunrise_liquiditypool_query_proto.Messages().ByName("QueryCalculationIncreaseLiquidityResponse")
+	fd_QueryCalculationIncreaseLiquidityResponse_token_required = md_QueryCalculationIncreaseLiquidityResponse.Fields().ByName("token_required")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(nil)
+
+type fastReflection_QueryCalculationIncreaseLiquidityResponse QueryCalculationIncreaseLiquidityResponse
+
+func (x *QueryCalculationIncreaseLiquidityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(x)
+}
+
+func (x *QueryCalculationIncreaseLiquidityResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_liquiditypool_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType{}
+
+type fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType struct{}
+
+func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationIncreaseLiquidityResponse)(nil)
+}
+func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationIncreaseLiquidityResponse)
+}
+func (x fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationIncreaseLiquidityResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationIncreaseLiquidityResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationIncreaseLiquidityResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationIncreaseLiquidityResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationIncreaseLiquidityResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TokenRequired != nil {
+		value := protoreflect.ValueOfMessage(x.TokenRequired.ProtoReflect())
+		if !f(fd_QueryCalculationIncreaseLiquidityResponse_token_required, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		return x.TokenRequired != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		x.TokenRequired = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		value := x.TokenRequired
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		x.TokenRequired = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		if x.TokenRequired == nil {
+			x.TokenRequired = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TokenRequired.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationIncreaseLiquidityResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TokenRequired != nil {
+			l = options.Size(x.TokenRequired)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TokenRequired != nil {
+			encoded, err := options.Marshal(x.TokenRequired)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationIncreaseLiquidityResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationIncreaseLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenRequired", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TokenRequired == nil {
+					x.TokenRequired = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenRequired); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: sunrise/liquiditypool/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryPoolRequest) Reset() {
+	*x = QueryPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryPoolRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PoolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool       *Pool         `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	TokenBase  *v1beta1.Coin `protobuf:"bytes,2,opt,name=token_base,json=tokenBase,proto3" json:"token_base,omitempty"`
+	TokenQuote *v1beta1.Coin `protobuf:"bytes,3,opt,name=token_quote,json=tokenQuote,proto3" json:"token_quote,omitempty"`
+}
+
+func (x *PoolInfo) Reset() {
+	*x = PoolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolInfo) ProtoMessage() {}
+
+// Deprecated: Use PoolInfo.ProtoReflect.Descriptor instead.
+func (*PoolInfo) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PoolInfo) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *PoolInfo) GetTokenBase() *v1beta1.Coin {
+	if x != nil {
+		return x.TokenBase
+	}
+	return nil
 }
 
 func (x *PoolInfo) GetTokenQuote() *v1beta1.Coin {
@@ -10623,526 +15516,944 @@ func (x *PoolInfo) GetTokenQuote() *v1beta1.Coin {
 	return nil
 }
 
-type QueryPoolResponse struct {
+type QueryPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool *PoolInfo `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (x *QueryPoolResponse) Reset() {
+	*x = QueryPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryPoolResponse) GetPool() *PoolInfo {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+type QueryPoolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPoolsRequest) Reset() {
+	*x = QueryPoolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryPoolsRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools      []*PoolInfo            `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPoolsResponse) Reset() {
+	*x = QueryPoolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryPoolsResponse) GetPools() []*PoolInfo {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *QueryPoolsResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsByDenomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DenomBase  string `protobuf:"bytes,1,opt,name=denom_base,json=denomBase,proto3" json:"denom_base,omitempty"`
+	DenomQuote string `protobuf:"bytes,2,opt,name=denom_quote,json=denomQuote,proto3" json:"denom_quote,omitempty"`
+}
+
+func (x *QueryPoolsByDenomsRequest) Reset() {
+	*x = QueryPoolsByDenomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolsByDenomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolsByDenomsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolsByDenomsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByDenomsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryPoolsByDenomsRequest) GetDenomBase() string {
+	if x != nil {
+		return x.DenomBase
+	}
+	return ""
+}
+
+func (x *QueryPoolsByDenomsRequest) GetDenomQuote() string {
+	if x != nil {
+		return x.DenomQuote
+	}
+	return ""
+}
+
+type QueryPoolsByDenomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools []*PoolInfo `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *QueryPoolsByDenomsResponse) Reset() {
+	*x = QueryPoolsByDenomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolsByDenomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolsByDenomsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolsByDenomsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByDenomsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPoolsByDenomsResponse) GetPools() []*PoolInfo {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type QueryPositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryPositionRequest) Reset() {
+	*x = QueryPositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPositionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPositionRequest.ProtoReflect.Descriptor instead.
+func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryPositionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PositionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position   *Position     `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	TokenBase  *v1beta1.Coin `protobuf:"bytes,2,opt,name=token_base,json=tokenBase,proto3" json:"token_base,omitempty"`
+	TokenQuote *v1beta1.Coin `protobuf:"bytes,3,opt,name=token_quote,json=tokenQuote,proto3" json:"token_quote,omitempty"`
+}
+
+func (x *PositionInfo) Reset() {
+	*x = PositionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionInfo) ProtoMessage() {}
+
+// Deprecated: Use PositionInfo.ProtoReflect.Descriptor instead.
+func (*PositionInfo) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PositionInfo) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PositionInfo) GetTokenBase() *v1beta1.Coin {
+	if x != nil {
+		return x.TokenBase
+	}
+	return nil
+}
+
+func (x *PositionInfo) GetTokenQuote() *v1beta1.Coin {
+	if x != nil {
+		return x.TokenQuote
+	}
+	return nil
+}
+
+type QueryPositionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool *PoolInfo `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Position *PositionInfo `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *QueryPositionResponse) Reset() {
+	*x = QueryPositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPositionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPositionResponse.ProtoReflect.Descriptor instead.
+func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryPositionResponse) GetPosition() *PositionInfo {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type QueryPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPositionsRequest) Reset() {
+	*x = QueryPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPositionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPositionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryPositionsRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions  []*PositionInfo        `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPositionsResponse) Reset() {
+	*x = QueryPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPositionsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPositionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryPositionsResponse) GetPositions() []*PositionInfo {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *QueryPositionsResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPoolPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (x *QueryPoolResponse) Reset() {
-	*x = QueryPoolResponse{}
+func (x *QueryPoolPositionsRequest) Reset() {
+	*x = QueryPoolPositionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[4]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolResponse) String() string {
+func (x *QueryPoolPositionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolResponse) ProtoMessage() {}
+func (*QueryPoolPositionsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolResponse.ProtoReflect.Descriptor instead.
-func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryPoolPositionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryPoolResponse) GetPool() *PoolInfo {
+func (x *QueryPoolPositionsRequest) GetPoolId() uint64 {
 	if x != nil {
-		return x.Pool
+		return x.PoolId
 	}
-	return nil
+	return 0
 }
 
-type QueryPoolsRequest struct {
+type QueryPoolPositionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Positions []*PositionInfo `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
 }
 
-func (x *QueryPoolsRequest) Reset() {
-	*x = QueryPoolsRequest{}
+func (x *QueryPoolPositionsResponse) Reset() {
+	*x = QueryPoolPositionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[5]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolsRequest) String() string {
+func (x *QueryPoolPositionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolsRequest) ProtoMessage() {}
+func (*QueryPoolPositionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryPoolPositionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryPoolsRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryPoolPositionsResponse) GetPositions() []*PositionInfo {
 	if x != nil {
-		return x.Pagination
+		return x.Positions
 	}
 	return nil
 }
 
-type QueryPoolsResponse struct {
+type QueryAddressPositionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pools      []*PoolInfo            `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryPoolsResponse) Reset() {
-	*x = QueryPoolsResponse{}
+func (x *QueryAddressPositionsRequest) Reset() {
+	*x = QueryAddressPositionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[6]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolsResponse) String() string {
+func (x *QueryAddressPositionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryPoolsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{6}
-}
+func (*QueryAddressPositionsRequest) ProtoMessage() {}
 
-func (x *QueryPoolsResponse) GetPools() []*PoolInfo {
-	if x != nil {
-		return x.Pools
-	}
-	return nil
+// Deprecated: Use QueryAddressPositionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryAddressPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryPoolsResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryAddressPositionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Pagination
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type QueryPositionRequest struct {
+type QueryAddressPositionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Positions []*PositionInfo `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
 }
 
-func (x *QueryPositionRequest) Reset() {
-	*x = QueryPositionRequest{}
+func (x *QueryAddressPositionsResponse) Reset() {
+	*x = QueryAddressPositionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[7]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionRequest) String() string {
+func (x *QueryAddressPositionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionRequest) ProtoMessage() {}
+func (*QueryAddressPositionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPositionRequest.ProtoReflect.Descriptor instead.
-func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryAddressPositionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryAddressPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryPositionRequest) GetId() uint64 {
+func (x *QueryAddressPositionsResponse) GetPositions() []*PositionInfo {
 	if x != nil {
-		return x.Id
+		return x.Positions
 	}
-	return 0
+	return nil
 }
 
-type PositionInfo struct {
+type QueryPositionFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position   *Position     `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	TokenBase  *v1beta1.Coin `protobuf:"bytes,2,opt,name=token_base,json=tokenBase,proto3" json:"token_base,omitempty"`
-	TokenQuote *v1beta1.Coin `protobuf:"bytes,3,opt,name=token_quote,json=tokenQuote,proto3" json:"token_quote,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PositionInfo) Reset() {
-	*x = PositionInfo{}
+func (x *QueryPositionFeesRequest) Reset() {
+	*x = QueryPositionFeesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[8]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PositionInfo) String() string {
+func (x *QueryPositionFeesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionInfo) ProtoMessage() {}
-
-// Deprecated: Use PositionInfo.ProtoReflect.Descriptor instead.
-func (*PositionInfo) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *PositionInfo) GetPosition() *Position {
-	if x != nil {
-		return x.Position
-	}
-	return nil
-}
+func (*QueryPositionFeesRequest) ProtoMessage() {}
 
-func (x *PositionInfo) GetTokenBase() *v1beta1.Coin {
-	if x != nil {
-		return x.TokenBase
-	}
-	return nil
+// Deprecated: Use QueryPositionFeesRequest.ProtoReflect.Descriptor instead.
+func (*QueryPositionFeesRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PositionInfo) GetTokenQuote() *v1beta1.Coin {
+func (x *QueryPositionFeesRequest) GetId() uint64 {
 	if x != nil {
-		return x.TokenQuote
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type QueryPositionResponse struct {
+type QueryPositionFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position *PositionInfo `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Fees []*v1beta1.Coin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees,omitempty"`
 }
 
-func (x *QueryPositionResponse) Reset() {
-	*x = QueryPositionResponse{}
+func (x *QueryPositionFeesResponse) Reset() {
+	*x = QueryPositionFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[9]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionResponse) String() string {
+func (x *QueryPositionFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionResponse) ProtoMessage() {}
+func (*QueryPositionFeesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPositionResponse.ProtoReflect.Descriptor instead.
-func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryPositionFeesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPositionFeesResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryPositionResponse) GetPosition() *PositionInfo {
+func (x *QueryPositionFeesResponse) GetFees() []*v1beta1.Coin {
 	if x != nil {
-		return x.Position
+		return x.Fees
 	}
 	return nil
 }
 
-type QueryPositionsRequest struct {
+type QueryLimitOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryPositionsRequest) Reset() {
-	*x = QueryPositionsRequest{}
+func (x *QueryLimitOrderRequest) Reset() {
+	*x = QueryLimitOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[10]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionsRequest) String() string {
+func (x *QueryLimitOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionsRequest) ProtoMessage() {}
+func (*QueryLimitOrderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPositionsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryLimitOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryPositionsRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryLimitOrderRequest) GetId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type QueryPositionsResponse struct {
+type QueryLimitOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Positions  []*PositionInfo        `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LimitOrder *LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
 }
 
-func (x *QueryPositionsResponse) Reset() {
-	*x = QueryPositionsResponse{}
+func (x *QueryLimitOrderResponse) Reset() {
+	*x = QueryLimitOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[11]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionsResponse) String() string {
+func (x *QueryLimitOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryPositionsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{11}
-}
+func (*QueryLimitOrderResponse) ProtoMessage() {}
 
-func (x *QueryPositionsResponse) GetPositions() []*PositionInfo {
-	if x != nil {
-		return x.Positions
-	}
-	return nil
+// Deprecated: Use QueryLimitOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryPositionsResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryLimitOrderResponse) GetLimitOrder() *LimitOrder {
 	if x != nil {
-		return x.Pagination
+		return x.LimitOrder
 	}
 	return nil
 }
 
-type QueryPoolPositionsRequest struct {
+// Orders are filtered by filled if only_open or only_filled is set
+type QueryPoolLimitOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	OnlyOpen   bool   `protobuf:"varint,2,opt,name=only_open,json=onlyOpen,proto3" json:"only_open,omitempty"`
+	OnlyFilled bool   `protobuf:"varint,3,opt,name=only_filled,json=onlyFilled,proto3" json:"only_filled,omitempty"`
 }
 
-func (x *QueryPoolPositionsRequest) Reset() {
-	*x = QueryPoolPositionsRequest{}
+func (x *QueryPoolLimitOrdersRequest) Reset() {
+	*x = QueryPoolLimitOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[12]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolPositionsRequest) String() string {
+func (x *QueryPoolLimitOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolPositionsRequest) ProtoMessage() {}
+func (*QueryPoolLimitOrdersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolPositionsRequest.ProtoReflect.Descriptor instead.
-func (*QueryPoolPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryPoolLimitOrdersRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryPoolPositionsRequest) GetPoolId() uint64 {
+func (x *QueryPoolLimitOrdersRequest) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-type QueryPoolPositionsResponse struct {
+func (x *QueryPoolLimitOrdersRequest) GetOnlyOpen() bool {
+	if x != nil {
+		return x.OnlyOpen
+	}
+	return false
+}
+
+func (x *QueryPoolLimitOrdersRequest) GetOnlyFilled() bool {
+	if x != nil {
+		return x.OnlyFilled
+	}
+	return false
+}
+
+type QueryPoolLimitOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Positions []*PositionInfo `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	LimitOrders []*LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders,omitempty"`
 }
 
-func (x *QueryPoolPositionsResponse) Reset() {
-	*x = QueryPoolPositionsResponse{}
+func (x *QueryPoolLimitOrdersResponse) Reset() {
+	*x = QueryPoolLimitOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[13]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPoolPositionsResponse) String() string {
+func (x *QueryPoolLimitOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPoolPositionsResponse) ProtoMessage() {}
+func (*QueryPoolLimitOrdersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPoolPositionsResponse.ProtoReflect.Descriptor instead.
-func (*QueryPoolPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryPoolLimitOrdersResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryPoolPositionsResponse) GetPositions() []*PositionInfo {
+func (x *QueryPoolLimitOrdersResponse) GetLimitOrders() []*LimitOrder {
 	if x != nil {
-		return x.Positions
+		return x.LimitOrders
 	}
 	return nil
 }
 
-type QueryAddressPositionsRequest struct {
+// Orders are filtered by filled if only_open or only_filled is set
+type QueryAddressLimitOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OnlyOpen   bool   `protobuf:"varint,2,opt,name=only_open,json=onlyOpen,proto3" json:"only_open,omitempty"`
+	OnlyFilled bool   `protobuf:"varint,3,opt,name=only_filled,json=onlyFilled,proto3" json:"only_filled,omitempty"`
 }
 
-func (x *QueryAddressPositionsRequest) Reset() {
-	*x = QueryAddressPositionsRequest{}
+func (x *QueryAddressLimitOrdersRequest) Reset() {
+	*x = QueryAddressLimitOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[14]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddressPositionsRequest) String() string {
+func (x *QueryAddressLimitOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
-
-func (*QueryAddressPositionsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAddressPositionsRequest.ProtoReflect.Descriptor instead.
-func (*QueryAddressPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{14}
+
+func (*QueryAddressLimitOrdersRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAddressLimitOrdersRequest.ProtoReflect.Descriptor instead.
+func (*QueryAddressLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryAddressPositionsRequest) GetAddress() string {
+func (x *QueryAddressLimitOrdersRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type QueryAddressPositionsResponse struct {
+func (x *QueryAddressLimitOrdersRequest) GetOnlyOpen() bool {
+	if x != nil {
+		return x.OnlyOpen
+	}
+	return false
+}
+
+func (x *QueryAddressLimitOrdersRequest) GetOnlyFilled() bool {
+	if x != nil {
+		return x.OnlyFilled
+	}
+	return false
+}
+
+type QueryAddressLimitOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Positions []*PositionInfo `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	LimitOrders []*LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders,omitempty"`
 }
 
-func (x *QueryAddressPositionsResponse) Reset() {
-	*x = QueryAddressPositionsResponse{}
+func (x *QueryAddressLimitOrdersResponse) Reset() {
+	*x = QueryAddressLimitOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[15]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddressPositionsResponse) String() string {
+func (x *QueryAddressLimitOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddressPositionsResponse) ProtoMessage() {}
+func (*QueryAddressLimitOrdersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAddressPositionsResponse.ProtoReflect.Descriptor instead.
-func (*QueryAddressPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryAddressLimitOrdersResponse.ProtoReflect.Descriptor instead.
+func (*QueryAddressLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryAddressPositionsResponse) GetPositions() []*PositionInfo {
+func (x *QueryAddressLimitOrdersResponse) GetLimitOrders() []*LimitOrder {
 	if x != nil {
-		return x.Positions
+		return x.LimitOrders
 	}
 	return nil
 }
 
-type QueryPositionFeesRequest struct {
+type QueryTwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId uint64                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Start  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *QueryPositionFeesRequest) Reset() {
-	*x = QueryPositionFeesRequest{}
+func (x *QueryTwapRequest) Reset() {
+	*x = QueryTwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[16]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionFeesRequest) String() string {
+func (x *QueryTwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionFeesRequest) ProtoMessage() {}
+func (*QueryTwapRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPositionFeesRequest.ProtoReflect.Descriptor instead.
-func (*QueryPositionFeesRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryTwapRequest.ProtoReflect.Descriptor instead.
+func (*QueryTwapRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryPositionFeesRequest) GetId() uint64 {
+func (x *QueryTwapRequest) GetPoolId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.PoolId
 	}
 	return 0
 }
 
-type QueryPositionFeesResponse struct {
+func (x *QueryTwapRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *QueryTwapRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type QueryTwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fees []*v1beta1.Coin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees,omitempty"`
+	// Geometric mean of the quote per base price over [start, end]
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// Arithmetic mean of the current tick liquidity over [start, end]
+	AverageLiquidity string `protobuf:"bytes,2,opt,name=average_liquidity,json=averageLiquidity,proto3" json:"average_liquidity,omitempty"`
 }
 
-func (x *QueryPositionFeesResponse) Reset() {
-	*x = QueryPositionFeesResponse{}
+func (x *QueryTwapResponse) Reset() {
+	*x = QueryTwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[17]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPositionFeesResponse) String() string {
+func (x *QueryTwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPositionFeesResponse) ProtoMessage() {}
+func (*QueryTwapResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPositionFeesResponse.ProtoReflect.Descriptor instead.
-func (*QueryPositionFeesResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryTwapResponse.ProtoReflect.Descriptor instead.
+func (*QueryTwapResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryPositionFeesResponse) GetFees() []*v1beta1.Coin {
+func (x *QueryTwapResponse) GetPrice() string {
 	if x != nil {
-		return x.Fees
+		return x.Price
 	}
-	return nil
+	return ""
+}
+
+func (x *QueryTwapResponse) GetAverageLiquidity() string {
+	if x != nil {
+		return x.AverageLiquidity
+	}
+	return ""
 }
 
 type QueryCalculationCreatePositionRequest struct {
@@ -11160,7 +16471,7 @@ type QueryCalculationCreatePositionRequest struct {
 func (x *QueryCalculationCreatePositionRequest) Reset() {
 	*x = QueryCalculationCreatePositionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[18]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11174,7 +16485,7 @@ func (*QueryCalculationCreatePositionRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculationCreatePositionRequest.ProtoReflect.Descriptor instead.
 func (*QueryCalculationCreatePositionRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{18}
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryCalculationCreatePositionRequest) GetPoolId() uint64 {
@@ -11223,7 +16534,7 @@ type QueryCalculationCreatePositionResponse struct {
 func (x *QueryCalculationCreatePositionResponse) Reset() {
 	*x = QueryCalculationCreatePositionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[19]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11237,7 +16548,7 @@ func (*QueryCalculationCreatePositionResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculationCreatePositionResponse.ProtoReflect.Descriptor instead.
 func (*QueryCalculationCreatePositionResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{19}
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryCalculationCreatePositionResponse) GetAmount() *v1beta1.Coin {
@@ -11260,7 +16571,7 @@ type QueryCalculationIncreaseLiquidityRequest struct {
 func (x *QueryCalculationIncreaseLiquidityRequest) Reset() {
 	*x = QueryCalculationIncreaseLiquidityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[20]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11274,7 +16585,7 @@ func (*QueryCalculationIncreaseLiquidityRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculationIncreaseLiquidityRequest.ProtoReflect.Descriptor instead.
 func (*QueryCalculationIncreaseLiquidityRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{20}
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryCalculationIncreaseLiquidityRequest) GetId() uint64 {
@@ -11309,7 +16620,7 @@ type QueryCalculationIncreaseLiquidityResponse struct {
 func (x *QueryCalculationIncreaseLiquidityResponse) Reset() {
 	*x = QueryCalculationIncreaseLiquidityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[21]
+		mi := &file_sunrise_liquiditypool_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11323,7 +16634,7 @@ func (*QueryCalculationIncreaseLiquidityResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculationIncreaseLiquidityResponse.ProtoReflect.Descriptor instead.
 func (*QueryCalculationIncreaseLiquidityResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{21}
+	return file_sunrise_liquiditypool_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryCalculationIncreaseLiquidityResponse) GetTokenRequired() *v1beta1.Coin {
@@ -11344,282 +16655,413 @@ var file_sunrise_liquiditypool_query_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x20, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x57, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x22, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x22, 0x0a,
+	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xc3, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35,
+	0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x61, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x5b, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x5b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42,
+	0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x59,
+	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x61, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
 	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc3, 0x01, 0x0a,
-	0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x04, 0x70, 0x6f, 0x6f,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
-	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
-	0x12, 0x3e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x38, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x68, 0x0a, 0x1d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x7c, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x22, 0x28, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22,
+	0x74, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x6e, 0x6c, 0x79,
+	0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x46,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x22, 0x78, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e,
+	0x6c, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x1f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xbc, 0x01, 0x0a,
+	0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0xac, 0x01, 0x0a, 0x25,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x61, 0x0a, 0x26, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f,
-	0x6f, 0x6c, 0x22, 0x5b, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x9a, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x6f,
-	0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a,
+	0x28, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x49,
+	0x6e, 0x22, 0x73, 0x0a, 0x29, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x32, 0x8f, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x86, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x04, 0x50, 0x6f,
+	0x6f, 0x6c, 0x12, 0x27, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x82, 0x01, 0x0a, 0x05, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42,
+	0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x15, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x16,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72,
 	0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f,
-	0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x65,
-	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x68, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
-	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x04, 0x66,
-	0x65, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72,
-	0x54, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x22, 0x61, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x28, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61,
-	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73,
-	0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x49, 0x6e, 0x22, 0x73, 0x0a, 0x29, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63,
-	0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x32, 0xb8,
-	0x0d, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x84, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x2e, 0x73, 0x75, 0x6e,
+	0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x2d, 0x62, 0x79, 0x2d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x94, 0x01, 0x0a,
+	0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6f,
+	0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x75, 0x6e,
 	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
-	0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f,
-	0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x05, 0x50, 0x6f, 0x6f,
-	0x6c, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
+	0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73,
 	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x94, 0x01,
-	0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
-	0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f,
 	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x0d, 0x50, 0x6f,
-	0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
-	0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c,
-	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x10, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x33, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x34, 0x12, 0x32, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x34, 0x12, 0x32, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x65, 0x65, 0x73, 0x12, 0xe5,
-	0x01, 0x0a, 0x19, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2c, 0x12, 0x2a, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x65, 0x65, 0x73, 0x12, 0x9d, 0x01,
+	0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x73,
 	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x73, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
-	0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x45, 0x12, 0x43, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f,
-	0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xf0, 0x01, 0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01,
+	0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x32, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x35, 0x12, 0x33, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0xc2, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x35,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x8e, 0x01, 0x0a,
+	0x04, 0x54, 0x77, 0x61, 0x70, 0x12, 0x27, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x12, 0x2b, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x77, 0x61, 0x70, 0x12, 0xe5, 0x01,
+	0x0a, 0x19, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45,
+	0x12, 0x43, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xf0, 0x01, 0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x47, 0x12, 0x45, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x5f,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0xc4, 0x01, 0x0a, 0x19, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0xa2, 0x02, 0x03,
-	0x53, 0x4c, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0xca, 0x02, 0x15, 0x53, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70,
-	0x6f, 0x6f, 0x6c, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x47, 0x12, 0x45, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0xc4, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0xa2, 0x02, 0x03, 0x53,
+	0x4c, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0xca, 0x02, 0x15, 0x53, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f,
+	0x6f, 0x6c, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x70, 0x6f, 0x6f, 0x6c, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11634,7 +17076,7 @@ func file_sunrise_liquiditypool_query_proto_rawDescGZIP() []byte {
 	return file_sunrise_liquiditypool_query_proto_rawDescData
 }
 
-var file_sunrise_liquiditypool_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_sunrise_liquiditypool_query_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_sunrise_liquiditypool_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                        // 0: sunrise.liquiditypool.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                       // 1: sunrise.liquiditypool.QueryParamsResponse
@@ -11643,74 +17085,102 @@ var file_sunrise_liquiditypool_query_proto_goTypes = []interface{}{
 	(*QueryPoolResponse)(nil),                         // 4: sunrise.liquiditypool.QueryPoolResponse
 	(*QueryPoolsRequest)(nil),                         // 5: sunrise.liquiditypool.QueryPoolsRequest
 	(*QueryPoolsResponse)(nil),                        // 6: sunrise.liquiditypool.QueryPoolsResponse
-	(*QueryPositionRequest)(nil),                      // 7: sunrise.liquiditypool.QueryPositionRequest
-	(*PositionInfo)(nil),                              // 8: sunrise.liquiditypool.PositionInfo
-	(*QueryPositionResponse)(nil),                     // 9: sunrise.liquiditypool.QueryPositionResponse
-	(*QueryPositionsRequest)(nil),                     // 10: sunrise.liquiditypool.QueryPositionsRequest
-	(*QueryPositionsResponse)(nil),                    // 11: sunrise.liquiditypool.QueryPositionsResponse
-	(*QueryPoolPositionsRequest)(nil),                 // 12: sunrise.liquiditypool.QueryPoolPositionsRequest
-	(*QueryPoolPositionsResponse)(nil),                // 13: sunrise.liquiditypool.QueryPoolPositionsResponse
-	(*QueryAddressPositionsRequest)(nil),              // 14: sunrise.liquiditypool.QueryAddressPositionsRequest
-	(*QueryAddressPositionsResponse)(nil),             // 15: sunrise.liquiditypool.QueryAddressPositionsResponse
-	(*QueryPositionFeesRequest)(nil),                  // 16: sunrise.liquiditypool.QueryPositionFeesRequest
-	(*QueryPositionFeesResponse)(nil),                 // 17: sunrise.liquiditypool.QueryPositionFeesResponse
-	(*QueryCalculationCreatePositionRequest)(nil),     // 18: sunrise.liquiditypool.QueryCalculationCreatePositionRequest
-	(*QueryCalculationCreatePositionResponse)(nil),    // 19: sunrise.liquiditypool.QueryCalculationCreatePositionResponse
-	(*QueryCalculationIncreaseLiquidityRequest)(nil),  // 20: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest
-	(*QueryCalculationIncreaseLiquidityResponse)(nil), // 21: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse
-	(*Params)(nil),                                    // 22: sunrise.liquiditypool.Params
-	(*Pool)(nil),                                      // 23: sunrise.liquiditypool.Pool
-	(*v1beta1.Coin)(nil),                              // 24: cosmos.base.v1beta1.Coin
-	(*v1beta11.PageRequest)(nil),                      // 25: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta11.PageResponse)(nil),                     // 26: cosmos.base.query.v1beta1.PageResponse
-	(*Position)(nil),                                  // 27: sunrise.liquiditypool.Position
+	(*QueryPoolsByDenomsRequest)(nil),                 // 7: sunrise.liquiditypool.QueryPoolsByDenomsRequest
+	(*QueryPoolsByDenomsResponse)(nil),                // 8: sunrise.liquiditypool.QueryPoolsByDenomsResponse
+	(*QueryPositionRequest)(nil),                      // 9: sunrise.liquiditypool.QueryPositionRequest
+	(*PositionInfo)(nil),                              // 10: sunrise.liquiditypool.PositionInfo
+	(*QueryPositionResponse)(nil),                     // 11: sunrise.liquiditypool.QueryPositionResponse
+	(*QueryPositionsRequest)(nil),                     // 12: sunrise.liquiditypool.QueryPositionsRequest
+	(*QueryPositionsResponse)(nil),                    // 13: sunrise.liquiditypool.QueryPositionsResponse
+	(*QueryPoolPositionsRequest)(nil),                 // 14: sunrise.liquiditypool.QueryPoolPositionsRequest
+	(*QueryPoolPositionsResponse)(nil),                // 15: sunrise.liquiditypool.QueryPoolPositionsResponse
+	(*QueryAddressPositionsRequest)(nil),              // 16: sunrise.liquiditypool.QueryAddressPositionsRequest
+	(*QueryAddressPositionsResponse)(nil),             // 17: sunrise.liquiditypool.QueryAddressPositionsResponse
+	(*QueryPositionFeesRequest)(nil),                  // 18: sunrise.liquiditypool.QueryPositionFeesRequest
+	(*QueryPositionFeesResponse)(nil),                 // 19: sunrise.liquiditypool.QueryPositionFeesResponse
+	(*QueryLimitOrderRequest)(nil),                    // 20: sunrise.liquiditypool.QueryLimitOrderRequest
+	(*QueryLimitOrderResponse)(nil),                   // 21: sunrise.liquiditypool.QueryLimitOrderResponse
+	(*QueryPoolLimitOrdersRequest)(nil),               // 22: sunrise.liquiditypool.QueryPoolLimitOrdersRequest
+	(*QueryPoolLimitOrdersResponse)(nil),              // 23: sunrise.liquiditypool.QueryPoolLimitOrdersResponse
+	(*QueryAddressLimitOrdersRequest)(nil),            // 24: sunrise.liquiditypool.QueryAddressLimitOrdersRequest
+	(*QueryAddressLimitOrdersResponse)(nil),           // 25: sunrise.liquiditypool.QueryAddressLimitOrdersResponse
+	(*QueryTwapRequest)(nil),                          // 26: sunrise.liquiditypool.QueryTwapRequest
+	(*QueryTwapResponse)(nil),                         // 27: sunrise.liquiditypool.QueryTwapResponse
+	(*QueryCalculationCreatePositionRequest)(nil),     // 28: sunrise.liquiditypool.QueryCalculationCreatePositionRequest
+	(*QueryCalculationCreatePositionResponse)(nil),    // 29: sunrise.liquiditypool.QueryCalculationCreatePositionResponse
+	(*QueryCalculationIncreaseLiquidityRequest)(nil),  // 30: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest
+	(*QueryCalculationIncreaseLiquidityResponse)(nil), // 31: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse
+	(*Params)(nil),                                    // 32: sunrise.liquiditypool.Params
+	(*Pool)(nil),                                      // 33: sunrise.liquiditypool.Pool
+	(*v1beta1.Coin)(nil),                              // 34: cosmos.base.v1beta1.Coin
+	(*v1beta11.PageRequest)(nil),                      // 35: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta11.PageResponse)(nil),                     // 36: cosmos.base.query.v1beta1.PageResponse
+	(*Position)(nil),                                  // 37: sunrise.liquiditypool.Position
+	(*LimitOrder)(nil),                                // 38: sunrise.liquiditypool.LimitOrder
+	(*timestamppb.Timestamp)(nil),                     // 39: google.protobuf.Timestamp
 }
 var file_sunrise_liquiditypool_query_proto_depIdxs = []int32{
-	22, // 0: sunrise.liquiditypool.QueryParamsResponse.params:type_name -> sunrise.liquiditypool.Params
-	23, // 1: sunrise.liquiditypool.PoolInfo.pool:type_name -> sunrise.liquiditypool.Pool
-	24, // 2: sunrise.liquiditypool.PoolInfo.token_base:type_name -> cosmos.base.v1beta1.Coin
-	24, // 3: sunrise.liquiditypool.PoolInfo.token_quote:type_name -> cosmos.base.v1beta1.Coin
+	32, // 0: sunrise.liquiditypool.QueryParamsResponse.params:type_name -> sunrise.liquiditypool.Params
+	33, // 1: sunrise.liquiditypool.PoolInfo.pool:type_name -> sunrise.liquiditypool.Pool
+	34, // 2: sunrise.liquiditypool.PoolInfo.token_base:type_name -> cosmos.base.v1beta1.Coin
+	34, // 3: sunrise.liquiditypool.PoolInfo.token_quote:type_name -> cosmos.base.v1beta1.Coin
 	3,  // 4: sunrise.liquiditypool.QueryPoolResponse.pool:type_name -> sunrise.liquiditypool.PoolInfo
-	25, // 5: sunrise.liquiditypool.QueryPoolsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	35, // 5: sunrise.liquiditypool.QueryPoolsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	3,  // 6: sunrise.liquiditypool.QueryPoolsResponse.pools:type_name -> sunrise.liquiditypool.PoolInfo
-	26, // 7: sunrise.liquiditypool.QueryPoolsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 8: sunrise.liquiditypool.PositionInfo.position:type_name -> sunrise.liquiditypool.Position
-	24, // 9: sunrise.liquiditypool.PositionInfo.token_base:type_name -> cosmos.base.v1beta1.Coin
-	24, // 10: sunrise.liquiditypool.PositionInfo.token_quote:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 11: sunrise.liquiditypool.QueryPositionResponse.position:type_name -> sunrise.liquiditypool.PositionInfo
-	25, // 12: sunrise.liquiditypool.QueryPositionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	8,  // 13: sunrise.liquiditypool.QueryPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
-	26, // 14: sunrise.liquiditypool.QueryPositionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	8,  // 15: sunrise.liquiditypool.QueryPoolPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
-	8,  // 16: sunrise.liquiditypool.QueryAddressPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
-	24, // 17: sunrise.liquiditypool.QueryPositionFeesResponse.fees:type_name -> cosmos.base.v1beta1.Coin
-	24, // 18: sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	24, // 19: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 20: sunrise.liquiditypool.Query.Params:input_type -> sunrise.liquiditypool.QueryParamsRequest
-	2,  // 21: sunrise.liquiditypool.Query.Pool:input_type -> sunrise.liquiditypool.QueryPoolRequest
-	5,  // 22: sunrise.liquiditypool.Query.Pools:input_type -> sunrise.liquiditypool.QueryPoolsRequest
-	7,  // 23: sunrise.liquiditypool.Query.Position:input_type -> sunrise.liquiditypool.QueryPositionRequest
-	10, // 24: sunrise.liquiditypool.Query.Positions:input_type -> sunrise.liquiditypool.QueryPositionsRequest
-	12, // 25: sunrise.liquiditypool.Query.PoolPositions:input_type -> sunrise.liquiditypool.QueryPoolPositionsRequest
-	14, // 26: sunrise.liquiditypool.Query.AddressPositions:input_type -> sunrise.liquiditypool.QueryAddressPositionsRequest
-	16, // 27: sunrise.liquiditypool.Query.PositionFees:input_type -> sunrise.liquiditypool.QueryPositionFeesRequest
-	18, // 28: sunrise.liquiditypool.Query.CalculationCreatePosition:input_type -> sunrise.liquiditypool.QueryCalculationCreatePositionRequest
-	20, // 29: sunrise.liquiditypool.Query.CalculationIncreaseLiquidity:input_type -> sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest
-	1,  // 30: sunrise.liquiditypool.Query.Params:output_type -> sunrise.liquiditypool.QueryParamsResponse
-	4,  // 31: sunrise.liquiditypool.Query.Pool:output_type -> sunrise.liquiditypool.QueryPoolResponse
-	6,  // 32: sunrise.liquiditypool.Query.Pools:output_type -> sunrise.liquiditypool.QueryPoolsResponse
-	9,  // 33: sunrise.liquiditypool.Query.Position:output_type -> sunrise.liquiditypool.QueryPositionResponse
-	11, // 34: sunrise.liquiditypool.Query.Positions:output_type -> sunrise.liquiditypool.QueryPositionsResponse
-	13, // 35: sunrise.liquiditypool.Query.PoolPositions:output_type -> sunrise.liquiditypool.QueryPoolPositionsResponse
-	15, // 36: sunrise.liquiditypool.Query.AddressPositions:output_type -> sunrise.liquiditypool.QueryAddressPositionsResponse
-	17, // 37: sunrise.liquiditypool.Query.PositionFees:output_type -> sunrise.liquiditypool.QueryPositionFeesResponse
-	19, // 38: sunrise.liquiditypool.Query.CalculationCreatePosition:output_type -> sunrise.liquiditypool.QueryCalculationCreatePositionResponse
-	21, // 39: sunrise.liquiditypool.Query.CalculationIncreaseLiquidity:output_type -> sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse
-	30, // [30:40] is the sub-list for method output_type
-	20, // [20:30] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	36, // 7: sunrise.liquiditypool.QueryPoolsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	3,  // 8: sunrise.liquiditypool.QueryPoolsByDenomsResponse.pools:type_name -> sunrise.liquiditypool.PoolInfo
+	37, // 9: sunrise.liquiditypool.PositionInfo.position:type_name -> sunrise.liquiditypool.Position
+	34, // 10: sunrise.liquiditypool.PositionInfo.token_base:type_name -> cosmos.base.v1beta1.Coin
+	34, // 11: sunrise.liquiditypool.PositionInfo.token_quote:type_name -> cosmos.base.v1beta1.Coin
+	10, // 12: sunrise.liquiditypool.QueryPositionResponse.position:type_name -> sunrise.liquiditypool.PositionInfo
+	35, // 13: sunrise.liquiditypool.QueryPositionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	10, // 14: sunrise.liquiditypool.QueryPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
+	36, // 15: sunrise.liquiditypool.QueryPositionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	10, // 16: sunrise.liquiditypool.QueryPoolPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
+	10, // 17: sunrise.liquiditypool.QueryAddressPositionsResponse.positions:type_name -> sunrise.liquiditypool.PositionInfo
+	34, // 18: sunrise.liquiditypool.QueryPositionFeesResponse.fees:type_name -> cosmos.base.v1beta1.Coin
+	38, // 19: sunrise.liquiditypool.QueryLimitOrderResponse.limit_order:type_name -> sunrise.liquiditypool.LimitOrder
+	38, // 20: sunrise.liquiditypool.QueryPoolLimitOrdersResponse.limit_orders:type_name -> sunrise.liquiditypool.LimitOrder
+	38, // 21: sunrise.liquiditypool.QueryAddressLimitOrdersResponse.limit_orders:type_name -> sunrise.liquiditypool.LimitOrder
+	39, // 22: sunrise.liquiditypool.QueryTwapRequest.start:type_name -> google.protobuf.Timestamp
+	39, // 23: sunrise.liquiditypool.QueryTwapRequest.end:type_name -> google.protobuf.Timestamp
+	34, // 24: sunrise.liquiditypool.QueryCalculationCreatePositionResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	34, // 25: sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse.token_required:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 26: sunrise.liquiditypool.Query.Params:input_type -> sunrise.liquiditypool.QueryParamsRequest
+	2,  // 27: sunrise.liquiditypool.Query.Pool:input_type -> sunrise.liquiditypool.QueryPoolRequest
+	5,  // 28: sunrise.liquiditypool.Query.Pools:input_type -> sunrise.liquiditypool.QueryPoolsRequest
+	7,  // 29: sunrise.liquiditypool.Query.PoolsByDenoms:input_type -> sunrise.liquiditypool.QueryPoolsByDenomsRequest
+	9,  // 30: sunrise.liquiditypool.Query.Position:input_type -> sunrise.liquiditypool.QueryPositionRequest
+	12, // 31: sunrise.liquiditypool.Query.Positions:input_type -> sunrise.liquiditypool.QueryPositionsRequest
+	14, // 32: sunrise.liquiditypool.Query.PoolPositions:input_type -> sunrise.liquiditypool.QueryPoolPositionsRequest
+	16, // 33: sunrise.liquiditypool.Query.AddressPositions:input_type -> sunrise.liquiditypool.QueryAddressPositionsRequest
+	18, // 34: sunrise.liquiditypool.Query.PositionFees:input_type -> sunrise.liquiditypool.QueryPositionFeesRequest
+	20, // 35: sunrise.liquiditypool.Query.LimitOrder:input_type -> sunrise.liquiditypool.QueryLimitOrderRequest
+	22, // 36: sunrise.liquiditypool.Query.PoolLimitOrders:input_type -> sunrise.liquiditypool.QueryPoolLimitOrdersRequest
+	24, // 37: sunrise.liquiditypool.Query.AddressLimitOrders:input_type -> sunrise.liquiditypool.QueryAddressLimitOrdersRequest
+	26, // 38: sunrise.liquiditypool.Query.Twap:input_type -> sunrise.liquiditypool.QueryTwapRequest
+	28, // 39: sunrise.liquiditypool.Query.CalculationCreatePosition:input_type -> sunrise.liquiditypool.QueryCalculationCreatePositionRequest
+	30, // 40: sunrise.liquiditypool.Query.CalculationIncreaseLiquidity:input_type -> sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest
+	1,  // 41: sunrise.liquiditypool.Query.Params:output_type -> sunrise.liquiditypool.QueryParamsResponse
+	4,  // 42: sunrise.liquiditypool.Query.Pool:output_type -> sunrise.liquiditypool.QueryPoolResponse
+	6,  // 43: sunrise.liquiditypool.Query.Pools:output_type -> sunrise.liquiditypool.QueryPoolsResponse
+	8,  // 44: sunrise.liquiditypool.Query.PoolsByDenoms:output_type -> sunrise.liquiditypool.QueryPoolsByDenomsResponse
+	11, // 45: sunrise.liquiditypool.Query.Position:output_type -> sunrise.liquiditypool.QueryPositionResponse
+	13, // 46: sunrise.liquiditypool.Query.Positions:output_type -> sunrise.liquiditypool.QueryPositionsResponse
+	15, // 47: sunrise.liquiditypool.Query.PoolPositions:output_type -> sunrise.liquiditypool.QueryPoolPositionsResponse
+	17, // 48: sunrise.liquiditypool.Query.AddressPositions:output_type -> sunrise.liquiditypool.QueryAddressPositionsResponse
+	19, // 49: sunrise.liquiditypool.Query.PositionFees:output_type -> sunrise.liquiditypool.QueryPositionFeesResponse
+	21, // 50: sunrise.liquiditypool.Query.LimitOrder:output_type -> sunrise.liquiditypool.QueryLimitOrderResponse
+	23, // 51: sunrise.liquiditypool.Query.PoolLimitOrders:output_type -> sunrise.liquiditypool.QueryPoolLimitOrdersResponse
+	25, // 52: sunrise.liquiditypool.Query.AddressLimitOrders:output_type -> sunrise.liquiditypool.QueryAddressLimitOrdersResponse
+	27, // 53: sunrise.liquiditypool.Query.Twap:output_type -> sunrise.liquiditypool.QueryTwapResponse
+	29, // 54: sunrise.liquiditypool.Query.CalculationCreatePosition:output_type -> sunrise.liquiditypool.QueryCalculationCreatePositionResponse
+	31, // 55: sunrise.liquiditypool.Query.CalculationIncreaseLiquidity:output_type -> sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse
+	41, // [41:56] is the sub-list for method output_type
+	26, // [26:41] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_liquiditypool_query_proto_init() }
@@ -11718,6 +17188,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 	if File_sunrise_liquiditypool_query_proto != nil {
 		return
 	}
+	file_sunrise_liquiditypool_limit_order_proto_init()
 	file_sunrise_liquiditypool_params_proto_init()
 	file_sunrise_liquiditypool_pool_proto_init()
 	file_sunrise_liquiditypool_position_proto_init()
@@ -11807,7 +17278,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionRequest); i {
+			switch v := v.(*QueryPoolsByDenomsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11819,7 +17290,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PositionInfo); i {
+			switch v := v.(*QueryPoolsByDenomsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11831,7 +17302,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionResponse); i {
+			switch v := v.(*QueryPositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11843,7 +17314,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionsRequest); i {
+			switch v := v.(*PositionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11855,7 +17326,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionsResponse); i {
+			switch v := v.(*QueryPositionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11867,7 +17338,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolPositionsRequest); i {
+			switch v := v.(*QueryPositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11879,7 +17350,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPoolPositionsResponse); i {
+			switch v := v.(*QueryPositionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11891,7 +17362,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddressPositionsRequest); i {
+			switch v := v.(*QueryPoolPositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11903,7 +17374,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddressPositionsResponse); i {
+			switch v := v.(*QueryPoolPositionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11915,7 +17386,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionFeesRequest); i {
+			switch v := v.(*QueryAddressPositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11927,7 +17398,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryPositionFeesResponse); i {
+			switch v := v.(*QueryAddressPositionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11939,7 +17410,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationCreatePositionRequest); i {
+			switch v := v.(*QueryPositionFeesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11951,7 +17422,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationCreatePositionResponse); i {
+			switch v := v.(*QueryPositionFeesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11963,7 +17434,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationIncreaseLiquidityRequest); i {
+			switch v := v.(*QueryLimitOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11975,6 +17446,126 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			}
 		}
 		file_sunrise_liquiditypool_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLimitOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolLimitOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolLimitOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddressLimitOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddressLimitOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCalculationCreatePositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCalculationCreatePositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCalculationIncreaseLiquidityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_liquiditypool_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryCalculationIncreaseLiquidityResponse); i {
 			case 0:
 				return &v.state
@@ -11993,7 +17584,7 @@ func file_sunrise_liquiditypool_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sunrise_liquiditypool_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
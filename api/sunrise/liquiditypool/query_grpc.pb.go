@@ -22,11 +22,16 @@ const (
 	Query_Params_FullMethodName                       = "/sunrise.liquiditypool.Query/Params"
 	Query_Pool_FullMethodName                         = "/sunrise.liquiditypool.Query/Pool"
 	Query_Pools_FullMethodName                        = "/sunrise.liquiditypool.Query/Pools"
+	Query_PoolsByDenoms_FullMethodName                = "/sunrise.liquiditypool.Query/PoolsByDenoms"
 	Query_Position_FullMethodName                     = "/sunrise.liquiditypool.Query/Position"
 	Query_Positions_FullMethodName                    = "/sunrise.liquiditypool.Query/Positions"
 	Query_PoolPositions_FullMethodName                = "/sunrise.liquiditypool.Query/PoolPositions"
 	Query_AddressPositions_FullMethodName             = "/sunrise.liquiditypool.Query/AddressPositions"
 	Query_PositionFees_FullMethodName                 = "/sunrise.liquiditypool.Query/PositionFees"
+	Query_LimitOrder_FullMethodName                   = "/sunrise.liquiditypool.Query/LimitOrder"
+	Query_PoolLimitOrders_FullMethodName              = "/sunrise.liquiditypool.Query/PoolLimitOrders"
+	Query_AddressLimitOrders_FullMethodName           = "/sunrise.liquiditypool.Query/AddressLimitOrders"
+	Query_Twap_FullMethodName                         = "/sunrise.liquiditypool.Query/Twap"
 	Query_CalculationCreatePosition_FullMethodName    = "/sunrise.liquiditypool.Query/CalculationCreatePosition"
 	Query_CalculationIncreaseLiquidity_FullMethodName = "/sunrise.liquiditypool.Query/CalculationIncreaseLiquidity"
 )
@@ -40,6 +45,7 @@ type QueryClient interface {
 	// Queries a list of Pool items.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	PoolsByDenoms(ctx context.Context, in *QueryPoolsByDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomsResponse, error)
 	// Queries a list of Position items.
 	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
 	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
@@ -47,6 +53,12 @@ type QueryClient interface {
 	AddressPositions(ctx context.Context, in *QueryAddressPositionsRequest, opts ...grpc.CallOption) (*QueryAddressPositionsResponse, error)
 	// Query fees by position id
 	PositionFees(ctx context.Context, in *QueryPositionFeesRequest, opts ...grpc.CallOption) (*QueryPositionFeesResponse, error)
+	// Queries a list of LimitOrder items.
+	LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error)
+	PoolLimitOrders(ctx context.Context, in *QueryPoolLimitOrdersRequest, opts ...grpc.CallOption) (*QueryPoolLimitOrdersResponse, error)
+	AddressLimitOrders(ctx context.Context, in *QueryAddressLimitOrdersRequest, opts ...grpc.CallOption) (*QueryAddressLimitOrdersResponse, error)
+	// Queries the time weighted average price of a pool
+	Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
 	// Query calculation another amount of create position
 	CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error)
 	// Query calculation another amount of increase liquidity
@@ -88,6 +100,15 @@ func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) PoolsByDenoms(ctx context.Context, in *QueryPoolsByDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomsResponse, error) {
+	out := new(QueryPoolsByDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_PoolsByDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
 	out := new(QueryPositionResponse)
 	err := c.cc.Invoke(ctx, Query_Position_FullMethodName, in, out, opts...)
@@ -133,6 +154,42 @@ func (c *queryClient) PositionFees(ctx context.Context, in *QueryPositionFeesReq
 	return out, nil
 }
 
+func (c *queryClient) LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error) {
+	out := new(QueryLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Query_LimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolLimitOrders(ctx context.Context, in *QueryPoolLimitOrdersRequest, opts ...grpc.CallOption) (*QueryPoolLimitOrdersResponse, error) {
+	out := new(QueryPoolLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, Query_PoolLimitOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressLimitOrders(ctx context.Context, in *QueryAddressLimitOrdersRequest, opts ...grpc.CallOption) (*QueryAddressLimitOrdersResponse, error) {
+	out := new(QueryAddressLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, Query_AddressLimitOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, Query_Twap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error) {
 	out := new(QueryCalculationCreatePositionResponse)
 	err := c.cc.Invoke(ctx, Query_CalculationCreatePosition_FullMethodName, in, out, opts...)
@@ -160,6 +217,7 @@ type QueryServer interface {
 	// Queries a list of Pool items.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	PoolsByDenoms(context.Context, *QueryPoolsByDenomsRequest) (*QueryPoolsByDenomsResponse, error)
 	// Queries a list of Position items.
 	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
 	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
@@ -167,6 +225,12 @@ type QueryServer interface {
 	AddressPositions(context.Context, *QueryAddressPositionsRequest) (*QueryAddressPositionsResponse, error)
 	// Query fees by position id
 	PositionFees(context.Context, *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error)
+	// Queries a list of LimitOrder items.
+	LimitOrder(context.Context, *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error)
+	PoolLimitOrders(context.Context, *QueryPoolLimitOrdersRequest) (*QueryPoolLimitOrdersResponse, error)
+	AddressLimitOrders(context.Context, *QueryAddressLimitOrdersRequest) (*QueryAddressLimitOrdersResponse, error)
+	// Queries the time weighted average price of a pool
+	Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
 	// Query calculation another amount of create position
 	CalculationCreatePosition(context.Context, *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error)
 	// Query calculation another amount of increase liquidity
@@ -187,6 +251,9 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
+func (UnimplementedQueryServer) PoolsByDenoms(context.Context, *QueryPoolsByDenomsRequest) (*QueryPoolsByDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByDenoms not implemented")
+}
 func (UnimplementedQueryServer) Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
 }
@@ -202,6 +269,18 @@ func (UnimplementedQueryServer) AddressPositions(context.Context, *QueryAddressP
 func (UnimplementedQueryServer) PositionFees(context.Context, *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PositionFees not implemented")
 }
+func (UnimplementedQueryServer) LimitOrder(context.Context, *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrder not implemented")
+}
+func (UnimplementedQueryServer) PoolLimitOrders(context.Context, *QueryPoolLimitOrdersRequest) (*QueryPoolLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolLimitOrders not implemented")
+}
+func (UnimplementedQueryServer) AddressLimitOrders(context.Context, *QueryAddressLimitOrdersRequest) (*QueryAddressLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressLimitOrders not implemented")
+}
+func (UnimplementedQueryServer) Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twap not implemented")
+}
 func (UnimplementedQueryServer) CalculationCreatePosition(context.Context, *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculationCreatePosition not implemented")
 }
@@ -275,6 +354,24 @@ func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolsByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolsByDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByDenoms(ctx, req.(*QueryPoolsByDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPositionRequest)
 	if err := dec(in); err != nil {
@@ -365,6 +462,78 @@ func _Query_PositionFees_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrder(ctx, req.(*QueryLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolLimitOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolLimitOrders(ctx, req.(*QueryPoolLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressLimitOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressLimitOrders(ctx, req.(*QueryAddressLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Twap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Twap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Twap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculationCreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCalculationCreatePositionRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +589,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pools",
 			Handler:    _Query_Pools_Handler,
 		},
+		{
+			MethodName: "PoolsByDenoms",
+			Handler:    _Query_PoolsByDenoms_Handler,
+		},
 		{
 			MethodName: "Position",
 			Handler:    _Query_Position_Handler,
@@ -440,6 +613,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PositionFees",
 			Handler:    _Query_PositionFees_Handler,
 		},
+		{
+			MethodName: "LimitOrder",
+			Handler:    _Query_LimitOrder_Handler,
+		},
+		{
+			MethodName: "PoolLimitOrders",
+			Handler:    _Query_PoolLimitOrders_Handler,
+		},
+		{
+			MethodName: "AddressLimitOrders",
+			Handler:    _Query_AddressLimitOrders_Handler,
+		},
+		{
+			MethodName: "Twap",
+			Handler:    _Query_Twap_Handler,
+		},
 		{
 			MethodName: "CalculationCreatePosition",
 			Handler:    _Query_CalculationCreatePosition_Handler,
@@ -25,6 +25,9 @@ const (
 	Msg_IncreaseLiquidity_FullMethodName = "/sunrise.liquiditypool.Msg/IncreaseLiquidity"
 	Msg_DecreaseLiquidity_FullMethodName = "/sunrise.liquiditypool.Msg/DecreaseLiquidity"
 	Msg_ClaimRewards_FullMethodName      = "/sunrise.liquiditypool.Msg/ClaimRewards"
+	Msg_PlaceLimitOrder_FullMethodName   = "/sunrise.liquiditypool.Msg/PlaceLimitOrder"
+	Msg_CancelLimitOrder_FullMethodName  = "/sunrise.liquiditypool.Msg/CancelLimitOrder"
+	Msg_ClaimLimitOrder_FullMethodName   = "/sunrise.liquiditypool.Msg/ClaimLimitOrder"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,6 +42,9 @@ type MsgClient interface {
 	IncreaseLiquidity(ctx context.Context, in *MsgIncreaseLiquidity, opts ...grpc.CallOption) (*MsgIncreaseLiquidityResponse, error)
 	DecreaseLiquidity(ctx context.Context, in *MsgDecreaseLiquidity, opts ...grpc.CallOption) (*MsgDecreaseLiquidityResponse, error)
 	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	ClaimLimitOrder(ctx context.Context, in *MsgClaimLimitOrder, opts ...grpc.CallOption) (*MsgClaimLimitOrderResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +109,33 @@ func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts
 	return out, nil
 }
 
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_PlaceLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimLimitOrder(ctx context.Context, in *MsgClaimLimitOrder, opts ...grpc.CallOption) (*MsgClaimLimitOrderResponse, error) {
+	out := new(MsgClaimLimitOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimLimitOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -115,6 +148,9 @@ type MsgServer interface {
 	IncreaseLiquidity(context.Context, *MsgIncreaseLiquidity) (*MsgIncreaseLiquidityResponse, error)
 	DecreaseLiquidity(context.Context, *MsgDecreaseLiquidity) (*MsgDecreaseLiquidityResponse, error)
 	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	ClaimLimitOrder(context.Context, *MsgClaimLimitOrder) (*MsgClaimLimitOrderResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -140,6 +176,15 @@ func (UnimplementedMsgServer) DecreaseLiquidity(context.Context, *MsgDecreaseLiq
 func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
+func (UnimplementedMsgServer) PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (UnimplementedMsgServer) ClaimLimitOrder(context.Context, *MsgClaimLimitOrder) (*MsgClaimLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLimitOrder not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -261,6 +306,60 @@ func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlaceLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLimitOrder(ctx, req.(*MsgClaimLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -292,6 +391,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRewards",
 			Handler:    _Msg_ClaimRewards_Handler,
 		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "ClaimLimitOrder",
+			Handler:    _Msg_ClaimLimitOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquiditypool/tx.proto",
@@ -0,0 +1,1290 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package swap
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventOutgoingInFlightPacketRetried                   protoreflect.MessageDescriptor
+	fd_EventOutgoingInFlightPacketRetried_index             protoreflect.FieldDescriptor
+	fd_EventOutgoingInFlightPacketRetried_previous_index    protoreflect.FieldDescriptor
+	fd_EventOutgoingInFlightPacketRetried_timeout_timestamp protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_events_proto_init()
+	md_EventOutgoingInFlightPacketRetried = File_sunrise_swap_events_proto.Messages().ByName("EventOutgoingInFlightPacketRetried")
+	fd_EventOutgoingInFlightPacketRetried_index = md_EventOutgoingInFlightPacketRetried.Fields().ByName("index")
+	fd_EventOutgoingInFlightPacketRetried_previous_index = md_EventOutgoingInFlightPacketRetried.Fields().ByName("previous_index")
+	fd_EventOutgoingInFlightPacketRetried_timeout_timestamp = md_EventOutgoingInFlightPacketRetried.Fields().ByName("timeout_timestamp")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventOutgoingInFlightPacketRetried)(nil)
+
+type fastReflection_EventOutgoingInFlightPacketRetried EventOutgoingInFlightPacketRetried
+
+func (x *EventOutgoingInFlightPacketRetried) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventOutgoingInFlightPacketRetried)(x)
+}
+
+func (x *EventOutgoingInFlightPacketRetried) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventOutgoingInFlightPacketRetried_messageType fastReflection_EventOutgoingInFlightPacketRetried_messageType
+var _ protoreflect.MessageType = fastReflection_EventOutgoingInFlightPacketRetried_messageType{}
+
+type fastReflection_EventOutgoingInFlightPacketRetried_messageType struct{}
+
+func (x fastReflection_EventOutgoingInFlightPacketRetried_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventOutgoingInFlightPacketRetried)(nil)
+}
+func (x fastReflection_EventOutgoingInFlightPacketRetried_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventOutgoingInFlightPacketRetried)
+}
+func (x fastReflection_EventOutgoingInFlightPacketRetried_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventOutgoingInFlightPacketRetried
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventOutgoingInFlightPacketRetried
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Type() protoreflect.MessageType {
+	return _fastReflection_EventOutgoingInFlightPacketRetried_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) New() protoreflect.Message {
+	return new(fastReflection_EventOutgoingInFlightPacketRetried)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Interface() protoreflect.ProtoMessage {
+	return (*EventOutgoingInFlightPacketRetried)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != nil {
+		value := protoreflect.ValueOfMessage(x.Index.ProtoReflect())
+		if !f(fd_EventOutgoingInFlightPacketRetried_index, value) {
+			return
+		}
+	}
+	if x.PreviousIndex != nil {
+		value := protoreflect.ValueOfMessage(x.PreviousIndex.ProtoReflect())
+		if !f(fd_EventOutgoingInFlightPacketRetried_previous_index, value) {
+			return
+		}
+	}
+	if x.TimeoutTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutTimestamp)
+		if !f(fd_EventOutgoingInFlightPacketRetried_timeout_timestamp, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		return x.Index != nil
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		return x.PreviousIndex != nil
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		return x.TimeoutTimestamp != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		x.Index = nil
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		x.PreviousIndex = nil
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		x.TimeoutTimestamp = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		value := x.Index
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		value := x.PreviousIndex
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		value := x.TimeoutTimestamp
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		x.Index = value.Message().Interface().(*PacketIndex)
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		x.PreviousIndex = value.Message().Interface().(*PacketIndex)
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		x.TimeoutTimestamp = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		if x.Index == nil {
+			x.Index = new(PacketIndex)
+		}
+		return protoreflect.ValueOfMessage(x.Index.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		if x.PreviousIndex == nil {
+			x.PreviousIndex = new(PacketIndex)
+		}
+		return protoreflect.ValueOfMessage(x.PreviousIndex.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		panic(fmt.Errorf("field timeout_timestamp of message sunrise.swap.EventOutgoingInFlightPacketRetried is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.index":
+		m := new(PacketIndex)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index":
+		m := new(PacketIndex)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.EventOutgoingInFlightPacketRetried.timeout_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventOutgoingInFlightPacketRetried"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventOutgoingInFlightPacketRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.EventOutgoingInFlightPacketRetried", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventOutgoingInFlightPacketRetried) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventOutgoingInFlightPacketRetried)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != nil {
+			l = options.Size(x.Index)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PreviousIndex != nil {
+			l = options.Size(x.PreviousIndex)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutTimestamp))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventOutgoingInFlightPacketRetried)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TimeoutTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutTimestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousIndex != nil {
+			encoded, err := options.Marshal(x.PreviousIndex)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != nil {
+			encoded, err := options.Marshal(x.Index)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventOutgoingInFlightPacketRetried)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventOutgoingInFlightPacketRetried: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventOutgoingInFlightPacketRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Index == nil {
+					x.Index = &PacketIndex{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Index); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousIndex", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PreviousIndex == nil {
+					x.PreviousIndex = &PacketIndex{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousIndex); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				}
+				x.TimeoutTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventIncomingInFlightPacketExpired                protoreflect.MessageDescriptor
+	fd_EventIncomingInFlightPacketExpired_index          protoreflect.FieldDescriptor
+	fd_EventIncomingInFlightPacketExpired_created_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_events_proto_init()
+	md_EventIncomingInFlightPacketExpired = File_sunrise_swap_events_proto.Messages().ByName("EventIncomingInFlightPacketExpired")
+	fd_EventIncomingInFlightPacketExpired_index = md_EventIncomingInFlightPacketExpired.Fields().ByName("index")
+	fd_EventIncomingInFlightPacketExpired_created_height = md_EventIncomingInFlightPacketExpired.Fields().ByName("created_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventIncomingInFlightPacketExpired)(nil)
+
+type fastReflection_EventIncomingInFlightPacketExpired EventIncomingInFlightPacketExpired
+
+func (x *EventIncomingInFlightPacketExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventIncomingInFlightPacketExpired)(x)
+}
+
+func (x *EventIncomingInFlightPacketExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventIncomingInFlightPacketExpired_messageType fastReflection_EventIncomingInFlightPacketExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventIncomingInFlightPacketExpired_messageType{}
+
+type fastReflection_EventIncomingInFlightPacketExpired_messageType struct{}
+
+func (x fastReflection_EventIncomingInFlightPacketExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventIncomingInFlightPacketExpired)(nil)
+}
+func (x fastReflection_EventIncomingInFlightPacketExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventIncomingInFlightPacketExpired)
+}
+func (x fastReflection_EventIncomingInFlightPacketExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventIncomingInFlightPacketExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventIncomingInFlightPacketExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventIncomingInFlightPacketExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) New() protoreflect.Message {
+	return new(fastReflection_EventIncomingInFlightPacketExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventIncomingInFlightPacketExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != nil {
+		value := protoreflect.ValueOfMessage(x.Index.ProtoReflect())
+		if !f(fd_EventIncomingInFlightPacketExpired_index, value) {
+			return
+		}
+	}
+	if x.CreatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedHeight)
+		if !f(fd_EventIncomingInFlightPacketExpired_created_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		return x.Index != nil
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		return x.CreatedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		x.Index = nil
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		x.CreatedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		value := x.Index
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		value := x.CreatedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		x.Index = value.Message().Interface().(*PacketIndex)
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		x.CreatedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		if x.Index == nil {
+			x.Index = new(PacketIndex)
+		}
+		return protoreflect.ValueOfMessage(x.Index.ProtoReflect())
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		panic(fmt.Errorf("field created_height of message sunrise.swap.EventIncomingInFlightPacketExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.index":
+		m := new(PacketIndex)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.EventIncomingInFlightPacketExpired.created_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.EventIncomingInFlightPacketExpired"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.EventIncomingInFlightPacketExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.EventIncomingInFlightPacketExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventIncomingInFlightPacketExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventIncomingInFlightPacketExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != nil {
+			l = options.Size(x.Index)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventIncomingInFlightPacketExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Index != nil {
+			encoded, err := options.Marshal(x.Index)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventIncomingInFlightPacketExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventIncomingInFlightPacketExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventIncomingInFlightPacketExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Index == nil {
+					x.Index = &PacketIndex{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Index); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				}
+				x.CreatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: sunrise/swap/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventOutgoingInFlightPacketRetried struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index            *PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	PreviousIndex    *PacketIndex `protobuf:"bytes,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	TimeoutTimestamp uint64       `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (x *EventOutgoingInFlightPacketRetried) Reset() {
+	*x = EventOutgoingInFlightPacketRetried{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventOutgoingInFlightPacketRetried) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOutgoingInFlightPacketRetried) ProtoMessage() {}
+
+// Deprecated: Use EventOutgoingInFlightPacketRetried.ProtoReflect.Descriptor instead.
+func (*EventOutgoingInFlightPacketRetried) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventOutgoingInFlightPacketRetried) GetIndex() *PacketIndex {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *EventOutgoingInFlightPacketRetried) GetPreviousIndex() *PacketIndex {
+	if x != nil {
+		return x.PreviousIndex
+	}
+	return nil
+}
+
+func (x *EventOutgoingInFlightPacketRetried) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+type EventIncomingInFlightPacketExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         *PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	CreatedHeight int64        `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (x *EventIncomingInFlightPacketExpired) Reset() {
+	*x = EventIncomingInFlightPacketExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventIncomingInFlightPacketExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventIncomingInFlightPacketExpired) ProtoMessage() {}
+
+// Deprecated: Use EventIncomingInFlightPacketExpired.ProtoReflect.Descriptor instead.
+func (*EventIncomingInFlightPacketExpired) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventIncomingInFlightPacketExpired) GetIndex() *PacketIndex {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *EventIncomingInFlightPacketExpired) GetCreatedHeight() int64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+var File_sunrise_swap_events_proto protoreflect.FileDescriptor
+
+var file_sunrise_swap_events_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x23, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e,
+	0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x22, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x46, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x82, 0x01, 0x0a, 0x22, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x35,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x8f, 0x01, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2,
+	0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53,
+	0x77, 0x61, 0x70, 0xe2, 0x02, 0x18, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77,
+	0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sunrise_swap_events_proto_rawDescOnce sync.Once
+	file_sunrise_swap_events_proto_rawDescData = file_sunrise_swap_events_proto_rawDesc
+)
+
+func file_sunrise_swap_events_proto_rawDescGZIP() []byte {
+	file_sunrise_swap_events_proto_rawDescOnce.Do(func() {
+		file_sunrise_swap_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_sunrise_swap_events_proto_rawDescData)
+	})
+	return file_sunrise_swap_events_proto_rawDescData
+}
+
+var file_sunrise_swap_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sunrise_swap_events_proto_goTypes = []interface{}{
+	(*EventOutgoingInFlightPacketRetried)(nil), // 0: sunrise.swap.EventOutgoingInFlightPacketRetried
+	(*EventIncomingInFlightPacketExpired)(nil), // 1: sunrise.swap.EventIncomingInFlightPacketExpired
+	(*PacketIndex)(nil),                        // 2: sunrise.swap.PacketIndex
+}
+var file_sunrise_swap_events_proto_depIdxs = []int32{
+	2, // 0: sunrise.swap.EventOutgoingInFlightPacketRetried.index:type_name -> sunrise.swap.PacketIndex
+	2, // 1: sunrise.swap.EventOutgoingInFlightPacketRetried.previous_index:type_name -> sunrise.swap.PacketIndex
+	2, // 2: sunrise.swap.EventIncomingInFlightPacketExpired.index:type_name -> sunrise.swap.PacketIndex
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sunrise_swap_events_proto_init() }
+func file_sunrise_swap_events_proto_init() {
+	if File_sunrise_swap_events_proto != nil {
+		return
+	}
+	file_sunrise_swap_in_flight_packet_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_sunrise_swap_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventOutgoingInFlightPacketRetried); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventIncomingInFlightPacketExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sunrise_swap_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sunrise_swap_events_proto_goTypes,
+		DependencyIndexes: file_sunrise_swap_events_proto_depIdxs,
+		MessageInfos:      file_sunrise_swap_events_proto_msgTypes,
+	}.Build()
+	File_sunrise_swap_events_proto = out.File
+	file_sunrise_swap_events_proto_rawDesc = nil
+	file_sunrise_swap_events_proto_goTypes = nil
+	file_sunrise_swap_events_proto_depIdxs = nil
+}
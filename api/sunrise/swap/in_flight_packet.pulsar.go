@@ -562,6 +562,7 @@ var (
 	fd_IncomingInFlightPacket_ack_change             protoreflect.FieldDescriptor
 	fd_IncomingInFlightPacket_outgoing_index_forward protoreflect.FieldDescriptor
 	fd_IncomingInFlightPacket_ack_forward            protoreflect.FieldDescriptor
+	fd_IncomingInFlightPacket_created_height         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -580,6 +581,7 @@ func init() {
 	fd_IncomingInFlightPacket_ack_change = md_IncomingInFlightPacket.Fields().ByName("ack_change")
 	fd_IncomingInFlightPacket_outgoing_index_forward = md_IncomingInFlightPacket.Fields().ByName("outgoing_index_forward")
 	fd_IncomingInFlightPacket_ack_forward = md_IncomingInFlightPacket.Fields().ByName("ack_forward")
+	fd_IncomingInFlightPacket_created_height = md_IncomingInFlightPacket.Fields().ByName("created_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_IncomingInFlightPacket)(nil)
@@ -733,6 +735,12 @@ func (x *fastReflection_IncomingInFlightPacket) Range(f func(protoreflect.FieldD
 			}
 		}
 	}
+	if x.CreatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedHeight)
+		if !f(fd_IncomingInFlightPacket_created_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -798,6 +806,8 @@ func (x *fastReflection_IncomingInFlightPacket) Has(fd protoreflect.FieldDescrip
 		} else {
 			return false
 		}
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		return x.CreatedHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -840,6 +850,8 @@ func (x *fastReflection_IncomingInFlightPacket) Clear(fd protoreflect.FieldDescr
 		x.Forward = nil
 	case "sunrise.swap.IncomingInFlightPacket.ack_forward":
 		x.Forward = nil
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		x.CreatedHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -915,6 +927,9 @@ func (x *fastReflection_IncomingInFlightPacket) Get(descriptor protoreflect.Fiel
 		} else {
 			return protoreflect.ValueOfBytes(nil)
 		}
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		value := x.CreatedHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -965,6 +980,8 @@ func (x *fastReflection_IncomingInFlightPacket) Set(fd protoreflect.FieldDescrip
 	case "sunrise.swap.IncomingInFlightPacket.ack_forward":
 		cv := value.Bytes()
 		x.Forward = &IncomingInFlightPacket_AckForward{AckForward: cv}
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		x.CreatedHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -1045,6 +1062,8 @@ func (x *fastReflection_IncomingInFlightPacket) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field ack_change of message sunrise.swap.IncomingInFlightPacket is not mutable"))
 	case "sunrise.swap.IncomingInFlightPacket.ack_forward":
 		panic(fmt.Errorf("field ack_forward of message sunrise.swap.IncomingInFlightPacket is not mutable"))
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		panic(fmt.Errorf("field created_height of message sunrise.swap.IncomingInFlightPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -1088,6 +1107,8 @@ func (x *fastReflection_IncomingInFlightPacket) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	case "sunrise.swap.IncomingInFlightPacket.ack_forward":
 		return protoreflect.ValueOfBytes(nil)
+	case "sunrise.swap.IncomingInFlightPacket.created_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.IncomingInFlightPacket"))
@@ -1240,6 +1261,9 @@ func (x *fastReflection_IncomingInFlightPacket) ProtoMethods() *protoiface.Metho
 			l = len(x.AckForward)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.CreatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1311,6 +1335,11 @@ func (x *fastReflection_IncomingInFlightPacket) ProtoMethods() *protoiface.Metho
 			i--
 			dAtA[i] = 0x5a
 		}
+		if x.CreatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedHeight))
+			i--
+			dAtA[i] = 0x70
+		}
 		if len(x.InterfaceFee) > 0 {
 			i -= len(x.InterfaceFee)
 			copy(dAtA[i:], x.InterfaceFee)
@@ -1858,6 +1887,25 @@ func (x *fastReflection_IncomingInFlightPacket) ProtoMethods() *protoiface.Metho
 				copy(v, dAtA[iNdEx:postIndex])
 				x.Forward = &IncomingInFlightPacket_AckForward{v}
 				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				}
+				x.CreatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1898,6 +1946,7 @@ var (
 	fd_OutgoingInFlightPacket_index             protoreflect.FieldDescriptor
 	fd_OutgoingInFlightPacket_ack_waiting_index protoreflect.FieldDescriptor
 	fd_OutgoingInFlightPacket_retries_remaining protoreflect.FieldDescriptor
+	fd_OutgoingInFlightPacket_resent_count      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1906,6 +1955,7 @@ func init() {
 	fd_OutgoingInFlightPacket_index = md_OutgoingInFlightPacket.Fields().ByName("index")
 	fd_OutgoingInFlightPacket_ack_waiting_index = md_OutgoingInFlightPacket.Fields().ByName("ack_waiting_index")
 	fd_OutgoingInFlightPacket_retries_remaining = md_OutgoingInFlightPacket.Fields().ByName("retries_remaining")
+	fd_OutgoingInFlightPacket_resent_count = md_OutgoingInFlightPacket.Fields().ByName("resent_count")
 }
 
 var _ protoreflect.Message = (*fastReflection_OutgoingInFlightPacket)(nil)
@@ -1991,6 +2041,12 @@ func (x *fastReflection_OutgoingInFlightPacket) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.ResentCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ResentCount)
+		if !f(fd_OutgoingInFlightPacket_resent_count, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2012,6 +2068,8 @@ func (x *fastReflection_OutgoingInFlightPacket) Has(fd protoreflect.FieldDescrip
 		return x.AckWaitingIndex != nil
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		return x.RetriesRemaining != int32(0)
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		return x.ResentCount != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2034,6 +2092,8 @@ func (x *fastReflection_OutgoingInFlightPacket) Clear(fd protoreflect.FieldDescr
 		x.AckWaitingIndex = nil
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		x.RetriesRemaining = int32(0)
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		x.ResentCount = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2059,6 +2119,9 @@ func (x *fastReflection_OutgoingInFlightPacket) Get(descriptor protoreflect.Fiel
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		value := x.RetriesRemaining
 		return protoreflect.ValueOfInt32(value)
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		value := x.ResentCount
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2085,6 +2148,8 @@ func (x *fastReflection_OutgoingInFlightPacket) Set(fd protoreflect.FieldDescrip
 		x.AckWaitingIndex = value.Message().Interface().(*PacketIndex)
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		x.RetriesRemaining = int32(value.Int())
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		x.ResentCount = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2117,6 +2182,8 @@ func (x *fastReflection_OutgoingInFlightPacket) Mutable(fd protoreflect.FieldDes
 		return protoreflect.ValueOfMessage(x.AckWaitingIndex.ProtoReflect())
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		panic(fmt.Errorf("field retries_remaining of message sunrise.swap.OutgoingInFlightPacket is not mutable"))
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		panic(fmt.Errorf("field resent_count of message sunrise.swap.OutgoingInFlightPacket is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2138,6 +2205,8 @@ func (x *fastReflection_OutgoingInFlightPacket) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "sunrise.swap.OutgoingInFlightPacket.retries_remaining":
 		return protoreflect.ValueOfInt32(int32(0))
+	case "sunrise.swap.OutgoingInFlightPacket.resent_count":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.OutgoingInFlightPacket"))
@@ -2218,6 +2287,9 @@ func (x *fastReflection_OutgoingInFlightPacket) ProtoMethods() *protoiface.Metho
 		if x.RetriesRemaining != 0 {
 			n += 1 + runtime.Sov(uint64(x.RetriesRemaining))
 		}
+		if x.ResentCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResentCount))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2247,6 +2319,11 @@ func (x *fastReflection_OutgoingInFlightPacket) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ResentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResentCount))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.RetriesRemaining != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetriesRemaining))
 			i--
@@ -2420,6 +2497,25 @@ func (x *fastReflection_OutgoingInFlightPacket) ProtoMethods() *protoiface.Metho
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResentCount", wireType)
+				}
+				x.ResentCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResentCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2534,15 +2630,15 @@ type IncomingInFlightPacket struct {
 	Result           *RouteResult `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
 	InterfaceFee     string       `protobuf:"bytes,9,opt,name=interface_fee,json=interfaceFee,proto3" json:"interface_fee,omitempty"`
 	// Types that are assignable to Change:
-	//
 	//	*IncomingInFlightPacket_OutgoingIndexChange
 	//	*IncomingInFlightPacket_AckChange
 	Change isIncomingInFlightPacket_Change `protobuf_oneof:"change"`
 	// Types that are assignable to Forward:
-	//
 	//	*IncomingInFlightPacket_OutgoingIndexForward
 	//	*IncomingInFlightPacket_AckForward
 	Forward isIncomingInFlightPacket_Forward `protobuf_oneof:"forward"`
+	// Height of the block in which the packet was received
+	CreatedHeight int64 `protobuf:"varint,14,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
 }
 
 func (x *IncomingInFlightPacket) Reset() {
@@ -2670,6 +2766,13 @@ func (x *IncomingInFlightPacket) GetAckForward() []byte {
 	return nil
 }
 
+func (x *IncomingInFlightPacket) GetCreatedHeight() int64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
 type isIncomingInFlightPacket_Change interface {
 	isIncomingInFlightPacket_Change()
 }
@@ -2710,6 +2813,8 @@ type OutgoingInFlightPacket struct {
 	Index            *PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 	AckWaitingIndex  *PacketIndex `protobuf:"bytes,2,opt,name=ack_waiting_index,json=ackWaitingIndex,proto3" json:"ack_waiting_index,omitempty"`
 	RetriesRemaining int32        `protobuf:"varint,3,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// Number of times the packet has been resent
+	ResentCount uint32 `protobuf:"varint,4,opt,name=resent_count,json=resentCount,proto3" json:"resent_count,omitempty"`
 }
 
 func (x *OutgoingInFlightPacket) Reset() {
@@ -2753,6 +2858,13 @@ func (x *OutgoingInFlightPacket) GetRetriesRemaining() int32 {
 	return 0
 }
 
+func (x *OutgoingInFlightPacket) GetResentCount() uint32 {
+	if x != nil {
+		return x.ResentCount
+	}
+	return 0
+}
+
 var File_sunrise_swap_in_flight_packet_proto protoreflect.FileDescriptor
 
 var file_sunrise_swap_in_flight_packet_proto_rawDesc = []byte{
@@ -2771,7 +2883,7 @@ var file_sunrise_swap_in_flight_packet_proto_rawDesc = []byte{
 	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
 	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
 	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x22, 0xa6, 0x05, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0xcd, 0x05, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
 	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
 	0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61,
@@ -2812,32 +2924,36 @@ var file_sunrise_swap_in_flight_packet_proto_rawDesc = []byte{
 	0x14, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x46, 0x6f,
 	0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x6f, 0x72,
 	0x77, 0x61, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63,
-	0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0xd3, 0x01,
-	0x0a, 0x16, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x50, 0x0a, 0x11, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e,
-	0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
-	0x69, 0x6e, 0x67, 0x42, 0x97, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x13, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02,
-	0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x53,
-	0x77, 0x61, 0x70, 0xca, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0xe2, 0x02, 0x18, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61,
-	0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d,
-	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x50, 0x0a, 0x11, 0x61,
+	0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x61, 0x63,
+	0x6b, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x97, 0x01,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x42, 0x13, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02,
+	0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x0c,
+	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x18, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
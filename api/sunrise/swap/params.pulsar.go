@@ -16,14 +16,24 @@ import (
 )
 
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_interface_fee_rate protoreflect.FieldDescriptor
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_interface_fee_rate       protoreflect.FieldDescriptor
+	fd_Params_retry_count              protoreflect.FieldDescriptor
+	fd_Params_packet_timeout_seconds   protoreflect.FieldDescriptor
+	fd_Params_retry_timeout_multiplier protoreflect.FieldDescriptor
+	fd_Params_max_in_flight_blocks     protoreflect.FieldDescriptor
+	fd_Params_max_interface_fee_rate   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_params_proto_init()
 	md_Params = File_sunrise_swap_params_proto.Messages().ByName("Params")
 	fd_Params_interface_fee_rate = md_Params.Fields().ByName("interface_fee_rate")
+	fd_Params_retry_count = md_Params.Fields().ByName("retry_count")
+	fd_Params_packet_timeout_seconds = md_Params.Fields().ByName("packet_timeout_seconds")
+	fd_Params_retry_timeout_multiplier = md_Params.Fields().ByName("retry_timeout_multiplier")
+	fd_Params_max_in_flight_blocks = md_Params.Fields().ByName("max_in_flight_blocks")
+	fd_Params_max_interface_fee_rate = md_Params.Fields().ByName("max_interface_fee_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -97,6 +107,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.RetryCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetryCount)
+		if !f(fd_Params_retry_count, value) {
+			return
+		}
+	}
+	if x.PacketTimeoutSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketTimeoutSeconds)
+		if !f(fd_Params_packet_timeout_seconds, value) {
+			return
+		}
+	}
+	if x.RetryTimeoutMultiplier != "" {
+		value := protoreflect.ValueOfString(x.RetryTimeoutMultiplier)
+		if !f(fd_Params_retry_timeout_multiplier, value) {
+			return
+		}
+	}
+	if x.MaxInFlightBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxInFlightBlocks)
+		if !f(fd_Params_max_in_flight_blocks, value) {
+			return
+		}
+	}
+	if x.MaxInterfaceFeeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxInterfaceFeeRate)
+		if !f(fd_Params_max_interface_fee_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -114,6 +154,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "sunrise.swap.Params.interface_fee_rate":
 		return x.InterfaceFeeRate != ""
+	case "sunrise.swap.Params.retry_count":
+		return x.RetryCount != uint32(0)
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		return x.PacketTimeoutSeconds != uint64(0)
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		return x.RetryTimeoutMultiplier != ""
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		return x.MaxInFlightBlocks != uint64(0)
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		return x.MaxInterfaceFeeRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -132,6 +182,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "sunrise.swap.Params.interface_fee_rate":
 		x.InterfaceFeeRate = ""
+	case "sunrise.swap.Params.retry_count":
+		x.RetryCount = uint32(0)
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		x.PacketTimeoutSeconds = uint64(0)
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		x.RetryTimeoutMultiplier = ""
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		x.MaxInFlightBlocks = uint64(0)
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		x.MaxInterfaceFeeRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -151,6 +211,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "sunrise.swap.Params.interface_fee_rate":
 		value := x.InterfaceFeeRate
 		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.Params.retry_count":
+		value := x.RetryCount
+		return protoreflect.ValueOfUint32(value)
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		value := x.PacketTimeoutSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		value := x.RetryTimeoutMultiplier
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		value := x.MaxInFlightBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		value := x.MaxInterfaceFeeRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -173,6 +248,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "sunrise.swap.Params.interface_fee_rate":
 		x.InterfaceFeeRate = value.Interface().(string)
+	case "sunrise.swap.Params.retry_count":
+		x.RetryCount = uint32(value.Uint())
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		x.PacketTimeoutSeconds = value.Uint()
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		x.RetryTimeoutMultiplier = value.Interface().(string)
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		x.MaxInFlightBlocks = value.Uint()
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		x.MaxInterfaceFeeRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -195,6 +280,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "sunrise.swap.Params.interface_fee_rate":
 		panic(fmt.Errorf("field interface_fee_rate of message sunrise.swap.Params is not mutable"))
+	case "sunrise.swap.Params.retry_count":
+		panic(fmt.Errorf("field retry_count of message sunrise.swap.Params is not mutable"))
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		panic(fmt.Errorf("field packet_timeout_seconds of message sunrise.swap.Params is not mutable"))
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		panic(fmt.Errorf("field retry_timeout_multiplier of message sunrise.swap.Params is not mutable"))
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		panic(fmt.Errorf("field max_in_flight_blocks of message sunrise.swap.Params is not mutable"))
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		panic(fmt.Errorf("field max_interface_fee_rate of message sunrise.swap.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -210,6 +305,16 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "sunrise.swap.Params.interface_fee_rate":
 		return protoreflect.ValueOfString("")
+	case "sunrise.swap.Params.retry_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "sunrise.swap.Params.packet_timeout_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.swap.Params.retry_timeout_multiplier":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.Params.max_in_flight_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.swap.Params.max_interface_fee_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.Params"))
@@ -283,6 +388,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RetryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryCount))
+		}
+		if x.PacketTimeoutSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketTimeoutSeconds))
+		}
+		l = len(x.RetryTimeoutMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxInFlightBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxInFlightBlocks))
+		}
+		l = len(x.MaxInterfaceFeeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -312,6 +434,35 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxInterfaceFeeRate) > 0 {
+			i -= len(x.MaxInterfaceFeeRate)
+			copy(dAtA[i:], x.MaxInterfaceFeeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxInterfaceFeeRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxInFlightBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxInFlightBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.RetryTimeoutMultiplier) > 0 {
+			i -= len(x.RetryTimeoutMultiplier)
+			copy(dAtA[i:], x.RetryTimeoutMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetryTimeoutMultiplier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.PacketTimeoutSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketTimeoutSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RetryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryCount))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.InterfaceFeeRate) > 0 {
 			i -= len(x.InterfaceFeeRate)
 			copy(dAtA[i:], x.InterfaceFeeRate)
@@ -400,6 +551,127 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.InterfaceFeeRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+				}
+				x.RetryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutSeconds", wireType)
+				}
+				x.PacketTimeoutSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketTimeoutSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryTimeoutMultiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetryTimeoutMultiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightBlocks", wireType)
+				}
+				x.MaxInFlightBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxInFlightBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInterfaceFeeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxInterfaceFeeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -455,6 +727,20 @@ type Params struct {
 	unknownFields protoimpl.UnknownFields
 
 	InterfaceFeeRate string `protobuf:"bytes,1,opt,name=interface_fee_rate,json=interfaceFeeRate,proto3" json:"interface_fee_rate,omitempty"`
+	// Number of times a change or forward packet is sent when its metadata does
+	// not set the retries
+	RetryCount uint32 `protobuf:"varint,2,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Timeout in seconds of a change or forward packet when it is first sent
+	PacketTimeoutSeconds uint64 `protobuf:"varint,3,opt,name=packet_timeout_seconds,json=packetTimeoutSeconds,proto3" json:"packet_timeout_seconds,omitempty"`
+	// Factor by which the timeout of a change or forward packet grows each time
+	// it is resent
+	RetryTimeoutMultiplier string `protobuf:"bytes,4,opt,name=retry_timeout_multiplier,json=retryTimeoutMultiplier,proto3" json:"retry_timeout_multiplier,omitempty"`
+	// Number of blocks after which an incoming packet still waiting for its
+	// change or forward is acknowledged by the EndBlocker. Zero disables it.
+	MaxInFlightBlocks uint64 `protobuf:"varint,5,opt,name=max_in_flight_blocks,json=maxInFlightBlocks,proto3" json:"max_in_flight_blocks,omitempty"`
+	// Maximum interface fee rate, capping the rates approved for the registered
+	// interface providers
+	MaxInterfaceFeeRate string `protobuf:"bytes,6,opt,name=max_interface_fee_rate,json=maxInterfaceFeeRate,proto3" json:"max_interface_fee_rate,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -484,6 +770,41 @@ func (x *Params) GetInterfaceFeeRate() string {
 	return ""
 }
 
+func (x *Params) GetRetryCount() uint32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *Params) GetPacketTimeoutSeconds() uint64 {
+	if x != nil {
+		return x.PacketTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetRetryTimeoutMultiplier() string {
+	if x != nil {
+		return x.RetryTimeoutMultiplier
+	}
+	return ""
+}
+
+func (x *Params) GetMaxInFlightBlocks() uint64 {
+	if x != nil {
+		return x.MaxInFlightBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxInterfaceFeeRate() string {
+	if x != nil {
+		return x.MaxInterfaceFeeRate
+	}
+	return ""
+}
+
 var File_sunrise_swap_params_proto protoreflect.FileDescriptor
 
 var file_sunrise_swap_params_proto_rawDesc = []byte{
@@ -493,26 +814,48 @@ var file_sunrise_swap_params_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x03,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x64, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
 	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x1e,
-	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x8f,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73,
-	0x77, 0x61, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61,
-	0x70, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x18, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c,
-	0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x18, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x16, 0x72, 0x65, 0x74, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x6b, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x46, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x15,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x8f, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02,
+	0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x0c,
+	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x18, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2784,29 +2784,27 @@ func (x *fastReflection_QueryIncomingInFlightPacketsResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryOutgoingInFlightPacketRequest                protoreflect.MessageDescriptor
-	fd_QueryOutgoingInFlightPacketRequest_src_port_id    protoreflect.FieldDescriptor
-	fd_QueryOutgoingInFlightPacketRequest_src_channel_id protoreflect.FieldDescriptor
-	fd_QueryOutgoingInFlightPacketRequest_sequence       protoreflect.FieldDescriptor
+	md_QueryPendingIncomingInFlightPacketsRequest                    protoreflect.MessageDescriptor
+	fd_QueryPendingIncomingInFlightPacketsRequest_min_pending_blocks protoreflect.FieldDescriptor
+	fd_QueryPendingIncomingInFlightPacketsRequest_pagination         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryOutgoingInFlightPacketRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketRequest")
-	fd_QueryOutgoingInFlightPacketRequest_src_port_id = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("src_port_id")
-	fd_QueryOutgoingInFlightPacketRequest_src_channel_id = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("src_channel_id")
-	fd_QueryOutgoingInFlightPacketRequest_sequence = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("sequence")
+	md_QueryPendingIncomingInFlightPacketsRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryPendingIncomingInFlightPacketsRequest")
+	fd_QueryPendingIncomingInFlightPacketsRequest_min_pending_blocks = md_QueryPendingIncomingInFlightPacketsRequest.Fields().ByName("min_pending_blocks")
+	fd_QueryPendingIncomingInFlightPacketsRequest_pagination = md_QueryPendingIncomingInFlightPacketsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPendingIncomingInFlightPacketsRequest)(nil)
 
-type fastReflection_QueryOutgoingInFlightPacketRequest QueryOutgoingInFlightPacketRequest
+type fastReflection_QueryPendingIncomingInFlightPacketsRequest QueryPendingIncomingInFlightPacketsRequest
 
-func (x *QueryOutgoingInFlightPacketRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketRequest)(x)
+func (x *QueryPendingIncomingInFlightPacketsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingIncomingInFlightPacketsRequest)(x)
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryPendingIncomingInFlightPacketsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2818,43 +2816,43 @@ func (x *QueryOutgoingInFlightPacketRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutgoingInFlightPacketRequest_messageType fastReflection_QueryOutgoingInFlightPacketRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketRequest_messageType{}
+var _fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType{}
 
-type fastReflection_QueryOutgoingInFlightPacketRequest_messageType struct{}
+type fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType struct{}
 
-func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketRequest)(nil)
+func (x fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingIncomingInFlightPacketsRequest)(nil)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketRequest)
+func (x fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingIncomingInFlightPacketsRequest)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketRequest
+func (x fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingIncomingInFlightPacketsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketRequest
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingIncomingInFlightPacketsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutgoingInFlightPacketRequest_messageType
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingIncomingInFlightPacketsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketRequest)
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingIncomingInFlightPacketsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutgoingInFlightPacketRequest)(x)
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingIncomingInFlightPacketsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2862,22 +2860,16 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SrcPortId != "" {
-		value := protoreflect.ValueOfString(x.SrcPortId)
-		if !f(fd_QueryOutgoingInFlightPacketRequest_src_port_id, value) {
-			return
-		}
-	}
-	if x.SrcChannelId != "" {
-		value := protoreflect.ValueOfString(x.SrcChannelId)
-		if !f(fd_QueryOutgoingInFlightPacketRequest_src_channel_id, value) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinPendingBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinPendingBlocks)
+		if !f(fd_QueryPendingIncomingInFlightPacketsRequest_min_pending_blocks, value) {
 			return
 		}
 	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_QueryOutgoingInFlightPacketRequest_sequence, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPendingIncomingInFlightPacketsRequest_pagination, value) {
 			return
 		}
 	}
@@ -2894,19 +2886,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		return x.SrcPortId != ""
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		return x.SrcChannelId != ""
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
-		return x.Sequence != uint64(0)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
+		return x.MinPendingBlocks != uint64(0)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2916,19 +2906,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		x.SrcPortId = ""
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		x.SrcChannelId = ""
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
-		x.Sequence = uint64(0)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
+		x.MinPendingBlocks = uint64(0)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2938,22 +2926,19 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		value := x.SrcPortId
-		return protoreflect.ValueOfString(value)
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		value := x.SrcChannelId
-		return protoreflect.ValueOfString(value)
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
-		value := x.Sequence
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
+		value := x.MinPendingBlocks
 		return protoreflect.ValueOfUint64(value)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2967,19 +2952,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		x.SrcPortId = value.Interface().(string)
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		x.SrcChannelId = value.Interface().(string)
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
-		x.Sequence = value.Uint()
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
+		x.MinPendingBlocks = value.Uint()
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2993,48 +2976,48 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		panic(fmt.Errorf("field src_port_id of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		panic(fmt.Errorf("field src_channel_id of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
-		panic(fmt.Errorf("field sequence of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
+		panic(fmt.Errorf("field min_pending_blocks of message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
-		return protoreflect.ValueOfString("")
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
-		return protoreflect.ValueOfString("")
-	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.min_pending_blocks":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryPendingIncomingInFlightPacketsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3042,7 +3025,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3053,7 +3036,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3065,7 +3048,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) IsValid() bool {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3075,9 +3058,9 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3089,17 +3072,13 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.SrcPortId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MinPendingBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinPendingBlocks))
 		}
-		l = len(x.SrcChannelId)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3110,7 +3089,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3129,24 +3108,24 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.SrcChannelId) > 0 {
-			i -= len(x.SrcChannelId)
-			copy(dAtA[i:], x.SrcChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcChannelId)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.SrcPortId) > 0 {
-			i -= len(x.SrcPortId)
-			copy(dAtA[i:], x.SrcPortId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcPortId)))
+		if x.MinPendingBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinPendingBlocks))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3159,7 +3138,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3191,17 +3170,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPendingBlocks", wireType)
 				}
-				var stringLen uint64
+				x.MinPendingBlocks = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3211,29 +3190,16 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MinPendingBlocks |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SrcPortId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3243,43 +3209,28 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SrcChannelId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				x.Sequence = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3315,74 +3266,127 @@ func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *prot
 	}
 }
 
-var (
-	md_QueryOutgoingInFlightPacketResponse        protoreflect.MessageDescriptor
-	fd_QueryOutgoingInFlightPacketResponse_packet protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryPendingIncomingInFlightPacketsResponse_1_list)(nil)
 
-func init() {
-	file_sunrise_swap_query_proto_init()
-	md_QueryOutgoingInFlightPacketResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketResponse")
-	fd_QueryOutgoingInFlightPacketResponse_packet = md_QueryOutgoingInFlightPacketResponse.Fields().ByName("packet")
+type _QueryPendingIncomingInFlightPacketsResponse_1_list struct {
+	list *[]*IncomingInFlightPacket
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketResponse)(nil)
-
-type fastReflection_QueryOutgoingInFlightPacketResponse QueryOutgoingInFlightPacketResponse
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *QueryOutgoingInFlightPacketResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketResponse)(x)
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *QueryOutgoingInFlightPacketResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_swap_query_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IncomingInFlightPacket)
+	(*x.list)[i] = concreteValue
 }
 
-var _fastReflection_QueryOutgoingInFlightPacketResponse_messageType fastReflection_QueryOutgoingInFlightPacketResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketResponse_messageType{}
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IncomingInFlightPacket)
+	*x.list = append(*x.list, concreteValue)
+}
 
-type fastReflection_QueryOutgoingInFlightPacketResponse_messageType struct{}
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(IncomingInFlightPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketResponse)(nil)
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketResponse)
+
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(IncomingInFlightPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketResponse
+
+func (x *_QueryPendingIncomingInFlightPacketsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPendingIncomingInFlightPacketsResponse            protoreflect.MessageDescriptor
+	fd_QueryPendingIncomingInFlightPacketsResponse_packets    protoreflect.FieldDescriptor
+	fd_QueryPendingIncomingInFlightPacketsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryPendingIncomingInFlightPacketsResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryPendingIncomingInFlightPacketsResponse")
+	fd_QueryPendingIncomingInFlightPacketsResponse_packets = md_QueryPendingIncomingInFlightPacketsResponse.Fields().ByName("packets")
+	fd_QueryPendingIncomingInFlightPacketsResponse_pagination = md_QueryPendingIncomingInFlightPacketsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPendingIncomingInFlightPacketsResponse)(nil)
+
+type fastReflection_QueryPendingIncomingInFlightPacketsResponse QueryPendingIncomingInFlightPacketsResponse
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingIncomingInFlightPacketsResponse)(x)
+}
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType{}
+
+type fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType struct{}
+
+func (x fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingIncomingInFlightPacketsResponse)(nil)
+}
+func (x fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingIncomingInFlightPacketsResponse)
+}
+func (x fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingIncomingInFlightPacketsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketResponse
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingIncomingInFlightPacketsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutgoingInFlightPacketResponse_messageType
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingIncomingInFlightPacketsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketResponse)
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingIncomingInFlightPacketsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutgoingInFlightPacketResponse)(x)
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingIncomingInFlightPacketsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3390,10 +3394,16 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Packet != nil {
-		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
-		if !f(fd_QueryOutgoingInFlightPacketResponse_packet, value) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPendingIncomingInFlightPacketsResponse_1_list{list: &x.Packets})
+		if !f(fd_QueryPendingIncomingInFlightPacketsResponse_packets, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPendingIncomingInFlightPacketsResponse_pagination, value) {
 			return
 		}
 	}
@@ -3410,15 +3420,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		return x.Packet != nil
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		return len(x.Packets) != 0
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3428,15 +3440,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		x.Packet = nil
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		x.Packets = nil
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3446,16 +3460,22 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		value := x.Packet
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_QueryPendingIncomingInFlightPacketsResponse_1_list{})
+		}
+		listValue := &_QueryPendingIncomingInFlightPacketsResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3469,15 +3489,19 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		x.Packet = value.Message().Interface().(*OutgoingInFlightPacket)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		lv := value.List()
+		clv := lv.(*_QueryPendingIncomingInFlightPacketsResponse_1_list)
+		x.Packets = *clv.list
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3491,44 +3515,53 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		if x.Packet == nil {
-			x.Packet = new(OutgoingInFlightPacket)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		if x.Packets == nil {
+			x.Packets = []*IncomingInFlightPacket{}
 		}
-		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		value := &_QueryPendingIncomingInFlightPacketsResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
-		m := new(OutgoingInFlightPacket)
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets":
+		list := []*IncomingInFlightPacket{}
+		return protoreflect.ValueOfList(&_QueryPendingIncomingInFlightPacketsResponse_1_list{list: &list})
+	case "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryPendingIncomingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryPendingIncomingInFlightPacketsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3536,7 +3569,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3547,7 +3580,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3559,7 +3592,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) IsValid() bool {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3569,9 +3602,9 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPendingIncomingInFlightPacketsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3583,8 +3616,14 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if x.Packet != nil {
-			l = options.Size(x.Packet)
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3597,7 +3636,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3616,8 +3655,8 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Packet != nil {
-			encoded, err := options.Marshal(x.Packet)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3628,7 +3667,23 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3641,7 +3696,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
+		x := input.Message.Interface().(*QueryPendingIncomingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3673,15 +3728,15 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3708,10 +3763,44 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Packet == nil {
-					x.Packet = &OutgoingInFlightPacket{}
+				x.Packets = append(x.Packets, &IncomingInFlightPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3751,25 +3840,29 @@ func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *pro
 }
 
 var (
-	md_QueryOutgoingInFlightPacketsRequest            protoreflect.MessageDescriptor
-	fd_QueryOutgoingInFlightPacketsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryOutgoingInFlightPacketRequest                protoreflect.MessageDescriptor
+	fd_QueryOutgoingInFlightPacketRequest_src_port_id    protoreflect.FieldDescriptor
+	fd_QueryOutgoingInFlightPacketRequest_src_channel_id protoreflect.FieldDescriptor
+	fd_QueryOutgoingInFlightPacketRequest_sequence       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryOutgoingInFlightPacketsRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketsRequest")
-	fd_QueryOutgoingInFlightPacketsRequest_pagination = md_QueryOutgoingInFlightPacketsRequest.Fields().ByName("pagination")
+	md_QueryOutgoingInFlightPacketRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketRequest")
+	fd_QueryOutgoingInFlightPacketRequest_src_port_id = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("src_port_id")
+	fd_QueryOutgoingInFlightPacketRequest_src_channel_id = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("src_channel_id")
+	fd_QueryOutgoingInFlightPacketRequest_sequence = md_QueryOutgoingInFlightPacketRequest.Fields().ByName("sequence")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketRequest)(nil)
 
-type fastReflection_QueryOutgoingInFlightPacketsRequest QueryOutgoingInFlightPacketsRequest
+type fastReflection_QueryOutgoingInFlightPacketRequest QueryOutgoingInFlightPacketRequest
 
-func (x *QueryOutgoingInFlightPacketsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketsRequest)(x)
+func (x *QueryOutgoingInFlightPacketRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketRequest)(x)
 }
 
-func (x *QueryOutgoingInFlightPacketsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutgoingInFlightPacketRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3781,43 +3874,43 @@ func (x *QueryOutgoingInFlightPacketsRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutgoingInFlightPacketsRequest_messageType fastReflection_QueryOutgoingInFlightPacketsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketsRequest_messageType{}
+var _fastReflection_QueryOutgoingInFlightPacketRequest_messageType fastReflection_QueryOutgoingInFlightPacketRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketRequest_messageType{}
 
-type fastReflection_QueryOutgoingInFlightPacketsRequest_messageType struct{}
+type fastReflection_QueryOutgoingInFlightPacketRequest_messageType struct{}
 
-func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketsRequest)(nil)
+func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketRequest)(nil)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketsRequest)
+func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketRequest)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketsRequest
+func (x fastReflection_QueryOutgoingInFlightPacketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketsRequest
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutgoingInFlightPacketsRequest_messageType
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutgoingInFlightPacketRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketsRequest)
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutgoingInFlightPacketsRequest)(x)
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutgoingInFlightPacketRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3825,10 +3918,22 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryOutgoingInFlightPacketsRequest_pagination, value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SrcPortId != "" {
+		value := protoreflect.ValueOfString(x.SrcPortId)
+		if !f(fd_QueryOutgoingInFlightPacketRequest_src_port_id, value) {
+			return
+		}
+	}
+	if x.SrcChannelId != "" {
+		value := protoreflect.ValueOfString(x.SrcChannelId)
+		if !f(fd_QueryOutgoingInFlightPacketRequest_src_channel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_QueryOutgoingInFlightPacketRequest_sequence, value) {
 			return
 		}
 	}
@@ -3845,15 +3950,19 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		return x.Pagination != nil
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		return x.SrcPortId != ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		return x.SrcChannelId != ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		return x.Sequence != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3863,15 +3972,19 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		x.Pagination = nil
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		x.SrcPortId = ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		x.SrcChannelId = ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		x.Sequence = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3881,16 +3994,22 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		value := x.SrcPortId
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		value := x.SrcChannelId
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3904,15 +4023,19 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		x.SrcPortId = value.Interface().(string)
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		x.SrcChannelId = value.Interface().(string)
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		x.Sequence = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3926,44 +4049,48 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		panic(fmt.Errorf("field src_port_id of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		panic(fmt.Errorf("field src_channel_id of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		panic(fmt.Errorf("field sequence of message sunrise.swap.QueryOutgoingInFlightPacketRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_port_id":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.src_channel_id":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryOutgoingInFlightPacketRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3971,7 +4098,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3982,7 +4109,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3994,7 +4121,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) IsValid() bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4004,9 +4131,9 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutgoingInFlightPacketRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4018,10 +4145,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.SrcPortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SrcChannelId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4032,7 +4166,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4051,17 +4185,22 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SrcChannelId) > 0 {
+			i -= len(x.SrcChannelId)
+			copy(dAtA[i:], x.SrcChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SrcPortId) > 0 {
+			i -= len(x.SrcPortId)
+			copy(dAtA[i:], x.SrcPortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SrcPortId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4076,7 +4215,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4108,17 +4247,17 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4128,28 +4267,75 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				x.SrcPortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.SrcChannelId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4185,79 +4371,26 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *pro
 	}
 }
 
-var _ protoreflect.List = (*_QueryOutgoingInFlightPacketsResponse_1_list)(nil)
+var (
+	md_QueryOutgoingInFlightPacketResponse        protoreflect.MessageDescriptor
+	fd_QueryOutgoingInFlightPacketResponse_packet protoreflect.FieldDescriptor
+)
 
-type _QueryOutgoingInFlightPacketsResponse_1_list struct {
-	list *[]*OutgoingInFlightPacket
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryOutgoingInFlightPacketResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketResponse")
+	fd_QueryOutgoingInFlightPacketResponse_packet = md_QueryOutgoingInFlightPacketResponse.Fields().ByName("packet")
 }
 
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketResponse)(nil)
 
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_QueryOutgoingInFlightPacketResponse QueryOutgoingInFlightPacketResponse
 
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OutgoingInFlightPacket)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*OutgoingInFlightPacket)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(OutgoingInFlightPacket)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(OutgoingInFlightPacket)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryOutgoingInFlightPacketsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryOutgoingInFlightPacketsResponse            protoreflect.MessageDescriptor
-	fd_QueryOutgoingInFlightPacketsResponse_packets    protoreflect.FieldDescriptor
-	fd_QueryOutgoingInFlightPacketsResponse_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_sunrise_swap_query_proto_init()
-	md_QueryOutgoingInFlightPacketsResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketsResponse")
-	fd_QueryOutgoingInFlightPacketsResponse_packets = md_QueryOutgoingInFlightPacketsResponse.Fields().ByName("packets")
-	fd_QueryOutgoingInFlightPacketsResponse_pagination = md_QueryOutgoingInFlightPacketsResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketsResponse)(nil)
-
-type fastReflection_QueryOutgoingInFlightPacketsResponse QueryOutgoingInFlightPacketsResponse
-
-func (x *QueryOutgoingInFlightPacketsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketsResponse)(x)
+func (x *QueryOutgoingInFlightPacketResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketResponse)(x)
 }
 
-func (x *QueryOutgoingInFlightPacketsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutgoingInFlightPacketResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4269,43 +4402,43 @@ func (x *QueryOutgoingInFlightPacketsResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryOutgoingInFlightPacketsResponse_messageType fastReflection_QueryOutgoingInFlightPacketsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketsResponse_messageType{}
+var _fastReflection_QueryOutgoingInFlightPacketResponse_messageType fastReflection_QueryOutgoingInFlightPacketResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketResponse_messageType{}
 
-type fastReflection_QueryOutgoingInFlightPacketsResponse_messageType struct{}
+type fastReflection_QueryOutgoingInFlightPacketResponse_messageType struct{}
 
-func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryOutgoingInFlightPacketsResponse)(nil)
+func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketResponse)(nil)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketsResponse)
+func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketResponse)
 }
-func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketsResponse
+func (x fastReflection_QueryOutgoingInFlightPacketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryOutgoingInFlightPacketsResponse
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryOutgoingInFlightPacketsResponse_messageType
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutgoingInFlightPacketResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryOutgoingInFlightPacketsResponse)
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryOutgoingInFlightPacketsResponse)(x)
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutgoingInFlightPacketResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4313,16 +4446,10 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Packets) != 0 {
-		value := protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets})
-		if !f(fd_QueryOutgoingInFlightPacketsResponse_packets, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryOutgoingInFlightPacketsResponse_pagination, value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		value := protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
+		if !f(fd_QueryOutgoingInFlightPacketResponse_packet, value) {
 			return
 		}
 	}
@@ -4339,17 +4466,15 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		return len(x.Packets) != 0
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		return x.Pagination != nil
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		return x.Packet != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4359,17 +4484,15 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		x.Packets = nil
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		x.Pagination = nil
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		x.Packet = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4379,22 +4502,16 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		if len(x.Packets) == 0 {
-			return protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{})
-		}
-		listValue := &_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets}
-		return protoreflect.ValueOfList(listValue)
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		value := x.Pagination
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		value := x.Packet
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4408,19 +4525,15 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		lv := value.List()
-		clv := lv.(*_QueryOutgoingInFlightPacketsResponse_1_list)
-		x.Packets = *clv.list
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		x.Packet = value.Message().Interface().(*OutgoingInFlightPacket)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4434,53 +4547,44 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		if x.Packets == nil {
-			x.Packets = []*OutgoingInFlightPacket{}
-		}
-		value := &_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets}
-		return protoreflect.ValueOfList(value)
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		if x.Packet == nil {
+			x.Packet = new(OutgoingInFlightPacket)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Packet.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
-		list := []*OutgoingInFlightPacket{}
-		return protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{list: &list})
-	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "sunrise.swap.QueryOutgoingInFlightPacketResponse.packet":
+		m := new(OutgoingInFlightPacket)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4488,7 +4592,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4499,7 +4603,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4511,7 +4615,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) IsValid() bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4521,9 +4625,9 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutgoingInFlightPacketResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4535,14 +4639,8 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		if len(x.Packets) > 0 {
-			for _, e := range x.Packets {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Packet != nil {
+			l = options.Size(x.Packet)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4555,7 +4653,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4574,8 +4672,8 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Packet != nil {
+			encoded, err := options.Marshal(x.Packet)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4586,23 +4684,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Packets) > 0 {
-			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Packets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4615,7 +4697,7 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4647,15 +4729,15 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4682,44 +4764,10 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Packets = append(x.Packets, &OutgoingInFlightPacket{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if x.Packet == nil {
+					x.Packet = &OutgoingInFlightPacket{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packet); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4759,29 +4807,25 @@ func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryCalculationSwapExactAmountInRequest                   protoreflect.MessageDescriptor
-	fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountInRequest_route             protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountInRequest_amount_in         protoreflect.FieldDescriptor
+	md_QueryOutgoingInFlightPacketsRequest            protoreflect.MessageDescriptor
+	fd_QueryOutgoingInFlightPacketsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryCalculationSwapExactAmountInRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountInRequest")
-	fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("has_interface_fee")
-	fd_QueryCalculationSwapExactAmountInRequest_route = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("route")
-	fd_QueryCalculationSwapExactAmountInRequest_amount_in = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("amount_in")
+	md_QueryOutgoingInFlightPacketsRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketsRequest")
+	fd_QueryOutgoingInFlightPacketsRequest_pagination = md_QueryOutgoingInFlightPacketsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountInRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketsRequest)(nil)
 
-type fastReflection_QueryCalculationSwapExactAmountInRequest QueryCalculationSwapExactAmountInRequest
+type fastReflection_QueryOutgoingInFlightPacketsRequest QueryOutgoingInFlightPacketsRequest
 
-func (x *QueryCalculationSwapExactAmountInRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountInRequest)(x)
+func (x *QueryOutgoingInFlightPacketsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketsRequest)(x)
 }
 
-func (x *QueryCalculationSwapExactAmountInRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutgoingInFlightPacketsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4793,43 +4837,43 @@ func (x *QueryCalculationSwapExactAmountInRequest) slowProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationSwapExactAmountInRequest_messageType fastReflection_QueryCalculationSwapExactAmountInRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountInRequest_messageType{}
+var _fastReflection_QueryOutgoingInFlightPacketsRequest_messageType fastReflection_QueryOutgoingInFlightPacketsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketsRequest_messageType{}
 
-type fastReflection_QueryCalculationSwapExactAmountInRequest_messageType struct{}
+type fastReflection_QueryOutgoingInFlightPacketsRequest_messageType struct{}
 
-func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountInRequest)(nil)
+func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketsRequest)(nil)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountInRequest)
+func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketsRequest)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountInRequest
+func (x fastReflection_QueryOutgoingInFlightPacketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountInRequest
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationSwapExactAmountInRequest_messageType
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutgoingInFlightPacketsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountInRequest)
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationSwapExactAmountInRequest)(x)
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutgoingInFlightPacketsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4837,22 +4881,10 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Interface() pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HasInterfaceFee != false {
-		value := protoreflect.ValueOfBool(x.HasInterfaceFee)
-		if !f(fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee, value) {
-			return
-		}
-	}
-	if x.Route != nil {
-		value := protoreflect.ValueOfMessage(x.Route.ProtoReflect())
-		if !f(fd_QueryCalculationSwapExactAmountInRequest_route, value) {
-			return
-		}
-	}
-	if x.AmountIn != "" {
-		value := protoreflect.ValueOfString(x.AmountIn)
-		if !f(fd_QueryCalculationSwapExactAmountInRequest_amount_in, value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOutgoingInFlightPacketsRequest_pagination, value) {
 			return
 		}
 	}
@@ -4869,19 +4901,15 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Range(f func(p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		return x.HasInterfaceFee != false
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		return x.Route != nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		return x.AmountIn != ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4891,19 +4919,15 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Has(fd protore
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		x.HasInterfaceFee = false
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		x.Route = nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		x.AmountIn = ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4913,22 +4937,16 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Clear(fd proto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		value := x.HasInterfaceFee
-		return protoreflect.ValueOfBool(value)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		value := x.Route
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		value := x.AmountIn
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4942,19 +4960,15 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		x.HasInterfaceFee = value.Bool()
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		x.Route = value.Message().Interface().(*Route)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		x.AmountIn = value.Interface().(string)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4968,52 +4982,44 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Set(fd protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		if x.Route == nil {
-			x.Route = new(Route)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Route.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		panic(fmt.Errorf("field has_interface_fee of message sunrise.swap.QueryCalculationSwapExactAmountInRequest is not mutable"))
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		panic(fmt.Errorf("field amount_in of message sunrise.swap.QueryCalculationSwapExactAmountInRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
-		return protoreflect.ValueOfBool(false)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
-		m := new(Route)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountInRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5021,7 +5027,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) WhichOneof(d p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5032,7 +5038,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) GetUnknown() p
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5044,7 +5050,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) SetUnknown(fie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) IsValid() bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5054,9 +5060,9 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutgoingInFlightPacketsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5068,15 +5074,8 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 		var n int
 		var l int
 		_ = l
-		if x.HasInterfaceFee {
-			n += 2
-		}
-		if x.Route != nil {
-			l = options.Size(x.Route)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AmountIn)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5089,7 +5088,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5108,15 +5107,8 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AmountIn) > 0 {
-			i -= len(x.AmountIn)
-			copy(dAtA[i:], x.AmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Route != nil {
-			encoded, err := options.Marshal(x.Route)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5127,17 +5119,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.HasInterfaceFee {
-			i--
-			if x.HasInterfaceFee {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5150,7 +5132,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5182,35 +5164,15 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.HasInterfaceFee = bool(v != 0)
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5237,45 +5199,13 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Route == nil {
-					x.Route = &Route{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Route); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AmountIn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5311,30 +5241,79 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods()
 	}
 }
 
+var _ protoreflect.List = (*_QueryOutgoingInFlightPacketsResponse_1_list)(nil)
+
+type _QueryOutgoingInFlightPacketsResponse_1_list struct {
+	list *[]*OutgoingInFlightPacket
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutgoingInFlightPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutgoingInFlightPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OutgoingInFlightPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OutgoingInFlightPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOutgoingInFlightPacketsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCalculationSwapExactAmountInResponse                        protoreflect.MessageDescriptor
-	fd_QueryCalculationSwapExactAmountInResponse_result                 protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountInResponse_amount_out             protoreflect.FieldDescriptor
+	md_QueryOutgoingInFlightPacketsResponse            protoreflect.MessageDescriptor
+	fd_QueryOutgoingInFlightPacketsResponse_packets    protoreflect.FieldDescriptor
+	fd_QueryOutgoingInFlightPacketsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryCalculationSwapExactAmountInResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountInResponse")
-	fd_QueryCalculationSwapExactAmountInResponse_result = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("result")
-	fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("interface_provider_fee")
-	fd_QueryCalculationSwapExactAmountInResponse_amount_out = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("amount_out")
+	md_QueryOutgoingInFlightPacketsResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryOutgoingInFlightPacketsResponse")
+	fd_QueryOutgoingInFlightPacketsResponse_packets = md_QueryOutgoingInFlightPacketsResponse.Fields().ByName("packets")
+	fd_QueryOutgoingInFlightPacketsResponse_pagination = md_QueryOutgoingInFlightPacketsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountInResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOutgoingInFlightPacketsResponse)(nil)
 
-type fastReflection_QueryCalculationSwapExactAmountInResponse QueryCalculationSwapExactAmountInResponse
+type fastReflection_QueryOutgoingInFlightPacketsResponse QueryOutgoingInFlightPacketsResponse
 
-func (x *QueryCalculationSwapExactAmountInResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountInResponse)(x)
+func (x *QueryOutgoingInFlightPacketsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketsResponse)(x)
 }
 
-func (x *QueryCalculationSwapExactAmountInResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryOutgoingInFlightPacketsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5346,43 +5325,43 @@ func (x *QueryCalculationSwapExactAmountInResponse) slowProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationSwapExactAmountInResponse_messageType fastReflection_QueryCalculationSwapExactAmountInResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountInResponse_messageType{}
+var _fastReflection_QueryOutgoingInFlightPacketsResponse_messageType fastReflection_QueryOutgoingInFlightPacketsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOutgoingInFlightPacketsResponse_messageType{}
 
-type fastReflection_QueryCalculationSwapExactAmountInResponse_messageType struct{}
+type fastReflection_QueryOutgoingInFlightPacketsResponse_messageType struct{}
 
-func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountInResponse)(nil)
+func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOutgoingInFlightPacketsResponse)(nil)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountInResponse)
+func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketsResponse)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountInResponse
+func (x fastReflection_QueryOutgoingInFlightPacketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountInResponse
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOutgoingInFlightPacketsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationSwapExactAmountInResponse_messageType
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOutgoingInFlightPacketsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountInResponse)
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOutgoingInFlightPacketsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationSwapExactAmountInResponse)(x)
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOutgoingInFlightPacketsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5390,22 +5369,16 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Interface() p
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_QueryCalculationSwapExactAmountInResponse_result, value) {
-			return
-		}
-	}
-	if x.InterfaceProviderFee != "" {
-		value := protoreflect.ValueOfString(x.InterfaceProviderFee)
-		if !f(fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee, value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Packets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets})
+		if !f(fd_QueryOutgoingInFlightPacketsResponse_packets, value) {
 			return
 		}
 	}
-	if x.AmountOut != "" {
-		value := protoreflect.ValueOfString(x.AmountOut)
-		if !f(fd_QueryCalculationSwapExactAmountInResponse_amount_out, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOutgoingInFlightPacketsResponse_pagination, value) {
 			return
 		}
 	}
@@ -5422,19 +5395,17 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Range(f func(
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		return x.Result != nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		return x.InterfaceProviderFee != ""
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		return x.AmountOut != ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		return len(x.Packets) != 0
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5444,19 +5415,17 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Has(fd protor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		x.Result = nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		x.InterfaceProviderFee = ""
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		x.AmountOut = ""
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		x.Packets = nil
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5466,22 +5435,22 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Clear(fd prot
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		value := x.Result
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		if len(x.Packets) == 0 {
+			return protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{})
+		}
+		listValue := &_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		value := x.InterfaceProviderFee
-		return protoreflect.ValueOfString(value)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		value := x.AmountOut
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5495,19 +5464,19 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Get(descripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		x.Result = value.Message().Interface().(*RouteResult)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		x.InterfaceProviderFee = value.Interface().(string)
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		x.AmountOut = value.Interface().(string)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		lv := value.List()
+		clv := lv.(*_QueryOutgoingInFlightPacketsResponse_1_list)
+		x.Packets = *clv.list
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5521,52 +5490,53 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Set(fd protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		if x.Result == nil {
-			x.Result = new(RouteResult)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		if x.Packets == nil {
+			x.Packets = []*OutgoingInFlightPacket{}
 		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		panic(fmt.Errorf("field interface_provider_fee of message sunrise.swap.QueryCalculationSwapExactAmountInResponse is not mutable"))
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		panic(fmt.Errorf("field amount_out of message sunrise.swap.QueryCalculationSwapExactAmountInResponse is not mutable"))
+		value := &_QueryOutgoingInFlightPacketsResponse_1_list{list: &x.Packets}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
-		m := new(RouteResult)
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets":
+		list := []*OutgoingInFlightPacket{}
+		return protoreflect.ValueOfList(&_QueryOutgoingInFlightPacketsResponse_1_list{list: &list})
+	case "sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
-		return protoreflect.ValueOfString("")
-	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOutgoingInFlightPacketsResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryOutgoingInFlightPacketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountInResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOutgoingInFlightPacketsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5574,7 +5544,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5585,7 +5555,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5597,7 +5567,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) SetUnknown(fi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) IsValid() bool {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5607,9 +5577,9 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) IsValid() boo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOutgoingInFlightPacketsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5621,16 +5591,14 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 		var n int
 		var l int
 		_ = l
-		if x.Result != nil {
-			l = options.Size(x.Result)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InterfaceProviderFee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Packets) > 0 {
+			for _, e := range x.Packets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.AmountOut)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5643,7 +5611,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5662,22 +5630,8 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AmountOut) > 0 {
-			i -= len(x.AmountOut)
-			copy(dAtA[i:], x.AmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountOut)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.InterfaceProviderFee) > 0 {
-			i -= len(x.InterfaceProviderFee)
-			copy(dAtA[i:], x.InterfaceProviderFee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceProviderFee)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5688,7 +5642,23 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Packets) > 0 {
+			for iNdEx := len(x.Packets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Packets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5701,7 +5671,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
+		x := input.Message.Interface().(*QueryOutgoingInFlightPacketsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5733,15 +5703,15 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5768,18 +5738,16 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Result == nil {
-					x.Result = &RouteResult{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+				x.Packets = append(x.Packets, &OutgoingInFlightPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Packets[len(x.Packets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5789,55 +5757,27 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InterfaceProviderFee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.AmountOut = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5875,29 +5815,31 @@ func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods(
 }
 
 var (
-	md_QueryCalculationSwapExactAmountOutRequest                   protoreflect.MessageDescriptor
-	fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountOutRequest_route             protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountOutRequest_amount_out        protoreflect.FieldDescriptor
+	md_QueryCalculationSwapExactAmountInRequest                    protoreflect.MessageDescriptor
+	fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee  protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountInRequest_route              protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountInRequest_amount_in          protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountInRequest_interface_provider protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryCalculationSwapExactAmountOutRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountOutRequest")
-	fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("has_interface_fee")
-	fd_QueryCalculationSwapExactAmountOutRequest_route = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("route")
-	fd_QueryCalculationSwapExactAmountOutRequest_amount_out = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("amount_out")
+	md_QueryCalculationSwapExactAmountInRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountInRequest")
+	fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("has_interface_fee")
+	fd_QueryCalculationSwapExactAmountInRequest_route = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("route")
+	fd_QueryCalculationSwapExactAmountInRequest_amount_in = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("amount_in")
+	fd_QueryCalculationSwapExactAmountInRequest_interface_provider = md_QueryCalculationSwapExactAmountInRequest.Fields().ByName("interface_provider")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountInRequest)(nil)
 
-type fastReflection_QueryCalculationSwapExactAmountOutRequest QueryCalculationSwapExactAmountOutRequest
+type fastReflection_QueryCalculationSwapExactAmountInRequest QueryCalculationSwapExactAmountInRequest
 
-func (x *QueryCalculationSwapExactAmountOutRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(x)
+func (x *QueryCalculationSwapExactAmountInRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountInRequest)(x)
 }
 
-func (x *QueryCalculationSwapExactAmountOutRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCalculationSwapExactAmountInRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5909,43 +5851,43 @@ func (x *QueryCalculationSwapExactAmountOutRequest) slowProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType{}
+var _fastReflection_QueryCalculationSwapExactAmountInRequest_messageType fastReflection_QueryCalculationSwapExactAmountInRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountInRequest_messageType{}
 
-type fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType struct{}
+type fastReflection_QueryCalculationSwapExactAmountInRequest_messageType struct{}
 
-func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(nil)
+func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountInRequest)(nil)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountOutRequest)
+func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountInRequest)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountOutRequest
+func (x fastReflection_QueryCalculationSwapExactAmountInRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountInRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountOutRequest
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountInRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationSwapExactAmountInRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountOutRequest)
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountInRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationSwapExactAmountOutRequest)(x)
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationSwapExactAmountInRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5953,22 +5895,28 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Interface() p
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.HasInterfaceFee != false {
 		value := protoreflect.ValueOfBool(x.HasInterfaceFee)
-		if !f(fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee, value) {
+		if !f(fd_QueryCalculationSwapExactAmountInRequest_has_interface_fee, value) {
 			return
 		}
 	}
 	if x.Route != nil {
 		value := protoreflect.ValueOfMessage(x.Route.ProtoReflect())
-		if !f(fd_QueryCalculationSwapExactAmountOutRequest_route, value) {
+		if !f(fd_QueryCalculationSwapExactAmountInRequest_route, value) {
 			return
 		}
 	}
-	if x.AmountOut != "" {
-		value := protoreflect.ValueOfString(x.AmountOut)
-		if !f(fd_QueryCalculationSwapExactAmountOutRequest_amount_out, value) {
+	if x.AmountIn != "" {
+		value := protoreflect.ValueOfString(x.AmountIn)
+		if !f(fd_QueryCalculationSwapExactAmountInRequest_amount_in, value) {
+			return
+		}
+	}
+	if x.InterfaceProvider != "" {
+		value := protoreflect.ValueOfString(x.InterfaceProvider)
+		if !f(fd_QueryCalculationSwapExactAmountInRequest_interface_provider, value) {
 			return
 		}
 	}
@@ -5985,19 +5933,21 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Range(f func(
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
 		return x.HasInterfaceFee != false
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		return x.Route != nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
-		return x.AmountOut != ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		return x.AmountIn != ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
+		return x.InterfaceProvider != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6007,19 +5957,21 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Has(fd protor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
 		x.HasInterfaceFee = false
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		x.Route = nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
-		x.AmountOut = ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		x.AmountIn = ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
+		x.InterfaceProvider = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6029,22 +5981,25 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Clear(fd prot
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
 		value := x.HasInterfaceFee
 		return protoreflect.ValueOfBool(value)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		value := x.Route
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
-		value := x.AmountOut
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		value := x.AmountIn
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
+		value := x.InterfaceProvider
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6058,19 +6013,21 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Get(descripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
 		x.HasInterfaceFee = value.Bool()
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		x.Route = value.Message().Interface().(*Route)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
-		x.AmountOut = value.Interface().(string)
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		x.AmountIn = value.Interface().(string)
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
+		x.InterfaceProvider = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6084,52 +6041,56 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Set(fd protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		if x.Route == nil {
 			x.Route = new(Route)
 		}
 		return protoreflect.ValueOfMessage(x.Route.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
-		panic(fmt.Errorf("field has_interface_fee of message sunrise.swap.QueryCalculationSwapExactAmountOutRequest is not mutable"))
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
-		panic(fmt.Errorf("field amount_out of message sunrise.swap.QueryCalculationSwapExactAmountOutRequest is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
+		panic(fmt.Errorf("field has_interface_fee of message sunrise.swap.QueryCalculationSwapExactAmountInRequest is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		panic(fmt.Errorf("field amount_in of message sunrise.swap.QueryCalculationSwapExactAmountInRequest is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
+		panic(fmt.Errorf("field interface_provider of message sunrise.swap.QueryCalculationSwapExactAmountInRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.has_interface_fee":
 		return protoreflect.ValueOfBool(false)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.route":
 		m := new(Route)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.amount_in":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryCalculationSwapExactAmountInRequest.interface_provider":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountOutRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountInRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6137,7 +6098,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6148,7 +6109,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6160,7 +6121,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) SetUnknown(fi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) IsValid() bool {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6170,9 +6131,9 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) IsValid() boo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCalculationSwapExactAmountInRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6191,7 +6152,11 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 			l = options.Size(x.Route)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AmountOut)
+		l = len(x.AmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterfaceProvider)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6205,7 +6170,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6224,10 +6189,17 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AmountOut) > 0 {
-			i -= len(x.AmountOut)
-			copy(dAtA[i:], x.AmountOut)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountOut)))
+		if len(x.InterfaceProvider) > 0 {
+			i -= len(x.InterfaceProvider)
+			copy(dAtA[i:], x.InterfaceProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceProvider)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.AmountIn) > 0 {
+			i -= len(x.AmountIn)
+			copy(dAtA[i:], x.AmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6266,7 +6238,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6298,10 +6270,10 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6362,7 +6334,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6390,7 +6362,39 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AmountOut = string(dAtA[iNdEx:postIndex])
+				x.AmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterfaceProvider = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6428,29 +6432,29 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods(
 }
 
 var (
-	md_QueryCalculationSwapExactAmountOutResponse                        protoreflect.MessageDescriptor
-	fd_QueryCalculationSwapExactAmountOutResponse_result                 protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee protoreflect.FieldDescriptor
-	fd_QueryCalculationSwapExactAmountOutResponse_amount_in              protoreflect.FieldDescriptor
+	md_QueryCalculationSwapExactAmountInResponse                        protoreflect.MessageDescriptor
+	fd_QueryCalculationSwapExactAmountInResponse_result                 protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountInResponse_amount_out             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_swap_query_proto_init()
-	md_QueryCalculationSwapExactAmountOutResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountOutResponse")
-	fd_QueryCalculationSwapExactAmountOutResponse_result = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("result")
-	fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("interface_provider_fee")
-	fd_QueryCalculationSwapExactAmountOutResponse_amount_in = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("amount_in")
+	md_QueryCalculationSwapExactAmountInResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountInResponse")
+	fd_QueryCalculationSwapExactAmountInResponse_result = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("result")
+	fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("interface_provider_fee")
+	fd_QueryCalculationSwapExactAmountInResponse_amount_out = md_QueryCalculationSwapExactAmountInResponse.Fields().ByName("amount_out")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountInResponse)(nil)
 
-type fastReflection_QueryCalculationSwapExactAmountOutResponse QueryCalculationSwapExactAmountOutResponse
+type fastReflection_QueryCalculationSwapExactAmountInResponse QueryCalculationSwapExactAmountInResponse
 
-func (x *QueryCalculationSwapExactAmountOutResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(x)
+func (x *QueryCalculationSwapExactAmountInResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountInResponse)(x)
 }
 
-func (x *QueryCalculationSwapExactAmountOutResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCalculationSwapExactAmountInResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_swap_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6462,43 +6466,43 @@ func (x *QueryCalculationSwapExactAmountOutResponse) slowProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType{}
+var _fastReflection_QueryCalculationSwapExactAmountInResponse_messageType fastReflection_QueryCalculationSwapExactAmountInResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountInResponse_messageType{}
 
-type fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType struct{}
+type fastReflection_QueryCalculationSwapExactAmountInResponse_messageType struct{}
 
-func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(nil)
+func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountInResponse)(nil)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountOutResponse)
+func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountInResponse)
 }
-func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountOutResponse
+func (x fastReflection_QueryCalculationSwapExactAmountInResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountInResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculationSwapExactAmountOutResponse
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountInResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationSwapExactAmountInResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculationSwapExactAmountOutResponse)
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountInResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculationSwapExactAmountOutResponse)(x)
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationSwapExactAmountInResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6506,22 +6510,22 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Result != nil {
 		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_QueryCalculationSwapExactAmountOutResponse_result, value) {
+		if !f(fd_QueryCalculationSwapExactAmountInResponse_result, value) {
 			return
 		}
 	}
 	if x.InterfaceProviderFee != "" {
 		value := protoreflect.ValueOfString(x.InterfaceProviderFee)
-		if !f(fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee, value) {
+		if !f(fd_QueryCalculationSwapExactAmountInResponse_interface_provider_fee, value) {
 			return
 		}
 	}
-	if x.AmountIn != "" {
-		value := protoreflect.ValueOfString(x.AmountIn)
-		if !f(fd_QueryCalculationSwapExactAmountOutResponse_amount_in, value) {
+	if x.AmountOut != "" {
+		value := protoreflect.ValueOfString(x.AmountOut)
+		if !f(fd_QueryCalculationSwapExactAmountInResponse_amount_out, value) {
 			return
 		}
 	}
@@ -6538,19 +6542,19 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Range(f func
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		return x.Result != nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
 		return x.InterfaceProviderFee != ""
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
-		return x.AmountIn != ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
+		return x.AmountOut != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6560,19 +6564,19 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Has(fd proto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		x.Result = nil
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
 		x.InterfaceProviderFee = ""
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
-		x.AmountIn = ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
+		x.AmountOut = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6582,22 +6586,22 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Clear(fd pro
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
 		value := x.InterfaceProviderFee
 		return protoreflect.ValueOfString(value)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
-		value := x.AmountIn
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
+		value := x.AmountOut
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6611,19 +6615,19 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Get(descript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		x.Result = value.Message().Interface().(*RouteResult)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
 		x.InterfaceProviderFee = value.Interface().(string)
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
-		x.AmountIn = value.Interface().(string)
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
+		x.AmountOut = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6637,52 +6641,52 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Set(fd proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		if x.Result == nil {
 			x.Result = new(RouteResult)
 		}
 		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
-		panic(fmt.Errorf("field interface_provider_fee of message sunrise.swap.QueryCalculationSwapExactAmountOutResponse is not mutable"))
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
-		panic(fmt.Errorf("field amount_in of message sunrise.swap.QueryCalculationSwapExactAmountOutResponse is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
+		panic(fmt.Errorf("field interface_provider_fee of message sunrise.swap.QueryCalculationSwapExactAmountInResponse is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
+		panic(fmt.Errorf("field amount_out of message sunrise.swap.QueryCalculationSwapExactAmountInResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.result":
 		m := new(RouteResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.interface_provider_fee":
 		return protoreflect.ValueOfString("")
-	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+	case "sunrise.swap.QueryCalculationSwapExactAmountInResponse.amount_out":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountInResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountInResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountOutResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountInResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6690,7 +6694,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6701,7 +6705,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6713,7 +6717,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) SetUnknown(f
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) IsValid() bool {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6723,9 +6727,9 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) IsValid() bo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCalculationSwapExactAmountInResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6745,7 +6749,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AmountIn)
+		l = len(x.AmountOut)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6759,7 +6763,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6778,10 +6782,10 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AmountIn) > 0 {
-			i -= len(x.AmountIn)
-			copy(dAtA[i:], x.AmountIn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
+		if len(x.AmountOut) > 0 {
+			i -= len(x.AmountOut)
+			copy(dAtA[i:], x.AmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountOut)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6817,7 +6821,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountInResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6849,10 +6853,10 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6925,7 +6929,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6953,7 +6957,7 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AmountIn = string(dAtA[iNdEx:postIndex])
+				x.AmountOut = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6990,615 +6994,6108 @@ func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: sunrise/swap/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryCalculationSwapExactAmountOutRequest                    protoreflect.MessageDescriptor
+	fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee  protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountOutRequest_route              protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountOutRequest_amount_out         protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountOutRequest_interface_provider protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryCalculationSwapExactAmountOutRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountOutRequest")
+	fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("has_interface_fee")
+	fd_QueryCalculationSwapExactAmountOutRequest_route = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("route")
+	fd_QueryCalculationSwapExactAmountOutRequest_amount_out = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("amount_out")
+	fd_QueryCalculationSwapExactAmountOutRequest_interface_provider = md_QueryCalculationSwapExactAmountOutRequest.Fields().ByName("interface_provider")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(nil)
+
+type fastReflection_QueryCalculationSwapExactAmountOutRequest QueryCalculationSwapExactAmountOutRequest
+
+func (x *QueryCalculationSwapExactAmountOutRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(x)
+}
+
+func (x *QueryCalculationSwapExactAmountOutRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType{}
+
+type fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType struct{}
+
+func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountOutRequest)(nil)
+}
+func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountOutRequest)
+}
+func (x fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountOutRequest
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountOutRequest
+}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationSwapExactAmountOutRequest_messageType
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountOutRequest)
+}
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationSwapExactAmountOutRequest)(x)
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HasInterfaceFee != false {
+		value := protoreflect.ValueOfBool(x.HasInterfaceFee)
+		if !f(fd_QueryCalculationSwapExactAmountOutRequest_has_interface_fee, value) {
+			return
+		}
+	}
+	if x.Route != nil {
+		value := protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+		if !f(fd_QueryCalculationSwapExactAmountOutRequest_route, value) {
+			return
+		}
+	}
+	if x.AmountOut != "" {
+		value := protoreflect.ValueOfString(x.AmountOut)
+		if !f(fd_QueryCalculationSwapExactAmountOutRequest_amount_out, value) {
+			return
+		}
+	}
+	if x.InterfaceProvider != "" {
+		value := protoreflect.ValueOfString(x.InterfaceProvider)
+		if !f(fd_QueryCalculationSwapExactAmountOutRequest_interface_provider, value) {
+			return
+		}
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		return x.HasInterfaceFee != false
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		return x.Route != nil
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		return x.AmountOut != ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		return x.InterfaceProvider != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+	}
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		x.HasInterfaceFee = false
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		x.Route = nil
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		x.AmountOut = ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		x.InterfaceProvider = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+	}
+}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{1}
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		value := x.HasInterfaceFee
+		return protoreflect.ValueOfBool(value)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		value := x.Route
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		value := x.AmountOut
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		value := x.InterfaceProvider
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		x.HasInterfaceFee = value.Bool()
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		x.Route = value.Message().Interface().(*Route)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		x.AmountOut = value.Interface().(string)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		x.InterfaceProvider = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		if x.Route == nil {
+			x.Route = new(Route)
+		}
+		return protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		panic(fmt.Errorf("field has_interface_fee of message sunrise.swap.QueryCalculationSwapExactAmountOutRequest is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		panic(fmt.Errorf("field amount_out of message sunrise.swap.QueryCalculationSwapExactAmountOutRequest is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		panic(fmt.Errorf("field interface_provider of message sunrise.swap.QueryCalculationSwapExactAmountOutRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.has_interface_fee":
+		return protoreflect.ValueOfBool(false)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route":
+		m := new(Route)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.amount_out":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutRequest.interface_provider":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountOutRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.HasInterfaceFee {
+			n += 2
+		}
+		if x.Route != nil {
+			l = options.Size(x.Route)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmountOut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterfaceProvider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InterfaceProvider) > 0 {
+			i -= len(x.InterfaceProvider)
+			copy(dAtA[i:], x.InterfaceProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceProvider)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.AmountOut) > 0 {
+			i -= len(x.AmountOut)
+			copy(dAtA[i:], x.AmountOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountOut)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Route != nil {
+			encoded, err := options.Marshal(x.Route)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.HasInterfaceFee {
+			i--
+			if x.HasInterfaceFee {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.HasInterfaceFee = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Route == nil {
+					x.Route = &Route{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Route); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterfaceProvider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculationSwapExactAmountOutResponse                        protoreflect.MessageDescriptor
+	fd_QueryCalculationSwapExactAmountOutResponse_result                 protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee protoreflect.FieldDescriptor
+	fd_QueryCalculationSwapExactAmountOutResponse_amount_in              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryCalculationSwapExactAmountOutResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryCalculationSwapExactAmountOutResponse")
+	fd_QueryCalculationSwapExactAmountOutResponse_result = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("result")
+	fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("interface_provider_fee")
+	fd_QueryCalculationSwapExactAmountOutResponse_amount_in = md_QueryCalculationSwapExactAmountOutResponse.Fields().ByName("amount_in")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(nil)
+
+type fastReflection_QueryCalculationSwapExactAmountOutResponse QueryCalculationSwapExactAmountOutResponse
+
+func (x *QueryCalculationSwapExactAmountOutResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(x)
+}
+
+func (x *QueryCalculationSwapExactAmountOutResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType{}
+
+type fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType struct{}
+
+func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculationSwapExactAmountOutResponse)(nil)
+}
+func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountOutResponse)
+}
+func (x fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountOutResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculationSwapExactAmountOutResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculationSwapExactAmountOutResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculationSwapExactAmountOutResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculationSwapExactAmountOutResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_QueryCalculationSwapExactAmountOutResponse_result, value) {
+			return
+		}
+	}
+	if x.InterfaceProviderFee != "" {
+		value := protoreflect.ValueOfString(x.InterfaceProviderFee)
+		if !f(fd_QueryCalculationSwapExactAmountOutResponse_interface_provider_fee, value) {
+			return
+		}
+	}
+	if x.AmountIn != "" {
+		value := protoreflect.ValueOfString(x.AmountIn)
+		if !f(fd_QueryCalculationSwapExactAmountOutResponse_amount_in, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		return x.Result != nil
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		return x.InterfaceProviderFee != ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		return x.AmountIn != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		x.Result = nil
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		x.InterfaceProviderFee = ""
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		x.AmountIn = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		value := x.InterfaceProviderFee
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		value := x.AmountIn
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		x.Result = value.Message().Interface().(*RouteResult)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		x.InterfaceProviderFee = value.Interface().(string)
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		x.AmountIn = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		if x.Result == nil {
+			x.Result = new(RouteResult)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		panic(fmt.Errorf("field interface_provider_fee of message sunrise.swap.QueryCalculationSwapExactAmountOutResponse is not mutable"))
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		panic(fmt.Errorf("field amount_in of message sunrise.swap.QueryCalculationSwapExactAmountOutResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result":
+		m := new(RouteResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.interface_provider_fee":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryCalculationSwapExactAmountOutResponse.amount_in":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryCalculationSwapExactAmountOutResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryCalculationSwapExactAmountOutResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryCalculationSwapExactAmountOutResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculationSwapExactAmountOutResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterfaceProviderFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmountIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AmountIn) > 0 {
+			i -= len(x.AmountIn)
+			copy(dAtA[i:], x.AmountIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountIn)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.InterfaceProviderFee) > 0 {
+			i -= len(x.InterfaceProviderFee)
+			copy(dAtA[i:], x.InterfaceProviderFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterfaceProviderFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculationSwapExactAmountOutResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &RouteResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterfaceProviderFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOptimalRouteRequest            protoreflect.MessageDescriptor
+	fd_QueryOptimalRouteRequest_denom_in   protoreflect.FieldDescriptor
+	fd_QueryOptimalRouteRequest_denom_out  protoreflect.FieldDescriptor
+	fd_QueryOptimalRouteRequest_amount     protoreflect.FieldDescriptor
+	fd_QueryOptimalRouteRequest_max_hops   protoreflect.FieldDescriptor
+	fd_QueryOptimalRouteRequest_max_splits protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryOptimalRouteRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryOptimalRouteRequest")
+	fd_QueryOptimalRouteRequest_denom_in = md_QueryOptimalRouteRequest.Fields().ByName("denom_in")
+	fd_QueryOptimalRouteRequest_denom_out = md_QueryOptimalRouteRequest.Fields().ByName("denom_out")
+	fd_QueryOptimalRouteRequest_amount = md_QueryOptimalRouteRequest.Fields().ByName("amount")
+	fd_QueryOptimalRouteRequest_max_hops = md_QueryOptimalRouteRequest.Fields().ByName("max_hops")
+	fd_QueryOptimalRouteRequest_max_splits = md_QueryOptimalRouteRequest.Fields().ByName("max_splits")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOptimalRouteRequest)(nil)
+
+type fastReflection_QueryOptimalRouteRequest QueryOptimalRouteRequest
+
+func (x *QueryOptimalRouteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOptimalRouteRequest)(x)
+}
+
+func (x *QueryOptimalRouteRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOptimalRouteRequest_messageType fastReflection_QueryOptimalRouteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOptimalRouteRequest_messageType{}
+
+type fastReflection_QueryOptimalRouteRequest_messageType struct{}
+
+func (x fastReflection_QueryOptimalRouteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOptimalRouteRequest)(nil)
+}
+func (x fastReflection_QueryOptimalRouteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOptimalRouteRequest)
+}
+func (x fastReflection_QueryOptimalRouteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOptimalRouteRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOptimalRouteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOptimalRouteRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOptimalRouteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOptimalRouteRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOptimalRouteRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOptimalRouteRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOptimalRouteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOptimalRouteRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOptimalRouteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DenomIn != "" {
+		value := protoreflect.ValueOfString(x.DenomIn)
+		if !f(fd_QueryOptimalRouteRequest_denom_in, value) {
+			return
+		}
+	}
+	if x.DenomOut != "" {
+		value := protoreflect.ValueOfString(x.DenomOut)
+		if !f(fd_QueryOptimalRouteRequest_denom_out, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_QueryOptimalRouteRequest_amount, value) {
+			return
+		}
+	}
+	if x.MaxHops != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxHops)
+		if !f(fd_QueryOptimalRouteRequest_max_hops, value) {
+			return
+		}
+	}
+	if x.MaxSplits != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSplits)
+		if !f(fd_QueryOptimalRouteRequest_max_splits, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOptimalRouteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		return x.DenomIn != ""
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		return x.DenomOut != ""
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		return x.Amount != ""
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		return x.MaxHops != uint32(0)
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		return x.MaxSplits != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		x.DenomIn = ""
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		x.DenomOut = ""
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		x.Amount = ""
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		x.MaxHops = uint32(0)
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		x.MaxSplits = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOptimalRouteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		value := x.DenomIn
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		value := x.DenomOut
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		value := x.MaxHops
+		return protoreflect.ValueOfUint32(value)
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		value := x.MaxSplits
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		x.DenomIn = value.Interface().(string)
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		x.DenomOut = value.Interface().(string)
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		x.Amount = value.Interface().(string)
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		x.MaxHops = uint32(value.Uint())
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		x.MaxSplits = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		panic(fmt.Errorf("field denom_in of message sunrise.swap.QueryOptimalRouteRequest is not mutable"))
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		panic(fmt.Errorf("field denom_out of message sunrise.swap.QueryOptimalRouteRequest is not mutable"))
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		panic(fmt.Errorf("field amount of message sunrise.swap.QueryOptimalRouteRequest is not mutable"))
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		panic(fmt.Errorf("field max_hops of message sunrise.swap.QueryOptimalRouteRequest is not mutable"))
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		panic(fmt.Errorf("field max_splits of message sunrise.swap.QueryOptimalRouteRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOptimalRouteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_in":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryOptimalRouteRequest.denom_out":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryOptimalRouteRequest.amount":
+		return protoreflect.ValueOfString("")
+	case "sunrise.swap.QueryOptimalRouteRequest.max_hops":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "sunrise.swap.QueryOptimalRouteRequest.max_splits":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOptimalRouteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOptimalRouteRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOptimalRouteRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOptimalRouteRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOptimalRouteRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOptimalRouteRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DenomIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DenomOut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxHops != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxHops))
+		}
+		if x.MaxSplits != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSplits))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOptimalRouteRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxSplits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSplits))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxHops != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxHops))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DenomOut) > 0 {
+			i -= len(x.DenomOut)
+			copy(dAtA[i:], x.DenomOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomOut)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DenomIn) > 0 {
+			i -= len(x.DenomIn)
+			copy(dAtA[i:], x.DenomIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomIn)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOptimalRouteRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOptimalRouteRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOptimalRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+				}
+				x.MaxHops = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxHops |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+				}
+				x.MaxSplits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSplits |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOptimalRouteResponse        protoreflect.MessageDescriptor
+	fd_QueryOptimalRouteResponse_route  protoreflect.FieldDescriptor
+	fd_QueryOptimalRouteResponse_result protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryOptimalRouteResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryOptimalRouteResponse")
+	fd_QueryOptimalRouteResponse_route = md_QueryOptimalRouteResponse.Fields().ByName("route")
+	fd_QueryOptimalRouteResponse_result = md_QueryOptimalRouteResponse.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOptimalRouteResponse)(nil)
+
+type fastReflection_QueryOptimalRouteResponse QueryOptimalRouteResponse
+
+func (x *QueryOptimalRouteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOptimalRouteResponse)(x)
+}
+
+func (x *QueryOptimalRouteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOptimalRouteResponse_messageType fastReflection_QueryOptimalRouteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOptimalRouteResponse_messageType{}
+
+type fastReflection_QueryOptimalRouteResponse_messageType struct{}
+
+func (x fastReflection_QueryOptimalRouteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOptimalRouteResponse)(nil)
+}
+func (x fastReflection_QueryOptimalRouteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOptimalRouteResponse)
+}
+func (x fastReflection_QueryOptimalRouteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOptimalRouteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOptimalRouteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOptimalRouteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOptimalRouteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOptimalRouteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOptimalRouteResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOptimalRouteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOptimalRouteResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOptimalRouteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOptimalRouteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Route != nil {
+		value := protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+		if !f(fd_QueryOptimalRouteResponse_route, value) {
+			return
+		}
+	}
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_QueryOptimalRouteResponse_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOptimalRouteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		return x.Route != nil
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		return x.Result != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		x.Route = nil
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOptimalRouteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		value := x.Route
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		x.Route = value.Message().Interface().(*Route)
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		x.Result = value.Message().Interface().(*RouteResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		if x.Route == nil {
+			x.Route = new(Route)
+		}
+		return protoreflect.ValueOfMessage(x.Route.ProtoReflect())
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		if x.Result == nil {
+			x.Result = new(RouteResult)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOptimalRouteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryOptimalRouteResponse.route":
+		m := new(Route)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.swap.QueryOptimalRouteResponse.result":
+		m := new(RouteResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryOptimalRouteResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryOptimalRouteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOptimalRouteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryOptimalRouteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOptimalRouteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOptimalRouteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOptimalRouteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOptimalRouteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOptimalRouteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Route != nil {
+			l = options.Size(x.Route)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOptimalRouteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Route != nil {
+			encoded, err := options.Marshal(x.Route)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOptimalRouteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOptimalRouteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOptimalRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Route == nil {
+					x.Route = &Route{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Route); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &RouteResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInterfaceProviderRequest         protoreflect.MessageDescriptor
+	fd_QueryInterfaceProviderRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProviderRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProviderRequest")
+	fd_QueryInterfaceProviderRequest_address = md_QueryInterfaceProviderRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProviderRequest)(nil)
+
+type fastReflection_QueryInterfaceProviderRequest QueryInterfaceProviderRequest
+
+func (x *QueryInterfaceProviderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderRequest)(x)
+}
+
+func (x *QueryInterfaceProviderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProviderRequest_messageType fastReflection_QueryInterfaceProviderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProviderRequest_messageType{}
+
+type fastReflection_QueryInterfaceProviderRequest_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProviderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderRequest)(nil)
+}
+func (x fastReflection_QueryInterfaceProviderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderRequest)
+}
+func (x fastReflection_QueryInterfaceProviderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProviderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProviderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProviderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProviderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProviderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProviderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProviderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryInterfaceProviderRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProviderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProviderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		panic(fmt.Errorf("field address of message sunrise.swap.QueryInterfaceProviderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProviderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProviderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProviderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProviderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProviderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProviderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProviderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInterfaceProviderResponse                    protoreflect.MessageDescriptor
+	fd_QueryInterfaceProviderResponse_interface_provider protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProviderResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProviderResponse")
+	fd_QueryInterfaceProviderResponse_interface_provider = md_QueryInterfaceProviderResponse.Fields().ByName("interface_provider")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProviderResponse)(nil)
+
+type fastReflection_QueryInterfaceProviderResponse QueryInterfaceProviderResponse
+
+func (x *QueryInterfaceProviderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderResponse)(x)
+}
+
+func (x *QueryInterfaceProviderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProviderResponse_messageType fastReflection_QueryInterfaceProviderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProviderResponse_messageType{}
+
+type fastReflection_QueryInterfaceProviderResponse_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProviderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderResponse)(nil)
+}
+func (x fastReflection_QueryInterfaceProviderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderResponse)
+}
+func (x fastReflection_QueryInterfaceProviderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProviderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProviderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProviderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProviderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProviderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProviderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProviderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InterfaceProvider != nil {
+		value := protoreflect.ValueOfMessage(x.InterfaceProvider.ProtoReflect())
+		if !f(fd_QueryInterfaceProviderResponse_interface_provider, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProviderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		return x.InterfaceProvider != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		x.InterfaceProvider = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProviderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		value := x.InterfaceProvider
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		x.InterfaceProvider = value.Message().Interface().(*InterfaceProvider)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		if x.InterfaceProvider == nil {
+			x.InterfaceProvider = new(InterfaceProvider)
+		}
+		return protoreflect.ValueOfMessage(x.InterfaceProvider.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProviderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderResponse.interface_provider":
+		m := new(InterfaceProvider)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProviderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProviderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProviderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProviderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProviderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProviderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InterfaceProvider != nil {
+			l = options.Size(x.InterfaceProvider)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InterfaceProvider != nil {
+			encoded, err := options.Marshal(x.InterfaceProvider)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InterfaceProvider == nil {
+					x.InterfaceProvider = &InterfaceProvider{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InterfaceProvider); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInterfaceProvidersRequest            protoreflect.MessageDescriptor
+	fd_QueryInterfaceProvidersRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProvidersRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProvidersRequest")
+	fd_QueryInterfaceProvidersRequest_pagination = md_QueryInterfaceProvidersRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProvidersRequest)(nil)
+
+type fastReflection_QueryInterfaceProvidersRequest QueryInterfaceProvidersRequest
+
+func (x *QueryInterfaceProvidersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProvidersRequest)(x)
+}
+
+func (x *QueryInterfaceProvidersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProvidersRequest_messageType fastReflection_QueryInterfaceProvidersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProvidersRequest_messageType{}
+
+type fastReflection_QueryInterfaceProvidersRequest_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProvidersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProvidersRequest)(nil)
+}
+func (x fastReflection_QueryInterfaceProvidersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProvidersRequest)
+}
+func (x fastReflection_QueryInterfaceProvidersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProvidersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProvidersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProvidersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProvidersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProvidersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProvidersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryInterfaceProvidersRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProvidersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProvidersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProvidersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProvidersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProvidersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProvidersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProvidersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProvidersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProvidersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProvidersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryInterfaceProvidersResponse_1_list)(nil)
+
+type _QueryInterfaceProvidersResponse_1_list struct {
+	list *[]*InterfaceProvider
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InterfaceProvider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InterfaceProvider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(InterfaceProvider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(InterfaceProvider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInterfaceProvidersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryInterfaceProvidersResponse                     protoreflect.MessageDescriptor
+	fd_QueryInterfaceProvidersResponse_interface_providers protoreflect.FieldDescriptor
+	fd_QueryInterfaceProvidersResponse_pagination          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProvidersResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProvidersResponse")
+	fd_QueryInterfaceProvidersResponse_interface_providers = md_QueryInterfaceProvidersResponse.Fields().ByName("interface_providers")
+	fd_QueryInterfaceProvidersResponse_pagination = md_QueryInterfaceProvidersResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProvidersResponse)(nil)
+
+type fastReflection_QueryInterfaceProvidersResponse QueryInterfaceProvidersResponse
+
+func (x *QueryInterfaceProvidersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProvidersResponse)(x)
+}
+
+func (x *QueryInterfaceProvidersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProvidersResponse_messageType fastReflection_QueryInterfaceProvidersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProvidersResponse_messageType{}
+
+type fastReflection_QueryInterfaceProvidersResponse_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProvidersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProvidersResponse)(nil)
+}
+func (x fastReflection_QueryInterfaceProvidersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProvidersResponse)
+}
+func (x fastReflection_QueryInterfaceProvidersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProvidersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProvidersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProvidersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProvidersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProvidersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProvidersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.InterfaceProviders) != 0 {
+		value := protoreflect.ValueOfList(&_QueryInterfaceProvidersResponse_1_list{list: &x.InterfaceProviders})
+		if !f(fd_QueryInterfaceProvidersResponse_interface_providers, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryInterfaceProvidersResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		return len(x.InterfaceProviders) != 0
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		x.InterfaceProviders = nil
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		if len(x.InterfaceProviders) == 0 {
+			return protoreflect.ValueOfList(&_QueryInterfaceProvidersResponse_1_list{})
+		}
+		listValue := &_QueryInterfaceProvidersResponse_1_list{list: &x.InterfaceProviders}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		lv := value.List()
+		clv := lv.(*_QueryInterfaceProvidersResponse_1_list)
+		x.InterfaceProviders = *clv.list
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		if x.InterfaceProviders == nil {
+			x.InterfaceProviders = []*InterfaceProvider{}
+		}
+		value := &_QueryInterfaceProvidersResponse_1_list{list: &x.InterfaceProviders}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProvidersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProvidersResponse.interface_providers":
+		list := []*InterfaceProvider{}
+		return protoreflect.ValueOfList(&_QueryInterfaceProvidersResponse_1_list{list: &list})
+	case "sunrise.swap.QueryInterfaceProvidersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProvidersResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProvidersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProvidersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProvidersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProvidersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProvidersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProvidersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProvidersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.InterfaceProviders) > 0 {
+			for _, e := range x.InterfaceProviders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProvidersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InterfaceProviders) > 0 {
+			for iNdEx := len(x.InterfaceProviders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InterfaceProviders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProvidersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProvidersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterfaceProviders = append(x.InterfaceProviders, &InterfaceProvider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InterfaceProviders[len(x.InterfaceProviders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInterfaceProviderStatsRequest         protoreflect.MessageDescriptor
+	fd_QueryInterfaceProviderStatsRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProviderStatsRequest = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProviderStatsRequest")
+	fd_QueryInterfaceProviderStatsRequest_address = md_QueryInterfaceProviderStatsRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProviderStatsRequest)(nil)
+
+type fastReflection_QueryInterfaceProviderStatsRequest QueryInterfaceProviderStatsRequest
+
+func (x *QueryInterfaceProviderStatsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderStatsRequest)(x)
+}
+
+func (x *QueryInterfaceProviderStatsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProviderStatsRequest_messageType fastReflection_QueryInterfaceProviderStatsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProviderStatsRequest_messageType{}
+
+type fastReflection_QueryInterfaceProviderStatsRequest_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProviderStatsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderStatsRequest)(nil)
+}
+func (x fastReflection_QueryInterfaceProviderStatsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderStatsRequest)
+}
+func (x fastReflection_QueryInterfaceProviderStatsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderStatsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderStatsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProviderStatsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderStatsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProviderStatsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryInterfaceProviderStatsRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		panic(fmt.Errorf("field address of message sunrise.swap.QueryInterfaceProviderStatsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProviderStatsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProviderStatsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderStatsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryInterfaceProviderStatsResponse       protoreflect.MessageDescriptor
+	fd_QueryInterfaceProviderStatsResponse_stats protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_swap_query_proto_init()
+	md_QueryInterfaceProviderStatsResponse = File_sunrise_swap_query_proto.Messages().ByName("QueryInterfaceProviderStatsResponse")
+	fd_QueryInterfaceProviderStatsResponse_stats = md_QueryInterfaceProviderStatsResponse.Fields().ByName("stats")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInterfaceProviderStatsResponse)(nil)
+
+type fastReflection_QueryInterfaceProviderStatsResponse QueryInterfaceProviderStatsResponse
+
+func (x *QueryInterfaceProviderStatsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderStatsResponse)(x)
+}
+
+func (x *QueryInterfaceProviderStatsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_swap_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInterfaceProviderStatsResponse_messageType fastReflection_QueryInterfaceProviderStatsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInterfaceProviderStatsResponse_messageType{}
+
+type fastReflection_QueryInterfaceProviderStatsResponse_messageType struct{}
+
+func (x fastReflection_QueryInterfaceProviderStatsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInterfaceProviderStatsResponse)(nil)
+}
+func (x fastReflection_QueryInterfaceProviderStatsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderStatsResponse)
+}
+func (x fastReflection_QueryInterfaceProviderStatsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderStatsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInterfaceProviderStatsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInterfaceProviderStatsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInterfaceProviderStatsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInterfaceProviderStatsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Stats != nil {
+		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+		if !f(fd_QueryInterfaceProviderStatsResponse_stats, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		return x.Stats != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		x.Stats = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		value := x.Stats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		x.Stats = value.Message().Interface().(*InterfaceProviderStats)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		if x.Stats == nil {
+			x.Stats = new(InterfaceProviderStats)
+		}
+		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.swap.QueryInterfaceProviderStatsResponse.stats":
+		m := new(InterfaceProviderStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.swap.QueryInterfaceProviderStatsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.swap.QueryInterfaceProviderStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.swap.QueryInterfaceProviderStatsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInterfaceProviderStatsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Stats != nil {
+			l = options.Size(x.Stats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stats != nil {
+			encoded, err := options.Marshal(x.Stats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInterfaceProviderStatsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderStatsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInterfaceProviderStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stats == nil {
+					x.Stats = &InterfaceProviderStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: sunrise/swap/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryIncomingInFlightPacketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcPortId    string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
+	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *QueryIncomingInFlightPacketRequest) Reset() {
+	*x = QueryIncomingInFlightPacketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIncomingInFlightPacketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIncomingInFlightPacketRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryIncomingInFlightPacketRequest.ProtoReflect.Descriptor instead.
+func (*QueryIncomingInFlightPacketRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryIncomingInFlightPacketRequest) GetSrcPortId() string {
+	if x != nil {
+		return x.SrcPortId
+	}
+	return ""
+}
+
+func (x *QueryIncomingInFlightPacketRequest) GetSrcChannelId() string {
+	if x != nil {
+		return x.SrcChannelId
+	}
+	return ""
+}
+
+func (x *QueryIncomingInFlightPacketRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type QueryIncomingInFlightPacketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packet *IncomingInFlightPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (x *QueryIncomingInFlightPacketResponse) Reset() {
+	*x = QueryIncomingInFlightPacketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIncomingInFlightPacketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIncomingInFlightPacketResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryIncomingInFlightPacketResponse.ProtoReflect.Descriptor instead.
+func (*QueryIncomingInFlightPacketResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryIncomingInFlightPacketResponse) GetPacket() *IncomingInFlightPacket {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+type QueryIncomingInFlightPacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryIncomingInFlightPacketsRequest) Reset() {
+	*x = QueryIncomingInFlightPacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIncomingInFlightPacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIncomingInFlightPacketsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryIncomingInFlightPacketsRequest.ProtoReflect.Descriptor instead.
+func (*QueryIncomingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryIncomingInFlightPacketsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryIncomingInFlightPacketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packets    []*IncomingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryIncomingInFlightPacketsResponse) Reset() {
+	*x = QueryIncomingInFlightPacketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIncomingInFlightPacketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIncomingInFlightPacketsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryIncomingInFlightPacketsResponse.ProtoReflect.Descriptor instead.
+func (*QueryIncomingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryIncomingInFlightPacketsResponse) GetPackets() []*IncomingInFlightPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *QueryIncomingInFlightPacketsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPendingIncomingInFlightPacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinPendingBlocks uint64               `protobuf:"varint,1,opt,name=min_pending_blocks,json=minPendingBlocks,proto3" json:"min_pending_blocks,omitempty"`
+	Pagination       *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPendingIncomingInFlightPacketsRequest) Reset() {
+	*x = QueryPendingIncomingInFlightPacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingIncomingInFlightPacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingIncomingInFlightPacketsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingIncomingInFlightPacketsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingIncomingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryPendingIncomingInFlightPacketsRequest) GetMinPendingBlocks() uint64 {
+	if x != nil {
+		return x.MinPendingBlocks
+	}
+	return 0
+}
+
+func (x *QueryPendingIncomingInFlightPacketsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPendingIncomingInFlightPacketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packets    []*IncomingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) Reset() {
+	*x = QueryPendingIncomingInFlightPacketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingIncomingInFlightPacketsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPendingIncomingInFlightPacketsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingIncomingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) GetPackets() []*IncomingInFlightPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *QueryPendingIncomingInFlightPacketsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryOutgoingInFlightPacketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcPortId    string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
+	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *QueryOutgoingInFlightPacketRequest) Reset() {
+	*x = QueryOutgoingInFlightPacketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOutgoingInFlightPacketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOutgoingInFlightPacketRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOutgoingInFlightPacketRequest.ProtoReflect.Descriptor instead.
+func (*QueryOutgoingInFlightPacketRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryOutgoingInFlightPacketRequest) GetSrcPortId() string {
+	if x != nil {
+		return x.SrcPortId
+	}
+	return ""
+}
+
+func (x *QueryOutgoingInFlightPacketRequest) GetSrcChannelId() string {
+	if x != nil {
+		return x.SrcChannelId
+	}
+	return ""
+}
+
+func (x *QueryOutgoingInFlightPacketRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type QueryOutgoingInFlightPacketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packet *OutgoingInFlightPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (x *QueryOutgoingInFlightPacketResponse) Reset() {
+	*x = QueryOutgoingInFlightPacketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOutgoingInFlightPacketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOutgoingInFlightPacketResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOutgoingInFlightPacketResponse.ProtoReflect.Descriptor instead.
+func (*QueryOutgoingInFlightPacketResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryOutgoingInFlightPacketResponse) GetPacket() *OutgoingInFlightPacket {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+type QueryOutgoingInFlightPacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOutgoingInFlightPacketsRequest) Reset() {
+	*x = QueryOutgoingInFlightPacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOutgoingInFlightPacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOutgoingInFlightPacketsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOutgoingInFlightPacketsRequest.ProtoReflect.Descriptor instead.
+func (*QueryOutgoingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryOutgoingInFlightPacketsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryOutgoingInFlightPacketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packets    []*OutgoingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
+	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOutgoingInFlightPacketsResponse) Reset() {
+	*x = QueryOutgoingInFlightPacketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_swap_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOutgoingInFlightPacketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOutgoingInFlightPacketsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOutgoingInFlightPacketsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOutgoingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryOutgoingInFlightPacketsResponse) GetPackets() []*OutgoingInFlightPacket {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryOutgoingInFlightPacketsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Params
+		return x.Pagination
 	}
 	return nil
 }
 
-type QueryIncomingInFlightPacketRequest struct {
+type QueryCalculationSwapExactAmountInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SrcPortId    string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
-	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
-	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
+	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	AmountIn        string `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	// interface_provider applies the interface fee rate of the provider,
+	// has_interface_fee the interface_fee_rate param if it is not set
+	InterfaceProvider string `protobuf:"bytes,4,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider,omitempty"`
 }
 
-func (x *QueryIncomingInFlightPacketRequest) Reset() {
-	*x = QueryIncomingInFlightPacketRequest{}
+func (x *QueryCalculationSwapExactAmountInRequest) Reset() {
+	*x = QueryCalculationSwapExactAmountInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[2]
+		mi := &file_sunrise_swap_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIncomingInFlightPacketRequest) String() string {
+func (x *QueryCalculationSwapExactAmountInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIncomingInFlightPacketRequest) ProtoMessage() {}
+func (*QueryCalculationSwapExactAmountInRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryIncomingInFlightPacketRequest.ProtoReflect.Descriptor instead.
-func (*QueryIncomingInFlightPacketRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryCalculationSwapExactAmountInRequest.ProtoReflect.Descriptor instead.
+func (*QueryCalculationSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryIncomingInFlightPacketRequest) GetSrcPortId() string {
+func (x *QueryCalculationSwapExactAmountInRequest) GetHasInterfaceFee() bool {
 	if x != nil {
-		return x.SrcPortId
+		return x.HasInterfaceFee
 	}
-	return ""
+	return false
 }
 
-func (x *QueryIncomingInFlightPacketRequest) GetSrcChannelId() string {
+func (x *QueryCalculationSwapExactAmountInRequest) GetRoute() *Route {
 	if x != nil {
-		return x.SrcChannelId
+		return x.Route
+	}
+	return nil
+}
+
+func (x *QueryCalculationSwapExactAmountInRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
 	}
 	return ""
 }
 
-func (x *QueryIncomingInFlightPacketRequest) GetSequence() uint64 {
+func (x *QueryCalculationSwapExactAmountInRequest) GetInterfaceProvider() string {
 	if x != nil {
-		return x.Sequence
+		return x.InterfaceProvider
 	}
-	return 0
+	return ""
 }
 
-type QueryIncomingInFlightPacketResponse struct {
+type QueryCalculationSwapExactAmountInResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packet *IncomingInFlightPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	Result               *RouteResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	InterfaceProviderFee string       `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3" json:"interface_provider_fee,omitempty"`
+	AmountOut            string       `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
 }
 
-func (x *QueryIncomingInFlightPacketResponse) Reset() {
-	*x = QueryIncomingInFlightPacketResponse{}
+func (x *QueryCalculationSwapExactAmountInResponse) Reset() {
+	*x = QueryCalculationSwapExactAmountInResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[3]
+		mi := &file_sunrise_swap_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIncomingInFlightPacketResponse) String() string {
+func (x *QueryCalculationSwapExactAmountInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIncomingInFlightPacketResponse) ProtoMessage() {}
+func (*QueryCalculationSwapExactAmountInResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryIncomingInFlightPacketResponse.ProtoReflect.Descriptor instead.
-func (*QueryIncomingInFlightPacketResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryCalculationSwapExactAmountInResponse.ProtoReflect.Descriptor instead.
+func (*QueryCalculationSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryIncomingInFlightPacketResponse) GetPacket() *IncomingInFlightPacket {
+func (x *QueryCalculationSwapExactAmountInResponse) GetResult() *RouteResult {
 	if x != nil {
-		return x.Packet
+		return x.Result
 	}
 	return nil
 }
 
-type QueryIncomingInFlightPacketsRequest struct {
+func (x *QueryCalculationSwapExactAmountInResponse) GetInterfaceProviderFee() string {
+	if x != nil {
+		return x.InterfaceProviderFee
+	}
+	return ""
+}
+
+func (x *QueryCalculationSwapExactAmountInResponse) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+type QueryCalculationSwapExactAmountOutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
+	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	AmountOut       string `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	// interface_provider applies the interface fee rate of the provider,
+	// has_interface_fee the interface_fee_rate param if it is not set
+	InterfaceProvider string `protobuf:"bytes,4,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider,omitempty"`
 }
 
-func (x *QueryIncomingInFlightPacketsRequest) Reset() {
-	*x = QueryIncomingInFlightPacketsRequest{}
+func (x *QueryCalculationSwapExactAmountOutRequest) Reset() {
+	*x = QueryCalculationSwapExactAmountOutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[4]
+		mi := &file_sunrise_swap_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIncomingInFlightPacketsRequest) String() string {
+func (x *QueryCalculationSwapExactAmountOutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIncomingInFlightPacketsRequest) ProtoMessage() {}
+func (*QueryCalculationSwapExactAmountOutRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryIncomingInFlightPacketsRequest.ProtoReflect.Descriptor instead.
-func (*QueryIncomingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryCalculationSwapExactAmountOutRequest.ProtoReflect.Descriptor instead.
+func (*QueryCalculationSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryIncomingInFlightPacketsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryCalculationSwapExactAmountOutRequest) GetHasInterfaceFee() bool {
 	if x != nil {
-		return x.Pagination
+		return x.HasInterfaceFee
+	}
+	return false
+}
+
+func (x *QueryCalculationSwapExactAmountOutRequest) GetRoute() *Route {
+	if x != nil {
+		return x.Route
 	}
 	return nil
 }
 
-type QueryIncomingInFlightPacketsResponse struct {
+func (x *QueryCalculationSwapExactAmountOutRequest) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+func (x *QueryCalculationSwapExactAmountOutRequest) GetInterfaceProvider() string {
+	if x != nil {
+		return x.InterfaceProvider
+	}
+	return ""
+}
+
+type QueryCalculationSwapExactAmountOutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packets    []*IncomingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
-	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Result               *RouteResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	InterfaceProviderFee string       `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3" json:"interface_provider_fee,omitempty"`
+	AmountIn             string       `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
 }
 
-func (x *QueryIncomingInFlightPacketsResponse) Reset() {
-	*x = QueryIncomingInFlightPacketsResponse{}
+func (x *QueryCalculationSwapExactAmountOutResponse) Reset() {
+	*x = QueryCalculationSwapExactAmountOutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[5]
+		mi := &file_sunrise_swap_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryIncomingInFlightPacketsResponse) String() string {
+func (x *QueryCalculationSwapExactAmountOutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryIncomingInFlightPacketsResponse) ProtoMessage() {}
+func (*QueryCalculationSwapExactAmountOutResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryIncomingInFlightPacketsResponse.ProtoReflect.Descriptor instead.
-func (*QueryIncomingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryCalculationSwapExactAmountOutResponse.ProtoReflect.Descriptor instead.
+func (*QueryCalculationSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryIncomingInFlightPacketsResponse) GetPackets() []*IncomingInFlightPacket {
+func (x *QueryCalculationSwapExactAmountOutResponse) GetResult() *RouteResult {
 	if x != nil {
-		return x.Packets
+		return x.Result
 	}
 	return nil
 }
 
-func (x *QueryIncomingInFlightPacketsResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryCalculationSwapExactAmountOutResponse) GetInterfaceProviderFee() string {
 	if x != nil {
-		return x.Pagination
+		return x.InterfaceProviderFee
 	}
-	return nil
+	return ""
 }
 
-type QueryOutgoingInFlightPacketRequest struct {
+func (x *QueryCalculationSwapExactAmountOutResponse) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+type QueryOptimalRouteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SrcPortId    string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
-	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
-	Sequence     uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DenomIn  string `protobuf:"bytes,1,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty"`
+	DenomOut string `protobuf:"bytes,2,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// Exact amount of denom_in
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Maximum number of pools in a path, defaults to 3
+	MaxHops uint32 `protobuf:"varint,4,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// Maximum number of parallel paths the amount is split into, defaults to 1
+	MaxSplits uint32 `protobuf:"varint,5,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty"`
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) Reset() {
-	*x = QueryOutgoingInFlightPacketRequest{}
+func (x *QueryOptimalRouteRequest) Reset() {
+	*x = QueryOptimalRouteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[6]
+		mi := &file_sunrise_swap_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) String() string {
+func (x *QueryOptimalRouteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutgoingInFlightPacketRequest) ProtoMessage() {}
+func (*QueryOptimalRouteRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOutgoingInFlightPacketRequest.ProtoReflect.Descriptor instead.
-func (*QueryOutgoingInFlightPacketRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryOptimalRouteRequest.ProtoReflect.Descriptor instead.
+func (*QueryOptimalRouteRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) GetSrcPortId() string {
+func (x *QueryOptimalRouteRequest) GetDenomIn() string {
 	if x != nil {
-		return x.SrcPortId
+		return x.DenomIn
 	}
 	return ""
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) GetSrcChannelId() string {
+func (x *QueryOptimalRouteRequest) GetDenomOut() string {
 	if x != nil {
-		return x.SrcChannelId
+		return x.DenomOut
 	}
 	return ""
 }
 
-func (x *QueryOutgoingInFlightPacketRequest) GetSequence() uint64 {
+func (x *QueryOptimalRouteRequest) GetAmount() string {
 	if x != nil {
-		return x.Sequence
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *QueryOptimalRouteRequest) GetMaxHops() uint32 {
+	if x != nil {
+		return x.MaxHops
 	}
 	return 0
 }
 
-type QueryOutgoingInFlightPacketResponse struct {
+func (x *QueryOptimalRouteRequest) GetMaxSplits() uint32 {
+	if x != nil {
+		return x.MaxSplits
+	}
+	return 0
+}
+
+type QueryOptimalRouteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packet *OutgoingInFlightPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
+	Route  *Route       `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Result *RouteResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *QueryOutgoingInFlightPacketResponse) Reset() {
-	*x = QueryOutgoingInFlightPacketResponse{}
+func (x *QueryOptimalRouteResponse) Reset() {
+	*x = QueryOptimalRouteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[7]
+		mi := &file_sunrise_swap_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutgoingInFlightPacketResponse) String() string {
+func (x *QueryOptimalRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutgoingInFlightPacketResponse) ProtoMessage() {}
+func (*QueryOptimalRouteResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryOutgoingInFlightPacketResponse.ProtoReflect.Descriptor instead.
-func (*QueryOutgoingInFlightPacketResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryOptimalRouteResponse.ProtoReflect.Descriptor instead.
+func (*QueryOptimalRouteResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryOutgoingInFlightPacketResponse) GetPacket() *OutgoingInFlightPacket {
+func (x *QueryOptimalRouteResponse) GetRoute() *Route {
 	if x != nil {
-		return x.Packet
+		return x.Route
 	}
 	return nil
 }
 
-type QueryOutgoingInFlightPacketsRequest struct {
+func (x *QueryOptimalRouteResponse) GetResult() *RouteResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type QueryInterfaceProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryOutgoingInFlightPacketsRequest) Reset() {
-	*x = QueryOutgoingInFlightPacketsRequest{}
+func (x *QueryInterfaceProviderRequest) Reset() {
+	*x = QueryInterfaceProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[8]
+		mi := &file_sunrise_swap_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutgoingInFlightPacketsRequest) String() string {
+func (x *QueryInterfaceProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutgoingInFlightPacketsRequest) ProtoMessage() {}
+func (*QueryInterfaceProviderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryOutgoingInFlightPacketsRequest.ProtoReflect.Descriptor instead.
-func (*QueryOutgoingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryInterfaceProviderRequest.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProviderRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryOutgoingInFlightPacketsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryInterfaceProviderRequest) GetAddress() string {
 	if x != nil {
-		return x.Pagination
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type QueryOutgoingInFlightPacketsResponse struct {
+type QueryInterfaceProviderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Packets    []*OutgoingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets,omitempty"`
-	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	InterfaceProvider *InterfaceProvider `protobuf:"bytes,1,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider,omitempty"`
 }
 
-func (x *QueryOutgoingInFlightPacketsResponse) Reset() {
-	*x = QueryOutgoingInFlightPacketsResponse{}
+func (x *QueryInterfaceProviderResponse) Reset() {
+	*x = QueryInterfaceProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[9]
+		mi := &file_sunrise_swap_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryOutgoingInFlightPacketsResponse) String() string {
+func (x *QueryInterfaceProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryOutgoingInFlightPacketsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryOutgoingInFlightPacketsResponse.ProtoReflect.Descriptor instead.
-func (*QueryOutgoingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *QueryOutgoingInFlightPacketsResponse) GetPackets() []*OutgoingInFlightPacket {
-	if x != nil {
-		return x.Packets
-	}
-	return nil
-}
-
-func (x *QueryOutgoingInFlightPacketsResponse) GetPagination() *v1beta1.PageResponse {
+func (*QueryInterfaceProviderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryInterfaceProviderResponse.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProviderResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryInterfaceProviderResponse) GetInterfaceProvider() *InterfaceProvider {
 	if x != nil {
-		return x.Pagination
+		return x.InterfaceProvider
 	}
 	return nil
 }
 
-type QueryCalculationSwapExactAmountInRequest struct {
+type QueryInterfaceProvidersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
-	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	AmountIn        string `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryCalculationSwapExactAmountInRequest) Reset() {
-	*x = QueryCalculationSwapExactAmountInRequest{}
+func (x *QueryInterfaceProvidersRequest) Reset() {
+	*x = QueryInterfaceProvidersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[10]
+		mi := &file_sunrise_swap_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculationSwapExactAmountInRequest) String() string {
+func (x *QueryInterfaceProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculationSwapExactAmountInRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryCalculationSwapExactAmountInRequest.ProtoReflect.Descriptor instead.
-func (*QueryCalculationSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{10}
-}
+func (*QueryInterfaceProvidersRequest) ProtoMessage() {}
 
-func (x *QueryCalculationSwapExactAmountInRequest) GetHasInterfaceFee() bool {
-	if x != nil {
-		return x.HasInterfaceFee
-	}
-	return false
+// Deprecated: Use QueryInterfaceProvidersRequest.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryCalculationSwapExactAmountInRequest) GetRoute() *Route {
+func (x *QueryInterfaceProvidersRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.Route
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *QueryCalculationSwapExactAmountInRequest) GetAmountIn() string {
-	if x != nil {
-		return x.AmountIn
-	}
-	return ""
-}
-
-type QueryCalculationSwapExactAmountInResponse struct {
+type QueryInterfaceProvidersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result               *RouteResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	InterfaceProviderFee string       `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3" json:"interface_provider_fee,omitempty"`
-	AmountOut            string       `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	InterfaceProviders []*InterfaceProvider  `protobuf:"bytes,1,rep,name=interface_providers,json=interfaceProviders,proto3" json:"interface_providers,omitempty"`
+	Pagination         *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryCalculationSwapExactAmountInResponse) Reset() {
-	*x = QueryCalculationSwapExactAmountInResponse{}
+func (x *QueryInterfaceProvidersResponse) Reset() {
+	*x = QueryInterfaceProvidersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[11]
+		mi := &file_sunrise_swap_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculationSwapExactAmountInResponse) String() string {
+func (x *QueryInterfaceProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculationSwapExactAmountInResponse) ProtoMessage() {}
+func (*QueryInterfaceProvidersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCalculationSwapExactAmountInResponse.ProtoReflect.Descriptor instead.
-func (*QueryCalculationSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryInterfaceProvidersResponse.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryCalculationSwapExactAmountInResponse) GetResult() *RouteResult {
+func (x *QueryInterfaceProvidersResponse) GetInterfaceProviders() []*InterfaceProvider {
 	if x != nil {
-		return x.Result
+		return x.InterfaceProviders
 	}
 	return nil
 }
 
-func (x *QueryCalculationSwapExactAmountInResponse) GetInterfaceProviderFee() string {
-	if x != nil {
-		return x.InterfaceProviderFee
-	}
-	return ""
-}
-
-func (x *QueryCalculationSwapExactAmountInResponse) GetAmountOut() string {
+func (x *QueryInterfaceProvidersResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.AmountOut
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryCalculationSwapExactAmountOutRequest struct {
+type QueryInterfaceProviderStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
-	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	AmountOut       string `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryCalculationSwapExactAmountOutRequest) Reset() {
-	*x = QueryCalculationSwapExactAmountOutRequest{}
+func (x *QueryInterfaceProviderStatsRequest) Reset() {
+	*x = QueryInterfaceProviderStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[12]
+		mi := &file_sunrise_swap_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculationSwapExactAmountOutRequest) String() string {
+func (x *QueryInterfaceProviderStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculationSwapExactAmountOutRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryCalculationSwapExactAmountOutRequest.ProtoReflect.Descriptor instead.
-func (*QueryCalculationSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *QueryCalculationSwapExactAmountOutRequest) GetHasInterfaceFee() bool {
-	if x != nil {
-		return x.HasInterfaceFee
-	}
-	return false
-}
+func (*QueryInterfaceProviderStatsRequest) ProtoMessage() {}
 
-func (x *QueryCalculationSwapExactAmountOutRequest) GetRoute() *Route {
-	if x != nil {
-		return x.Route
-	}
-	return nil
+// Deprecated: Use QueryInterfaceProviderStatsRequest.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProviderStatsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryCalculationSwapExactAmountOutRequest) GetAmountOut() string {
+func (x *QueryInterfaceProviderStatsRequest) GetAddress() string {
 	if x != nil {
-		return x.AmountOut
+		return x.Address
 	}
 	return ""
 }
 
-type QueryCalculationSwapExactAmountOutResponse struct {
+type QueryInterfaceProviderStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result               *RouteResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	InterfaceProviderFee string       `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3" json:"interface_provider_fee,omitempty"`
-	AmountIn             string       `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	Stats *InterfaceProviderStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *QueryCalculationSwapExactAmountOutResponse) Reset() {
-	*x = QueryCalculationSwapExactAmountOutResponse{}
+func (x *QueryInterfaceProviderStatsResponse) Reset() {
+	*x = QueryInterfaceProviderStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_swap_query_proto_msgTypes[13]
+		mi := &file_sunrise_swap_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculationSwapExactAmountOutResponse) String() string {
+func (x *QueryInterfaceProviderStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculationSwapExactAmountOutResponse) ProtoMessage() {}
+func (*QueryInterfaceProviderStatsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCalculationSwapExactAmountOutResponse.ProtoReflect.Descriptor instead.
-func (*QueryCalculationSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryInterfaceProviderStatsResponse.ProtoReflect.Descriptor instead.
+func (*QueryInterfaceProviderStatsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_swap_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryCalculationSwapExactAmountOutResponse) GetResult() *RouteResult {
+func (x *QueryInterfaceProviderStatsResponse) GetStats() *InterfaceProviderStats {
 	if x != nil {
-		return x.Result
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *QueryCalculationSwapExactAmountOutResponse) GetInterfaceProviderFee() string {
-	if x != nil {
-		return x.InterfaceProviderFee
-	}
-	return ""
-}
-
-func (x *QueryCalculationSwapExactAmountOutResponse) GetAmountIn() string {
-	if x != nil {
-		return x.AmountIn
-	}
-	return ""
-}
-
 var File_sunrise_swap_query_proto protoreflect.FileDescriptor
 
 var file_sunrise_swap_query_proto_rawDesc = []byte{
@@ -7617,123 +13114,122 @@ var file_sunrise_swap_query_proto_rawDesc = []byte{
 	0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x23, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f,
 	0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x73, 0x77, 0x61, 0x70, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
-	0x0e, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22,
-	0x69, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x6d, 0x0a, 0x23, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x24, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x86, 0x01,
+	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x50, 0x6f,
+	0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x72,
+	0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x22, 0x6d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x86, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x23, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x6d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xb5, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x2a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x01,
+	0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
 	0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
 	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4f, 0x75,
-	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
 	0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
 	0x65, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a,
-	0x28, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x22, 0xa2, 0x02,
-	0x0a, 0x29, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x66, 0x0a, 0x16, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x65,
-	0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x75, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x29, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x05,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x2a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45,
-	0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a,
+	0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x72, 0x63,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x22, 0x6d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xb5, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a, 0x28, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xa2, 0x02, 0x0a, 0x29, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
 	0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
@@ -7744,105 +13240,252 @@ var file_sunrise_swap_query_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
 	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
 	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x32, 0xba, 0x0a, 0x0a, 0x05, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20,
-	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0xdb, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x2e, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
-	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x56, 0x12, 0x54, 0x2f, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x12,
-	0xb4, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x75,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0xd0, 0x01, 0x0a,
+	0x29, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61,
+	0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0xa1, 0x02, 0x0a, 0x2a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x66, 0x0a, 0x16,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x39, 0x0a, 0x1d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x76, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x12, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0x68, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e,
+	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x67,
+	0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x32, 0xaa, 0x11, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xdb,
+	0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x56, 0x12, 0x54, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0xb4, 0x01, 0x0a,
+	0x17, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x75,
 	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x12, 0xe6, 0x01, 0x0a, 0x1e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x39, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77,
+	0x61, 0x70, 0x2f, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x7d, 0x12, 0xd9, 0x01, 0x0a,
+	0x16, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x54, 0x12, 0x52, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x2f, 0x7b,
+	0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x72,
+	0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77,
+	0x61, 0x70, 0x2f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0xcb, 0x01,
+	0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61,
+	0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x36,
 	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x73, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x6b, 0x5f, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x69, 0x6e,
-	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0xd9, 0x01, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x67, 0x6f,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x12, 0x52,
-	0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e,
-	0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x17, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x31,
+	0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77,
+	0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x12, 0xcf, 0x01, 0x0a, 0x1d,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45,
+	0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x2e,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61,
+	0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0xa3, 0x01,
+	0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0xb8, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x2e,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x84, 0x01,
+	0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x26,
 	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x32, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x69, 0x6e, 0x5f,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x6f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0xcb, 0x01, 0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x36, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63,
-	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x37, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x73,
-	0x77, 0x61, 0x70, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x12, 0xcf, 0x01, 0x0a, 0x1d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x37, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x73, 0x77, 0x61, 0x70, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x42, 0x8e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa,
-	0x02, 0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02,
-	0x0c, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x18,
-	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x42, 0x8e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0c, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x0c, 0x53, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x18, 0x53, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a,
+	0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7857,63 +13500,95 @@ func file_sunrise_swap_query_proto_rawDescGZIP() []byte {
 	return file_sunrise_swap_query_proto_rawDescData
 }
 
-var file_sunrise_swap_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_sunrise_swap_query_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_sunrise_swap_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),                         // 0: sunrise.swap.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                        // 1: sunrise.swap.QueryParamsResponse
-	(*QueryIncomingInFlightPacketRequest)(nil),         // 2: sunrise.swap.QueryIncomingInFlightPacketRequest
-	(*QueryIncomingInFlightPacketResponse)(nil),        // 3: sunrise.swap.QueryIncomingInFlightPacketResponse
-	(*QueryIncomingInFlightPacketsRequest)(nil),        // 4: sunrise.swap.QueryIncomingInFlightPacketsRequest
-	(*QueryIncomingInFlightPacketsResponse)(nil),       // 5: sunrise.swap.QueryIncomingInFlightPacketsResponse
-	(*QueryOutgoingInFlightPacketRequest)(nil),         // 6: sunrise.swap.QueryOutgoingInFlightPacketRequest
-	(*QueryOutgoingInFlightPacketResponse)(nil),        // 7: sunrise.swap.QueryOutgoingInFlightPacketResponse
-	(*QueryOutgoingInFlightPacketsRequest)(nil),        // 8: sunrise.swap.QueryOutgoingInFlightPacketsRequest
-	(*QueryOutgoingInFlightPacketsResponse)(nil),       // 9: sunrise.swap.QueryOutgoingInFlightPacketsResponse
-	(*QueryCalculationSwapExactAmountInRequest)(nil),   // 10: sunrise.swap.QueryCalculationSwapExactAmountInRequest
-	(*QueryCalculationSwapExactAmountInResponse)(nil),  // 11: sunrise.swap.QueryCalculationSwapExactAmountInResponse
-	(*QueryCalculationSwapExactAmountOutRequest)(nil),  // 12: sunrise.swap.QueryCalculationSwapExactAmountOutRequest
-	(*QueryCalculationSwapExactAmountOutResponse)(nil), // 13: sunrise.swap.QueryCalculationSwapExactAmountOutResponse
-	(*Params)(nil),                 // 14: sunrise.swap.Params
-	(*IncomingInFlightPacket)(nil), // 15: sunrise.swap.IncomingInFlightPacket
-	(*v1beta1.PageRequest)(nil),    // 16: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),   // 17: cosmos.base.query.v1beta1.PageResponse
-	(*OutgoingInFlightPacket)(nil), // 18: sunrise.swap.OutgoingInFlightPacket
-	(*Route)(nil),                  // 19: sunrise.swap.Route
-	(*RouteResult)(nil),            // 20: sunrise.swap.RouteResult
+	(*QueryParamsRequest)(nil),                          // 0: sunrise.swap.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                         // 1: sunrise.swap.QueryParamsResponse
+	(*QueryIncomingInFlightPacketRequest)(nil),          // 2: sunrise.swap.QueryIncomingInFlightPacketRequest
+	(*QueryIncomingInFlightPacketResponse)(nil),         // 3: sunrise.swap.QueryIncomingInFlightPacketResponse
+	(*QueryIncomingInFlightPacketsRequest)(nil),         // 4: sunrise.swap.QueryIncomingInFlightPacketsRequest
+	(*QueryIncomingInFlightPacketsResponse)(nil),        // 5: sunrise.swap.QueryIncomingInFlightPacketsResponse
+	(*QueryPendingIncomingInFlightPacketsRequest)(nil),  // 6: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest
+	(*QueryPendingIncomingInFlightPacketsResponse)(nil), // 7: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse
+	(*QueryOutgoingInFlightPacketRequest)(nil),          // 8: sunrise.swap.QueryOutgoingInFlightPacketRequest
+	(*QueryOutgoingInFlightPacketResponse)(nil),         // 9: sunrise.swap.QueryOutgoingInFlightPacketResponse
+	(*QueryOutgoingInFlightPacketsRequest)(nil),         // 10: sunrise.swap.QueryOutgoingInFlightPacketsRequest
+	(*QueryOutgoingInFlightPacketsResponse)(nil),        // 11: sunrise.swap.QueryOutgoingInFlightPacketsResponse
+	(*QueryCalculationSwapExactAmountInRequest)(nil),    // 12: sunrise.swap.QueryCalculationSwapExactAmountInRequest
+	(*QueryCalculationSwapExactAmountInResponse)(nil),   // 13: sunrise.swap.QueryCalculationSwapExactAmountInResponse
+	(*QueryCalculationSwapExactAmountOutRequest)(nil),   // 14: sunrise.swap.QueryCalculationSwapExactAmountOutRequest
+	(*QueryCalculationSwapExactAmountOutResponse)(nil),  // 15: sunrise.swap.QueryCalculationSwapExactAmountOutResponse
+	(*QueryOptimalRouteRequest)(nil),                    // 16: sunrise.swap.QueryOptimalRouteRequest
+	(*QueryOptimalRouteResponse)(nil),                   // 17: sunrise.swap.QueryOptimalRouteResponse
+	(*QueryInterfaceProviderRequest)(nil),               // 18: sunrise.swap.QueryInterfaceProviderRequest
+	(*QueryInterfaceProviderResponse)(nil),              // 19: sunrise.swap.QueryInterfaceProviderResponse
+	(*QueryInterfaceProvidersRequest)(nil),              // 20: sunrise.swap.QueryInterfaceProvidersRequest
+	(*QueryInterfaceProvidersResponse)(nil),             // 21: sunrise.swap.QueryInterfaceProvidersResponse
+	(*QueryInterfaceProviderStatsRequest)(nil),          // 22: sunrise.swap.QueryInterfaceProviderStatsRequest
+	(*QueryInterfaceProviderStatsResponse)(nil),         // 23: sunrise.swap.QueryInterfaceProviderStatsResponse
+	(*Params)(nil),                 // 24: sunrise.swap.Params
+	(*IncomingInFlightPacket)(nil), // 25: sunrise.swap.IncomingInFlightPacket
+	(*v1beta1.PageRequest)(nil),    // 26: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),   // 27: cosmos.base.query.v1beta1.PageResponse
+	(*OutgoingInFlightPacket)(nil), // 28: sunrise.swap.OutgoingInFlightPacket
+	(*Route)(nil),                  // 29: sunrise.swap.Route
+	(*RouteResult)(nil),            // 30: sunrise.swap.RouteResult
+	(*InterfaceProvider)(nil),      // 31: sunrise.swap.InterfaceProvider
+	(*InterfaceProviderStats)(nil), // 32: sunrise.swap.InterfaceProviderStats
 }
 var file_sunrise_swap_query_proto_depIdxs = []int32{
-	14, // 0: sunrise.swap.QueryParamsResponse.params:type_name -> sunrise.swap.Params
-	15, // 1: sunrise.swap.QueryIncomingInFlightPacketResponse.packet:type_name -> sunrise.swap.IncomingInFlightPacket
-	16, // 2: sunrise.swap.QueryIncomingInFlightPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	15, // 3: sunrise.swap.QueryIncomingInFlightPacketsResponse.packets:type_name -> sunrise.swap.IncomingInFlightPacket
-	17, // 4: sunrise.swap.QueryIncomingInFlightPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	18, // 5: sunrise.swap.QueryOutgoingInFlightPacketResponse.packet:type_name -> sunrise.swap.OutgoingInFlightPacket
-	16, // 6: sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 7: sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets:type_name -> sunrise.swap.OutgoingInFlightPacket
-	17, // 8: sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	19, // 9: sunrise.swap.QueryCalculationSwapExactAmountInRequest.route:type_name -> sunrise.swap.Route
-	20, // 10: sunrise.swap.QueryCalculationSwapExactAmountInResponse.result:type_name -> sunrise.swap.RouteResult
-	19, // 11: sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route:type_name -> sunrise.swap.Route
-	20, // 12: sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result:type_name -> sunrise.swap.RouteResult
-	0,  // 13: sunrise.swap.Query.Params:input_type -> sunrise.swap.QueryParamsRequest
-	2,  // 14: sunrise.swap.Query.IncomingInFlightPacket:input_type -> sunrise.swap.QueryIncomingInFlightPacketRequest
-	4,  // 15: sunrise.swap.Query.IncomingInFlightPackets:input_type -> sunrise.swap.QueryIncomingInFlightPacketsRequest
-	6,  // 16: sunrise.swap.Query.OutgoingInFlightPacket:input_type -> sunrise.swap.QueryOutgoingInFlightPacketRequest
-	8,  // 17: sunrise.swap.Query.OutgoingInFlightPackets:input_type -> sunrise.swap.QueryOutgoingInFlightPacketsRequest
-	10, // 18: sunrise.swap.Query.CalculationSwapExactAmountIn:input_type -> sunrise.swap.QueryCalculationSwapExactAmountInRequest
-	12, // 19: sunrise.swap.Query.CalculationSwapExactAmountOut:input_type -> sunrise.swap.QueryCalculationSwapExactAmountOutRequest
-	1,  // 20: sunrise.swap.Query.Params:output_type -> sunrise.swap.QueryParamsResponse
-	3,  // 21: sunrise.swap.Query.IncomingInFlightPacket:output_type -> sunrise.swap.QueryIncomingInFlightPacketResponse
-	5,  // 22: sunrise.swap.Query.IncomingInFlightPackets:output_type -> sunrise.swap.QueryIncomingInFlightPacketsResponse
-	7,  // 23: sunrise.swap.Query.OutgoingInFlightPacket:output_type -> sunrise.swap.QueryOutgoingInFlightPacketResponse
-	9,  // 24: sunrise.swap.Query.OutgoingInFlightPackets:output_type -> sunrise.swap.QueryOutgoingInFlightPacketsResponse
-	11, // 25: sunrise.swap.Query.CalculationSwapExactAmountIn:output_type -> sunrise.swap.QueryCalculationSwapExactAmountInResponse
-	13, // 26: sunrise.swap.Query.CalculationSwapExactAmountOut:output_type -> sunrise.swap.QueryCalculationSwapExactAmountOutResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	24, // 0: sunrise.swap.QueryParamsResponse.params:type_name -> sunrise.swap.Params
+	25, // 1: sunrise.swap.QueryIncomingInFlightPacketResponse.packet:type_name -> sunrise.swap.IncomingInFlightPacket
+	26, // 2: sunrise.swap.QueryIncomingInFlightPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	25, // 3: sunrise.swap.QueryIncomingInFlightPacketsResponse.packets:type_name -> sunrise.swap.IncomingInFlightPacket
+	27, // 4: sunrise.swap.QueryIncomingInFlightPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	26, // 5: sunrise.swap.QueryPendingIncomingInFlightPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	25, // 6: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.packets:type_name -> sunrise.swap.IncomingInFlightPacket
+	27, // 7: sunrise.swap.QueryPendingIncomingInFlightPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 8: sunrise.swap.QueryOutgoingInFlightPacketResponse.packet:type_name -> sunrise.swap.OutgoingInFlightPacket
+	26, // 9: sunrise.swap.QueryOutgoingInFlightPacketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 10: sunrise.swap.QueryOutgoingInFlightPacketsResponse.packets:type_name -> sunrise.swap.OutgoingInFlightPacket
+	27, // 11: sunrise.swap.QueryOutgoingInFlightPacketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	29, // 12: sunrise.swap.QueryCalculationSwapExactAmountInRequest.route:type_name -> sunrise.swap.Route
+	30, // 13: sunrise.swap.QueryCalculationSwapExactAmountInResponse.result:type_name -> sunrise.swap.RouteResult
+	29, // 14: sunrise.swap.QueryCalculationSwapExactAmountOutRequest.route:type_name -> sunrise.swap.Route
+	30, // 15: sunrise.swap.QueryCalculationSwapExactAmountOutResponse.result:type_name -> sunrise.swap.RouteResult
+	29, // 16: sunrise.swap.QueryOptimalRouteResponse.route:type_name -> sunrise.swap.Route
+	30, // 17: sunrise.swap.QueryOptimalRouteResponse.result:type_name -> sunrise.swap.RouteResult
+	31, // 18: sunrise.swap.QueryInterfaceProviderResponse.interface_provider:type_name -> sunrise.swap.InterfaceProvider
+	26, // 19: sunrise.swap.QueryInterfaceProvidersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 20: sunrise.swap.QueryInterfaceProvidersResponse.interface_providers:type_name -> sunrise.swap.InterfaceProvider
+	27, // 21: sunrise.swap.QueryInterfaceProvidersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 22: sunrise.swap.QueryInterfaceProviderStatsResponse.stats:type_name -> sunrise.swap.InterfaceProviderStats
+	0,  // 23: sunrise.swap.Query.Params:input_type -> sunrise.swap.QueryParamsRequest
+	2,  // 24: sunrise.swap.Query.IncomingInFlightPacket:input_type -> sunrise.swap.QueryIncomingInFlightPacketRequest
+	4,  // 25: sunrise.swap.Query.IncomingInFlightPackets:input_type -> sunrise.swap.QueryIncomingInFlightPacketsRequest
+	6,  // 26: sunrise.swap.Query.PendingIncomingInFlightPackets:input_type -> sunrise.swap.QueryPendingIncomingInFlightPacketsRequest
+	8,  // 27: sunrise.swap.Query.OutgoingInFlightPacket:input_type -> sunrise.swap.QueryOutgoingInFlightPacketRequest
+	10, // 28: sunrise.swap.Query.OutgoingInFlightPackets:input_type -> sunrise.swap.QueryOutgoingInFlightPacketsRequest
+	12, // 29: sunrise.swap.Query.CalculationSwapExactAmountIn:input_type -> sunrise.swap.QueryCalculationSwapExactAmountInRequest
+	14, // 30: sunrise.swap.Query.CalculationSwapExactAmountOut:input_type -> sunrise.swap.QueryCalculationSwapExactAmountOutRequest
+	18, // 31: sunrise.swap.Query.InterfaceProvider:input_type -> sunrise.swap.QueryInterfaceProviderRequest
+	20, // 32: sunrise.swap.Query.InterfaceProviders:input_type -> sunrise.swap.QueryInterfaceProvidersRequest
+	22, // 33: sunrise.swap.Query.InterfaceProviderStats:input_type -> sunrise.swap.QueryInterfaceProviderStatsRequest
+	16, // 34: sunrise.swap.Query.OptimalRoute:input_type -> sunrise.swap.QueryOptimalRouteRequest
+	1,  // 35: sunrise.swap.Query.Params:output_type -> sunrise.swap.QueryParamsResponse
+	3,  // 36: sunrise.swap.Query.IncomingInFlightPacket:output_type -> sunrise.swap.QueryIncomingInFlightPacketResponse
+	5,  // 37: sunrise.swap.Query.IncomingInFlightPackets:output_type -> sunrise.swap.QueryIncomingInFlightPacketsResponse
+	7,  // 38: sunrise.swap.Query.PendingIncomingInFlightPackets:output_type -> sunrise.swap.QueryPendingIncomingInFlightPacketsResponse
+	9,  // 39: sunrise.swap.Query.OutgoingInFlightPacket:output_type -> sunrise.swap.QueryOutgoingInFlightPacketResponse
+	11, // 40: sunrise.swap.Query.OutgoingInFlightPackets:output_type -> sunrise.swap.QueryOutgoingInFlightPacketsResponse
+	13, // 41: sunrise.swap.Query.CalculationSwapExactAmountIn:output_type -> sunrise.swap.QueryCalculationSwapExactAmountInResponse
+	15, // 42: sunrise.swap.Query.CalculationSwapExactAmountOut:output_type -> sunrise.swap.QueryCalculationSwapExactAmountOutResponse
+	19, // 43: sunrise.swap.Query.InterfaceProvider:output_type -> sunrise.swap.QueryInterfaceProviderResponse
+	21, // 44: sunrise.swap.Query.InterfaceProviders:output_type -> sunrise.swap.QueryInterfaceProvidersResponse
+	23, // 45: sunrise.swap.Query.InterfaceProviderStats:output_type -> sunrise.swap.QueryInterfaceProviderStatsResponse
+	17, // 46: sunrise.swap.Query.OptimalRoute:output_type -> sunrise.swap.QueryOptimalRouteResponse
+	35, // [35:47] is the sub-list for method output_type
+	23, // [23:35] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_swap_query_proto_init() }
@@ -7923,6 +13598,7 @@ func file_sunrise_swap_query_proto_init() {
 	}
 	file_sunrise_swap_params_proto_init()
 	file_sunrise_swap_in_flight_packet_proto_init()
+	file_sunrise_swap_interface_provider_proto_init()
 	file_sunrise_swap_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_sunrise_swap_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -7998,7 +13674,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutgoingInFlightPacketRequest); i {
+			switch v := v.(*QueryPendingIncomingInFlightPacketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8010,7 +13686,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutgoingInFlightPacketResponse); i {
+			switch v := v.(*QueryPendingIncomingInFlightPacketsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8022,7 +13698,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutgoingInFlightPacketsRequest); i {
+			switch v := v.(*QueryOutgoingInFlightPacketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8034,7 +13710,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOutgoingInFlightPacketsResponse); i {
+			switch v := v.(*QueryOutgoingInFlightPacketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8046,7 +13722,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationSwapExactAmountInRequest); i {
+			switch v := v.(*QueryOutgoingInFlightPacketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8058,7 +13734,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationSwapExactAmountInResponse); i {
+			switch v := v.(*QueryOutgoingInFlightPacketsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8070,7 +13746,7 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculationSwapExactAmountOutRequest); i {
+			switch v := v.(*QueryCalculationSwapExactAmountInRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8082,6 +13758,30 @@ func file_sunrise_swap_query_proto_init() {
 			}
 		}
 		file_sunrise_swap_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCalculationSwapExactAmountInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCalculationSwapExactAmountOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryCalculationSwapExactAmountOutResponse); i {
 			case 0:
 				return &v.state
@@ -8093,6 +13793,102 @@ func file_sunrise_swap_query_proto_init() {
 				return nil
 			}
 		}
+		file_sunrise_swap_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOptimalRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOptimalRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProviderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProviderStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_swap_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInterfaceProviderStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8100,7 +13896,7 @@ func file_sunrise_swap_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sunrise_swap_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                        = "/sunrise.swap.Query/Params"
-	Query_IncomingInFlightPacket_FullMethodName        = "/sunrise.swap.Query/IncomingInFlightPacket"
-	Query_IncomingInFlightPackets_FullMethodName       = "/sunrise.swap.Query/IncomingInFlightPackets"
-	Query_OutgoingInFlightPacket_FullMethodName        = "/sunrise.swap.Query/OutgoingInFlightPacket"
-	Query_OutgoingInFlightPackets_FullMethodName       = "/sunrise.swap.Query/OutgoingInFlightPackets"
-	Query_CalculationSwapExactAmountIn_FullMethodName  = "/sunrise.swap.Query/CalculationSwapExactAmountIn"
-	Query_CalculationSwapExactAmountOut_FullMethodName = "/sunrise.swap.Query/CalculationSwapExactAmountOut"
+	Query_Params_FullMethodName                         = "/sunrise.swap.Query/Params"
+	Query_IncomingInFlightPacket_FullMethodName         = "/sunrise.swap.Query/IncomingInFlightPacket"
+	Query_IncomingInFlightPackets_FullMethodName        = "/sunrise.swap.Query/IncomingInFlightPackets"
+	Query_PendingIncomingInFlightPackets_FullMethodName = "/sunrise.swap.Query/PendingIncomingInFlightPackets"
+	Query_OutgoingInFlightPacket_FullMethodName         = "/sunrise.swap.Query/OutgoingInFlightPacket"
+	Query_OutgoingInFlightPackets_FullMethodName        = "/sunrise.swap.Query/OutgoingInFlightPackets"
+	Query_CalculationSwapExactAmountIn_FullMethodName   = "/sunrise.swap.Query/CalculationSwapExactAmountIn"
+	Query_CalculationSwapExactAmountOut_FullMethodName  = "/sunrise.swap.Query/CalculationSwapExactAmountOut"
+	Query_InterfaceProvider_FullMethodName              = "/sunrise.swap.Query/InterfaceProvider"
+	Query_InterfaceProviders_FullMethodName             = "/sunrise.swap.Query/InterfaceProviders"
+	Query_InterfaceProviderStats_FullMethodName         = "/sunrise.swap.Query/InterfaceProviderStats"
+	Query_OptimalRoute_FullMethodName                   = "/sunrise.swap.Query/OptimalRoute"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,12 +42,22 @@ type QueryClient interface {
 	// Queries a list of IncomingInFlightPacket items.
 	IncomingInFlightPacket(ctx context.Context, in *QueryIncomingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketResponse, error)
 	IncomingInFlightPackets(ctx context.Context, in *QueryIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketsResponse, error)
+	// Queries the IncomingInFlightPacket items pending for at least a number of blocks
+	PendingIncomingInFlightPackets(ctx context.Context, in *QueryPendingIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIncomingInFlightPacketsResponse, error)
 	// Queries a list of OutgoingInFlightPacket items.
 	OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error)
 	OutgoingInFlightPackets(ctx context.Context, in *QueryOutgoingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketsResponse, error)
 	// Queries a Calculation swap value.
 	CalculationSwapExactAmountIn(ctx context.Context, in *QueryCalculationSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountInResponse, error)
 	CalculationSwapExactAmountOut(ctx context.Context, in *QueryCalculationSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountOutResponse, error)
+	// Queries an InterfaceProvider by its address.
+	InterfaceProvider(ctx context.Context, in *QueryInterfaceProviderRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderResponse, error)
+	// Queries a list of InterfaceProvider items.
+	InterfaceProviders(ctx context.Context, in *QueryInterfaceProvidersRequest, opts ...grpc.CallOption) (*QueryInterfaceProvidersResponse, error)
+	// Queries the cumulative volume and fees of the swaps of an interface provider.
+	InterfaceProviderStats(ctx context.Context, in *QueryInterfaceProviderStatsRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderStatsResponse, error)
+	// Queries the route with the largest output for an exact input amount
+	OptimalRoute(ctx context.Context, in *QueryOptimalRouteRequest, opts ...grpc.CallOption) (*QueryOptimalRouteResponse, error)
 }
 
 type queryClient struct {
@@ -80,6 +95,15 @@ func (c *queryClient) IncomingInFlightPackets(ctx context.Context, in *QueryInco
 	return out, nil
 }
 
+func (c *queryClient) PendingIncomingInFlightPackets(ctx context.Context, in *QueryPendingIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIncomingInFlightPacketsResponse, error) {
+	out := new(QueryPendingIncomingInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingIncomingInFlightPackets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error) {
 	out := new(QueryOutgoingInFlightPacketResponse)
 	err := c.cc.Invoke(ctx, Query_OutgoingInFlightPacket_FullMethodName, in, out, opts...)
@@ -116,6 +140,42 @@ func (c *queryClient) CalculationSwapExactAmountOut(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) InterfaceProvider(ctx context.Context, in *QueryInterfaceProviderRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderResponse, error) {
+	out := new(QueryInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, Query_InterfaceProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterfaceProviders(ctx context.Context, in *QueryInterfaceProvidersRequest, opts ...grpc.CallOption) (*QueryInterfaceProvidersResponse, error) {
+	out := new(QueryInterfaceProvidersResponse)
+	err := c.cc.Invoke(ctx, Query_InterfaceProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterfaceProviderStats(ctx context.Context, in *QueryInterfaceProviderStatsRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderStatsResponse, error) {
+	out := new(QueryInterfaceProviderStatsResponse)
+	err := c.cc.Invoke(ctx, Query_InterfaceProviderStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OptimalRoute(ctx context.Context, in *QueryOptimalRouteRequest, opts ...grpc.CallOption) (*QueryOptimalRouteResponse, error) {
+	out := new(QueryOptimalRouteResponse)
+	err := c.cc.Invoke(ctx, Query_OptimalRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -125,12 +185,22 @@ type QueryServer interface {
 	// Queries a list of IncomingInFlightPacket items.
 	IncomingInFlightPacket(context.Context, *QueryIncomingInFlightPacketRequest) (*QueryIncomingInFlightPacketResponse, error)
 	IncomingInFlightPackets(context.Context, *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error)
+	// Queries the IncomingInFlightPacket items pending for at least a number of blocks
+	PendingIncomingInFlightPackets(context.Context, *QueryPendingIncomingInFlightPacketsRequest) (*QueryPendingIncomingInFlightPacketsResponse, error)
 	// Queries a list of OutgoingInFlightPacket items.
 	OutgoingInFlightPacket(context.Context, *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error)
 	OutgoingInFlightPackets(context.Context, *QueryOutgoingInFlightPacketsRequest) (*QueryOutgoingInFlightPacketsResponse, error)
 	// Queries a Calculation swap value.
 	CalculationSwapExactAmountIn(context.Context, *QueryCalculationSwapExactAmountInRequest) (*QueryCalculationSwapExactAmountInResponse, error)
 	CalculationSwapExactAmountOut(context.Context, *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error)
+	// Queries an InterfaceProvider by its address.
+	InterfaceProvider(context.Context, *QueryInterfaceProviderRequest) (*QueryInterfaceProviderResponse, error)
+	// Queries a list of InterfaceProvider items.
+	InterfaceProviders(context.Context, *QueryInterfaceProvidersRequest) (*QueryInterfaceProvidersResponse, error)
+	// Queries the cumulative volume and fees of the swaps of an interface provider.
+	InterfaceProviderStats(context.Context, *QueryInterfaceProviderStatsRequest) (*QueryInterfaceProviderStatsResponse, error)
+	// Queries the route with the largest output for an exact input amount
+	OptimalRoute(context.Context, *QueryOptimalRouteRequest) (*QueryOptimalRouteResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -147,6 +217,9 @@ func (UnimplementedQueryServer) IncomingInFlightPacket(context.Context, *QueryIn
 func (UnimplementedQueryServer) IncomingInFlightPackets(context.Context, *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncomingInFlightPackets not implemented")
 }
+func (UnimplementedQueryServer) PendingIncomingInFlightPackets(context.Context, *QueryPendingIncomingInFlightPacketsRequest) (*QueryPendingIncomingInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingIncomingInFlightPackets not implemented")
+}
 func (UnimplementedQueryServer) OutgoingInFlightPacket(context.Context, *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutgoingInFlightPacket not implemented")
 }
@@ -159,6 +232,18 @@ func (UnimplementedQueryServer) CalculationSwapExactAmountIn(context.Context, *Q
 func (UnimplementedQueryServer) CalculationSwapExactAmountOut(context.Context, *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculationSwapExactAmountOut not implemented")
 }
+func (UnimplementedQueryServer) InterfaceProvider(context.Context, *QueryInterfaceProviderRequest) (*QueryInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProvider not implemented")
+}
+func (UnimplementedQueryServer) InterfaceProviders(context.Context, *QueryInterfaceProvidersRequest) (*QueryInterfaceProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProviders not implemented")
+}
+func (UnimplementedQueryServer) InterfaceProviderStats(context.Context, *QueryInterfaceProviderStatsRequest) (*QueryInterfaceProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProviderStats not implemented")
+}
+func (UnimplementedQueryServer) OptimalRoute(context.Context, *QueryOptimalRouteRequest) (*QueryOptimalRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimalRoute not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +311,24 @@ func _Query_IncomingInFlightPackets_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingIncomingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingIncomingInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingIncomingInFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingIncomingInFlightPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingIncomingInFlightPackets(ctx, req.(*QueryPendingIncomingInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OutgoingInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOutgoingInFlightPacketRequest)
 	if err := dec(in); err != nil {
@@ -298,6 +401,78 @@ func _Query_CalculationSwapExactAmountOut_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InterfaceProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProvider(ctx, req.(*QueryInterfaceProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterfaceProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InterfaceProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProviders(ctx, req.(*QueryInterfaceProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterfaceProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProviderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InterfaceProviderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProviderStats(ctx, req.(*QueryInterfaceProviderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OptimalRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOptimalRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OptimalRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OptimalRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptimalRoute(ctx, req.(*QueryOptimalRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +492,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IncomingInFlightPackets",
 			Handler:    _Query_IncomingInFlightPackets_Handler,
 		},
+		{
+			MethodName: "PendingIncomingInFlightPackets",
+			Handler:    _Query_PendingIncomingInFlightPackets_Handler,
+		},
 		{
 			MethodName: "OutgoingInFlightPacket",
 			Handler:    _Query_OutgoingInFlightPacket_Handler,
@@ -333,6 +512,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculationSwapExactAmountOut",
 			Handler:    _Query_CalculationSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "InterfaceProvider",
+			Handler:    _Query_InterfaceProvider_Handler,
+		},
+		{
+			MethodName: "InterfaceProviders",
+			Handler:    _Query_InterfaceProviders_Handler,
+		},
+		{
+			MethodName: "InterfaceProviderStats",
+			Handler:    _Query_InterfaceProviderStats_Handler,
+		},
+		{
+			MethodName: "OptimalRoute",
+			Handler:    _Query_OptimalRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/swap/query.proto",
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName       = "/sunrise.swap.Msg/UpdateParams"
-	Msg_SwapExactAmountIn_FullMethodName  = "/sunrise.swap.Msg/SwapExactAmountIn"
-	Msg_SwapExactAmountOut_FullMethodName = "/sunrise.swap.Msg/SwapExactAmountOut"
+	Msg_UpdateParams_FullMethodName                = "/sunrise.swap.Msg/UpdateParams"
+	Msg_SwapExactAmountIn_FullMethodName           = "/sunrise.swap.Msg/SwapExactAmountIn"
+	Msg_SwapExactAmountOut_FullMethodName          = "/sunrise.swap.Msg/SwapExactAmountOut"
+	Msg_RegisterInterfaceProvider_FullMethodName   = "/sunrise.swap.Msg/RegisterInterfaceProvider"
+	Msg_UpdateInterfaceProvider_FullMethodName     = "/sunrise.swap.Msg/UpdateInterfaceProvider"
+	Msg_SetInterfaceProviderFeeRate_FullMethodName = "/sunrise.swap.Msg/SetInterfaceProviderFeeRate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +36,14 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	// RegisterInterfaceProvider registers the signer as an interface provider.
+	RegisterInterfaceProvider(ctx context.Context, in *MsgRegisterInterfaceProvider, opts ...grpc.CallOption) (*MsgRegisterInterfaceProviderResponse, error)
+	// UpdateInterfaceProvider updates the metadata and the fee shares of an
+	// interface provider.
+	UpdateInterfaceProvider(ctx context.Context, in *MsgUpdateInterfaceProvider, opts ...grpc.CallOption) (*MsgUpdateInterfaceProviderResponse, error)
+	// SetInterfaceProviderFeeRate defines a (governance) operation for setting
+	// the interface fee rate of a registered interface provider.
+	SetInterfaceProviderFeeRate(ctx context.Context, in *MsgSetInterfaceProviderFeeRate, opts ...grpc.CallOption) (*MsgSetInterfaceProviderFeeRateResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +81,33 @@ func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmou
 	return out, nil
 }
 
+func (c *msgClient) RegisterInterfaceProvider(ctx context.Context, in *MsgRegisterInterfaceProvider, opts ...grpc.CallOption) (*MsgRegisterInterfaceProviderResponse, error) {
+	out := new(MsgRegisterInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterInterfaceProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInterfaceProvider(ctx context.Context, in *MsgUpdateInterfaceProvider, opts ...grpc.CallOption) (*MsgUpdateInterfaceProviderResponse, error) {
+	out := new(MsgUpdateInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateInterfaceProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetInterfaceProviderFeeRate(ctx context.Context, in *MsgSetInterfaceProviderFeeRate, opts ...grpc.CallOption) (*MsgSetInterfaceProviderFeeRateResponse, error) {
+	out := new(MsgSetInterfaceProviderFeeRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetInterfaceProviderFeeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +117,14 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	// RegisterInterfaceProvider registers the signer as an interface provider.
+	RegisterInterfaceProvider(context.Context, *MsgRegisterInterfaceProvider) (*MsgRegisterInterfaceProviderResponse, error)
+	// UpdateInterfaceProvider updates the metadata and the fee shares of an
+	// interface provider.
+	UpdateInterfaceProvider(context.Context, *MsgUpdateInterfaceProvider) (*MsgUpdateInterfaceProviderResponse, error)
+	// SetInterfaceProviderFeeRate defines a (governance) operation for setting
+	// the interface fee rate of a registered interface provider.
+	SetInterfaceProviderFeeRate(context.Context, *MsgSetInterfaceProviderFeeRate) (*MsgSetInterfaceProviderFeeRateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +141,15 @@ func (UnimplementedMsgServer) SwapExactAmountIn(context.Context, *MsgSwapExactAm
 func (UnimplementedMsgServer) SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
 }
+func (UnimplementedMsgServer) RegisterInterfaceProvider(context.Context, *MsgRegisterInterfaceProvider) (*MsgRegisterInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterfaceProvider not implemented")
+}
+func (UnimplementedMsgServer) UpdateInterfaceProvider(context.Context, *MsgUpdateInterfaceProvider) (*MsgUpdateInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterfaceProvider not implemented")
+}
+func (UnimplementedMsgServer) SetInterfaceProviderFeeRate(context.Context, *MsgSetInterfaceProviderFeeRate) (*MsgSetInterfaceProviderFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceProviderFeeRate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +217,60 @@ func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterInterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterInterfaceProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterInterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterInterfaceProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterInterfaceProvider(ctx, req.(*MsgRegisterInterfaceProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInterfaceProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateInterfaceProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInterfaceProvider(ctx, req.(*MsgUpdateInterfaceProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInterfaceProviderFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInterfaceProviderFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInterfaceProviderFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetInterfaceProviderFeeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInterfaceProviderFeeRate(ctx, req.(*MsgSetInterfaceProviderFeeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +290,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapExactAmountOut",
 			Handler:    _Msg_SwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "RegisterInterfaceProvider",
+			Handler:    _Msg_RegisterInterfaceProvider_Handler,
+		},
+		{
+			MethodName: "UpdateInterfaceProvider",
+			Handler:    _Msg_UpdateInterfaceProvider_Handler,
+		},
+		{
+			MethodName: "SetInterfaceProviderFeeRate",
+			Handler:    _Msg_SetInterfaceProviderFeeRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/swap/tx.proto",
@@ -38,7 +38,7 @@ func (app *App) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 	switch req.Type {
 	// new transactions must be checked in their entirety
 	case abci.CheckTxType_New:
-		err := blobtypes.ValidateBlobTx(app.txConfig, btx)
+		err := blobtypes.ValidateBlobTx(app.txConfig, btx, app.BaseApp.AppVersion())
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), err
 		}
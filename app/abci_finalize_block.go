@@ -1,7 +1,13 @@
 package app
 
 import (
+	"time"
+
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
 	"github.com/sunriselayer/sunrise/pkg/blob"
 )
 
@@ -16,6 +22,22 @@ import (
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
 func (app *App) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	// The data root is not part of the request, so it is computed from the
+	// block data including the blobs to be recorded for Blobstream in the
+	// PreBlocker. The extended square is cached if the node processed the
+	// proposal, but a node syncing blocks misses the cache and erasure codes
+	// every block again. This is measured as finalize_block/data_root.
+	start := time.Now()
+	dataRoot, err := app.DataRoot(req.Txs, app.BaseApp.AppVersion())
+	telemetry.MeasureSince(start, "finalize_block", "data_root")
+	if err != nil {
+		// The block has already been accepted by ProcessProposal, so it is
+		// finalized without recording its data root.
+		app.Logger().Error("failed to compute the data root", "height", req.Height, "err", err)
+		dataRoot = nil
+	}
+	app.dataRoot = dataRoot
+
 	txs := [][]byte{}
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
@@ -34,3 +56,22 @@ func (app *App) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFin
 
 	return app.BaseApp.FinalizeBlock(req)
 }
+
+// PreBlocker records the data root of the block for the data root tuple proofs
+// of Blobstream before running the PreBlockers of the modules.
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	if app.dataRoot != nil {
+		app.StreamKeeper.SetDataRoot(ctx, uint64(req.Height), app.dataRoot)
+	}
+	return app.App.PreBlocker(ctx, req)
+}
+
+// DataRoot returns the data root of the block data for a given app version.
+// The extended square is usually cached by ProcessProposal.
+func (app *App) DataRoot(txs [][]byte, appVersion uint64) ([]byte, error) {
+	es, err := extendedSquare(txs, appVersion, appconsts.SquareSizeUpperBound(appVersion), app.daWorkers)
+	if err != nil {
+		return nil, err
+	}
+	return es.DAH.Hash(), nil
+}
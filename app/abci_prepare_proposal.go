@@ -4,8 +4,6 @@ import (
 	"time"
 
 	"github.com/sunriselayer/sunrise/app/ante"
-	"github.com/sunriselayer/sunrise/pkg/da"
-	"github.com/sunriselayer/sunrise/pkg/shares"
 	"github.com/sunriselayer/sunrise/pkg/square"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -85,17 +83,28 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 		txs = FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, res.Txs)
 	}
 
-	// build the square from the set of valid and prioritised transactions.
+	// build the square from the set of valid and prioritised transactions,
+	// leaving the shares reserved for the blob lane to blob transactions.
 	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := square.Build(txs, app.BaseApp.AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+	maxSquareSize := app.GovSquareSizeUpperBound(sdkCtx)
+	dataSquare, txs, err := square.BuildWithReservedBlobShares(
+		txs,
+		app.BaseApp.AppVersion(),
+		maxSquareSize,
+		app.BlobLaneReservedShares(sdkCtx, maxSquareSize),
+	)
 	if err != nil {
 		panic(err)
 	}
 
-	// erasure the data square which we use to create the data root.
+	// erasure the data square and create the new data root by creating the
+	// data availability header (merkle roots of each row and col of the
+	// erasure data).
 	// Note: uses the nmt wrapper to construct the tree.
 	// checkout pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	// The same block is processed and finalized by this node, so the extended
+	// square is cached to not erasure the data square again.
+	es, err := extendedSquareCache.Extend(txs, app.BaseApp.AppVersion(), dataSquare, app.daWorkers)
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -105,23 +114,11 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 		panic(err)
 	}
 
-	// create the new data root by creating the data availability header (merkle
-	// roots of each row and col of the erasure data).
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		app.Logger().Error(
-			"failure to create new data availability header",
-			"error",
-			err.Error(),
-		)
-		panic(err)
-	}
-
 	// tendermint doesn't need to use any of the erasure data, as only the
 	// protobuf encoded version of the block data is gossiped.
 	return &abci.ResponsePrepareProposal{
 		Txs:        txs,
-		DataHash:   dah.Hash(),
+		DataHash:   es.DAH.Hash(),
 		SquareSize: uint64(dataSquare.Size()),
 	}, nil
 }
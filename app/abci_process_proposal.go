@@ -7,10 +7,6 @@ import (
 
 	"github.com/sunriselayer/sunrise/app/ante"
 	"github.com/sunriselayer/sunrise/pkg/blob"
-	"github.com/sunriselayer/sunrise/pkg/da"
-	"github.com/sunriselayer/sunrise/pkg/shares"
-	"github.com/sunriselayer/sunrise/pkg/square"
-
 	blobtypes "github.com/sunriselayer/sunrise/x/blob/types"
 
 	"cosmossdk.io/log"
@@ -121,7 +117,7 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		// - that the sizes match
 		// - that the namespaces match between blob and PFB
 		// - that the share commitment is correct
-		if err := blobtypes.ValidateBlobTx(app.txConfig, blobTx); err != nil {
+		if err := blobtypes.ValidateBlobTx(app.txConfig, blobTx, app.BaseApp.AppVersion()); err != nil {
 			logInvalidPropBlockError(app.Logger(), req.ProposerAddress, fmt.Sprintf("invalid blob tx %d", idx), err)
 			return reject(err)
 		}
@@ -134,31 +130,22 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		}
 	}
 
-	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(txs, app.BaseApp.AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+	// Construct the data square from the block's transactions and erasure it.
+	// The extended square is cached if this node proposed the block.
+	es, err := extendedSquare(txs, app.BaseApp.AppVersion(), app.GovSquareSizeUpperBound(sdkCtx), app.daWorkers)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to compute data square from transactions:", err)
+		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to compute the extended data square from transactions:", err)
 		return reject(err)
 	}
 
 	// Assert that the square size stated by the proposer is correct
-	if uint64(dataSquare.Size()) != req.SquareSize {
-		err := fmt.Errorf("proposed square size differs from calculated square size, expected %d, got %d", req.SquareSize, dataSquare.Size())
+	if uint64(es.Square.Size()) != req.SquareSize {
+		err := fmt.Errorf("proposed square size differs from calculated square size, expected %d, got %d", req.SquareSize, es.Square.Size())
 		logInvalidPropBlock(app.Logger(), req.ProposerAddress, err.Error())
 		return reject(err)
 	}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to erasure the data square", err)
-		return reject(err)
-	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to create new data availability header", err)
-		return reject(err)
-	}
+	dah := es.DAH
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
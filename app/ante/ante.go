@@ -67,9 +67,15 @@ func NewAnteHandler(
 		// Ensure that the tx's gas limit is > the gas consumed based on the blob size(s).
 		// Contract: must be called after all decorators that consume gas.
 		// Note: does not consume gas from the gas meter.
-		blobante.NewMinGasPFBDecorator(blobKeeper),
+		// Side effect: burns the blob base fee from the fees deducted.
+		blobante.NewMinGasPFBDecorator(blobKeeper, feeKeeper),
 		// Ensure that the tx's total blob size is <= the max blob size.
 		blobante.NewMaxBlobSizeDecorator(blobKeeper),
+		// Ensure that the tx's signer is authorised to post to the registered namespaces.
+		blobante.NewNamespacePosterDecorator(blobKeeper),
+		// Ensure that the tx's signer and namespaces are within their blob quotas.
+		// Side effect: records the blob bytes against the quotas.
+		blobante.NewBlobQuotaDecorator(blobKeeper),
 		// Side effect: increment the nonce for all tx signers.
 		ante.NewIncrementSequenceDecorator(accountKeeper),
 		// Ensure that the tx is not a IBC packet or update message that has already been processed.
@@ -59,6 +59,7 @@ import (
 	"github.com/skip-mev/block-sdk/v2/block/service"
 	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
 	auctionkeeper "github.com/skip-mev/block-sdk/v2/x/auction/keeper"
+	"github.com/spf13/cast"
 	"github.com/sunriselayer/sunrise/app/ante"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -66,6 +67,7 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	defaultoverrides "github.com/sunriselayer/sunrise/app/defaultoverrides"
+	"github.com/sunriselayer/sunrise/pkg/proof"
 	feetypes "github.com/sunriselayer/sunrise/x/fee/types"
 	tokenconvertertypes "github.com/sunriselayer/sunrise/x/tokenconverter/types"
 
@@ -114,6 +116,10 @@ type App struct {
 	txConfig          client.TxConfig
 	interfaceRegistry codectypes.InterfaceRegistry
 
+	// daWorkers is the number of goroutines extending the data squares,
+	// the number of CPUs if it is not positive.
+	daWorkers int
+
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
 	BankKeeper            bankkeeper.Keeper
@@ -163,6 +169,9 @@ type App struct {
 	// custom structure for skip-mev protection
 	mevLane        *mevlane.MEVLane
 	checkTxHandler checktx.CheckTx
+
+	// data root of the block being finalized
+	dataRoot []byte
 }
 
 func init() {
@@ -359,13 +368,13 @@ func New(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
-	// Register legacy modules
-	app.registerIBCModules()
-
 	// <sunrise>
-	app.SwapKeeper.TransferKeeper = &app.TransferKeeper
+	app.daWorkers = cast.ToInt(appOpts.Get(FlagDAWorkers))
 	// </sunrise>
 
+	// Register legacy modules
+	app.registerIBCModules()
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -377,14 +386,14 @@ func New(
 	// ------------------------- Begin `Skip MEV` Code ---------------------------- //
 	// ---------------------------------------------------------------------------- //
 	// STEP 1-3: Create the Block SDK lanes.
-	mevLane, freeLane, defaultLane := CreateLanes(app)
+	mevLane, freeLane, blobLane, defaultLane := CreateLanes(app)
 
 	// STEP 4: Construct a mempool based off the lanes. Note that the order of the lanes
 	// matters. Blocks are constructed from the top lane to the bottom lane. The top lane
 	// is the first lane in the array and the bottom lane is the last lane in the array.
 	mempool, err := block.NewLanedMempool(
 		app.Logger(),
-		[]block.Lane{mevLane, freeLane, defaultLane},
+		[]block.Lane{mevLane, freeLane, blobLane, defaultLane},
 	)
 	if err != nil {
 		panic(err)
@@ -419,6 +428,9 @@ func New(
 	freeLane.WithOptions(
 		opt...,
 	)
+	blobLane.WithOptions(
+		opt...,
+	)
 	defaultLane.WithOptions(
 		opt...,
 	)
@@ -482,6 +494,10 @@ func New(
 	// 	return app.App.InitChainer(ctx, req)
 	// })
 
+	// record the data roots of the blocks for Blobstream. It must be set before
+	// loading not to be replaced by the PreBlocker of the modules.
+	app.SetPreBlocker(app.PreBlocker)
+
 	if err := app.Load(loadLatest); err != nil {
 		return nil, err
 	}
@@ -556,6 +572,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	// Register the Block SDK mempool API routes.
 	service.RegisterGRPCGatewayRoutes(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the proof query API routes.
+	proof.RegisterGRPCGatewayRoutes(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
+
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -578,6 +597,14 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 	service.RegisterMempoolService(app.GRPCQueryRouter(), mempool)
 }
 
+// RegisterTendermintService implements the Application.RegisterTendermintService method.
+func (app *App) RegisterTendermintService(clientCtx client.Context) {
+	app.App.RegisterTendermintService(clientCtx)
+
+	// Register the proof query service rebuilding the data squares of the blocks.
+	proof.RegisterQueryService(app.GRPCQueryRouter(), clientCtx.Client)
+}
+
 // GetIBCKeeper returns the IBC keeper.
 func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
 	return app.IBCKeeper
@@ -240,12 +240,14 @@ var (
 		// ibctransfertypes.ModuleName,
 		// ibcfeetypes.ModuleName,
 		// icatypes.ModuleName,
+		// swapmoduletypes.ModuleName: the ICS20 tokens to swap are received by the
+		// swap module account and forwarded from it, and the ibc-go transfer keeper
+		// rejects blocked senders. Anyone can also send tokens to it with MsgSend.
 		blobmoduletypes.ModuleName,
 		streammoduletypes.ModuleName,
 		tokenconvertermoduletypes.ModuleName,
 		liquiditypoolmoduletypes.ModuleName,
 		liquidityincentivemoduletypes.ModuleName,
-		swapmoduletypes.ModuleName,
 		feemoduletypes.ModuleName,
 	}
 
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	stdmath "math"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+)
+
+const (
+	// BlobLaneName defines the name of the blob lane.
+	BlobLaneName = "blob"
+
+	// blobFeePriorityScale is the number of fractional digits kept when the
+	// fee per blob byte is used as an integer priority.
+	blobFeePriorityScale = 1_000_000
+)
+
+// NewBlobLane returns a new lane for the transactions paying for blobs. The
+// block space of the lane only bounds the size of the PFB transactions, the
+// shares of the square taken by their blobs are reserved by the
+// BlobLaneSquareShare param of the blob module when the square is built.
+func NewBlobLane[C comparable](
+	cfg base.LaneConfig,
+	txPriority base.TxPriority[C],
+	matchFn base.MatchHandler,
+) *base.BaseLane {
+	options := []base.LaneOption{
+		base.WithMatchHandler(matchFn),
+		base.WithMempoolConfigs[C](cfg, txPriority),
+	}
+
+	lane, err := base.NewBaseLane(
+		cfg,
+		BlobLaneName,
+		options...,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return lane
+}
+
+// BlobMatchHandler returns the match handler of the blob lane. It matches the
+// transactions with a MsgPayForBlobs, which are the ones wrapped in a BlobTx
+// and whose blobs are laid out in the square after the other transactions.
+func BlobMatchHandler() base.MatchHandler {
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		_, has := hasPFB(tx.GetMsgs())
+		return has
+	}
+}
+
+// BlobTxPriority returns the priority of the blob lane. Transactions are
+// ordered by the amount of the fee paid in the fee denom per byte of blob,
+// scaled by blobFeePriorityScale. Fees paid in other denoms are not taken into
+// account.
+func BlobTxPriority(feeDenom func(ctx context.Context) string) base.TxPriority[int64] {
+	return base.TxPriority[int64]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) int64 {
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return 0
+			}
+			pfb, ok := hasPFB(tx.GetMsgs())
+			if !ok {
+				return 0
+			}
+			return feePerBlobByte(feeTx.GetFee().AmountOf(feeDenom(ctx)), pfb.BlobSizes)
+		},
+		Compare: func(a, b int64) int {
+			switch {
+			case a > b:
+				return 1
+			case a < b:
+				return -1
+			default:
+				return 0
+			}
+		},
+		MinValue: stdmath.MinInt64,
+	}
+}
+
+// feePerBlobByte returns the fee per byte of the blobs scaled by
+// blobFeePriorityScale, capped to the largest int64.
+func feePerBlobByte(fee math.Int, blobSizes []uint32) int64 {
+	blobBytes := int64(0)
+	for _, size := range blobSizes {
+		blobBytes += int64(size)
+	}
+	if blobBytes == 0 || !fee.IsPositive() {
+		return 0
+	}
+	priority := fee.MulRaw(blobFeePriorityScale).QuoRaw(blobBytes)
+	if !priority.IsInt64() {
+		return stdmath.MaxInt64
+	}
+	return priority.Int64()
+}
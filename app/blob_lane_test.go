@@ -0,0 +1,86 @@
+package app_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/app"
+	"github.com/sunriselayer/sunrise/app/encoding"
+	blobtypes "github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func TestBlobLane(t *testing.T) {
+	txConfig := encoding.MakeConfig().TxConfig
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(fee)
+		return builder.GetTx()
+	}
+	pfb := func(blobSizes ...uint32) *blobtypes.MsgPayForBlobs {
+		return &blobtypes.MsgPayForBlobs{BlobSizes: blobSizes}
+	}
+	fees := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("fee", amount), sdk.NewInt64Coin("stake", 1_000_000))
+	}
+
+	t.Run("match", func(t *testing.T) {
+		match := app.BlobMatchHandler()
+		require.True(t, match(sdk.Context{}, newTx(fees(1), pfb(100))))
+		require.False(t, match(sdk.Context{}, newTx(fees(1), &banktypes.MsgSend{})))
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		priority := app.BlobTxPriority(func(context.Context) string { return "fee" })
+		testCases := []struct {
+			name     string
+			tx       sdk.Tx
+			expected int64
+		}{
+			{
+				name:     "fee per blob byte",
+				tx:       newTx(fees(1000), pfb(100)),
+				expected: 10_000_000,
+			},
+			{
+				name:     "bytes of all the blobs",
+				tx:       newTx(fees(1000), pfb(100, 300)),
+				expected: 2_500_000,
+			},
+			{
+				name:     "fractional fee per blob byte",
+				tx:       newTx(fees(1), pfb(3)),
+				expected: 333_333,
+			},
+			{
+				name:     "no fee in the fee denom",
+				tx:       newTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), pfb(100)),
+				expected: 0,
+			},
+			{
+				name:     "no pfb",
+				tx:       newTx(fees(1000), &banktypes.MsgSend{}),
+				expected: 0,
+			},
+			{
+				name:     "capped",
+				tx:       newTx(sdk.NewCoins(sdk.NewCoin("fee", sdkmath.NewIntFromUint64(math.MaxUint64))), pfb(1)),
+				expected: math.MaxInt64,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.expected, priority.GetTxPriority(context.Background(), tc.tx))
+			})
+		}
+		require.Equal(t, 1, priority.Compare(2, 1))
+		require.Equal(t, -1, priority.Compare(1, 2))
+		require.Equal(t, 0, priority.Compare(1, 1))
+	})
+}
@@ -1,25 +1,87 @@
 package app
 
 import (
+	"bytes"
+
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
-	"github.com/sunriselayer/sunrise/pkg/da"
-	"github.com/sunriselayer/sunrise/pkg/shares"
 	"github.com/sunriselayer/sunrise/pkg/square"
+	"github.com/sunriselayer/sunrise/pkg/wrapper"
 
 	"github.com/celestiaorg/rsmt2d"
 	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
+// FlagDAWorkers is the app.toml option of the number of goroutines erasure
+// coding the data squares and computing their row and column roots.
+const FlagDAWorkers = "da.workers"
+
+// DAConfig is the data availability section of app.toml.
+type DAConfig struct {
+	// Workers is the number of goroutines erasure coding the data squares and
+	// computing their row and column roots. The number of CPUs is used if it
+	// is not positive.
+	Workers int `mapstructure:"workers"`
+}
+
+// DefaultDAConfig returns the default data availability section of app.toml.
+func DefaultDAConfig() DAConfig {
+	return DAConfig{Workers: 0}
+}
+
+// DAConfigTemplate is the app.toml template of the data availability section.
+const DAConfigTemplate = `
+###############################################################################
+###                         Data Availability                               ###
+###############################################################################
+
+[da]
+
+# Number of goroutines erasure coding the data squares and computing their row
+# and column roots. The number of CPUs is used if it is 0.
+workers = {{ .DA.Workers }}
+`
+
+// extendedSquareCache is shared by PrepareProposal, ProcessProposal,
+// FinalizeBlock and ExtendBlock so that a block is only erasure coded once by
+// the node. The extended data squares in the cache must not be modified.
+var extendedSquareCache = square.NewCache(square.DefaultCacheSize)
+
 // ExtendBlock extends the given block data into a data square for a given app
-// version.
+// version. It returns a copy of the cached extended data square, which the
+// caller is free to modify. A data square missing from the cache is extended
+// with as many goroutines as CPUs.
 func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
-	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	es, err := extendedSquare(data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion), 0)
 	if err != nil {
 		return nil, err
 	}
 
-	return da.ExtendShares(shares.ToBytes(dataSquare))
+	cells := es.EDS.Flattened()
+	for i, cell := range cells {
+		cells[i] = bytes.Clone(cell)
+	}
+	return rsmt2d.ImportExtendedDataSquare(cells, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(es.EDS.Width()/2)))
+}
+
+// extendedSquare returns the extended square of the block transactions from
+// the cache, constructing and extending the data square with at most workers
+// goroutines if it is missing.
+func extendedSquare(txs [][]byte, appVersion uint64, maxSquareSize int, workers int) (*square.ExtendedSquare, error) {
+	es, hit, err := extendedSquareCache.Construct(txs, appVersion, maxSquareSize, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	if hit {
+		telemetry.IncrCounter(1, "eds_cache", "hits")
+	} else {
+		telemetry.IncrCounter(1, "eds_cache", "misses")
+	}
+	stats := extendedSquareCache.Stats()
+	telemetry.SetGauge(float32(stats.HitRate()), "eds_cache", "hit_rate")
+	telemetry.SetGauge(float32(stats.Bytes), "eds_cache", "bytes")
+	return es, nil
 }
 
 // EmptyBlock returns true if the given block data is considered empty by the
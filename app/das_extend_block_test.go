@@ -0,0 +1,31 @@
+package app_test
+
+import (
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/app"
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+)
+
+func TestExtendBlockReturnsCopy(t *testing.T) {
+	data := coretypes.Data{}
+
+	eds, err := app.ExtendBlock(data, appconsts.LatestVersion)
+	require.NoError(t, err)
+	rowRoots, err := eds.RowRoots()
+	require.NoError(t, err)
+	first := eds.Flattened()[0][0]
+
+	// Modifying the returned square does not affect the cached one
+	eds.Flattened()[0][0] ^= 0xff
+
+	eds, err = app.ExtendBlock(data, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Equal(t, first, eds.Flattened()[0][0])
+	cachedRowRoots, err := eds.RowRoots()
+	require.NoError(t, err)
+	require.Equal(t, rowRoots, cachedRowRoots)
+}
@@ -22,3 +22,14 @@ func (app *App) GovSquareSizeUpperBound(ctx sdk.Context) int {
 	hardMax := appconsts.SquareSizeUpperBound(ctx.BlockHeader().Version.App)
 	return min(gmax, hardMax)
 }
+
+// BlobLaneReservedShares returns the number of shares of a square of the max
+// square size reserved for the transactions of the blob lane using the
+// governance parameter blob.BlobLaneSquareShare.
+func (app *App) BlobLaneReservedShares(ctx sdk.Context, maxSquareSize int) int {
+	share := app.BlobKeeper.BlobLaneSquareShare(ctx)
+	if share.IsNil() {
+		return 0
+	}
+	return int(share.MulInt64(int64(maxSquareSize * maxSquareSize)).TruncateInt64())
+}
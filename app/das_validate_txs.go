@@ -26,6 +26,10 @@ func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []blob.BlobTx) {
 }
 
 // FilterTxs applies the antehandler to all proposed transactions and removes transactions that return an error.
+// The blob transactions, which are the ones matched by the blob lane, are returned after the normal
+// transactions in their original order so that the square is packed following the priority of the lanes.
+// The state changes of the antehandler carry over to the following transactions, so the blob quotas of the
+// accounts and namespaces apply to the set of transactions as a whole.
 func FilterTxs(logger log.Logger, ctx sdk.Context, handler sdk.AnteHandler, txConfig client.TxConfig, txs [][]byte) [][]byte {
 	normalTxs, blobTxs := separateTxs(txConfig, txs)
 	normalTxs, ctx = filterStdTxs(logger, txConfig.TxDecoder(), ctx, handler, normalTxs)
@@ -113,6 +113,9 @@ func (app *App) registerIBCModules() {
 		scopedIBCTransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// The swap keeper is copied into the swap middleware below, so it must
+	// be given the transfer keeper before.
+	app.SwapKeeper.TransferKeeper = &app.TransferKeeper
 
 	// Create interchain account keepers
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"cosmossdk.io/math"
 
 	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
@@ -11,10 +13,10 @@ import (
 )
 
 // CreateLanes walks through the process of creating the lanes for the block sdk. In this function
-// we create three separate lanes - MEV, Free, and Default - and then return them.
+// we create four separate lanes - MEV, Free, Blob and Default - and then return them.
 //
 // NOTE: Application Developers should closely replicate this function in their own application.
-func CreateLanes(app *App) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane) {
+func CreateLanes(app *App) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane, *base.BaseLane) {
 	// 1. Create the signer extractor. This is used to extract the expected signers from
 	// a transaction. Each lane can have a different signer extractor if needed.
 	signerAdapter := signerextraction.NewDefaultAdapter()
@@ -48,13 +50,25 @@ func CreateLanes(app *App) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane) {
 		MaxTxs:          1000,
 	}
 
-	// Create a default configuration that accepts 1000 transactions and consumes 60% of the
+	// Create a blob configuration that accepts 1000 transactions and consumes 20% of the
+	// block space. The block space only bounds the PFB transactions, the shares of the
+	// square taken by their blobs are reserved by the blob module params.
+	blobConfig := base.LaneConfig{
+		Logger:          app.Logger(),
+		TxEncoder:       app.txConfig.TxEncoder(),
+		TxDecoder:       app.txConfig.TxDecoder(),
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.2"),
+		SignerExtractor: signerAdapter,
+		MaxTxs:          1000,
+	}
+
+	// Create a default configuration that accepts 1000 transactions and consumes 40% of the
 	// block space.
 	defaultConfig := base.LaneConfig{
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.6"),
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.4"),
 		SignerExtractor: signerAdapter,
 		MaxTxs:          1000,
 	}
@@ -69,6 +83,9 @@ func CreateLanes(app *App) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane) {
 	// Create the final match handler for the free lane.
 	freeMatchHandler := freelane.DefaultMatchHandler()
 
+	// Create the final match handler for the blob lane.
+	blobMatchHandler := BlobMatchHandler()
+
 	// Create the final match handler for the default lane.
 	defaultMatchHandler := base.DefaultMatchHandler()
 
@@ -85,10 +102,18 @@ func CreateLanes(app *App) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane) {
 		freeMatchHandler,
 	)
 
+	blobLane := NewBlobLane(
+		blobConfig,
+		BlobTxPriority(func(ctx context.Context) string {
+			return app.FeeKeeper.GetParams(ctx).FeeDenom
+		}),
+		blobMatchHandler,
+	)
+
 	defaultLane := defaultlane.NewDefaultLane(
 		defaultConfig,
 		defaultMatchHandler,
 	)
 
-	return mevLane, freeLane, defaultLane
+	return mevLane, freeLane, blobLane, defaultLane
 }
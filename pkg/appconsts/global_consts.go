@@ -3,6 +3,7 @@ package appconsts
 import (
 	"math"
 
+	"github.com/sunriselayer/sunrise/pkg/blob"
 	ns "github.com/sunriselayer/sunrise/pkg/namespace"
 
 	"github.com/celestiaorg/rsmt2d"
@@ -40,6 +41,14 @@ const (
 	// ShareVersionZero is the first share version format.
 	ShareVersionZero = uint8(0)
 
+	// ShareVersionOne is the share version format where the first share of a
+	// blob also carries the address of the signer of the blob.
+	ShareVersionOne = uint8(blob.ShareVersionOne)
+
+	// SignerSize is the size of the signer address in the first share of a
+	// blob of ShareVersionOne.
+	SignerSize = blob.SignerSize
+
 	// DefaultShareVersion is the defacto share version. Use this if you are
 	// unsure of which version to use.
 	DefaultShareVersion = ShareVersionZero
@@ -92,8 +101,10 @@ var (
 	// DefaultCodec is the default codec creator used for data erasure.
 	DefaultCodec = rsmt2d.NewLeoRSCodec
 
-	// SupportedShareVersions is a list of supported share versions.
-	SupportedShareVersions = []uint8{ShareVersionZero}
+	// SupportedShareVersions is a list of supported share versions. The share
+	// versions blobs can use depend on the app version, see
+	// SupportedBlobShareVersions.
+	SupportedShareVersions = []uint8{ShareVersionZero, ShareVersionOne}
 )
 
 // HashLength returns the length of a hash in bytes.
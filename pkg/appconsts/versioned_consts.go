@@ -4,10 +4,11 @@ import (
 	"github.com/sunriselayer/sunrise/pkg/appconsts/testground"
 	v1 "github.com/sunriselayer/sunrise/pkg/appconsts/v1"
 	v2 "github.com/sunriselayer/sunrise/pkg/appconsts/v2"
+	v3 "github.com/sunriselayer/sunrise/pkg/appconsts/v3"
 )
 
 const (
-	LatestVersion = v2.Version
+	LatestVersion = v3.Version
 )
 
 // SubtreeRootThreshold works as a target upper bound for the number of subtree
@@ -35,6 +36,18 @@ func SquareSizeUpperBound(v uint64) int {
 	}
 }
 
+// SupportedBlobShareVersions returns the share versions that blobs can be
+// encoded with for a version of the state machine. Blobs authored by their
+// signer (ShareVersionOne) are supported from v3.
+func SupportedBlobShareVersions(v uint64) []uint8 {
+	switch v {
+	case v1.Version, v2.Version:
+		return []uint8{ShareVersionZero}
+	default:
+		return []uint8{ShareVersionZero, ShareVersionOne}
+	}
+}
+
 var (
 	DefaultSubtreeRootThreshold = SubtreeRootThreshold(LatestVersion)
 	DefaultSquareSizeUpperBound = SquareSizeUpperBound(LatestVersion)
@@ -10,6 +10,7 @@ import (
 	"github.com/sunriselayer/sunrise/pkg/appconsts/testground"
 	v1 "github.com/sunriselayer/sunrise/pkg/appconsts/v1"
 	v2 "github.com/sunriselayer/sunrise/pkg/appconsts/v2"
+	v3 "github.com/sunriselayer/sunrise/pkg/appconsts/v3"
 )
 
 func TestSubtreeRootThreshold(t *testing.T) {
@@ -20,6 +21,7 @@ func TestSubtreeRootThreshold(t *testing.T) {
 	testCases := []testCase{
 		{version: v1.Version, want: v1.SubtreeRootThreshold},
 		{version: v2.Version, want: v2.SubtreeRootThreshold},
+		{version: v3.Version, want: v3.SubtreeRootThreshold},
 		{version: testground.Version, want: testground.SubtreeRootThreshold},
 	}
 	for _, tc := range testCases {
@@ -39,6 +41,7 @@ func TestSquareSizeUpperBound(t *testing.T) {
 	testCases := []testCase{
 		{version: v1.Version, want: v1.SquareSizeUpperBound},
 		{version: v2.Version, want: v2.SquareSizeUpperBound},
+		{version: v3.Version, want: v3.SquareSizeUpperBound},
 		{version: testground.Version, want: testground.SquareSizeUpperBound},
 	}
 	for _, tc := range testCases {
@@ -49,3 +52,23 @@ func TestSquareSizeUpperBound(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportedBlobShareVersions(t *testing.T) {
+	type testCase struct {
+		version uint64
+		want    []uint8
+	}
+	testCases := []testCase{
+		{version: v1.Version, want: []uint8{appconsts.ShareVersionZero}},
+		{version: v2.Version, want: []uint8{appconsts.ShareVersionZero}},
+		{version: v3.Version, want: []uint8{appconsts.ShareVersionZero, appconsts.ShareVersionOne}},
+		{version: testground.Version, want: []uint8{appconsts.ShareVersionZero, appconsts.ShareVersionOne}},
+	}
+	for _, tc := range testCases {
+		name := fmt.Sprintf("version %v", tc.version)
+		t.Run(name, func(t *testing.T) {
+			got := appconsts.SupportedBlobShareVersions(tc.version)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -14,6 +14,15 @@ import (
 // decoding binaries that are not actually BlobTxs.
 const ProtoBlobTxTypeID = "BLOB"
 
+const (
+	// ShareVersionOne is the share version of the blobs whose first share
+	// carries the address of their signer.
+	ShareVersionOne = 1
+	// SignerSize is the size of the signer address carried by the blobs of
+	// ShareVersionOne.
+	SignerSize = 20
+)
+
 // NewBlob creates a new coretypes.Blob from the provided data after performing
 // basic stateless checks over it.
 func New(ns namespace.Namespace, blob []byte, shareVersion uint8) *Blob {
@@ -25,6 +34,17 @@ func New(ns namespace.Namespace, blob []byte, shareVersion uint8) *Blob {
 	}
 }
 
+// NewV1 creates a new blob of ShareVersionOne authored by the signer.
+func NewV1(ns namespace.Namespace, blob []byte, signer []byte) *Blob {
+	return &Blob{
+		NamespaceId:      ns.ID,
+		Data:             blob,
+		ShareVersion:     ShareVersionOne,
+		NamespaceVersion: uint32(ns.Version),
+		Signer:           signer,
+	}
+}
+
 // Namespace returns the namespace of the blob
 func (b Blob) Namespace() namespace.Namespace {
 	return namespace.Namespace{
@@ -50,6 +70,13 @@ func (b *Blob) Validate() error {
 	if len(b.Data) == 0 {
 		return errors.New("blob data can not be empty")
 	}
+	if b.ShareVersion == ShareVersionOne {
+		if len(b.Signer) != SignerSize {
+			return fmt.Errorf("signer must be %d bytes for share version %d", SignerSize, ShareVersionOne)
+		}
+	} else if len(b.Signer) != 0 {
+		return fmt.Errorf("signer can only be set for share version %d", ShareVersionOne)
+	}
 	return nil
 }
 
@@ -31,6 +31,10 @@ type Blob struct {
 	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	ShareVersion     uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
 	NamespaceVersion uint32 `protobuf:"varint,4,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// signer is the address of the signer of the MsgPayForBlobs paying for the
+	// blob. It is only set for share version 1 where it is encoded in the first
+	// share of the blob.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *Blob) Reset()         { *m = Blob{} }
@@ -94,6 +98,13 @@ func (m *Blob) GetNamespaceVersion() uint32 {
 	return 0
 }
 
+func (m *Blob) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 // BlobTx wraps an encoded sdk.Tx with a second field to contain blobs of data.
 // The raw bytes of the blobs are not signed over, instead we verify each blob
 // using the relevant MsgPayForBlobs that is signed over in the encoded sdk.Tx.
@@ -165,25 +176,26 @@ func init() {
 func init() { proto.RegisterFile("sunrise/core/v1/blob/blob.proto", fileDescriptor_ddb51f5eb2ed1c90) }
 
 var fileDescriptor_ddb51f5eb2ed1c90 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0x34, 0x04, 0xe1, 0xa6, 0x08, 0x2c, 0x24, 0x22, 0x06, 0x13, 0xca, 0x12, 0x09,
-	0xc9, 0xa1, 0xf0, 0x06, 0xd9, 0xba, 0x46, 0x88, 0x81, 0xa5, 0x72, 0x12, 0xab, 0x8d, 0x48, 0xe3,
-	0xc8, 0x76, 0xa3, 0xf4, 0x29, 0xe0, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x45, 0x90, 0xdd, 0xb4,
-	0x2c, 0x2c, 0x96, 0xef, 0xff, 0xbf, 0x3b, 0xfd, 0x77, 0xf0, 0x56, 0x6e, 0x2a, 0x51, 0x48, 0x16,
-	0x65, 0x5c, 0xb0, 0xa8, 0x99, 0x45, 0x69, 0xc9, 0x53, 0xf3, 0x90, 0x5a, 0x70, 0xc5, 0xd1, 0xd5,
-	0x00, 0x10, 0x0d, 0x90, 0x66, 0x46, 0xb4, 0x37, 0xfd, 0x00, 0xd0, 0x89, 0x4b, 0x9e, 0xa2, 0x3b,
-	0xe8, 0x55, 0x74, 0xcd, 0x64, 0x4d, 0x33, 0xb6, 0x28, 0x72, 0x1f, 0x04, 0x20, 0xf4, 0x92, 0xf1,
-	0x51, 0x9b, 0xe7, 0x08, 0x41, 0x27, 0xa7, 0x8a, 0xfa, 0xb6, 0xb1, 0xcc, 0x1f, 0xdd, 0xc3, 0x89,
-	0x5c, 0x51, 0xc1, 0x16, 0x0d, 0x13, 0xb2, 0xe0, 0x95, 0x3f, 0x0a, 0x40, 0x38, 0x49, 0x3c, 0x23,
-	0xbe, 0xee, 0x35, 0xf4, 0x00, 0x2f, 0xff, 0x66, 0x1f, 0x40, 0xc7, 0x80, 0x17, 0x47, 0x63, 0x80,
-	0xa7, 0x19, 0x74, 0x75, 0xa0, 0x97, 0x16, 0x9d, 0x43, 0x5b, 0xb5, 0x43, 0x10, 0x5b, 0xb5, 0xe8,
-	0x11, 0x9e, 0xe8, 0xcc, 0xd2, 0xb7, 0x83, 0x51, 0x38, 0x7e, 0xba, 0x21, 0xff, 0x6d, 0x44, 0x74,
-	0x73, 0xb2, 0x07, 0xd1, 0x35, 0x3c, 0x55, 0xdb, 0xda, 0xec, 0xa3, 0x73, 0x9d, 0x25, 0xae, 0x2e,
-	0xe7, 0x79, 0x1c, 0x7f, 0x75, 0x18, 0xec, 0x3a, 0x0c, 0x7e, 0x3a, 0x0c, 0x3e, 0x7b, 0x6c, 0xed,
-	0x7a, 0x6c, 0x7d, 0xf7, 0xd8, 0x7a, 0x0b, 0x97, 0x85, 0x5a, 0x6d, 0x52, 0x92, 0xf1, 0x75, 0x34,
-	0xcc, 0x2f, 0xe9, 0x96, 0x89, 0x43, 0x11, 0xd5, 0xef, 0x4b, 0x73, 0xd6, 0xd4, 0x35, 0x77, 0x7d,
-	0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xe7, 0x0e, 0x62, 0x7a, 0x01, 0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0xeb, 0xb4, 0x0d, 0xc2, 0x6d, 0x11, 0x58, 0x08, 0x22, 0x06, 0x13, 0xca, 0x12, 0x09,
+	0xc9, 0xa1, 0x70, 0x83, 0x6c, 0x5d, 0x23, 0xc4, 0xc0, 0x52, 0x39, 0x89, 0x95, 0x46, 0xa4, 0x71,
+	0x64, 0xbb, 0x51, 0x7a, 0x0b, 0x6e, 0xc1, 0x55, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x22, 0xc8, 0x6e,
+	0x52, 0x16, 0x16, 0xcb, 0xef, 0xfd, 0x9f, 0x9f, 0xff, 0xff, 0xc1, 0x5b, 0xb9, 0x2d, 0x44, 0x26,
+	0x99, 0x1f, 0x73, 0xc1, 0xfc, 0x6a, 0xe1, 0x47, 0x39, 0x8f, 0xcc, 0x41, 0x4a, 0xc1, 0x15, 0x47,
+	0x97, 0x1d, 0x40, 0x34, 0x40, 0xaa, 0x05, 0xd1, 0xda, 0xfc, 0x13, 0xc0, 0x51, 0x90, 0xf3, 0x08,
+	0xdd, 0xc1, 0x69, 0x41, 0x37, 0x4c, 0x96, 0x34, 0x66, 0xab, 0x2c, 0x71, 0x80, 0x0b, 0xbc, 0x69,
+	0x38, 0x39, 0xf6, 0x96, 0x09, 0x42, 0x70, 0x94, 0x50, 0x45, 0x1d, 0xcb, 0x48, 0xe6, 0x8e, 0xee,
+	0xe1, 0x4c, 0xae, 0xa9, 0x60, 0xab, 0x8a, 0x09, 0x99, 0xf1, 0xc2, 0x19, 0xba, 0xc0, 0x9b, 0x85,
+	0x53, 0xd3, 0x7c, 0x3d, 0xf4, 0xd0, 0x03, 0xbc, 0xf8, 0x9b, 0xdd, 0x83, 0x23, 0x03, 0x9e, 0x1f,
+	0x85, 0x1e, 0xbe, 0x82, 0xb6, 0xcc, 0xd2, 0x82, 0x09, 0x67, 0x6c, 0xfe, 0xe9, 0xaa, 0x79, 0x0c,
+	0x6d, 0x6d, 0xf4, 0xa5, 0x46, 0x67, 0xd0, 0x52, 0x75, 0x67, 0xd0, 0x52, 0x35, 0x7a, 0x84, 0x63,
+	0x9d, 0x45, 0x3a, 0x96, 0x3b, 0xf4, 0x26, 0x4f, 0x37, 0xe4, 0xbf, 0xa4, 0x44, 0x3f, 0x0e, 0x0f,
+	0x20, 0xba, 0x86, 0x27, 0x6a, 0x57, 0x9a, 0x9c, 0xda, 0xef, 0x69, 0x68, 0xeb, 0x72, 0x99, 0x04,
+	0xc1, 0x57, 0x83, 0xc1, 0xbe, 0xc1, 0xe0, 0xa7, 0xc1, 0xe0, 0xa3, 0xc5, 0x83, 0x7d, 0x8b, 0x07,
+	0xdf, 0x2d, 0x1e, 0xbc, 0x79, 0x69, 0xa6, 0xd6, 0xdb, 0x88, 0xc4, 0x7c, 0xe3, 0x77, 0xf3, 0x73,
+	0xba, 0x63, 0xa2, 0x2f, 0xfc, 0xf2, 0x3d, 0x35, 0xeb, 0x8e, 0x6c, 0xb3, 0xef, 0xe7, 0xdf, 0x01,
+	0x00, 0x94, 0x45, 0xa4, 0x79, 0x92, 0x01, 0x00, 0x00,
 }
 
 func (m *Blob) Marshal() (dAtA []byte, err error) {
@@ -206,6 +218,13 @@ func (m *Blob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBlob(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.NamespaceVersion != 0 {
 		i = encodeVarintBlob(dAtA, i, uint64(m.NamespaceVersion))
 		i--
@@ -315,6 +334,10 @@ func (m *Blob) Size() (n int) {
 	if m.NamespaceVersion != 0 {
 		n += 1 + sovBlob(uint64(m.NamespaceVersion))
 	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBlob(uint64(l))
+	}
 	return n
 }
 
@@ -482,6 +505,40 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlob
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlob(dAtA[iNdEx:])
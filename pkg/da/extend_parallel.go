@@ -0,0 +1,160 @@
+package da
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/shares"
+	"github.com/sunriselayer/sunrise/pkg/wrapper"
+)
+
+// ExtendSharesParallel erasure codes the shares into an extended data square
+// and computes its data availability header with a pool of workers. At most
+// workers rows or columns are encoded or hashed at the same time, and the
+// number of CPUs is used if workers is not positive. The output is identical
+// to ExtendShares followed by NewDataAvailabilityHeader.
+func ExtendSharesParallel(s [][]byte, workers int) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, error) {
+	// Check that the length of the square is a power of 2.
+	if !shares.IsPowerOfTwo(len(s)) {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	codec := appconsts.DefaultCodec()
+	if len(s) > codec.MaxChunks() {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("number of shares exceeds the maximum %d", codec.MaxChunks())
+	}
+	squareSize := SquareSize(len(s))
+	width := 2 * squareSize
+
+	// the cells of the extended data square indexed by row * width + column
+	cells := make([][]byte, width*width)
+	for row := 0; row < squareSize; row++ {
+		copy(cells[row*width:row*width+squareSize], s[row*squareSize:(row+1)*squareSize])
+	}
+	rowSlice := func(row, start int) [][]byte {
+		return cells[row*width+start : row*width+start+squareSize]
+	}
+	column := func(col int) [][]byte {
+		slice := make([][]byte, width)
+		for i := range slice {
+			slice[i] = cells[i*width+col]
+		}
+		return slice
+	}
+	extendRow := func(row int) error {
+		parity, err := codec.Encode(rowSlice(row, 0))
+		if err != nil {
+			return err
+		}
+		copy(rowSlice(row, squareSize), parity)
+		return nil
+	}
+	extendCol := func(col int) error {
+		parity, err := codec.Encode(column(col)[:squareSize])
+		if err != nil {
+			return err
+		}
+		for i, share := range parity {
+			cells[(squareSize+i)*width+col] = share
+		}
+		return nil
+	}
+
+	// Encode the rows of Q0 into Q1 and the columns of Q0 into Q2, then the
+	// rows of Q2 into Q3. Every task writes to its own cells only.
+	err := runTasks(workers, 2*squareSize, func(i int) error {
+		if i < squareSize {
+			return extendRow(i)
+		}
+		return extendCol(i - squareSize)
+	})
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+	err = runTasks(workers, squareSize, func(i int) error {
+		return extendRow(squareSize + i)
+	})
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+
+	// Compute the NMT roots of the 2k rows and the 2k columns.
+	rowRoots := make([][]byte, width)
+	colRoots := make([][]byte, width)
+	err = runTasks(workers, 2*width, func(i int) error {
+		var err error
+		if i < width {
+			rowRoots[i], err = axisRoot(squareSize, rsmt2d.Row, i, cells[i*width:(i+1)*width])
+			return err
+		}
+		col := i - width
+		colRoots[col], err = axisRoot(squareSize, rsmt2d.Col, col, column(col))
+		return err
+	})
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+
+	eds, err := rsmt2d.ImportExtendedDataSquare(cells, codec, wrapper.NewConstructor(uint64(squareSize)))
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+	dah := DataAvailabilityHeader{
+		RowRoots:    rowRoots,
+		ColumnRoots: colRoots,
+	}
+	// Generate the hash of the data using the new roots
+	dah.Hash()
+	return eds, dah, nil
+}
+
+// axisRoot returns the NMT root of the shares of a row or a column of the
+// extended data square.
+func axisRoot(squareSize int, axis rsmt2d.Axis, index int, axisShares [][]byte) ([]byte, error) {
+	tree := wrapper.NewConstructor(uint64(squareSize))(axis, uint(index))
+	for _, share := range axisShares {
+		if err := tree.Push(share); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
+
+// runTasks runs the tasks indexed from 0 to count - 1 with at most workers
+// goroutines and returns the first error encountered.
+func runTasks(workers, count int, task func(i int) error) error {
+	if workers > count {
+		workers = count
+	}
+	tasks := make(chan int, count)
+	for i := 0; i < count; i++ {
+		tasks <- i
+	}
+	close(tasks)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range tasks {
+				if err := task(i); err != nil {
+					errOnce.Do(func() { firstErr = err })
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
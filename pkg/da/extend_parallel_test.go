@@ -0,0 +1,95 @@
+package da
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendSharesParallel(t *testing.T) {
+	for squareSize := 1; squareSize <= appconsts.DefaultSquareSizeUpperBound; squareSize *= 2 {
+		shares := generateRandomShares(rand.New(rand.NewSource(int64(squareSize))), squareSize*squareSize)
+		eds, err := ExtendShares(shares)
+		require.NoError(t, err)
+		dah, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		for _, workers := range []int{0, 1, 3, 64} {
+			t.Run(fmt.Sprintf("squareSize=%d/workers=%d", squareSize, workers), func(t *testing.T) {
+				parallelEDS, parallelDAH, err := ExtendSharesParallel(shares, workers)
+				require.NoError(t, err)
+				require.True(t, eds.Equals(parallelEDS))
+				require.Equal(t, dah.RowRoots, parallelDAH.RowRoots)
+				require.Equal(t, dah.ColumnRoots, parallelDAH.ColumnRoots)
+				require.Equal(t, dah.Hash(), parallelDAH.Hash())
+			})
+		}
+	}
+
+	_, _, err := ExtendSharesParallel(generateShares(5), 0)
+	require.Error(t, err)
+}
+
+func FuzzExtendSharesParallel(f *testing.F) {
+	f.Add(int64(1), uint8(0), uint8(1))
+	f.Add(int64(2), uint8(3), uint8(4))
+	f.Add(int64(3), uint8(5), uint8(0))
+	f.Fuzz(func(t *testing.T, seed int64, log2SquareSize uint8, workers uint8) {
+		// squares of size 1 to 64 keep the iterations fast
+		squareSize := 1 << (log2SquareSize % 7)
+		shares := generateRandomShares(rand.New(rand.NewSource(seed)), squareSize*squareSize)
+
+		eds, err := ExtendShares(shares)
+		require.NoError(t, err)
+		dah, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		parallelEDS, parallelDAH, err := ExtendSharesParallel(shares, int(workers))
+		require.NoError(t, err)
+		require.True(t, eds.Equals(parallelEDS))
+		require.Equal(t, dah.Hash(), parallelDAH.Hash())
+	})
+}
+
+func BenchmarkExtendShares(b *testing.B) {
+	for _, squareSize := range []int{32, 64, 128} {
+		shares := generateRandomShares(rand.New(rand.NewSource(int64(squareSize))), squareSize*squareSize)
+		b.Run(fmt.Sprintf("squareSize=%d/sequential", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				eds, err := ExtendShares(shares)
+				require.NoError(b, err)
+				_, err = NewDataAvailabilityHeader(eds)
+				require.NoError(b, err)
+			}
+		})
+		for _, workers := range []int{1, 4, 0} {
+			b.Run(fmt.Sprintf("squareSize=%d/workers=%d", squareSize, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, _, err := ExtendSharesParallel(shares, workers)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+// generateRandomShares generates count number of shares with random sorted
+// namespaces and random contents.
+func generateRandomShares(r *rand.Rand, count int) [][]byte {
+	shares := make([][]byte, count)
+	for i := range shares {
+		id := make([]byte, appns.NamespaceVersionZeroIDSize)
+		r.Read(id)
+		share := make([]byte, appconsts.ShareSize)
+		copy(share, appns.MustNewV0(id).Bytes())
+		r.Read(share[appconsts.NamespaceSize:])
+		shares[i] = share
+	}
+	sortByteArrays(shares)
+	return shares
+}
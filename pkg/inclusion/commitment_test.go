@@ -72,6 +72,7 @@ func TestCreateCommitment(t *testing.T) {
 		expected     []byte
 		expectErr    bool
 		shareVersion uint8
+		signer       []byte
 	}
 	tests := []test{
 		{
@@ -86,7 +87,22 @@ func TestCreateCommitment(t *testing.T) {
 			namespace:    ns1,
 			blob:         bytes.Repeat([]byte{0xFF}, 12*appconsts.ShareSize),
 			expectErr:    true,
-			shareVersion: uint8(1), // unsupported share version
+			shareVersion: uint8(2), // unsupported share version
+		},
+		{
+			name:         "blob of 3 shares with signer succeeds",
+			namespace:    ns1,
+			blob:         bytes.Repeat([]byte{0xFF}, 3*appconsts.ShareSize),
+			expected:     []byte{0xf6, 0xc6, 0x27, 0x4d, 0x90, 0xba, 0xc3, 0x37, 0x6c, 0xf6, 0x77, 0x45, 0x22, 0x2e, 0xf3, 0xe8, 0xc9, 0x7d, 0x95, 0xaa, 0xed, 0xe9, 0x62, 0xe7, 0x37, 0xfd, 0xc3, 0xc9, 0x14, 0x0f, 0xbf, 0xc6},
+			shareVersion: appconsts.ShareVersionOne,
+			signer:       bytes.Repeat([]byte{0x1}, appconsts.SignerSize),
+		},
+		{
+			name:         "blob of share version 1 without signer should return error",
+			namespace:    ns1,
+			blob:         bytes.Repeat([]byte{0xFF}, 3*appconsts.ShareSize),
+			expectErr:    true,
+			shareVersion: appconsts.ShareVersionOne,
 		},
 	}
 	for _, tt := range tests {
@@ -96,6 +112,7 @@ func TestCreateCommitment(t *testing.T) {
 				Data:             tt.blob,
 				ShareVersion:     uint32(tt.shareVersion),
 				NamespaceVersion: uint32(tt.namespace.Version),
+				Signer:           tt.signer,
 			}
 			res, err := inclusion.CreateCommitment(blob)
 			if tt.expectErr {
@@ -0,0 +1,97 @@
+package proof
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/types"
+)
+
+// DataRootTupleHeightSize is the size of the height padded in a data root tuple.
+const DataRootTupleHeightSize = 32
+
+// EncodeDataRootTuple encodes the data root tuple of a block the same way as
+// the data commitments signed for Blobstream, i.e. the height padded to 32
+// bytes big endian followed by the data root.
+func EncodeDataRootTuple(height uint64, dataRoot []byte) ([]byte, error) {
+	if len(dataRoot) != tmhash.Size {
+		return nil, fmt.Errorf("data root size %d must be %d", len(dataRoot), tmhash.Size)
+	}
+	tuple := make([]byte, DataRootTupleHeightSize, DataRootTupleHeightSize+tmhash.Size)
+	binary.BigEndian.PutUint64(tuple[DataRootTupleHeightSize-8:], height)
+	return append(tuple, dataRoot...), nil
+}
+
+// NewDataRootInclusionProof returns the data commitment over the data roots of
+// the consecutive blocks starting at beginBlock, i.e. the range
+// [beginBlock, beginBlock+len(dataRoots)), and the merkle proof of the data
+// root tuple of the height to it.
+func NewDataRootInclusionProof(beginBlock uint64, dataRoots [][]byte, height uint64) ([]byte, *merkle.Proof, error) {
+	if height < beginBlock || height-beginBlock >= uint64(len(dataRoots)) {
+		return nil, nil, fmt.Errorf("height %d out of range [%d, %d)", height, beginBlock, beginBlock+uint64(len(dataRoots)))
+	}
+
+	tuples := make([][]byte, len(dataRoots))
+	for i, dataRoot := range dataRoots {
+		tuple, err := EncodeDataRootTuple(beginBlock+uint64(i), dataRoot)
+		if err != nil {
+			return nil, nil, err
+		}
+		tuples[i] = tuple
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(tuples)
+	return root, proofs[height-beginBlock], nil
+}
+
+// VerifyDataRootInclusion verifies that the data root tuple of the height is
+// included in the data commitment.
+func VerifyDataRootInclusion(proof merkle.Proof, height uint64, dataRoot []byte, dataCommitment []byte) error {
+	tuple, err := EncodeDataRootTuple(height, dataRoot)
+	if err != nil {
+		return err
+	}
+	return proof.Verify(dataCommitment, tuple)
+}
+
+// VerifyShareInclusion verifies end to end that the shares of the share proof
+// are included in the data commitment signed for Blobstream: the shares to
+// the data root of the block at the height by the share proof, then the data
+// root tuple to the data commitment by the data root proof.
+func VerifyShareInclusion(
+	shareProof types.ShareProof,
+	height uint64,
+	dataRoot []byte,
+	dataRootProof merkle.Proof,
+	dataCommitment []byte,
+) error {
+	if err := shareProof.Validate(dataRoot); err != nil {
+		return fmt.Errorf("invalid share proof: %w", err)
+	}
+	if err := VerifyDataRootInclusion(dataRootProof, height, dataRoot, dataCommitment); err != nil {
+		return fmt.Errorf("invalid data root inclusion proof: %w", err)
+	}
+	return nil
+}
+
+// VerifyRowInclusion verifies end to end that the rows of the row proof are
+// included in the data commitment signed for Blobstream: the row roots to the
+// data root of the block at the height by the row proof, then the data root
+// tuple to the data commitment by the data root proof.
+func VerifyRowInclusion(
+	rowProof types.RowProof,
+	height uint64,
+	dataRoot []byte,
+	dataRootProof merkle.Proof,
+	dataCommitment []byte,
+) error {
+	if err := rowProof.Validate(dataRoot); err != nil {
+		return fmt.Errorf("invalid row proof: %w", err)
+	}
+	if err := VerifyDataRootInclusion(dataRootProof, height, dataRoot, dataCommitment); err != nil {
+		return fmt.Errorf("invalid data root inclusion proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/da"
+	"github.com/sunriselayer/sunrise/pkg/proof"
+	"github.com/sunriselayer/sunrise/pkg/shares"
+	"github.com/sunriselayer/sunrise/pkg/square"
+	"github.com/sunriselayer/sunrise/test/util/testfactory"
+)
+
+func TestEncodeDataRootTuple(t *testing.T) {
+	dataRoot := bytes.Repeat([]byte{0xab}, tmhash.Size)
+	tuple, err := proof.EncodeDataRootTuple(0x0102, dataRoot)
+	require.NoError(t, err)
+
+	expected := make([]byte, 30, 64)
+	expected = append(expected, 0x01, 0x02)
+	expected = append(expected, dataRoot...)
+	require.Equal(t, expected, tuple)
+
+	_, err = proof.EncodeDataRootTuple(1, dataRoot[1:])
+	require.Error(t, err)
+}
+
+func TestDataRootInclusionProof(t *testing.T) {
+	const beginBlock = 11
+	dataRoots := make([][]byte, 5)
+	for i := range dataRoots {
+		dataRoots[i] = tmhash.Sum([]byte{byte(i)})
+	}
+
+	for height := uint64(beginBlock); height < beginBlock+5; height++ {
+		dataCommitment, dataRootProof, err := proof.NewDataRootInclusionProof(beginBlock, dataRoots, height)
+		require.NoError(t, err)
+
+		dataRoot := dataRoots[height-beginBlock]
+		require.NoError(t, proof.VerifyDataRootInclusion(*dataRootProof, height, dataRoot, dataCommitment))
+		// the tuple is bound to the height
+		require.Error(t, proof.VerifyDataRootInclusion(*dataRootProof, height+1, dataRoot, dataCommitment))
+		// and to the data root
+		require.Error(t, proof.VerifyDataRootInclusion(*dataRootProof, height, tmhash.Sum(dataRoot), dataCommitment))
+	}
+
+	_, _, err := proof.NewDataRootInclusionProof(beginBlock, dataRoots, beginBlock-1)
+	require.Error(t, err)
+	_, _, err = proof.NewDataRootInclusionProof(beginBlock, dataRoots, beginBlock+5)
+	require.Error(t, err)
+}
+
+func TestVerifyShareInclusion(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	const beginBlock, height = 1, 3
+	dataRoots := [][]byte{tmhash.Sum([]byte{1}), tmhash.Sum([]byte{2}), dah.Hash(), tmhash.Sum([]byte{4})}
+	dataCommitment, dataRootProof, err := proof.NewDataRootInclusionProof(beginBlock, dataRoots, height)
+	require.NoError(t, err)
+
+	shareProof, err := proof.NewTxInclusionProof(txs.ToSliceOfBytes(), 0, appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	require.NoError(t, proof.VerifyShareInclusion(shareProof, height, dah.Hash(), *dataRootProof, dataCommitment))
+	require.NoError(t, proof.VerifyRowInclusion(shareProof.RowProof, height, dah.Hash(), *dataRootProof, dataCommitment))
+
+	// the data root of another block in the window
+	require.Error(t, proof.VerifyShareInclusion(shareProof, height, dataRoots[0], *dataRootProof, dataCommitment))
+	// another data commitment
+	require.Error(t, proof.VerifyRowInclusion(shareProof.RowProof, height, dah.Hash(), *dataRootProof, tmhash.Sum(dataCommitment)))
+}
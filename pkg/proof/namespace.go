@@ -0,0 +1,227 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/blob"
+	"github.com/sunriselayer/sunrise/pkg/da"
+	"github.com/sunriselayer/sunrise/pkg/inclusion"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/pkg/shares"
+	"github.com/sunriselayer/sunrise/pkg/square"
+	"github.com/sunriselayer/sunrise/pkg/wrapper"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// NewNamespaceProof returns the proof that the shares of the namespace in the
+// data square are all the shares of the namespace, along with the data root
+// of the data square.
+func NewNamespaceProof(dataSquare square.Square, namespace appns.Namespace) (NamespaceProof, []byte, error) {
+	squareSize := dataSquare.Size()
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceProof{}, nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return NamespaceProof{}, nil, err
+	}
+
+	// prove the roots of all the rows of the original data square to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(dah.RowRoots, dah.ColumnRoots...))
+	rowProof := RowProof{
+		RowRoots: dah.RowRoots[:squareSize],
+		Proofs:   make([]*crypto.Proof, squareSize),
+		Root:     dah.Hash(),
+		StartRow: 0,
+		EndRow:   uint32(squareSize - 1),
+	}
+	for i := 0; i < squareSize; i++ {
+		rowProof.Proofs[i] = allProofs[i].ToProto()
+	}
+
+	shareProofs := make([]*NMTProof, squareSize)
+	var rawShares [][]byte
+	for i := 0; i < squareSize; i++ {
+		if !rowIncludesNamespace(dah.RowRoots[i], namespace) {
+			shareProofs[i] = &NMTProof{}
+			continue
+		}
+
+		// create an nmt to generate a proof.
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
+		row := eds.Row(uint(i))
+		for _, share := range row {
+			if err := tree.Push(share); err != nil {
+				return NamespaceProof{}, nil, err
+			}
+		}
+		proof, err := tree.ProveNamespace(namespace.Bytes())
+		if err != nil {
+			return NamespaceProof{}, nil, err
+		}
+
+		shareProofs[i] = &NMTProof{
+			Start:    int32(proof.Start()),
+			End:      int32(proof.End()),
+			Nodes:    proof.Nodes(),
+			LeafHash: proof.LeafHash(),
+		}
+		if !proof.IsOfAbsence() {
+			rawShares = append(rawShares, row[proof.Start():proof.End()]...)
+		}
+	}
+
+	return NamespaceProof{
+		Shares:      rawShares,
+		ShareProofs: shareProofs,
+		RowProof:    &rowProof,
+	}, dah.Hash(), nil
+}
+
+// Verify verifies that the shares of the proof are all the shares of the
+// namespace in the data square of the data root.
+func (p NamespaceProof) Verify(dataRoot []byte, namespace appns.Namespace) error {
+	if p.RowProof == nil {
+		return errors.New("missing row proof")
+	}
+	rowRoots := p.RowProof.RowRoots
+	squareSize := len(rowRoots)
+	if squareSize == 0 {
+		return errors.New("no row roots")
+	}
+	if p.RowProof.StartRow != 0 || int(p.RowProof.EndRow) != squareSize-1 {
+		return fmt.Errorf("the rows [%d, %d] must be all the %d rows of the data square", p.RowProof.StartRow, p.RowProof.EndRow, squareSize)
+	}
+	if len(p.RowProof.Proofs) != squareSize || len(p.ShareProofs) != squareSize {
+		return fmt.Errorf("the number of row proofs %d and share proofs %d must equal the number of row roots %d",
+			len(p.RowProof.Proofs), len(p.ShareProofs), squareSize)
+	}
+
+	for i, rowRoot := range rowRoots {
+		proof, err := merkle.ProofFromProto(p.RowProof.Proofs[i])
+		if err != nil {
+			return err
+		}
+		// the row and column roots of the extended data square are the leaves
+		// of the data root, where the original rows come first
+		if proof.Index != int64(i) || proof.Total != int64(4*squareSize) {
+			return fmt.Errorf("row proof %d is not of the row %d of the data square of size %d", proof.Index, i, squareSize)
+		}
+		if err := proof.Verify(dataRoot, rowRoot); err != nil {
+			return fmt.Errorf("row proof %d failed to verify: %w", i, err)
+		}
+	}
+
+	cursor := 0
+	for i, rowRoot := range rowRoots {
+		if !rowIncludesNamespace(rowRoot, namespace) {
+			continue
+		}
+		shareProof := p.ShareProofs[i]
+		if shareProof == nil {
+			return fmt.Errorf("missing share proof of row %d", i)
+		}
+
+		var nmtProof nmt.Proof
+		var leaves [][]byte
+		if len(shareProof.LeafHash) > 0 {
+			nmtProof = nmt.NewAbsenceProof(int(shareProof.Start), int(shareProof.End), shareProof.Nodes, shareProof.LeafHash, true)
+		} else {
+			nmtProof = nmt.NewInclusionProof(int(shareProof.Start), int(shareProof.End), shareProof.Nodes, true)
+			sharesUsed := int(shareProof.End - shareProof.Start)
+			if sharesUsed <= 0 || cursor+sharesUsed > len(p.Shares) {
+				return fmt.Errorf("share proof of row %d does not match the number of shares %d", i, len(p.Shares))
+			}
+			for _, share := range p.Shares[cursor : cursor+sharesUsed] {
+				leaves = append(leaves, append(namespace.Bytes(), share...))
+			}
+			cursor += sharesUsed
+		}
+
+		if !nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), leaves, rowRoot) {
+			return fmt.Errorf("share proof of row %d failed to verify", i)
+		}
+	}
+	if cursor != len(p.Shares) {
+		return fmt.Errorf("the number of shares %d must equal the number of shares in share proofs %d", len(p.Shares), cursor)
+	}
+
+	return nil
+}
+
+// Blobs parses the blobs from the shares of the proof.
+func (p NamespaceProof) Blobs() ([]*blob.Blob, error) {
+	rawShares, err := shares.FromBytes(p.Shares)
+	if err != nil {
+		return nil, err
+	}
+	return shares.ParseBlobs(rawShares)
+}
+
+// NewBlobsWithCommitments returns the blobs with their share commitments.
+func NewBlobsWithCommitments(blobs []*blob.Blob) ([]*BlobWithCommitment, error) {
+	blobsWithCommitments := make([]*BlobWithCommitment, len(blobs))
+	for i, b := range blobs {
+		commitment, err := inclusion.CreateCommitment(b)
+		if err != nil {
+			return nil, err
+		}
+		blobsWithCommitments[i] = &BlobWithCommitment{
+			NamespaceId:      b.NamespaceId,
+			Data:             b.Data,
+			ShareVersion:     b.ShareVersion,
+			NamespaceVersion: b.NamespaceVersion,
+			Commitment:       commitment,
+			Signer:           b.Signer,
+		}
+	}
+	return blobsWithCommitments, nil
+}
+
+// Verify verifies that the blobs of the response are all the blobs of the
+// namespace in the data square of the data root with their share commitments.
+func (res *QueryBlobsByNamespaceResponse) Verify(dataRoot []byte, namespace appns.Namespace) error {
+	if res.Proof == nil {
+		return errors.New("missing namespace proof")
+	}
+	if err := res.Proof.Verify(dataRoot, namespace); err != nil {
+		return err
+	}
+
+	blobs, err := res.Proof.Blobs()
+	if err != nil {
+		return err
+	}
+	expected, err := NewBlobsWithCommitments(blobs)
+	if err != nil {
+		return err
+	}
+	if len(expected) != len(res.Blobs) {
+		return fmt.Errorf("the number of blobs %d must equal the number of blobs in the shares %d", len(res.Blobs), len(expected))
+	}
+	for i, b := range res.Blobs {
+		if !bytes.Equal(b.NamespaceId, expected[i].NamespaceId) || !bytes.Equal(b.Data, expected[i].Data) ||
+			b.ShareVersion != expected[i].ShareVersion || b.NamespaceVersion != expected[i].NamespaceVersion ||
+			!bytes.Equal(b.Commitment, expected[i].Commitment) || !bytes.Equal(b.Signer, expected[i].Signer) {
+			return fmt.Errorf("blob %d differs from the shares", i)
+		}
+	}
+	return nil
+}
+
+// rowIncludesNamespace returns true if the namespace is in the namespace range
+// of the row root.
+func rowIncludesNamespace(rowRoot []byte, namespace appns.Namespace) bool {
+	minNamespace := nmt.MinNamespace(rowRoot, appconsts.NamespaceSize)
+	maxNamespace := nmt.MaxNamespace(rowRoot, appconsts.NamespaceSize)
+	return bytes.Compare(minNamespace, namespace.Bytes()) <= 0 && bytes.Compare(namespace.Bytes(), maxNamespace) <= 0
+}
@@ -0,0 +1,171 @@
+package proof_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/blob"
+	"github.com/sunriselayer/sunrise/pkg/inclusion"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/pkg/proof"
+	"github.com/sunriselayer/sunrise/pkg/square"
+	"github.com/sunriselayer/sunrise/test/util/blobfactory"
+	"github.com/sunriselayer/sunrise/test/util/testfactory"
+	"github.com/sunriselayer/sunrise/test/util/testnode"
+)
+
+func namespaceTestTxs(t *testing.T) (types.Txs, []appns.Namespace) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ns3 := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns2, ns2, ns3}, []int{500, 5000, 100, 500})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	return append(txs, blobTxs...), []appns.Namespace{ns1, ns2, ns3}
+}
+
+func TestBlobsByNamespace(t *testing.T) {
+	txs, namespaces := namespaceTestTxs(t)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	// blobs of the namespaces in the order of the txs
+	expected := make(map[string][]*blob.Blob)
+	for _, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if !isBlobTx {
+			continue
+		}
+		for _, b := range blobTx.Blobs {
+			expected[string(b.Namespace().Bytes())] = append(expected[string(b.Namespace().Bytes())], b)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		namespace appns.Namespace
+		blobs     []*blob.Blob
+	}{
+		{
+			name:      "single blob",
+			namespace: namespaces[0],
+			blobs:     expected[string(namespaces[0].Bytes())],
+		},
+		{
+			name:      "multiple blobs over rows",
+			namespace: namespaces[1],
+			blobs:     expected[string(namespaces[1].Bytes())],
+		},
+		{
+			name:      "absent in the range of a row",
+			namespace: appns.MustNewV0(append(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize-1), 2)),
+		},
+		{
+			name:      "absent after all the blobs",
+			namespace: appns.MustNewV0(bytes.Repeat([]byte{4}, appns.NamespaceVersionZeroIDSize)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := proof.BlobsByNamespace(dataSquare, tc.namespace)
+			require.NoError(t, err)
+			require.Len(t, res.Blobs, len(tc.blobs))
+			require.ElementsMatch(t, blobData(tc.blobs), blobWithCommitmentData(res.Blobs))
+			for i, b := range res.Blobs {
+				commitment, err := inclusion.CreateCommitment(&blob.Blob{
+					NamespaceId:      b.NamespaceId,
+					Data:             b.Data,
+					ShareVersion:     b.ShareVersion,
+					NamespaceVersion: b.NamespaceVersion,
+					Signer:           b.Signer,
+				})
+				require.NoError(t, err)
+				require.Equal(t, commitment, res.Blobs[i].Commitment)
+			}
+
+			_, dataRoot, err := proof.NewNamespaceProof(dataSquare, tc.namespace)
+			require.NoError(t, err)
+			require.NoError(t, res.Verify(dataRoot, tc.namespace))
+
+			// another data root
+			require.Error(t, res.Verify(bytes.Repeat([]byte{1}, 32), tc.namespace))
+			// another namespace
+			require.Error(t, res.Verify(dataRoot, namespaces[2]))
+
+			if len(res.Proof.Shares) > 0 {
+				// omitting a share breaks the completeness
+				tampered := *res.Proof
+				tampered.Shares = tampered.Shares[:len(tampered.Shares)-1]
+				require.Error(t, tampered.Verify(dataRoot, tc.namespace))
+			}
+			// omitting a row breaks the completeness
+			tampered := *res.Proof
+			rowProof := *tampered.RowProof
+			rowProof.RowRoots = rowProof.RowRoots[1:]
+			rowProof.Proofs = rowProof.Proofs[1:]
+			rowProof.EndRow--
+			tampered.RowProof = &rowProof
+			tampered.ShareProofs = tampered.ShareProofs[1:]
+			require.Error(t, tampered.Verify(dataRoot, tc.namespace))
+		})
+	}
+}
+
+type blockClient struct {
+	block *types.Block
+}
+
+func (c blockClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if height != nil && *height != c.block.Height {
+		return nil, context.DeadlineExceeded
+	}
+	return &coretypes.ResultBlock{Block: c.block}, nil
+}
+
+func TestQueryBlobsByNamespace(t *testing.T) {
+	txs, namespaces := namespaceTestTxs(t)
+	block := &types.Block{Header: types.Header{Height: 10}, Data: types.Data{Txs: txs}}
+	block.Header.Version.App = appconsts.LatestVersion
+	server := proof.NewQueryServer(blockClient{block: block})
+
+	res, err := server.BlobsByNamespace(context.Background(), &proof.QueryBlobsByNamespaceRequest{Height: 10, Namespace: namespaces[1].Bytes()})
+	require.NoError(t, err)
+	require.Len(t, res.Blobs, 2)
+
+	// the latest block
+	res, err = server.BlobsByNamespace(context.Background(), &proof.QueryBlobsByNamespaceRequest{Namespace: namespaces[0].Bytes()})
+	require.NoError(t, err)
+	require.Len(t, res.Blobs, 1)
+
+	_, err = server.BlobsByNamespace(context.Background(), &proof.QueryBlobsByNamespaceRequest{Height: 11, Namespace: namespaces[0].Bytes()})
+	require.Error(t, err)
+	_, err = server.BlobsByNamespace(context.Background(), &proof.QueryBlobsByNamespaceRequest{Height: 10, Namespace: appns.TxNamespace.Bytes()})
+	require.Error(t, err)
+	_, err = server.BlobsByNamespace(context.Background(), &proof.QueryBlobsByNamespaceRequest{Height: 10, Namespace: []byte{1}})
+	require.Error(t, err)
+}
+
+func blobData(blobs []*blob.Blob) [][]byte {
+	data := make([][]byte, len(blobs))
+	for i, b := range blobs {
+		data[i] = b.Data
+	}
+	return data
+}
+
+func blobWithCommitmentData(blobs []*proof.BlobWithCommitment) [][]byte {
+	data := make([][]byte, len(blobs))
+	for i, b := range blobs {
+		data[i] = b.Data
+	}
+	return data
+}
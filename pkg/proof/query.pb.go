@@ -0,0 +1,1416 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/core/v1/proof/query.proto
+
+package proof
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlobsByNamespaceRequest
+type QueryBlobsByNamespaceRequest struct {
+	// height of the block. The latest block is used if it is not positive.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the version followed by the ID of the namespace.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceRequest) Reset()         { *m = QueryBlobsByNamespaceRequest{} }
+func (m *QueryBlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceRequest) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe29da5a8e1afe72, []int{0}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryBlobsByNamespaceResponse
+type QueryBlobsByNamespaceResponse struct {
+	Blobs []*BlobWithCommitment `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Proof *NamespaceProof       `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceResponse) Reset()         { *m = QueryBlobsByNamespaceResponse{} }
+func (m *QueryBlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceResponse) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe29da5a8e1afe72, []int{1}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceResponse) GetBlobs() []*BlobWithCommitment {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) GetProof() *NamespaceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// BlobWithCommitment is a blob with its share commitment.
+type BlobWithCommitment struct {
+	NamespaceId      []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ShareVersion     uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	NamespaceVersion uint32 `protobuf:"varint,4,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	Commitment       []byte `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signer is only set for blobs of share version 1.
+	Signer []byte `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *BlobWithCommitment) Reset()         { *m = BlobWithCommitment{} }
+func (m *BlobWithCommitment) String() string { return proto.CompactTextString(m) }
+func (*BlobWithCommitment) ProtoMessage()    {}
+func (*BlobWithCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe29da5a8e1afe72, []int{2}
+}
+func (m *BlobWithCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobWithCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobWithCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobWithCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobWithCommitment.Merge(m, src)
+}
+func (m *BlobWithCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobWithCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobWithCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobWithCommitment proto.InternalMessageInfo
+
+func (m *BlobWithCommitment) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *BlobWithCommitment) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *BlobWithCommitment) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *BlobWithCommitment) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *BlobWithCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BlobWithCommitment) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+// NamespaceProof is a proof that a set of shares are all the shares of a
+// namespace in a data square. The roots of all the rows of the original data
+// square are proven to the data root so that the rows whose namespace range
+// includes the namespace are known.
+type NamespaceProof struct {
+	// shares of the namespace in the order of the rows
+	Shares [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	// share_proofs are the NMT namespace proofs of the rows, which are absence
+	// proofs if the namespace is in the range of the row but absent. They are
+	// empty for the rows whose range doesn't include the namespace.
+	ShareProofs []*NMTProof `protobuf:"bytes,2,rep,name=share_proofs,json=shareProofs,proto3" json:"share_proofs,omitempty"`
+	// row_proof proves the roots of all the rows of the original data square.
+	RowProof *RowProof `protobuf:"bytes,3,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+}
+
+func (m *NamespaceProof) Reset()         { *m = NamespaceProof{} }
+func (m *NamespaceProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceProof) ProtoMessage()    {}
+func (*NamespaceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe29da5a8e1afe72, []int{3}
+}
+func (m *NamespaceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceProof.Merge(m, src)
+}
+func (m *NamespaceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceProof proto.InternalMessageInfo
+
+func (m *NamespaceProof) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *NamespaceProof) GetShareProofs() []*NMTProof {
+	if m != nil {
+		return m.ShareProofs
+	}
+	return nil
+}
+
+func (m *NamespaceProof) GetRowProof() *RowProof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBlobsByNamespaceRequest)(nil), "sunrise.core.v1.proof.QueryBlobsByNamespaceRequest")
+	proto.RegisterType((*QueryBlobsByNamespaceResponse)(nil), "sunrise.core.v1.proof.QueryBlobsByNamespaceResponse")
+	proto.RegisterType((*BlobWithCommitment)(nil), "sunrise.core.v1.proof.BlobWithCommitment")
+	proto.RegisterType((*NamespaceProof)(nil), "sunrise.core.v1.proof.NamespaceProof")
+}
+
+func init() { proto.RegisterFile("sunrise/core/v1/proof/query.proto", fileDescriptor_fe29da5a8e1afe72) }
+
+var fileDescriptor_fe29da5a8e1afe72 = []byte{
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xed, 0xb6, 0xb8, 0xaf, 0x5d, 0x59, 0x07, 0x94, 0x50, 0x6a, 0xec, 0x56, 0x84,
+	0x96, 0x85, 0x84, 0x76, 0xbd, 0x29, 0x08, 0xdd, 0x93, 0x07, 0x45, 0xc3, 0xa2, 0xe0, 0xa5, 0x24,
+	0xed, 0x98, 0x0c, 0x36, 0x99, 0xec, 0xcc, 0xb4, 0x4b, 0xaf, 0xfe, 0x05, 0x82, 0x67, 0xff, 0x01,
+	0x4f, 0x9e, 0xfd, 0x0b, 0x3c, 0x2e, 0x88, 0xe0, 0x51, 0x5a, 0xff, 0x10, 0xc9, 0x9b, 0x34, 0xeb,
+	0x8f, 0x8d, 0xb0, 0x97, 0x30, 0xef, 0xcd, 0xf7, 0x7d, 0xde, 0xbc, 0x1f, 0x81, 0x03, 0xb5, 0x48,
+	0x24, 0x57, 0xcc, 0x9d, 0x0a, 0xc9, 0xdc, 0xe5, 0xd0, 0x4d, 0xa5, 0x10, 0xaf, 0xdd, 0xd3, 0x05,
+	0x93, 0x2b, 0x27, 0x95, 0x42, 0x0b, 0x7a, 0x33, 0x97, 0x38, 0x99, 0xc4, 0x59, 0x0e, 0x1d, 0x94,
+	0xb4, 0x3b, 0xa1, 0x10, 0xe1, 0x9c, 0xb9, 0x7e, 0xca, 0x5d, 0x3f, 0x49, 0x84, 0xf6, 0x35, 0x17,
+	0x89, 0x32, 0x41, 0xed, 0x12, 0x2e, 0x7e, 0x8d, 0xa4, 0x77, 0x02, 0x9d, 0xe7, 0x59, 0x9a, 0xf1,
+	0x5c, 0x04, 0x6a, 0xbc, 0x7a, 0xea, 0xc7, 0x4c, 0xa5, 0xfe, 0x94, 0x79, 0xec, 0x74, 0xc1, 0x94,
+	0xa6, 0xb7, 0xa0, 0x11, 0x31, 0x1e, 0x46, 0xda, 0x22, 0x5d, 0xd2, 0xaf, 0x79, 0xb9, 0x45, 0x3b,
+	0xb0, 0x9b, 0x6c, 0xb5, 0x56, 0xb5, 0x4b, 0xfa, 0x2d, 0xef, 0xc2, 0xd1, 0xfb, 0x40, 0xe0, 0x76,
+	0x09, 0x56, 0xa5, 0x22, 0x51, 0x8c, 0x3e, 0x82, 0x7a, 0x90, 0xdd, 0x59, 0xa4, 0x5b, 0xeb, 0x37,
+	0x47, 0x03, 0xe7, 0xd2, 0xfa, 0x9c, 0x2c, 0xfe, 0x25, 0xd7, 0xd1, 0xb1, 0x88, 0x63, 0xae, 0x63,
+	0x96, 0x68, 0xcf, 0xc4, 0xd1, 0x07, 0x50, 0x47, 0x09, 0x26, 0x6f, 0x8e, 0xee, 0x95, 0x00, 0x8a,
+	0xcc, 0xcf, 0x32, 0xd3, 0x33, 0x31, 0xbd, 0x6f, 0x04, 0xe8, 0xbf, 0x68, 0x7a, 0x00, 0xad, 0xa2,
+	0x86, 0x09, 0x9f, 0x61, 0xc9, 0x2d, 0xaf, 0x59, 0xf8, 0x1e, 0xcf, 0x28, 0x85, 0x9d, 0x99, 0xaf,
+	0xfd, 0xbc, 0x64, 0x3c, 0xd3, 0xbb, 0xb0, 0xa7, 0x22, 0x5f, 0xb2, 0xc9, 0x92, 0x49, 0xc5, 0x45,
+	0x62, 0xd5, 0xba, 0xa4, 0xbf, 0xe7, 0xb5, 0xd0, 0xf9, 0xc2, 0xf8, 0xe8, 0x21, 0xdc, 0xb8, 0x60,
+	0x6f, 0x85, 0x3b, 0x28, 0xdc, 0x2f, 0x2e, 0xb6, 0x62, 0x1b, 0x60, 0x5a, 0x3c, 0xcb, 0xaa, 0x63,
+	0xae, 0xdf, 0x3c, 0xd9, 0x54, 0x14, 0x0f, 0x13, 0x26, 0xad, 0x06, 0xde, 0xe5, 0x56, 0xef, 0x23,
+	0x81, 0xeb, 0x7f, 0x56, 0x8c, 0xd2, 0xec, 0x1d, 0xa6, 0xd3, 0x2d, 0x2f, 0xb7, 0xe8, 0x18, 0xcc,
+	0xfb, 0x26, 0xd8, 0x11, 0x65, 0x55, 0x71, 0x0e, 0x77, 0xca, 0xda, 0xf8, 0xe4, 0xc4, 0x34, 0xb0,
+	0x89, 0x41, 0x78, 0x56, 0xf4, 0x21, 0xec, 0x4a, 0x71, 0x66, 0x08, 0x58, 0x74, 0x39, 0xc0, 0x13,
+	0x67, 0x06, 0x70, 0x4d, 0xe6, 0xa7, 0xd1, 0x67, 0x02, 0x75, 0x5c, 0x12, 0xfa, 0x89, 0xc0, 0xfe,
+	0xdf, 0x9b, 0x42, 0x8f, 0x4a, 0x48, 0xff, 0x5b, 0xd7, 0xf6, 0xfd, 0xab, 0x05, 0x99, 0x65, 0xec,
+	0x0d, 0xdf, 0x7e, 0xfd, 0xf9, 0xbe, 0x7a, 0x48, 0x07, 0xee, 0xe5, 0x3f, 0x0c, 0x6e, 0xdc, 0x24,
+	0x58, 0x4d, 0x8a, 0x41, 0x8d, 0x8f, 0xbf, 0xac, 0x6d, 0x72, 0xbe, 0xb6, 0xc9, 0x8f, 0xb5, 0x4d,
+	0xde, 0x6d, 0xec, 0xca, 0xf9, 0xc6, 0xae, 0x7c, 0xdf, 0xd8, 0x95, 0x57, 0x83, 0x90, 0xeb, 0x68,
+	0x11, 0x38, 0x53, 0x11, 0x6f, 0x71, 0x73, 0x7f, 0xc5, 0x64, 0xc1, 0x4e, 0xdf, 0x84, 0x86, 0x1b,
+	0x34, 0xf0, 0x1f, 0x3c, 0xfa, 0x35, 0x00, 0xe7, 0xcd, 0x05, 0xf1, 0x00, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobsByNamespace returns the blobs of a namespace in the block at a height
+	// with the proof that they are all the blobs of the namespace.
+	BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error) {
+	out := new(QueryBlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.core.v1.proof.Query/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobsByNamespace returns the blobs of a namespace in the block at a height
+	// with the proof that they are all the blobs of the namespace.
+	BlobsByNamespace(context.Context, *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobsByNamespace(ctx context.Context, req *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.core.v1.proof.Query/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobsByNamespace(ctx, req.(*QueryBlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.core.v1.proof.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _Query_BlobsByNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/core/v1/proof/query.proto",
+}
+
+func (m *QueryBlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobWithCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobWithCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobWithCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RowProof != nil {
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShareProofs) > 0 {
+		for iNdEx := len(m.ShareProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobWithCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ShareVersion))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovQuery(uint64(m.NamespaceVersion))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ShareProofs) > 0 {
+		for _, e := range m.ShareProofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &BlobWithCommitment{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &NamespaceProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobWithCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobWithCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobWithCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareProofs = append(m.ShareProofs, &NMTProof{})
+			if err := m.ShareProofs[len(m.ShareProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,98 @@
+package proof
+
+import (
+	"context"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/pkg/square"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockClient fetches the blocks stored by a node. It is fulfilled by the
+// CometBFT RPC client.
+type BlockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	client BlockClient
+}
+
+// NewQueryServer returns the proof query server rebuilding the data squares
+// of the blocks fetched by the client.
+func NewQueryServer(client BlockClient) QueryServer {
+	return queryServer{client: client}
+}
+
+// RegisterQueryService registers the proof query service on the gRPC server.
+func RegisterQueryService(server gogogrpc.Server, client BlockClient) {
+	RegisterQueryServer(server, NewQueryServer(client))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof query service's gRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+// BlobsByNamespace rebuilds the data square of the block and returns the
+// blobs of the namespace with the proof that they are all of them.
+func (s queryServer) BlobsByNamespace(ctx context.Context, req *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	namespace, err := appns.From(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if namespace.IsReserved() {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace %X is reserved", req.Namespace)
+	}
+
+	var height *int64
+	if req.Height > 0 {
+		height = &req.Height
+	}
+	block, err := s.client.Block(ctx, height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	appVersion := block.Block.Header.Version.App
+	dataSquare, err := square.Construct(block.Block.Data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return BlobsByNamespace(dataSquare, namespace)
+}
+
+// BlobsByNamespace returns the blobs of the namespace in the data square with
+// their share commitments and the proof that they are all of them.
+func BlobsByNamespace(dataSquare square.Square, namespace appns.Namespace) (*QueryBlobsByNamespaceResponse, error) {
+	proof, _, err := NewNamespaceProof(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := proof.Blobs()
+	if err != nil {
+		return nil, err
+	}
+	blobsWithCommitments, err := NewBlobsWithCommitments(blobs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryBlobsByNamespaceResponse{
+		Blobs: blobsWithCommitments,
+		Proof: &proof,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
 	"github.com/sunriselayer/sunrise/pkg/blob"
 )
 
@@ -57,6 +58,13 @@ func parseSparseShares(shares []Share, supportedShareVersions []uint8) (blobs []
 				return nil, err
 			}
 			blob := blob.New(ns, data, version)
+			if version == appconsts.ShareVersionOne {
+				signer, err := share.Signer()
+				if err != nil {
+					return nil, err
+				}
+				blob.Signer = bytes.Clone(signer)
+			}
 			sequences = append(sequences, sequence{
 				blob:        blob,
 				sequenceLen: sequenceLen,
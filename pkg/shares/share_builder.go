@@ -3,6 +3,7 @@ package shares
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
 	appns "github.com/sunriselayer/sunrise/pkg/namespace"
@@ -87,6 +88,9 @@ func (b *Builder) IsEmptyShare() bool {
 	if b.isFirstShare {
 		expectedLen += appconsts.SequenceLenBytes
 	}
+	if b.hasSigner() {
+		expectedLen += appconsts.SignerSize
+	}
 	return len(b.rawShareData) == expectedLen
 }
 
@@ -169,6 +173,30 @@ func (b *Builder) WriteSequenceLen(sequenceLen uint32) error {
 	return nil
 }
 
+// WriteSigner writes the signer of the blob to the first share of a blob of
+// share version one.
+func (b *Builder) WriteSigner(signer []byte) error {
+	if b == nil {
+		return errors.New("the builder object is not initialized (is nil)")
+	}
+	if !b.hasSigner() {
+		return errors.New("not the first share of a blob of share version one")
+	}
+	if len(signer) != appconsts.SignerSize {
+		return fmt.Errorf("signer must be %d bytes, got %d", appconsts.SignerSize, len(signer))
+	}
+
+	index := appconsts.NamespaceSize + appconsts.ShareInfoBytes + appconsts.SequenceLenBytes
+	copy(b.rawShareData[index:index+appconsts.SignerSize], signer)
+	return nil
+}
+
+// hasSigner returns true if the share carries the signer of the blob, which
+// is the case for the first share of a blob of share version one.
+func (b *Builder) hasSigner() bool {
+	return b.isFirstShare && !b.isCompactShare && b.shareVersion == appconsts.ShareVersionOne
+}
+
 // FlipSequenceStart flips the sequence start indicator of the share provided
 func (b *Builder) FlipSequenceStart() {
 	infoByteIndex := b.indexOfInfoBytes()
@@ -215,6 +243,9 @@ func (b *Builder) prepareSparseShare() error {
 	if b.isFirstShare {
 		shareData = append(shareData, placeholderSequenceLen...)
 	}
+	if b.hasSigner() {
+		shareData = append(shareData, make([]byte, appconsts.SignerSize)...)
+	}
 
 	b.rawShareData = shareData
 	return nil
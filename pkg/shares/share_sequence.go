@@ -97,7 +97,21 @@ func numberOfSharesNeeded(firstShare Share) (sharesUsed int, err error) {
 	if isCompact {
 		return CompactSharesNeeded(int(sequenceLen)), nil
 	}
-	return SparseSharesNeeded(sequenceLen), nil
+	version, err := firstShare.Version()
+	if err != nil {
+		return 0, err
+	}
+	return BlobSharesNeeded(sequenceLen, version), nil
+}
+
+// BlobSharesNeeded returns the number of shares needed to store a blob of
+// length blobSize with the share version. The first share of a blob of share
+// version one also carries the signer of the blob.
+func BlobSharesNeeded(blobSize uint32, shareVersion uint8) (sharesNeeded int) {
+	if shareVersion == appconsts.ShareVersionOne && blobSize > 0 {
+		return SparseSharesNeeded(blobSize + appconsts.SignerSize)
+	}
+	return SparseSharesNeeded(blobSize)
 }
 
 // CompactSharesNeeded returns the number of compact shares needed to store a
@@ -188,9 +188,40 @@ func (s *Share) rawDataStartIndex() int {
 	if isCompact {
 		index += appconsts.CompactShareReservedBytes
 	}
+	if s.hasSigner() {
+		index += appconsts.SignerSize
+	}
 	return index
 }
 
+// Signer returns the signer of the blob carried by the first share of a blob
+// of share version one. It returns nil if the share does not carry a signer.
+func (s *Share) Signer() ([]byte, error) {
+	if !s.hasSigner() {
+		return nil, nil
+	}
+	start := appconsts.NamespaceSize + appconsts.ShareInfoBytes + appconsts.SequenceLenBytes
+	end := start + appconsts.SignerSize
+	if len(s.data) < end {
+		return nil, fmt.Errorf("share %s is too short to contain a signer", s)
+	}
+	return s.data[start:end], nil
+}
+
+// hasSigner returns true if the share is the first share of a blob of share
+// version one.
+func (s *Share) hasSigner() bool {
+	infoByte, err := s.InfoByte()
+	if err != nil {
+		return false
+	}
+	isCompact, err := s.IsCompactShare()
+	if err != nil {
+		return false
+	}
+	return infoByte.IsSequenceStart() && !isCompact && infoByte.Version() == appconsts.ShareVersionOne
+}
+
 // RawDataWithReserved returns the raw share data while taking reserved bytes into account.
 func (s *Share) RawDataUsingReserved() (rawData []byte, err error) {
 	rawDataStartIndexUsingReserved, err := s.rawDataStartIndexUsingReserved()
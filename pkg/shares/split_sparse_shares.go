@@ -43,6 +43,11 @@ func (sss *SparseShareSplitter) Write(blob *blob.Blob) error {
 	if err := b.WriteSequenceLen(uint32(len(rawData))); err != nil {
 		return err
 	}
+	if uint8(blob.ShareVersion) == appconsts.ShareVersionOne {
+		if err := b.WriteSigner(blob.Signer); err != nil {
+			return err
+		}
+	}
 
 	for rawData != nil {
 
@@ -9,6 +9,7 @@ import (
 	appns "github.com/sunriselayer/sunrise/pkg/namespace"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSparseShareSplitter tests that the spare share splitter can split blobs
@@ -60,3 +61,36 @@ func TestWriteNamespacePaddingShares(t *testing.T) {
 func newBlob(ns appns.Namespace, shareVersion uint8) *blob.Blob {
 	return blob.New(ns, []byte("data"), shareVersion)
 }
+
+// TestSparseShareSplitterWithSigner tests that the signer of a blob of share
+// version one is written to its first share and parsed back.
+func TestSparseShareSplitterWithSigner(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{0xAA}, appconsts.SignerSize)
+
+	// the signer pushes the last byte of the blob to a second share
+	data := bytes.Repeat([]byte{0xFF}, appconsts.FirstSparseShareContentSize-appconsts.SignerSize+1)
+	blob1 := blob.NewV1(ns1, data, signer)
+	sss := NewSparseShareSplitter()
+	require.NoError(t, sss.Write(blob1))
+
+	got := sss.Export()
+	require.Len(t, got, BlobSharesNeeded(uint32(len(data)), appconsts.ShareVersionOne))
+	require.Len(t, got, 2)
+
+	gotSigner, err := got[0].Signer()
+	require.NoError(t, err)
+	assert.Equal(t, signer, gotSigner)
+	gotSigner, err = got[1].Signer()
+	require.NoError(t, err)
+	assert.Nil(t, gotSigner)
+
+	blobs, err := ParseBlobs(got)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, blob1, blobs[0])
+
+	// a blob of share version one can not be written without its signer
+	blob1.Signer = nil
+	assert.Error(t, sss.Write(blob1))
+}
@@ -21,6 +21,10 @@ type Builder struct {
 	maxCapacity int
 	// currentSize is an overestimate for the number of shares used by this builder.
 	currentSize int
+	// reservedBlobShares is the number of shares that only blob transactions can use.
+	reservedBlobShares int
+	// blobSize is an overestimate for the number of shares used by blob transactions.
+	blobSize int
 
 	// here we keep track of the pending data to go in a square
 	Txs   [][]byte
@@ -77,7 +81,7 @@ func NewBuilder(maxSquareSize int, appVersion uint64, txs ...[]byte) (*Builder,
 // enough space in the square to fit the transaction.
 func (b *Builder) AppendTx(tx []byte) bool {
 	lenChange := b.TxCounter.Add(len(tx))
-	if b.canFit(lenChange) {
+	if b.canFit(lenChange + b.unusedReservedBlobShares()) {
 		b.Txs = append(b.Txs, tx)
 		b.currentSize += lenChange
 		b.done = false
@@ -110,6 +114,7 @@ func (b *Builder) AppendBlobTx(blobTx blob.BlobTx) bool {
 		b.Blobs = append(b.Blobs, blobElements...)
 		b.Pfbs = append(b.Pfbs, iw)
 		b.currentSize += (pfbShareDiff + maxBlobShareCount)
+		b.blobSize += (pfbShareDiff + maxBlobShareCount)
 		b.done = false
 		return true
 	}
@@ -356,6 +361,23 @@ func (b *Builder) NumTxs() int {
 	return len(b.Txs) + len(b.Pfbs)
 }
 
+// ReserveBlobShares reserves shares of the square for blob transactions. Normal
+// transactions are only appended if they leave enough space for the blob
+// transactions to use the reserved shares, while blob transactions can use all
+// the shares of the square.
+func (b *Builder) ReserveBlobShares(shares int) {
+	b.reservedBlobShares = shares
+}
+
+// unusedReservedBlobShares returns the number of reserved shares not used by
+// blob transactions yet.
+func (b *Builder) unusedReservedBlobShares() int {
+	if b.blobSize >= b.reservedBlobShares {
+		return 0
+	}
+	return b.reservedBlobShares - b.blobSize
+}
+
 func (b *Builder) canFit(shareNum int) bool {
 	return b.currentSize+shareNum <= b.maxCapacity
 }
@@ -373,7 +395,7 @@ type Element struct {
 }
 
 func newElement(blob *blob.Blob, pfbIndex, blobIndex, subtreeRootThreshold int) *Element {
-	numShares := shares.SparseSharesNeeded(uint32(len(blob.Data)))
+	numShares := shares.BlobSharesNeeded(uint32(len(blob.Data)), uint8(blob.ShareVersion))
 	return &Element{
 		Blob:      blob,
 		PfbIndex:  pfbIndex,
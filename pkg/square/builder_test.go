@@ -200,6 +200,65 @@ func TestBuilderRejectsBlobTransactions(t *testing.T) {
 	}
 }
 
+func TestBuilderReservesBlobShares(t *testing.T) {
+	ns1 := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	txs := generateBlobTxsWithNamespaces(t, ns1.Repeat(1), [][]int{{shares.AvailableBytesFromSparseShares(1)}})
+	require.Len(t, txs, 1)
+	blobTx, isBlobTx := blob.UnmarshalBlobTx(txs[0])
+	require.True(t, isBlobTx)
+
+	t.Run("normal txs leave the reserved shares", func(t *testing.T) {
+		builder, err := square.NewBuilder(2, appconsts.LatestVersion) // 2 x 2 square
+		require.NoError(t, err)
+		builder.ReserveBlobShares(2)
+		require.False(t, builder.AppendTx(newTx(shares.AvailableBytesFromCompactShares(3))))
+		require.True(t, builder.AppendTx(newTx(shares.AvailableBytesFromCompactShares(2))))
+		require.False(t, builder.AppendTx(newTx(shares.AvailableBytesFromCompactShares(1))))
+		require.True(t, builder.AppendBlobTx(blobTx))
+	})
+
+	t.Run("blob txs use the shares left by normal txs", func(t *testing.T) {
+		builder, err := square.NewBuilder(2, appconsts.LatestVersion)
+		require.NoError(t, err)
+		builder.ReserveBlobShares(1)
+		require.True(t, builder.AppendTx(newTx(shares.AvailableBytesFromCompactShares(2))))
+		require.True(t, builder.AppendBlobTx(blobTx))
+	})
+
+	t.Run("normal txs use the reserved shares once taken by blob txs", func(t *testing.T) {
+		builder, err := square.NewBuilder(2, appconsts.LatestVersion)
+		require.NoError(t, err)
+		builder.ReserveBlobShares(4)
+		require.True(t, builder.AppendBlobTx(blobTx))
+		require.False(t, builder.AppendTx(newTx(1)))
+		builder.ReserveBlobShares(2)
+		require.True(t, builder.AppendTx(newTx(shares.AvailableBytesFromCompactShares(2))))
+	})
+}
+
+func TestBuildWithReservedBlobShares(t *testing.T) {
+	ns1 := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	blobTxs := generateBlobTxsWithNamespaces(t, ns1.Repeat(1), [][]int{{shares.AvailableBytesFromSparseShares(1)}})
+	normalTxs := [][]byte{
+		newTx(shares.AvailableBytesFromCompactShares(2)),
+		newTx(shares.AvailableBytesFromCompactShares(2)),
+	}
+	txs := append(normalTxs, blobTxs...)
+
+	_, included, err := square.Build(txs, appconsts.LatestVersion, 2)
+	require.NoError(t, err)
+	require.Equal(t, normalTxs, included)
+
+	dataSquare, included, err := square.BuildWithReservedBlobShares(txs, appconsts.LatestVersion, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{normalTxs[0], blobTxs[0]}, included)
+
+	// the reservation is not a rule of the square construction
+	constructed, err := square.Construct(included, appconsts.LatestVersion, 2)
+	require.NoError(t, err)
+	require.Equal(t, dataSquare, constructed)
+}
+
 func TestBuilderInvalidConstructor(t *testing.T) {
 	_, err := square.NewBuilder(-4, appconsts.LatestVersion)
 	require.Error(t, err)
@@ -0,0 +1,220 @@
+package square
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/da"
+	"github.com/sunriselayer/sunrise/pkg/shares"
+
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// DefaultCacheSize is the default number of bytes of extended squares a Cache
+// keeps. It holds a few extended squares of the largest square size, which is
+// enough for the same block to be built, processed and extended by a node.
+const DefaultCacheSize = 256 * 1024 * 1024
+
+// ExtendedSquare is a data square along with its extended data square and its
+// data availability header.
+type ExtendedSquare struct {
+	Square Square
+	EDS    *rsmt2d.ExtendedDataSquare
+	DAH    da.DataAvailabilityHeader
+}
+
+// Extend erasure codes the data square and computes the data availability
+// header of the extended data square with at most workers goroutines, or the
+// number of CPUs if workers is not positive.
+func Extend(dataSquare Square, workers int) (*ExtendedSquare, error) {
+	eds, dah, err := da.ExtendSharesParallel(shares.ToBytes(dataSquare), workers)
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendedSquare{
+		Square: dataSquare,
+		EDS:    eds,
+		DAH:    dah,
+	}, nil
+}
+
+// size returns an estimate of the number of bytes held by the extended
+// square: the shares of the original square, the four quadrants of the
+// extended square and the row and column roots.
+func (es *ExtendedSquare) size() int {
+	squareSize := es.Square.Size()
+	rootSize := 2*appconsts.NamespaceSize + appconsts.HashLength()
+	return 5*squareSize*squareSize*appconsts.ShareSize + 4*squareSize*rootSize
+}
+
+// CacheStats are the statistics of the lookups of a Cache.
+type CacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+	Bytes   int
+}
+
+// HitRate returns the ratio of lookups that were served from the cache.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type cacheEntry struct {
+	key            [sha256.Size]byte
+	extendedSquare *ExtendedSquare
+	size           int
+}
+
+// Cache keeps the extended squares of the recently seen sets of transactions
+// so that the same block is only erasure coded once when it is prepared,
+// processed, finalized and extended for sampling. The entries are keyed by the
+// hash of the ordered transactions and the app version and the least recently
+// used ones are evicted once the cache holds more than its size in bytes.
+//
+// The extended squares returned by the cache are shared and must not be
+// modified.
+type Cache struct {
+	mu      sync.Mutex
+	maxSize int
+	size    int
+	entries map[[sha256.Size]byte]*list.Element
+	lru     *list.List
+	hits    uint64
+	misses  uint64
+}
+
+// NewCache returns a cache holding up to maxSize bytes of extended squares.
+func NewCache(maxSize int) *Cache {
+	return &Cache{
+		maxSize: maxSize,
+		entries: make(map[[sha256.Size]byte]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Construct returns the extended square of the ordered transactions. It is
+// served from the cache if it has already been computed, otherwise the square
+// is constructed, extended with at most workers goroutines and added to the
+// cache. It returns true if the extended square was found in the cache.
+func (c *Cache) Construct(txs [][]byte, appVersion uint64, maxSquareSize int, workers int) (*ExtendedSquare, bool, error) {
+	key := cacheKey(txs, appVersion)
+	if es, found := c.get(key, maxSquareSize); found {
+		return es, true, nil
+	}
+
+	dataSquare, err := Construct(txs, appVersion, maxSquareSize)
+	if err != nil {
+		return nil, false, err
+	}
+	es, err := Extend(dataSquare, workers)
+	if err != nil {
+		return nil, false, err
+	}
+	c.add(key, es)
+	return es, false, nil
+}
+
+// Extend extends the data square built from the ordered transactions with at
+// most workers goroutines and adds it to the cache.
+func (c *Cache) Extend(txs [][]byte, appVersion uint64, dataSquare Square, workers int) (*ExtendedSquare, error) {
+	es, err := Extend(dataSquare, workers)
+	if err != nil {
+		return nil, err
+	}
+	c.Add(txs, appVersion, es)
+	return es, nil
+}
+
+// Add adds the extended square built from the ordered transactions to the
+// cache.
+func (c *Cache) Add(txs [][]byte, appVersion uint64, es *ExtendedSquare) {
+	c.add(cacheKey(txs, appVersion), es)
+}
+
+// Get returns the extended square of the ordered transactions if it is in the
+// cache and fits in the max square size.
+func (c *Cache) Get(txs [][]byte, appVersion uint64, maxSquareSize int) (*ExtendedSquare, bool) {
+	return c.get(cacheKey(txs, appVersion), maxSquareSize)
+}
+
+// Stats returns the statistics of the lookups of the cache.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: c.lru.Len(),
+		Bytes:   c.size,
+	}
+}
+
+func (c *Cache) get(key [sha256.Size]byte, maxSquareSize int) (*ExtendedSquare, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, found := c.entries[key]
+	// The square constructed from a set of transactions does not depend on the
+	// max square size as long as it fits, so an extended square cached with a
+	// larger max square size is only served if it would have been constructed.
+	if !found || elem.Value.(*cacheEntry).extendedSquare.Square.Size() > maxSquareSize {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).extendedSquare, true
+}
+
+func (c *Cache) add(key [sha256.Size]byte, es *ExtendedSquare) {
+	size := es.size()
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.entries[key]; found {
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{
+		key:            key,
+		extendedSquare: es,
+		size:           size,
+	})
+	c.size += size
+
+	for c.size > c.maxSize {
+		oldest := c.lru.Back()
+		entry := oldest.Value.(*cacheEntry)
+		c.lru.Remove(oldest)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+	}
+}
+
+// cacheKey hashes the app version and the length prefixed transactions in
+// their order.
+func cacheKey(txs [][]byte, appVersion uint64) [sha256.Size]byte {
+	hasher := sha256.New()
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, appVersion)
+	hasher.Write(buf)
+	for _, tx := range txs {
+		binary.BigEndian.PutUint64(buf, uint64(len(tx)))
+		hasher.Write(buf)
+		hasher.Write(tx)
+	}
+	var key [sha256.Size]byte
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
@@ -0,0 +1,100 @@
+package square_test
+
+import (
+	"testing"
+
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	"github.com/sunriselayer/sunrise/pkg/square"
+	"github.com/sunriselayer/sunrise/test/util/testnode"
+)
+
+func TestCache(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+	txs := generateOrderedTxs(signer, rand, 10, 10, 1, 10000)
+	otherTxs := generateOrderedTxs(signer, rand, 10, 10, 1, 10000)
+
+	cache := square.NewCache(square.DefaultCacheSize)
+	es, hit, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+	require.NoError(t, err)
+	require.False(t, hit)
+
+	// the extended square matches the one computed without the cache
+	dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	expected, err := square.Extend(dataSquare, 0)
+	require.NoError(t, err)
+	require.True(t, expected.Square.Equals(es.Square))
+	require.Equal(t, expected.DAH.Hash(), es.DAH.Hash())
+
+	cached, hit, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+	require.NoError(t, err)
+	require.True(t, hit)
+	require.Same(t, es, cached)
+
+	// another app version or set of transactions is another entry
+	_, found := cache.Get(txs, appconsts.LatestVersion-1, appconsts.DefaultSquareSizeUpperBound)
+	require.False(t, found)
+	_, found = cache.Get(otherTxs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.False(t, found)
+
+	// the square does not fit in a smaller max square size
+	_, found = cache.Get(txs, appconsts.LatestVersion, es.Square.Size()/2)
+	require.False(t, found)
+	_, _, err = cache.Construct(txs, appconsts.LatestVersion, es.Square.Size()/2, 0)
+	require.Error(t, err)
+
+	stats := cache.Stats()
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(5), stats.Misses)
+	require.Equal(t, 1, stats.Entries)
+	require.InDelta(t, 1.0/6, stats.HitRate(), 1e-9)
+}
+
+func TestCacheEviction(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	rand := tmrand.NewRand()
+	txs := [][][]byte{
+		generateOrderedTxs(signer, rand, 10, 10, 1, 10000),
+		generateOrderedTxs(signer, rand, 10, 10, 1, 10000),
+		generateOrderedTxs(signer, rand, 10, 10, 1, 10000),
+	}
+
+	extendedSquares := make([]*square.ExtendedSquare, len(txs))
+	for i := range txs {
+		dataSquare, err := square.Construct(txs[i], appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		extendedSquares[i], err = square.Extend(dataSquare, 0)
+		require.NoError(t, err)
+	}
+
+	// the cache holds two of the extended squares of the same size
+	cache := square.NewCache(1)
+	cache.Add(txs[0], appconsts.LatestVersion, extendedSquares[0])
+	require.Equal(t, 0, cache.Stats().Entries, "an extended square larger than the cache is not added")
+
+	single := square.NewCache(square.DefaultCacheSize)
+	single.Add(txs[0], appconsts.LatestVersion, extendedSquares[0])
+	cache = square.NewCache(2 * single.Stats().Bytes)
+
+	cache.Add(txs[0], appconsts.LatestVersion, extendedSquares[0])
+	cache.Add(txs[1], appconsts.LatestVersion, extendedSquares[1])
+	// using the first one makes the second one the least recently used
+	_, found := cache.Get(txs[0], appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.True(t, found)
+	cache.Add(txs[2], appconsts.LatestVersion, extendedSquares[2])
+
+	require.Equal(t, 2, cache.Stats().Entries)
+	require.LessOrEqual(t, cache.Stats().Bytes, 2*single.Stats().Bytes)
+	_, found = cache.Get(txs[0], appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.True(t, found)
+	_, found = cache.Get(txs[1], appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.False(t, found)
+	_, found = cache.Get(txs[2], appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.True(t, found)
+}
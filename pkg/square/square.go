@@ -21,10 +21,21 @@ import (
 // not check the underlying validity of the transactions.
 // Errors should not occur and would reflect a violation in an invariant.
 func Build(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, [][]byte, error) {
+	return BuildWithReservedBlobShares(txs, appVersion, maxSquareSize, 0)
+}
+
+// BuildWithReservedBlobShares builds a square like Build while reserving
+// reservedBlobShares shares of the square for blob transactions. Normal
+// transactions that would take reserved shares not used by blob transactions
+// are left out of the square. The reservation is a rule of the block proposer
+// only, the square is constructed from the returned transactions regardless of
+// it.
+func BuildWithReservedBlobShares(txs [][]byte, appVersion uint64, maxSquareSize int, reservedBlobShares int) (Square, [][]byte, error) {
 	builder, err := NewBuilder(maxSquareSize, appVersion)
 	if err != nil {
 		return nil, nil, err
 	}
+	builder.ReserveBlobShares(reservedBlobShares)
 	normalTxs := make([][]byte, 0, len(txs))
 	blobTxs := make([][]byte, 0, len(txs))
 	for _, tx := range txs {
@@ -130,10 +141,13 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 		if len(pfb.BlobSizes) != len(wpfb.ShareIndexes) {
 			return nil, fmt.Errorf("expected PFB to have %d blob sizes, but got %d", len(wpfb.ShareIndexes), len(pfb.BlobSizes))
 		}
+		if len(pfb.ShareVersions) != len(wpfb.ShareIndexes) {
+			return nil, fmt.Errorf("expected PFB to have %d share versions, but got %d", len(wpfb.ShareIndexes), len(pfb.ShareVersions))
+		}
 
 		blobs := make([]*blob.Blob, len(wpfb.ShareIndexes))
 		for j, shareIndex := range wpfb.ShareIndexes {
-			end := int(shareIndex) + shares.SparseSharesNeeded(pfb.BlobSizes[j])
+			end := int(shareIndex) + shares.BlobSharesNeeded(pfb.BlobSizes[j], uint8(pfb.ShareVersions[j]))
 			parsedBlobs, err := shares.ParseBlobs(s[shareIndex:end])
 			if err != nil {
 				return nil, err
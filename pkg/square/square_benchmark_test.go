@@ -55,3 +55,60 @@ func BenchmarkSquareBuild(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkExtendedSquare compares erasure coding a block in PrepareProposal,
+// ProcessProposal and FinalizeBlock without the cache with the cached path
+// where the extended square is computed once by the proposer and served from
+// the cache afterwards, and with the cache miss of FinalizeBlock while syncing.
+func BenchmarkExtendedSquare(b *testing.B) {
+	for _, pfbCount := range []int{8, 64} {
+		signer, err := testnode.NewOfflineSigner()
+		require.NoError(b, err)
+		// blobs of 100 KB fill a square of size 64 and 128 respectively
+		txs := generateOrderedTxs(signer, tmrand.NewRand(), 0, pfbCount, 1, 100_000)
+		dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(b, err)
+		squareSize := dataSquare.Size()
+
+		b.Run(fmt.Sprintf("squareSize=%d/uncached", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < 3; j++ {
+					dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+					require.NoError(b, err)
+					_, err = square.Extend(dataSquare, 0)
+					require.NoError(b, err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("squareSize=%d/cached", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				cache := square.NewCache(square.DefaultCacheSize)
+				for j := 0; j < 3; j++ {
+					_, _, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+					require.NoError(b, err)
+				}
+			}
+		})
+		// a node syncing blocks without processing their proposals misses
+		// the cache in FinalizeBlock for every block
+		b.Run(fmt.Sprintf("squareSize=%d/miss", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				cache := square.NewCache(square.DefaultCacheSize)
+				_, hit, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+				require.NoError(b, err)
+				require.False(b, hit)
+			}
+		})
+		b.Run(fmt.Sprintf("squareSize=%d/hit", squareSize), func(b *testing.B) {
+			cache := square.NewCache(square.DefaultCacheSize)
+			_, _, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+			require.NoError(b, err)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, hit, err := cache.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, 0)
+				require.NoError(b, err)
+				require.True(b, hit)
+			}
+		})
+	}
+}
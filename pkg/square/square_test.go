@@ -13,6 +13,7 @@ import (
 	"github.com/sunriselayer/sunrise/pkg/da"
 	"github.com/sunriselayer/sunrise/pkg/inclusion"
 	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	apprand "github.com/sunriselayer/sunrise/pkg/random"
 	"github.com/sunriselayer/sunrise/pkg/shares"
 	"github.com/sunriselayer/sunrise/pkg/square"
 	"github.com/sunriselayer/sunrise/test/util"
@@ -222,6 +223,22 @@ func TestSquareDeconstruct(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
 	})
+	t.Run("AuthoredBlobs", func(t *testing.T) {
+		signer, err := testnode.NewOfflineSigner()
+		require.NoError(t, err)
+		txs := make([][]byte, 0, 10)
+		for i := 0; i < 10; i++ {
+			b := blob.NewV1(apprand.RandomBlobNamespace(), tmrand.Bytes(1000*(i+1)), signer.Address())
+			tx, err := signer.CreatePayForBlob([]*blob.Blob{b})
+			require.NoError(t, err)
+			txs = append(txs, tx)
+		}
+		dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		recomputedTxs, err := square.Deconstruct(dataSquare, encCfg.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
+	})
 	t.Run("EmptySquare", func(t *testing.T) {
 		tx, err := square.Deconstruct(square.EmptySquare(), encCfg.TxConfig.TxDecoder())
 		require.NoError(t, err)
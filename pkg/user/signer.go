@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"github.com/sunriselayer/sunrise/app/encoding"
 	"github.com/sunriselayer/sunrise/pkg/blob"
 	blobtypes "github.com/sunriselayer/sunrise/x/blob/types"
+	feetypes "github.com/sunriselayer/sunrise/x/fee/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,6 +30,10 @@ import (
 
 const DefaultPollTime = 3 * time.Second
 
+// BaseFeeMultiplier is applied to the base fee and the blob base fee when the fee is priced
+// by the Signer, so that the tx stays valid while the base fees rise for a few blocks.
+var BaseFeeMultiplier = sdkmath.LegacyMustNewDecFromStr("1.25")
+
 // Signer is an abstraction for building, signing, and broadcasting Celestia transactions
 type Signer struct {
 	keys          keyring.Keyring
@@ -38,6 +44,8 @@ type Signer struct {
 	chainID       string
 	accountNumber uint64
 	pollTime      time.Duration
+	baseFee       sdktypes.DecCoin
+	blobBaseFee   sdkmath.LegacyDec
 
 	mtx                   sync.RWMutex
 	lastSignedSequence    uint64
@@ -121,12 +129,25 @@ func SetupSigner(
 		return nil, err
 	}
 
-	return NewSigner(keys, conn, address, encCfg.TxConfig, chainID, accNum, seqNum)
+	signer, err := NewSigner(keys, conn, address, encCfg.TxConfig, chainID, accNum, seqNum)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signer.UpdateBaseFee(ctx); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
 }
 
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
-// may be provided to set the fee and gas limit.
+// may be provided to set the fee and gas limit. If the fee is not set, it is priced from the latest base fee.
 func (s *Signer) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	if err := s.UpdateBaseFee(ctx); err != nil {
+		return nil, err
+	}
+
 	txBytes, err := s.CreateTx(msgs, opts...)
 	if err != nil {
 		return nil, err
@@ -144,8 +165,16 @@ func (s *Signer) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOp
 }
 
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
-// TxOptions may be provided to set the fee and gas limit.
+// TxOptions may be provided to set the fee and gas limit. If the fee is not set, it is priced from the latest base fee
+// and blob base fee.
 func (s *Signer) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	if err := s.UpdateBaseFee(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.UpdateBlobBaseFee(ctx); err != nil {
+		return nil, err
+	}
+
 	txBytes, err := s.CreatePayForBlob(blobs, opts...)
 	if err != nil {
 		return nil, err
@@ -163,9 +192,14 @@ func (s *Signer) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts
 }
 
 // CreateTx forms a transaction from the provided messages and signs it. TxOptions may be optionally
-// used to set the gas limit and fee.
+// used to set the gas limit and fee. If only the gas limit is set, the fee is priced from the base fee
+// last fetched by the Signer.
 func (s *Signer) CreateTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, error) {
-	txBuilder := s.txBuilder(opts...)
+	return s.createTx(msgs, nil, opts...)
+}
+
+func (s *Signer) createTx(msgs []sdktypes.Msg, blobSizes []uint32, opts ...TxOption) ([]byte, error) {
+	txBuilder := s.txBuilder(blobSizes, opts...)
 	if err := txBuilder.SetMsgs(msgs...); err != nil {
 		return nil, err
 	}
@@ -177,13 +211,16 @@ func (s *Signer) CreateTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, error)
 	return s.enc.TxEncoder()(txBuilder.GetTx())
 }
 
+// CreatePayForBlob forms a transaction from the provided blobs and signs it. TxOptions may be optionally
+// used to set the gas limit and fee. If only the gas limit is set, the fee is priced from the base fee and
+// the blob base fee last fetched by the Signer.
 func (s *Signer) CreatePayForBlob(blobs []*blob.Blob, opts ...TxOption) ([]byte, error) {
 	msg, err := blobtypes.NewMsgPayForBlobs(s.address.String(), blobs...)
 	if err != nil {
 		return nil, err
 	}
 
-	txBytes, err := s.CreateTx([]sdktypes.Msg{msg}, opts...)
+	txBytes, err := s.createTx([]sdktypes.Msg{msg}, msg.BlobSizes, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,6 +302,46 @@ func (s *Signer) SetPollTime(pollTime time.Duration) {
 	s.pollTime = pollTime
 }
 
+// BaseFee returns the base fee last fetched by the signer
+func (s *Signer) BaseFee() sdktypes.DecCoin {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.baseFee
+}
+
+// UpdateBaseFee fetches the current base fee from the chain
+func (s *Signer) UpdateBaseFee(ctx context.Context) error {
+	baseFee, err := QueryBaseFee(ctx, s.grpc)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.baseFee = baseFee
+	return nil
+}
+
+// BlobBaseFee returns the blob base fee last fetched by the signer
+func (s *Signer) BlobBaseFee() sdkmath.LegacyDec {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.blobBaseFee
+}
+
+// UpdateBlobBaseFee fetches the current blob base fee from the chain
+func (s *Signer) UpdateBlobBaseFee(ctx context.Context) error {
+	blobBaseFee, err := QueryBlobBaseFee(ctx, s.grpc)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.blobBaseFee = blobBaseFee
+	return nil
+}
+
 // PubKey returns the public key of the signer
 func (s *Signer) PubKey() cryptotypes.PubKey {
 	return s.pk
@@ -366,11 +443,30 @@ func (s *Signer) createSignature(builder client.TxBuilder, sequence uint64) ([]b
 }
 
 // txBuilder returns the default sdk Tx builder using the celestia-app encoding config
-func (s *Signer) txBuilder(opts ...TxOption) client.TxBuilder {
+func (s *Signer) txBuilder(blobSizes []uint32, opts ...TxOption) client.TxBuilder {
 	builder := s.enc.NewTxBuilder()
 	for _, opt := range opts {
 		builder = opt(builder)
 	}
+
+	// price the fee from the base fee and the blob base fee of the blobs if only the gas limit is set
+	tx := builder.GetTx()
+	baseFee := s.BaseFee()
+	if tx.GetFee().IsZero() && tx.GetGas() > 0 && !baseFee.Amount.IsNil() && baseFee.Denom != "" {
+		fee := baseFee.Amount.MulInt(sdkmath.NewIntFromUint64(tx.GetGas()))
+		if blobBaseFee := s.BlobBaseFee(); !blobBaseFee.IsNil() {
+			blobBytes := uint64(0)
+			for _, size := range blobSizes {
+				blobBytes += uint64(size)
+			}
+			fee = fee.Add(blobBaseFee.MulInt(sdkmath.NewIntFromUint64(blobBytes)))
+		}
+		if fee.IsPositive() {
+			amount := fee.Mul(BaseFeeMultiplier).Ceil().TruncateInt()
+			builder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(baseFee.Denom, amount)))
+		}
+	}
+
 	return builder
 }
 
@@ -394,3 +490,26 @@ func QueryAccount(ctx context.Context, conn *grpc.ClientConn, encCfg encoding.Co
 	accNum, seqNum = acc.GetAccountNumber(), acc.GetSequence()
 	return accNum, seqNum, nil
 }
+
+// QueryBaseFee fetches the current base fee, the minimum gas price of txs, from the celestia-app node.
+func QueryBaseFee(ctx context.Context, conn *grpc.ClientConn) (sdktypes.DecCoin, error) {
+	qclient := feetypes.NewQueryClient(conn)
+	resp, err := qclient.BaseFee(ctx, &feetypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return sdktypes.DecCoin{}, err
+	}
+
+	return resp.BaseFee, nil
+}
+
+// QueryBlobBaseFee fetches the current blob base fee, the minimum price per blob byte in the fee denom,
+// from the celestia-app node.
+func QueryBlobBaseFee(ctx context.Context, conn *grpc.ClientConn) (sdkmath.LegacyDec, error) {
+	qclient := blobtypes.NewQueryClient(conn)
+	resp, err := qclient.BlobBaseFee(ctx, &blobtypes.QueryBlobBaseFeeRequest{})
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	return sdkmath.LegacyNewDecFromStr(resp.BlobBaseFee)
+}
@@ -61,6 +61,16 @@ func (s *SignerTestSuite) TestSubmitPayForBlob() {
 	require.EqualValues(t, 0, resp.Code)
 }
 
+func (s *SignerTestSuite) TestQueryBlobBaseFee() {
+	t := s.T()
+	blobBaseFee, err := user.QueryBlobBaseFee(s.ctx.GoContext(), s.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.False(t, blobBaseFee.IsNegative())
+
+	require.NoError(t, s.signer.UpdateBlobBaseFee(s.ctx.GoContext()))
+	require.False(t, s.signer.BlobBaseFee().IsNegative())
+}
+
 func (s *SignerTestSuite) TestSubmitTx() {
 	t := s.T()
 	fee := user.SetFee(1e6)
@@ -62,7 +62,8 @@ func SetFeeGranter(feeGranter sdk.AccAddress) TxOption {
 }
 
 // SetGasLimitAndFee sets the gas limit and fee using the provided gas price and
-// gas limit. Note that this could overwrite or be overwritten by other
+// gas limit. If the gas price is zero, the fee is priced by the Signer from the
+// base fee of the chain. Note that this could overwrite or be overwritten by other
 // conflicting TxOptions.
 func SetGasLimitAndFee(gasLimit uint64, gasPrice float64) TxOption {
 	return func(builder sdkclient.TxBuilder) sdkclient.TxBuilder {
@@ -47,6 +47,7 @@ type Tree interface {
 	Root() ([]byte, error)
 	Push(namespacedData namespace.PrefixedData) error
 	ProveRange(start, end int) (nmt.Proof, error)
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -129,6 +130,13 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle range proof for all the leaves of the
+// namespace, or an absence proof if the namespace is in the range of the tree
+// but absent.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(nID)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
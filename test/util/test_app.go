@@ -31,6 +31,7 @@ import (
 	"github.com/sunriselayer/sunrise/test/util/testnode"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 const ChainID = testfactory.ChainID
@@ -119,6 +120,18 @@ func SetupTestAppWithGenesisValSet(cparams tmproto.ConsensusParams, genAccounts
 	return testApp, kr
 }
 
+// SetupTestingApp initializes a new app with a no-op logger and its default
+// genesis state. It can be set as the DefaultTestingAppInit of ibc-go's testing
+// package to run chains of the app in a coordinator.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	testApp, err := app.New(log.NewNopLogger(), db, nil, true, EmptyAppOptions{})
+	if err != nil {
+		panic(err)
+	}
+	return &TestingApp{App: testApp}, testApp.DefaultGenesis()
+}
+
 // AddAccount mimics the cli addAccount command, providing an
 // account with an allocation of to "token" and "tia" tokens in the genesis
 // state
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+
+	"github.com/sunriselayer/sunrise/app"
+)
+
+var _ ibctesting.TestingApp = (*TestingApp)(nil)
+
+// TestingApp wraps the app with the accessors required by the TestingApp
+// interface of ibc-go's testing package, which the app does not need itself.
+type TestingApp struct {
+	*app.App
+}
+
+// GetBaseApp implements the TestingApp interface.
+func (app *TestingApp) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetStakingKeeper implements the TestingApp interface.
+func (app *TestingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetScopedIBCKeeper implements the TestingApp interface.
+func (app *TestingApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
@@ -15,13 +15,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sunriselayer/sunrise/x/blob/keeper"
+	blobtestutil "github.com/sunriselayer/sunrise/x/blob/testutil"
 	"github.com/sunriselayer/sunrise/x/blob/types"
 )
 
+type BlobMocks struct {
+	BankKeeper *blobtestutil.MockBankKeeper
+}
+
 func BlobKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := blobKeeper(t, BlobMocks{})
+	return k, ctx
+}
+
+func BlobKeeperWithMocks(t testing.TB) (keeper.Keeper, BlobMocks, sdk.Context) {
+	ctrl := gomock.NewController(t)
+	m := BlobMocks{
+		BankKeeper: blobtestutil.NewMockBankKeeper(ctrl),
+	}
+	return blobKeeper(t, m)
+}
+
+func blobKeeper(t testing.TB, m BlobMocks) (keeper.Keeper, BlobMocks, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +57,7 @@ func BlobKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		m.BankKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -45,5 +65,5 @@ func BlobKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, m, ctx
 }
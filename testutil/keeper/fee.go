@@ -15,13 +15,38 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sunriselayer/sunrise/x/fee/keeper"
+	feetestutil "github.com/sunriselayer/sunrise/x/fee/testutil"
 	"github.com/sunriselayer/sunrise/x/fee/types"
 )
 
+type FeeMocks struct {
+	BankKeeper          *feetestutil.MockBankKeeper
+	DistributionKeeper  *feetestutil.MockDistributionKeeper
+	SwapKeeper          *feetestutil.MockSwapKeeper
+	LiquidityPoolKeeper *feetestutil.MockLiquidityPoolKeeper
+}
+
 func FeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := feeKeeper(t, FeeMocks{})
+	return k, ctx
+}
+
+func FeeKeeperWithMocks(t testing.TB) (keeper.Keeper, FeeMocks, sdk.Context) {
+	ctrl := gomock.NewController(t)
+	m := FeeMocks{
+		BankKeeper:          feetestutil.NewMockBankKeeper(ctrl),
+		DistributionKeeper:  feetestutil.NewMockDistributionKeeper(ctrl),
+		SwapKeeper:          feetestutil.NewMockSwapKeeper(ctrl),
+		LiquidityPoolKeeper: feetestutil.NewMockLiquidityPoolKeeper(ctrl),
+	}
+	return feeKeeper(t, m)
+}
+
+func feeKeeper(t testing.TB, m FeeMocks) (keeper.Keeper, FeeMocks, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,7 +63,10 @@ func FeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil,
+		m.BankKeeper,
+		m.DistributionKeeper,
+		m.SwapKeeper,
+		m.LiquidityPoolKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -46,5 +74,5 @@ func FeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, m, ctx
 }
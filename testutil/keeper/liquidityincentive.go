@@ -28,6 +28,7 @@ type LiquidityIncentiveMocks struct {
 	BankKeeper          *liquidityincentivetestutil.MockBankKeeper
 	StakingKeeper       *liquidityincentivetestutil.MockStakingKeeper
 	LiquiditypoolKeeper *liquidityincentivetestutil.MockLiquidityPoolKeeper
+	FeeKeeper           *liquidityincentivetestutil.MockFeeKeeper
 }
 
 func LiquidityincentiveKeeper(t testing.TB) (keeper.Keeper, LiquidityIncentiveMocks, sdk.Context) {
@@ -49,6 +50,7 @@ func LiquidityincentiveKeeper(t testing.TB) (keeper.Keeper, LiquidityIncentiveMo
 		BankKeeper:          liquidityincentivetestutil.NewMockBankKeeper(ctrl),
 		StakingKeeper:       liquidityincentivetestutil.NewMockStakingKeeper(ctrl),
 		LiquiditypoolKeeper: liquidityincentivetestutil.NewMockLiquidityPoolKeeper(ctrl),
+		FeeKeeper:           liquidityincentivetestutil.NewMockFeeKeeper(ctrl),
 	}
 
 	k := keeper.NewKeeper(
@@ -60,6 +62,7 @@ func LiquidityincentiveKeeper(t testing.TB) (keeper.Keeper, LiquidityIncentiveMo
 		m.BankKeeper,
 		m.StakingKeeper,
 		m.LiquiditypoolKeeper,
+		m.FeeKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
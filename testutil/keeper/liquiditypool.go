@@ -29,7 +29,17 @@ func init() {
 	config.SetBech32PrefixForValidator("sunrisevaloper", "sunrisevaloperpub")
 }
 
+type LiquiditypoolMocks struct {
+	BankKeeper         *liquiditypooltestutil.MockBankKeeper
+	DistributionKeeper *liquiditypooltestutil.MockDistributionKeeper
+}
+
 func LiquiditypoolKeeper(t *testing.T) (keeper.Keeper, *liquiditypooltestutil.MockBankKeeper, sdk.Context) {
+	k, m, ctx := LiquiditypoolKeeperWithMocks(t)
+	return k, m.BankKeeper, ctx
+}
+
+func LiquiditypoolKeeperWithMocks(t *testing.T) (keeper.Keeper, LiquiditypoolMocks, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -42,14 +52,18 @@ func LiquiditypoolKeeper(t *testing.T) (keeper.Keeper, *liquiditypooltestutil.Mo
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	ctrl := gomock.NewController(t)
-	bankKeeper := liquiditypooltestutil.NewMockBankKeeper(ctrl)
+	m := LiquiditypoolMocks{
+		BankKeeper:         liquiditypooltestutil.NewMockBankKeeper(ctrl),
+		DistributionKeeper: liquiditypooltestutil.NewMockDistributionKeeper(ctrl),
+	}
 
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		bankKeeper,
+		m.BankKeeper,
+		m.DistributionKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -59,5 +73,5 @@ func LiquiditypoolKeeper(t *testing.T) (keeper.Keeper, *liquiditypooltestutil.Mo
 		panic(err)
 	}
 
-	return k, bankKeeper, ctx
+	return k, m, ctx
 }
@@ -15,13 +15,38 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sunriselayer/sunrise/x/swap/keeper"
+	swaptestutil "github.com/sunriselayer/sunrise/x/swap/testutil"
 	"github.com/sunriselayer/sunrise/x/swap/types"
 )
 
+type SwapMocks struct {
+	AccountKeeper       *swaptestutil.MockAccountKeeper
+	BankKeeper          *swaptestutil.MockBankKeeper
+	TransferKeeper      *swaptestutil.MockTransferKeeper
+	LiquidityPoolKeeper *swaptestutil.MockLiquidityPoolKeeper
+}
+
 func SwapKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := swapKeeper(t, SwapMocks{})
+	return k, ctx
+}
+
+func SwapKeeperWithMocks(t testing.TB) (keeper.Keeper, SwapMocks, sdk.Context) {
+	ctrl := gomock.NewController(t)
+	m := SwapMocks{
+		AccountKeeper:       swaptestutil.NewMockAccountKeeper(ctrl),
+		BankKeeper:          swaptestutil.NewMockBankKeeper(ctrl),
+		TransferKeeper:      swaptestutil.NewMockTransferKeeper(ctrl),
+		LiquidityPoolKeeper: swaptestutil.NewMockLiquidityPoolKeeper(ctrl),
+	}
+	return swapKeeper(t, m)
+}
+
+func swapKeeper(t testing.TB, m SwapMocks) (keeper.Keeper, SwapMocks, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,10 +63,10 @@ func SwapKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil,
-		nil,
-		nil,
-		nil,
+		m.AccountKeeper,
+		m.BankKeeper,
+		m.TransferKeeper,
+		m.LiquidityPoolKeeper,
 		nil,
 	)
 
@@ -52,5 +77,5 @@ func SwapKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, m, ctx
 }
@@ -6,30 +6,38 @@ import (
 	"github.com/sunriselayer/sunrise/x/blob/types"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // MinGasPFBDecorator helps to prevent a PFB from being included in a block
 // but running out of gas in DeliverTx (effectively getting DA for free)
-// This decorator should be run after any decorator that consumes gas.
+// It also charges the blob base fee of the blobs on top of the base fee of the
+// gas.
+// This decorator should be run after any decorator that consumes gas and after
+// the fees are deducted.
 type MinGasPFBDecorator struct {
-	k BlobKeeper
+	k  BlobKeeper
+	fk FeeKeeper
 }
 
-func NewMinGasPFBDecorator(k BlobKeeper) MinGasPFBDecorator {
-	return MinGasPFBDecorator{k}
+func NewMinGasPFBDecorator(k BlobKeeper, fk FeeKeeper) MinGasPFBDecorator {
+	return MinGasPFBDecorator{k, fk}
 }
 
 // AnteHandle implements the AnteHandler interface. It checks to see
 // if the transaction contains a MsgPayForBlobs and if so, checks that
-// the transaction has allocated enough gas.
+// the transaction has allocated enough gas and pays the blob base fee.
 func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	if ctx.IsReCheckTx() {
 		return next(ctx, tx, simulate)
 	}
 
-	var gasPerByte uint32
+	var (
+		gasPerByte  uint32
+		blobBaseFee = math.ZeroInt()
+	)
 	txGas := ctx.GasMeter().GasRemaining()
 	for _, m := range tx.GetMsgs() {
 		// NOTE: here we assume only one PFB per transaction
@@ -42,6 +50,18 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 			if gasToConsume > txGas {
 				return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 			}
+			blobBaseFee = blobBaseFee.Add(d.k.GetRequiredBlobBaseFee(ctx, pfb.BlobSizes))
+		}
+	}
+
+	// the fees are not checked when simulating like in the fee deduction
+	if !simulate && blobBaseFee.IsPositive() {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+		if err := d.fk.ChargeExtraBaseFee(ctx, feeTx.GetFee(), feeTx.GetGas(), blobBaseFee); err != nil {
+			return ctx, errors.Wrap(err, "not enough fees to pay the blob base fee")
 		}
 	}
 
@@ -51,4 +71,9 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 type BlobKeeper interface {
 	GasPerBlobByte(ctx context.Context) uint32
 	GovMaxSquareSize(ctx context.Context) uint64
+	GetRequiredBlobBaseFee(ctx context.Context, blobSizes []uint32) math.Int
+}
+
+type FeeKeeper interface {
+	ChargeExtraBaseFee(ctx sdk.Context, fees sdk.Coins, gas uint64, extra math.Int) error
 }
@@ -11,8 +11,10 @@ import (
 	ante "github.com/sunriselayer/sunrise/x/blob/ante"
 	blob "github.com/sunriselayer/sunrise/x/blob/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,7 +93,7 @@ func TestPFBAnteHandler(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{})
+			anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{}, &mockFeeKeeper{})
 			ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(tc.txGas)).WithIsCheckTx(true)
 			ctx.GasMeter().ConsumeGas(tc.gasConsumed, "test")
 			txBuilder := txConfig.NewTxBuilder()
@@ -107,7 +109,58 @@ func TestPFBAnteHandler(t *testing.T) {
 	}
 }
 
-type mockBlobKeeper struct{}
+func TestPFBAnteHandlerBlobBaseFee(t *testing.T) {
+	txConfig := encoding.MakeConfig(util.ModuleBasics).TxConfig
+	pfb := &blob.MsgPayForBlobs{
+		BlobSizes: []uint32{100, 200},
+	}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("fee", 1000))
+	newTx := func() sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(pfb))
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(1000000)
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	newCtx := func() sdk.Context {
+		return sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(1000000)).WithIsCheckTx(true)
+	}
+
+	t.Run("charges the blob base fee of all the blobs", func(t *testing.T) {
+		feeKeeper := &mockFeeKeeper{}
+		anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{blobBaseFee: math.LegacyNewDecWithPrec(15, 1)}, feeKeeper)
+		_, err := anteHandler.AnteHandle(newCtx(), newTx(), false, next)
+		require.NoError(t, err)
+		require.Equal(t, fees, feeKeeper.fees)
+		require.Equal(t, uint64(1000000), feeKeeper.gas)
+		require.Equal(t, math.NewInt(450), feeKeeper.extra)
+	})
+
+	t.Run("not enough fees", func(t *testing.T) {
+		feeKeeper := &mockFeeKeeper{err: sdkerrors.ErrInsufficientFee}
+		anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{blobBaseFee: math.LegacyOneDec()}, feeKeeper)
+		_, err := anteHandler.AnteHandle(newCtx(), newTx(), false, next)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	})
+
+	t.Run("not charged without blob base fee, when simulating or rechecking", func(t *testing.T) {
+		feeKeeper := &mockFeeKeeper{err: sdkerrors.ErrInsufficientFee}
+		_, err := ante.NewMinGasPFBDecorator(mockBlobKeeper{}, feeKeeper).AnteHandle(newCtx(), newTx(), false, next)
+		require.NoError(t, err)
+
+		anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{blobBaseFee: math.LegacyOneDec()}, feeKeeper)
+		_, err = anteHandler.AnteHandle(newCtx(), newTx(), true, next)
+		require.NoError(t, err)
+		_, err = anteHandler.AnteHandle(newCtx().WithIsReCheckTx(true), newTx(), false, next)
+		require.NoError(t, err)
+		require.Nil(t, feeKeeper.fees)
+	})
+}
+
+type mockBlobKeeper struct {
+	blobBaseFee math.LegacyDec
+}
 
 func (mockBlobKeeper) GasPerBlobByte(_ context.Context) uint32 {
 	return testGasPerBlobByte
@@ -116,3 +169,29 @@ func (mockBlobKeeper) GasPerBlobByte(_ context.Context) uint32 {
 func (mockBlobKeeper) GovMaxSquareSize(_ context.Context) uint64 {
 	return testGovMaxSquareSize
 }
+
+func (k mockBlobKeeper) GetRequiredBlobBaseFee(_ context.Context, blobSizes []uint32) math.Int {
+	if k.blobBaseFee.IsNil() {
+		return math.ZeroInt()
+	}
+	blobBytes := int64(0)
+	for _, size := range blobSizes {
+		blobBytes += int64(size)
+	}
+	return k.blobBaseFee.MulInt64(blobBytes).Ceil().TruncateInt()
+}
+
+type mockFeeKeeper struct {
+	err   error
+	fees  sdk.Coins
+	gas   uint64
+	extra math.Int
+}
+
+func (k *mockFeeKeeper) ChargeExtraBaseFee(_ sdk.Context, fees sdk.Coins, gas uint64, extra math.Int) error {
+	if k.err != nil {
+		return k.err
+	}
+	k.fees, k.gas, k.extra = fees, gas, extra
+	return nil
+}
@@ -0,0 +1,41 @@
+package ante
+
+import (
+	"context"
+
+	blobtypes "github.com/sunriselayer/sunrise/x/blob/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlobQuotaDecorator prevents an account from paying for more blob bytes, and
+// a namespace from holding more blob bytes, than the quotas of the blob module
+// allow per window. The usage is recorded as the transactions are checked, so
+// the quotas apply cumulatively to the transactions of the mempool, of a
+// proposal and of a block.
+type BlobQuotaDecorator struct {
+	k BlobQuotaKeeper
+}
+
+func NewBlobQuotaDecorator(k BlobQuotaKeeper) BlobQuotaDecorator {
+	return BlobQuotaDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose signer or namespaces
+// exceed their blob quota in the current window.
+func (d BlobQuotaDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			if err := d.k.ConsumeBlobQuota(ctx, pfb); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+type BlobQuotaKeeper interface {
+	ConsumeBlobQuota(ctx context.Context, msg *blobtypes.MsgPayForBlobs) error
+}
@@ -0,0 +1,58 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sunriselayer/sunrise/app/encoding"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/test/util"
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	ante "github.com/sunriselayer/sunrise/x/blob/ante"
+	blob "github.com/sunriselayer/sunrise/x/blob/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobQuotaAnteHandler(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	ctx = ctx.WithBlockHeight(2)
+
+	params := blob.DefaultParams()
+	params.AccountBlobQuota = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	txConfig := encoding.MakeConfig(util.ModuleBasics).TxConfig
+	signer := sample.AccAddress()
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	decorator := ante.NewBlobQuotaDecorator(k)
+
+	for _, tc := range []struct {
+		name      string
+		blobSize  uint32
+		expectErr error
+	}{
+		{name: "within the quota", blobSize: 60},
+		{name: "fills the quota", blobSize: 40},
+		{name: "exceeds the quota", blobSize: 1, expectErr: blob.ErrAccountBlobQuotaExceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&blob.MsgPayForBlobs{
+				Signer:     signer,
+				Namespaces: [][]byte{ns},
+				BlobSizes:  []uint32{tc.blobSize},
+			}))
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			require.ErrorIs(t, err, tc.expectErr)
+		})
+	}
+
+	// transactions without blobs are not limited
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{FromAddress: signer}))
+	_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+	require.NoError(t, err)
+}
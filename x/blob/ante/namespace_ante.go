@@ -0,0 +1,41 @@
+package ante
+
+import (
+	"context"
+
+	blobtypes "github.com/sunriselayer/sunrise/x/blob/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NamespacePosterDecorator rejects the PFBs paying for blobs in a restricted
+// registered namespace if their signer is neither the owner nor a poster of
+// the namespace.
+type NamespacePosterDecorator struct {
+	k NamespaceKeeper
+}
+
+func NewNamespacePosterDecorator(k NamespaceKeeper) NamespacePosterDecorator {
+	return NamespacePosterDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose signer is not
+// authorised to post to one of its namespaces.
+func (d NamespacePosterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			for _, namespace := range pfb.Namespaces {
+				if err := d.k.ValidateNamespacePoster(ctx, pfb.Signer, namespace); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+type NamespaceKeeper interface {
+	ValidateNamespacePoster(ctx context.Context, address string, namespace []byte) error
+}
@@ -0,0 +1,53 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sunriselayer/sunrise/app/encoding"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/test/util"
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	ante "github.com/sunriselayer/sunrise/x/blob/ante"
+	blob "github.com/sunriselayer/sunrise/x/blob/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacePosterAnteHandler(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+
+	owner := sample.AccAddress()
+	poster := sample.AccAddress()
+	restricted := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	unrestricted := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	unregistered := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	k.SetRegisteredNamespace(ctx, blob.RegisteredNamespace{Namespace: restricted, Owner: owner, Posters: []string{poster}, Restricted: true})
+	k.SetRegisteredNamespace(ctx, blob.RegisteredNamespace{Namespace: unrestricted, Owner: owner})
+
+	txConfig := encoding.MakeConfig(util.ModuleBasics).TxConfig
+	decorator := ante.NewNamespacePosterDecorator(k)
+
+	for _, tc := range []struct {
+		name       string
+		signer     string
+		namespaces [][]byte
+		expectErr  error
+	}{
+		{name: "owner", signer: owner, namespaces: [][]byte{restricted}},
+		{name: "poster", signer: poster, namespaces: [][]byte{restricted, unregistered}},
+		{name: "anyone in an unrestricted namespace", signer: sample.AccAddress(), namespaces: [][]byte{unrestricted, unregistered}},
+		{name: "anyone in a restricted namespace", signer: sample.AccAddress(), namespaces: [][]byte{unregistered, restricted}, expectErr: blob.ErrUnauthorizedNamespacePoster},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&blob.MsgPayForBlobs{
+				Signer:     tc.signer,
+				Namespaces: tc.namespaces,
+			}))
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			require.ErrorIs(t, err, tc.expectErr)
+		})
+	}
+}
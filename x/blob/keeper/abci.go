@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.PruneBlobUsage(ctx)
+
+	return k.UpdateBlobBaseFee(ctx)
+}
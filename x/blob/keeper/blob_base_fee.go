@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+// GetBlobBaseFee returns the blob base fee, the minimum price per blob byte in
+// the fee denom. It is the min blob base fee of the params until it is set.
+func (k Keeper) GetBlobBaseFee(ctx context.Context) math.LegacyDec {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BlobBaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBlobBaseFee
+	}
+
+	var blobBaseFee math.LegacyDec
+	if err := blobBaseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return blobBaseFee
+}
+
+// SetBlobBaseFee sets the blob base fee
+func (k Keeper) SetBlobBaseFee(ctx context.Context, blobBaseFee math.LegacyDec) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := blobBaseFee.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.BlobBaseFeeKey, bz)
+
+	return nil
+}
+
+// GetRequiredBlobBaseFee returns the blob base fee the blobs of the sizes pay
+// in the fee denom.
+func (k Keeper) GetRequiredBlobBaseFee(ctx context.Context, blobSizes []uint32) math.Int {
+	blobBytes := uint64(0)
+	for _, size := range blobSizes {
+		blobBytes += uint64(size)
+	}
+	return k.GetBlobBaseFee(ctx).MulInt(math.NewIntFromUint64(blobBytes)).Ceil().TruncateInt()
+}
+
+// GetBlockBlobShares returns the number of shares used by the blobs paid for
+// in the current block.
+func (k Keeper) GetBlockBlobShares(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BlockBlobSharesKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// AddBlockBlobShares adds the shares used by blobs paid for in the current
+// block.
+func (k Keeper) AddBlockBlobShares(ctx context.Context, shares uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.BlockBlobSharesKey, binary.BigEndian.AppendUint64(nil, k.GetBlockBlobShares(ctx)+shares))
+}
+
+// UpdateBlobBaseFee moves the blob base fee toward the shares used by the blobs
+// of the block in the way of EIP-1559. The blob base fee rises if the shares
+// used exceed the target square utilisation of a square of the gov max square
+// size, falls otherwise, and never goes below the min blob base fee. The shares
+// used by the blobs of the block are reset.
+func (k Keeper) UpdateBlobBaseFee(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	blobBaseFee := k.GetBlobBaseFee(ctx)
+	sharesUsed := k.GetBlockBlobShares(ctx)
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.BlockBlobSharesKey)
+
+	// $ delta = blobBaseFee * (sharesUsed - target) / target / denominator $
+	squareShares := params.GovMaxSquareSize * params.GovMaxSquareSize
+	target := params.TargetSquareUtilisation.MulInt(math.NewIntFromUint64(squareShares))
+	if !target.IsPositive() {
+		return nil
+	}
+	sharesDelta := math.LegacyNewDecFromInt(math.NewIntFromUint64(sharesUsed)).Sub(target)
+	delta := blobBaseFee.Mul(sharesDelta).Quo(target).QuoInt64(int64(params.BlobBaseFeeChangeDenominator))
+
+	newBlobBaseFee := math.LegacyMaxDec(blobBaseFee.Add(delta), params.MinBlobBaseFee)
+
+	return k.SetBlobBaseFee(ctx, newBlobBaseFee)
+}
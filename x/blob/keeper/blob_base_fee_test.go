@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func TestUpdateBlobBaseFee(t *testing.T) {
+	tests := []struct {
+		desc           string
+		blobBaseFee    math.LegacyDec
+		sharesUsed     uint64
+		expBlobBaseFee math.LegacyDec
+	}{
+		{
+			desc:           "at target",
+			blobBaseFee:    math.LegacyNewDec(8),
+			sharesUsed:     8,
+			expBlobBaseFee: math.LegacyNewDec(8),
+		},
+		{
+			desc:           "full square",
+			blobBaseFee:    math.LegacyNewDec(8),
+			sharesUsed:     16,
+			expBlobBaseFee: math.LegacyNewDec(9),
+		},
+		{
+			desc:           "no blobs",
+			blobBaseFee:    math.LegacyNewDec(8),
+			sharesUsed:     0,
+			expBlobBaseFee: math.LegacyNewDec(7),
+		},
+		{
+			desc:           "not below min blob base fee",
+			blobBaseFee:    math.LegacyNewDecWithPrec(11, 1),
+			sharesUsed:     0,
+			expBlobBaseFee: math.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlobKeeper(t)
+			params := types.DefaultParams()
+			params.GovMaxSquareSize = 4
+			params.MinBlobBaseFee = math.LegacyOneDec()
+			params.TargetSquareUtilisation = math.LegacyMustNewDecFromStr("0.5")
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetBlobBaseFee(ctx, tc.blobBaseFee))
+
+			k.AddBlockBlobShares(ctx, tc.sharesUsed/2)
+			k.AddBlockBlobShares(ctx, tc.sharesUsed-tc.sharesUsed/2)
+			require.Equal(t, tc.sharesUsed, k.GetBlockBlobShares(ctx))
+
+			require.NoError(t, k.EndBlocker(ctx))
+			require.Equal(t, tc.expBlobBaseFee, k.GetBlobBaseFee(ctx))
+			require.Zero(t, k.GetBlockBlobShares(ctx))
+		})
+	}
+}
+
+func TestUpdateBlobBaseFeeDefaultParams(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	params := types.DefaultParams()
+	require.Equal(t, params.MinBlobBaseFee, k.GetBlobBaseFee(ctx))
+
+	// Full squares raise the blob base fee from its default floor
+	squareShares := params.GovMaxSquareSize * params.GovMaxSquareSize
+	for i := 0; i < 3; i++ {
+		k.AddBlockBlobShares(ctx, squareShares)
+		require.NoError(t, k.EndBlocker(ctx))
+	}
+	raised := k.GetBlobBaseFee(ctx)
+	require.True(t, raised.GT(params.MinBlobBaseFee), raised.String())
+
+	// Empty squares lower it back to the floor
+	for i := 0; i < 100; i++ {
+		require.NoError(t, k.EndBlocker(ctx))
+	}
+	require.Equal(t, params.MinBlobBaseFee, k.GetBlobBaseFee(ctx))
+}
+
+func TestBlobBaseFeeQuery(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+
+	// Defaults to the min blob base fee
+	params := types.DefaultParams()
+	params.MinBlobBaseFee = math.LegacyNewDecWithPrec(2, 3)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := k.BlobBaseFee(ctx, &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.MinBlobBaseFee.String(), res.BlobBaseFee)
+
+	require.NoError(t, k.SetBlobBaseFee(ctx, math.LegacyNewDecWithPrec(3, 3)))
+	res, err = k.BlobBaseFee(ctx, &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(3, 3).String(), res.BlobBaseFee)
+
+	require.Equal(t, math.NewInt(4), k.GetRequiredBlobBaseFee(ctx, []uint32{1000, 1}))
+
+	_, err = k.BlobBaseFee(ctx, nil)
+	require.Error(t, err)
+}
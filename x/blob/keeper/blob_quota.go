@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+// GetBlobQuotaWindow returns the index of the current blob quota window and the
+// first height of the next one.
+func (k Keeper) GetBlobQuotaWindow(ctx context.Context) (window uint64, endHeight int64) {
+	blockSize := k.GetParams(ctx).BlobQuotaWindow
+	if blockSize == 0 {
+		blockSize = 1
+	}
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	window = height / blockSize
+	return window, int64((window + 1) * blockSize)
+}
+
+// IsBlobQuotaExempt returns true if the address is exempt from the blob quotas.
+func (k Keeper) IsBlobQuotaExempt(ctx context.Context, address string) bool {
+	return slices.Contains(k.GetParams(ctx).BlobQuotaExemptAddresses, address)
+}
+
+// GetAccountBlobUsage returns the blob bytes paid for by the account in the
+// current quota window.
+func (k Keeper) GetAccountBlobUsage(ctx context.Context, address string) uint64 {
+	window, _ := k.GetBlobQuotaWindow(ctx)
+	return k.getBlobUsage(ctx, types.AccountBlobUsageKey(window, address))
+}
+
+// GetNamespaceBlobUsage returns the blob bytes paid for in the namespace in the
+// current quota window.
+func (k Keeper) GetNamespaceBlobUsage(ctx context.Context, namespace []byte) uint64 {
+	window, _ := k.GetBlobQuotaWindow(ctx)
+	return k.getBlobUsage(ctx, types.NamespaceBlobUsageKey(window, namespace))
+}
+
+// ConsumeBlobQuota records the blob bytes paid for by the MsgPayForBlobs
+// against the quotas of its signer and of its namespaces in the current window.
+// It returns an error without recording anything if a quota would be exceeded.
+// The blobs of the exempt addresses are not counted, and nothing is recorded
+// against a disabled quota.
+func (k Keeper) ConsumeBlobQuota(ctx context.Context, msg *types.MsgPayForBlobs) error {
+	params := k.GetParams(ctx)
+	if params.AccountBlobQuota == 0 && params.NamespaceBlobQuota == 0 {
+		return nil
+	}
+	if slices.Contains(params.BlobQuotaExemptAddresses, msg.Signer) {
+		return nil
+	}
+	window, _ := k.GetBlobQuotaWindow(ctx)
+
+	accountBytes := uint64(0)
+	namespaceBytes := make(map[string]uint64, len(msg.Namespaces))
+	namespaces := make([][]byte, 0, len(msg.Namespaces))
+	for i, namespace := range msg.Namespaces {
+		if i >= len(msg.BlobSizes) {
+			break
+		}
+		accountBytes += uint64(msg.BlobSizes[i])
+		if _, found := namespaceBytes[string(namespace)]; !found {
+			namespaces = append(namespaces, namespace)
+		}
+		namespaceBytes[string(namespace)] += uint64(msg.BlobSizes[i])
+	}
+
+	accountUsage := k.GetAccountBlobUsage(ctx, msg.Signer) + accountBytes
+	if params.AccountBlobQuota != 0 && accountUsage > params.AccountBlobQuota {
+		return errorsmod.Wrapf(
+			types.ErrAccountBlobQuotaExceeded,
+			"account %s would pay for %d blob bytes in the window, quota is %d", msg.Signer, accountUsage, params.AccountBlobQuota,
+		)
+	}
+	namespaceUsages := make([]uint64, len(namespaces))
+	for i, namespace := range namespaces {
+		namespaceUsages[i] = k.GetNamespaceBlobUsage(ctx, namespace) + namespaceBytes[string(namespace)]
+		if params.NamespaceBlobQuota != 0 && namespaceUsages[i] > params.NamespaceBlobQuota {
+			return errorsmod.Wrapf(
+				types.ErrNamespaceBlobQuotaExceeded,
+				"namespace %X would hold %d blob bytes in the window, quota is %d", namespace, namespaceUsages[i], params.NamespaceBlobQuota,
+			)
+		}
+	}
+
+	if params.AccountBlobQuota != 0 {
+		if err := k.setBlobUsage(ctx, types.AccountBlobUsageKey(window, msg.Signer), window, accountUsage); err != nil {
+			return err
+		}
+	}
+	if params.NamespaceBlobQuota != 0 {
+		for i, namespace := range namespaces {
+			if err := k.setBlobUsage(ctx, types.NamespaceBlobUsageKey(window, namespace), window, namespaceUsages[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PruneBlobUsage deletes the blob usage recorded in the quota windows other
+// than the current one.
+func (k Keeper) PruneBlobUsage(ctx context.Context) {
+	window, _ := k.GetBlobQuotaWindow(ctx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, keyPrefix := range [][]byte{types.AccountBlobUsageKeyPrefix, types.NamespaceBlobUsageKeyPrefix} {
+		store := prefix.NewStore(storeAdapter, keyPrefix)
+
+		// The window can go backwards if the blob quota window param is raised
+		var keys [][]byte
+		for _, iterator := range []storetypes.Iterator{
+			store.Iterator(nil, types.BlobUsageWindowPrefix(window)),
+			store.Iterator(storetypes.PrefixEndBytes(types.BlobUsageWindowPrefix(window)), nil),
+		} {
+			for ; iterator.Valid(); iterator.Next() {
+				keys = append(keys, iterator.Key())
+			}
+			iterator.Close()
+		}
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// getBlobUsage returns the blob bytes stored under the key.
+func (k Keeper) getBlobUsage(ctx context.Context, key []byte) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	var usage types.BlobUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage.Bytes
+}
+
+// setBlobUsage stores the blob bytes of the window under the key.
+func (k Keeper) setBlobUsage(ctx context.Context, key []byte, window, bytes uint64) error {
+	bz, err := k.cdc.Marshal(&types.BlobUsage{Window: window, Bytes: bytes})
+	if err != nil {
+		return err
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(key, bz)
+
+	return nil
+}
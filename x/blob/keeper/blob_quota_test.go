@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func TestConsumeBlobQuota(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	exempt := sample.AccAddress()
+	params := types.DefaultParams()
+	params.BlobQuotaWindow = 10
+	params.AccountBlobQuota = 100
+	params.NamespaceBlobQuota = 150
+	params.BlobQuotaExemptAddresses = []string{exempt}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+	pfb := func(signer string, namespaces [][]byte, blobSizes ...uint32) *types.MsgPayForBlobs {
+		return &types.MsgPayForBlobs{Signer: signer, Namespaces: namespaces, BlobSizes: blobSizes}
+	}
+
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns1, ns2}, 40, 20)))
+	require.Equal(t, uint64(60), k.GetAccountBlobUsage(ctx, alice))
+	require.Equal(t, uint64(40), k.GetNamespaceBlobUsage(ctx, ns1))
+	require.Equal(t, uint64(20), k.GetNamespaceBlobUsage(ctx, ns2))
+
+	// the account quota is exceeded and nothing is recorded
+	err := k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns1}, 41))
+	require.ErrorIs(t, err, types.ErrAccountBlobQuotaExceeded)
+	require.Equal(t, uint64(60), k.GetAccountBlobUsage(ctx, alice))
+	require.Equal(t, uint64(40), k.GetNamespaceBlobUsage(ctx, ns1))
+
+	// blobs in the same namespace are summed
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(bob, [][]byte{ns1, ns1}, 50, 50)))
+	require.Equal(t, uint64(140), k.GetNamespaceBlobUsage(ctx, ns1))
+
+	// the namespace quota is exceeded and nothing is recorded
+	err = k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns2, ns1}, 1, 11))
+	require.ErrorIs(t, err, types.ErrNamespaceBlobQuotaExceeded)
+	require.Equal(t, uint64(60), k.GetAccountBlobUsage(ctx, alice))
+	require.Equal(t, uint64(20), k.GetNamespaceBlobUsage(ctx, ns2))
+
+	// the blobs of exempt addresses are not counted
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(exempt, [][]byte{ns1}, 1000)))
+	require.Zero(t, k.GetAccountBlobUsage(ctx, exempt))
+	require.Equal(t, uint64(140), k.GetNamespaceBlobUsage(ctx, ns1))
+
+	// the usage is reset in the next window
+	ctx = ctx.WithBlockHeight(20)
+	require.Zero(t, k.GetAccountBlobUsage(ctx, alice))
+	require.Zero(t, k.GetNamespaceBlobUsage(ctx, ns1))
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns1}, 100)))
+
+	// a zero quota is unlimited and nothing is recorded against it
+	params.NamespaceBlobQuota = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	require.ErrorIs(t, k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns2}, 1)), types.ErrAccountBlobQuotaExceeded)
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(bob, [][]byte{ns2}, 100)))
+	require.Equal(t, uint64(100), k.GetAccountBlobUsage(ctx, bob))
+	require.Zero(t, k.GetNamespaceBlobUsage(ctx, ns2))
+
+	params.AccountBlobQuota = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.ConsumeBlobQuota(ctx, pfb(alice, [][]byte{ns1}, 1000)))
+	require.Equal(t, uint64(100), k.GetAccountBlobUsage(ctx, alice))
+}
+
+func TestConsumeBlobQuotaDefaultParams(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	alice := sample.AccAddress()
+	require.NoError(t, k.ConsumeBlobQuota(ctx, &types.MsgPayForBlobs{
+		Signer:     alice,
+		Namespaces: [][]byte{ns},
+		BlobSizes:  []uint32{1000},
+	}))
+
+	// both quotas are disabled by default so nothing is written
+	require.Zero(t, k.GetAccountBlobUsage(ctx, alice))
+	require.Zero(t, k.GetNamespaceBlobUsage(ctx, ns))
+}
+
+func TestPruneBlobUsage(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	ctx = ctx.WithBlockHeight(15)
+
+	params := types.DefaultParams()
+	params.BlobQuotaWindow = 10
+	params.AccountBlobQuota = 100
+	params.NamespaceBlobQuota = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	alice := sample.AccAddress()
+	consume := func(ctx sdk.Context) {
+		require.NoError(t, k.ConsumeBlobQuota(ctx, &types.MsgPayForBlobs{
+			Signer:     alice,
+			Namespaces: [][]byte{ns},
+			BlobSizes:  []uint32{30},
+		}))
+	}
+	consume(ctx)
+
+	// the usage of the current window is kept
+	k.PruneBlobUsage(ctx)
+	require.Equal(t, uint64(30), k.GetAccountBlobUsage(ctx, alice))
+	require.Equal(t, uint64(30), k.GetNamespaceBlobUsage(ctx, ns))
+
+	// the usage of the past windows is deleted
+	next := ctx.WithBlockHeight(20)
+	consume(next)
+	k.PruneBlobUsage(next)
+	require.Zero(t, k.GetAccountBlobUsage(ctx, alice))
+	require.Zero(t, k.GetNamespaceBlobUsage(ctx, ns))
+	require.Equal(t, uint64(30), k.GetAccountBlobUsage(next, alice))
+	require.Equal(t, uint64(30), k.GetNamespaceBlobUsage(next, ns))
+
+	// and so is the usage of the later windows if the window is raised
+	params.BlobQuotaWindow = 100
+	require.NoError(t, k.SetParams(next, params))
+	k.PruneBlobUsage(next)
+	params.BlobQuotaWindow = 10
+	require.NoError(t, k.SetParams(next, params))
+	require.Zero(t, k.GetAccountBlobUsage(next, alice))
+	require.Zero(t, k.GetNamespaceBlobUsage(next, ns))
+}
+
+func TestBlobQuotaQueries(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+	ctx = ctx.WithBlockHeight(15)
+
+	exempt := sample.AccAddress()
+	params := types.DefaultParams()
+	params.BlobQuotaWindow = 10
+	params.AccountBlobQuota = 100
+	params.NamespaceBlobQuota = 150
+	params.BlobQuotaExemptAddresses = []string{exempt}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	alice := sample.AccAddress()
+	require.NoError(t, k.ConsumeBlobQuota(ctx, &types.MsgPayForBlobs{
+		Signer:     alice,
+		Namespaces: [][]byte{ns},
+		BlobSizes:  []uint32{30},
+	}))
+
+	accountRes, err := k.AccountBlobQuota(ctx, &types.QueryAccountBlobQuotaRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAccountBlobQuotaResponse{
+		Quota:           100,
+		Used:            30,
+		Remaining:       70,
+		WindowEndHeight: 20,
+	}, accountRes)
+
+	accountRes, err = k.AccountBlobQuota(ctx, &types.QueryAccountBlobQuotaRequest{Address: exempt})
+	require.NoError(t, err)
+	require.True(t, accountRes.Exempt)
+	require.Zero(t, accountRes.Used)
+
+	namespaceRes, err := k.NamespaceBlobQuota(ctx, &types.QueryNamespaceBlobQuotaRequest{Namespace: ns})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryNamespaceBlobQuotaResponse{
+		Quota:           150,
+		Used:            30,
+		Remaining:       120,
+		WindowEndHeight: 20,
+	}, namespaceRes)
+
+	_, err = k.AccountBlobQuota(ctx, &types.QueryAccountBlobQuotaRequest{Address: "invalid"})
+	require.Error(t, err)
+	_, err = k.NamespaceBlobQuota(ctx, &types.QueryNamespaceBlobQuotaRequest{Namespace: []byte{1}})
+	require.Error(t, err)
+	_, err = k.AccountBlobQuota(ctx, nil)
+	require.Error(t, err)
+}
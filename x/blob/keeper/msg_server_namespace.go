@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRegisteredNamespace(ctx, msg.Namespace); found {
+		return nil, errorsmod.Wrapf(types.ErrNamespaceAlreadyRegistered, "namespace %X", msg.Namespace)
+	}
+
+	deposit := k.GetParams(ctx).NamespaceRegistrationDeposit
+	if deposit.IsPositive() {
+		owner := sdk.MustAccAddressFromBech32(msg.Owner)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetRegisteredNamespace(ctx, types.RegisteredNamespace{
+		Namespace:  msg.Namespace,
+		Owner:      msg.Owner,
+		Restricted: msg.Restricted,
+		Deposit:    deposit,
+	})
+
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+func (k msgServer) DeregisterNamespace(goCtx context.Context, msg *types.MsgDeregisterNamespace) (*types.MsgDeregisterNamespaceResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registered, err := k.getOwnedNamespace(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if registered.Deposit.IsPositive() {
+		owner := sdk.MustAccAddressFromBech32(msg.Owner)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(registered.Deposit)); err != nil {
+			return nil, err
+		}
+	}
+	k.RemoveRegisteredNamespace(ctx, msg.Namespace)
+
+	return &types.MsgDeregisterNamespaceResponse{}, nil
+}
+
+func (k msgServer) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registered, err := k.getOwnedNamespace(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// the deposit is transferred along with the namespace
+	registered.Owner = msg.NewOwner
+	k.SetRegisteredNamespace(ctx, registered)
+
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+func (k msgServer) AddNamespacePoster(goCtx context.Context, msg *types.MsgAddNamespacePoster) (*types.MsgAddNamespacePosterResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registered, err := k.getOwnedNamespace(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(registered.Posters, msg.Poster) {
+		return nil, errorsmod.Wrapf(types.ErrNamespacePosterAlreadyAdded, "poster %s", msg.Poster)
+	}
+
+	registered.Posters = append(registered.Posters, msg.Poster)
+	k.SetRegisteredNamespace(ctx, registered)
+
+	return &types.MsgAddNamespacePosterResponse{}, nil
+}
+
+func (k msgServer) RemoveNamespacePoster(goCtx context.Context, msg *types.MsgRemoveNamespacePoster) (*types.MsgRemoveNamespacePosterResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registered, err := k.getOwnedNamespace(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.Index(registered.Posters, msg.Poster)
+	if index < 0 {
+		return nil, errorsmod.Wrapf(types.ErrNamespacePosterNotFound, "poster %s", msg.Poster)
+	}
+
+	registered.Posters = slices.Delete(registered.Posters, index, index+1)
+	k.SetRegisteredNamespace(ctx, registered)
+
+	return &types.MsgRemoveNamespacePosterResponse{}, nil
+}
+
+func (k msgServer) SetNamespaceRestricted(goCtx context.Context, msg *types.MsgSetNamespaceRestricted) (*types.MsgSetNamespaceRestrictedResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registered, err := k.getOwnedNamespace(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	registered.Restricted = msg.Restricted
+	k.SetRegisteredNamespace(ctx, registered)
+
+	return &types.MsgSetNamespaceRestrictedResponse{}, nil
+}
+
+// getOwnedNamespace returns the registered namespace if it is owned by the
+// owner.
+func (k Keeper) getOwnedNamespace(ctx context.Context, owner string, namespace []byte) (types.RegisteredNamespace, error) {
+	registered, found := k.GetRegisteredNamespace(ctx, namespace)
+	if !found {
+		return registered, errorsmod.Wrapf(types.ErrNamespaceNotRegistered, "namespace %X", namespace)
+	}
+	if registered.Owner != owner {
+		return registered, errorsmod.Wrapf(types.ErrNotNamespaceOwner, "expected %s, got %s", registered.Owner, owner)
+	}
+	return registered, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	"github.com/sunriselayer/sunrise/x/blob/keeper"
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func TestMsgServerNamespaceRegistry(t *testing.T) {
+	k, mocks, ctx := keepertest.BlobKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	owner := sample.AccAddress()
+	newOwner := sample.AccAddress()
+	poster := sample.AccAddress()
+	other := sample.AccAddress()
+	deposit := sdk.NewCoins(types.DefaultNamespaceRegistrationDeposit)
+
+	// register
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(owner), types.ModuleName, deposit).Return(nil)
+	_, err := ms.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, ns, true))
+	require.NoError(t, err)
+	_, err = ms.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(other, ns, false))
+	require.ErrorIs(t, err, types.ErrNamespaceAlreadyRegistered)
+	_, err = ms.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, appns.TxNamespace.Bytes(), false))
+	require.ErrorIs(t, err, types.ErrReservedNamespace)
+
+	registered, found := k.GetRegisteredNamespace(ctx, ns)
+	require.True(t, found)
+	require.Equal(t, types.RegisteredNamespace{
+		Namespace:  ns,
+		Owner:      owner,
+		Restricted: true,
+		Deposit:    types.DefaultNamespaceRegistrationDeposit,
+	}, registered)
+	require.NoError(t, k.ValidateNamespacePoster(ctx, owner, ns))
+	require.ErrorIs(t, k.ValidateNamespacePoster(ctx, poster, ns), types.ErrUnauthorizedNamespacePoster)
+
+	// posters
+	_, err = ms.AddNamespacePoster(ctx, types.NewMsgAddNamespacePoster(other, ns, poster))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = ms.AddNamespacePoster(ctx, types.NewMsgAddNamespacePoster(owner, ns, poster))
+	require.NoError(t, err)
+	_, err = ms.AddNamespacePoster(ctx, types.NewMsgAddNamespacePoster(owner, ns, poster))
+	require.ErrorIs(t, err, types.ErrNamespacePosterAlreadyAdded)
+	require.NoError(t, k.ValidateNamespacePoster(ctx, poster, ns))
+
+	_, err = ms.RemoveNamespacePoster(ctx, types.NewMsgRemoveNamespacePoster(owner, ns, poster))
+	require.NoError(t, err)
+	_, err = ms.RemoveNamespacePoster(ctx, types.NewMsgRemoveNamespacePoster(owner, ns, poster))
+	require.ErrorIs(t, err, types.ErrNamespacePosterNotFound)
+	require.ErrorIs(t, k.ValidateNamespacePoster(ctx, poster, ns), types.ErrUnauthorizedNamespacePoster)
+
+	// unrestricted namespaces are open to anyone
+	_, err = ms.SetNamespaceRestricted(ctx, types.NewMsgSetNamespaceRestricted(owner, ns, false))
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateNamespacePoster(ctx, other, ns))
+	_, err = ms.SetNamespaceRestricted(ctx, types.NewMsgSetNamespaceRestricted(owner, ns, true))
+	require.NoError(t, err)
+
+	// transfer
+	_, err = ms.TransferNamespace(ctx, types.NewMsgTransferNamespace(other, ns, newOwner))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = ms.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, ns, newOwner))
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateNamespacePoster(ctx, newOwner, ns))
+	require.ErrorIs(t, k.ValidateNamespacePoster(ctx, owner, ns), types.ErrUnauthorizedNamespacePoster)
+
+	res, err := k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Empty(t, res.Namespaces)
+	res, err = k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: newOwner})
+	require.NoError(t, err)
+	require.Len(t, res.Namespaces, 1)
+	require.Equal(t, ns, res.Namespaces[0].Namespace)
+
+	// deregister returns the deposit to the current owner
+	_, err = ms.DeregisterNamespace(ctx, types.NewMsgDeregisterNamespace(owner, ns))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(newOwner), deposit).Return(nil)
+	_, err = ms.DeregisterNamespace(ctx, types.NewMsgDeregisterNamespace(newOwner, ns))
+	require.NoError(t, err)
+	_, found = k.GetRegisteredNamespace(ctx, ns)
+	require.False(t, found)
+	require.NoError(t, k.ValidateNamespacePoster(ctx, other, ns))
+	_, err = ms.DeregisterNamespace(ctx, types.NewMsgDeregisterNamespace(newOwner, ns))
+	require.ErrorIs(t, err, types.ErrNamespaceNotRegistered)
+}
+
+func TestNamespaceQueries(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+
+	owner := sample.AccAddress()
+	var namespaces []types.RegisteredNamespace
+	for i := byte(1); i <= 5; i++ {
+		namespace := types.RegisteredNamespace{
+			Namespace: appns.MustNewV0(bytes.Repeat([]byte{i}, appns.NamespaceVersionZeroIDSize)).Bytes(),
+			Owner:     owner,
+			Deposit:   types.DefaultNamespaceRegistrationDeposit,
+		}
+		k.SetRegisteredNamespace(ctx, namespace)
+		namespaces = append(namespaces, namespace)
+	}
+	k.SetRegisteredNamespace(ctx, types.RegisteredNamespace{
+		Namespace: appns.MustNewV0(bytes.Repeat([]byte{6}, appns.NamespaceVersionZeroIDSize)).Bytes(),
+		Owner:     sample.AccAddress(),
+		Deposit:   types.DefaultNamespaceRegistrationDeposit,
+	})
+
+	res, err := k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: namespaces[2].Namespace})
+	require.NoError(t, err)
+	require.Equal(t, namespaces[2], res.Namespace)
+	_, err = k.Namespace(ctx, &types.QueryNamespaceRequest{Namespace: []byte{1}})
+	require.Error(t, err)
+
+	var got []types.RegisteredNamespace
+	var nextKey []byte
+	for {
+		res, err := k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{
+			Owner:      owner,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Namespaces), 2)
+		got = append(got, res.Namespaces...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, namespaces, got)
+
+	_, err = k.NamespacesByOwner(ctx, &types.QueryNamespacesByOwnerRequest{Owner: "invalid"})
+	require.Error(t, err)
+	_, err = k.NamespacesByOwner(ctx, nil)
+	require.Error(t, err)
+}
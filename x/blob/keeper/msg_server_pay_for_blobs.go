@@ -10,8 +10,11 @@ import (
 func (k msgServer) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	gasToConsume := types.GasToConsume(msg.BlobSizes, k.GasPerBlobByte(ctx))
-	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
+	// The extra share of the signer of share version one blobs is charged as in the ante handler
+	ctx.GasMeter().ConsumeGas(msg.Gas(k.GasPerBlobByte(ctx)), payForBlobGasDescriptor)
+
+	// The shares used by the blobs move the blob base fee at the end of the block
+	k.AddBlockBlobShares(ctx, msg.SharesUsed())
 
 	err := ctx.EventManager().EmitTypedEvent(
 		types.NewPayForBlobsEvent(msg.Signer, msg.BlobSizes, msg.Namespaces),
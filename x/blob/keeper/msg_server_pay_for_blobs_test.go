@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,31 @@ func TestPayForBlobs(t *testing.T) {
 	assert.Equal(t, signer, event.Signer)
 	assert.Equal(t, namespaces, event.Namespaces)
 	assert.Equal(t, blobSizes, event.BlobSizes)
+
+	// verify that the shares of the blob are recorded for the blob base fee
+	assert.Equal(t, uint64(1), k.GetBlockBlobShares(ctx))
+}
+
+// TestPayForBlobsShareVersionOneGas verifies that the extra share taken by the
+// signer of a share version one blob is charged.
+func TestPayForBlobsShareVersionOneGas(t *testing.T) {
+	k, ctx := testkeeper.BlobKeeper(t)
+	ctx = ctx.WithKVGasConfig(storetypes.GasConfig{}).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	signer := "sunrise155u042u8wk3al32h3vzxu989jj76k4zcc6d03n"
+	namespace := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+
+	// The blob fits in one share without the signer but not with it
+	blobData := bytes.Repeat([]byte{1}, appconsts.FirstSparseShareContentSize-appconsts.SignerSize+1)
+	msg, err := types.NewMsgPayForBlobs(signer, blob.NewV1(namespace, blobData, sdk.MustAccAddressFromBech32(signer)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), msg.SharesUsed())
+
+	_, err = keeper.NewMsgServerImpl(k).PayForBlobs(ctx, msg)
+	require.NoError(t, err)
+
+	gasPerByte := k.GasPerBlobByte(ctx)
+	require.Equal(t, msg.Gas(gasPerByte), ctx.GasMeter().GasConsumed())
+	require.Greater(t, ctx.GasMeter().GasConsumed(), types.GasToConsume(msg.BlobSizes, gasPerByte))
 }
 
 func convertToEventPayForBlobs(message proto.Message) (*types.EventPayForBlobs, error) {
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+// SetRegisteredNamespace sets a registered namespace and indexes it by its
+// owner
+func (k Keeper) SetRegisteredNamespace(ctx context.Context, namespace types.RegisteredNamespace) {
+	if prev, found := k.GetRegisteredNamespace(ctx, namespace.Namespace); found && prev.Owner != namespace.Owner {
+		k.removeNamespaceByOwner(ctx, prev.Owner, prev.Namespace)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := k.cdc.MustMarshal(&namespace)
+	store.Set(types.RegisteredNamespaceKey(namespace.Namespace), bz)
+	store.Set(types.NamespaceByOwnerKey(namespace.Owner, namespace.Namespace), []byte{})
+}
+
+// GetRegisteredNamespace returns a registered namespace from its namespace
+func (k Keeper) GetRegisteredNamespace(ctx context.Context, namespace []byte) (val types.RegisteredNamespace, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.RegisteredNamespaceKey(namespace))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// RemoveRegisteredNamespace removes a registered namespace and its owner index
+func (k Keeper) RemoveRegisteredNamespace(ctx context.Context, namespace []byte) {
+	val, found := k.GetRegisteredNamespace(ctx, namespace)
+	if !found {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.RegisteredNamespaceKey(namespace))
+	k.removeNamespaceByOwner(ctx, val.Owner, namespace)
+}
+
+// GetAllRegisteredNamespaces returns all the registered namespaces
+func (k Keeper) GetAllRegisteredNamespaces(ctx context.Context) (list []types.RegisteredNamespace) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	namespaceStore := prefix.NewStore(store, types.RegisteredNamespaceKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(namespaceStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RegisteredNamespace
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ValidateNamespacePoster returns an error if the address is not authorised to
+// pay for blobs in the namespace. Unregistered namespaces are open to anyone.
+func (k Keeper) ValidateNamespacePoster(ctx context.Context, address string, namespace []byte) error {
+	registered, found := k.GetRegisteredNamespace(ctx, namespace)
+	if !found || registered.IsAuthorisedPoster(address) {
+		return nil
+	}
+	return types.ErrUnauthorizedNamespacePoster.Wrapf("%s cannot post to namespace %X", address, namespace)
+}
+
+func (k Keeper) removeNamespaceByOwner(ctx context.Context, owner string, namespace []byte) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.NamespaceByOwnerKey(owner, namespace))
+}
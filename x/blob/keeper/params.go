@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
 
 	"github.com/sunriselayer/sunrise/x/blob/types"
@@ -41,3 +42,13 @@ func (k Keeper) GasPerBlobByte(ctx context.Context) (res uint32) {
 func (k Keeper) GovMaxSquareSize(ctx context.Context) (res uint64) {
 	return k.GetParams(ctx).GovMaxSquareSize
 }
+
+// BlobLaneSquareShare returns the BlobLaneSquareShare param
+func (k Keeper) BlobLaneSquareShare(ctx context.Context) (res math.LegacyDec) {
+	return k.GetParams(ctx).BlobLaneSquareShare
+}
+
+// MinBlobBaseFee returns the MinBlobBaseFee param
+func (k Keeper) MinBlobBaseFee(ctx context.Context) (res math.LegacyDec) {
+	return k.GetParams(ctx).MinBlobBaseFee
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func (k Keeper) BlobBaseFee(goCtx context.Context, req *types.QueryBlobBaseFeeRequest) (*types.QueryBlobBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBlobBaseFeeResponse{BlobBaseFee: k.GetBlobBaseFee(ctx).String()}, nil
+}
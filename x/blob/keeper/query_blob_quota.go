@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func (k Keeper) AccountBlobQuota(goCtx context.Context, req *types.QueryAccountBlobQuotaRequest) (*types.QueryAccountBlobQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quota := k.GetParams(ctx).AccountBlobQuota
+	_, windowEndHeight := k.GetBlobQuotaWindow(ctx)
+	if k.IsBlobQuotaExempt(ctx, req.Address) {
+		return &types.QueryAccountBlobQuotaResponse{
+			Quota:           quota,
+			WindowEndHeight: windowEndHeight,
+			Exempt:          true,
+		}, nil
+	}
+	used := k.GetAccountBlobUsage(ctx, req.Address)
+
+	return &types.QueryAccountBlobQuotaResponse{
+		Quota:           quota,
+		Used:            used,
+		Remaining:       remainingBlobQuota(quota, used),
+		WindowEndHeight: windowEndHeight,
+	}, nil
+}
+
+func (k Keeper) NamespaceBlobQuota(goCtx context.Context, req *types.QueryNamespaceBlobQuotaRequest) (*types.QueryNamespaceBlobQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := appns.From(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	quota := k.GetParams(ctx).NamespaceBlobQuota
+	_, windowEndHeight := k.GetBlobQuotaWindow(ctx)
+	used := k.GetNamespaceBlobUsage(ctx, req.Namespace)
+
+	return &types.QueryNamespaceBlobQuotaResponse{
+		Quota:           quota,
+		Used:            used,
+		Remaining:       remainingBlobQuota(quota, used),
+		WindowEndHeight: windowEndHeight,
+	}, nil
+}
+
+// remainingBlobQuota returns the blob bytes left in the window, zero if the
+// quota is unlimited.
+func remainingBlobQuota(quota, used uint64) uint64 {
+	if quota <= used {
+		return 0
+	}
+	return quota - used
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunriselayer/sunrise/x/blob/types"
+)
+
+func (k Keeper) Namespace(ctx context.Context, req *types.QueryNamespaceRequest) (*types.QueryNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	namespace, found := k.GetRegisteredNamespace(ctx, req.Namespace)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryNamespaceResponse{Namespace: namespace}, nil
+}
+
+func (k Keeper) NamespacesByOwner(ctx context.Context, req *types.QueryNamespacesByOwnerRequest) (*types.QueryNamespacesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var namespaces []types.RegisteredNamespace
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	ownerStore := prefix.NewStore(store, types.NamespaceByOwnerKeyPrefixByOwner(req.Owner))
+
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, _ []byte) error {
+		namespace, found := k.GetRegisteredNamespace(ctx, key)
+		if !found {
+			return status.Errorf(codes.Internal, "namespace %X indexed but not registered", key)
+		}
+		namespaces = append(namespaces, namespace)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespacesByOwnerResponse{Namespaces: namespaces, Pagination: pageRes}, nil
+}
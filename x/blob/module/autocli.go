@@ -17,6 +17,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "BlobBaseFee",
+					Use:       "blob-base-fee",
+					Short:     "Shows the current blob base fee, the minimum price per blob byte",
+				},
+				{
+					RpcMethod:      "AccountBlobQuota",
+					Use:            "account-blob-quota [address]",
+					Short:          "Shows the blob quota of an account in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "NamespaceBlobQuota",
+					Use:            "namespace-blob-quota [namespace]",
+					Short:          "Shows the blob quota of a namespace in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "Namespace",
+					Use:            "namespace [namespace]",
+					Short:          "Shows a registered namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "NamespacesByOwner",
+					Use:            "namespaces-by-owner [owner]",
+					Short:          "List the namespaces registered by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -34,6 +63,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a pay-for-blobs tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "RegisterNamespace",
+					Use:            "register-namespace [namespace]",
+					Short:          "Register a namespace against the namespace registration deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "DeregisterNamespace",
+					Use:            "deregister-namespace [namespace]",
+					Short:          "Deregister a namespace and get its deposit back",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "TransferNamespace",
+					Use:            "transfer-namespace [namespace] [new-owner]",
+					Short:          "Transfer the ownership of a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "AddNamespacePoster",
+					Use:            "add-namespace-poster [namespace] [poster]",
+					Short:          "Delegate the posting rights of a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "poster"}},
+				},
+				{
+					RpcMethod:      "RemoveNamespacePoster",
+					Use:            "remove-namespace-poster [namespace] [poster]",
+					Short:          "Revoke the posting rights of a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "poster"}},
+				},
+				{
+					RpcMethod:      "SetNamespaceRestricted",
+					Use:            "set-namespace-restricted [namespace] [restricted]",
+					Short:          "Set whether only the owner and the posters can post to a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "restricted"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -11,12 +11,20 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	if err := k.SetBlobBaseFee(ctx, genState.BlobBaseFee); err != nil {
+		panic(err)
+	}
+	for _, namespace := range genState.Namespaces {
+		k.SetRegisteredNamespace(ctx, namespace)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.BlobBaseFee = k.GetBlobBaseFee(ctx)
+	genesis.Namespaces = k.GetAllRegisteredNamespaces(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -1,18 +1,32 @@
 package blob_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
+	"github.com/sunriselayer/sunrise/testutil/sample"
 	blob "github.com/sunriselayer/sunrise/x/blob/module"
 	"github.com/sunriselayer/sunrise/x/blob/types"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:      types.DefaultParams(),
+		BlobBaseFee: math.LegacyNewDecWithPrec(25, 4),
+		Namespaces: []types.RegisteredNamespace{
+			{
+				Namespace:  appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)).Bytes(),
+				Owner:      sample.AccAddress(),
+				Posters:    []string{sample.AccAddress()},
+				Restricted: true,
+				Deposit:    types.DefaultNamespaceRegistrationDeposit,
+			},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -25,5 +39,7 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.BlobBaseFee, got.BlobBaseFee)
+	require.ElementsMatch(t, genesisState.Namespaces, got.Namespaces)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
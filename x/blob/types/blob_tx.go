@@ -2,7 +2,9 @@ package types
 
 import (
 	"bytes"
+	"slices"
 
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
 	"github.com/sunriselayer/sunrise/pkg/blob"
 	"github.com/sunriselayer/sunrise/pkg/inclusion"
 	appns "github.com/sunriselayer/sunrise/pkg/namespace"
@@ -32,8 +34,8 @@ func NewBlob(ns appns.Namespace, data []byte, shareVersion uint8) (*blob.Blob, e
 }
 
 // ValidateBlobTx performs stateless checks on the BlobTx to ensure that the
-// blobs attached to the transaction are valid.
-func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
+// blobs attached to the transaction are valid for the app version.
+func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx, appVersion uint64) error {
 	sdkTx, err := txcfg.TxDecoder()(bTx.Tx)
 	if err != nil {
 		return err
@@ -64,6 +66,17 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
 	if err != nil {
 		return err
 	}
+	for _, pblob := range bTx.Blobs {
+		if !slices.Contains(appconsts.SupportedBlobShareVersions(appVersion), uint8(pblob.ShareVersion)) {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported in app version %d", pblob.ShareVersion, appVersion)
+		}
+	}
+
+	// blobs of share version one must be authored by the signer of the msgPFB
+	err = validateBlobSigners(msgPFB.Signer, bTx.Blobs)
+	if err != nil {
+		return err
+	}
 
 	// check that the sizes in the blobTx match the sizes in the msgPFB
 	if !equalSlices(sizes, msgPFB.BlobSizes) {
@@ -105,7 +118,7 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
 func BlobTxSharesUsed(btx blob.BlobTx) int {
 	sharesUsed := 0
 	for _, blob := range btx.Blobs {
-		sharesUsed += shares.SparseSharesNeeded(uint32(len(blob.Data)))
+		sharesUsed += shares.BlobSharesNeeded(uint32(len(blob.Data)), uint8(blob.ShareVersion))
 	}
 	return sharesUsed
 }
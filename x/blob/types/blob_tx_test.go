@@ -6,6 +6,7 @@ import (
 
 	"github.com/sunriselayer/sunrise/app/encoding"
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
+	v2 "github.com/sunriselayer/sunrise/pkg/appconsts/v2"
 	"github.com/sunriselayer/sunrise/pkg/blob"
 	"github.com/sunriselayer/sunrise/pkg/inclusion"
 	"github.com/sunriselayer/sunrise/pkg/namespace"
@@ -45,9 +46,23 @@ func TestValidateBlobTx(t *testing.T) {
 	type test struct {
 		name        string
 		getTx       func() blob.BlobTx
+		appVersion  uint64
 		expectedErr error
 	}
 
+	authoredBtx := func(blobSigner []byte) blob.BlobTx {
+		b := blob.NewV1(ns1, tmrand.Bytes(100), addr)
+		msg, err := types.NewMsgPayForBlobs(addr.String(), b)
+		require.NoError(t, err)
+		rawTx, err := signer.CreateTx([]sdk.Msg{msg})
+		require.NoError(t, err)
+		b.Signer = blobSigner
+		return blob.BlobTx{
+			Tx:    rawTx,
+			Blobs: []*blob.Blob{b},
+		}
+	}
+
 	validRawBtx := func() []byte {
 		btx := blobfactory.RandBlobTxsWithNamespacesAndSigner(
 			signer,
@@ -135,6 +150,35 @@ func TestValidateBlobTx(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidShareCommitment,
 		},
+		{
+			name: "authored blob",
+			getTx: func() blob.BlobTx {
+				return authoredBtx(addr)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "authored blob of another signer",
+			getTx: func() blob.BlobTx {
+				return authoredBtx(tmrand.Bytes(appconsts.SignerSize))
+			},
+			expectedErr: types.ErrBlobSignerMismatch,
+		},
+		{
+			name: "authored blob without signer",
+			getTx: func() blob.BlobTx {
+				return authoredBtx(nil)
+			},
+			expectedErr: types.ErrInvalidBlobSigner,
+		},
+		{
+			name: "authored blob before app version 3",
+			getTx: func() blob.BlobTx {
+				return authoredBtx(addr)
+			},
+			appVersion:  v2.Version,
+			expectedErr: types.ErrUnsupportedShareVersion,
+		},
 		{
 			name: "complex transaction with one send and one pfb",
 			getTx: func() blob.BlobTx {
@@ -237,9 +281,15 @@ func TestValidateBlobTx(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			encCfg := encoding.MakeConfig(util.ModuleBasics)
-			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx())
+			appVersion := tt.appVersion
+			if appVersion == 0 {
+				appVersion = appconsts.LatestVersion
+			}
+			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx(), appVersion)
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr, tt.name)
+			} else {
+				assert.NoError(t, err, tt.name)
 			}
 		})
 	}
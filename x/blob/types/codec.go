@@ -11,12 +11,24 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayForBlobs{}, "/sunrise.blob.v1.MsgPayForBlobs", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "/sunrise.blob.v1.MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, "/sunrise.blob.v1.MsgRegisterNamespace", nil)
+	cdc.RegisterConcrete(&MsgDeregisterNamespace{}, "/sunrise.blob.v1.MsgDeregisterNamespace", nil)
+	cdc.RegisterConcrete(&MsgTransferNamespace{}, "/sunrise.blob.v1.MsgTransferNamespace", nil)
+	cdc.RegisterConcrete(&MsgAddNamespacePoster{}, "/sunrise.blob.v1.MsgAddNamespacePoster", nil)
+	cdc.RegisterConcrete(&MsgRemoveNamespacePoster{}, "/sunrise.blob.v1.MsgRemoveNamespacePoster", nil)
+	cdc.RegisterConcrete(&MsgSetNamespaceRestricted{}, "/sunrise.blob.v1.MsgSetNamespaceRestricted", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
 		&MsgUpdateParams{},
+		&MsgRegisterNamespace{},
+		&MsgDeregisterNamespace{},
+		&MsgTransferNamespace{},
+		&MsgAddNamespacePoster{},
+		&MsgRemoveNamespacePoster{},
+		&MsgSetNamespaceRestricted{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -37,4 +37,15 @@ var (
 	ErrInvalidNamespace               = sdkerrors.Register(ModuleName, 11136, "invalid namespace")
 	ErrInvalidNamespaceVersion        = sdkerrors.Register(ModuleName, 11137, "invalid namespace version")
 	ErrTotalBlobSizeTooLarge          = sdkerrors.Register(ModuleName, 11138, "total blob size too large")
+	ErrInvalidBlobSigner              = sdkerrors.Register(ModuleName, 11139, "invalid blob signer")
+	ErrBlobSignerMismatch             = sdkerrors.Register(ModuleName, 11140, "signer of blob and its respective MsgPayForBlobs differ")
+	ErrInvalidBlobBaseFee             = sdkerrors.Register(ModuleName, 11141, "blob base fee must be non-negative")
+	ErrAccountBlobQuotaExceeded       = sdkerrors.Register(ModuleName, 11142, "account blob quota exceeded")
+	ErrNamespaceBlobQuotaExceeded     = sdkerrors.Register(ModuleName, 11143, "namespace blob quota exceeded")
+	ErrNamespaceAlreadyRegistered     = sdkerrors.Register(ModuleName, 11144, "namespace already registered")
+	ErrNamespaceNotRegistered         = sdkerrors.Register(ModuleName, 11145, "namespace not registered")
+	ErrNotNamespaceOwner              = sdkerrors.Register(ModuleName, 11146, "signer is not the owner of the namespace")
+	ErrUnauthorizedNamespacePoster    = sdkerrors.Register(ModuleName, 11147, "signer is not authorised to post to the namespace")
+	ErrNamespacePosterAlreadyAdded    = sdkerrors.Register(ModuleName, 11148, "namespace poster already added")
+	ErrNamespacePosterNotFound        = sdkerrors.Register(ModuleName, 11149, "namespace poster not found")
 )
@@ -1,5 +1,7 @@
 package types
 
+import errorsmod "cosmossdk.io/errors"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -9,7 +11,8 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:      DefaultParams(),
+		BlobBaseFee: DefaultParams().MinBlobBaseFee,
 	}
 }
 
@@ -18,5 +21,20 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if gs.BlobBaseFee.IsNil() || gs.BlobBaseFee.IsNegative() {
+		return ErrInvalidBlobBaseFee
+	}
+
+	namespaces := make(map[string]bool, len(gs.Namespaces))
+	for _, namespace := range gs.Namespaces {
+		if err := namespace.Validate(); err != nil {
+			return err
+		}
+		if namespaces[string(namespace.Namespace)] {
+			return errorsmod.Wrapf(ErrNamespaceAlreadyRegistered, "duplicate namespace %X", namespace.Namespace)
+		}
+		namespaces[string(namespace.Namespace)] = true
+	}
+
 	return gs.Params.Validate()
 }
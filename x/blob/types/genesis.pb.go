@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,7 +29,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the blob module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params      Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"blob_base_fee"`
+	Namespaces  []RegisteredNamespace       `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +74,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetNamespaces() []RegisteredNamespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.blob.v1.GenesisState")
 }
@@ -77,21 +88,29 @@ func init() {
 func init() { proto.RegisterFile("sunrise/blob/v1/genesis.proto", fileDescriptor_c5a2569993865025) }
 
 var fileDescriptor_c5a2569993865025 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x4a, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94,
-	0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd0, 0x0d, 0x2e, 0x48,
-	0x2c, 0x4a, 0xcc, 0x85, 0x9a, 0xab, 0xe4, 0xc5, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0xc8, 0x8a, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xae,
-	0x87, 0x66, 0xb1, 0x5e, 0x00, 0x58, 0xda, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37,
-	0x68, 0x31, 0x06, 0x41, 0x75, 0x38, 0xb9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xbc,
-	0x9c, 0xc4, 0xca, 0xd4, 0x22, 0x18, 0x47, 0xbf, 0x02, 0xe2, 0xba, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xd3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47, 0x65, 0xcb, 0x60, 0x13,
-	0x01, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0xb3, 0x5f, 0xa1, 0xd0, 0xf4, 0x13, 0x31, 0x08, 0xd6, 0xaa, 0x69, 0x11, 0x0f, 0x45,
+	0x74, 0x97, 0x56, 0xf0, 0xe0, 0x31, 0x94, 0x7a, 0x11, 0x95, 0x7a, 0xeb, 0xa5, 0x6c, 0xd2, 0x31,
+	0x0d, 0x36, 0xd9, 0x90, 0xd9, 0x16, 0xfb, 0x16, 0x3e, 0x86, 0x47, 0x0f, 0x3e, 0x44, 0x8f, 0xc5,
+	0x93, 0x78, 0x28, 0xd2, 0x1e, 0x7c, 0x04, 0xaf, 0x92, 0xec, 0x56, 0x4a, 0xbd, 0x2c, 0x33, 0xfb,
+	0xff, 0xcd, 0xcc, 0x7f, 0xc6, 0x3c, 0xc0, 0x61, 0x94, 0x04, 0x08, 0xcc, 0x1d, 0x08, 0x97, 0x8d,
+	0xea, 0xcc, 0x87, 0x08, 0x30, 0x40, 0x1a, 0x27, 0x42, 0x0a, 0x6b, 0x53, 0xcb, 0x34, 0x95, 0xe9,
+	0xa8, 0x5e, 0xde, 0xe2, 0x61, 0x10, 0x09, 0x96, 0xbd, 0x8a, 0x29, 0x6f, 0xfb, 0xc2, 0x17, 0x59,
+	0xc8, 0xd2, 0x48, 0xff, 0xee, 0x7a, 0x02, 0x43, 0x81, 0x5d, 0x25, 0xa8, 0x44, 0x4b, 0x95, 0xf5,
+	0x99, 0x11, 0x0f, 0x01, 0x63, 0xee, 0x81, 0x06, 0xf6, 0xd7, 0x81, 0x98, 0x27, 0x3c, 0xd4, 0xe5,
+	0x87, 0xdf, 0xc4, 0xfc, 0x7f, 0xa9, 0x5c, 0xde, 0x49, 0x2e, 0xc1, 0xba, 0x30, 0xf3, 0x0a, 0x28,
+	0x91, 0x2a, 0xa9, 0x15, 0x1b, 0x3b, 0x74, 0xcd, 0x35, 0xbd, 0xcd, 0x64, 0xa7, 0x30, 0x99, 0x55,
+	0x8c, 0xe7, 0xaf, 0x97, 0x63, 0xd2, 0xd6, 0x15, 0x56, 0xc7, 0xdc, 0x48, 0xa1, 0xae, 0xcb, 0x11,
+	0xba, 0xf7, 0x00, 0xa5, 0x7f, 0x55, 0x52, 0x2b, 0x38, 0xe7, 0x29, 0xf9, 0x31, 0xab, 0xec, 0x29,
+	0xe3, 0xd8, 0x7b, 0xa0, 0x81, 0x60, 0x21, 0x97, 0x7d, 0x7a, 0x05, 0x3e, 0xf7, 0xc6, 0x4d, 0xf0,
+	0xde, 0x5e, 0x4f, 0x4d, 0xbd, 0x57, 0x13, 0x3c, 0xd5, 0xb6, 0x98, 0x36, 0x73, 0x38, 0x42, 0x0b,
+	0xc0, 0xba, 0x31, 0xcd, 0xdf, 0xcd, 0xb0, 0x94, 0xab, 0xe6, 0x6a, 0xc5, 0xc6, 0xd1, 0x1f, 0x6f,
+	0x6d, 0xf0, 0x03, 0x94, 0x90, 0x40, 0xef, 0x7a, 0x09, 0xaf, 0x1a, 0x5d, 0x69, 0xe1, 0xb4, 0x26,
+	0x73, 0x9b, 0x4c, 0xe7, 0x36, 0xf9, 0x9c, 0xdb, 0xe4, 0x69, 0x61, 0x1b, 0xd3, 0x85, 0x6d, 0xbc,
+	0x2f, 0x6c, 0xa3, 0x73, 0xe2, 0x07, 0xb2, 0x3f, 0x74, 0xa9, 0x27, 0x42, 0xa6, 0x07, 0x0c, 0xf8,
+	0x18, 0x92, 0x65, 0xc2, 0x1e, 0xd5, 0x2d, 0xe5, 0x38, 0x06, 0x74, 0xf3, 0xd9, 0x21, 0xcf, 0x7e,
+	0x06, 0x00, 0x4f, 0x10, 0xc2, 0x11, 0xfd, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +133,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BlobBaseFee.Size()
+		i -= size
+		if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +189,14 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BlobBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +268,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, RegisteredNamespace{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
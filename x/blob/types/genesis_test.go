@@ -1,13 +1,23 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
 	"github.com/sunriselayer/sunrise/x/blob/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	owner := sdk.AccAddress("namespace_owner_____").String()
+	poster := sdk.AccAddress("namespace_poster____").String()
+	namespace := func(b byte) []byte {
+		return appns.MustNewV0(bytes.Repeat([]byte{b}, appns.NamespaceVersionZeroIDSize)).Bytes()
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -22,13 +32,117 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					GasPerBlobByte:   16,
-					GovMaxSquareSize: 128,
+					GasPerBlobByte:               16,
+					GovMaxSquareSize:             128,
+					BlobLaneSquareShare:          math.LegacyMustNewDecFromStr("0.5"),
+					MinBlobBaseFee:               math.LegacyNewDecWithPrec(1, 3),
+					BlobBaseFeeChangeDenominator: 8,
+					TargetSquareUtilisation:      math.LegacyMustNewDecFromStr("0.5"),
+					BlobQuotaWindow:              600,
+					AccountBlobQuota:             1_000_000,
+					NamespaceBlobQuota:           2_000_000,
+					NamespaceRegistrationDeposit: sdk.NewInt64Coin("stake", 1000),
+				},
+				BlobBaseFee: math.LegacyNewDecWithPrec(2, 3),
+				Namespaces: []types.RegisteredNamespace{
+					{
+						Namespace:  namespace(1),
+						Owner:      owner,
+						Posters:    []string{poster},
+						Restricted: true,
+						Deposit:    sdk.NewInt64Coin("stake", 1000),
+					},
+					{
+						Namespace: namespace(2),
+						Owner:     owner,
+						Deposit:   sdk.NewInt64Coin("stake", 0),
+					},
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "negative blob base fee",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				BlobBaseFee: math.LegacyNewDec(-1),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate registered namespace",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				BlobBaseFee: math.LegacyZeroDec(),
+				Namespaces: []types.RegisteredNamespace{
+					{Namespace: namespace(1), Owner: owner, Deposit: sdk.NewInt64Coin("stake", 0)},
+					{Namespace: namespace(1), Owner: poster, Deposit: sdk.NewInt64Coin("stake", 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "reserved registered namespace",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				BlobBaseFee: math.LegacyZeroDec(),
+				Namespaces: []types.RegisteredNamespace{
+					{Namespace: appns.TxNamespace.Bytes(), Owner: owner, Deposit: sdk.NewInt64Coin("stake", 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid blob quota exempt address",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					16,
+					128,
+					math.LegacyMustNewDecFromStr("0.5"),
+					math.LegacyNewDecWithPrec(1, 3),
+					8,
+					math.LegacyMustNewDecFromStr("0.5"),
+					600,
+					0,
+					0,
+					[]string{"invalid"},
+					types.DefaultNamespaceRegistrationDeposit,
+				),
+				BlobBaseFee: math.LegacyZeroDec(),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero target square utilisation",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					GasPerBlobByte:               16,
+					GovMaxSquareSize:             128,
+					BlobLaneSquareShare:          math.LegacyMustNewDecFromStr("0.5"),
+					MinBlobBaseFee:               math.LegacyNewDecWithPrec(1, 3),
+					BlobBaseFeeChangeDenominator: 8,
+					TargetSquareUtilisation:      math.LegacyZeroDec(),
+				},
+				BlobBaseFee: math.LegacyZeroDec(),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero min blob base fee",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					GasPerBlobByte:               16,
+					GovMaxSquareSize:             128,
+					BlobLaneSquareShare:          math.LegacyMustNewDecFromStr("0.5"),
+					MinBlobBaseFee:               math.LegacyZeroDec(),
+					BlobBaseFeeChangeDenominator: 8,
+					TargetSquareUtilisation:      math.LegacyMustNewDecFromStr("0.5"),
+				},
+				BlobBaseFee: math.LegacyZeroDec(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
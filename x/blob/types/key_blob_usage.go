@@ -0,0 +1,33 @@
+package types
+
+import "encoding/binary"
+
+// BlobUsageWindowPrefix returns the part of the BlobUsage store keys holding
+// the quota window, so that the entries of past windows can be pruned
+func BlobUsageWindowPrefix(window uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, window)
+}
+
+// AccountBlobUsageKey returns the store key to retrieve the BlobUsage of an
+// account in a quota window
+func AccountBlobUsageKey(window uint64, address string) []byte {
+	var key []byte
+
+	key = append(key, AccountBlobUsageKeyPrefix...)
+	key = append(key, BlobUsageWindowPrefix(window)...)
+	key = append(key, []byte(address)...)
+
+	return key
+}
+
+// NamespaceBlobUsageKey returns the store key to retrieve the BlobUsage of a
+// namespace in a quota window
+func NamespaceBlobUsageKey(window uint64, namespace []byte) []byte {
+	var key []byte
+
+	key = append(key, NamespaceBlobUsageKeyPrefix...)
+	key = append(key, BlobUsageWindowPrefix(window)...)
+	key = append(key, namespace...)
+
+	return key
+}
@@ -0,0 +1,32 @@
+package types
+
+// RegisteredNamespaceKey returns the store key to retrieve a
+// RegisteredNamespace from its namespace
+func RegisteredNamespaceKey(namespace []byte) []byte {
+	var key []byte
+
+	key = append(key, RegisteredNamespaceKeyPrefix...)
+	key = append(key, namespace...)
+
+	return key
+}
+
+// NamespaceByOwnerKeyPrefixByOwner returns the prefix to retrieve the
+// namespaces registered by an owner
+func NamespaceByOwnerKeyPrefixByOwner(owner string) []byte {
+	var key []byte
+
+	key = append(key, NamespaceByOwnerKeyPrefix...)
+	key = append(key, []byte(owner)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// NamespaceByOwnerKey returns the store key indexing a namespace by its owner
+func NamespaceByOwnerKey(owner string, namespace []byte) []byte {
+	key := NamespaceByOwnerKeyPrefixByOwner(owner)
+	key = append(key, namespace...)
+
+	return key
+}
@@ -13,6 +13,26 @@ const (
 
 var (
 	ParamsKey = []byte("p_blob")
+
+	BlobBaseFeeKey = []byte("BlobBaseFee/value/")
+
+	// BlockBlobSharesKey stores the number of shares used by the blobs paid
+	// for in the current block
+	BlockBlobSharesKey = []byte("BlockBlobShares/value/")
+
+	// AccountBlobUsageKeyPrefix stores the blob bytes paid for by each account
+	// by quota window
+	AccountBlobUsageKeyPrefix = []byte("AccountBlobUsage/value/")
+
+	// NamespaceBlobUsageKeyPrefix stores the blob bytes paid for in each
+	// namespace by quota window
+	NamespaceBlobUsageKeyPrefix = []byte("NamespaceBlobUsage/value/")
+
+	// RegisteredNamespaceKeyPrefix stores the registered namespaces
+	RegisteredNamespaceKeyPrefix = []byte("RegisteredNamespace/value/")
+
+	// NamespaceByOwnerKeyPrefix indexes the registered namespaces by owner
+	NamespaceByOwnerKeyPrefix = []byte("NamespaceByOwner/value/")
 )
 
 func KeyPrefix(p string) []byte {
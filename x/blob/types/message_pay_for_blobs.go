@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"slices"
 
@@ -44,6 +45,9 @@ func NewMsgPayForBlobs(signer string, blobs ...*blob.Blob) (*MsgPayForBlobs, err
 	if err != nil {
 		return nil, err
 	}
+	if err := validateBlobSigners(signer, blobs); err != nil {
+		return nil, err
+	}
 	commitments, err := inclusion.CreateCommitments(blobs)
 	if err != nil {
 		return nil, err
@@ -113,7 +117,7 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	}
 
 	for _, v := range msg.ShareVersions {
-		if v != uint32(appconsts.ShareVersionZero) {
+		if v > appconsts.MaxShareVersion || !slices.Contains(appconsts.SupportedShareVersions, uint8(v)) {
 			return ErrUnsupportedShareVersion
 		}
 	}
@@ -133,7 +137,23 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 }
 
 func (msg *MsgPayForBlobs) Gas(gasPerByte uint32) uint64 {
-	return GasToConsume(msg.BlobSizes, gasPerByte)
+	return msg.SharesUsed() * appconsts.ShareSize * uint64(gasPerByte)
+}
+
+// SharesUsed returns the number of shares used by the blobs of the PFB.
+func (msg *MsgPayForBlobs) SharesUsed() uint64 {
+	var totalSharesUsed uint64
+	if len(msg.ShareVersions) != len(msg.BlobSizes) {
+		for _, size := range msg.BlobSizes {
+			totalSharesUsed += uint64(appshares.SparseSharesNeeded(size))
+		}
+		return totalSharesUsed
+	}
+	// the first share of a blob of share version one also carries its signer
+	for i, size := range msg.BlobSizes {
+		totalSharesUsed += uint64(appshares.BlobSharesNeeded(size, uint8(msg.ShareVersions[i])))
+	}
+	return totalSharesUsed
 }
 
 // GasToConsume works out the extra gas charged to pay for a set of blobs in a PFB.
@@ -200,14 +220,44 @@ func ValidateBlobs(blobs ...*blob.Blob) error {
 			return ErrZeroBlobSize
 		}
 
-		if !slices.Contains(appconsts.SupportedShareVersions, uint8(blob.ShareVersion)) {
+		if blob.ShareVersion > appconsts.MaxShareVersion || !slices.Contains(appconsts.SupportedShareVersions, uint8(blob.ShareVersion)) {
 			return ErrUnsupportedShareVersion
 		}
+
+		if uint8(blob.ShareVersion) == appconsts.ShareVersionOne {
+			if len(blob.Signer) != appconsts.SignerSize {
+				return ErrInvalidBlobSigner.Wrapf("signer must be %d bytes for share version %d", appconsts.SignerSize, appconsts.ShareVersionOne)
+			}
+		} else if len(blob.Signer) != 0 {
+			return ErrInvalidBlobSigner.Wrapf("signer can only be set for share version %d", appconsts.ShareVersionOne)
+		}
 	}
 
 	return nil
 }
 
+// validateBlobSigners checks that the blobs of share version one are authored
+// by the signer of the MsgPayForBlobs.
+func validateBlobSigners(signer string, blobs []*blob.Blob) error {
+	var signerAddr sdk.AccAddress
+	for _, blob := range blobs {
+		if uint8(blob.ShareVersion) != appconsts.ShareVersionOne {
+			continue
+		}
+		if signerAddr == nil {
+			addr, err := sdk.AccAddressFromBech32(signer)
+			if err != nil {
+				return err
+			}
+			signerAddr = addr
+		}
+		if !bytes.Equal(blob.Signer, signerAddr) {
+			return ErrBlobSignerMismatch.Wrapf("blob signer %X, MsgPayForBlobs signer %s", blob.Signer, signer)
+		}
+	}
+	return nil
+}
+
 // ExtractBlobComponents separates and returns the components of a slice of
 // blobs.
 func ExtractBlobComponents(pblobs []*blob.Blob) (namespaceVersions []uint32, namespaceIds [][]byte, sizes []uint32, shareVersions []uint32) {
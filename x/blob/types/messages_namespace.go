@@ -0,0 +1,162 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	appns "github.com/sunriselayer/sunrise/pkg/namespace"
+)
+
+var _ sdk.Msg = &MsgRegisterNamespace{}
+
+func NewMsgRegisterNamespace(owner string, namespace []byte, restricted bool) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:      owner,
+		Namespace:  namespace,
+		Restricted: restricted,
+	}
+}
+
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	return validateNamespaceOwner(msg.Owner, msg.Namespace)
+}
+
+var _ sdk.Msg = &MsgDeregisterNamespace{}
+
+func NewMsgDeregisterNamespace(owner string, namespace []byte) *MsgDeregisterNamespace {
+	return &MsgDeregisterNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+	}
+}
+
+func (msg *MsgDeregisterNamespace) ValidateBasic() error {
+	return validateNamespaceOwner(msg.Owner, msg.Namespace)
+}
+
+var _ sdk.Msg = &MsgTransferNamespace{}
+
+func NewMsgTransferNamespace(owner string, namespace []byte, newOwner string) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+		NewOwner:  newOwner,
+	}
+}
+
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if err := validateNamespaceOwner(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAddNamespacePoster{}
+
+func NewMsgAddNamespacePoster(owner string, namespace []byte, poster string) *MsgAddNamespacePoster {
+	return &MsgAddNamespacePoster{
+		Owner:     owner,
+		Namespace: namespace,
+		Poster:    poster,
+	}
+}
+
+func (msg *MsgAddNamespacePoster) ValidateBasic() error {
+	if err := validateNamespaceOwner(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Poster); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid poster address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveNamespacePoster{}
+
+func NewMsgRemoveNamespacePoster(owner string, namespace []byte, poster string) *MsgRemoveNamespacePoster {
+	return &MsgRemoveNamespacePoster{
+		Owner:     owner,
+		Namespace: namespace,
+		Poster:    poster,
+	}
+}
+
+func (msg *MsgRemoveNamespacePoster) ValidateBasic() error {
+	if err := validateNamespaceOwner(msg.Owner, msg.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Poster); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid poster address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetNamespaceRestricted{}
+
+func NewMsgSetNamespaceRestricted(owner string, namespace []byte, restricted bool) *MsgSetNamespaceRestricted {
+	return &MsgSetNamespaceRestricted{
+		Owner:      owner,
+		Namespace:  namespace,
+		Restricted: restricted,
+	}
+}
+
+func (msg *MsgSetNamespaceRestricted) ValidateBasic() error {
+	return validateNamespaceOwner(msg.Owner, msg.Namespace)
+}
+
+// validateNamespaceOwner validates the owner address and the namespace of the
+// namespace registry messages.
+func validateNamespaceOwner(owner string, namespace []byte) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return ValidateRegisteredNamespace(namespace)
+}
+
+// ValidateRegisteredNamespace returns an error if the namespace cannot be
+// registered, i.e. if it is not a namespace blobs can be paid for in.
+func ValidateRegisteredNamespace(namespace []byte) error {
+	ns, err := appns.From(namespace)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return ValidateBlobNamespace(ns)
+}
+
+// Validate performs a basic validation of the registered namespace.
+func (n RegisteredNamespace) Validate() error {
+	if err := validateNamespaceOwner(n.Owner, n.Namespace); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(n.Posters))
+	for _, poster := range n.Posters {
+		if _, err := sdk.AccAddressFromBech32(poster); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid poster address (%s)", err)
+		}
+		if seen[poster] {
+			return errorsmod.Wrapf(ErrNamespacePosterAlreadyAdded, "duplicate poster %s", poster)
+		}
+		seen[poster] = true
+	}
+	return n.Deposit.Validate()
+}
+
+// IsAuthorisedPoster returns true if the address can pay for blobs in the
+// namespace, i.e. if the namespace is not restricted or the address is its
+// owner or one of its posters.
+func (n RegisteredNamespace) IsAuthorisedPoster(address string) bool {
+	if !n.Restricted || n.Owner == address {
+		return true
+	}
+	for _, poster := range n.Posters {
+		if poster == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/blob/v1/namespace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RegisteredNamespace is a namespace registered on chain by its owner.
+type RegisteredNamespace struct {
+	// namespace is the namespace version followed by the namespace id
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// owner is the address that can transfer the namespace and manage its
+	// posters
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// posters are the addresses the owner delegated the posting rights to
+	Posters []string `protobuf:"bytes,3,rep,name=posters,proto3" json:"posters,omitempty"`
+	// restricted is true if only the owner and the posters can pay for blobs in
+	// the namespace
+	Restricted bool `protobuf:"varint,4,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// deposit is the deposit escrowed at the registration, returned to the owner
+	// when the namespace is deregistered
+	Deposit types.Coin `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *RegisteredNamespace) Reset()         { *m = RegisteredNamespace{} }
+func (m *RegisteredNamespace) String() string { return proto.CompactTextString(m) }
+func (*RegisteredNamespace) ProtoMessage()    {}
+func (*RegisteredNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c766256d5537ba8, []int{0}
+}
+func (m *RegisteredNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredNamespace.Merge(m, src)
+}
+func (m *RegisteredNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredNamespace proto.InternalMessageInfo
+
+func (m *RegisteredNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *RegisteredNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RegisteredNamespace) GetPosters() []string {
+	if m != nil {
+		return m.Posters
+	}
+	return nil
+}
+
+func (m *RegisteredNamespace) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *RegisteredNamespace) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*RegisteredNamespace)(nil), "sunrise.blob.v1.RegisteredNamespace")
+}
+
+func init() { proto.RegisterFile("sunrise/blob/v1/namespace.proto", fileDescriptor_6c766256d5537ba8) }
+
+var fileDescriptor_6c766256d5537ba8 = []byte{
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0x8e, 0x6f, 0x6f, 0x6f, 0x6f, 0x7d, 0xaf, 0x84, 0x08, 0x1d, 0xd2, 0x0a, 0xb9, 0x11, 0x53,
+	0x84, 0xc0, 0x56, 0xca, 0x8e, 0x44, 0x91, 0x18, 0x19, 0xc2, 0xc6, 0x82, 0xf2, 0x73, 0x14, 0x2c,
+	0x35, 0x39, 0x91, 0xed, 0x16, 0xfa, 0x16, 0x3c, 0x06, 0x23, 0x03, 0x0f, 0xd1, 0xb1, 0x62, 0x62,
+	0x42, 0xa8, 0x1d, 0x78, 0x04, 0x56, 0x94, 0x26, 0x01, 0x26, 0x16, 0xcb, 0xdf, 0xcf, 0xf9, 0xd1,
+	0x77, 0xe8, 0x50, 0x4f, 0x73, 0x25, 0x35, 0x88, 0x68, 0x82, 0x91, 0x98, 0xf9, 0x22, 0x0f, 0x33,
+	0xd0, 0x45, 0x18, 0x03, 0x2f, 0x14, 0x1a, 0xb4, 0xb7, 0x6a, 0x03, 0x2f, 0x0d, 0x7c, 0xe6, 0x0f,
+	0xb6, 0xc3, 0x4c, 0xe6, 0x28, 0x36, 0x6f, 0xe5, 0x19, 0xb0, 0x18, 0x75, 0x86, 0x5a, 0x44, 0xa1,
+	0x06, 0x31, 0xf3, 0x23, 0x30, 0xa1, 0x2f, 0x62, 0x94, 0x79, 0xad, 0xf7, 0x2b, 0xfd, 0x6a, 0x83,
+	0x44, 0x05, 0x6a, 0xa9, 0x97, 0x62, 0x8a, 0x15, 0x5f, 0xfe, 0x2a, 0x76, 0xef, 0x9d, 0xd0, 0x9d,
+	0x00, 0x52, 0xa9, 0x0d, 0x28, 0x48, 0xce, 0x9b, 0x95, 0xec, 0x5d, 0xda, 0xfd, 0xdc, 0xcf, 0x21,
+	0x2e, 0xf1, 0xfe, 0x07, 0x5f, 0x84, 0xcd, 0x69, 0x1b, 0x6f, 0x72, 0x50, 0xce, 0x2f, 0x97, 0x78,
+	0xdd, 0xb1, 0xf3, 0xf4, 0x78, 0xd8, 0xab, 0x87, 0x9d, 0x24, 0x89, 0x02, 0xad, 0x2f, 0x8c, 0x92,
+	0x79, 0x1a, 0x54, 0x36, 0x7b, 0x44, 0x3b, 0x05, 0x96, 0x23, 0xb4, 0xd3, 0x72, 0x5b, 0x3f, 0x56,
+	0x34, 0x46, 0x9b, 0x51, 0xaa, 0x40, 0x1b, 0x25, 0x63, 0x03, 0x89, 0xf3, 0xdb, 0x25, 0xde, 0xdf,
+	0xe0, 0x1b, 0x63, 0x1f, 0xd3, 0x4e, 0x02, 0x05, 0x6a, 0x69, 0x9c, 0xb6, 0x4b, 0xbc, 0x7f, 0xa3,
+	0x3e, 0xaf, 0x1b, 0x96, 0xe1, 0xf0, 0x3a, 0x1c, 0x7e, 0x8a, 0x32, 0x1f, 0x77, 0x17, 0x2f, 0x43,
+	0xeb, 0xfe, 0xed, 0x61, 0x9f, 0x04, 0x4d, 0xd1, 0xf8, 0x6c, 0xb1, 0x62, 0x64, 0xb9, 0x62, 0xe4,
+	0x75, 0xc5, 0xc8, 0xdd, 0x9a, 0x59, 0xcb, 0x35, 0xb3, 0x9e, 0xd7, 0xcc, 0xba, 0x3c, 0x48, 0xa5,
+	0xb9, 0x9e, 0x46, 0x3c, 0xc6, 0x4c, 0xd4, 0x37, 0x99, 0x84, 0x73, 0x50, 0x0d, 0x10, 0xb7, 0xd5,
+	0x0d, 0xcd, 0xbc, 0x00, 0x1d, 0xfd, 0xd9, 0x04, 0x79, 0xf4, 0x31, 0x00, 0xdf, 0x71, 0xbb, 0x50,
+	0xe0, 0x01, 0x00, 0x00,
+}
+
+func (m *RegisteredNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Posters) > 0 {
+		for iNdEx := len(m.Posters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Posters[iNdEx])
+			copy(dAtA[i:], m.Posters[iNdEx])
+			i = encodeVarintNamespace(dAtA, i, uint64(len(m.Posters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisteredNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if len(m.Posters) > 0 {
+		for _, s := range m.Posters {
+			l = len(s)
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	if m.Restricted {
+		n += 2
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovNamespace(uint64(l))
+	return n
+}
+
+func sovNamespace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNamespace(x uint64) (n int) {
+	return sovNamespace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisteredNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posters = append(m.Posters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNamespace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNamespace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNamespace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNamespace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNamespace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNamespace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNamespace = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,16 +6,36 @@ import (
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
 	"github.com/sunriselayer/sunrise/pkg/shares"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyGasPerBlobByte              = []byte("GasPerBlobByte")
-	DefaultGasPerBlobByte   uint32 = appconsts.DefaultGasPerBlobByte
-	KeyGovMaxSquareSize            = []byte("GovMaxSquareSize")
-	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyGasPerBlobByte                          = []byte("GasPerBlobByte")
+	DefaultGasPerBlobByte               uint32 = appconsts.DefaultGasPerBlobByte
+	KeyGovMaxSquareSize                        = []byte("GovMaxSquareSize")
+	DefaultGovMaxSquareSize             uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyBlobLaneSquareShare                     = []byte("BlobLaneSquareShare")
+	DefaultBlobLaneSquareShare                 = math.LegacyMustNewDecFromStr("0.5")
+	KeyMinBlobBaseFee                          = []byte("MinBlobBaseFee")
+	DefaultMinBlobBaseFee                      = math.LegacyMustNewDecFromStr("0.016")
+	KeyBlobBaseFeeChangeDenominator            = []byte("BlobBaseFeeChangeDenominator")
+	DefaultBlobBaseFeeChangeDenominator uint64 = 8
+	KeyTargetSquareUtilisation                 = []byte("TargetSquareUtilisation")
+	DefaultTargetSquareUtilisation             = math.LegacyMustNewDecFromStr("0.5")
+	KeyBlobQuotaWindow                         = []byte("BlobQuotaWindow")
+	DefaultBlobQuotaWindow              uint64 = 600
+	KeyAccountBlobQuota                        = []byte("AccountBlobQuota")
+	DefaultAccountBlobQuota             uint64 = 0
+	KeyNamespaceBlobQuota                      = []byte("NamespaceBlobQuota")
+	DefaultNamespaceBlobQuota           uint64 = 0
+	KeyBlobQuotaExemptAddresses                = []byte("BlobQuotaExemptAddresses")
+	DefaultBlobQuotaExemptAddresses            = []string(nil)
+	KeyNamespaceRegistrationDeposit            = []byte("NamespaceRegistrationDeposit")
+	DefaultNamespaceRegistrationDeposit        = sdk.NewInt64Coin(appconsts.BondDenom, 1_000_000)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -24,16 +44,49 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
+func NewParams(
+	gasPerBlobByte uint32,
+	govMaxSquareSize uint64,
+	blobLaneSquareShare math.LegacyDec,
+	minBlobBaseFee math.LegacyDec,
+	blobBaseFeeChangeDenominator uint64,
+	targetSquareUtilisation math.LegacyDec,
+	blobQuotaWindow uint64,
+	accountBlobQuota uint64,
+	namespaceBlobQuota uint64,
+	blobQuotaExemptAddresses []string,
+	namespaceRegistrationDeposit sdk.Coin,
+) Params {
 	return Params{
-		GasPerBlobByte:   gasPerBlobByte,
-		GovMaxSquareSize: govMaxSquareSize,
+		GasPerBlobByte:               gasPerBlobByte,
+		GovMaxSquareSize:             govMaxSquareSize,
+		BlobLaneSquareShare:          blobLaneSquareShare,
+		MinBlobBaseFee:               minBlobBaseFee,
+		BlobBaseFeeChangeDenominator: blobBaseFeeChangeDenominator,
+		TargetSquareUtilisation:      targetSquareUtilisation,
+		BlobQuotaWindow:              blobQuotaWindow,
+		AccountBlobQuota:             accountBlobQuota,
+		NamespaceBlobQuota:           namespaceBlobQuota,
+		BlobQuotaExemptAddresses:     blobQuotaExemptAddresses,
+		NamespaceRegistrationDeposit: namespaceRegistrationDeposit,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
+	return NewParams(
+		DefaultGasPerBlobByte,
+		appconsts.DefaultGovMaxSquareSize,
+		DefaultBlobLaneSquareShare,
+		DefaultMinBlobBaseFee,
+		DefaultBlobBaseFeeChangeDenominator,
+		DefaultTargetSquareUtilisation,
+		DefaultBlobQuotaWindow,
+		DefaultAccountBlobQuota,
+		DefaultNamespaceBlobQuota,
+		DefaultBlobQuotaExemptAddresses,
+		DefaultNamespaceRegistrationDeposit,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -41,6 +94,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
 		paramtypes.NewParamSetPair(KeyGovMaxSquareSize, &p.GovMaxSquareSize, validateGovMaxSquareSize),
+		paramtypes.NewParamSetPair(KeyBlobLaneSquareShare, &p.BlobLaneSquareShare, validateBlobLaneSquareShare),
+		paramtypes.NewParamSetPair(KeyMinBlobBaseFee, &p.MinBlobBaseFee, validateMinBlobBaseFee),
+		paramtypes.NewParamSetPair(KeyBlobBaseFeeChangeDenominator, &p.BlobBaseFeeChangeDenominator, validateBlobBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyTargetSquareUtilisation, &p.TargetSquareUtilisation, validateTargetSquareUtilisation),
+		paramtypes.NewParamSetPair(KeyBlobQuotaWindow, &p.BlobQuotaWindow, validateBlobQuotaWindow),
+		paramtypes.NewParamSetPair(KeyAccountBlobQuota, &p.AccountBlobQuota, validateBlobQuota),
+		paramtypes.NewParamSetPair(KeyNamespaceBlobQuota, &p.NamespaceBlobQuota, validateBlobQuota),
+		paramtypes.NewParamSetPair(KeyBlobQuotaExemptAddresses, &p.BlobQuotaExemptAddresses, validateBlobQuotaExemptAddresses),
+		paramtypes.NewParamSetPair(KeyNamespaceRegistrationDeposit, &p.NamespaceRegistrationDeposit, validateNamespaceRegistrationDeposit),
 	}
 }
 
@@ -50,7 +112,43 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	err = validateBlobLaneSquareShare(p.BlobLaneSquareShare)
+	if err != nil {
+		return err
+	}
+	err = validateMinBlobBaseFee(p.MinBlobBaseFee)
+	if err != nil {
+		return err
+	}
+	err = validateBlobBaseFeeChangeDenominator(p.BlobBaseFeeChangeDenominator)
+	if err != nil {
+		return err
+	}
+	err = validateTargetSquareUtilisation(p.TargetSquareUtilisation)
+	if err != nil {
+		return err
+	}
+	err = validateBlobQuotaWindow(p.BlobQuotaWindow)
+	if err != nil {
+		return err
+	}
+	err = validateBlobQuota(p.AccountBlobQuota)
+	if err != nil {
+		return err
+	}
+	err = validateBlobQuota(p.NamespaceBlobQuota)
+	if err != nil {
+		return err
+	}
+	err = validateBlobQuotaExemptAddresses(p.BlobQuotaExemptAddresses)
+	if err != nil {
+		return err
+	}
+	return validateNamespaceRegistrationDeposit(p.NamespaceRegistrationDeposit)
 }
 
 // validateGasPerBlobByte validates the GasPerBlobByte param
@@ -87,3 +185,121 @@ func validateGovMaxSquareSize(v interface{}) error {
 
 	return nil
 }
+
+// validateBlobLaneSquareShare validates the BlobLaneSquareShare param
+func validateBlobLaneSquareShare(v interface{}) error {
+	blobLaneSquareShare, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if blobLaneSquareShare.IsNil() || blobLaneSquareShare.IsNegative() || blobLaneSquareShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("blob lane square share must be between 0 and 1: %s", blobLaneSquareShare)
+	}
+
+	return nil
+}
+
+// validateMinBlobBaseFee validates the MinBlobBaseFee param
+func validateMinBlobBaseFee(v interface{}) error {
+	minBlobBaseFee, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// The blob base fee is updated proportionally to itself, so that it would
+	// never leave zero
+	if minBlobBaseFee.IsNil() || !minBlobBaseFee.IsPositive() {
+		return fmt.Errorf("min blob base fee must be positive: %s", minBlobBaseFee)
+	}
+
+	return nil
+}
+
+// validateBlobBaseFeeChangeDenominator validates the BlobBaseFeeChangeDenominator param
+func validateBlobBaseFeeChangeDenominator(v interface{}) error {
+	blobBaseFeeChangeDenominator, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if blobBaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("blob base fee change denominator cannot be zero")
+	}
+
+	return nil
+}
+
+// validateTargetSquareUtilisation validates the TargetSquareUtilisation param
+func validateTargetSquareUtilisation(v interface{}) error {
+	targetSquareUtilisation, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if targetSquareUtilisation.IsNil() || !targetSquareUtilisation.IsPositive() || targetSquareUtilisation.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target square utilisation must be greater than 0 and at most 1: %s", targetSquareUtilisation)
+	}
+
+	return nil
+}
+
+// validateBlobQuotaWindow validates the BlobQuotaWindow param
+func validateBlobQuotaWindow(v interface{}) error {
+	blobQuotaWindow, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if blobQuotaWindow == 0 {
+		return fmt.Errorf("blob quota window cannot be zero")
+	}
+
+	return nil
+}
+
+// validateBlobQuota validates the AccountBlobQuota and NamespaceBlobQuota
+// params, zero disables the quota
+func validateBlobQuota(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateBlobQuotaExemptAddresses validates the BlobQuotaExemptAddresses param
+func validateBlobQuotaExemptAddresses(v interface{}) error {
+	addresses, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid blob quota exempt address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate blob quota exempt address: %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// validateNamespaceRegistrationDeposit validates the NamespaceRegistrationDeposit param
+func validateNamespaceRegistrationDeposit(v interface{}) error {
+	deposit, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid namespace registration deposit: %w", err)
+	}
+
+	return nil
+}
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +31,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// Ratio of the shares of the square reserved for the transactions of the
+	// blob lane
+	BlobLaneSquareShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=blob_lane_square_share,json=blobLaneSquareShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"blob_lane_square_share" yaml:"blob_lane_square_share"`
+	// Lower bound of the blob base fee, the minimum price per blob byte in the
+	// fee denom
+	MinBlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_blob_base_fee,json=minBlobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_blob_base_fee" yaml:"min_blob_base_fee"`
+	// The blob base fee changes by at most 1 / blob_base_fee_change_denominator
+	// per block
+	BlobBaseFeeChangeDenominator uint64 `protobuf:"varint,5,opt,name=blob_base_fee_change_denominator,json=blobBaseFeeChangeDenominator,proto3" json:"blob_base_fee_change_denominator,omitempty" yaml:"blob_base_fee_change_denominator"`
+	// Ratio of the shares of a square of the max square size used by blobs at
+	// which the blob base fee stays unchanged
+	TargetSquareUtilisation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=target_square_utilisation,json=targetSquareUtilisation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_square_utilisation" yaml:"target_square_utilisation"`
+	// Number of blocks of the windows over which the blob quotas apply
+	BlobQuotaWindow uint64 `protobuf:"varint,7,opt,name=blob_quota_window,json=blobQuotaWindow,proto3" json:"blob_quota_window,omitempty" yaml:"blob_quota_window"`
+	// Number of blob bytes an account can pay for per window. Zero disables the
+	// quota.
+	AccountBlobQuota uint64 `protobuf:"varint,8,opt,name=account_blob_quota,json=accountBlobQuota,proto3" json:"account_blob_quota,omitempty" yaml:"account_blob_quota"`
+	// Number of blob bytes that can be paid for in a namespace per window. Zero
+	// disables the quota.
+	NamespaceBlobQuota uint64 `protobuf:"varint,9,opt,name=namespace_blob_quota,json=namespaceBlobQuota,proto3" json:"namespace_blob_quota,omitempty" yaml:"namespace_blob_quota"`
+	// Addresses of the rollup sequencers exempt from the blob quotas. Their
+	// blobs are not counted in the namespace quotas either.
+	BlobQuotaExemptAddresses []string `protobuf:"bytes,10,rep,name=blob_quota_exempt_addresses,json=blobQuotaExemptAddresses,proto3" json:"blob_quota_exempt_addresses,omitempty" yaml:"blob_quota_exempt_addresses"`
+	// Deposit escrowed to register a namespace
+	NamespaceRegistrationDeposit types.Coin `protobuf:"bytes,11,opt,name=namespace_registration_deposit,json=namespaceRegistrationDeposit,proto3" json:"namespace_registration_deposit" yaml:"namespace_registration_deposit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +105,48 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetBlobBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BlobBaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetBlobQuotaWindow() uint64 {
+	if m != nil {
+		return m.BlobQuotaWindow
+	}
+	return 0
+}
+
+func (m *Params) GetAccountBlobQuota() uint64 {
+	if m != nil {
+		return m.AccountBlobQuota
+	}
+	return 0
+}
+
+func (m *Params) GetNamespaceBlobQuota() uint64 {
+	if m != nil {
+		return m.NamespaceBlobQuota
+	}
+	return 0
+}
+
+func (m *Params) GetBlobQuotaExemptAddresses() []string {
+	if m != nil {
+		return m.BlobQuotaExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetNamespaceRegistrationDeposit() types.Coin {
+	if m != nil {
+		return m.NamespaceRegistrationDeposit
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sunrise.blob.v1.Params")
 }
@@ -84,26 +154,54 @@ func init() {
 func init() { proto.RegisterFile("sunrise/blob/v1/params.proto", fileDescriptor_57e292251fb36f89) }
 
 var fileDescriptor_57e292251fb36f89 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xca, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48,
-	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x0e, 0x33, 0x72, 0xb1, 0x05,
-	0x80, 0x8d, 0x12, 0x72, 0xe7, 0x12, 0x4c, 0x4f, 0x2c, 0x8e, 0x2f, 0x48, 0x2d, 0x8a, 0x07, 0x19,
-	0x13, 0x9f, 0x54, 0x59, 0x92, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xeb, 0x24, 0xf3, 0xe9, 0x9e,
-	0xbc, 0x44, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x86, 0x12, 0xa5, 0x20, 0xbe, 0xf4, 0xc4, 0xe2,
-	0x80, 0xd4, 0x22, 0xa7, 0x9c, 0xfc, 0x24, 0xa7, 0xca, 0x92, 0x54, 0x21, 0x5f, 0x2e, 0xe1, 0xf4,
-	0xfc, 0xb2, 0xf8, 0xdc, 0xc4, 0x8a, 0xf8, 0xe2, 0xc2, 0xd2, 0xc4, 0xa2, 0xd4, 0xf8, 0xe2, 0xcc,
-	0xaa, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x16, 0x27, 0xb9, 0x4f, 0xf7, 0xe4, 0xa5, 0xa0, 0x46,
-	0x61, 0x2a, 0x52, 0x0a, 0x12, 0x48, 0xcf, 0x2f, 0xf3, 0x4d, 0xac, 0x08, 0x06, 0x8b, 0x05, 0x67,
-	0x56, 0xa5, 0x5a, 0x29, 0xbe, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x04, 0x2c, 0x0c,
-	0x2a, 0xe0, 0xa1, 0x00, 0x71, 0xba, 0x93, 0xdb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xb5,
-	0xe7, 0x24, 0x56, 0xa6, 0x16, 0xe9, 0xa3, 0x99, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x0e, 0x14, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26, 0x75, 0xd6, 0x35, 0x6e, 0x01, 0x00,
-	0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0xf9, 0x3e, 0x0a, 0x71, 0x45, 0xdb, 0xb8, 0x15, 0x38, 0x6d, 0x6a, 0x07, 0x4b, 0x88,
+	0xa8, 0x80, 0xad, 0x94, 0x5d, 0x17, 0x48, 0x75, 0x43, 0x41, 0xa2, 0x95, 0x5a, 0x57, 0xa8, 0x52,
+	0x37, 0xd6, 0xd8, 0xb9, 0x38, 0x23, 0xe2, 0x99, 0xd4, 0x33, 0x49, 0x93, 0x4a, 0x7d, 0x01, 0xc4,
+	0x02, 0x09, 0x89, 0x35, 0x4b, 0x96, 0x5d, 0xf0, 0x10, 0x5d, 0x56, 0xac, 0x10, 0x0b, 0x0b, 0xb5,
+	0x8b, 0xb2, 0xf6, 0x13, 0x20, 0xcf, 0x38, 0x3f, 0x34, 0xe1, 0x53, 0x37, 0x91, 0x73, 0xef, 0xb9,
+	0x67, 0xce, 0x39, 0x33, 0xba, 0x6a, 0x8d, 0xf5, 0x49, 0x82, 0x19, 0x38, 0x41, 0x97, 0x06, 0xce,
+	0xa0, 0xe9, 0xf4, 0x50, 0x82, 0x62, 0x66, 0xf7, 0x12, 0xca, 0xa9, 0xb6, 0x5a, 0x74, 0xed, 0xbc,
+	0x6b, 0x0f, 0x9a, 0x9b, 0x15, 0x14, 0x63, 0x42, 0x1d, 0xf1, 0x2b, 0x31, 0x9b, 0x46, 0x48, 0x59,
+	0x4c, 0x99, 0x13, 0x20, 0x06, 0xce, 0xa0, 0x19, 0x00, 0x47, 0x4d, 0x27, 0xa4, 0x98, 0x14, 0xfd,
+	0x8d, 0x88, 0x46, 0x54, 0x7c, 0x3a, 0xf9, 0x57, 0x51, 0xad, 0xca, 0x29, 0x5f, 0x36, 0xe4, 0x1f,
+	0xd9, 0xb2, 0xd2, 0xb2, 0xba, 0x74, 0x22, 0x54, 0x68, 0x5f, 0xa9, 0x95, 0x08, 0x31, 0xbf, 0x07,
+	0x89, 0x9f, 0x2b, 0xf0, 0x83, 0x11, 0x07, 0x5d, 0xa9, 0x2b, 0x8d, 0xf7, 0xdc, 0x5a, 0x96, 0x9a,
+	0xfa, 0x08, 0xc5, 0xdd, 0x3d, 0x6b, 0x0e, 0x62, 0x79, 0x2b, 0x11, 0x62, 0x27, 0x90, 0xb8, 0x5d,
+	0x1a, 0xb8, 0x23, 0x0e, 0xda, 0xb1, 0xba, 0x1e, 0xd1, 0x81, 0x1f, 0xa3, 0xa1, 0xcf, 0x2e, 0xfb,
+	0x28, 0x01, 0x9f, 0xe1, 0x6b, 0xd0, 0xdf, 0xaa, 0x2b, 0x8d, 0xd7, 0xae, 0x91, 0xa5, 0xe6, 0x66,
+	0x41, 0x35, 0x0f, 0xb2, 0xbc, 0xb5, 0x88, 0x0e, 0x8e, 0xd1, 0xf0, 0x4c, 0xd4, 0xce, 0xf0, 0x35,
+	0x68, 0x3f, 0x2a, 0xea, 0xfb, 0xe2, 0xb4, 0x2e, 0x22, 0x30, 0x01, 0x77, 0x50, 0x02, 0xfa, 0xab,
+	0xba, 0xd2, 0x28, 0xbb, 0xe7, 0x77, 0xa9, 0x59, 0xfa, 0x2b, 0x35, 0xb7, 0xa4, 0x33, 0xd6, 0xfe,
+	0xde, 0xc6, 0xd4, 0x89, 0x11, 0xef, 0xd8, 0x47, 0x10, 0xa1, 0x70, 0xd4, 0x82, 0x30, 0x4b, 0xcd,
+	0x6d, 0x79, 0xea, 0x62, 0x2a, 0xeb, 0x8f, 0xdf, 0x3f, 0x53, 0x8b, 0x64, 0x5a, 0x10, 0xfe, 0xf6,
+	0x74, 0xbb, 0xa3, 0x78, 0xeb, 0x39, 0xf6, 0x08, 0x11, 0x28, 0xd4, 0xe4, 0x40, 0xed, 0x46, 0xad,
+	0xc4, 0x98, 0x14, 0xfe, 0x11, 0x03, 0xff, 0x3b, 0x00, 0xfd, 0xb5, 0x10, 0xe2, 0xbd, 0x4c, 0x48,
+	0x91, 0xe4, 0x1c, 0xcb, 0x42, 0x0d, 0x2b, 0x31, 0x26, 0x22, 0x59, 0xc4, 0xe0, 0x10, 0x40, 0x63,
+	0x6a, 0xfd, 0x3f, 0x43, 0x7e, 0xd8, 0x41, 0x24, 0x02, 0xbf, 0x0d, 0x84, 0xc6, 0x98, 0x20, 0x4e,
+	0x13, 0xfd, 0x6d, 0x91, 0xf4, 0x27, 0x59, 0x6a, 0x7e, 0x3c, 0xe3, 0xf9, 0x0d, 0x13, 0x96, 0x57,
+	0x0b, 0xa6, 0x67, 0x1c, 0x88, 0x7e, 0x6b, 0xda, 0xd6, 0x7e, 0x51, 0xd4, 0x2a, 0x47, 0x49, 0x04,
+	0x7c, 0x1c, 0x5a, 0x9f, 0xe3, 0x2e, 0x66, 0x88, 0x63, 0x4a, 0xf4, 0x25, 0x61, 0xfe, 0xe2, 0x65,
+	0xe6, 0xeb, 0x52, 0xd1, 0xff, 0xb2, 0x2d, 0x0c, 0xe1, 0x03, 0x09, 0x97, 0xd7, 0xf0, 0xed, 0x14,
+	0xac, 0x7d, 0xad, 0x56, 0x84, 0xb7, 0xcb, 0x3e, 0xe5, 0xc8, 0xbf, 0xc2, 0xa4, 0x4d, 0xaf, 0xf4,
+	0x77, 0x84, 0xfd, 0x99, 0x37, 0x3b, 0x07, 0xb1, 0xbc, 0xd5, 0xbc, 0x76, 0x9a, 0x97, 0xce, 0x45,
+	0x45, 0xfb, 0x46, 0xd5, 0x50, 0x18, 0xd2, 0x3e, 0xe1, 0xfe, 0x14, 0xae, 0xbf, 0x2b, 0xa8, 0xb6,
+	0xb3, 0xd4, 0xac, 0x4a, 0xaa, 0x79, 0x8c, 0xe5, 0xad, 0x15, 0x45, 0x77, 0x4c, 0xa9, 0x9d, 0xaa,
+	0x1b, 0x04, 0xc5, 0xc0, 0x7a, 0x28, 0x84, 0x59, 0xba, 0xb2, 0xa0, 0x33, 0xb3, 0xd4, 0xdc, 0x92,
+	0x74, 0x8b, 0x50, 0x96, 0xa7, 0x4d, 0xca, 0x53, 0xca, 0x1b, 0x75, 0x6b, 0xc6, 0x06, 0x0c, 0x21,
+	0xee, 0x71, 0x1f, 0xb5, 0xdb, 0x09, 0x30, 0x06, 0x4c, 0x57, 0xeb, 0xaf, 0x1a, 0x65, 0xf7, 0x8b,
+	0x2c, 0x35, 0xad, 0x39, 0xcf, 0xcf, 0xc1, 0x79, 0xc4, 0x1b, 0x45, 0xc4, 0xfb, 0xb2, 0x78, 0xc6,
+	0x13, 0x4c, 0x22, 0x4f, 0x9f, 0xa4, 0xf2, 0xa5, 0x98, 0xd9, 0x1f, 0x8f, 0x68, 0x3f, 0x2b, 0xaa,
+	0x31, 0x15, 0x9b, 0x40, 0x84, 0x19, 0x4f, 0xc4, 0x1d, 0xf8, 0x6d, 0xe8, 0x51, 0x86, 0xb9, 0xbe,
+	0x5c, 0x57, 0x1a, 0xcb, 0xbb, 0x55, 0xbb, 0x60, 0xce, 0x9f, 0x9b, 0x5d, 0xac, 0x28, 0xfb, 0x80,
+	0x62, 0xe2, 0xee, 0xe6, 0x2f, 0x24, 0x4b, 0xcd, 0x8f, 0x9e, 0x7b, 0x5f, 0x44, 0x67, 0xc9, 0x9b,
+	0xaf, 0x4d, 0x50, 0xde, 0x0c, 0xa8, 0x25, 0x31, 0x7b, 0x1f, 0xfe, 0xf3, 0xab, 0xa9, 0xfc, 0xf0,
+	0x74, 0xbb, 0xa3, 0x8f, 0x37, 0xeb, 0x70, 0xb2, 0x5b, 0xe5, 0x56, 0x73, 0x0f, 0xef, 0x1e, 0x0c,
+	0xe5, 0xfe, 0xc1, 0x50, 0xfe, 0x7e, 0x30, 0x94, 0x9f, 0x1e, 0x8d, 0xd2, 0xfd, 0xa3, 0x51, 0xfa,
+	0xf3, 0xd1, 0x28, 0x5d, 0x7c, 0x1a, 0x61, 0xde, 0xe9, 0x07, 0x76, 0x48, 0x63, 0xa7, 0x18, 0xef,
+	0xa2, 0x11, 0x24, 0xce, 0x33, 0x2e, 0x3e, 0xea, 0x01, 0x0b, 0x96, 0xc4, 0xbe, 0xfc, 0xfc, 0xdf,
+	0x01, 0x00, 0xf7, 0x28, 0xc4, 0x95, 0xc4, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -131,6 +229,38 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.GovMaxSquareSize != that1.GovMaxSquareSize {
 		return false
 	}
+	if !this.BlobLaneSquareShare.Equal(that1.BlobLaneSquareShare) {
+		return false
+	}
+	if !this.MinBlobBaseFee.Equal(that1.MinBlobBaseFee) {
+		return false
+	}
+	if this.BlobBaseFeeChangeDenominator != that1.BlobBaseFeeChangeDenominator {
+		return false
+	}
+	if !this.TargetSquareUtilisation.Equal(that1.TargetSquareUtilisation) {
+		return false
+	}
+	if this.BlobQuotaWindow != that1.BlobQuotaWindow {
+		return false
+	}
+	if this.AccountBlobQuota != that1.AccountBlobQuota {
+		return false
+	}
+	if this.NamespaceBlobQuota != that1.NamespaceBlobQuota {
+		return false
+	}
+	if len(this.BlobQuotaExemptAddresses) != len(that1.BlobQuotaExemptAddresses) {
+		return false
+	}
+	for i := range this.BlobQuotaExemptAddresses {
+		if this.BlobQuotaExemptAddresses[i] != that1.BlobQuotaExemptAddresses[i] {
+			return false
+		}
+	}
+	if !this.NamespaceRegistrationDeposit.Equal(&that1.NamespaceRegistrationDeposit) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +283,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.NamespaceRegistrationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.BlobQuotaExemptAddresses) > 0 {
+		for iNdEx := len(m.BlobQuotaExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlobQuotaExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.BlobQuotaExemptAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlobQuotaExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NamespaceBlobQuota != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NamespaceBlobQuota))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AccountBlobQuota != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountBlobQuota))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlobQuotaWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlobQuotaWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TargetSquareUtilisation.Size()
+		i -= size
+		if _, err := m.TargetSquareUtilisation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.BlobBaseFeeChangeDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlobBaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinBlobBaseFee.Size()
+		i -= size
+		if _, err := m.MinBlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlobLaneSquareShare.Size()
+		i -= size
+		if _, err := m.BlobLaneSquareShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -189,6 +388,32 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	l = m.BlobLaneSquareShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBlobBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BlobBaseFeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.BlobBaseFeeChangeDenominator))
+	}
+	l = m.TargetSquareUtilisation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BlobQuotaWindow != 0 {
+		n += 1 + sovParams(uint64(m.BlobQuotaWindow))
+	}
+	if m.AccountBlobQuota != 0 {
+		n += 1 + sovParams(uint64(m.AccountBlobQuota))
+	}
+	if m.NamespaceBlobQuota != 0 {
+		n += 1 + sovParams(uint64(m.NamespaceBlobQuota))
+	}
+	if len(m.BlobQuotaExemptAddresses) > 0 {
+		for _, s := range m.BlobQuotaExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.NamespaceRegistrationDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -265,6 +490,249 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobLaneSquareShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobLaneSquareShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFeeChangeDenominator", wireType)
+			}
+			m.BlobBaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobBaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilisation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSquareUtilisation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobQuotaWindow", wireType)
+			}
+			m.BlobQuotaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobQuotaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBlobQuota", wireType)
+			}
+			m.AccountBlobQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountBlobQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceBlobQuota", wireType)
+			}
+			m.NamespaceBlobQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceBlobQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobQuotaExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobQuotaExemptAddresses = append(m.BlobQuotaExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NamespaceRegistrationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
 )
@@ -42,3 +44,110 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateBlobLaneSquareShare(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "valid",
+			input:     DefaultBlobLaneSquareShare,
+			expectErr: false,
+		},
+		{
+			name:      "zero",
+			input:     math.LegacyZeroDec(),
+			expectErr: false,
+		},
+		{
+			name:      "whole square",
+			input:     math.LegacyOneDec(),
+			expectErr: false,
+		},
+		{
+			name:      "greater than one",
+			input:     math.LegacyMustNewDecFromStr("1.1"),
+			expectErr: true,
+		},
+		{
+			name:      "negative",
+			input:     math.LegacyMustNewDecFromStr("-0.1"),
+			expectErr: true,
+		},
+		{
+			name:      "nil",
+			input:     math.LegacyDec{},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     "0.5",
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlobLaneSquareShare(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateBlobQuotaExemptAddresses(t *testing.T) {
+	address := sdk.AccAddress("exempt_address______").String()
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "valid",
+			input:     []string{address},
+			expectErr: false,
+		},
+		{
+			name:      "empty",
+			input:     []string{},
+			expectErr: false,
+		},
+		{
+			name:      "invalid address",
+			input:     []string{"invalid"},
+			expectErr: true,
+		},
+		{
+			name:      "duplicate",
+			input:     []string{address, address},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     address,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlobQuotaExemptAddresses(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateBlobQuotaWindow(t *testing.T) {
+	assert.NoError(t, validateBlobQuotaWindow(DefaultBlobQuotaWindow))
+	assert.Error(t, validateBlobQuotaWindow(uint64(0)))
+	assert.Error(t, validateBlobQuotaWindow(int64(1)))
+}
@@ -6,7 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,213 +115,2373 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.blob.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.blob.v1.QueryParamsResponse")
+// QueryBlobBaseFeeRequest is request type for the Query/BlobBaseFee RPC method.
+type QueryBlobBaseFeeRequest struct {
 }
 
-func init() { proto.RegisterFile("sunrise/blob/v1/query.proto", fileDescriptor_b44e3bf285c50520) }
+func (m *QueryBlobBaseFeeRequest) Reset()         { *m = QueryBlobBaseFeeRequest{} }
+func (m *QueryBlobBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlobBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{2}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_b44e3bf285c50520 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x18, 0x86, 0xef, 0x04, 0x0b, 0x9e, 0x83, 0x18, 0x0b, 0xd5, 0x2a, 0xa9, 0x54, 0x07, 0x29, 0x92,
-	0x70, 0x75, 0x73, 0xec, 0xe0, 0x6c, 0x3b, 0xba, 0x25, 0x25, 0xc4, 0x40, 0x2f, 0x5f, 0x7a, 0xc9,
-	0x15, 0x3b, 0xb8, 0xf8, 0x0b, 0x04, 0xff, 0x84, 0xa3, 0x3f, 0xa3, 0x63, 0xc1, 0xc5, 0x49, 0xa4,
-	0x15, 0xfc, 0x1b, 0xd2, 0xe4, 0x2a, 0xd8, 0x8a, 0xcb, 0xf1, 0xf1, 0xbd, 0xef, 0xf3, 0xde, 0xfb,
-	0x25, 0x39, 0xb4, 0x85, 0xce, 0x95, 0x15, 0x94, 0x0f, 0x80, 0xd3, 0x51, 0x4a, 0x87, 0x85, 0xc8,
-	0xc7, 0xc4, 0xe4, 0xe0, 0x00, 0xed, 0x94, 0x22, 0x59, 0x88, 0x64, 0x94, 0xd6, 0x77, 0x59, 0xa6,
-	0x34, 0x50, 0xff, 0x0d, 0x9e, 0x7a, 0x55, 0x82, 0x04, 0x3f, 0xd2, 0xc5, 0x54, 0x6e, 0x8f, 0x24,
-	0x80, 0x1c, 0x08, 0xca, 0x8c, 0xa2, 0x4c, 0x6b, 0x70, 0xcc, 0x29, 0xd0, 0xb6, 0x54, 0x5b, 0x7d,
-	0xb0, 0x19, 0x58, 0xca, 0x99, 0x15, 0xe1, 0x87, 0x74, 0x94, 0x72, 0xe1, 0x58, 0x4a, 0x0d, 0x93,
-	0x4a, 0x7b, 0xf3, 0x32, 0x69, 0xb5, 0xa0, 0x61, 0x39, 0xcb, 0xca, 0xa4, 0x66, 0x35, 0x41, 0xdd,
-	0x05, 0x7f, 0xed, 0x97, 0x3d, 0x31, 0x2c, 0x84, 0x75, 0xcd, 0x6e, 0xb2, 0xf7, 0x6b, 0x6b, 0x0d,
-	0x68, 0x2b, 0xd0, 0x65, 0x52, 0x09, 0xf0, 0x7e, 0x7c, 0x1c, 0x9f, 0x6d, 0xb7, 0x6b, 0x64, 0xe5,
-	0x3e, 0x12, 0x80, 0xce, 0xd6, 0xe4, 0xbd, 0x11, 0x3d, 0x7f, 0xbd, 0xb4, 0xe2, 0x5e, 0x49, 0xb4,
-	0xef, 0x93, 0x4d, 0x1f, 0x89, 0x5c, 0x52, 0x09, 0x2e, 0x74, 0xb2, 0x86, 0xaf, 0x57, 0xa9, 0x9f,
-	0xfe, 0x6f, 0x0a, 0xcd, 0x9a, 0x8d, 0x87, 0xd7, 0xcf, 0xa7, 0x8d, 0x03, 0x54, 0xa3, 0x7f, 0x5f,
-	0xdb, 0xb9, 0x9a, 0xcc, 0x70, 0x3c, 0x9d, 0xe1, 0xf8, 0x63, 0x86, 0xe3, 0xc7, 0x39, 0x8e, 0xa6,
-	0x73, 0x1c, 0xbd, 0xcd, 0x71, 0x74, 0x73, 0x2e, 0x95, 0xbb, 0x2d, 0x38, 0xe9, 0x43, 0xb6, 0x84,
-	0x07, 0x6c, 0x2c, 0xf2, 0x9f, 0xa4, 0xbb, 0x90, 0xe5, 0xc6, 0x46, 0x58, 0x5e, 0xf1, 0xcf, 0x76,
-	0xf1, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x6f, 0x28, 0xf2, 0xf7, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryBlobBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBlobBaseFeeResponse is response type for the Query/BlobBaseFee RPC
+// method.
+type QueryBlobBaseFeeResponse struct {
+	// blob_base_fee is the price per blob byte in the fee denom, as a decimal
+	// string
+	BlobBaseFee string `protobuf:"bytes,1,opt,name=blob_base_fee,json=blobBaseFee,proto3" json:"blob_base_fee,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBlobBaseFeeResponse) Reset()         { *m = QueryBlobBaseFeeResponse{} }
+func (m *QueryBlobBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlobBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{3}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBlobBaseFeeResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryBlobBaseFeeResponse) GetBlobBaseFee() string {
+	if m != nil {
+		return m.BlobBaseFee
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAccountBlobQuotaRequest is request type for the Query/AccountBlobQuota
+// RPC method.
+type QueryAccountBlobQuotaRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAccountBlobQuotaRequest) Reset()         { *m = QueryAccountBlobQuotaRequest{} }
+func (m *QueryAccountBlobQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBlobQuotaRequest) ProtoMessage()    {}
+func (*QueryAccountBlobQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{4}
+}
+func (m *QueryAccountBlobQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBlobQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBlobQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBlobQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBlobQuotaRequest.Merge(m, src)
+}
+func (m *QueryAccountBlobQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBlobQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBlobQuotaRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountBlobQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryAccountBlobQuotaRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryAccountBlobQuotaResponse is response type for the
+// Query/AccountBlobQuota RPC method.
+type QueryAccountBlobQuotaResponse struct {
+	// quota is the number of blob bytes per window, zero if unlimited
+	Quota uint64 `protobuf:"varint,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// used is the number of blob bytes paid for in the current window
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// remaining is the number of blob bytes left in the current window, zero if
+	// the quota is unlimited
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// window_end_height is the first height of the next window
+	WindowEndHeight int64 `protobuf:"varint,4,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+	// exempt is true if the account is exempt from the blob quotas
+	Exempt bool `protobuf:"varint,5,opt,name=exempt,proto3" json:"exempt,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAccountBlobQuotaResponse) Reset()         { *m = QueryAccountBlobQuotaResponse{} }
+func (m *QueryAccountBlobQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBlobQuotaResponse) ProtoMessage()    {}
+func (*QueryAccountBlobQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{5}
+}
+func (m *QueryAccountBlobQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBlobQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBlobQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBlobQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBlobQuotaResponse.Merge(m, src)
+}
+func (m *QueryAccountBlobQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBlobQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBlobQuotaResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAccountBlobQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryAccountBlobQuotaResponse) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAccountBlobQuotaResponse) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAccountBlobQuotaResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryAccountBlobQuotaResponse) GetWindowEndHeight() int64 {
+	if m != nil {
+		return m.WindowEndHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blob.v1.Query/Params",
+	return 0
+}
+
+func (m *QueryAccountBlobQuotaResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return false
+}
+
+// QueryNamespaceBlobQuotaRequest is request type for the
+// Query/NamespaceBlobQuota RPC method.
+type QueryNamespaceBlobQuotaRequest struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceBlobQuotaRequest) Reset()         { *m = QueryNamespaceBlobQuotaRequest{} }
+func (m *QueryNamespaceBlobQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceBlobQuotaRequest) ProtoMessage()    {}
+func (*QueryNamespaceBlobQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{6}
+}
+func (m *QueryNamespaceBlobQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceBlobQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceBlobQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNamespaceBlobQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceBlobQuotaRequest.Merge(m, src)
+}
+func (m *QueryNamespaceBlobQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceBlobQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceBlobQuotaRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.blob.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sunrise/blob/v1/query.proto",
+var xxx_messageInfo_QueryNamespaceBlobQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceBlobQuotaRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryNamespaceBlobQuotaResponse is response type for the
+// Query/NamespaceBlobQuota RPC method.
+type QueryNamespaceBlobQuotaResponse struct {
+	// quota is the number of blob bytes per window, zero if unlimited
+	Quota uint64 `protobuf:"varint,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// used is the number of blob bytes paid for in the current window
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// remaining is the number of blob bytes left in the current window, zero if
+	// the quota is unlimited
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// window_end_height is the first height of the next window
+	WindowEndHeight int64 `protobuf:"varint,4,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
+}
+
+func (m *QueryNamespaceBlobQuotaResponse) Reset()         { *m = QueryNamespaceBlobQuotaResponse{} }
+func (m *QueryNamespaceBlobQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceBlobQuotaResponse) ProtoMessage()    {}
+func (*QueryNamespaceBlobQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{7}
+}
+func (m *QueryNamespaceBlobQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceBlobQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceBlobQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryNamespaceBlobQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceBlobQuotaResponse.Merge(m, src)
+}
+func (m *QueryNamespaceBlobQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceBlobQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceBlobQuotaResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNamespaceBlobQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceBlobQuotaResponse) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryNamespaceBlobQuotaResponse) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNamespaceBlobQuotaResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNamespaceBlobQuotaResponse) GetWindowEndHeight() int64 {
+	if m != nil {
+		return m.WindowEndHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryNamespaceRequest is request type for the Query/Namespace RPC method.
+type QueryNamespaceRequest struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceRequest) Reset()         { *m = QueryNamespaceRequest{} }
+func (m *QueryNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceRequest) ProtoMessage()    {}
+func (*QueryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{8}
+}
+func (m *QueryNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceRequest.Merge(m, src)
+}
+func (m *QueryNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceResponse is response type for the Query/Namespace RPC method.
+type QueryNamespaceResponse struct {
+	Namespace RegisteredNamespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace"`
+}
+
+func (m *QueryNamespaceResponse) Reset()         { *m = QueryNamespaceResponse{} }
+func (m *QueryNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceResponse) ProtoMessage()    {}
+func (*QueryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{9}
+}
+func (m *QueryNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceResponse.Merge(m, src)
+}
+func (m *QueryNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceResponse) GetNamespace() RegisteredNamespace {
+	if m != nil {
+		return m.Namespace
+	}
+	return RegisteredNamespace{}
+}
+
+// QueryNamespacesByOwnerRequest is request type for the
+// Query/NamespacesByOwner RPC method.
+type QueryNamespacesByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamespacesByOwnerRequest) Reset()         { *m = QueryNamespacesByOwnerRequest{} }
+func (m *QueryNamespacesByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespacesByOwnerRequest) ProtoMessage()    {}
+func (*QueryNamespacesByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{10}
+}
+func (m *QueryNamespacesByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespacesByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespacesByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespacesByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespacesByOwnerRequest.Merge(m, src)
+}
+func (m *QueryNamespacesByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespacesByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespacesByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespacesByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryNamespacesByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryNamespacesByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNamespacesByOwnerResponse is response type for the
+// Query/NamespacesByOwner RPC method.
+type QueryNamespacesByOwnerResponse struct {
+	Namespaces []RegisteredNamespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamespacesByOwnerResponse) Reset()         { *m = QueryNamespacesByOwnerResponse{} }
+func (m *QueryNamespacesByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespacesByOwnerResponse) ProtoMessage()    {}
+func (*QueryNamespacesByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b44e3bf285c50520, []int{11}
+}
+func (m *QueryNamespacesByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespacesByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespacesByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespacesByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespacesByOwnerResponse.Merge(m, src)
+}
+func (m *QueryNamespacesByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespacesByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespacesByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespacesByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryNamespacesByOwnerResponse) GetNamespaces() []RegisteredNamespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *QueryNamespacesByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.blob.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlobBaseFeeRequest)(nil), "sunrise.blob.v1.QueryBlobBaseFeeRequest")
+	proto.RegisterType((*QueryBlobBaseFeeResponse)(nil), "sunrise.blob.v1.QueryBlobBaseFeeResponse")
+	proto.RegisterType((*QueryAccountBlobQuotaRequest)(nil), "sunrise.blob.v1.QueryAccountBlobQuotaRequest")
+	proto.RegisterType((*QueryAccountBlobQuotaResponse)(nil), "sunrise.blob.v1.QueryAccountBlobQuotaResponse")
+	proto.RegisterType((*QueryNamespaceBlobQuotaRequest)(nil), "sunrise.blob.v1.QueryNamespaceBlobQuotaRequest")
+	proto.RegisterType((*QueryNamespaceBlobQuotaResponse)(nil), "sunrise.blob.v1.QueryNamespaceBlobQuotaResponse")
+	proto.RegisterType((*QueryNamespaceRequest)(nil), "sunrise.blob.v1.QueryNamespaceRequest")
+	proto.RegisterType((*QueryNamespaceResponse)(nil), "sunrise.blob.v1.QueryNamespaceResponse")
+	proto.RegisterType((*QueryNamespacesByOwnerRequest)(nil), "sunrise.blob.v1.QueryNamespacesByOwnerRequest")
+	proto.RegisterType((*QueryNamespacesByOwnerResponse)(nil), "sunrise.blob.v1.QueryNamespacesByOwnerResponse")
+}
+
+func init() { proto.RegisterFile("sunrise/blob/v1/query.proto", fileDescriptor_b44e3bf285c50520) }
+
+var fileDescriptor_b44e3bf285c50520 = []byte{
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xc7, 0x33, 0xcd, 0x4b, 0xf1, 0x63, 0xa0, 0x64, 0x08, 0x8d, 0xb3, 0x98, 0x4d, 0xb4, 0x94,
+	0x24, 0x44, 0xf5, 0x0e, 0x36, 0xf4, 0xc2, 0x01, 0xa9, 0x16, 0x04, 0x2e, 0xb4, 0xcd, 0x72, 0xe3,
+	0xb2, 0x9a, 0xb5, 0x87, 0xcd, 0x4a, 0xf6, 0xcc, 0x66, 0x67, 0x9d, 0xd4, 0xaa, 0x2a, 0x21, 0x8e,
+	0x1c, 0x10, 0x12, 0x42, 0x7c, 0x03, 0x54, 0xc1, 0x85, 0x43, 0x4f, 0x7c, 0x82, 0x1e, 0xab, 0x70,
+	0xe1, 0x84, 0x50, 0x82, 0xc4, 0xd7, 0x40, 0x3b, 0x33, 0x6b, 0x3b, 0x5e, 0x2f, 0xb6, 0xb8, 0x70,
+	0xb1, 0x67, 0x9e, 0xd7, 0xdf, 0x3c, 0x9a, 0xf9, 0xdb, 0xf0, 0xba, 0x1c, 0xf0, 0x24, 0x92, 0x8c,
+	0x04, 0x3d, 0x11, 0x90, 0xd3, 0x26, 0x39, 0x19, 0xb0, 0x64, 0xe8, 0xc6, 0x89, 0x48, 0x05, 0xbe,
+	0x61, 0x9c, 0x6e, 0xe6, 0x74, 0x4f, 0x9b, 0xd6, 0x3a, 0xed, 0x47, 0x5c, 0x10, 0xf5, 0xa9, 0x63,
+	0xac, 0x8d, 0x50, 0x84, 0x42, 0x2d, 0x49, 0xb6, 0x32, 0xd6, 0xad, 0x8e, 0x90, 0x7d, 0x21, 0x7d,
+	0xed, 0xd0, 0x1b, 0xe3, 0xaa, 0x87, 0x42, 0x84, 0x3d, 0x46, 0x68, 0x1c, 0x11, 0xca, 0xb9, 0x48,
+	0x69, 0x1a, 0x09, 0x9e, 0x7b, 0x0f, 0x74, 0x2c, 0x09, 0xa8, 0x64, 0x9a, 0x85, 0x9c, 0x36, 0x03,
+	0x96, 0xd2, 0x26, 0x89, 0x69, 0x18, 0x71, 0x15, 0x6c, 0x62, 0xb7, 0xa7, 0xd9, 0x39, 0xed, 0x33,
+	0x19, 0xd3, 0x0e, 0xcb, 0x5b, 0x4d, 0x07, 0xc4, 0x34, 0xa1, 0x7d, 0xd3, 0xca, 0xd9, 0x00, 0x7c,
+	0x94, 0x35, 0x78, 0xa0, 0x8c, 0x1e, 0x3b, 0x19, 0x30, 0x99, 0x3a, 0x47, 0xf0, 0xea, 0x15, 0xab,
+	0x8c, 0x05, 0x97, 0x0c, 0xbf, 0x0f, 0x6b, 0x3a, 0xb9, 0x86, 0x76, 0xd0, 0x7e, 0xb5, 0xb5, 0xe9,
+	0x4e, 0xcd, 0xc6, 0xd5, 0x09, 0xed, 0xca, 0xb3, 0x3f, 0xb6, 0x97, 0x9e, 0xfc, 0xfd, 0xcb, 0x01,
+	0xf2, 0x4c, 0x86, 0xb3, 0x05, 0x9b, 0xaa, 0x64, 0xbb, 0x27, 0x82, 0x36, 0x95, 0xec, 0x90, 0xb1,
+	0xbc, 0xdb, 0x3d, 0xa8, 0x15, 0x5d, 0xa6, 0x65, 0x0b, 0x5e, 0xca, 0x6a, 0xfb, 0xd9, 0x28, 0xfc,
+	0x2f, 0x18, 0x53, 0x9d, 0x2b, 0xed, 0x97, 0xcf, 0x9f, 0x36, 0xc0, 0x4c, 0xf4, 0x43, 0xd6, 0xf1,
+	0xaa, 0xc1, 0x38, 0xd7, 0xf1, 0xa0, 0xae, 0xea, 0xdd, 0xed, 0x74, 0xc4, 0x80, 0xa7, 0x59, 0xd9,
+	0xa3, 0x81, 0x48, 0xa9, 0xe9, 0x87, 0x5b, 0x70, 0x9d, 0x76, 0xbb, 0x09, 0x93, 0xd2, 0x54, 0xab,
+	0x9d, 0x3f, 0x6d, 0x6c, 0x98, 0x6a, 0x77, 0xb5, 0xe7, 0xb3, 0x34, 0x89, 0x78, 0xe8, 0xe5, 0x81,
+	0xce, 0xcf, 0x08, 0xde, 0x28, 0x29, 0x6a, 0x48, 0x37, 0x60, 0xf5, 0x24, 0x33, 0xa8, 0x9a, 0x2b,
+	0x9e, 0xde, 0x60, 0x0c, 0x2b, 0x03, 0xc9, 0xba, 0xb5, 0x6b, 0xca, 0xa8, 0xd6, 0xb8, 0x0e, 0x95,
+	0x84, 0xf5, 0x69, 0xc4, 0x23, 0x1e, 0xd6, 0x96, 0x95, 0x63, 0x6c, 0xc0, 0x07, 0xb0, 0x7e, 0x16,
+	0xf1, 0xae, 0x38, 0xf3, 0x19, 0xef, 0xfa, 0xc7, 0x2c, 0x0a, 0x8f, 0xd3, 0xda, 0xca, 0x0e, 0xda,
+	0x5f, 0xf6, 0x6e, 0x68, 0xc7, 0x47, 0xbc, 0xfb, 0x89, 0x32, 0xe3, 0x9b, 0xb0, 0xc6, 0x1e, 0xb2,
+	0x7e, 0x9c, 0xd6, 0x56, 0x77, 0xd0, 0xfe, 0x0b, 0x9e, 0xd9, 0x39, 0x1f, 0x80, 0xad, 0x60, 0xef,
+	0xe5, 0x77, 0xa1, 0x30, 0x83, 0x3a, 0x54, 0x46, 0x17, 0x45, 0x11, 0xbf, 0xe8, 0x8d, 0x0d, 0xce,
+	0xf7, 0x08, 0xb6, 0x4b, 0x0b, 0xfc, 0x7f, 0xe7, 0x75, 0xee, 0xc0, 0x6b, 0x57, 0xb1, 0x16, 0x3b,
+	0x4e, 0x08, 0x37, 0xa7, 0xd3, 0xcc, 0x21, 0x3e, 0x9d, 0xce, 0xab, 0xb6, 0x6e, 0x15, 0x2e, 0xb5,
+	0xc7, 0xc2, 0x48, 0xa6, 0x2c, 0x61, 0xdd, 0xf1, 0x38, 0x26, 0x6e, 0xf8, 0x44, 0xa3, 0x1f, 0xf2,
+	0x5b, 0x32, 0x0a, 0x94, 0xed, 0xe1, 0xfd, 0x33, 0xce, 0x92, 0x1c, 0xd4, 0x85, 0x55, 0x91, 0xed,
+	0xe7, 0xde, 0x3c, 0x1d, 0x86, 0x0f, 0x01, 0xc6, 0x4f, 0x5e, 0x4d, 0xb5, 0xda, 0xda, 0x75, 0x4d,
+	0x46, 0xf6, 0x28, 0x5c, 0xad, 0x55, 0x46, 0x1f, 0xdc, 0x07, 0x34, 0xcc, 0x87, 0xe2, 0x4d, 0x64,
+	0x3a, 0xbf, 0x22, 0xb0, 0xcb, 0xc8, 0xcc, 0x2c, 0xee, 0x03, 0x8c, 0x4e, 0x92, 0xbd, 0x8c, 0xe5,
+	0xff, 0x32, 0x8c, 0x89, 0x12, 0xf8, 0xe3, 0x19, 0xec, 0x7b, 0x73, 0xd9, 0x35, 0xcd, 0x24, 0x7c,
+	0xeb, 0x9b, 0xeb, 0xb0, 0xaa, 0xe0, 0x71, 0x0a, 0x6b, 0x5a, 0x62, 0xf0, 0x9b, 0x05, 0xb2, 0xa2,
+	0x8e, 0x59, 0xb7, 0xfe, 0x3d, 0x48, 0xb7, 0x72, 0xb6, 0xbf, 0xfa, 0xed, 0xaf, 0xef, 0xae, 0x6d,
+	0xe1, 0x4d, 0x32, 0x5b, 0x2a, 0xf1, 0xd7, 0x08, 0xaa, 0x13, 0xe2, 0x84, 0xf7, 0x67, 0x97, 0x2d,
+	0x4a, 0x9b, 0xf5, 0xf6, 0x02, 0x91, 0x86, 0x62, 0x57, 0x51, 0xec, 0x60, 0xbb, 0x40, 0x71, 0x45,
+	0x00, 0xf1, 0x13, 0x04, 0xaf, 0x4c, 0x8b, 0x10, 0x6e, 0xcc, 0xee, 0x53, 0xa2, 0x80, 0x96, 0xbb,
+	0x68, 0xb8, 0x61, 0xbb, 0xa3, 0xd8, 0x08, 0x6e, 0x14, 0xd8, 0xa8, 0x4e, 0xf1, 0x15, 0xa3, 0x92,
+	0x00, 0xf2, 0xc8, 0x68, 0xe6, 0x63, 0xfc, 0x23, 0x02, 0x5c, 0x54, 0x10, 0x4c, 0x66, 0x77, 0x2f,
+	0x15, 0x2b, 0xeb, 0x9d, 0xc5, 0x13, 0x0c, 0x70, 0x43, 0x01, 0xef, 0xe1, 0xb7, 0x48, 0xe9, 0xcf,
+	0xe3, 0x04, 0x32, 0xfe, 0x12, 0x41, 0x65, 0x54, 0x0d, 0xef, 0xce, 0x69, 0x97, 0x63, 0xed, 0xcd,
+	0x8d, 0x33, 0x34, 0x8e, 0xa2, 0xa9, 0x63, 0xab, 0x9c, 0x06, 0xff, 0x84, 0x60, 0xbd, 0xf0, 0x36,
+	0xb1, 0x3b, 0xa7, 0xc5, 0x94, 0xbc, 0x58, 0x64, 0xe1, 0x78, 0x83, 0xf6, 0x9e, 0x42, 0x73, 0xf1,
+	0xed, 0x72, 0x34, 0xe9, 0x07, 0x43, 0x5f, 0xa9, 0x11, 0x79, 0xa4, 0xbe, 0x1e, 0xb7, 0x0f, 0x9f,
+	0x5d, 0xd8, 0xe8, 0xf9, 0x85, 0x8d, 0xfe, 0xbc, 0xb0, 0xd1, 0xb7, 0x97, 0xf6, 0xd2, 0xf3, 0x4b,
+	0x7b, 0xe9, 0xf7, 0x4b, 0x7b, 0xe9, 0xf3, 0xdb, 0x61, 0x94, 0x1e, 0x0f, 0x02, 0xb7, 0x23, 0xfa,
+	0x79, 0xc5, 0x1e, 0x1d, 0xb2, 0x64, 0x54, 0xfe, 0xa1, 0x6e, 0x90, 0x0e, 0x63, 0x26, 0x83, 0x35,
+	0xf5, 0x27, 0xe4, 0xdd, 0x7f, 0x06, 0x00, 0x8a, 0xb9, 0xc1, 0x05, 0x81, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the current blob base fee, the minimum price per blob
+	// byte.
+	BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error)
+	// AccountBlobQuota queries the blob quota of an account in the current
+	// window.
+	AccountBlobQuota(ctx context.Context, in *QueryAccountBlobQuotaRequest, opts ...grpc.CallOption) (*QueryAccountBlobQuotaResponse, error)
+	// NamespaceBlobQuota queries the blob quota of a namespace in the current
+	// window.
+	NamespaceBlobQuota(ctx context.Context, in *QueryNamespaceBlobQuotaRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobQuotaResponse, error)
+	// Namespace queries a registered namespace.
+	Namespace(ctx context.Context, in *QueryNamespaceRequest, opts ...grpc.CallOption) (*QueryNamespaceResponse, error)
+	// NamespacesByOwner queries the namespaces registered by an owner.
+	NamespacesByOwner(ctx context.Context, in *QueryNamespacesByOwnerRequest, opts ...grpc.CallOption) (*QueryNamespacesByOwnerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error) {
+	out := new(QueryBlobBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/BlobBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBlobQuota(ctx context.Context, in *QueryAccountBlobQuotaRequest, opts ...grpc.CallOption) (*QueryAccountBlobQuotaResponse, error) {
+	out := new(QueryAccountBlobQuotaResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/AccountBlobQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceBlobQuota(ctx context.Context, in *QueryNamespaceBlobQuotaRequest, opts ...grpc.CallOption) (*QueryNamespaceBlobQuotaResponse, error) {
+	out := new(QueryNamespaceBlobQuotaResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/NamespaceBlobQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Namespace(ctx context.Context, in *QueryNamespaceRequest, opts ...grpc.CallOption) (*QueryNamespaceResponse, error) {
+	out := new(QueryNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/Namespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespacesByOwner(ctx context.Context, in *QueryNamespacesByOwnerRequest, opts ...grpc.CallOption) (*QueryNamespacesByOwnerResponse, error) {
+	out := new(QueryNamespacesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Query/NamespacesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the current blob base fee, the minimum price per blob
+	// byte.
+	BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error)
+	// AccountBlobQuota queries the blob quota of an account in the current
+	// window.
+	AccountBlobQuota(context.Context, *QueryAccountBlobQuotaRequest) (*QueryAccountBlobQuotaResponse, error)
+	// NamespaceBlobQuota queries the blob quota of a namespace in the current
+	// window.
+	NamespaceBlobQuota(context.Context, *QueryNamespaceBlobQuotaRequest) (*QueryNamespaceBlobQuotaResponse, error)
+	// Namespace queries a registered namespace.
+	Namespace(context.Context, *QueryNamespaceRequest) (*QueryNamespaceResponse, error)
+	// NamespacesByOwner queries the namespaces registered by an owner.
+	NamespacesByOwner(context.Context, *QueryNamespacesByOwnerRequest) (*QueryNamespacesByOwnerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BlobBaseFee(ctx context.Context, req *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) AccountBlobQuota(ctx context.Context, req *QueryAccountBlobQuotaRequest) (*QueryAccountBlobQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBlobQuota not implemented")
+}
+func (*UnimplementedQueryServer) NamespaceBlobQuota(ctx context.Context, req *QueryNamespaceBlobQuotaRequest) (*QueryNamespaceBlobQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceBlobQuota not implemented")
+}
+func (*UnimplementedQueryServer) Namespace(ctx context.Context, req *QueryNamespaceRequest) (*QueryNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespace not implemented")
+}
+func (*UnimplementedQueryServer) NamespacesByOwner(ctx context.Context, req *QueryNamespacesByOwnerRequest) (*QueryNamespacesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespacesByOwner not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlobBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/BlobBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobBaseFee(ctx, req.(*QueryBlobBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBlobQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBlobQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBlobQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/AccountBlobQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBlobQuota(ctx, req.(*QueryAccountBlobQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceBlobQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceBlobQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceBlobQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/NamespaceBlobQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceBlobQuota(ctx, req.(*QueryNamespaceBlobQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Namespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Namespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/Namespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Namespace(ctx, req.(*QueryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespacesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespacesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespacesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Query/NamespacesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespacesByOwner(ctx, req.(*QueryNamespacesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.blob.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BlobBaseFee",
+			Handler:    _Query_BlobBaseFee_Handler,
+		},
+		{
+			MethodName: "AccountBlobQuota",
+			Handler:    _Query_AccountBlobQuota_Handler,
+		},
+		{
+			MethodName: "NamespaceBlobQuota",
+			Handler:    _Query_NamespaceBlobQuota_Handler,
+		},
+		{
+			MethodName: "Namespace",
+			Handler:    _Query_Namespace_Handler,
+		},
+		{
+			MethodName: "NamespacesByOwner",
+			Handler:    _Query_NamespacesByOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/blob/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlobBaseFee) > 0 {
+		i -= len(m.BlobBaseFee)
+		copy(dAtA[i:], m.BlobBaseFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlobBaseFee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBlobQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBlobQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBlobQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBlobQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBlobQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBlobQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Quota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceBlobQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceBlobQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceBlobQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceBlobQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceBlobQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceBlobQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Quota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespacesByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespacesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespacesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespacesByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespacesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespacesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlobBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlobBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlobBaseFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBlobQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBlobQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != 0 {
+		n += 1 + sovQuery(uint64(m.Quota))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEndHeight))
+	}
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNamespaceBlobQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceBlobQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != 0 {
+		n += 1 + sovQuery(uint64(m.Quota))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEndHeight))
+	}
+	return n
+}
+
+func (m *QueryNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Namespace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNamespacesByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespacesByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobBaseFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBlobQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBlobQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBlobQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBlobQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBlobQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBlobQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceBlobQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceBlobQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceBlobQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceBlobQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceBlobQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceBlobQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
+			}
+			m.WindowEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNamespacesByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -343,12 +2504,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNamespacesByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNamespacesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -370,7 +2599,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNamespacesByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -393,15 +2622,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNamespacesByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNamespacesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -428,7 +2657,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Namespaces = append(m.Namespaces, RegisteredNamespace{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
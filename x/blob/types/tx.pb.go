@@ -249,431 +249,3120 @@ func (m *MsgPayForBlobsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayForBlobsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "sunrise.blob.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sunrise.blob.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgPayForBlobs)(nil), "sunrise.blob.v1.MsgPayForBlobs")
-	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "sunrise.blob.v1.MsgPayForBlobsResponse")
+// MsgRegisterNamespace is the Msg/RegisterNamespace request type.
+type MsgRegisterNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// restricted is true if only the owner and the posters can pay for blobs in
+	// the namespace
+	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
-func init() { proto.RegisterFile("sunrise/blob/v1/tx.proto", fileDescriptor_de22c28e54aaf776) }
-
-var fileDescriptor_de22c28e54aaf776 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xbf, 0x8b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x0d, 0x66, 0x72, 0x3f, 0xbc, 0xe1, 0xb8, 0xec, 0x05, 0xdd, 0x8b, 0x01, 0x71,
-	0x89, 0xba, 0xcb, 0x9d, 0x62, 0x91, 0xce, 0x08, 0xd7, 0x05, 0x8e, 0x3d, 0x54, 0xb8, 0x26, 0xcc,
-	0x26, 0xc3, 0x64, 0x21, 0xb3, 0xb3, 0xcc, 0x9b, 0x84, 0x8b, 0x95, 0x58, 0x5a, 0xf9, 0x67, 0x58,
-	0xa6, 0xb0, 0xb3, 0xb2, 0x4b, 0x79, 0x58, 0x59, 0x89, 0x24, 0x45, 0xfe, 0x0d, 0xd9, 0xdd, 0x89,
-	0xc9, 0xad, 0xc2, 0x35, 0x21, 0xf3, 0x7d, 0xdf, 0xfb, 0xde, 0xf7, 0xde, 0x3e, 0x6c, 0xc1, 0x28,
-	0x52, 0x21, 0x30, 0x2f, 0x18, 0xca, 0xc0, 0x1b, 0x1f, 0x7b, 0xfa, 0xd2, 0x8d, 0x95, 0xd4, 0x92,
-	0xec, 0x1a, 0xc6, 0x4d, 0x18, 0x77, 0x7c, 0x5c, 0xdb, 0xa3, 0x22, 0x8c, 0xa4, 0x97, 0xfe, 0x66,
-	0x9a, 0x5a, 0xb5, 0x27, 0x41, 0x48, 0xf0, 0x04, 0xf0, 0xa4, 0x56, 0x00, 0x37, 0xc4, 0x61, 0x46,
-	0x74, 0xd3, 0x97, 0x97, 0x3d, 0x0c, 0xb5, 0xcf, 0x25, 0x97, 0x19, 0x9e, 0xfc, 0x33, 0xe8, 0xfd,
-	0x7c, 0x8e, 0x98, 0x2a, 0x2a, 0x4c, 0x4d, 0xe3, 0x1b, 0xc2, 0xbb, 0x1d, 0xe0, 0x6f, 0xe2, 0x3e,
-	0xd5, 0xec, 0x2c, 0x65, 0xc8, 0x4b, 0x5c, 0xa6, 0x23, 0x3d, 0x90, 0x2a, 0xd4, 0x13, 0x0b, 0xd5,
-	0x91, 0x53, 0x6e, 0x5b, 0x3f, 0xbe, 0x3e, 0xdb, 0x37, 0xcd, 0x5e, 0xf5, 0xfb, 0x8a, 0x01, 0x9c,
-	0x6b, 0x15, 0x46, 0xdc, 0x5f, 0x4b, 0x49, 0x0b, 0x97, 0x32, 0x6f, 0xeb, 0x56, 0x1d, 0x39, 0x95,
-	0x93, 0xaa, 0x9b, 0x1b, 0xd4, 0xcd, 0x1a, 0xb4, 0xcb, 0xb3, 0x5f, 0x47, 0x85, 0x2f, 0xcb, 0x69,
-	0x13, 0xf9, 0xa6, 0xa2, 0xf5, 0xe2, 0xe3, 0x72, 0xda, 0x5c, 0x7b, 0x7d, 0x5a, 0x4e, 0x9b, 0x0f,
-	0x57, 0xc1, 0x2f, 0xff, 0x46, 0xcf, 0x25, 0x6d, 0x1c, 0xe2, 0x6a, 0x0e, 0xf2, 0x19, 0xc4, 0x32,
-	0x02, 0xd6, 0x98, 0x21, 0xbc, 0xd3, 0x01, 0x7e, 0x46, 0x27, 0xa7, 0x52, 0xb5, 0x87, 0x32, 0x00,
-	0x72, 0x80, 0x4b, 0x10, 0xf2, 0x88, 0xa9, 0x6c, 0x28, 0xdf, 0xbc, 0x88, 0x8d, 0x71, 0x44, 0x05,
-	0x83, 0x98, 0xf6, 0x58, 0x92, 0xbd, 0xe8, 0x6c, 0xf9, 0x1b, 0x08, 0x79, 0x80, 0x71, 0x12, 0xa0,
-	0x0b, 0xe1, 0x7b, 0x06, 0x56, 0xb1, 0x5e, 0x74, 0xb6, 0xfd, 0x72, 0x82, 0x9c, 0x27, 0x00, 0x79,
-	0x82, 0xf7, 0x60, 0x40, 0x15, 0xeb, 0xf6, 0xa4, 0x10, 0xa1, 0x16, 0x2c, 0xd2, 0x60, 0xdd, 0x4e,
-	0x5d, 0xee, 0xa5, 0xc4, 0xeb, 0x35, 0x4e, 0x1e, 0xe1, 0x9d, 0x4c, 0x3c, 0x66, 0x0a, 0x42, 0x19,
-	0x81, 0x75, 0x37, 0xf5, 0xdb, 0x4e, 0xd1, 0xb7, 0x06, 0x6c, 0x55, 0x92, 0x75, 0x98, 0x7c, 0x0d,
-	0x0b, 0x1f, 0x5c, 0x9f, 0x64, 0x35, 0xe4, 0xc9, 0x77, 0x84, 0x8b, 0x1d, 0xe0, 0xe4, 0x02, 0x6f,
-	0x5d, 0xfb, 0x82, 0xf5, 0x7f, 0x36, 0x9f, 0x5b, 0x53, 0xcd, 0xb9, 0x49, 0xb1, 0xea, 0x41, 0xde,
-	0xe1, 0xca, 0xe6, 0x12, 0x8f, 0xfe, 0x57, 0xb8, 0x21, 0xa8, 0x3d, 0xbe, 0x41, 0xb0, 0x32, 0xae,
-	0xdd, 0xf9, 0x90, 0x5c, 0x40, 0xfb, 0x74, 0x36, 0xb7, 0xd1, 0xd5, 0xdc, 0x46, 0xbf, 0xe7, 0x36,
-	0xfa, 0xbc, 0xb0, 0x0b, 0x57, 0x0b, 0xbb, 0xf0, 0x73, 0x61, 0x17, 0x2e, 0x9e, 0xf2, 0x50, 0x0f,
-	0x46, 0x81, 0xdb, 0x93, 0xc2, 0x33, 0x9e, 0x43, 0x3a, 0x61, 0xca, 0xcb, 0x1d, 0x86, 0x9e, 0xc4,
-	0x0c, 0x82, 0x52, 0x7a, 0xd0, 0xcf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x78, 0xe1, 0xbc,
-	0x78, 0x03, 0x00, 0x00,
+func (m *MsgRegisterNamespace) Reset()         { *m = MsgRegisterNamespace{} }
+func (m *MsgRegisterNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNamespace) ProtoMessage()    {}
+func (*MsgRegisterNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
+func (m *MsgRegisterNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNamespace.Merge(m, src)
+}
+func (m *MsgRegisterNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNamespace.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterNamespace proto.InternalMessageInfo
+
+func (m *MsgRegisterNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error) {
-	out := new(MsgPayForBlobsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/PayForBlobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
+func (m *MsgRegisterNamespace) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgRegisterNamespaceResponse defines the Msg/RegisterNamespace response type.
+type MsgRegisterNamespaceResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRegisterNamespaceResponse) Reset()         { *m = MsgRegisterNamespaceResponse{} }
+func (m *MsgRegisterNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNamespaceResponse) ProtoMessage()    {}
+func (*MsgRegisterNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{5}
 }
-func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
+func (m *MsgRegisterNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNamespaceResponse.Merge(m, src)
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNamespaceResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterNamespaceResponse proto.InternalMessageInfo
+
+// MsgDeregisterNamespace is the Msg/DeregisterNamespace request type.
+type MsgDeregisterNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blob.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDeregisterNamespace) Reset()         { *m = MsgDeregisterNamespace{} }
+func (m *MsgDeregisterNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterNamespace) ProtoMessage()    {}
+func (*MsgDeregisterNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{6}
+}
+func (m *MsgDeregisterNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterNamespace.Merge(m, src)
+}
+func (m *MsgDeregisterNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterNamespace.DiscardUnknown(m)
 }
 
-func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayForBlobs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayForBlobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blob.v1.Msg/PayForBlobs",
+var xxx_messageInfo_MsgDeregisterNamespace proto.InternalMessageInfo
+
+func (m *MsgDeregisterNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayForBlobs(ctx, req.(*MsgPayForBlobs))
+	return ""
+}
+
+func (m *MsgDeregisterNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.blob.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "PayForBlobs",
-			Handler:    _Msg_PayForBlobs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sunrise/blob/v1/tx.proto",
+// MsgDeregisterNamespaceResponse defines the Msg/DeregisterNamespace response
+// type.
+type MsgDeregisterNamespaceResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeregisterNamespaceResponse) Reset()         { *m = MsgDeregisterNamespaceResponse{} }
+func (m *MsgDeregisterNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterNamespaceResponse) ProtoMessage()    {}
+func (*MsgDeregisterNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{7}
+}
+func (m *MsgDeregisterNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeregisterNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterNamespaceResponse.Merge(m, src)
+}
+func (m *MsgDeregisterNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterNamespaceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeregisterNamespaceResponse proto.InternalMessageInfo
+
+// MsgTransferNamespace is the Msg/TransferNamespace request type.
+type MsgTransferNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferNamespace) Reset()         { *m = MsgTransferNamespace{} }
+func (m *MsgTransferNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespace) ProtoMessage()    {}
+func (*MsgTransferNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{8}
+}
+func (m *MsgTransferNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTransferNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespace.Merge(m, src)
+}
+func (m *MsgTransferNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespace.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferNamespace proto.InternalMessageInfo
+
+func (m *MsgTransferNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
 }
 
-func (m *MsgPayForBlobs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNamespace) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferNamespaceResponse defines the Msg/TransferNamespace response type.
+type MsgTransferNamespaceResponse struct {
 }
 
-func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShareVersions) > 0 {
-		dAtA3 := make([]byte, len(m.ShareVersions)*10)
-		var j2 int
-		for _, num := range m.ShareVersions {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ShareCommitments) > 0 {
-		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ShareCommitments[iNdEx])
-			copy(dAtA[i:], m.ShareCommitments[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BlobSizes) > 0 {
-		dAtA5 := make([]byte, len(m.BlobSizes)*10)
-		var j4 int
-		for _, num := range m.BlobSizes {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *MsgTransferNamespaceResponse) Reset()         { *m = MsgTransferNamespaceResponse{} }
+func (m *MsgTransferNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespaceResponse) ProtoMessage()    {}
+func (*MsgTransferNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{9}
+}
+func (m *MsgTransferNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Namespaces) > 0 {
-		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Namespaces[iNdEx])
-			copy(dAtA[i:], m.Namespaces[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+}
+func (m *MsgTransferNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespaceResponse.Merge(m, src)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNamespaceResponse proto.InternalMessageInfo
+
+// MsgAddNamespacePoster is the Msg/AddNamespacePoster request type.
+type MsgAddNamespacePoster struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Poster    string `protobuf:"bytes,3,opt,name=poster,proto3" json:"poster,omitempty"`
+}
+
+func (m *MsgAddNamespacePoster) Reset()         { *m = MsgAddNamespacePoster{} }
+func (m *MsgAddNamespacePoster) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNamespacePoster) ProtoMessage()    {}
+func (*MsgAddNamespacePoster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{10}
+}
+func (m *MsgAddNamespacePoster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNamespacePoster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNamespacePoster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAddNamespacePoster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNamespacePoster.Merge(m, src)
+}
+func (m *MsgAddNamespacePoster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNamespacePoster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNamespacePoster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddNamespacePoster proto.InternalMessageInfo
+
+func (m *MsgAddNamespacePoster) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPayForBlobsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddNamespacePoster) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPayForBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddNamespacePoster) GetPoster() string {
+	if m != nil {
+		return m.Poster
+	}
+	return ""
 }
 
-func (m *MsgPayForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAddNamespacePosterResponse defines the Msg/AddNamespacePoster response
+// type.
+type MsgAddNamespacePosterResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgAddNamespacePosterResponse) Reset()         { *m = MsgAddNamespacePosterResponse{} }
+func (m *MsgAddNamespacePosterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddNamespacePosterResponse) ProtoMessage()    {}
+func (*MsgAddNamespacePosterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{11}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddNamespacePosterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddNamespacePosterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddNamespacePosterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAddNamespacePosterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddNamespacePosterResponse.Merge(m, src)
+}
+func (m *MsgAddNamespacePosterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddNamespacePosterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddNamespacePosterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddNamespacePosterResponse proto.InternalMessageInfo
+
+// MsgRemoveNamespacePoster is the Msg/RemoveNamespacePoster request type.
+type MsgRemoveNamespacePoster struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Poster    string `protobuf:"bytes,3,opt,name=poster,proto3" json:"poster,omitempty"`
+}
+
+func (m *MsgRemoveNamespacePoster) Reset()         { *m = MsgRemoveNamespacePoster{} }
+func (m *MsgRemoveNamespacePoster) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNamespacePoster) ProtoMessage()    {}
+func (*MsgRemoveNamespacePoster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{12}
+}
+func (m *MsgRemoveNamespacePoster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNamespacePoster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNamespacePoster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRemoveNamespacePoster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNamespacePoster.Merge(m, src)
+}
+func (m *MsgRemoveNamespacePoster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNamespacePoster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNamespacePoster.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveNamespacePoster proto.InternalMessageInfo
+
+func (m *MsgRemoveNamespacePoster) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgPayForBlobs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveNamespacePoster) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgRemoveNamespacePoster) GetPoster() string {
+	if m != nil {
+		return m.Poster
 	}
-	if len(m.Namespaces) > 0 {
-		for _, b := range m.Namespaces {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgRemoveNamespacePosterResponse defines the Msg/RemoveNamespacePoster
+// response type.
+type MsgRemoveNamespacePosterResponse struct {
+}
+
+func (m *MsgRemoveNamespacePosterResponse) Reset()         { *m = MsgRemoveNamespacePosterResponse{} }
+func (m *MsgRemoveNamespacePosterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNamespacePosterResponse) ProtoMessage()    {}
+func (*MsgRemoveNamespacePosterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{13}
+}
+func (m *MsgRemoveNamespacePosterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNamespacePosterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNamespacePosterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BlobSizes) > 0 {
-		l = 0
-		for _, e := range m.BlobSizes {
-			l += sovTx(uint64(e))
+}
+func (m *MsgRemoveNamespacePosterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNamespacePosterResponse.Merge(m, src)
+}
+func (m *MsgRemoveNamespacePosterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNamespacePosterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNamespacePosterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveNamespacePosterResponse proto.InternalMessageInfo
+
+// MsgSetNamespaceRestricted is the Msg/SetNamespaceRestricted request type.
+type MsgSetNamespaceRestricted struct {
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace  []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+}
+
+func (m *MsgSetNamespaceRestricted) Reset()         { *m = MsgSetNamespaceRestricted{} }
+func (m *MsgSetNamespaceRestricted) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamespaceRestricted) ProtoMessage()    {}
+func (*MsgSetNamespaceRestricted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{14}
+}
+func (m *MsgSetNamespaceRestricted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamespaceRestricted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamespaceRestricted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.ShareCommitments) > 0 {
-		for _, b := range m.ShareCommitments {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+}
+func (m *MsgSetNamespaceRestricted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamespaceRestricted.Merge(m, src)
+}
+func (m *MsgSetNamespaceRestricted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamespaceRestricted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamespaceRestricted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNamespaceRestricted proto.InternalMessageInfo
+
+func (m *MsgSetNamespaceRestricted) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.ShareVersions) > 0 {
-		l = 0
-		for _, e := range m.ShareVersions {
-			l += sovTx(uint64(e))
+	return ""
+}
+
+func (m *MsgSetNamespaceRestricted) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgSetNamespaceRestricted) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+// MsgSetNamespaceRestrictedResponse defines the Msg/SetNamespaceRestricted
+// response type.
+type MsgSetNamespaceRestrictedResponse struct {
+}
+
+func (m *MsgSetNamespaceRestrictedResponse) Reset()         { *m = MsgSetNamespaceRestrictedResponse{} }
+func (m *MsgSetNamespaceRestrictedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamespaceRestrictedResponse) ProtoMessage()    {}
+func (*MsgSetNamespaceRestrictedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de22c28e54aaf776, []int{15}
+}
+func (m *MsgSetNamespaceRestrictedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNamespaceRestrictedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamespaceRestrictedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNamespaceRestrictedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamespaceRestrictedResponse.Merge(m, src)
+}
+func (m *MsgSetNamespaceRestrictedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamespaceRestrictedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamespaceRestrictedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNamespaceRestrictedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "sunrise.blob.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sunrise.blob.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPayForBlobs)(nil), "sunrise.blob.v1.MsgPayForBlobs")
+	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "sunrise.blob.v1.MsgPayForBlobsResponse")
+	proto.RegisterType((*MsgRegisterNamespace)(nil), "sunrise.blob.v1.MsgRegisterNamespace")
+	proto.RegisterType((*MsgRegisterNamespaceResponse)(nil), "sunrise.blob.v1.MsgRegisterNamespaceResponse")
+	proto.RegisterType((*MsgDeregisterNamespace)(nil), "sunrise.blob.v1.MsgDeregisterNamespace")
+	proto.RegisterType((*MsgDeregisterNamespaceResponse)(nil), "sunrise.blob.v1.MsgDeregisterNamespaceResponse")
+	proto.RegisterType((*MsgTransferNamespace)(nil), "sunrise.blob.v1.MsgTransferNamespace")
+	proto.RegisterType((*MsgTransferNamespaceResponse)(nil), "sunrise.blob.v1.MsgTransferNamespaceResponse")
+	proto.RegisterType((*MsgAddNamespacePoster)(nil), "sunrise.blob.v1.MsgAddNamespacePoster")
+	proto.RegisterType((*MsgAddNamespacePosterResponse)(nil), "sunrise.blob.v1.MsgAddNamespacePosterResponse")
+	proto.RegisterType((*MsgRemoveNamespacePoster)(nil), "sunrise.blob.v1.MsgRemoveNamespacePoster")
+	proto.RegisterType((*MsgRemoveNamespacePosterResponse)(nil), "sunrise.blob.v1.MsgRemoveNamespacePosterResponse")
+	proto.RegisterType((*MsgSetNamespaceRestricted)(nil), "sunrise.blob.v1.MsgSetNamespaceRestricted")
+	proto.RegisterType((*MsgSetNamespaceRestrictedResponse)(nil), "sunrise.blob.v1.MsgSetNamespaceRestrictedResponse")
+}
+
+func init() { proto.RegisterFile("sunrise/blob/v1/tx.proto", fileDescriptor_de22c28e54aaf776) }
+
+var fileDescriptor_de22c28e54aaf776 = []byte{
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4b, 0x4f, 0xdb, 0x58,
+	0x18, 0x8d, 0x27, 0x43, 0x44, 0x3e, 0x5e, 0x83, 0x87, 0x87, 0xb1, 0xc0, 0x04, 0x8f, 0x80, 0x4c,
+	0x18, 0xec, 0x81, 0x16, 0xaa, 0x66, 0x47, 0x5a, 0xb1, 0x4b, 0x8b, 0x4c, 0x1f, 0x12, 0x9b, 0xc8,
+	0x49, 0x6e, 0x8d, 0xa5, 0xd8, 0x37, 0xba, 0xd7, 0x09, 0xa4, 0xab, 0xaa, 0xcb, 0xae, 0xfa, 0x0f,
+	0xba, 0xed, 0x92, 0x45, 0x77, 0xac, 0x2b, 0xb1, 0x2b, 0x42, 0xaa, 0xd4, 0x55, 0x55, 0xc1, 0x22,
+	0x7f, 0xa3, 0xf2, 0x23, 0xce, 0xc3, 0x37, 0x4d, 0x16, 0xa5, 0xea, 0x26, 0x8a, 0xcf, 0x77, 0xee,
+	0xf9, 0xce, 0xf1, 0x67, 0xdf, 0x6b, 0x10, 0x68, 0xcd, 0x26, 0x26, 0x45, 0x6a, 0xb1, 0x82, 0x8b,
+	0x6a, 0x7d, 0x4b, 0x75, 0x4e, 0x95, 0x2a, 0xc1, 0x0e, 0xe6, 0xa7, 0x82, 0x8a, 0xe2, 0x56, 0x94,
+	0xfa, 0x96, 0x38, 0xad, 0x5b, 0xa6, 0x8d, 0x55, 0xef, 0xd7, 0xe7, 0x88, 0xf3, 0x25, 0x4c, 0x2d,
+	0x4c, 0x55, 0x8b, 0x1a, 0xee, 0x5a, 0x8b, 0x1a, 0x41, 0x61, 0xc1, 0x2f, 0x14, 0xbc, 0x2b, 0xd5,
+	0xbf, 0x08, 0x4a, 0x33, 0x06, 0x36, 0xb0, 0x8f, 0xbb, 0xff, 0x02, 0x74, 0xb1, 0xd7, 0x47, 0x55,
+	0x27, 0xba, 0x15, 0xac, 0x91, 0xcf, 0x39, 0x98, 0xca, 0x53, 0xe3, 0x69, 0xb5, 0xac, 0x3b, 0xe8,
+	0xc0, 0xab, 0xf0, 0xbb, 0x90, 0xd4, 0x6b, 0xce, 0x31, 0x26, 0xa6, 0xd3, 0x10, 0xb8, 0x14, 0x97,
+	0x4e, 0xe6, 0x84, 0xab, 0x0f, 0x9b, 0x33, 0x41, 0xb3, 0xbd, 0x72, 0x99, 0x20, 0x4a, 0x0f, 0x1d,
+	0x62, 0xda, 0x86, 0xd6, 0xa6, 0xf2, 0x59, 0x48, 0xf8, 0xda, 0xc2, 0x1f, 0x29, 0x2e, 0x3d, 0xb6,
+	0x3d, 0xaf, 0xf4, 0x04, 0x55, 0xfc, 0x06, 0xb9, 0xe4, 0xc5, 0xd7, 0xe5, 0xd8, 0xfb, 0xe6, 0x59,
+	0x86, 0xd3, 0x82, 0x15, 0xd9, 0xbb, 0xaf, 0x9b, 0x67, 0x99, 0xb6, 0xd6, 0x9b, 0xe6, 0x59, 0x66,
+	0xa5, 0x65, 0xfc, 0x34, 0xb4, 0xde, 0xe3, 0x54, 0x5e, 0x80, 0xf9, 0x1e, 0x48, 0x43, 0xb4, 0x8a,
+	0x6d, 0x8a, 0xe4, 0x0b, 0x0e, 0x26, 0xf3, 0xd4, 0x38, 0xd0, 0x1b, 0xfb, 0x98, 0xe4, 0x2a, 0xb8,
+	0x48, 0xf9, 0x39, 0x48, 0x50, 0xd3, 0xb0, 0x11, 0xf1, 0x43, 0x69, 0xc1, 0x15, 0x2f, 0x01, 0xd8,
+	0xba, 0x85, 0x68, 0x55, 0x2f, 0x21, 0xd7, 0x7b, 0x3c, 0x3d, 0xae, 0x75, 0x20, 0xfc, 0x12, 0x80,
+	0x6b, 0xa0, 0x40, 0xcd, 0x97, 0x88, 0x0a, 0xf1, 0x54, 0x3c, 0x3d, 0xa1, 0x25, 0x5d, 0xe4, 0xd0,
+	0x05, 0xf8, 0x0d, 0x98, 0xa6, 0xc7, 0x3a, 0x41, 0x85, 0x12, 0xb6, 0x2c, 0xd3, 0xb1, 0x90, 0xed,
+	0x50, 0xe1, 0x4f, 0x4f, 0xe5, 0x2f, 0xaf, 0xf0, 0xa0, 0x8d, 0xf3, 0xab, 0x30, 0xe9, 0x93, 0xeb,
+	0x88, 0x50, 0x13, 0xdb, 0x54, 0x18, 0xf5, 0xf4, 0x26, 0x3c, 0xf4, 0x59, 0x00, 0x66, 0xc7, 0xdc,
+	0xdb, 0x11, 0xf8, 0x93, 0x05, 0x98, 0xeb, 0x4e, 0x12, 0x86, 0x3c, 0xe7, 0x60, 0x26, 0x4f, 0x0d,
+	0x0d, 0x19, 0x26, 0x75, 0x10, 0x79, 0xd4, 0xf2, 0xcc, 0x2b, 0x30, 0x82, 0x4f, 0xc2, 0xa4, 0x3f,
+	0x18, 0x9f, 0x4f, 0xe3, 0x17, 0x21, 0x19, 0x06, 0xf6, 0xa6, 0x37, 0xae, 0xb5, 0x01, 0xf7, 0x06,
+	0x11, 0x44, 0x1d, 0x62, 0x96, 0x1c, 0x54, 0x16, 0xe2, 0x29, 0x2e, 0x3d, 0xaa, 0x75, 0x20, 0xd9,
+	0x1d, 0xd7, 0xad, 0xaf, 0xe4, 0x0e, 0x6e, 0x8d, 0x39, 0xb8, 0x88, 0x49, 0x59, 0x82, 0x45, 0x16,
+	0x1e, 0xa6, 0x7b, 0xc7, 0x79, 0xc1, 0x1f, 0x22, 0x72, 0xbb, 0xf9, 0xb2, 0xf7, 0xba, 0xfd, 0xa7,
+	0x99, 0xfe, 0x19, 0x36, 0xe4, 0x14, 0x48, 0xec, 0x4a, 0x98, 0xe1, 0xca, 0x9f, 0xd0, 0x13, 0xa2,
+	0xdb, 0xf4, 0xc5, 0xed, 0x4d, 0x68, 0x07, 0x92, 0x36, 0x3a, 0x29, 0xf8, 0x8a, 0xf1, 0x01, 0x8a,
+	0xa3, 0x36, 0x3a, 0x79, 0xec, 0x32, 0x87, 0x1b, 0x5c, 0xc4, 0x7b, 0x30, 0xb8, 0x08, 0x1e, 0x86,
+	0xfe, 0xc4, 0xc1, 0x6c, 0x9e, 0x1a, 0x7b, 0xe5, 0x72, 0x58, 0x3b, 0xc0, 0xee, 0xfd, 0xf9, 0xc9,
+	0xa9, 0xff, 0x87, 0x44, 0xd5, 0xd3, 0x1d, 0x18, 0x39, 0xe0, 0x65, 0x77, 0xbb, 0x03, 0xaf, 0x33,
+	0x03, 0x47, 0x7d, 0xcb, 0xcb, 0xb0, 0xc4, 0x2c, 0x84, 0x91, 0x3f, 0x73, 0x20, 0x78, 0x0f, 0xb3,
+	0x85, 0xeb, 0xe8, 0x77, 0x4b, 0x7d, 0xbf, 0x3b, 0x75, 0xa6, 0xcf, 0xfb, 0xc9, 0xb0, 0x2e, 0xcb,
+	0x90, 0xea, 0x57, 0x0b, 0xb3, 0x7f, 0xe4, 0x60, 0x21, 0x4f, 0x8d, 0x43, 0xe4, 0x74, 0x3e, 0x0a,
+	0xc1, 0xe6, 0xf0, 0x8b, 0xb7, 0xa2, 0x6c, 0x77, 0xd4, 0x0d, 0x66, 0x54, 0xb6, 0x53, 0xf9, 0x1f,
+	0x58, 0xe9, 0x5b, 0x6c, 0x85, 0xdd, 0x6e, 0x26, 0x20, 0x9e, 0xa7, 0x06, 0x7f, 0x04, 0xe3, 0x5d,
+	0x87, 0x66, 0x2a, 0x72, 0xd8, 0xf5, 0x9c, 0x4c, 0x62, 0x7a, 0x10, 0xa3, 0xd5, 0x83, 0x7f, 0x0e,
+	0x63, 0x9d, 0xe7, 0xd6, 0x32, 0x6b, 0x61, 0x07, 0x41, 0x5c, 0x1f, 0x40, 0x08, 0x85, 0x4d, 0x98,
+	0x8e, 0x9e, 0x15, 0xab, 0xac, 0xd5, 0x11, 0x9a, 0xb8, 0x39, 0x14, 0x2d, 0x6c, 0x85, 0xe1, 0x6f,
+	0xd6, 0xc6, 0xcd, 0xb4, 0xca, 0x20, 0x8a, 0xea, 0x90, 0xc4, 0xce, 0x6c, 0xd1, 0x5d, 0x96, 0x99,
+	0x2d, 0x42, 0x13, 0x37, 0x87, 0xa2, 0x85, 0xad, 0x2a, 0xc0, 0x33, 0xf6, 0xb6, 0x35, 0x96, 0x48,
+	0x94, 0x27, 0x2a, 0xc3, 0xf1, 0xc2, 0x6e, 0x35, 0x98, 0x65, 0x6f, 0x2b, 0xff, 0xb2, 0x27, 0xc2,
+	0xa0, 0x8a, 0x5b, 0x43, 0x53, 0xc3, 0xb6, 0xa7, 0x30, 0xd7, 0xe7, 0x8d, 0xce, 0xb0, 0xc4, 0xd8,
+	0x5c, 0x71, 0x7b, 0x78, 0x6e, 0xab, 0xb3, 0x38, 0xf2, 0xca, 0xfd, 0x34, 0xcc, 0xed, 0x5f, 0x5c,
+	0x4b, 0xdc, 0xe5, 0xb5, 0xc4, 0x7d, 0xbb, 0x96, 0xb8, 0xb7, 0x37, 0x52, 0xec, 0xf2, 0x46, 0x8a,
+	0x7d, 0xb9, 0x91, 0x62, 0x47, 0xff, 0x19, 0xa6, 0x73, 0x5c, 0x2b, 0x2a, 0x25, 0x6c, 0xa9, 0x81,
+	0x7c, 0x45, 0x6f, 0x20, 0xa2, 0xf6, 0xbc, 0xed, 0x4e, 0xa3, 0x8a, 0x68, 0x31, 0xe1, 0x7d, 0xe9,
+	0xde, 0xf9, 0x3e, 0x00, 0xbe, 0x87, 0xe1, 0x4f, 0x91, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
+	// RegisterNamespace registers a namespace owned by the signer against the
+	// namespace registration deposit.
+	RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error)
+	// DeregisterNamespace deregisters a namespace and returns its deposit to
+	// the owner.
+	DeregisterNamespace(ctx context.Context, in *MsgDeregisterNamespace, opts ...grpc.CallOption) (*MsgDeregisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace.
+	TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error)
+	// AddNamespacePoster delegates the posting rights of a namespace.
+	AddNamespacePoster(ctx context.Context, in *MsgAddNamespacePoster, opts ...grpc.CallOption) (*MsgAddNamespacePosterResponse, error)
+	// RemoveNamespacePoster revokes the posting rights of a namespace.
+	RemoveNamespacePoster(ctx context.Context, in *MsgRemoveNamespacePoster, opts ...grpc.CallOption) (*MsgRemoveNamespacePosterResponse, error)
+	// SetNamespaceRestricted sets whether only the owner and the posters of a
+	// namespace can pay for blobs in it.
+	SetNamespaceRestricted(ctx context.Context, in *MsgSetNamespaceRestricted, opts ...grpc.CallOption) (*MsgSetNamespaceRestrictedResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error) {
+	out := new(MsgPayForBlobsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/PayForBlobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error) {
+	out := new(MsgRegisterNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/RegisterNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterNamespace(ctx context.Context, in *MsgDeregisterNamespace, opts ...grpc.CallOption) (*MsgDeregisterNamespaceResponse, error) {
+	out := new(MsgDeregisterNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/DeregisterNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error) {
+	out := new(MsgTransferNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/TransferNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddNamespacePoster(ctx context.Context, in *MsgAddNamespacePoster, opts ...grpc.CallOption) (*MsgAddNamespacePosterResponse, error) {
+	out := new(MsgAddNamespacePosterResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/AddNamespacePoster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNamespacePoster(ctx context.Context, in *MsgRemoveNamespacePoster, opts ...grpc.CallOption) (*MsgRemoveNamespacePosterResponse, error) {
+	out := new(MsgRemoveNamespacePosterResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/RemoveNamespacePoster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNamespaceRestricted(ctx context.Context, in *MsgSetNamespaceRestricted, opts ...grpc.CallOption) (*MsgSetNamespaceRestrictedResponse, error) {
+	out := new(MsgSetNamespaceRestrictedResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blob.v1.Msg/SetNamespaceRestricted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
+	// RegisterNamespace registers a namespace owned by the signer against the
+	// namespace registration deposit.
+	RegisterNamespace(context.Context, *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error)
+	// DeregisterNamespace deregisters a namespace and returns its deposit to
+	// the owner.
+	DeregisterNamespace(context.Context, *MsgDeregisterNamespace) (*MsgDeregisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace.
+	TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error)
+	// AddNamespacePoster delegates the posting rights of a namespace.
+	AddNamespacePoster(context.Context, *MsgAddNamespacePoster) (*MsgAddNamespacePosterResponse, error)
+	// RemoveNamespacePoster revokes the posting rights of a namespace.
+	RemoveNamespacePoster(context.Context, *MsgRemoveNamespacePoster) (*MsgRemoveNamespacePosterResponse, error)
+	// SetNamespaceRestricted sets whether only the owner and the posters of a
+	// namespace can pay for blobs in it.
+	SetNamespaceRestricted(context.Context, *MsgSetNamespaceRestricted) (*MsgSetNamespaceRestrictedResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBlobs) (*MsgPayForBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayForBlobs not implemented")
+}
+func (*UnimplementedMsgServer) RegisterNamespace(ctx context.Context, req *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNamespace not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterNamespace(ctx context.Context, req *MsgDeregisterNamespace) (*MsgDeregisterNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterNamespace not implemented")
+}
+func (*UnimplementedMsgServer) TransferNamespace(ctx context.Context, req *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespace not implemented")
+}
+func (*UnimplementedMsgServer) AddNamespacePoster(ctx context.Context, req *MsgAddNamespacePoster) (*MsgAddNamespacePosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamespacePoster not implemented")
+}
+func (*UnimplementedMsgServer) RemoveNamespacePoster(ctx context.Context, req *MsgRemoveNamespacePoster) (*MsgRemoveNamespacePosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamespacePoster not implemented")
+}
+func (*UnimplementedMsgServer) SetNamespaceRestricted(ctx context.Context, req *MsgSetNamespaceRestricted) (*MsgSetNamespaceRestrictedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceRestricted not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayForBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayForBlobs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayForBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/PayForBlobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayForBlobs(ctx, req.(*MsgPayForBlobs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/RegisterNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterNamespace(ctx, req.(*MsgRegisterNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/DeregisterNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterNamespace(ctx, req.(*MsgDeregisterNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/TransferNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNamespace(ctx, req.(*MsgTransferNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddNamespacePoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNamespacePoster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddNamespacePoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/AddNamespacePoster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddNamespacePoster(ctx, req.(*MsgAddNamespacePoster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNamespacePoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNamespacePoster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNamespacePoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/RemoveNamespacePoster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNamespacePoster(ctx, req.(*MsgRemoveNamespacePoster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNamespaceRestricted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNamespaceRestricted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNamespaceRestricted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blob.v1.Msg/SetNamespaceRestricted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNamespaceRestricted(ctx, req.(*MsgSetNamespaceRestricted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.blob.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "PayForBlobs",
+			Handler:    _Msg_PayForBlobs_Handler,
+		},
+		{
+			MethodName: "RegisterNamespace",
+			Handler:    _Msg_RegisterNamespace_Handler,
+		},
+		{
+			MethodName: "DeregisterNamespace",
+			Handler:    _Msg_DeregisterNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespace",
+			Handler:    _Msg_TransferNamespace_Handler,
+		},
+		{
+			MethodName: "AddNamespacePoster",
+			Handler:    _Msg_AddNamespacePoster_Handler,
+		},
+		{
+			MethodName: "RemoveNamespacePoster",
+			Handler:    _Msg_RemoveNamespacePoster_Handler,
+		},
+		{
+			MethodName: "SetNamespaceRestricted",
+			Handler:    _Msg_SetNamespaceRestricted_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/blob/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayForBlobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForBlobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareVersions) > 0 {
+		dAtA3 := make([]byte, len(m.ShareVersions)*10)
+		var j2 int
+		for _, num := range m.ShareVersions {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ShareCommitments) > 0 {
+		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShareCommitments[iNdEx])
+			copy(dAtA[i:], m.ShareCommitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		dAtA5 := make([]byte, len(m.BlobSizes)*10)
+		var j4 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayForBlobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForBlobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForBlobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNamespacePoster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNamespacePoster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNamespacePoster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Poster) > 0 {
+		i -= len(m.Poster)
+		copy(dAtA[i:], m.Poster)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Poster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddNamespacePosterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddNamespacePosterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddNamespacePosterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNamespacePoster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNamespacePoster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNamespacePoster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Poster) > 0 {
+		i -= len(m.Poster)
+		copy(dAtA[i:], m.Poster)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Poster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNamespacePosterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNamespacePosterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNamespacePosterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamespaceRestricted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamespaceRestricted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamespaceRestricted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamespaceRestrictedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamespaceRestrictedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamespaceRestrictedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPayForBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddNamespacePoster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Poster)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddNamespacePosterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveNamespacePoster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Poster)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveNamespacePosterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNamespaceRestricted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetNamespaceRestrictedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareVersions = append(m.ShareVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareVersions) == 0 {
+					m.ShareVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareVersions = append(m.ShareVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddNamespacePoster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddNamespacePoster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddNamespacePoster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Poster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddNamespacePosterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddNamespacePosterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddNamespacePosterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *MsgPayForBlobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveNamespacePoster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -696,15 +3385,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveNamespacePoster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveNamespacePoster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -732,13 +3421,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -748,24 +3437,57 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Poster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -788,7 +3510,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveNamespacePosterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,10 +3533,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveNamespacePosterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveNamespacePosterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -838,7 +3560,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNamespaceRestricted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -861,15 +3583,15 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNamespaceRestricted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNamespaceRestricted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -897,11 +3619,11 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -928,90 +3650,16 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
-			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlobSizes = append(m.BlobSizes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlobSizes) == 0 {
-					m.BlobSizes = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlobSizes = append(m.BlobSizes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1021,100 +3669,12 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
-			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShareVersions = append(m.ShareVersions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShareVersions) == 0 {
-					m.ShareVersions = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShareVersions = append(m.ShareVersions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
-			}
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1136,7 +3696,7 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNamespaceRestrictedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,10 +3719,10 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNamespaceRestrictedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNamespaceRestrictedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/pkg/proof"
+	"github.com/sunriselayer/sunrise/x/blobstream/types"
+)
+
+// SetDataRoot records the data root of the block at the height. The data
+// roots are kept until a data commitment ending above them is pruned to be
+// able to prove the data root tuples to it.
+func (k Keeper) SetDataRoot(ctx sdk.Context, height uint64, dataRoot []byte) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.GetDataRootKey(height)), dataRoot)
+}
+
+// GetDataRoot returns the data root of the block at the height. Returns (nil,
+// false) if it is not recorded or has been pruned.
+func (k Keeper) GetDataRoot(ctx sdk.Context, height uint64) ([]byte, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get([]byte(types.GetDataRootKey(height)))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// PruneDataRoots deletes the data roots of all the blocks below the height,
+// including the ones not covered by any data commitment.
+func (k Keeper) PruneDataRoots(ctx sdk.Context, beforeHeight uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator([]byte(types.DataRootKey), []byte(types.GetDataRootKey(beforeHeight)))
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllDataRoots returns the data roots of all the blocks recorded in store.
+func (k Keeper) GetAllDataRoots(ctx sdk.Context) []types.DataRoot {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.DataRootKey))
+	defer iterator.Close()
+
+	dataRoots := []types.DataRoot{}
+	for ; iterator.Valid(); iterator.Next() {
+		dataRoots = append(dataRoots, types.DataRoot{
+			Height:   UInt64FromBytes(iterator.Key()[len(types.DataRootKey):]),
+			DataRoot: iterator.Value(),
+		})
+	}
+	return dataRoots
+}
+
+// GetDataRootInclusionProof returns the data commitment including the height,
+// the data root of the block at the height and the merkle proof of its data
+// root tuple to the data commitment. The data commitment root is rebuilt from
+// the data roots of the blocks in its [begin, end) window.
+func (k Keeper) GetDataRootInclusionProof(ctx sdk.Context, height uint64) (types.DataCommitment, []byte, *merkle.Proof, error) {
+	dataCommitment, err := k.GetDataCommitmentForHeight(ctx, height)
+	if err != nil {
+		return types.DataCommitment{}, nil, nil, err
+	}
+
+	dataRoots := make([][]byte, 0, dataCommitment.EndBlock-dataCommitment.BeginBlock)
+	for h := dataCommitment.BeginBlock; h < dataCommitment.EndBlock; h++ {
+		dataRoot, found := k.GetDataRoot(ctx, h)
+		if !found {
+			return types.DataCommitment{}, nil, nil, errors.Wrap(types.ErrDataRootNotFound, fmt.Sprintf("height %d", h))
+		}
+		dataRoots = append(dataRoots, dataRoot)
+	}
+
+	_, dataRootProof, err := proof.NewDataRootInclusionProof(dataCommitment.BeginBlock, dataRoots, height)
+	if err != nil {
+		return types.DataCommitment{}, nil, nil, errors.Wrap(types.ErrInvalidDataRoot, err.Error())
+	}
+	return dataCommitment, dataRoots[height-dataCommitment.BeginBlock], dataRootProof, nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/pkg/proof"
+	testutil "github.com/sunriselayer/sunrise/test/util"
+	"github.com/sunriselayer/sunrise/x/blobstream/types"
+)
+
+func TestDataRootInclusionProof(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	window := uint64(101)
+	k.SetParams(ctx, types.Params{DataCommitmentWindow: window})
+
+	dataRoot := func(height uint64) []byte {
+		return tmhash.Sum(types.UInt64Bytes(height))
+	}
+	for height := uint64(1); height < 2*window+1; height++ {
+		k.SetDataRoot(ctx, height, dataRoot(height))
+	}
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, k, 1, int64(2*window+2), time.Minute)
+
+	// the second data commitment is [102, 203)
+	height := window + 10
+	res, err := k.DataRootInclusionProof(ctx, &types.QueryDataRootInclusionProofRequest{Height: height})
+	require.NoError(t, err)
+	require.Equal(t, window+1, res.DataCommitment.BeginBlock)
+	require.Equal(t, 2*window+1, res.DataCommitment.EndBlock)
+	require.Equal(t, dataRoot(height), res.DataRoot)
+
+	// rebuild the data commitment as signed by the orchestrators
+	tuples := make([][]byte, 0, window)
+	for h := res.DataCommitment.BeginBlock; h < res.DataCommitment.EndBlock; h++ {
+		tuple, err := proof.EncodeDataRootTuple(h, dataRoot(h))
+		require.NoError(t, err)
+		tuples = append(tuples, tuple)
+	}
+	dataCommitment := merkle.HashFromByteSlices(tuples)
+
+	dataRootProof, err := merkle.ProofFromProto(&res.Proof)
+	require.NoError(t, err)
+	require.NoError(t, proof.VerifyDataRootInclusion(*dataRootProof, height, res.DataRoot, dataCommitment))
+
+	// the data commitment has not been generated yet
+	_, err = k.DataRootInclusionProof(ctx, &types.QueryDataRootInclusionProofRequest{Height: 2*window + 10})
+	require.ErrorIs(t, err, types.ErrDataCommitmentNotGenerated)
+
+	// a data root of the window is missing
+	k.PruneDataRoots(ctx, 2)
+	_, err = k.DataRootInclusionProof(ctx, &types.QueryDataRootInclusionProofRequest{Height: 10})
+	require.ErrorIs(t, err, types.ErrDataRootNotFound)
+}
+
+func TestPruneDataRoots(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	for height := uint64(1); height <= 5; height++ {
+		k.SetDataRoot(ctx, height, tmhash.Sum(types.UInt64Bytes(height)))
+	}
+
+	k.PruneDataRoots(ctx, 4)
+	dataRoots := k.GetAllDataRoots(ctx)
+	require.Len(t, dataRoots, 2)
+	require.Equal(t, uint64(4), dataRoots[0].Height)
+	require.Equal(t, uint64(5), dataRoots[1].Height)
+}
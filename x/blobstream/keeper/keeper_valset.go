@@ -58,17 +58,14 @@ func (k Keeper) GetLatestValset(ctx sdk.Context) (*types.Valset, error) {
 	return &currentVs, err
 }
 
-// SetLatestUnBondingBlockHeight sets the latest unbonding block height. Note
-// this value is not saved to state or loaded at genesis. This value is reset to
-// zero on chain upgrade.
+// SetLatestUnBondingBlockHeight sets the latest unbonding block height.
 func (k Keeper) SetLatestUnBondingBlockHeight(ctx sdk.Context, unbondingBlockHeight uint64) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Set([]byte(types.LatestUnBondingBlockHeight), types.UInt64Bytes(unbondingBlockHeight))
 }
 
 // GetLatestUnBondingBlockHeight returns the latest unbonding block height or
-// zero if not set. This value is not saved or loaded at genesis. This value is
-// reset to zero on chain upgrade.
+// zero if not set.
 func (k Keeper) GetLatestUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bytes := store.Get([]byte(types.LatestUnBondingBlockHeight))
@@ -245,3 +242,20 @@ func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Addres
 	}
 	return true
 }
+
+// GetAllEVMAddresses returns the EVM addresses of all the validators
+func (k Keeper) GetAllEVMAddresses(ctx sdk.Context) []types.EVMAddress {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.EvmAddress))
+	defer iterator.Close()
+
+	evmAddresses := []types.EVMAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		valAddress := sdk.ValAddress(iterator.Key()[len(types.EvmAddress):])
+		evmAddresses = append(evmAddresses, types.EVMAddress{
+			ValidatorAddress: valAddress.String(),
+			EvmAddress:       gethcommon.BytesToAddress(iterator.Value()).Hex(),
+		})
+	}
+	return evmAddresses
+}
@@ -30,3 +30,18 @@ func (k Keeper) LatestDataCommitment(
 		DataCommitment: &resp,
 	}, nil
 }
+
+func (k Keeper) DataRootInclusionProof(
+	c context.Context,
+	request *types.QueryDataRootInclusionProofRequest,
+) (*types.QueryDataRootInclusionProofResponse, error) {
+	dataCommitment, dataRoot, proof, err := k.GetDataRootInclusionProof(sdk.UnwrapSDKContext(c), request.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDataRootInclusionProofResponse{
+		DataCommitment: &dataCommitment,
+		DataRoot:       dataRoot,
+		Proof:          *proof.ToProto(),
+	}, nil
+}
@@ -174,6 +174,11 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 			// unexpired persist the new earliest available attestation nonce
 			break
 		}
+		if dataCommitment, ok := newEarliestAttestation.(*types.DataCommitment); ok {
+			// the data roots are only needed to prove inclusion in the
+			// unpruned data commitments, which are all above this one
+			k.PruneDataRoots(ctx, dataCommitment.EndBlock)
+		}
 		k.DeleteAttestation(ctx, newEarliestAvailableNonce)
 	}
 	if newEarliestAvailableNonce > earliestNonce {
@@ -527,6 +527,9 @@ func TestPruning(t *testing.T) {
 	bsKeeper.SetParams(ctx, types.Params{DataCommitmentWindow: window})
 	initialBlockTime := ctx.BlockTime()
 	blockInterval := 10 * time.Minute
+	for height := uint64(1); height < 1626; height++ {
+		bsKeeper.SetDataRoot(ctx, height, make([]byte, 32))
+	}
 	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1626, blockInterval)
 
 	// check that we created a number of attestations
@@ -552,6 +555,16 @@ func TestPruning(t *testing.T) {
 		assert.False(t, found)
 	}
 
+	// check that the data roots of the pruned data commitments were pruned
+	earliestDataCommitment, found, err := bsKeeper.GetAttestationByNonce(ctx, earliestAttestationNonce)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	earliestBeginBlock := earliestDataCommitment.(*types.DataCommitment).BeginBlock
+	for height := uint64(1); height < 1626; height++ {
+		_, found := bsKeeper.GetDataRoot(ctx, height)
+		assert.Equal(t, height >= earliestBeginBlock, found)
+	}
+
 	// check that the attestations after those still exist
 	for nonce := bsKeeper.GetEarliestAvailableAttestationNonce(ctx); nonce <= bsKeeper.GetLatestAttestationNonce(ctx); nonce++ {
 		at, found, err := bsKeeper.GetAttestationByNonce(ctx, nonce)
@@ -23,6 +23,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows the data commitment range for a given height",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
 				},
+				{
+					RpcMethod:      "DataRootInclusionProof",
+					Use:            "data-root-inclusion-proof [height]",
+					Short:          "Shows the inclusion proof of the data root tuple of a given height to its data commitment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -1,7 +1,10 @@
 package blobstream
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/sunriselayer/sunrise/x/blobstream/keeper"
 	"github.com/sunriselayer/sunrise/x/blobstream/types"
@@ -10,20 +13,35 @@ import (
 const (
 	// InitialLatestAttestationNonce the initial value set in genesis of the latest attestation
 	// nonce value in store.
-	InitialLatestAttestationNonce = uint64(0)
+	InitialLatestAttestationNonce = types.InitialLatestAttestationNonce
 	// InitialEarliestAvailableAttestationNonce the initial value set in genesis of the earliest
 	/// available attestation nonce in store.
-	InitialEarliestAvailableAttestationNonce = uint64(1)
+	InitialEarliestAvailableAttestationNonce = types.InitialEarliestAvailableAttestationNonce
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	k.SetLatestAttestationNonce(ctx, InitialLatestAttestationNonce)
-	// The reason we're setting the earliest available nonce to 1 is because at
-	// chain startup, a new valset will always be created. Also, it's easier to
-	// set it once here rather than conditionally setting it in abci.EndBlocker
-	// which is executed on every block.
-	k.SetEarliestAvailableAttestationNonce(ctx, InitialEarliestAvailableAttestationNonce)
+	for _, at := range genState.AttestationRequests() {
+		k.StoreAttestation(ctx, at)
+	}
+	// On a new chain the latest nonce is 0 and the earliest available nonce is 1
+	// because at chain startup, a new valset will always be created. Also, it's
+	// easier to set it once here rather than conditionally setting it in
+	// abci.EndBlocker which is executed on every block.
+	k.SetLatestAttestationNonce(ctx, genState.LatestAttestationNonce)
+	k.SetEarliestAvailableAttestationNonce(ctx, genState.EarliestAvailableAttestationNonce)
+	k.SetLatestUnBondingBlockHeight(ctx, genState.LatestUnbondingHeight)
+
+	for _, evmAddress := range genState.EvmAddresses {
+		valAddress, err := sdk.ValAddressFromBech32(evmAddress.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEVMAddress(ctx, valAddress, gethcommon.HexToAddress(evmAddress.EvmAddress))
+	}
+	for _, dataRoot := range genState.DataRoots {
+		k.SetDataRoot(ctx, dataRoot.Height, dataRoot.DataRoot)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -33,6 +51,31 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	if k.CheckLatestAttestationNonce(ctx) && k.CheckEarliestAvailableAttestationNonce(ctx) {
+		genesis.LatestAttestationNonce = k.GetLatestAttestationNonce(ctx)
+		genesis.EarliestAvailableAttestationNonce = k.GetEarliestAvailableAttestationNonce(ctx)
+		for nonce := genesis.EarliestAvailableAttestationNonce; nonce <= genesis.LatestAttestationNonce; nonce++ {
+			at, found, err := k.GetAttestationByNonce(ctx, nonce)
+			if err != nil {
+				panic(err)
+			}
+			if !found {
+				panic(fmt.Sprintf("attestation not found for nonce %d", nonce))
+			}
+			switch at := at.(type) {
+			case *types.Valset:
+				genesis.Valsets = append(genesis.Valsets, *at)
+			case *types.DataCommitment:
+				genesis.DataCommitments = append(genesis.DataCommitments, *at)
+			default:
+				panic(types.ErrUnknownAttestationType)
+			}
+		}
+	}
+	genesis.LatestUnbondingHeight = k.GetLatestUnBondingBlockHeight(ctx)
+	genesis.EvmAddresses = k.GetAllEVMAddresses(ctx)
+	genesis.DataRoots = k.GetAllDataRoots(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -1,22 +1,57 @@
 package blobstream_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
 	stream "github.com/sunriselayer/sunrise/x/blobstream/module"
 	"github.com/sunriselayer/sunrise/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	blockTime := time.Unix(1000, 0).UTC()
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: types.DefaultGenesis().Params,
+		Valsets: []types.Valset{
+			{
+				Nonce:   2,
+				Members: []types.BridgeValidator{{Power: 100, EvmAddress: "0x0000000000000000000000000000000000000001"}},
+				Height:  10,
+				Time:    blockTime,
+			},
+		},
+		DataCommitments: []types.DataCommitment{
+			{Nonce: 3, BeginBlock: 1, EndBlock: 401, Time: blockTime},
+			{Nonce: 4, BeginBlock: 401, EndBlock: 801, Time: blockTime},
+		},
+		LatestAttestationNonce:            4,
+		EarliestAvailableAttestationNonce: 2,
+		LatestUnbondingHeight:             7,
+		EvmAddresses: []types.EVMAddress{
+			{
+				ValidatorAddress: sdk.ValAddress("validator1").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x0000000000000000000000000000000000000001").Hex(),
+			},
+			{
+				ValidatorAddress: sdk.ValAddress("validator2").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x0000000000000000000000000000000000000002").Hex(),
+			},
+		},
+		DataRoots: []types.DataRoot{
+			{Height: 1, DataRoot: bytes.Repeat([]byte{1}, 32)},
+			{Height: 2, DataRoot: bytes.Repeat([]byte{2}, 32)},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
 	k, ctx := keepertest.StreamKeeper(t)
 	stream.InitGenesis(ctx, k, genesisState)
@@ -26,5 +61,24 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.Valsets, got.Valsets)
+	require.Equal(t, genesisState.DataCommitments, got.DataCommitments)
+	require.Equal(t, genesisState.LatestAttestationNonce, got.LatestAttestationNonce)
+	require.Equal(t, genesisState.EarliestAvailableAttestationNonce, got.EarliestAvailableAttestationNonce)
+	require.Equal(t, genesisState.LatestUnbondingHeight, got.LatestUnbondingHeight)
+	require.ElementsMatch(t, genesisState.EvmAddresses, got.EvmAddresses)
+	require.Equal(t, genesisState.DataRoots, got.DataRoots)
 	// this line is used by starport scaffolding # genesis/test/assert
+
+	// The next attestation continues the imported nonces
+	require.NotPanics(t, func() {
+		require.NoError(t, k.SetAttestationRequest(ctx, &types.DataCommitment{Nonce: 5, BeginBlock: 801, EndBlock: 1201, Time: blockTime}))
+	})
+}
+
+func TestDefaultGenesis(t *testing.T) {
+	k, ctx := keepertest.StreamKeeper(t)
+	stream.InitGenesis(ctx, k, *types.DefaultGenesis())
+	got := stream.ExportGenesis(ctx, k)
+	require.Equal(t, types.DefaultGenesis(), got)
 }
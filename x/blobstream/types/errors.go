@@ -32,4 +32,7 @@ var (
 	ErrEVMAddressNotHex                          = sdkerrors.Register(ModuleName, 36, "the provided evm address is not a valid hex address")
 	ErrEVMAddressAlreadyExists                   = sdkerrors.Register(ModuleName, 37, "the provided evm address already exists")
 	ErrEVMAddressNotFound                        = sdkerrors.Register(ModuleName, 38, "EVM address not found")
+	ErrAttestationNoncesNotContinuous            = sdkerrors.Register(ModuleName, 39, "attestation nonces are not continuous")
+	ErrDataRootNotFound                          = sdkerrors.Register(ModuleName, 40, "data root not found")
+	ErrInvalidDataRoot                           = sdkerrors.Register(ModuleName, 41, "invalid data root")
 )
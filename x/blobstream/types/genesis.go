@@ -2,10 +2,14 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/sunriselayer/sunrise/pkg/appconsts"
 
 	"cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultParamspace defines the default blobstream module parameter subspace
@@ -21,12 +25,27 @@ const (
 // DataCommitmentWindow param.
 var ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
 
+const (
+	// InitialLatestAttestationNonce the initial value set in genesis of the latest attestation
+	// nonce value in store.
+	InitialLatestAttestationNonce = uint64(0)
+	// InitialEarliestAvailableAttestationNonce the initial value set in genesis of the earliest
+	// available attestation nonce in store.
+	InitialEarliestAvailableAttestationNonce = uint64(1)
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: Params{
 			DataCommitmentWindow: 400,
 		},
+		Valsets:                           []Valset{},
+		DataCommitments:                   []DataCommitment{},
+		LatestAttestationNonce:            InitialLatestAttestationNonce,
+		EarliestAvailableAttestationNonce: InitialEarliestAvailableAttestationNonce,
+		EvmAddresses:                      []EVMAddress{},
+		DataRoots:                         []DataRoot{},
 	}
 }
 
@@ -37,9 +56,84 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return errors.Wrap(err, "params")
 	}
+
+	// The attestations must cover every nonce from the earliest available one to the latest one
+	if gs.EarliestAvailableAttestationNonce == 0 {
+		return errors.Wrap(ErrAttestationNoncesNotContinuous, "earliest available attestation nonce must be positive")
+	}
+	if gs.EarliestAvailableAttestationNonce > gs.LatestAttestationNonce+1 {
+		return errors.Wrapf(ErrAttestationNoncesNotContinuous, "earliest available nonce %d is after latest nonce %d",
+			gs.EarliestAvailableAttestationNonce, gs.LatestAttestationNonce)
+	}
+	nonces := make(map[uint64]bool)
+	for _, at := range gs.AttestationRequests() {
+		nonce := at.GetNonce()
+		if nonces[nonce] {
+			return errors.Wrapf(ErrDuplicate, "attestation nonce %d", nonce)
+		}
+		if nonce < gs.EarliestAvailableAttestationNonce || nonce > gs.LatestAttestationNonce {
+			return errors.Wrapf(ErrAttestationNoncesNotContinuous, "attestation nonce %d out of range [%d, %d]",
+				nonce, gs.EarliestAvailableAttestationNonce, gs.LatestAttestationNonce)
+		}
+		nonces[nonce] = true
+	}
+	if uint64(len(nonces)) != gs.LatestAttestationNonce+1-gs.EarliestAvailableAttestationNonce {
+		return errors.Wrapf(ErrAttestationNoncesNotContinuous, "missing attestations in range [%d, %d]",
+			gs.EarliestAvailableAttestationNonce, gs.LatestAttestationNonce)
+	}
+
+	// Each validator has one EVM address which is unique among the validators
+	validators := make(map[string]bool)
+	evmAddresses := make(map[gethcommon.Address]bool)
+	for _, evmAddress := range gs.EvmAddresses {
+		if _, err := sdk.ValAddressFromBech32(evmAddress.ValidatorAddress); err != nil {
+			return errors.Wrap(ErrInvalidValAddress, err.Error())
+		}
+		if validators[evmAddress.ValidatorAddress] {
+			return errors.Wrapf(ErrDuplicate, "evm address of validator %s", evmAddress.ValidatorAddress)
+		}
+		validators[evmAddress.ValidatorAddress] = true
+
+		if !gethcommon.IsHexAddress(evmAddress.EvmAddress) {
+			return errors.Wrap(ErrEVMAddressNotHex, evmAddress.EvmAddress)
+		}
+		addr := gethcommon.HexToAddress(evmAddress.EvmAddress)
+		if evmAddresses[addr] {
+			return errors.Wrap(ErrEVMAddressAlreadyExists, evmAddress.EvmAddress)
+		}
+		evmAddresses[addr] = true
+	}
+
+	heights := make(map[uint64]bool)
+	for _, dataRoot := range gs.DataRoots {
+		if heights[dataRoot.Height] {
+			return errors.Wrapf(ErrDuplicate, "data root of height %d", dataRoot.Height)
+		}
+		heights[dataRoot.Height] = true
+
+		if len(dataRoot.DataRoot) != tmhash.Size {
+			return errors.Wrapf(ErrInvalidDataRoot, "data root of height %d has size %d", dataRoot.Height, len(dataRoot.DataRoot))
+		}
+	}
+
 	return nil
 }
 
+// AttestationRequests returns the valsets and data commitments of the genesis state sorted by nonce
+func (gs GenesisState) AttestationRequests() []AttestationRequestI {
+	ats := make([]AttestationRequestI, 0, len(gs.Valsets)+len(gs.DataCommitments))
+	for i := range gs.Valsets {
+		ats = append(ats, &gs.Valsets[i])
+	}
+	for i := range gs.DataCommitments {
+		ats = append(ats, &gs.DataCommitments[i])
+	}
+	sort.SliceStable(ats, func(i, j int) bool {
+		return ats[i].GetNonce() < ats[j].GetNonce()
+	})
+	return ats
+}
+
 func validateDataCommitmentWindow(i interface{}) error {
 	val, ok := i.(uint64)
 	if !ok {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +29,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// Valset attestations in store
+	Valsets []Valset `protobuf:"bytes,2,rep,name=valsets,proto3" json:"valsets"`
+	// DataCommitment attestations in store
+	DataCommitments []DataCommitment `protobuf:"bytes,3,rep,name=data_commitments,json=dataCommitments,proto3" json:"data_commitments"`
+	// Nonce of the latest attestation
+	LatestAttestationNonce uint64 `protobuf:"varint,4,opt,name=latest_attestation_nonce,json=latestAttestationNonce,proto3" json:"latest_attestation_nonce,omitempty"`
+	// Nonce of the earliest attestation that has not been pruned
+	EarliestAvailableAttestationNonce uint64 `protobuf:"varint,5,opt,name=earliest_available_attestation_nonce,json=earliestAvailableAttestationNonce,proto3" json:"earliest_available_attestation_nonce,omitempty"`
+	// Latest validator unbonding block height
+	LatestUnbondingHeight uint64 `protobuf:"varint,6,opt,name=latest_unbonding_height,json=latestUnbondingHeight,proto3" json:"latest_unbonding_height,omitempty"`
+	// EVM addresses registered by validators
+	EvmAddresses []EVMAddress `protobuf:"bytes,7,rep,name=evm_addresses,json=evmAddresses,proto3" json:"evm_addresses"`
+	// Data roots of the blocks recorded for the data root tuple proofs
+	DataRoots []DataRoot `protobuf:"bytes,8,rep,name=data_roots,json=dataRoots,proto3" json:"data_roots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,8 +85,165 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetValsets() []Valset {
+	if m != nil {
+		return m.Valsets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataCommitments() []DataCommitment {
+	if m != nil {
+		return m.DataCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLatestAttestationNonce() uint64 {
+	if m != nil {
+		return m.LatestAttestationNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEarliestAvailableAttestationNonce() uint64 {
+	if m != nil {
+		return m.EarliestAvailableAttestationNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLatestUnbondingHeight() uint64 {
+	if m != nil {
+		return m.LatestUnbondingHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEvmAddresses() []EVMAddress {
+	if m != nil {
+		return m.EvmAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataRoots() []DataRoot {
+	if m != nil {
+		return m.DataRoots
+	}
+	return nil
+}
+
+// EVMAddress is the EVM address of a validator
+type EVMAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EvmAddress       string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *EVMAddress) Reset()         { *m = EVMAddress{} }
+func (m *EVMAddress) String() string { return proto.CompactTextString(m) }
+func (*EVMAddress) ProtoMessage()    {}
+func (*EVMAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d77699c1dc0f866f, []int{1}
+}
+func (m *EVMAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMAddress.Merge(m, src)
+}
+func (m *EVMAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMAddress proto.InternalMessageInfo
+
+func (m *EVMAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EVMAddress) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+// DataRoot is the data root of the block at a height
+type DataRoot struct {
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *DataRoot) Reset()         { *m = DataRoot{} }
+func (m *DataRoot) String() string { return proto.CompactTextString(m) }
+func (*DataRoot) ProtoMessage()    {}
+func (*DataRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d77699c1dc0f866f, []int{2}
+}
+func (m *DataRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRoot.Merge(m, src)
+}
+func (m *DataRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRoot proto.InternalMessageInfo
+
+func (m *DataRoot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DataRoot) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.blobstream.v1.GenesisState")
+	proto.RegisterType((*EVMAddress)(nil), "sunrise.blobstream.v1.EVMAddress")
+	proto.RegisterType((*DataRoot)(nil), "sunrise.blobstream.v1.DataRoot")
 }
 
 func init() {
@@ -79,21 +251,42 @@ func init() {
 }
 
 var fileDescriptor_d77699c1dc0f866f = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xca, 0xc9, 0x4f, 0x2a, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0xd5, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x2a, 0xd2, 0x43, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05,
-	0x15, 0x55, 0xc2, 0x6e, 0x49, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x0e, 0xa5, 0x00, 0x2e, 0x1e,
-	0x77, 0x88, 0xa5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x0e, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x59, 0x3d, 0xac, 0x8e, 0xd0, 0x0b, 0x00, 0x2b, 0x72, 0xe2, 0x3c,
-	0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x7d, 0x4e, 0x3e, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x35, 0x35, 0x27, 0xb1, 0x32, 0xb5, 0x08, 0xc6, 0xd1, 0xaf, 0x40,
-	0x76, 0x69, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x99, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x75, 0xf0, 0xee, 0xf5, 0x31, 0x01, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x90, 0x26, 0xdb, 0x20, 0xda, 0x15, 0x2d, 0xa6, 0xa8, 0xce, 0x07, 0x20, 0x55,
+	0x48, 0xd8, 0x6a, 0x91, 0x10, 0x17, 0x04, 0x09, 0x45, 0x70, 0x28, 0x05, 0xb9, 0x22, 0x07, 0x2e,
+	0xd6, 0x38, 0x5e, 0x39, 0x2b, 0xd9, 0xbb, 0x91, 0x77, 0x63, 0xd1, 0x03, 0xff, 0x81, 0x9f, 0xc1,
+	0x91, 0x03, 0x67, 0xce, 0x3d, 0x56, 0x9c, 0x38, 0x21, 0x94, 0x1c, 0xf8, 0x1b, 0xc8, 0xeb, 0xdd,
+	0xa6, 0xd0, 0xb4, 0x17, 0x7b, 0x67, 0xde, 0x9b, 0xb7, 0x33, 0x4f, 0x3b, 0xe8, 0xae, 0x98, 0xb0,
+	0x8c, 0x0a, 0xe2, 0x85, 0x09, 0x0f, 0x85, 0xcc, 0x08, 0xa4, 0x5e, 0xbe, 0xeb, 0xc5, 0x84, 0x11,
+	0x41, 0x85, 0x3b, 0xce, 0xb8, 0xe4, 0x78, 0x43, 0x93, 0xdc, 0x39, 0xc9, 0xcd, 0x77, 0xb7, 0xd6,
+	0x21, 0xa5, 0x8c, 0x7b, 0xea, 0x5b, 0x32, 0xb7, 0x6e, 0xc6, 0x3c, 0xe6, 0xea, 0xe8, 0x15, 0x27,
+	0x9d, 0xbd, 0x3d, 0xe4, 0x22, 0xe5, 0x22, 0x28, 0x81, 0x32, 0xd0, 0x50, 0x77, 0xf1, 0xfd, 0x63,
+	0xc8, 0x20, 0x35, 0x9c, 0xce, 0x62, 0x8e, 0x3c, 0x1e, 0x13, 0x4d, 0xe9, 0x7e, 0xaf, 0xa2, 0xe6,
+	0xab, 0xb2, 0xe7, 0x23, 0x09, 0x92, 0xe0, 0xe7, 0xa8, 0x56, 0x6a, 0xd8, 0x56, 0xdb, 0xda, 0x59,
+	0xdd, 0xdb, 0x76, 0x17, 0xce, 0xe0, 0xbe, 0x53, 0xa4, 0x7e, 0xe3, 0xe4, 0x57, 0xab, 0xf2, 0xe5,
+	0xcf, 0xd7, 0x07, 0x96, 0xaf, 0xeb, 0xf0, 0x53, 0xb4, 0x92, 0x43, 0x22, 0x88, 0x14, 0xf6, 0x52,
+	0x7b, 0xf9, 0x0a, 0x89, 0x81, 0x62, 0xf5, 0xab, 0x85, 0x84, 0x6f, 0x6a, 0xf0, 0x00, 0xad, 0x45,
+	0x20, 0x21, 0x18, 0xf2, 0x34, 0xa5, 0x32, 0x25, 0x4c, 0x0a, 0x7b, 0x59, 0xe9, 0xdc, 0xbf, 0x44,
+	0x67, 0x1f, 0x24, 0xbc, 0x38, 0x63, 0x6b, 0xbd, 0x1b, 0xd1, 0x3f, 0x59, 0x81, 0x9f, 0x20, 0x3b,
+	0x01, 0x49, 0x84, 0x0c, 0x40, 0x16, 0x3f, 0x90, 0x94, 0xb3, 0x80, 0x71, 0x36, 0x24, 0x76, 0xb5,
+	0x6d, 0xed, 0x54, 0xfd, 0xcd, 0x12, 0xef, 0xcd, 0xe1, 0xc3, 0x02, 0xc5, 0x6f, 0xd1, 0x3d, 0x02,
+	0x59, 0x42, 0x55, 0x6d, 0x0e, 0x34, 0x81, 0x30, 0x21, 0x0b, 0x54, 0xae, 0x29, 0x95, 0x8e, 0xe1,
+	0xf6, 0x0c, 0xf5, 0x82, 0xe0, 0x63, 0x74, 0x4b, 0xb7, 0x32, 0x61, 0x21, 0x67, 0x11, 0x65, 0x71,
+	0x30, 0x22, 0x34, 0x1e, 0x49, 0xbb, 0xa6, 0x34, 0x36, 0x4a, 0xf8, 0xbd, 0x41, 0x5f, 0x2b, 0x10,
+	0x1f, 0xa0, 0xeb, 0x24, 0x4f, 0x03, 0x88, 0xa2, 0x8c, 0x08, 0x41, 0x84, 0xbd, 0xa2, 0x7c, 0xe9,
+	0x5c, 0xe2, 0xcb, 0xcb, 0xc1, 0x9b, 0x5e, 0x49, 0xd5, 0x9e, 0x34, 0x49, 0x9e, 0xf6, 0x4c, 0x31,
+	0xde, 0x47, 0x48, 0x19, 0x9d, 0x71, 0x2e, 0x85, 0x5d, 0x57, 0x52, 0xad, 0x2b, 0x2c, 0xf6, 0x39,
+	0x37, 0xe6, 0x36, 0x22, 0x1d, 0x8b, 0xee, 0x27, 0x84, 0xe6, 0xf7, 0xe0, 0x43, 0xb4, 0x9e, 0x43,
+	0x42, 0x23, 0x90, 0x3c, 0x33, 0x7d, 0xaa, 0x87, 0xd4, 0xe8, 0x77, 0x7e, 0x7c, 0x7b, 0xb8, 0xad,
+	0x9f, 0xf0, 0xc0, 0x70, 0x74, 0xdd, 0x91, 0xcc, 0x28, 0x8b, 0xfd, 0xb5, 0xfc, 0xbf, 0x3c, 0x6e,
+	0xa1, 0xd5, 0x73, 0x13, 0xdb, 0x4b, 0x85, 0x92, 0x8f, 0xe6, 0x63, 0x74, 0x9f, 0xa1, 0xba, 0xe9,
+	0x0d, 0x6f, 0xa2, 0x9a, 0x76, 0xd1, 0x52, 0x2e, 0xea, 0x08, 0xdf, 0x41, 0x8d, 0xb3, 0x41, 0x95,
+	0x44, 0xd3, 0xaf, 0x9b, 0x01, 0xfa, 0x07, 0x27, 0x53, 0xc7, 0x3a, 0x9d, 0x3a, 0xd6, 0xef, 0xa9,
+	0x63, 0x7d, 0x9e, 0x39, 0x95, 0xd3, 0x99, 0x53, 0xf9, 0x39, 0x73, 0x2a, 0x1f, 0xf6, 0x62, 0x2a,
+	0x47, 0x93, 0xd0, 0x1d, 0xf2, 0xd4, 0xd3, 0xae, 0x24, 0x70, 0x4c, 0x32, 0x13, 0x78, 0x1f, 0xcf,
+	0xef, 0x95, 0x5a, 0xaa, 0xb0, 0xa6, 0xb6, 0xea, 0xd1, 0xdf, 0x01, 0x00, 0xfc, 0x7c, 0x17, 0xae,
+	0x1e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +309,77 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataRoots) > 0 {
+		for iNdEx := len(m.DataRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EvmAddresses) > 0 {
+		for iNdEx := len(m.EvmAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LatestUnbondingHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestUnbondingHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EarliestAvailableAttestationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarliestAvailableAttestationNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestAttestationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestAttestationNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DataCommitments) > 0 {
+		for iNdEx := len(m.DataCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -129,6 +393,78 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EVMAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -148,6 +484,72 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataCommitments) > 0 {
+		for _, e := range m.DataCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LatestAttestationNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestAttestationNonce))
+	}
+	if m.EarliestAvailableAttestationNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EarliestAvailableAttestationNonce))
+	}
+	if m.LatestUnbondingHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestUnbondingHeight))
+	}
+	if len(m.EvmAddresses) > 0 {
+		for _, e := range m.EvmAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataRoots) > 0 {
+		for _, e := range m.DataRoots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EVMAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DataRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -219,6 +621,416 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataCommitments = append(m.DataCommitments, DataCommitment{})
+			if err := m.DataCommitments[len(m.DataCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestAttestationNonce", wireType)
+			}
+			m.LatestAttestationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestAttestationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestAvailableAttestationNonce", wireType)
+			}
+			m.EarliestAvailableAttestationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestAvailableAttestationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestUnbondingHeight", wireType)
+			}
+			m.LatestUnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestUnbondingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddresses = append(m.EvmAddresses, EVMAddress{})
+			if err := m.EvmAddresses[len(m.EvmAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoots = append(m.DataRoots, DataRoot{})
+			if err := m.DataRoots[len(m.DataRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,10 +5,14 @@ import (
 
 	"github.com/sunriselayer/sunrise/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	evmAddress1 := "0x0000000000000000000000000000000000000001"
+	evmAddress2 := "0x0000000000000000000000000000000000000002"
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -25,10 +29,124 @@ func TestGenesisState_Validate(t *testing.T) {
 				Params: types.Params{
 					DataCommitmentWindow: 400,
 				},
+				Valsets: []types.Valset{
+					{Nonce: 1},
+				},
+				DataCommitments: []types.DataCommitment{
+					{Nonce: 2, BeginBlock: 1, EndBlock: 401},
+				},
+				LatestAttestationNonce:            2,
+				EarliestAvailableAttestationNonce: 1,
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: sdk.ValAddress("validator1").String(), EvmAddress: evmAddress1},
+					{ValidatorAddress: sdk.ValAddress("validator2").String(), EvmAddress: evmAddress2},
+				},
+				DataRoots: []types.DataRoot{
+					{Height: 1, DataRoot: make([]byte, 32)},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated attestation nonce",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				Valsets:                           []types.Valset{{Nonce: 1}},
+				DataCommitments:                   []types.DataCommitment{{Nonce: 1}},
+				LatestAttestationNonce:            1,
+				EarliestAvailableAttestationNonce: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "missing attestation nonce",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				Valsets:                           []types.Valset{{Nonce: 1}},
+				DataCommitments:                   []types.DataCommitment{{Nonce: 3}},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation nonce after latest nonce",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				Valsets:                           []types.Valset{{Nonce: 1}, {Nonce: 2}},
+				LatestAttestationNonce:            1,
+				EarliestAvailableAttestationNonce: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "zero earliest available nonce",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultGenesis().Params,
+				Valsets:                []types.Valset{{Nonce: 0}},
+				LatestAttestationNonce: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated evm address",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				EarliestAvailableAttestationNonce: 1,
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: sdk.ValAddress("validator1").String(), EvmAddress: evmAddress1},
+					{ValidatorAddress: sdk.ValAddress("validator2").String(), EvmAddress: evmAddress1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated validator",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				EarliestAvailableAttestationNonce: 1,
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: sdk.ValAddress("validator1").String(), EvmAddress: evmAddress1},
+					{ValidatorAddress: sdk.ValAddress("validator1").String(), EvmAddress: evmAddress2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid evm address",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				EarliestAvailableAttestationNonce: 1,
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: sdk.ValAddress("validator1").String(), EvmAddress: "0x01"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated data root height",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				EarliestAvailableAttestationNonce: 1,
+				DataRoots: []types.DataRoot{
+					{Height: 1, DataRoot: make([]byte, 32)},
+					{Height: 1, DataRoot: make([]byte, 32)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid data root size",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultGenesis().Params,
+				EarliestAvailableAttestationNonce: 1,
+				DataRoots: []types.DataRoot{
+					{Height: 1, DataRoot: make([]byte, 31)},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
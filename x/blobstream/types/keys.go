@@ -42,6 +42,9 @@ const (
 
 	// EVMAddress indexes evm addresses by validator address
 	EvmAddress = "EvmAddress"
+
+	// DataRootKey indexes the data roots of the blocks by height
+	DataRootKey = "DataRootKey"
 )
 
 // GetAttestationKey returns the following key format
@@ -51,6 +54,13 @@ func GetAttestationKey(nonce uint64) string {
 	return AttestationRequestKey + string(UInt64Bytes(nonce))
 }
 
+// GetDataRootKey returns the following key format
+// prefix    height
+// [0x0][0 0 0 0 0 0 0 1]
+func GetDataRootKey(height uint64) string {
+	return DataRootKey + string(UInt64Bytes(height))
+}
+
 func ConvertByteArrToString(value []byte) string {
 	var ret strings.Builder
 	for i := 0; i < len(value); i++ {
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
@@ -735,6 +736,116 @@ func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCom
 	return nil
 }
 
+// QueryDataRootInclusionProofRequest
+type QueryDataRootInclusionProofRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDataRootInclusionProofRequest) Reset()         { *m = QueryDataRootInclusionProofRequest{} }
+func (m *QueryDataRootInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootInclusionProofRequest) ProtoMessage()    {}
+func (*QueryDataRootInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{16}
+}
+func (m *QueryDataRootInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataRootInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryDataRootInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataRootInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryDataRootInclusionProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryDataRootInclusionProofResponse
+type QueryDataRootInclusionProofResponse struct {
+	// data_commitment is the data commitment whose [begin, end) window
+	// includes the height
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	// data_root is the data root of the block at the height
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// proof is the merkle proof of the data root tuple to the data commitment
+	Proof crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryDataRootInclusionProofResponse) Reset()         { *m = QueryDataRootInclusionProofResponse{} }
+func (m *QueryDataRootInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootInclusionProofResponse) ProtoMessage()    {}
+func (*QueryDataRootInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{17}
+}
+func (m *QueryDataRootInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataRootInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryDataRootInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataRootInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryDataRootInclusionProofResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
+	}
+	return nil
+}
+
+func (m *QueryDataRootInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryDataRootInclusionProofResponse) GetProof() crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return crypto.Proof{}
+}
+
 // QueryEvmAddressRequest
 type QueryEvmAddressRequest struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -744,7 +855,7 @@ func (m *QueryEvmAddressRequest) Reset()         { *m = QueryEvmAddressRequest{}
 func (m *QueryEvmAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEvmAddressRequest) ProtoMessage()    {}
 func (*QueryEvmAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199ea7b7663f6b25, []int{16}
+	return fileDescriptor_199ea7b7663f6b25, []int{18}
 }
 func (m *QueryEvmAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +900,7 @@ func (m *QueryEvmAddressResponse) Reset()         { *m = QueryEvmAddressResponse
 func (m *QueryEvmAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEvmAddressResponse) ProtoMessage()    {}
 func (*QueryEvmAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199ea7b7663f6b25, []int{17}
+	return fileDescriptor_199ea7b7663f6b25, []int{19}
 }
 func (m *QueryEvmAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,6 +953,8 @@ func init() {
 	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryDataRootInclusionProofRequest)(nil), "sunrise.blobstream.v1.QueryDataRootInclusionProofRequest")
+	proto.RegisterType((*QueryDataRootInclusionProofResponse)(nil), "sunrise.blobstream.v1.QueryDataRootInclusionProofResponse")
 	proto.RegisterType((*QueryEvmAddressRequest)(nil), "sunrise.blobstream.v1.QueryEvmAddressRequest")
 	proto.RegisterType((*QueryEvmAddressResponse)(nil), "sunrise.blobstream.v1.QueryEvmAddressResponse")
 }
@@ -849,66 +962,74 @@ func init() {
 func init() { proto.RegisterFile("sunrise/blobstream/v1/query.proto", fileDescriptor_199ea7b7663f6b25) }
 
 var fileDescriptor_199ea7b7663f6b25 = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
+	// 1058 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x22, 0x6a, 0xa9, 0x2f, 0xe2, 0x47, 0xa7, 0x6e, 0xda, 0xae, 0x5a, 0x27, 0x9d, 0x26,
-	0x55, 0xea, 0x2a, 0x3b, 0xc4, 0x6d, 0x20, 0x4d, 0x52, 0xd1, 0x18, 0x8a, 0x40, 0xaa, 0x0a, 0x58,
-	0x02, 0x24, 0x2e, 0xd1, 0x6c, 0x3c, 0xdd, 0xac, 0xe4, 0xdd, 0x71, 0x77, 0xd6, 0x16, 0x56, 0xc5,
-	0x85, 0x03, 0x67, 0x24, 0x0e, 0xfc, 0x0b, 0x1c, 0x39, 0x20, 0xce, 0x1c, 0x0b, 0xa7, 0x22, 0x24,
-	0xc4, 0xa9, 0x42, 0x09, 0x08, 0xfe, 0x0c, 0xe4, 0x99, 0xb7, 0xce, 0xda, 0xf1, 0xfe, 0xc0, 0xa8,
-	0x17, 0x6b, 0x67, 0xe6, 0x7b, 0xdf, 0xfb, 0xbe, 0xb7, 0xe3, 0xf7, 0x6c, 0xb8, 0xa2, 0x7a, 0x61,
-	0xe4, 0x2b, 0xc1, 0xdc, 0x8e, 0x74, 0x55, 0x1c, 0x09, 0x1e, 0xb0, 0xfe, 0x3a, 0x7b, 0xd4, 0x13,
-	0xd1, 0xc0, 0xe9, 0x46, 0x32, 0x96, 0xe4, 0x1c, 0x42, 0x9c, 0x63, 0x88, 0xd3, 0x5f, 0xb7, 0xcf,
-	0xf0, 0xc0, 0x0f, 0x25, 0xd3, 0x9f, 0x06, 0x69, 0x57, 0x3d, 0xe9, 0x49, 0xfd, 0xc8, 0x86, 0x4f,
-	0xb8, 0x7b, 0xc9, 0x93, 0xd2, 0xeb, 0x08, 0xc6, 0xbb, 0x3e, 0xe3, 0x61, 0x28, 0x63, 0x1e, 0xfb,
-	0x32, 0x54, 0x78, 0x5a, 0xdf, 0x97, 0x2a, 0x90, 0x8a, 0xb9, 0x5c, 0x09, 0x93, 0x96, 0xf5, 0xd7,
-	0x5d, 0x11, 0xf3, 0x75, 0xd6, 0xe5, 0x9e, 0x1f, 0x6a, 0x30, 0x62, 0xe9, 0x74, 0xb1, 0x5d, 0x1e,
-	0xf1, 0x20, 0xe1, 0xbb, 0x88, 0xd9, 0xf4, 0xca, 0xed, 0x3d, 0x64, 0x3c, 0x1c, 0x24, 0x47, 0x26,
-	0xd5, 0x9e, 0x51, 0x68, 0x16, 0x78, 0x94, 0x51, 0x86, 0x78, 0xd0, 0x15, 0x08, 0xa1, 0x55, 0x20,
-	0x1f, 0x0e, 0xe5, 0x7d, 0xa0, 0xb3, 0xb5, 0xc4, 0xa3, 0x9e, 0x50, 0x31, 0xfd, 0x04, 0xce, 0x8e,
-	0xed, 0xaa, 0xae, 0x0c, 0x95, 0x20, 0x77, 0xa1, 0x62, 0x54, 0x5d, 0xb0, 0x96, 0xac, 0xd5, 0xf9,
-	0xc6, 0x65, 0x67, 0x6a, 0x11, 0x1d, 0x13, 0xd6, 0x3c, 0xfd, 0xe4, 0xd9, 0xe2, 0xdc, 0xb7, 0x7f,
-	0x7f, 0x57, 0xb7, 0x5a, 0x18, 0x47, 0xef, 0xc0, 0x8a, 0x26, 0xde, 0x8d, 0x63, 0xa1, 0x4c, 0xc9,
-	0x30, 0x67, 0x73, 0xf0, 0x40, 0x86, 0xfb, 0x02, 0x57, 0xa4, 0x0a, 0xa7, 0xc2, 0xe1, 0x5a, 0x67,
-	0x7a, 0xb1, 0x65, 0x16, 0x74, 0x00, 0xd7, 0x8a, 0xc2, 0x51, 0xea, 0xfb, 0x30, 0xcf, 0x8f, 0x41,
-	0xa8, 0xb7, 0xea, 0x98, 0x32, 0x3a, 0x49, 0x19, 0x9d, 0xdd, 0x70, 0xd0, 0x3c, 0xff, 0xf3, 0xf7,
-	0x6b, 0x67, 0x4f, 0x32, 0xbe, 0xd7, 0x4a, 0x33, 0xd0, 0x65, 0xa0, 0x3a, 0xf5, 0x7d, 0x3e, 0xdc,
-	0x4b, 0xc1, 0xd3, 0xb2, 0xe9, 0x36, 0x5c, 0xcd, 0x45, 0xa1, 0xba, 0xe9, 0xee, 0xae, 0xc1, 0xb2,
-	0x0e, 0xbe, 0xc7, 0xa3, 0x8e, 0x9f, 0x93, 0x24, 0x29, 0x62, 0x36, 0x2e, 0x37, 0x4d, 0x13, 0xea,
-	0x29, 0x8d, 0x1f, 0xf3, 0x8e, 0x12, 0x71, 0x52, 0x45, 0xf1, 0x50, 0x46, 0xa2, 0xc4, 0x8b, 0x68,
-	0xc3, 0x8d, 0x52, 0x1c, 0x28, 0x64, 0x03, 0x2a, 0x7d, 0x8d, 0x29, 0xb8, 0x38, 0x48, 0x84, 0x60,
-	0x7a, 0x15, 0xae, 0xa4, 0xb2, 0x7c, 0x14, 0xba, 0x32, 0x6c, 0xfb, 0xa1, 0xf7, 0xae, 0xf0, 0xbd,
-	0x83, 0x24, 0x1d, 0xdd, 0x19, 0x7b, 0x31, 0x27, 0x40, 0xa8, 0x60, 0x01, 0x2a, 0x07, 0x7a, 0x07,
-	0x7d, 0xe0, 0x8a, 0x52, 0x58, 0x4a, 0x45, 0xbf, 0xcd, 0x63, 0xfe, 0x96, 0x0c, 0x02, 0x3f, 0x0e,
-	0x44, 0x38, 0xca, 0xa0, 0xc6, 0x64, 0x4c, 0x62, 0x30, 0xc1, 0x03, 0x78, 0xa5, 0xcd, 0x63, 0xbe,
-	0xb7, 0x3f, 0x3a, 0x42, 0xaf, 0x2b, 0x19, 0x5e, 0x27, 0x78, 0x5e, 0x6e, 0x8f, 0xad, 0x69, 0x13,
-	0x56, 0x75, 0xd2, 0x09, 0x18, 0x0f, 0x3d, 0xf1, 0x8e, 0x8c, 0xc6, 0x4a, 0x90, 0x69, 0xee, 0x31,
-	0x5c, 0x2f, 0xc1, 0xf1, 0x9c, 0x0c, 0xdc, 0x83, 0x05, 0x73, 0x4b, 0xfb, 0xc1, 0x6e, 0xbb, 0x1d,
-	0x09, 0x95, 0x74, 0x17, 0x72, 0x03, 0xce, 0xf4, 0x79, 0xc7, 0x6f, 0xf3, 0x58, 0x46, 0x7b, 0xdc,
-	0x9c, 0xe9, 0x5c, 0xa7, 0x5b, 0xaf, 0x8e, 0x0e, 0x30, 0x86, 0x6e, 0xc1, 0xf9, 0x13, 0x34, 0xa8,
-	0x78, 0x11, 0xe6, 0x45, 0x3f, 0x98, 0x60, 0x00, 0x31, 0x02, 0x36, 0xfe, 0x79, 0x09, 0x4e, 0xe9,
-	0x60, 0xf2, 0xa5, 0x05, 0x15, 0xd3, 0x95, 0xc8, 0xf5, 0x0c, 0x3b, 0x27, 0xdb, 0xa0, 0x5d, 0x2f,
-	0x03, 0x35, 0x62, 0xe8, 0xca, 0x17, 0xbf, 0xfe, 0xf9, 0xf5, 0x0b, 0x8b, 0xe4, 0x32, 0xcb, 0x6b,
-	0xe7, 0xe4, 0x37, 0x0b, 0x2e, 0x66, 0x76, 0x2f, 0xb2, 0x93, 0x97, 0xb0, 0xa8, 0x67, 0xda, 0x77,
-	0x66, 0x8c, 0x46, 0x07, 0x3b, 0xda, 0xc1, 0xeb, 0xe4, 0x56, 0x86, 0x83, 0x54, 0x37, 0x54, 0x2c,
-	0x32, 0x1c, 0x8a, 0x3d, 0xd6, 0x0d, 0xe1, 0x73, 0xf2, 0x93, 0x05, 0x0b, 0xd3, 0xbb, 0x1e, 0xb9,
-	0x9d, 0xa7, 0x2b, 0xb7, 0x9f, 0xda, 0x5b, 0xb3, 0x84, 0xa2, 0x9f, 0x4d, 0xed, 0xa7, 0x41, 0x5e,
-	0x2b, 0xe3, 0x47, 0xbb, 0x60, 0x1d, 0xcd, 0x48, 0x7e, 0xb1, 0xe0, 0x42, 0x56, 0x73, 0x25, 0xdb,
-	0x79, 0x92, 0x0a, 0x5a, 0xb7, 0xbd, 0x33, 0x5b, 0x30, 0x3a, 0xda, 0xd2, 0x8e, 0x6e, 0x91, 0x46,
-	0x79, 0x47, 0x02, 0x39, 0xc9, 0x5f, 0x16, 0xd4, 0xf2, 0xbb, 0x35, 0xd9, 0x2d, 0x2e, 0x76, 0xc1,
-	0xb4, 0xb0, 0x9b, 0xff, 0x87, 0xa2, 0xe4, 0x3d, 0x34, 0xc3, 0x21, 0xb9, 0x81, 0xcc, 0xd5, 0x14,
-	0xa3, 0x7b, 0xf8, 0x83, 0x05, 0xe7, 0xa6, 0x8e, 0x02, 0xb2, 0x59, 0xac, 0x6d, 0xfa, 0x88, 0xb1,
-	0x6f, 0xcf, 0x10, 0x89, 0x66, 0x56, 0xb5, 0x19, 0x4a, 0x96, 0x32, 0xcc, 0xf4, 0x92, 0x38, 0xf2,
-	0xcc, 0x82, 0x4b, 0x79, 0x8d, 0x9a, 0xbc, 0x99, 0xa7, 0xa2, 0xc4, 0x98, 0xb0, 0xef, 0xce, 0x4e,
-	0x80, 0x6e, 0xb6, 0xb5, 0x9b, 0x0d, 0x72, 0x33, 0xc3, 0xcd, 0xc4, 0x00, 0x61, 0xd1, 0x90, 0x86,
-	0x99, 0x69, 0x44, 0x7e, 0xb4, 0xa0, 0x3a, 0x6d, 0x84, 0x92, 0x37, 0x8a, 0xcb, 0x3b, 0x75, 0x30,
-	0xdb, 0x9b, 0xff, 0x3d, 0x10, 0x8d, 0x6c, 0x68, 0x23, 0x8c, 0xac, 0x95, 0x34, 0x82, 0x8d, 0xe1,
-	0x1b, 0x0b, 0xe0, 0x78, 0x10, 0x91, 0xb5, 0xdc, 0x6f, 0xf3, 0xe4, 0xdc, 0xb3, 0x9d, 0xb2, 0x70,
-	0x14, 0x59, 0xd7, 0x22, 0x97, 0x09, 0xcd, 0x10, 0x99, 0x1a, 0x7e, 0xcd, 0xfb, 0x4f, 0x0e, 0x6b,
-	0xd6, 0xd3, 0xc3, 0x9a, 0xf5, 0xc7, 0x61, 0xcd, 0xfa, 0xea, 0xa8, 0x36, 0xf7, 0xf4, 0xa8, 0x36,
-	0xf7, 0xfb, 0x51, 0x6d, 0xee, 0xd3, 0x86, 0xe7, 0xc7, 0x07, 0x3d, 0xd7, 0xd9, 0x97, 0x41, 0xc2,
-	0xd3, 0xe1, 0x03, 0x11, 0x8d, 0x48, 0x3f, 0x4b, 0xd3, 0xea, 0xff, 0x06, 0x6e, 0x45, 0xff, 0x40,
-	0xbe, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0x91, 0x8f, 0xd5, 0x48, 0x0d, 0x00, 0x00,
+	0x14, 0xce, 0x96, 0xd6, 0x22, 0x2f, 0x88, 0xd2, 0xa9, 0x9b, 0xba, 0x4b, 0xe3, 0x24, 0x93, 0xa4,
+	0x4a, 0x5d, 0x65, 0x87, 0xb8, 0x09, 0xa4, 0x49, 0x2a, 0x1a, 0x43, 0x11, 0x95, 0xaa, 0x52, 0x2c,
+	0x01, 0x12, 0x17, 0x6b, 0x6c, 0x4f, 0x9c, 0x95, 0xbc, 0x33, 0xee, 0xee, 0xd8, 0xc2, 0xaa, 0xb8,
+	0x70, 0xe0, 0x8c, 0xc4, 0x81, 0x7f, 0x81, 0x23, 0x87, 0x8a, 0x33, 0xc7, 0xc0, 0xa9, 0x08, 0x09,
+	0x71, 0xaa, 0x50, 0x02, 0xe2, 0xdf, 0x40, 0x3b, 0x33, 0xeb, 0x38, 0x8e, 0xf7, 0x07, 0x46, 0xbd,
+	0x54, 0x99, 0x9d, 0xef, 0x7d, 0xef, 0xfb, 0x9e, 0xb7, 0xef, 0xb3, 0x61, 0x31, 0xe8, 0x72, 0xdf,
+	0x0d, 0x18, 0xa9, 0xb7, 0x45, 0x3d, 0x90, 0x3e, 0xa3, 0x1e, 0xe9, 0xad, 0x93, 0x27, 0x5d, 0xe6,
+	0xf7, 0x9d, 0x8e, 0x2f, 0xa4, 0x40, 0x57, 0x0c, 0xc4, 0x39, 0x81, 0x38, 0xbd, 0x75, 0xfb, 0x12,
+	0xf5, 0x5c, 0x2e, 0x88, 0xfa, 0x57, 0x23, 0xed, 0x7c, 0x4b, 0xb4, 0x84, 0xfa, 0x93, 0x84, 0x7f,
+	0x99, 0xa7, 0xd7, 0x5b, 0x42, 0xb4, 0xda, 0x8c, 0xd0, 0x8e, 0x4b, 0x28, 0xe7, 0x42, 0x52, 0xe9,
+	0x0a, 0x1e, 0x98, 0xdb, 0x52, 0x43, 0x04, 0x9e, 0x08, 0x48, 0x9d, 0x06, 0x4c, 0xb7, 0x25, 0xbd,
+	0xf5, 0x3a, 0x93, 0x74, 0x9d, 0x74, 0x68, 0xcb, 0xe5, 0x0a, 0x6c, 0xb0, 0x78, 0xbc, 0xd8, 0x0e,
+	0xf5, 0xa9, 0x17, 0xf1, 0x5d, 0x33, 0xdd, 0xd4, 0xa9, 0xde, 0xdd, 0x27, 0x94, 0xf7, 0xa3, 0x2b,
+	0xdd, 0xaa, 0xa6, 0x15, 0xea, 0x83, 0xb9, 0x8a, 0x19, 0x83, 0xec, 0x77, 0x58, 0x04, 0x99, 0x93,
+	0x8c, 0x37, 0x99, 0xef, 0xb9, 0x5c, 0x92, 0x86, 0xdf, 0xef, 0x48, 0x11, 0xf6, 0x10, 0xfb, 0xfa,
+	0x1a, 0xe7, 0x01, 0x7d, 0x1c, 0xaa, 0x7f, 0xac, 0xc4, 0x54, 0xd9, 0x93, 0x2e, 0x0b, 0x24, 0xfe,
+	0x0c, 0x2e, 0x9f, 0x7a, 0x1a, 0x74, 0x04, 0x0f, 0x18, 0xba, 0x07, 0x39, 0x2d, 0xba, 0x60, 0x2d,
+	0x58, 0xab, 0x33, 0xe5, 0x39, 0x67, 0xec, 0x8c, 0x1d, 0x5d, 0x56, 0x99, 0x3e, 0x7c, 0x31, 0x3f,
+	0xf5, 0xfd, 0x3f, 0x3f, 0x94, 0xac, 0xaa, 0xa9, 0xc3, 0x77, 0x61, 0x45, 0x11, 0xef, 0x49, 0xc9,
+	0x02, 0x3d, 0x51, 0xd3, 0xb3, 0xd2, 0x7f, 0x24, 0x78, 0x83, 0x99, 0x13, 0xca, 0xc3, 0x05, 0x1e,
+	0x9e, 0x55, 0xa7, 0xf3, 0x55, 0x7d, 0xc0, 0x7d, 0xb8, 0x91, 0x56, 0x6e, 0xa4, 0x7e, 0x04, 0x33,
+	0xf4, 0x04, 0x64, 0xf4, 0xe6, 0x1d, 0x3d, 0x65, 0x27, 0x9a, 0xb2, 0xb3, 0xc7, 0xfb, 0x95, 0xab,
+	0xbf, 0x3c, 0x5b, 0xbb, 0x7c, 0x96, 0xf1, 0x41, 0x75, 0x98, 0x01, 0x2f, 0x03, 0x56, 0xad, 0x1f,
+	0xd2, 0xf0, 0xd9, 0x10, 0x7c, 0x58, 0x36, 0xde, 0x81, 0xa5, 0x44, 0x94, 0x51, 0x37, 0xde, 0xdd,
+	0x0d, 0x58, 0x56, 0xc5, 0xf7, 0xa9, 0xdf, 0x76, 0x13, 0x9a, 0x44, 0x43, 0x8c, 0xc7, 0x25, 0xb6,
+	0xa9, 0x40, 0x69, 0x48, 0xe3, 0xa7, 0xb4, 0x1d, 0x30, 0x19, 0x4d, 0x91, 0xed, 0x0b, 0x9f, 0x65,
+	0xf8, 0x20, 0x9a, 0x70, 0x2b, 0x13, 0x87, 0x11, 0xb2, 0x09, 0xb9, 0x9e, 0xc2, 0xa4, 0xbc, 0x38,
+	0x86, 0xc8, 0x80, 0xf1, 0x12, 0x2c, 0x0e, 0x75, 0xf9, 0x84, 0xd7, 0x05, 0x6f, 0xba, 0xbc, 0xf5,
+	0x21, 0x73, 0x5b, 0x07, 0x51, 0x3b, 0xbc, 0x0b, 0x38, 0x09, 0x64, 0x14, 0xcc, 0x42, 0xee, 0x40,
+	0x3d, 0x31, 0x3e, 0xcc, 0x09, 0x63, 0x58, 0x18, 0xaa, 0x7e, 0x9f, 0x4a, 0xfa, 0x9e, 0xf0, 0x3c,
+	0x57, 0x7a, 0x8c, 0x0f, 0x3a, 0x04, 0xb0, 0x98, 0x80, 0x31, 0x0d, 0x1e, 0xc1, 0xc5, 0x26, 0x95,
+	0xb4, 0xd6, 0x18, 0x5c, 0x19, 0xaf, 0x2b, 0x31, 0x5e, 0x47, 0x78, 0x5e, 0x6f, 0x9e, 0x3a, 0xe3,
+	0x0a, 0xac, 0xaa, 0xa6, 0x23, 0x30, 0xca, 0x5b, 0xec, 0x03, 0xe1, 0x9f, 0x1a, 0x41, 0xac, 0xb9,
+	0xa7, 0x70, 0x33, 0x03, 0xc7, 0x4b, 0x32, 0x10, 0x7d, 0x2e, 0x21, 0xac, 0x2a, 0x84, 0x7c, 0xc0,
+	0x1b, 0xed, 0x6e, 0xe0, 0x0a, 0xfe, 0x38, 0x5c, 0x3f, 0x69, 0xd2, 0x0f, 0x2d, 0x58, 0x4a, 0x2c,
+	0x7f, 0x39, 0xaa, 0xd1, 0x9b, 0x30, 0xad, 0xf8, 0x7c, 0x21, 0x64, 0xe1, 0xdc, 0x82, 0xb5, 0xfa,
+	0x5a, 0xf5, 0xd5, 0xa6, 0x91, 0x80, 0x36, 0xe0, 0x82, 0xda, 0x9d, 0x85, 0x57, 0x54, 0x8b, 0x82,
+	0x73, 0xb2, 0x5b, 0x1d, 0xbd, 0x5b, 0x1d, 0xa5, 0xae, 0x72, 0x3e, 0xdc, 0x7c, 0x55, 0x0d, 0xc6,
+	0xf7, 0x61, 0x56, 0xff, 0x77, 0xed, 0x79, 0x7b, 0xcd, 0xa6, 0xcf, 0x82, 0x68, 0xcd, 0xa2, 0x5b,
+	0x70, 0xa9, 0x47, 0xdb, 0x6e, 0x93, 0x4a, 0xe1, 0xd7, 0xa8, 0xbe, 0x53, 0xf2, 0xa7, 0xab, 0x6f,
+	0x0c, 0x2e, 0x4c, 0x0d, 0xde, 0x86, 0xab, 0x67, 0x68, 0xcc, 0x10, 0xe6, 0x61, 0x86, 0xf5, 0xbc,
+	0x11, 0x06, 0x60, 0x03, 0x60, 0xf9, 0xd9, 0x45, 0xb8, 0xa0, 0x8a, 0xd1, 0xd7, 0x16, 0xe4, 0xf4,
+	0x7a, 0x46, 0x37, 0x63, 0x26, 0x74, 0x36, 0x0f, 0xec, 0x52, 0x16, 0xa8, 0x16, 0x83, 0x57, 0xbe,
+	0xfa, 0xed, 0xaf, 0x6f, 0xcf, 0xcd, 0xa3, 0x39, 0x92, 0x14, 0x7b, 0xe8, 0x77, 0x0b, 0xae, 0xc5,
+	0xae, 0x71, 0xb4, 0x9b, 0xd4, 0x30, 0x2d, 0x3c, 0xec, 0xbb, 0x13, 0x56, 0x1b, 0x07, 0xbb, 0xca,
+	0xc1, 0xdb, 0x68, 0x23, 0xc6, 0xc1, 0x50, 0x2c, 0x04, 0xc4, 0xd7, 0x1c, 0x01, 0x79, 0xaa, 0x36,
+	0xe3, 0x97, 0xe8, 0x67, 0x0b, 0x66, 0xc7, 0xaf, 0x7f, 0x74, 0x27, 0x49, 0x57, 0x62, 0xb0, 0xd8,
+	0xdb, 0x93, 0x94, 0x1a, 0x3f, 0x5b, 0xca, 0x4f, 0x19, 0xbd, 0x95, 0xc5, 0x8f, 0x72, 0x41, 0xda,
+	0x8a, 0x11, 0xfd, 0x6a, 0x41, 0x21, 0x2e, 0x65, 0xd0, 0x4e, 0x92, 0xa4, 0x94, 0x0c, 0xb3, 0x77,
+	0x27, 0x2b, 0x36, 0x8e, 0xb6, 0x95, 0xa3, 0x0d, 0x54, 0xce, 0xee, 0x88, 0x19, 0x4e, 0xf4, 0xb7,
+	0x05, 0xc5, 0xe4, 0xd8, 0x42, 0x7b, 0xe9, 0xc3, 0x4e, 0x89, 0x4d, 0xbb, 0xf2, 0x7f, 0x28, 0x32,
+	0xbe, 0x87, 0x3a, 0x25, 0xa3, 0x37, 0x90, 0xd4, 0x15, 0xc5, 0xe0, 0x3d, 0xfc, 0xd1, 0x82, 0x2b,
+	0x63, 0x33, 0x11, 0x6d, 0xa5, 0x6b, 0x1b, 0x9f, 0xb5, 0xf6, 0x9d, 0x09, 0x2a, 0x8d, 0x99, 0x55,
+	0x65, 0x06, 0xa3, 0x85, 0x18, 0x33, 0xdd, 0xa8, 0x0e, 0xbd, 0xb0, 0xe0, 0x7a, 0x52, 0x62, 0xa1,
+	0x77, 0x93, 0x54, 0x64, 0xc8, 0x4b, 0xfb, 0xde, 0xe4, 0x04, 0xc6, 0xcd, 0x8e, 0x72, 0xb3, 0x89,
+	0x6e, 0xc7, 0xb8, 0x19, 0xc9, 0x24, 0xe2, 0x87, 0x34, 0x44, 0x67, 0x1b, 0xfa, 0xc9, 0x82, 0xfc,
+	0xb8, 0xef, 0x12, 0xe8, 0x9d, 0xf4, 0xf1, 0x8e, 0xfd, 0x86, 0x62, 0x6f, 0xfd, 0xf7, 0x42, 0x63,
+	0x64, 0x53, 0x19, 0x21, 0x68, 0x2d, 0xa3, 0x11, 0xb3, 0x18, 0xc2, 0x25, 0x37, 0x3e, 0x99, 0x93,
+	0x97, 0x5c, 0xe2, 0x97, 0x01, 0x7b, 0x7b, 0x92, 0xd2, 0x8c, 0x4b, 0x6e, 0x90, 0xea, 0x35, 0x37,
+	0x22, 0xa8, 0xa9, 0x7c, 0x46, 0xdf, 0x59, 0x00, 0x27, 0xa1, 0x8a, 0xd6, 0x12, 0x37, 0xd3, 0x68,
+	0x86, 0xdb, 0x4e, 0x56, 0xb8, 0xd1, 0x59, 0x52, 0x3a, 0x97, 0x11, 0x8e, 0xd1, 0x39, 0x14, 0xe4,
+	0x95, 0x87, 0x87, 0x47, 0x45, 0xeb, 0xf9, 0x51, 0xd1, 0xfa, 0xf3, 0xa8, 0x68, 0x7d, 0x73, 0x5c,
+	0x9c, 0x7a, 0x7e, 0x5c, 0x9c, 0xfa, 0xe3, 0xb8, 0x38, 0xf5, 0x79, 0xb9, 0xe5, 0xca, 0x83, 0x6e,
+	0xdd, 0x69, 0x08, 0x2f, 0xe2, 0x69, 0xd3, 0x3e, 0xf3, 0x07, 0xa4, 0x5f, 0x0c, 0xd3, 0xaa, 0xdf,
+	0x83, 0xf5, 0x9c, 0xfa, 0xd5, 0x73, 0xfb, 0xdf, 0x01, 0x00, 0x8c, 0xd3, 0xcf, 0x33, 0x3c, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -945,6 +1066,9 @@ type QueryClient interface {
 	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootInclusionProof returns the inclusion proof of the data root tuple
+	// of the provided height to the data commitment that includes it
+	DataRootInclusionProof(ctx context.Context, in *QueryDataRootInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootInclusionProofResponse, error)
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error)
@@ -1030,6 +1154,15 @@ func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestD
 	return out, nil
 }
 
+func (c *queryClient) DataRootInclusionProof(ctx context.Context, in *QueryDataRootInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootInclusionProofResponse, error) {
+	out := new(QueryDataRootInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/DataRootInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error) {
 	out := new(QueryEvmAddressResponse)
 	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EvmAddress", in, out, opts...)
@@ -1063,6 +1196,9 @@ type QueryServer interface {
 	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
 	// LatestDataCommitment returns the latest data commitment in store
 	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootInclusionProof returns the inclusion proof of the data root tuple
+	// of the provided height to the data commitment that includes it
+	DataRootInclusionProof(context.Context, *QueryDataRootInclusionProofRequest) (*QueryDataRootInclusionProofResponse, error)
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error)
@@ -1096,6 +1232,9 @@ func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Contex
 func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
 }
+func (*UnimplementedQueryServer) DataRootInclusionProof(ctx context.Context, req *QueryDataRootInclusionProofRequest) (*QueryDataRootInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootInclusionProof not implemented")
+}
 func (*UnimplementedQueryServer) EvmAddress(ctx context.Context, req *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvmAddress not implemented")
 }
@@ -1248,6 +1387,24 @@ func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataRootInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataRootInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/DataRootInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataRootInclusionProof(ctx, req.(*QueryDataRootInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EvmAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEvmAddressRequest)
 	if err := dec(in); err != nil {
@@ -1302,6 +1459,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LatestDataCommitment",
 			Handler:    _Query_LatestDataCommitment_Handler,
 		},
+		{
+			MethodName: "DataRootInclusionProof",
+			Handler:    _Query_DataRootInclusionProof_Handler,
+		},
 		{
 			MethodName: "EvmAddress",
 			Handler:    _Query_EvmAddress_Handler,
@@ -1767,6 +1928,86 @@ func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDataRootInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEvmAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2018,6 +2259,37 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDataRootInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataRootInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryEvmAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3141,6 +3413,228 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *QueryDataRootInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRootInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRootInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRootInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRootInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRootInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryEvmAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -110,7 +110,7 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	// deduct the fees
 	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee, dfd.feeKeeper)
+		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee, feeTx.GetGas(), dfd.feeKeeper)
 		if err != nil {
 			return err
 		}
@@ -129,17 +129,31 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 }
 
 // DeductFees deducts fees from the given account.
-func DeductFees(bankKeeper BankKeeper, ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins, feeKeeper feekeeper.Keeper) error {
+func DeductFees(bankKeeper BankKeeper, ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins, gas uint64, feeKeeper feekeeper.Keeper) error {
 	if !fees.IsValid() {
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	// <sunrise>
+	// Fees in other denoms are swapped to the fee denom before they are collected
+	if len(fees) == 1 && !feeKeeper.GetParams(ctx).IsFeeDenomOrBypass(fees[0].Denom) {
+		swapped, err := feeKeeper.SwapFeeToFeeDenom(ctx, acc.GetAddress(), fees[0])
+		if err != nil {
+			return err
+		}
+		fees = sdk.NewCoins(swapped)
+	} else {
+		err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
 	}
 
-	// <sunrise>
+	// The base fee is burnt in full and the burn ratio applies to the rest
+	fees, err := feeKeeper.BurnBaseFee(ctx, fees, gas)
+	if err != nil {
+		return err
+	}
 	if err := feeKeeper.Burn(ctx, fees); err != nil {
 		return err
 	}
@@ -35,17 +35,21 @@ func _checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx, k feekeepe
 		return nil, 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "only one fee denomination is allowed")
 	}
 	params := k.GetParams(ctx)
-	if feeCoins[0].Denom != params.FeeDenom {
-		includedBypass := false
-		for _, denom := range params.BypassDenoms {
-			if feeCoins[0].Denom == denom {
-				includedBypass = true
-				break
-			}
+	// Fees in other denoms are checked and prioritized by their value in the fee denom
+	convertedFeeCoins := feeCoins
+	if !params.IsFeeDenomOrBypass(feeCoins[0].Denom) {
+		quote, _, err := k.QuoteFeeInFeeDenom(ctx, feeCoins[0])
+		if err != nil {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fee denomination: %s: %s", feeCoins[0].Denom, err)
 		}
+		convertedFeeCoins = sdk.NewCoins(quote)
+	}
 
-		if !includedBypass {
-			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fee denomination: %s", feeCoins[0].Denom)
+	// The base fee is enforced by consensus on fees except in the bypass denoms
+	if feeCoins[0].Denom == params.FeeDenom || !params.IsFeeDenomOrBypass(feeCoins[0].Denom) {
+		requiredBaseFee := k.GetRequiredBaseFee(ctx, gas)
+		if convertedFeeCoins.AmountOf(params.FeeDenom).LT(requiredBaseFee.Amount) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", convertedFeeCoins, requiredBaseFee)
 		}
 	}
 	// </sunrise>
@@ -66,13 +70,13 @@ func _checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx, k feekeepe
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
-				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			if !convertedFeeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", convertedFeeCoins, requiredFees)
 			}
 		}
 	}
 
-	priority := getTxPriority(feeCoins, int64(gas))
+	priority := getTxPriority(convertedFeeCoins, int64(gas))
 	return feeCoins, priority, nil
 }
 
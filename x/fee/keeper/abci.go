@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/fee/types"
+)
+
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumed()
+	}
+
+	return k.UpdateBaseFee(ctx, gasUsed)
+}
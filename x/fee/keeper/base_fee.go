@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sunriselayer/sunrise/x/fee/types"
+)
+
+// GetBaseFee returns the base fee, the minimum gas price in the fee denom.
+// It is the min base fee of the params until it is set.
+func (k Keeper) GetBaseFee(ctx context.Context) math.LegacyDec {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseFee
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the base fee
+func (k Keeper) SetBaseFee(ctx context.Context, baseFee math.LegacyDec) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.BaseFeeKey, bz)
+
+	return nil
+}
+
+// UpdateBaseFee moves the base fee toward the gas used of the block in the way of EIP-1559.
+// The base fee rises if the gas used exceeds the target block gas, falls otherwise,
+// and never goes below the min base fee.
+func (k Keeper) UpdateBaseFee(ctx context.Context, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+
+	// $ delta = baseFee * (gasUsed - target) / target / denominator $
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockGas))
+	gasDelta := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).Sub(target)
+	delta := baseFee.Mul(gasDelta).Quo(target).QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	newBaseFee := math.LegacyMaxDec(baseFee.Add(delta), params.MinBaseFee)
+
+	return k.SetBaseFee(ctx, newBaseFee)
+}
+
+// GetRequiredBaseFee returns the base fee the tx of the gas pays in the fee denom.
+func (k Keeper) GetRequiredBaseFee(ctx context.Context, gas uint64) sdk.Coin {
+	params := k.GetParams(ctx)
+	amount := k.GetBaseFee(ctx).MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoin(params.FeeDenom, amount)
+}
+
+// BurnBaseFee burns the base fee portion of the fees collected for the tx of the gas
+// and returns the rest.
+func (k Keeper) BurnBaseFee(ctx sdk.Context, fees sdk.Coins, gas uint64) (sdk.Coins, error) {
+	required := k.GetRequiredBaseFee(ctx, gas)
+	burnCoin := sdk.NewCoin(required.Denom, math.MinInt(fees.AmountOf(required.Denom), required.Amount))
+	if !burnCoin.IsPositive() {
+		return fees, nil
+	}
+	burnCoins := sdk.NewCoins(burnCoin)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnCoins); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBaseFeeBurnt{
+		Fees: burnCoins,
+	}); err != nil {
+		return nil, err
+	}
+
+	return fees.Sub(burnCoins...), nil
+}
+
+// ChargeExtraBaseFee checks that the fees of the tx of the gas cover its base fee along with an
+// extra base fee in the fee denom, such as the blob base fee, and burns the extra base fee from
+// the fees collected. It is called after the fees are deducted, when the base fee is burnt and
+// the burn ratio is applied to the rest, so only the part of the extra base fee not burnt by the
+// burn ratio is burnt. Fees in the bypass denoms don't pay the extra base fee like the base fee.
+func (k Keeper) ChargeExtraBaseFee(ctx sdk.Context, fees sdk.Coins, gas uint64, extra math.Int) error {
+	if !extra.IsPositive() {
+		return nil
+	}
+	if len(fees) != 1 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "only one fee denomination is allowed")
+	}
+
+	params := k.GetParams(ctx)
+	fee := fees[0]
+	if fee.Denom != params.FeeDenom {
+		if params.IsFeeDenomOrBypass(fee.Denom) {
+			return nil
+		}
+		quote, _, err := k.QuoteFeeInFeeDenom(ctx, fee)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fee denomination: %s: %s", fee.Denom, err)
+		}
+		fee = quote
+	}
+
+	required := k.GetRequiredBaseFee(ctx, gas).AddAmount(extra)
+	if fee.Amount.LT(required.Amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, required)
+	}
+
+	burnAmount := extra.Sub(params.BurnRatio.MulInt(extra).TruncateInt())
+	if !burnAmount.IsPositive() {
+		return nil
+	}
+	burnCoins := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, burnAmount))
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnCoins); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBaseFeeBurnt{
+		Fees: burnCoins,
+	})
+}
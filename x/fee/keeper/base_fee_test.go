@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/fee/types"
+)
+
+func TestUpdateBaseFee(t *testing.T) {
+	tests := []struct {
+		desc       string
+		baseFee    math.LegacyDec
+		gasUsed    uint64
+		expBaseFee math.LegacyDec
+	}{
+		{
+			desc:       "at target",
+			baseFee:    math.LegacyNewDec(8),
+			gasUsed:    1000,
+			expBaseFee: math.LegacyNewDec(8),
+		},
+		{
+			desc:       "full block",
+			baseFee:    math.LegacyNewDec(8),
+			gasUsed:    2000,
+			expBaseFee: math.LegacyNewDec(9),
+		},
+		{
+			desc:       "empty block",
+			baseFee:    math.LegacyNewDec(8),
+			gasUsed:    0,
+			expBaseFee: math.LegacyNewDec(7),
+		},
+		{
+			desc:       "not below min base fee",
+			baseFee:    math.LegacyNewDecWithPrec(11, 1),
+			gasUsed:    0,
+			expBaseFee: math.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.FeeKeeper(t)
+			params := types.DefaultParams()
+			params.MinBaseFee = math.LegacyOneDec()
+			params.TargetBlockGas = 1000
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetBaseFee(ctx, tc.baseFee))
+
+			ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.BlockGasMeter().ConsumeGas(tc.gasUsed, "test")
+			require.NoError(t, k.EndBlocker(ctx))
+			require.Equal(t, tc.expBaseFee, k.GetBaseFee(ctx))
+		})
+	}
+}
+
+func TestUpdateBaseFeeDefaultParams(t *testing.T) {
+	k, ctx := keepertest.FeeKeeper(t)
+	params := types.DefaultParams()
+	require.Equal(t, params.MinBaseFee, k.GetBaseFee(ctx))
+
+	// Full blocks raise the base fee from its default floor
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		ctx.BlockGasMeter().ConsumeGas(2*params.TargetBlockGas, "test")
+		require.NoError(t, k.EndBlocker(ctx))
+	}
+	raised := k.GetBaseFee(ctx)
+	require.True(t, raised.GT(params.MinBaseFee), raised.String())
+
+	// Empty blocks lower it back to the floor
+	for i := 0; i < 100; i++ {
+		ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		require.NoError(t, k.EndBlocker(ctx))
+	}
+	require.Equal(t, params.MinBaseFee, k.GetBaseFee(ctx))
+}
+
+func TestBaseFeeQuery(t *testing.T) {
+	k, ctx := keepertest.FeeKeeper(t)
+
+	// Defaults to the min base fee
+	params := types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDecWithPrec(2, 3)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := k.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseFee), res.BaseFee)
+
+	require.NoError(t, k.SetBaseFee(ctx, math.LegacyNewDecWithPrec(3, 3)))
+	res, err = k.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(params.FeeDenom, math.LegacyNewDecWithPrec(3, 3)), res.BaseFee)
+
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 4), k.GetRequiredBaseFee(ctx, 1001))
+
+	_, err = k.BaseFee(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestBurnBaseFee(t *testing.T) {
+	k, mocks, ctx := keepertest.FeeKeeperWithMocks(t)
+	require.NoError(t, k.SetBaseFee(ctx, math.LegacyNewDecWithPrec(1, 1)))
+
+	burnt := sdk.NewCoins(sdk.NewInt64Coin("fee", 100))
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, burnt).Return(nil)
+	mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burnt).Return(nil)
+
+	rest, err := k.BurnBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("fee", 150)), 1000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("fee", 50)), rest)
+
+	// Fees in the bypass denoms are not burnt
+	rest, err = k.BurnBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), 1000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), rest)
+}
+
+func TestChargeExtraBaseFee(t *testing.T) {
+	k, mocks, ctx := keepertest.FeeKeeperWithMocks(t)
+	require.NoError(t, k.SetBaseFee(ctx, math.LegacyNewDecWithPrec(1, 1)))
+
+	// Half of the extra base fee is burnt by the burn ratio with the rest of the fees
+	burnt := sdk.NewCoins(sdk.NewInt64Coin("fee", 20))
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, burnt).Return(nil)
+	mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burnt).Return(nil)
+	require.NoError(t, k.ChargeExtraBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("fee", 140)), 1000, math.NewInt(40)))
+
+	// The fees must cover the base fee and the extra base fee
+	err := k.ChargeExtraBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("fee", 139)), 1000, math.NewInt(40))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// Fees in the bypass denoms don't pay the extra base fee
+	require.NoError(t, k.ChargeExtraBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 1000, math.NewInt(40)))
+
+	// Nothing is charged without an extra base fee
+	require.NoError(t, k.ChargeExtraBaseFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("fee", 1)), 1000, math.ZeroInt()))
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sunriselayer/sunrise/x/fee/types"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	swaptypes "github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// GetFeeSwapPool returns the pool against the fee denom with the largest current tick liquidity
+// among the pools whose liquidity reaches the min liquidity of the params.
+func (k Keeper) GetFeeSwapPool(ctx sdk.Context, denom string) (pool lptypes.Pool, found bool) {
+	params := k.GetParams(ctx)
+	for _, p := range k.liquidityPoolKeeper.GetPoolsByDenoms(ctx, denom, params.FeeDenom) {
+		if !p.CurrentTickLiquidity.IsPositive() || p.CurrentTickLiquidity.LT(params.FeeSwapMinLiquidity) {
+			continue
+		}
+		if !found || p.CurrentTickLiquidity.GT(pool.CurrentTickLiquidity) {
+			pool, found = p, true
+		}
+	}
+	return pool, found
+}
+
+// QuoteFeeInFeeDenom returns the amount of the fee denom the fee is expected to be swapped to.
+// The fee is valued at the lower of the spot price and the TWAP of the pool,
+// and the pool fee and the slippage buffer of the params are deducted.
+func (k Keeper) QuoteFeeInFeeDenom(ctx sdk.Context, fee sdk.Coin) (quote sdk.Coin, pool lptypes.Pool, err error) {
+	params := k.GetParams(ctx)
+
+	pool, found := k.GetFeeSwapPool(ctx, fee.Denom)
+	if !found {
+		return quote, pool, errorsmod.Wrapf(types.ErrFeeDenomNotSwappable, "denom: %s", fee.Denom)
+	}
+
+	value := convertWithPrice(fee.Amount, lptypes.SquareTruncate(pool.CurrentSqrtPrice), pool.DenomBase == fee.Denom)
+	if params.FeeSwapTwapPeriod > 0 {
+		end := ctx.BlockTime()
+		start := end.Add(-time.Duration(params.FeeSwapTwapPeriod) * time.Second)
+		// The spot price is used alone until the pool has observations over the period
+		if twap, _, err := k.liquidityPoolKeeper.GetTwap(ctx, pool.Id, start, end); err == nil {
+			value = math.LegacyMinDec(value, convertWithPrice(fee.Amount, twap, pool.DenomBase == fee.Denom))
+		}
+	}
+
+	value = value.Mul(math.LegacyOneDec().Sub(pool.FeeRate)).Mul(math.LegacyOneDec().Sub(params.FeeSwapSlippage))
+
+	return sdk.NewCoin(params.FeeDenom, value.TruncateInt()), pool, nil
+}
+
+// SwapFeeToFeeDenom swaps the fee paid by the payer to the fee denom and sends it to the fee collector.
+// The swap fails if the output is less than the quote.
+func (k Keeper) SwapFeeToFeeDenom(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	quote, pool, err := k.QuoteFeeInFeeDenom(ctx, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !quote.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is worth no %s", fee, quote.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	route := swaptypes.Route{
+		DenomIn:  fee.Denom,
+		DenomOut: quote.Denom,
+		Strategy: &swaptypes.Route_Pool{Pool: &swaptypes.RoutePool{PoolId: pool.Id}},
+	}
+	result, _, err := k.swapKeeper.SwapExactAmountIn(ctx, authtypes.NewModuleAddress(types.ModuleName), "", route, fee.Amount, quote.Amount)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "failed to swap fee %s: %s", fee, err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(result.TokenOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFeeSwapped{
+		TokenIn:  fee,
+		TokenOut: result.TokenOut,
+		PoolId:   pool.Id,
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return result.TokenOut, nil
+}
+
+// convertWithPrice converts the amount with the price of quote per base.
+func convertWithPrice(amount math.Int, price math.LegacyDec, isBase bool) math.LegacyDec {
+	if isBase {
+		return price.MulInt(amount)
+	}
+	if !price.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDecFromInt(amount).Quo(price)
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/fee/types"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	swaptypes "github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// feeSwapPools returns pools where 1 uusdc is worth 4 fee and 1 fee is worth 4 uatom
+func feeSwapPools() []lptypes.Pool {
+	return []lptypes.Pool{
+		{
+			Id:                   0,
+			DenomBase:            "uusdc",
+			DenomQuote:           "fee",
+			FeeRate:              math.LegacyZeroDec(),
+			CurrentTickLiquidity: math.LegacyNewDec(1000),
+			CurrentSqrtPrice:     math.LegacyNewDec(2),
+		},
+		{
+			Id:                   1,
+			DenomBase:            "uusdc",
+			DenomQuote:           "fee",
+			FeeRate:              math.LegacyZeroDec(),
+			CurrentTickLiquidity: math.LegacyNewDec(10),
+			CurrentSqrtPrice:     math.LegacyNewDec(3),
+		},
+		{
+			Id:                   2,
+			DenomBase:            "fee",
+			DenomQuote:           "uatom",
+			FeeRate:              math.LegacyNewDecWithPrec(1, 1),
+			CurrentTickLiquidity: math.LegacyNewDec(1000),
+			CurrentSqrtPrice:     math.LegacyNewDec(2),
+		},
+		{
+			Id:                   3,
+			DenomBase:            "ubtc",
+			DenomQuote:           "uatom",
+			FeeRate:              math.LegacyZeroDec(),
+			CurrentTickLiquidity: math.LegacyNewDec(1000),
+			CurrentSqrtPrice:     math.LegacyOneDec(),
+		},
+	}
+}
+
+// expectFeeSwapPools mocks the pair index of the liquidity pool keeper over feeSwapPools
+func expectFeeSwapPools(mocks keepertest.FeeMocks) *gomock.Call {
+	return mocks.LiquidityPoolKeeper.EXPECT().GetPoolsByDenoms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, denomBase, denomQuote string) []lptypes.Pool {
+			pools := []lptypes.Pool{}
+			for _, pool := range feeSwapPools() {
+				if (pool.DenomBase == denomBase && pool.DenomQuote == denomQuote) ||
+					(pool.DenomBase == denomQuote && pool.DenomQuote == denomBase) {
+					pools = append(pools, pool)
+				}
+			}
+			return pools
+		},
+	)
+}
+
+func TestQuoteFeeInFeeDenom(t *testing.T) {
+	tests := []struct {
+		desc         string
+		fee          sdk.Coin
+		minLiquidity math.LegacyDec
+		twap         math.LegacyDec
+		expQuote     int64
+		expPoolId    uint64
+		err          error
+	}{
+		{
+			desc:      "base denom",
+			fee:       sdk.NewInt64Coin("uusdc", 100),
+			expQuote:  380,
+			expPoolId: 0,
+		},
+		{
+			desc:      "quote denom with pool fee",
+			fee:       sdk.NewInt64Coin("uatom", 400),
+			expQuote:  85,
+			expPoolId: 2,
+		},
+		{
+			desc:      "lower twap",
+			fee:       sdk.NewInt64Coin("uusdc", 100),
+			twap:      math.LegacyNewDec(2),
+			expQuote:  190,
+			expPoolId: 0,
+		},
+		{
+			desc:      "higher twap",
+			fee:       sdk.NewInt64Coin("uusdc", 100),
+			twap:      math.LegacyNewDec(8),
+			expQuote:  380,
+			expPoolId: 0,
+		},
+		{
+			desc: "no pool against fee denom",
+			fee:  sdk.NewInt64Coin("ubtc", 100),
+			err:  types.ErrFeeDenomNotSwappable,
+		},
+		{
+			desc:         "pool not liquid enough",
+			fee:          sdk.NewInt64Coin("uusdc", 100),
+			minLiquidity: math.LegacyNewDec(10000),
+			err:          types.ErrFeeDenomNotSwappable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, mocks, ctx := keepertest.FeeKeeperWithMocks(t)
+			ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+			params := types.DefaultParams()
+			if !tc.minLiquidity.IsNil() {
+				params.FeeSwapMinLiquidity = tc.minLiquidity
+			}
+			require.NoError(t, k.SetParams(ctx, params))
+
+			expectFeeSwapPools(mocks).AnyTimes()
+			start := ctx.BlockTime().Add(-time.Duration(params.FeeSwapTwapPeriod) * time.Second)
+			if tc.twap.IsNil() {
+				mocks.LiquidityPoolKeeper.EXPECT().GetTwap(gomock.Any(), gomock.Any(), start, ctx.BlockTime()).Return(math.LegacyDec{}, math.LegacyDec{}, lptypes.ErrTwapNotAvailable).AnyTimes()
+			} else {
+				mocks.LiquidityPoolKeeper.EXPECT().GetTwap(gomock.Any(), tc.expPoolId, start, ctx.BlockTime()).Return(tc.twap, math.LegacyNewDec(1000), nil)
+			}
+
+			quote, pool, err := k.QuoteFeeInFeeDenom(ctx, tc.fee)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt64Coin("fee", tc.expQuote), quote)
+			require.Equal(t, tc.expPoolId, pool.Id)
+		})
+	}
+}
+
+func TestSwapFeeToFeeDenom(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	fee := sdk.NewInt64Coin("uusdc", 100)
+	route := swaptypes.Route{
+		DenomIn:  "uusdc",
+		DenomOut: "fee",
+		Strategy: &swaptypes.Route_Pool{Pool: &swaptypes.RoutePool{PoolId: 0}},
+	}
+
+	setup := func(t *testing.T) (keepertest.FeeMocks, func() (sdk.Coin, error)) {
+		k, mocks, ctx := keepertest.FeeKeeperWithMocks(t)
+		params := k.GetParams(ctx)
+		params.FeeSwapTwapPeriod = 0
+		require.NoError(t, k.SetParams(ctx, params))
+
+		expectFeeSwapPools(mocks)
+		mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, types.ModuleName, sdk.NewCoins(fee)).Return(nil)
+		return mocks, func() (sdk.Coin, error) {
+			return k.SwapFeeToFeeDenom(ctx, payer, fee)
+		}
+	}
+
+	t.Run("swapped", func(t *testing.T) {
+		mocks, swap := setup(t)
+		tokenOut := sdk.NewInt64Coin("fee", 395)
+		mocks.SwapKeeper.EXPECT().SwapExactAmountIn(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "", route, fee.Amount, math.NewInt(380)).
+			Return(swaptypes.RouteResult{TokenIn: fee, TokenOut: tokenOut}, math.ZeroInt(), nil)
+		mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(tokenOut)).Return(nil)
+
+		swapped, err := swap()
+		require.NoError(t, err)
+		require.Equal(t, tokenOut, swapped)
+	})
+
+	t.Run("output below quote", func(t *testing.T) {
+		mocks, swap := setup(t)
+		mocks.SwapKeeper.EXPECT().SwapExactAmountIn(gomock.Any(), gomock.Any(), "", route, fee.Amount, math.NewInt(380)).
+			Return(swaptypes.RouteResult{}, math.ZeroInt(), errors.New("slippage"))
+
+		_, err := swap()
+		require.Error(t, err)
+	})
+}
@@ -21,7 +21,10 @@ type (
 		// should be the x/gov module account.
 		authority string
 
-		bankKeeper types.BankKeeper
+		bankKeeper          types.BankKeeper
+		distributionKeeper  types.DistributionKeeper
+		swapKeeper          types.SwapKeeper
+		liquidityPoolKeeper types.LiquidityPoolKeeper
 	}
 )
 
@@ -31,6 +34,9 @@ func NewKeeper(
 	logger log.Logger,
 	authority string,
 	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	swapKeeper types.SwapKeeper,
+	liquidityPoolKeeper types.LiquidityPoolKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -42,6 +48,10 @@ func NewKeeper(
 		authority:    authority,
 		logger:       logger,
 		bankKeeper:   bankKeeper,
+
+		distributionKeeper:  distributionKeeper,
+		swapKeeper:          swapKeeper,
+		liquidityPoolKeeper: liquidityPoolKeeper,
 	}
 }
 
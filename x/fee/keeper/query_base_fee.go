@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunriselayer/sunrise/x/fee/types"
+)
+
+func (k Keeper) BaseFee(goCtx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+
+	return &types.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(params.FeeDenom, k.GetBaseFee(ctx))}, nil
+}
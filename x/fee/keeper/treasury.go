@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sunriselayer/sunrise/x/fee/types"
+)
+
+// DistributeTreasuryTax splits the treasury tax held by the module account `fromModule`
+// into the burn, community pool and vote rewards legs of the params.
+// The burn and community pool legs are paid out, and the vote rewards leg is returned
+// and left in `fromModule` for the caller to distribute.
+func (k Keeper) DistributeTreasuryTax(ctx sdk.Context, fromModule string, treasuryTax sdk.Coins) (voteRewards sdk.Coins, err error) {
+	params := k.GetParams(ctx)
+
+	burnCoins := sdk.NewCoins()
+	communityPoolCoins := sdk.NewCoins()
+	for _, coin := range treasuryTax {
+		burnAmount := params.TreasuryBurnRatio.MulInt(coin.Amount).TruncateInt()
+		if burnAmount.IsPositive() {
+			burnCoins = burnCoins.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		}
+		communityPoolAmount := params.TreasuryCommunityPoolRatio.MulInt(coin.Amount).TruncateInt()
+		if communityPoolAmount.IsPositive() {
+			communityPoolCoins = communityPoolCoins.Add(sdk.NewCoin(coin.Denom, communityPoolAmount))
+		}
+	}
+	voteRewards = treasuryTax.Sub(burnCoins...).Sub(communityPoolCoins...)
+
+	if !burnCoins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, types.ModuleName, burnCoins); err != nil {
+			return nil, err
+		}
+		if params.SwapTreasuryTaxToFeeDenom {
+			burnCoins, err = k.swapToFeeDenom(ctx, params.FeeDenom, burnCoins)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return nil, err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTreasuryTaxBurnt{
+			Fees: burnCoins,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if !communityPoolCoins.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, communityPoolCoins, authtypes.NewModuleAddress(fromModule)); err != nil {
+			return nil, err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTreasuryTaxToCommunityPool{
+			Fees: communityPoolCoins,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if !voteRewards.IsZero() {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTreasuryTaxToVoteRewards{
+			Fees: voteRewards,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return voteRewards, nil
+}
+
+// swapToFeeDenom swaps the coins held by the module account to the fee denom through the optimal route.
+// Coins without a route or whose swap fails are returned as they are.
+func (k Keeper) swapToFeeDenom(ctx sdk.Context, feeDenom string, coins sdk.Coins) (sdk.Coins, error) {
+	sender := authtypes.NewModuleAddress(types.ModuleName)
+
+	swapped := sdk.NewCoins()
+	for _, coin := range coins {
+		if coin.Denom == feeDenom {
+			swapped = swapped.Add(coin)
+			continue
+		}
+
+		amountOut, err := k.swapExactAmountIn(ctx, sender, coin, feeDenom)
+		if err != nil {
+			k.Logger().Info("treasury tax not swapped to fee denom", "denom", coin.Denom, "error", err)
+			swapped = swapped.Add(coin)
+			continue
+		}
+		tokenOut := sdk.NewCoin(feeDenom, amountOut)
+		swapped = swapped.Add(tokenOut)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTreasuryTaxSwapped{
+			TokenIn:  coin,
+			TokenOut: tokenOut,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return swapped, nil
+}
+
+// swapExactAmountIn swaps in a cached context which is committed only on success
+func (k Keeper) swapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, denomOut string) (math.Int, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	route, result, err := k.swapKeeper.FindOptimalRouteExactAmountIn(cacheCtx, tokenIn.Denom, denomOut, tokenIn.Amount, 0, 1)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	result, _, err = k.swapKeeper.SwapExactAmountIn(cacheCtx, sender, "", route, tokenIn.Amount, result.TokenOut.Amount)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	write()
+	return result.TokenOut.Amount, nil
+}
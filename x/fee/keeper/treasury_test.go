@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/fee/types"
+	swaptypes "github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestDistributeTreasuryTax(t *testing.T) {
+	const fromModule = "liquiditypool"
+	sender := authtypes.NewModuleAddress(fromModule)
+	feeModule := authtypes.NewModuleAddress(types.ModuleName)
+	route := swaptypes.Route{
+		DenomIn:  "uusdc",
+		DenomOut: "fee",
+		Strategy: &swaptypes.Route_Pool{Pool: &swaptypes.RoutePool{PoolId: 0}},
+	}
+
+	tests := []struct {
+		desc           string
+		burnRatio      math.LegacyDec
+		communityRatio math.LegacyDec
+		swap           bool
+		treasuryTax    sdk.Coins
+		setup          func(m keepertest.FeeMocks)
+		expVoteRewards sdk.Coins
+	}{
+		{
+			desc:           "all to vote rewards",
+			burnRatio:      math.LegacyZeroDec(),
+			communityRatio: math.LegacyZeroDec(),
+			treasuryTax:    sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)),
+			setup:          func(m keepertest.FeeMocks) {},
+			expVoteRewards: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)),
+		},
+		{
+			desc:           "split without swap",
+			burnRatio:      math.LegacyNewDecWithPrec(3, 1),
+			communityRatio: math.LegacyNewDecWithPrec(1, 1),
+			treasuryTax:    sdk.NewCoins(sdk.NewInt64Coin("fee", 100), sdk.NewInt64Coin("uusdc", 1000)),
+			setup: func(m keepertest.FeeMocks) {
+				burnt := sdk.NewCoins(sdk.NewInt64Coin("fee", 30), sdk.NewInt64Coin("uusdc", 300))
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), fromModule, types.ModuleName, burnt).Return(nil)
+				m.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burnt).Return(nil)
+				m.DistributionKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("fee", 10), sdk.NewInt64Coin("uusdc", 100)), sender).Return(nil)
+			},
+			expVoteRewards: sdk.NewCoins(sdk.NewInt64Coin("fee", 60), sdk.NewInt64Coin("uusdc", 600)),
+		},
+		{
+			desc:           "swap burnt tax to fee denom",
+			burnRatio:      math.LegacyNewDecWithPrec(5, 1),
+			communityRatio: math.LegacyZeroDec(),
+			swap:           true,
+			treasuryTax:    sdk.NewCoins(sdk.NewInt64Coin("fee", 100), sdk.NewInt64Coin("uusdc", 1000)),
+			setup: func(m keepertest.FeeMocks) {
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), fromModule, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("fee", 50), sdk.NewInt64Coin("uusdc", 500))).Return(nil)
+				result := swaptypes.RouteResult{TokenIn: sdk.NewInt64Coin("uusdc", 500), TokenOut: sdk.NewInt64Coin("fee", 250)}
+				m.SwapKeeper.EXPECT().FindOptimalRouteExactAmountIn(gomock.Any(), "uusdc", "fee", math.NewInt(500), uint32(0), uint32(1)).Return(route, result, nil)
+				m.SwapKeeper.EXPECT().SwapExactAmountIn(gomock.Any(), feeModule, "", route, math.NewInt(500), math.NewInt(250)).Return(result, math.ZeroInt(), nil)
+				m.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("fee", 300))).Return(nil)
+			},
+			expVoteRewards: sdk.NewCoins(sdk.NewInt64Coin("fee", 50), sdk.NewInt64Coin("uusdc", 500)),
+		},
+		{
+			desc:           "burn as is without route",
+			burnRatio:      math.LegacyNewDecWithPrec(5, 1),
+			communityRatio: math.LegacyZeroDec(),
+			swap:           true,
+			treasuryTax:    sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)),
+			setup: func(m keepertest.FeeMocks) {
+				burnt := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500))
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), fromModule, types.ModuleName, burnt).Return(nil)
+				m.SwapKeeper.EXPECT().FindOptimalRouteExactAmountIn(gomock.Any(), "uusdc", "fee", math.NewInt(500), uint32(0), uint32(1)).Return(swaptypes.Route{}, swaptypes.RouteResult{}, errors.New("no route"))
+				m.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burnt).Return(nil)
+			},
+			expVoteRewards: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, mocks, ctx := keepertest.FeeKeeperWithMocks(t)
+			params := types.DefaultParams()
+			params.TreasuryBurnRatio = tc.burnRatio
+			params.TreasuryCommunityPoolRatio = tc.communityRatio
+			params.SwapTreasuryTaxToFeeDenom = tc.swap
+			require.NoError(t, k.SetParams(ctx, params))
+			tc.setup(mocks)
+
+			voteRewards, err := k.DistributeTreasuryTax(ctx, fromModule, tc.treasuryTax)
+			require.NoError(t, err)
+			require.Equal(t, tc.expVoteRewards, voteRewards)
+		})
+	}
+}
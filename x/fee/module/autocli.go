@@ -17,6 +17,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Shows the current base fee, the minimum gas price of txs",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
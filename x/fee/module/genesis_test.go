@@ -3,6 +3,8 @@ package fee_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
@@ -12,7 +14,8 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:  types.DefaultParams(),
+		BaseFee: math.LegacyNewDecWithPrec(25, 4),
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -25,5 +28,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.BaseFee, got.BaseFee)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,325 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/fee/types/expected_keepers.go
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	math "cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "github.com/golang/mock/gomock"
+	types0 "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	types1 "github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// MockAccountKeeper is a mock of AccountKeeper interface.
+type MockAccountKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountKeeperMockRecorder
+}
+
+// MockAccountKeeperMockRecorder is the mock recorder for MockAccountKeeper.
+type MockAccountKeeperMockRecorder struct {
+	mock *MockAccountKeeper
+}
+
+// NewMockAccountKeeper creates a new mock instance.
+func NewMockAccountKeeper(ctrl *gomock.Controller) *MockAccountKeeper {
+	mock := &MockAccountKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAccount mocks base method.
+func (m *MockAccountKeeper) GetAccount(arg0 context.Context, arg1 types.AccAddress) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAccountKeeperMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), arg0, arg1)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(arg0 context.Context, arg1 types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
+}
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// MockSwapKeeper is a mock of SwapKeeper interface.
+type MockSwapKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSwapKeeperMockRecorder
+}
+
+// MockSwapKeeperMockRecorder is the mock recorder for MockSwapKeeper.
+type MockSwapKeeperMockRecorder struct {
+	mock *MockSwapKeeper
+}
+
+// NewMockSwapKeeper creates a new mock instance.
+func NewMockSwapKeeper(ctrl *gomock.Controller) *MockSwapKeeper {
+	mock := &MockSwapKeeper{ctrl: ctrl}
+	mock.recorder = &MockSwapKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwapKeeper) EXPECT() *MockSwapKeeperMockRecorder {
+	return m.recorder
+}
+
+// FindOptimalRouteExactAmountIn mocks base method.
+func (m *MockSwapKeeper) FindOptimalRouteExactAmountIn(ctx types.Context, denomIn, denomOut string, amountIn math.Int, maxHops, maxSplits uint32) (types1.Route, types1.RouteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOptimalRouteExactAmountIn", ctx, denomIn, denomOut, amountIn, maxHops, maxSplits)
+	ret0, _ := ret[0].(types1.Route)
+	ret1, _ := ret[1].(types1.RouteResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOptimalRouteExactAmountIn indicates an expected call of FindOptimalRouteExactAmountIn.
+func (mr *MockSwapKeeperMockRecorder) FindOptimalRouteExactAmountIn(ctx, denomIn, denomOut, amountIn, maxHops, maxSplits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOptimalRouteExactAmountIn", reflect.TypeOf((*MockSwapKeeper)(nil).FindOptimalRouteExactAmountIn), ctx, denomIn, denomOut, amountIn, maxHops, maxSplits)
+}
+
+// SwapExactAmountIn mocks base method.
+func (m *MockSwapKeeper) SwapExactAmountIn(ctx types.Context, sender types.AccAddress, interfaceProvider string, route types1.Route, amountIn, minAmountOut math.Int) (types1.RouteResult, math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapExactAmountIn", ctx, sender, interfaceProvider, route, amountIn, minAmountOut)
+	ret0, _ := ret[0].(types1.RouteResult)
+	ret1, _ := ret[1].(math.Int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SwapExactAmountIn indicates an expected call of SwapExactAmountIn.
+func (mr *MockSwapKeeperMockRecorder) SwapExactAmountIn(ctx, sender, interfaceProvider, route, amountIn, minAmountOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountIn", reflect.TypeOf((*MockSwapKeeper)(nil).SwapExactAmountIn), ctx, sender, interfaceProvider, route, amountIn, minAmountOut)
+}
+
+// MockLiquidityPoolKeeper is a mock of LiquidityPoolKeeper interface.
+type MockLiquidityPoolKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiquidityPoolKeeperMockRecorder
+}
+
+// MockLiquidityPoolKeeperMockRecorder is the mock recorder for MockLiquidityPoolKeeper.
+type MockLiquidityPoolKeeperMockRecorder struct {
+	mock *MockLiquidityPoolKeeper
+}
+
+// NewMockLiquidityPoolKeeper creates a new mock instance.
+func NewMockLiquidityPoolKeeper(ctrl *gomock.Controller) *MockLiquidityPoolKeeper {
+	mock := &MockLiquidityPoolKeeper{ctrl: ctrl}
+	mock.recorder = &MockLiquidityPoolKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiquidityPoolKeeper) EXPECT() *MockLiquidityPoolKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetPoolsByDenoms mocks base method.
+func (m *MockLiquidityPoolKeeper) GetPoolsByDenoms(ctx context.Context, denomBase, denomQuote string) []types0.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolsByDenoms", ctx, denomBase, denomQuote)
+	ret0, _ := ret[0].([]types0.Pool)
+	return ret0
+}
+
+// GetPoolsByDenoms indicates an expected call of GetPoolsByDenoms.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetPoolsByDenoms(ctx, denomBase, denomQuote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolsByDenoms", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPoolsByDenoms), ctx, denomBase, denomQuote)
+}
+
+// GetTwap mocks base method.
+func (m *MockLiquidityPoolKeeper) GetTwap(ctx types.Context, poolId uint64, start, end time.Time) (math.LegacyDec, math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwap", ctx, poolId, start, end)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(math.LegacyDec)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTwap indicates an expected call of GetTwap.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetTwap(ctx, poolId, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwap", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetTwap), ctx, poolId, start, end)
+}
+
+// MockParamSubspace is a mock of ParamSubspace interface.
+type MockParamSubspace struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamSubspaceMockRecorder
+}
+
+// MockParamSubspaceMockRecorder is the mock recorder for MockParamSubspace.
+type MockParamSubspaceMockRecorder struct {
+	mock *MockParamSubspace
+}
+
+// NewMockParamSubspace creates a new mock instance.
+func NewMockParamSubspace(ctrl *gomock.Controller) *MockParamSubspace {
+	mock := &MockParamSubspace{ctrl: ctrl}
+	mock.recorder = &MockParamSubspaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamSubspace) EXPECT() *MockParamSubspaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockParamSubspace) Get(arg0 context.Context, arg1 []byte, arg2 interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockParamSubspaceMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockParamSubspace)(nil).Get), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockParamSubspace) Set(arg0 context.Context, arg1 []byte, arg2 interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockParamSubspaceMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockParamSubspace)(nil).Set), arg0, arg1, arg2)
+}
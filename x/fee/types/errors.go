@@ -14,4 +14,14 @@ var (
 	ErrEmptyFeeDenom    = sdkerrors.Register(ModuleName, 1200, "fee denom cannot be empty")
 	ErrInvalidBurnRatio = sdkerrors.Register(ModuleName, 1201, "burn ratio must be positive and less than 1")
 	ErrEmptyBypassDenom = sdkerrors.Register(ModuleName, 1202, "bypass denom cannot be empty")
+
+	ErrInvalidTreasuryRatio = sdkerrors.Register(ModuleName, 1203, "treasury burn and community pool ratios must be non-negative and sum to at most 1")
+
+	ErrInvalidFeeSwapSlippage     = sdkerrors.Register(ModuleName, 1204, "fee swap slippage must be non-negative and less than 1")
+	ErrInvalidFeeSwapMinLiquidity = sdkerrors.Register(ModuleName, 1205, "fee swap min liquidity must be non-negative")
+	ErrFeeDenomNotSwappable       = sdkerrors.Register(ModuleName, 1206, "no liquid pool against the fee denom")
+
+	ErrInvalidBaseFee                  = sdkerrors.Register(ModuleName, 1207, "base fee must be non-negative")
+	ErrInvalidBaseFeeChangeDenominator = sdkerrors.Register(ModuleName, 1208, "base fee change denominator must be positive")
+	ErrInvalidTargetBlockGas           = sdkerrors.Register(ModuleName, 1209, "target block gas must be positive")
 )
@@ -70,30 +70,334 @@ func (m *EventFeeBurnt) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+type EventTreasuryTaxSwapped struct {
+	TokenIn  types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOut types.Coin `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out"`
+}
+
+func (m *EventTreasuryTaxSwapped) Reset()         { *m = EventTreasuryTaxSwapped{} }
+func (m *EventTreasuryTaxSwapped) String() string { return proto.CompactTextString(m) }
+func (*EventTreasuryTaxSwapped) ProtoMessage()    {}
+func (*EventTreasuryTaxSwapped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{1}
+}
+func (m *EventTreasuryTaxSwapped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasuryTaxSwapped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasuryTaxSwapped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasuryTaxSwapped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasuryTaxSwapped.Merge(m, src)
+}
+func (m *EventTreasuryTaxSwapped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasuryTaxSwapped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasuryTaxSwapped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasuryTaxSwapped proto.InternalMessageInfo
+
+func (m *EventTreasuryTaxSwapped) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *EventTreasuryTaxSwapped) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+type EventTreasuryTaxBurnt struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventTreasuryTaxBurnt) Reset()         { *m = EventTreasuryTaxBurnt{} }
+func (m *EventTreasuryTaxBurnt) String() string { return proto.CompactTextString(m) }
+func (*EventTreasuryTaxBurnt) ProtoMessage()    {}
+func (*EventTreasuryTaxBurnt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{2}
+}
+func (m *EventTreasuryTaxBurnt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasuryTaxBurnt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasuryTaxBurnt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasuryTaxBurnt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasuryTaxBurnt.Merge(m, src)
+}
+func (m *EventTreasuryTaxBurnt) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasuryTaxBurnt) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasuryTaxBurnt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasuryTaxBurnt proto.InternalMessageInfo
+
+func (m *EventTreasuryTaxBurnt) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+type EventTreasuryTaxToCommunityPool struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventTreasuryTaxToCommunityPool) Reset()         { *m = EventTreasuryTaxToCommunityPool{} }
+func (m *EventTreasuryTaxToCommunityPool) String() string { return proto.CompactTextString(m) }
+func (*EventTreasuryTaxToCommunityPool) ProtoMessage()    {}
+func (*EventTreasuryTaxToCommunityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{3}
+}
+func (m *EventTreasuryTaxToCommunityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasuryTaxToCommunityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasuryTaxToCommunityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasuryTaxToCommunityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasuryTaxToCommunityPool.Merge(m, src)
+}
+func (m *EventTreasuryTaxToCommunityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasuryTaxToCommunityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasuryTaxToCommunityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasuryTaxToCommunityPool proto.InternalMessageInfo
+
+func (m *EventTreasuryTaxToCommunityPool) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+type EventTreasuryTaxToVoteRewards struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventTreasuryTaxToVoteRewards) Reset()         { *m = EventTreasuryTaxToVoteRewards{} }
+func (m *EventTreasuryTaxToVoteRewards) String() string { return proto.CompactTextString(m) }
+func (*EventTreasuryTaxToVoteRewards) ProtoMessage()    {}
+func (*EventTreasuryTaxToVoteRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{4}
+}
+func (m *EventTreasuryTaxToVoteRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasuryTaxToVoteRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasuryTaxToVoteRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasuryTaxToVoteRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasuryTaxToVoteRewards.Merge(m, src)
+}
+func (m *EventTreasuryTaxToVoteRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasuryTaxToVoteRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasuryTaxToVoteRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasuryTaxToVoteRewards proto.InternalMessageInfo
+
+func (m *EventTreasuryTaxToVoteRewards) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+type EventFeeSwapped struct {
+	TokenIn  types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	TokenOut types.Coin `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out"`
+	PoolId   uint64     `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *EventFeeSwapped) Reset()         { *m = EventFeeSwapped{} }
+func (m *EventFeeSwapped) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSwapped) ProtoMessage()    {}
+func (*EventFeeSwapped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{5}
+}
+func (m *EventFeeSwapped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSwapped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSwapped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSwapped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSwapped.Merge(m, src)
+}
+func (m *EventFeeSwapped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSwapped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSwapped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSwapped proto.InternalMessageInfo
+
+func (m *EventFeeSwapped) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *EventFeeSwapped) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *EventFeeSwapped) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type EventBaseFeeBurnt struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EventBaseFeeBurnt) Reset()         { *m = EventBaseFeeBurnt{} }
+func (m *EventBaseFeeBurnt) String() string { return proto.CompactTextString(m) }
+func (*EventBaseFeeBurnt) ProtoMessage()    {}
+func (*EventBaseFeeBurnt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fa464a73c5ec94d, []int{6}
+}
+func (m *EventBaseFeeBurnt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBaseFeeBurnt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBaseFeeBurnt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBaseFeeBurnt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBaseFeeBurnt.Merge(m, src)
+}
+func (m *EventBaseFeeBurnt) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBaseFeeBurnt) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBaseFeeBurnt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBaseFeeBurnt proto.InternalMessageInfo
+
+func (m *EventBaseFeeBurnt) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventFeeBurnt)(nil), "sunrise.fee.EventFeeBurnt")
+	proto.RegisterType((*EventTreasuryTaxSwapped)(nil), "sunrise.fee.EventTreasuryTaxSwapped")
+	proto.RegisterType((*EventTreasuryTaxBurnt)(nil), "sunrise.fee.EventTreasuryTaxBurnt")
+	proto.RegisterType((*EventTreasuryTaxToCommunityPool)(nil), "sunrise.fee.EventTreasuryTaxToCommunityPool")
+	proto.RegisterType((*EventTreasuryTaxToVoteRewards)(nil), "sunrise.fee.EventTreasuryTaxToVoteRewards")
+	proto.RegisterType((*EventFeeSwapped)(nil), "sunrise.fee.EventFeeSwapped")
+	proto.RegisterType((*EventBaseFeeBurnt)(nil), "sunrise.fee.EventBaseFeeBurnt")
 }
 
 func init() { proto.RegisterFile("sunrise/fee/events.proto", fileDescriptor_9fa464a73c5ec94d) }
 
 var fileDescriptor_9fa464a73c5ec94d = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0x4b, 0x4d, 0xd5, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xca, 0xe8, 0xa5, 0xa5, 0xa6, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc,
-	0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x95, 0x92, 0x83, 0xf0, 0xf4, 0x93, 0x12, 0x8b, 0x53, 0xf5,
-	0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0x20, 0xf2, 0x4a, 0x05,
-	0x5c, 0xbc, 0xae, 0x20, 0xdb, 0xdc, 0x52, 0x53, 0x9d, 0x4a, 0x8b, 0xf2, 0x4a, 0x84, 0xe2, 0xb9,
-	0x58, 0xd2, 0x52, 0x53, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0xa0, 0xa6,
-	0x81, 0xf4, 0xeb, 0x41, 0xf5, 0xeb, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0x19, 0x9c, 0xb8, 0x27, 0xcf,
-	0xb0, 0xea, 0xbe, 0xbc, 0x46, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xd4,
-	0x6a, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x0c, 0xd6, 0x50, 0x1c,
-	0x04, 0x36, 0xd8, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4,
-	0x91, 0x4c, 0x82, 0x7a, 0x3a, 0x27, 0xb1, 0x32, 0xb5, 0x08, 0xc6, 0xd1, 0xaf, 0x00, 0x87, 0x0e,
-	0xd8, 0xc8, 0x24, 0x36, 0xb0, 0xfb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x2f, 0x2d,
-	0x42, 0x39, 0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0xaa, 0xd3, 0x40,
+	0x14, 0xce, 0x78, 0xcb, 0xbd, 0x3a, 0x17, 0x11, 0x83, 0xd2, 0xb4, 0x60, 0x5a, 0xb2, 0x2a, 0x88,
+	0x19, 0xab, 0x3b, 0x71, 0x95, 0x52, 0xa1, 0x2b, 0x25, 0x16, 0x17, 0x6e, 0xc2, 0xa4, 0x39, 0xad,
+	0xa1, 0xcd, 0x9c, 0x90, 0x99, 0xb4, 0xcd, 0x4e, 0x7c, 0x02, 0xc1, 0x57, 0x70, 0xe5, 0x93, 0x74,
+	0xd9, 0xa5, 0x2b, 0x95, 0xf6, 0x45, 0x24, 0x93, 0x14, 0xa4, 0x82, 0xb8, 0x69, 0xef, 0x2a, 0xf9,
+	0xf2, 0xe5, 0xfb, 0x61, 0x98, 0x73, 0xa8, 0x25, 0x73, 0x91, 0xc5, 0x12, 0xd8, 0x14, 0x80, 0xc1,
+	0x12, 0x84, 0x92, 0x6e, 0x9a, 0xa1, 0x42, 0xf3, 0xba, 0x66, 0xdc, 0x29, 0x40, 0xfb, 0xc1, 0x0c,
+	0x67, 0xa8, 0xbf, 0xb3, 0xf2, 0xad, 0xfa, 0xa5, 0xdd, 0x9a, 0xa0, 0x4c, 0x50, 0x06, 0x15, 0x51,
+	0x81, 0x9a, 0xb2, 0x2b, 0xc4, 0x42, 0x2e, 0x81, 0x2d, 0xfb, 0x21, 0x28, 0xde, 0x67, 0x13, 0x8c,
+	0x45, 0xc5, 0x3b, 0x29, 0xbd, 0x3b, 0x2c, 0xd3, 0x5e, 0x01, 0x78, 0x79, 0x26, 0x94, 0x19, 0xd0,
+	0xc6, 0x14, 0x40, 0x5a, 0xa4, 0x7b, 0xd1, 0xbb, 0x7e, 0xd6, 0x72, 0x6b, 0xb7, 0x52, 0xef, 0xd6,
+	0x7a, 0x77, 0x80, 0xb1, 0xf0, 0x9e, 0x6e, 0x7e, 0x74, 0x8c, 0x6f, 0x3f, 0x3b, 0xbd, 0x59, 0xac,
+	0x3e, 0xe4, 0xa1, 0x3b, 0xc1, 0xa4, 0x8e, 0xae, 0x1f, 0x4f, 0x64, 0x34, 0x67, 0xaa, 0x48, 0x41,
+	0x6a, 0x81, 0xf4, 0xb5, 0xb1, 0xf3, 0x85, 0xd0, 0xa6, 0x8e, 0x1c, 0x67, 0xc0, 0x65, 0x9e, 0x15,
+	0x63, 0xbe, 0x7e, 0xbb, 0xe2, 0x69, 0x0a, 0x91, 0xf9, 0x82, 0xde, 0x56, 0x38, 0x07, 0x11, 0xc4,
+	0xc2, 0x22, 0x5d, 0xf2, 0xef, 0x02, 0x8d, 0xb2, 0x80, 0x7f, 0xa5, 0x05, 0x23, 0x61, 0xbe, 0xa4,
+	0x77, 0x2a, 0x2d, 0xe6, 0xca, 0xba, 0xf5, 0x7f, 0xe2, 0x2a, 0xed, 0x75, 0xae, 0x9c, 0x35, 0x7d,
+	0x78, 0x5c, 0xea, 0x4c, 0xe7, 0xf1, 0x89, 0xd0, 0xce, 0x71, 0xf4, 0x18, 0x07, 0x98, 0x24, 0xb9,
+	0x88, 0x55, 0xf1, 0x06, 0x71, 0x71, 0xfa, 0x12, 0x1f, 0x09, 0x7d, 0xf4, 0x77, 0x89, 0x77, 0xa8,
+	0xc0, 0x87, 0x15, 0xcf, 0x22, 0x79, 0xfa, 0x0a, 0x5f, 0x09, 0xbd, 0x77, 0xb8, 0x8a, 0x37, 0x7e,
+	0x1f, 0xcc, 0x26, 0xbd, 0x4a, 0x11, 0x17, 0x41, 0x1c, 0x59, 0x17, 0x5d, 0xd2, 0x6b, 0xf8, 0x97,
+	0x25, 0x1c, 0x45, 0x8e, 0xa2, 0xf7, 0x75, 0x4b, 0x8f, 0x4b, 0x38, 0xdb, 0xd0, 0x78, 0xc3, 0xcd,
+	0xce, 0x26, 0xdb, 0x9d, 0x4d, 0x7e, 0xed, 0x6c, 0xf2, 0x79, 0x6f, 0x1b, 0xdb, 0xbd, 0x6d, 0x7c,
+	0xdf, 0xdb, 0xc6, 0xfb, 0xc7, 0x7f, 0x38, 0xd5, 0x9b, 0x62, 0xc1, 0x0b, 0xc8, 0x0e, 0x80, 0xad,
+	0xf5, 0x4a, 0xd1, 0x96, 0xe1, 0xa5, 0x1e, 0xfa, 0xe7, 0xbf, 0x07, 0x00, 0x16, 0x52, 0xb5, 0x4b,
+	0x6e, 0x04, 0x00, 0x00,
 }
 
 func (m *EventFeeBurnt) Marshal() (dAtA []byte, err error) {
@@ -133,40 +437,368 @@ func (m *EventFeeBurnt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventTreasuryTaxSwapped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventFeeBurnt) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventTreasuryTaxSwapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTreasuryTaxSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTreasuryTaxBurnt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTreasuryTaxBurnt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTreasuryTaxBurnt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventTreasuryTaxToCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventTreasuryTaxToCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventFeeBurnt) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *EventTreasuryTaxToCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTreasuryTaxToVoteRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTreasuryTaxToVoteRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTreasuryTaxToVoteRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSwapped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSwapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBaseFeeBurnt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBaseFeeBurnt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBaseFeeBurnt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFeeBurnt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTreasuryTaxSwapped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTreasuryTaxBurnt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTreasuryTaxToCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTreasuryTaxToVoteRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventFeeSwapped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EventBaseFeeBurnt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFeeBurnt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -249,6 +881,593 @@ func (m *EventFeeBurnt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventTreasuryTaxSwapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasuryTaxSwapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasuryTaxSwapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTreasuryTaxBurnt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasuryTaxBurnt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasuryTaxBurnt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTreasuryTaxToCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasuryTaxToCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasuryTaxToCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTreasuryTaxToVoteRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasuryTaxToVoteRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasuryTaxToVoteRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeSwapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeSwapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeSwapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBaseFeeBurnt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBaseFeeBurnt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBaseFeeBurnt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
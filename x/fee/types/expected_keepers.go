@@ -2,8 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	swaptypes "github.com/sunriselayer/sunrise/x/swap/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -18,9 +23,27 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// SwapKeeper defines the expected interface for the Swap module.
+type SwapKeeper interface {
+	FindOptimalRouteExactAmountIn(ctx sdk.Context, denomIn, denomOut string, amountIn math.Int, maxHops, maxSplits uint32) (route swaptypes.Route, result swaptypes.RouteResult, err error)
+	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, interfaceProvider string, route swaptypes.Route, amountIn math.Int, minAmountOut math.Int) (result swaptypes.RouteResult, interfaceFee math.Int, err error)
+}
+
+// LiquidityPoolKeeper defines the expected interface for the LiquidityPool module.
+type LiquidityPoolKeeper interface {
+	GetPoolsByDenoms(ctx context.Context, denomBase, denomQuote string) []lptypes.Pool
+	GetTwap(ctx sdk.Context, poolId uint64, start, end time.Time) (price math.LegacyDec, averageLiquidity math.LegacyDec, err error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -9,7 +9,8 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:  DefaultParams(),
+		BaseFee: DefaultParams().MinBaseFee,
 	}
 }
 
@@ -18,5 +19,9 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if gs.BaseFee.IsNil() || gs.BaseFee.IsNegative() {
+		return ErrInvalidBaseFee
+	}
+
 	return gs.Params.Validate()
 }
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/require"
 	"github.com/sunriselayer/sunrise/x/fee/types"
 )
@@ -21,11 +23,32 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-				Params: types.DefaultParams(),
+				Params:  types.DefaultParams(),
+				BaseFee: math.LegacyNewDecWithPrec(2, 3),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "treasury ratios over one",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.TreasuryBurnRatio = math.LegacyNewDecWithPrec(6, 1)
+					params.TreasuryCommunityPoolRatio = math.LegacyNewDecWithPrec(5, 1)
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative base fee",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				BaseFee: math.LegacyNewDec(-1),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
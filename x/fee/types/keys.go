@@ -13,6 +13,8 @@ const (
 
 var (
 	ParamsKey = []byte("p_fee")
+
+	BaseFeeKey = []byte("BaseFee/value/")
 )
 
 func KeyPrefix(p string) []byte {
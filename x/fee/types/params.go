@@ -14,11 +14,33 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(feeDenom string, burnRatio math.LegacyDec, bypassDenoms []string) Params {
+func NewParams(
+	feeDenom string,
+	burnRatio math.LegacyDec,
+	bypassDenoms []string,
+	treasuryBurnRatio math.LegacyDec,
+	treasuryCommunityPoolRatio math.LegacyDec,
+	swapTreasuryTaxToFeeDenom bool,
+	feeSwapSlippage math.LegacyDec,
+	feeSwapTwapPeriod uint64,
+	feeSwapMinLiquidity math.LegacyDec,
+	minBaseFee math.LegacyDec,
+	baseFeeChangeDenominator uint64,
+	targetBlockGas uint64,
+) Params {
 	return Params{
-		FeeDenom:     feeDenom,
-		BurnRatio:    burnRatio,
-		BypassDenoms: bypassDenoms,
+		FeeDenom:                   feeDenom,
+		BurnRatio:                  burnRatio,
+		BypassDenoms:               bypassDenoms,
+		TreasuryBurnRatio:          treasuryBurnRatio,
+		TreasuryCommunityPoolRatio: treasuryCommunityPoolRatio,
+		SwapTreasuryTaxToFeeDenom:  swapTreasuryTaxToFeeDenom,
+		FeeSwapSlippage:            feeSwapSlippage,
+		FeeSwapTwapPeriod:          feeSwapTwapPeriod,
+		FeeSwapMinLiquidity:        feeSwapMinLiquidity,
+		MinBaseFee:                 minBaseFee,
+		BaseFeeChangeDenominator:   baseFeeChangeDenominator,
+		TargetBlockGas:             targetBlockGas,
 	}
 }
 
@@ -28,6 +50,15 @@ func DefaultParams() Params {
 		"fee",
 		math.LegacyMustNewDecFromStr("0.5"),
 		[]string{"stake"},
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		false,
+		math.LegacyMustNewDecFromStr("0.05"),
+		600,
+		math.LegacyZeroDec(),
+		math.LegacyMustNewDecFromStr("0.002"),
+		8,
+		10_000_000,
 	)
 }
 
@@ -46,6 +77,34 @@ func (p Params) Validate() error {
 		return ErrInvalidBurnRatio
 	}
 
+	if p.TreasuryBurnRatio.IsNil() || p.TreasuryBurnRatio.IsNegative() ||
+		p.TreasuryCommunityPoolRatio.IsNil() || p.TreasuryCommunityPoolRatio.IsNegative() ||
+		p.TreasuryBurnRatio.Add(p.TreasuryCommunityPoolRatio).GT(math.LegacyOneDec()) {
+		return ErrInvalidTreasuryRatio
+	}
+
+	if p.FeeSwapSlippage.IsNil() || p.FeeSwapSlippage.IsNegative() || p.FeeSwapSlippage.GTE(math.LegacyOneDec()) {
+		return ErrInvalidFeeSwapSlippage
+	}
+
+	if p.FeeSwapMinLiquidity.IsNil() || p.FeeSwapMinLiquidity.IsNegative() {
+		return ErrInvalidFeeSwapMinLiquidity
+	}
+
+	// The base fee is updated proportionally to itself, so that it would never
+	// leave zero
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return ErrInvalidBaseFee
+	}
+
+	if p.BaseFeeChangeDenominator == 0 {
+		return ErrInvalidBaseFeeChangeDenominator
+	}
+
+	if p.TargetBlockGas == 0 {
+		return ErrInvalidTargetBlockGas
+	}
+
 	for _, denom := range p.BypassDenoms {
 		if denom == "" {
 			return ErrEmptyBypassDenom
@@ -54,3 +113,16 @@ func (p Params) Validate() error {
 
 	return nil
 }
+
+// IsFeeDenomOrBypass returns true if fees in the denom are taken without a swap
+func (p Params) IsFeeDenomOrBypass(denom string) bool {
+	if denom == p.FeeDenom {
+		return true
+	}
+	for _, bypassDenom := range p.BypassDenoms {
+		if denom == bypassDenom {
+			return true
+		}
+	}
+	return false
+}
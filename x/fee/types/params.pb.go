@@ -31,6 +31,30 @@ type Params struct {
 	FeeDenom     string                      `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
 	BurnRatio    cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
 	BypassDenoms []string                    `protobuf:"bytes,3,rep,name=bypass_denoms,json=bypassDenoms,proto3" json:"bypass_denoms,omitempty"`
+	// Ratio of the swap treasury tax burnt
+	TreasuryBurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=treasury_burn_ratio,json=treasuryBurnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_burn_ratio"`
+	// Ratio of the swap treasury tax sent to the community pool.
+	// The rest is paid as vote rewards.
+	TreasuryCommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=treasury_community_pool_ratio,json=treasuryCommunityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_community_pool_ratio"`
+	// If true the burnt treasury tax in other denoms is swapped to fee_denom
+	// before burning
+	SwapTreasuryTaxToFeeDenom bool `protobuf:"varint,6,opt,name=swap_treasury_tax_to_fee_denom,json=swapTreasuryTaxToFeeDenom,proto3" json:"swap_treasury_tax_to_fee_denom,omitempty"`
+	// Slippage buffer deducted from the quoted value of fees paid in a denom
+	// other than fee_denom
+	FeeSwapSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=fee_swap_slippage,json=feeSwapSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_swap_slippage"`
+	// Period in seconds of the TWAP used to quote fees paid in a denom other
+	// than fee_denom. If zero only the spot price is used.
+	FeeSwapTwapPeriod uint64 `protobuf:"varint,8,opt,name=fee_swap_twap_period,json=feeSwapTwapPeriod,proto3" json:"fee_swap_twap_period,omitempty"`
+	// Minimum current tick liquidity of a pool against fee_denom to accept fees
+	// in its other denom
+	FeeSwapMinLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=fee_swap_min_liquidity,json=feeSwapMinLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_swap_min_liquidity"`
+	// Lower bound of the base fee, the minimum gas price in fee_denom
+	// enforced by consensus
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// The base fee changes by at most 1 / base_fee_change_denominator per block
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,11,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// Block gas used at which the base fee stays unchanged
+	TargetBlockGas uint64 `protobuf:"varint,12,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +104,34 @@ func (m *Params) GetBypassDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetSwapTreasuryTaxToFeeDenom() bool {
+	if m != nil {
+		return m.SwapTreasuryTaxToFeeDenom
+	}
+	return false
+}
+
+func (m *Params) GetFeeSwapTwapPeriod() uint64 {
+	if m != nil {
+		return m.FeeSwapTwapPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sunrise.fee.Params")
 }
@@ -87,27 +139,43 @@ func init() {
 func init() { proto.RegisterFile("sunrise/fee/params.proto", fileDescriptor_4ecf2dc48b5653c3) }
 
 var fileDescriptor_4ecf2dc48b5653c3 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0x4b, 0x4d, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xca, 0xe8, 0xa5, 0xa5, 0xa6, 0x4a, 0x09, 0x26, 0xe6,
-	0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9,
-	0x0f, 0x62, 0x41, 0x45, 0x25, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12, 0x10, 0x0e,
-	0x44, 0x4a, 0xe9, 0x00, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x06, 0x21, 0x69, 0x2e, 0xce, 0xb4, 0xd4,
-	0xd4, 0xf8, 0x94, 0xd4, 0xbc, 0xfc, 0x5c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x8e, 0xb4,
-	0xd4, 0x54, 0x17, 0x10, 0x5f, 0x28, 0x94, 0x8b, 0x2b, 0xa9, 0xb4, 0x28, 0x2f, 0xbe, 0x28, 0xb1,
-	0x24, 0x33, 0x5f, 0x82, 0x09, 0x24, 0xeb, 0x64, 0x76, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2,
-	0xd2, 0x10, 0x13, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c,
-	0x52, 0xd3, 0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x5a, 0xe8, 0x92,
-	0x9a, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0x4e, 0x90, 0x49, 0x41, 0x20, 0x83, 0x84, 0x94,
-	0xb9, 0x78, 0x93, 0x2a, 0x0b, 0x12, 0x8b, 0x8b, 0x21, 0xd6, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6b,
-	0x70, 0x06, 0xf1, 0x40, 0x04, 0xc1, 0x56, 0x17, 0x5b, 0xc9, 0xbe, 0x58, 0x20, 0xcf, 0xd8, 0xf5,
-	0x7c, 0x83, 0x96, 0x08, 0x2c, 0x5c, 0x2a, 0xc0, 0x21, 0x03, 0x71, 0xb7, 0x93, 0xeb, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0xea, 0x43, 0xb5, 0xe6, 0x24, 0x56, 0xa6, 0x16, 0xe9, 0xa3, 0x9a, 0x53, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x10, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49,
-	0x9f, 0xcf, 0x88, 0x7d, 0x01, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xe3, 0x5f, 0xfb, 0x4b, 0x93, 0x6b, 0xf8, 0x13, 0x37, 0x42, 0x6e, 0xa2, 0x3a, 0x11,
+	0x2c, 0x51, 0x11, 0xf1, 0x80, 0xc4, 0x80, 0xc4, 0x40, 0x1a, 0xca, 0x52, 0xa4, 0xc8, 0x0d, 0x12,
+	0x62, 0x39, 0x3d, 0x76, 0x1e, 0x3b, 0xa7, 0xd8, 0x3e, 0xe3, 0x3b, 0x2b, 0xf1, 0x5b, 0x60, 0xe2,
+	0x25, 0x30, 0x22, 0xb1, 0x74, 0xe0, 0x45, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0xbc,
+	0x0c, 0xe4, 0x7f, 0x29, 0xcc, 0x59, 0x4e, 0x77, 0xcf, 0xf7, 0x7b, 0xdf, 0xcf, 0x63, 0x9f, 0xee,
+	0x88, 0x26, 0xe2, 0x20, 0x62, 0x02, 0x0d, 0x07, 0xd1, 0x08, 0x21, 0x02, 0x5f, 0x0c, 0xc2, 0x88,
+	0x4b, 0xae, 0xee, 0x17, 0xca, 0xc0, 0x41, 0x6c, 0x37, 0xc1, 0x67, 0x01, 0x37, 0xb2, 0x31, 0xd7,
+	0xdb, 0x2d, 0x97, 0xbb, 0x3c, 0x9b, 0x1a, 0xe9, 0xac, 0xa8, 0x1e, 0xda, 0x5c, 0xf8, 0x5c, 0xd0,
+	0x5c, 0xc8, 0x17, 0xb9, 0xf4, 0xf0, 0xeb, 0x1e, 0xa9, 0x8e, 0x33, 0x82, 0xda, 0x21, 0x75, 0x07,
+	0x91, 0x4e, 0x31, 0xe0, 0xbe, 0xa6, 0xf4, 0x94, 0x7e, 0xdd, 0xac, 0x39, 0x88, 0xa3, 0x74, 0xad,
+	0xbe, 0x25, 0xc4, 0x8a, 0xa3, 0x80, 0x46, 0x20, 0x19, 0xd7, 0xfe, 0x4b, 0xd5, 0xe1, 0xb3, 0xcb,
+	0xeb, 0x6e, 0xe5, 0xe7, 0x75, 0xb7, 0x93, 0x27, 0x8a, 0xe9, 0x7c, 0xc0, 0xb8, 0xe1, 0x83, 0x9c,
+	0x0d, 0xce, 0xd0, 0x05, 0x3b, 0x19, 0xa1, 0xfd, 0xfd, 0xdb, 0x13, 0x52, 0x00, 0x47, 0x68, 0x7f,
+	0xb9, 0xb9, 0x38, 0x56, 0xcc, 0x7a, 0x9a, 0x64, 0xa6, 0x41, 0xea, 0x23, 0x72, 0xc7, 0x4a, 0x42,
+	0x10, 0x22, 0xc7, 0x0a, 0x6d, 0xa7, 0xb7, 0xd3, 0xaf, 0x9b, 0x8d, 0xbc, 0x98, 0xa1, 0x85, 0xea,
+	0x90, 0x03, 0x19, 0x21, 0x88, 0x38, 0x4a, 0xe8, 0x5f, 0x4d, 0xec, 0x6e, 0xd5, 0x44, 0xb3, 0x8c,
+	0x1c, 0x6e, 0x9a, 0x49, 0xc8, 0xd1, 0x86, 0x63, 0x73, 0xdf, 0x8f, 0x03, 0x26, 0x13, 0x1a, 0x72,
+	0xee, 0x15, 0xc4, 0xff, 0xb7, 0x22, 0xb6, 0xcb, 0xf0, 0x93, 0x32, 0x7b, 0xcc, 0xb9, 0x97, 0xa3,
+	0x5f, 0x12, 0x5d, 0x2c, 0x20, 0xa4, 0x1b, 0xbe, 0x84, 0x25, 0x95, 0x9c, 0xde, 0x1e, 0x48, 0xb5,
+	0xa7, 0xf4, 0x6b, 0xe6, 0x61, 0xea, 0x9a, 0x14, 0xa6, 0x09, 0x2c, 0x27, 0xfc, 0xb4, 0x3c, 0x21,
+	0x8b, 0x34, 0x53, 0x77, 0x16, 0x23, 0x3c, 0x16, 0x86, 0xe0, 0xa2, 0xb6, 0xb7, 0x55, 0xc7, 0xf7,
+	0x1c, 0xc4, 0xf3, 0x05, 0x84, 0xe7, 0x45, 0x9c, 0x6a, 0x90, 0xd6, 0x86, 0x21, 0xd3, 0x21, 0xc4,
+	0x88, 0xf1, 0xa9, 0x56, 0xeb, 0x29, 0xfd, 0x5d, 0xb3, 0x59, 0xd8, 0x27, 0x0b, 0x08, 0xc7, 0x99,
+	0xa0, 0xce, 0xc9, 0x83, 0xcd, 0x06, 0x9f, 0x05, 0xd4, 0x63, 0x1f, 0x62, 0x36, 0x65, 0x32, 0xd1,
+	0xea, 0x5b, 0x75, 0x76, 0x50, 0xa0, 0xde, 0xb0, 0xe0, 0xac, 0x8c, 0x54, 0xdf, 0x91, 0x46, 0xca,
+	0xb0, 0x40, 0x60, 0xfa, 0xe3, 0x34, 0xb2, 0x15, 0x82, 0xf8, 0x2c, 0x18, 0x82, 0xc0, 0x53, 0x44,
+	0xf5, 0x05, 0xe9, 0x94, 0xa9, 0xd4, 0x9e, 0x41, 0xe0, 0x16, 0xa7, 0xc2, 0x02, 0x90, 0x3c, 0xd2,
+	0xf6, 0xb3, 0xcf, 0xd7, 0xac, 0xdc, 0x7d, 0x92, 0x19, 0x46, 0xb7, 0xba, 0xda, 0x27, 0xf7, 0x25,
+	0x44, 0x2e, 0x4a, 0x6a, 0x79, 0xdc, 0x9e, 0x53, 0x17, 0x84, 0xd6, 0xc8, 0xf6, 0xdc, 0xcd, 0xeb,
+	0xc3, 0xb4, 0xfc, 0x1a, 0xc4, 0xf3, 0xa3, 0xdf, 0x9f, 0xbb, 0xca, 0xc7, 0x9b, 0x8b, 0xe3, 0x56,
+	0xf9, 0x04, 0x2c, 0xb3, 0x47, 0x20, 0xbf, 0xa2, 0xc3, 0x57, 0x97, 0x2b, 0x5d, 0xb9, 0x5a, 0xe9,
+	0xca, 0xaf, 0x95, 0xae, 0x7c, 0x5a, 0xeb, 0x95, 0xab, 0xb5, 0x5e, 0xf9, 0xb1, 0xd6, 0x2b, 0xef,
+	0x1f, 0xbb, 0x4c, 0xce, 0x62, 0x6b, 0x60, 0x73, 0xdf, 0x28, 0xb6, 0x7a, 0x90, 0x60, 0x64, 0xfc,
+	0x9b, 0x23, 0x93, 0x10, 0x85, 0x55, 0xcd, 0xee, 0xfe, 0xd3, 0x3f, 0x03, 0x00, 0x38, 0x34, 0x07,
+	0x43, 0x68, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -143,6 +211,33 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.TreasuryBurnRatio.Equal(that1.TreasuryBurnRatio) {
+		return false
+	}
+	if !this.TreasuryCommunityPoolRatio.Equal(that1.TreasuryCommunityPoolRatio) {
+		return false
+	}
+	if this.SwapTreasuryTaxToFeeDenom != that1.SwapTreasuryTaxToFeeDenom {
+		return false
+	}
+	if !this.FeeSwapSlippage.Equal(that1.FeeSwapSlippage) {
+		return false
+	}
+	if this.FeeSwapTwapPeriod != that1.FeeSwapTwapPeriod {
+		return false
+	}
+	if !this.FeeSwapMinLiquidity.Equal(that1.FeeSwapMinLiquidity) {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -165,6 +260,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.FeeSwapMinLiquidity.Size()
+		i -= size
+		if _, err := m.FeeSwapMinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.FeeSwapTwapPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeSwapTwapPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.FeeSwapSlippage.Size()
+		i -= size
+		if _, err := m.FeeSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SwapTreasuryTaxToFeeDenom {
+		i--
+		if m.SwapTreasuryTaxToFeeDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TreasuryCommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.TreasuryCommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TreasuryBurnRatio.Size()
+		i -= size
+		if _, err := m.TreasuryBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.BypassDenoms) > 0 {
 		for iNdEx := len(m.BypassDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.BypassDenoms[iNdEx])
@@ -223,6 +393,28 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.TreasuryBurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TreasuryCommunityPoolRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SwapTreasuryTaxToFeeDenom {
+		n += 2
+	}
+	l = m.FeeSwapSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FeeSwapTwapPeriod != 0 {
+		n += 1 + sovParams(uint64(m.FeeSwapTwapPeriod))
+	}
+	l = m.FeeSwapMinLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovParams(uint64(m.TargetBlockGas))
+	}
 	return n
 }
 
@@ -359,6 +551,253 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BypassDenoms = append(m.BypassDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryCommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryCommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTreasuryTaxToFeeDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapTreasuryTaxToFeeDenom = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapTwapPeriod", wireType)
+			}
+			m.FeeSwapTwapPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeSwapTwapPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapMinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSwapMinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
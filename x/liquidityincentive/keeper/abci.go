@@ -12,7 +12,7 @@ import (
 )
 
 func (k Keeper) CreateEpoch(ctx sdk.Context, previousEpochId, epochId uint64) error {
-	weights, err := k.Tally(ctx)
+	weights, voterWeights, err := k.tally(ctx)
 	if err != nil {
 		return err
 	}
@@ -40,6 +40,7 @@ func (k Keeper) CreateEpoch(ctx sdk.Context, previousEpochId, epochId uint64) er
 		Gauges:     gauges,
 	}
 	k.SetEpoch(ctx, epoch)
+	k.SnapshotVoterWeights(ctx, epochId, voterWeights)
 	return nil
 }
 
@@ -55,6 +56,14 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 			return nil
 		}
 	} else if ctx.BlockHeight() >= lastEpoch.EndBlock {
+		// Make the treasury tax collected during the last `Epoch` claimable by its voters.
+		// It is written only on success, and a failure does not prevent the next `Epoch` from being created.
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.FinalizeVoteRewards(cacheCtx, lastEpoch.Id); err != nil {
+			ctx.Logger().Error("vote rewards finalization error", "error", err)
+		} else {
+			write()
+		}
 		err := k.CreateEpoch(ctx, lastEpoch.Id, lastEpoch.Id+1)
 		if err != nil {
 			ctx.Logger().Error("epoch creation error", err)
@@ -99,20 +108,31 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	if totalWeight.IsZero() {
 		return nil
 	}
+	allocations := make(map[uint64]sdk.Coins)
+	totalAllocation := sdk.NewCoins()
 	for _, weight := range lastEpoch.Gauges {
 		ratio := weight.Ratio.Quo(totalWeight)
 		allocationDec := incentiveFeesDec.MulDecTruncate(ratio)
 		allocation, _ := allocationDec.TruncateDecimal()
 		if allocation.IsAllPositive() {
-			err := k.liquidityPoolKeeper.AllocateIncentive(
-				ctx,
-				weight.PoolId,
-				authtypes.NewModuleAddress(authtypes.FeeCollectorName),
-				allocation,
-			)
-			if err != nil {
-				return err
-			}
+			allocations[weight.PoolId] = allocation
+			totalAllocation = totalAllocation.Add(allocation...)
+		}
+	}
+
+	if totalAllocation.IsZero() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, totalAllocation)
+	if err != nil {
+		return err
+	}
+
+	// The incentives are claimed lazily by the positions of the pools
+	for _, weight := range lastEpoch.Gauges {
+		if allocation, ok := allocations[weight.PoolId]; ok {
+			k.AddPoolInflated(ctx, weight.PoolId, allocation)
 		}
 	}
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -11,10 +12,12 @@ import (
 	sdkmath "cosmossdk.io/math"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	appconsts "github.com/sunriselayer/sunrise/pkg/appconsts"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
 
 func TestCreateEpoch(t *testing.T) {
@@ -99,6 +102,14 @@ func TestCreateEpoch(t *testing.T) {
 				require.Equal(t, gauges[0].PreviousEpochId, uint64(0))
 				require.Equal(t, gauges[0].PoolId, tt.expectedTally[0].PoolId)
 				require.Equal(t, gauges[0].Ratio, tt.expectedTally[0].Weight)
+
+				voterWeights := k.GetAllVoterWeights(ctx)
+				require.Len(t, voterWeights, 1)
+				require.Equal(t, sdk.AccAddress(valAddrs[0]).String(), voterWeights[0].Address)
+				require.Equal(t, uint64(1), voterWeights[0].EpochId)
+				pot, found := k.GetVoteRewardPot(ctx, 1)
+				require.True(t, found)
+				require.Equal(t, voterWeights[0].Weight, pot.TotalWeight)
 			}
 		})
 	}
@@ -110,6 +121,7 @@ func TestEndBlocker(t *testing.T) {
 		setup         func(tallyFixture)
 		expectedTally []types.PoolWeight
 		expectError   bool
+		check         func(tallyFixture)
 	}{
 		{
 			name: "no votes",
@@ -140,6 +152,36 @@ func TestEndBlocker(t *testing.T) {
 			},
 			expectedTally: []types.PoolWeight{{PoolId: 1, Weight: math.LegacyNewDec(1000000)}},
 		},
+		{
+			name: "failed vote rewards finalization",
+			setup: func(s tallyFixture) {
+				s.keeper.SetEpoch(s.ctx, types.Epoch{
+					Id:         1,
+					StartBlock: 0,
+					EndBlock:   0,
+					Gauges:     []types.Gauge{},
+				})
+				s.keeper.SnapshotVoterWeights(s.ctx, 1, []types.VoterWeight{{Address: s.delAddrs[0].String(), Weight: math.LegacyOneDec()}})
+				treasuryTax := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+				s.mocks.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), liquiditypooltypes.TreasuryAddress()).Return(treasuryTax)
+				s.mocks.FeeKeeper.EXPECT().DistributeTreasuryTax(gomock.Any(), liquiditypooltypes.ModuleName, treasuryTax).
+					DoAndReturn(func(ctx sdk.Context, _ string, _ sdk.Coins) (sdk.Coins, error) {
+						// a partial write which must be discarded
+						s.keeper.SetVoteRewardPot(ctx, types.VoteRewardPot{EpochId: 99, Rewards: sdk.NewCoins(), TotalWeight: math.LegacyOneDec()})
+						return nil, errors.New("swap failed")
+					})
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], []types.PoolWeight{{PoolId: 1, Weight: math.LegacyOneDec()}})
+			},
+			expectedTally: []types.PoolWeight{{PoolId: 1, Weight: math.LegacyNewDec(1000000)}},
+			check: func(s tallyFixture) {
+				pot, found := s.keeper.GetVoteRewardPot(s.ctx, 1)
+				require.True(s.t, found)
+				require.False(s.t, pot.Finalized)
+				_, found = s.keeper.GetVoteRewardPot(s.ctx, 99)
+				require.False(s.t, found)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -179,6 +221,8 @@ func TestEndBlocker(t *testing.T) {
 				mocks:    mocks,
 			}
 			tt.setup(suite)
+			// No treasury tax to distribute when the epoch ends
+			mocks.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), liquiditypooltypes.TreasuryAddress()).Return(sdk.NewCoins()).AnyTimes()
 
 			err := k.EndBlocker(ctx)
 			if tt.expectError {
@@ -203,15 +247,19 @@ func TestEndBlocker(t *testing.T) {
 				require.Equal(t, gauges[0].PoolId, tt.expectedTally[0].PoolId)
 				require.Equal(t, gauges[0].Ratio, tt.expectedTally[0].Weight)
 			}
+			if tt.check != nil {
+				tt.check(suite)
+			}
 		})
 	}
 }
 
 func TestBeginBlocker(t *testing.T) {
 	tests := []struct {
-		name        string
-		setup       func(tallyFixture)
-		expectError bool
+		name             string
+		setup            func(tallyFixture)
+		expectedInflated sdk.Coins
+		expectError      bool
 	}{
 		{
 			name: "empty epochs",
@@ -242,9 +290,10 @@ func TestBeginBlocker(t *testing.T) {
 				require.NoError(t, err)
 				s.mocks.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).
 					Return(sdk.Coins{sdk.NewInt64Coin(appconsts.BondDenom, 1000000)}).AnyTimes()
-				s.mocks.LiquiditypoolKeeper.EXPECT().AllocateIncentive(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil).AnyTimes()
+				s.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000000))).
+					Return(nil).Times(1)
 			},
+			expectedInflated: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000000)),
 		},
 		{
 			name: "zero liquidity incentive allocation",
@@ -268,8 +317,6 @@ func TestBeginBlocker(t *testing.T) {
 				require.NoError(t, err)
 				s.mocks.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).
 					Return(sdk.Coins{sdk.NewInt64Coin(appconsts.BondDenom, 1000000)}).AnyTimes()
-				s.mocks.LiquiditypoolKeeper.EXPECT().AllocateIncentive(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil).AnyTimes()
 			},
 		},
 		{
@@ -319,6 +366,14 @@ func TestBeginBlocker(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+
+			poolIncentive, found := k.GetPoolIncentive(ctx, 1)
+			if tt.expectedInflated != nil {
+				require.True(t, found)
+				require.Equal(t, tt.expectedInflated, poolIncentive.Inflated)
+			} else {
+				require.False(t, found)
+			}
 		})
 	}
 }
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// SetPoolIncentive set a specific pool incentive in the store
+func (k Keeper) SetPoolIncentive(ctx context.Context, poolIncentive types.PoolIncentive) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&poolIncentive)
+	storeAdapter.Set(types.PoolIncentiveKey(poolIncentive.PoolId), b)
+}
+
+// GetPoolIncentive returns a pool incentive from its pool id
+func (k Keeper) GetPoolIncentive(ctx context.Context, poolId uint64) (val types.PoolIncentive, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := storeAdapter.Get(types.PoolIncentiveKey(poolId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPoolIncentives returns all pool incentives
+func (k Keeper) GetAllPoolIncentives(ctx context.Context) (list []types.PoolIncentive) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolIncentiveKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolIncentive
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddPoolInflated records the incentive inflated to a pool
+func (k Keeper) AddPoolInflated(ctx context.Context, poolId uint64, amount sdk.Coins) {
+	poolIncentive, found := k.GetPoolIncentive(ctx, poolId)
+	if !found {
+		poolIncentive = types.PoolIncentive{
+			PoolId:   poolId,
+			Inflated: sdk.NewCoins(),
+			Claimed:  sdk.NewCoins(),
+		}
+	}
+	poolIncentive.Inflated = poolIncentive.Inflated.Add(amount...)
+	k.SetPoolIncentive(ctx, poolIncentive)
+}
+
+// quoteValue values the accumulated fee rewards of a position in the quote denom of the pool
+func quoteValue(pool liquiditypooltypes.Pool, accumulation sdk.DecCoins) math.LegacyDec {
+	price := pool.CurrentSqrtPrice.Mul(pool.CurrentSqrtPrice)
+	return accumulation.AmountOf(pool.DenomQuote).Add(accumulation.AmountOf(pool.DenomBase).Mul(price))
+}
+
+// incentiveClaim is the state of the lazy incentive accounting of a pool during a claim
+type incentiveClaim struct {
+	pool                  liquiditypooltypes.Pool
+	unclaimed             sdk.Coins
+	unclaimedAccumulation math.LegacyDec
+}
+
+// newIncentiveClaim returns the unclaimed incentive and the sum of the unclaimed accumulation of the positions of a pool
+func (k Keeper) newIncentiveClaim(ctx sdk.Context, poolId uint64) (incentiveClaim, error) {
+	pool, found := k.liquidityPoolKeeper.GetPool(ctx, poolId)
+	if !found {
+		return incentiveClaim{}, liquiditypooltypes.ErrPoolNotFound
+	}
+
+	unclaimed := sdk.NewCoins()
+	if poolIncentive, found := k.GetPoolIncentive(ctx, poolId); found {
+		unclaimed = poolIncentive.Inflated.Sub(poolIncentive.Claimed...)
+	}
+
+	return incentiveClaim{
+		pool:                  pool,
+		unclaimed:             unclaimed,
+		unclaimedAccumulation: quoteValue(pool, k.liquidityPoolKeeper.GetPoolIncentiveAccumulation(ctx, poolId)),
+	}, nil
+}
+
+// claim returns the share of the unclaimed incentive for the accumulation of a position and deducts it.
+// An accumulation whose share is truncated to zero is not deducted, so that it is kept for later claims.
+func (c *incentiveClaim) claim(accumulation sdk.DecCoins) sdk.Coins {
+	positionAccumulation := quoteValue(c.pool, accumulation)
+	if !positionAccumulation.IsPositive() || !c.unclaimedAccumulation.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	amount := c.unclaimed
+	if positionAccumulation.LT(c.unclaimedAccumulation) {
+		amount, _ = sdk.NewDecCoinsFromCoins(c.unclaimed...).MulDecTruncate(positionAccumulation).QuoDecTruncate(c.unclaimedAccumulation).TruncateDecimal()
+	}
+	if amount.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	c.unclaimedAccumulation = c.unclaimedAccumulation.Sub(math.LegacyMinDec(positionAccumulation, c.unclaimedAccumulation))
+	c.unclaimed = c.unclaimed.Sub(amount...)
+
+	return amount
+}
+
+// collect claims the share of the unclaimed incentive for the accumulation and resets the accumulation,
+// unless the share is truncated to zero
+func (c *incentiveClaim) collect(accumulation sdk.DecCoins, reset func() (sdk.DecCoins, error)) (sdk.Coins, error) {
+	amount := c.claim(accumulation)
+	if amount.IsZero() {
+		return amount, nil
+	}
+	if _, err := reset(); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
+
+// GetClaimableIncentives returns the incentives claimable by the positions and limit orders.
+// The incentives of an order are the ones accumulated by its position while the order was open.
+func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positions []liquiditypooltypes.Position, orders []liquiditypooltypes.LimitOrder) (sdk.Coins, error) {
+	claims := make(map[uint64]*incentiveClaim)
+	getClaim := func(poolId uint64) (*incentiveClaim, error) {
+		if claim, ok := claims[poolId]; ok {
+			return claim, nil
+		}
+		claim, err := k.newIncentiveClaim(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		claims[poolId] = &claim
+		return &claim, nil
+	}
+
+	total := sdk.NewCoins()
+	for _, position := range positions {
+		claim, err := getClaim(position.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		accumulation, err := k.liquidityPoolKeeper.GetIncentiveAccumulation(ctx, position.Id)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(claim.claim(accumulation)...)
+	}
+	for _, order := range orders {
+		claim, err := getClaim(order.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		accumulation, err := k.liquidityPoolKeeper.GetLimitOrderIncentiveAccumulation(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(claim.claim(accumulation)...)
+	}
+
+	return total, nil
+}
+
+// CollectIncentiveRewards pays out the incentives of the pools to the positions and limit orders of the sender
+// in proportion to their unclaimed accumulation.
+func (k Keeper) CollectIncentiveRewards(ctx sdk.Context, sender sdk.AccAddress, poolIds []uint64) (sdk.Coins, error) {
+	positionsByPool := make(map[uint64][]liquiditypooltypes.Position)
+	for _, position := range k.liquidityPoolKeeper.GetPositionsByAddress(ctx, sender.String()) {
+		positionsByPool[position.PoolId] = append(positionsByPool[position.PoolId], position)
+	}
+	ordersByPool := make(map[uint64][]liquiditypooltypes.LimitOrder)
+	for _, order := range k.liquidityPoolKeeper.GetLimitOrdersByAddress(ctx, sender.String()) {
+		ordersByPool[order.PoolId] = append(ordersByPool[order.PoolId], order)
+	}
+
+	collected := sdk.NewCoins()
+	for _, poolId := range poolIds {
+		claim, err := k.newIncentiveClaim(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		// Keep the accumulation for later claims if nothing is left to claim
+		if claim.unclaimed.IsZero() {
+			continue
+		}
+
+		poolCollected := sdk.NewCoins()
+		for _, position := range positionsByPool[poolId] {
+			accumulation, err := k.liquidityPoolKeeper.GetIncentiveAccumulation(ctx, position.Id)
+			if err != nil {
+				return nil, err
+			}
+			amount, err := claim.collect(accumulation, func() (sdk.DecCoins, error) {
+				return k.liquidityPoolKeeper.ClaimIncentiveAccumulation(ctx, position.Id)
+			})
+			if err != nil {
+				return nil, err
+			}
+			poolCollected = poolCollected.Add(amount...)
+		}
+		for _, order := range ordersByPool[poolId] {
+			accumulation, err := k.liquidityPoolKeeper.GetLimitOrderIncentiveAccumulation(ctx, order)
+			if err != nil {
+				return nil, err
+			}
+			amount, err := claim.collect(accumulation, func() (sdk.DecCoins, error) {
+				return k.liquidityPoolKeeper.ClaimLimitOrderIncentiveAccumulation(ctx, order.Id)
+			})
+			if err != nil {
+				return nil, err
+			}
+			poolCollected = poolCollected.Add(amount...)
+		}
+
+		if !poolCollected.IsZero() {
+			poolIncentive, _ := k.GetPoolIncentive(ctx, poolId)
+			poolIncentive.Claimed = poolIncentive.Claimed.Add(poolCollected...)
+			k.SetPoolIncentive(ctx, poolIncentive)
+			collected = collected.Add(poolCollected...)
+		}
+	}
+
+	if !collected.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, collected); err != nil {
+			return nil, err
+		}
+	}
+
+	return collected, nil
+}
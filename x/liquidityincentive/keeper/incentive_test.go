@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func TestCollectIncentiveRewards(t *testing.T) {
+	k, mocks, ctx := keepertest.LiquidityincentiveKeeper(t)
+	addr1, addr2 := sample.AccAddress(), sample.AccAddress()
+	sender1 := sdk.MustAccAddressFromBech32(addr1)
+	sender2 := sdk.MustAccAddressFromBech32(addr2)
+
+	pool := liquiditypooltypes.Pool{
+		Id:               1,
+		DenomBase:        "base",
+		DenomQuote:       "quote",
+		CurrentSqrtPrice: math.LegacyNewDec(2),
+	}
+	positions := []liquiditypooltypes.Position{
+		{Id: 1, Address: addr1, PoolId: 1},
+		{Id: 2, Address: addr2, PoolId: 1},
+	}
+	// the filled order of addr2 carries the accumulation of its position
+	orders := []liquiditypooltypes.LimitOrder{
+		{Id: 1, Address: addr2, PoolId: 1, PositionId: 3, Filled: true},
+	}
+	// quote-valued accumulations: 300 and 25 * 2^2 = 100, and 100 for the order
+	accumulations := map[uint64]sdk.DecCoins{
+		1: sdk.NewDecCoins(sdk.NewInt64DecCoin("quote", 300)),
+		2: sdk.NewDecCoins(sdk.NewInt64DecCoin("base", 25)),
+	}
+	orderAccumulations := map[uint64]sdk.DecCoins{
+		1: sdk.NewDecCoins(sdk.NewInt64DecCoin("quote", 100)),
+	}
+
+	mocks.LiquiditypoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(1)).Return(pool, true).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetPoolIncentiveAccumulation(gomock.Any(), uint64(1)).
+		DoAndReturn(func(ctx sdk.Context, poolId uint64) sdk.DecCoins {
+			total := sdk.NewDecCoins()
+			for _, accumulation := range accumulations {
+				total = total.Add(accumulation...)
+			}
+			for _, accumulation := range orderAccumulations {
+				total = total.Add(accumulation...)
+			}
+			return total
+		}).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetPositionsByAddress(gomock.Any(), addr1).Return(positions[:1]).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetPositionsByAddress(gomock.Any(), addr2).Return(positions[1:]).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetLimitOrdersByAddress(gomock.Any(), addr1).Return(nil).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetLimitOrdersByAddress(gomock.Any(), addr2).Return(orders).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetIncentiveAccumulation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error) {
+			return accumulations[positionId], nil
+		}).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetLimitOrderIncentiveAccumulation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, order liquiditypooltypes.LimitOrder) (sdk.DecCoins, error) {
+			return orderAccumulations[order.Id], nil
+		}).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().ClaimIncentiveAccumulation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error) {
+			accumulation := accumulations[positionId]
+			accumulations[positionId] = sdk.NewDecCoins()
+			return accumulation, nil
+		}).Times(2)
+	mocks.LiquiditypoolKeeper.EXPECT().ClaimLimitOrderIncentiveAccumulation(gomock.Any(), uint64(1)).
+		DoAndReturn(func(ctx sdk.Context, orderId uint64) (sdk.DecCoins, error) {
+			accumulation := orderAccumulations[orderId]
+			orderAccumulations[orderId] = sdk.NewDecCoins()
+			return accumulation, nil
+		}).Times(1)
+
+	// nothing is inflated to the pool yet, so the accumulation is kept
+	collected, err := k.CollectIncentiveRewards(ctx, sender1, []uint64{1})
+	require.NoError(t, err)
+	require.True(t, collected.IsZero())
+
+	k.AddPoolInflated(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("urise", 1000)))
+
+	claimable, err := k.GetClaimableIncentives(ctx, positions[:1], nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urise", 600)), claimable)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender1, sdk.NewCoins(sdk.NewInt64Coin("urise", 600))).Return(nil)
+	collected, err = k.CollectIncentiveRewards(ctx, sender1, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urise", 600)), collected)
+
+	poolIncentive, found := k.GetPoolIncentive(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urise", 600)), poolIncentive.Claimed)
+
+	// the remaining position and the order take the rest of the unclaimed incentive
+	claimable, err = k.GetClaimableIncentives(ctx, positions[1:], orders)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urise", 400)), claimable)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender2, sdk.NewCoins(sdk.NewInt64Coin("urise", 400))).Return(nil)
+	collected, err = k.CollectIncentiveRewards(ctx, sender2, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urise", 400)), collected)
+	require.True(t, orderAccumulations[1].IsZero())
+
+	mocks.LiquiditypoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(2)).Return(liquiditypooltypes.Pool{}, false)
+	_, err = k.CollectIncentiveRewards(ctx, sender1, []uint64{2})
+	require.ErrorIs(t, err, liquiditypooltypes.ErrPoolNotFound)
+}
+
+func TestCollectIncentiveRewardsKeepsTruncatedAccumulation(t *testing.T) {
+	k, mocks, ctx := keepertest.LiquidityincentiveKeeper(t)
+	addr := sample.AccAddress()
+	sender := sdk.MustAccAddressFromBech32(addr)
+
+	pool := liquiditypooltypes.Pool{
+		Id:               1,
+		DenomBase:        "base",
+		DenomQuote:       "quote",
+		CurrentSqrtPrice: math.LegacyOneDec(),
+	}
+	position := liquiditypooltypes.Position{Id: 1, Address: addr, PoolId: 1}
+
+	mocks.LiquiditypoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(1)).Return(pool, true).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetPoolIncentiveAccumulation(gomock.Any(), uint64(1)).
+		Return(sdk.NewDecCoins(sdk.NewInt64DecCoin("quote", 1_000_000))).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetPositionsByAddress(gomock.Any(), addr).Return([]liquiditypooltypes.Position{position}).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetLimitOrdersByAddress(gomock.Any(), addr).Return(nil).AnyTimes()
+	mocks.LiquiditypoolKeeper.EXPECT().GetIncentiveAccumulation(gomock.Any(), uint64(1)).
+		Return(sdk.NewDecCoins(sdk.NewInt64DecCoin("quote", 1)), nil).AnyTimes()
+	// the share of the position is truncated to zero, so its accumulation is not reset
+	mocks.LiquiditypoolKeeper.EXPECT().ClaimIncentiveAccumulation(gomock.Any(), gomock.Any()).Times(0)
+
+	k.AddPoolInflated(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("urise", 100)))
+
+	collected, err := k.CollectIncentiveRewards(ctx, sender, []uint64{1})
+	require.NoError(t, err)
+	require.True(t, collected.IsZero())
+
+	poolIncentive, found := k.GetPoolIncentive(ctx, 1)
+	require.True(t, found)
+	require.True(t, poolIncentive.Claimed.IsZero())
+}
@@ -25,6 +25,7 @@ type (
 		bankKeeper          types.BankKeeper
 		sk                  types.StakingKeeper
 		liquidityPoolKeeper types.LiquidityPoolKeeper
+		feeKeeper           types.FeeKeeper
 	}
 )
 
@@ -38,6 +39,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	liquidityPoolKeeper types.LiquidityPoolKeeper,
+	feeKeeper types.FeeKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -53,6 +55,7 @@ func NewKeeper(
 		bankKeeper:          bankKeeper,
 		sk:                  sk,
 		liquidityPoolKeeper: liquidityPoolKeeper,
+		feeKeeper:           feeKeeper,
 	}
 }
 
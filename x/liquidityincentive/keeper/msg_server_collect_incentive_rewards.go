@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+)
+
+func (k msgServer) CollectIncentiveRewards(goCtx context.Context, msg *types.MsgCollectIncentiveRewards) (*types.MsgCollectIncentiveRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collected, err := k.Keeper.CollectIncentiveRewards(ctx, sender, msg.PoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCollectIncentiveRewardsResponse{
+		Collected: collected,
+	}, nil
+}
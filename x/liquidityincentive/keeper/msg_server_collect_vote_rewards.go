@@ -10,8 +10,17 @@ import (
 func (k msgServer) CollectVoteRewards(goCtx context.Context, msg *types.MsgCollectVoteRewards) (*types.MsgCollectVoteRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Handling the message
-	_ = ctx
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.MsgCollectVoteRewardsResponse{}, nil
+	collected, err := k.Keeper.CollectVoteRewards(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCollectVoteRewardsResponse{
+		Collected: collected,
+	}, nil
 }
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PositionIncentives(goCtx context.Context, req *types.QueryPositionIncentivesRequest) (*types.QueryPositionIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, found := k.liquidityPoolKeeper.GetPosition(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	amount, err := k.GetClaimableIncentives(ctx, []liquiditypooltypes.Position{position}, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionIncentivesResponse{Amount: amount}, nil
+}
+
+func (k Keeper) PositionsIncentives(goCtx context.Context, req *types.QueryPositionsIncentivesRequest) (*types.QueryPositionsIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	positions := []liquiditypooltypes.Position{}
+	for _, id := range req.Ids {
+		position, found := k.liquidityPoolKeeper.GetPosition(ctx, id)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "position %d not found", id)
+		}
+		positions = append(positions, position)
+	}
+
+	amount, err := k.GetClaimableIncentives(ctx, positions, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsIncentivesResponse{Amount: amount}, nil
+}
+
+func (k Keeper) AddressIncentives(goCtx context.Context, req *types.QueryAddressIncentivesRequest) (*types.QueryAddressIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	positions := k.liquidityPoolKeeper.GetPositionsByAddress(ctx, req.Address)
+	orders := k.liquidityPoolKeeper.GetLimitOrdersByAddress(ctx, req.Address)
+	amount, err := k.GetClaimableIncentives(ctx, positions, orders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressIncentivesResponse{Amount: amount}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VoteRewards(ctx context.Context, req *types.QueryVoteRewardsRequest) (*types.QueryVoteRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryVoteRewardsResponse{
+		Rewards:      k.GetClaimableVoteRewards(ctx, req.Address),
+		VoterWeights: k.GetAllVoterWeightsByAddress(ctx, req.Address),
+	}, nil
+}
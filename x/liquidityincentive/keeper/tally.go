@@ -21,7 +21,21 @@ type ValidatorGovInfo struct {
 }
 
 func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
+	weights, _, err := k.tally(ctx)
+	return weights, err
+}
+
+// tally returns the pool weights together with the voting power of each voter
+func (k Keeper) tally(ctx context.Context) ([]types.PoolWeight, []types.VoterWeight, error) {
 	weights := make(map[uint64]math.LegacyDec)
+	voterPowers := make(map[string]math.LegacyDec)
+	addVoterPower := func(voter string, power math.LegacyDec) {
+		oldPower, ok := voterPowers[voter]
+		if !ok {
+			oldPower = math.LegacyZeroDec()
+		}
+		voterPowers[voter] = oldPower.Add(power)
+	}
 
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]ValidatorGovInfo)
@@ -43,7 +57,7 @@ func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
 		return false
 	})
 	if err != nil {
-		return []types.PoolWeight{}, err
+		return []types.PoolWeight{}, []types.VoterWeight{}, err
 	}
 
 	votes := k.GetAllVotes(ctx)
@@ -51,12 +65,12 @@ func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Sender)
 		if err != nil {
-			return []types.PoolWeight{}, err
+			return []types.PoolWeight{}, []types.VoterWeight{}, err
 		}
 
 		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
-			return []types.PoolWeight{}, err
+			return []types.PoolWeight{}, []types.VoterWeight{}, err
 		}
 		if val, ok := currValidators[valAddrStr]; ok {
 			val.Weights = vote.Weights
@@ -85,17 +99,18 @@ func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
 					weights[weight.PoolId] = oldWeight.Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+				addVoterPower(vote.Sender, votingPower)
 			}
 
 			return false
 		})
 		if err != nil {
-			return []types.PoolWeight{}, err
+			return []types.PoolWeight{}, []types.VoterWeight{}, err
 		}
 	}
 
 	if err != nil {
-		return []types.PoolWeight{}, err
+		return []types.PoolWeight{}, []types.VoterWeight{}, err
 	}
 
 	// iterate over the validators again to tally their voting power
@@ -116,16 +131,22 @@ func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
 			weights[weight.PoolId] = oldWeight.Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		valAccAddr, err := k.authKeeper.AddressCodec().BytesToString(val.Address)
+		if err != nil {
+			return []types.PoolWeight{}, []types.VoterWeight{}, err
+		}
+		addVoterPower(valAccAddr, votingPower)
 	}
 
 	// If there is no staked coins, the proposal fails
 	totalBonded, err := k.sk.TotalBondedTokens(ctx)
 	if err != nil {
-		return []types.PoolWeight{}, err
+		return []types.PoolWeight{}, []types.VoterWeight{}, err
 	}
 
 	if totalBonded.IsZero() {
-		return []types.PoolWeight{}, nil
+		return []types.PoolWeight{}, []types.VoterWeight{}, nil
 	}
 
 	weightsArr := []types.PoolWeight{}
@@ -139,5 +160,16 @@ func (k Keeper) Tally(ctx context.Context) ([]types.PoolWeight, error) {
 		return weightsArr[i].PoolId < weightsArr[j].PoolId
 	})
 
-	return weightsArr, nil
+	voterWeights := []types.VoterWeight{}
+	for voter, power := range voterPowers {
+		voterWeights = append(voterWeights, types.VoterWeight{
+			Address: voter,
+			Weight:  power,
+		})
+	}
+	sort.SliceStable(voterWeights, func(i, j int) bool {
+		return voterWeights[i].Address < voterWeights[j].Address
+	})
+
+	return weightsArr, voterWeights, nil
 }
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// SetVoteRewardPot set a specific vote reward pot in the store
+func (k Keeper) SetVoteRewardPot(ctx context.Context, pot types.VoteRewardPot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&pot)
+	storeAdapter.Set(types.VoteRewardPotKey(pot.EpochId), b)
+}
+
+// GetVoteRewardPot returns a vote reward pot from its epoch id
+func (k Keeper) GetVoteRewardPot(ctx context.Context, epochId uint64) (val types.VoteRewardPot, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := storeAdapter.Get(types.VoteRewardPotKey(epochId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVoteRewardPot removes a vote reward pot from the store
+func (k Keeper) RemoveVoteRewardPot(ctx context.Context, epochId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeAdapter.Delete(types.VoteRewardPotKey(epochId))
+}
+
+// GetAllVoteRewardPots returns all vote reward pots
+func (k Keeper) GetAllVoteRewardPots(ctx context.Context) (list []types.VoteRewardPot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VoteRewardPotKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VoteRewardPot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetVoterWeight set a specific voter weight in the store
+func (k Keeper) SetVoterWeight(ctx context.Context, weight types.VoterWeight) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&weight)
+	storeAdapter.Set(types.VoterWeightKey(weight.Address, weight.EpochId), b)
+}
+
+// GetVoterWeight returns a voter weight from its index
+func (k Keeper) GetVoterWeight(ctx context.Context, address string, epochId uint64) (val types.VoterWeight, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := storeAdapter.Get(types.VoterWeightKey(address, epochId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVoterWeight removes a voter weight from the store
+func (k Keeper) RemoveVoterWeight(ctx context.Context, address string, epochId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeAdapter.Delete(types.VoterWeightKey(address, epochId))
+}
+
+// GetAllVoterWeights returns all voter weights
+func (k Keeper) GetAllVoterWeights(ctx context.Context) (list []types.VoterWeight) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VoterWeightKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VoterWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllVoterWeightsByAddress returns all voter weights of an address
+func (k Keeper) GetAllVoterWeightsByAddress(ctx context.Context, address string) (list []types.VoterWeight) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VoterWeightKeyPrefixByAddress(address))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VoterWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SnapshotVoterWeights records the voting power of each voter for the epoch
+// and opens the vote reward pot the treasury tax of the epoch is paid into.
+// The vote rewards carried over from the previous epochs stay in the pot.
+func (k Keeper) SnapshotVoterWeights(ctx context.Context, epochId uint64, voterWeights []types.VoterWeight) {
+	totalWeight := math.LegacyZeroDec()
+	for _, weight := range voterWeights {
+		if !weight.Weight.IsPositive() {
+			continue
+		}
+		weight.EpochId = epochId
+		k.SetVoterWeight(ctx, weight)
+		totalWeight = totalWeight.Add(weight.Weight)
+	}
+
+	if totalWeight.IsZero() {
+		return
+	}
+
+	pot, found := k.GetVoteRewardPot(ctx, epochId)
+	if !found {
+		pot = types.VoteRewardPot{
+			EpochId: epochId,
+			Rewards: sdk.NewCoins(),
+		}
+	}
+	pot.TotalWeight = totalWeight
+	k.SetVoteRewardPot(ctx, pot)
+}
+
+// FinalizeVoteRewards splits the treasury tax collected in `x/liquiditypool` during the ended epoch
+// by `x/fee` into burns and the community pool, and moves the vote rewards share into the vote reward
+// pot of the epoch and makes it claimable.
+// If nobody voted in the epoch, the vote rewards share is carried over to the pot of the next epoch.
+func (k Keeper) FinalizeVoteRewards(ctx context.Context, epochId uint64) error {
+	pot, found := k.GetVoteRewardPot(ctx, epochId)
+	if found && pot.Finalized {
+		return nil
+	}
+	if !found {
+		pot = newCarriedVoteRewardPot(epochId)
+	}
+
+	voteRewards := sdk.NewCoins()
+	treasuryTax := k.bankKeeper.GetAllBalances(ctx, liquiditypooltypes.TreasuryAddress())
+	if !treasuryTax.IsZero() {
+		var err error
+		voteRewards, err = k.feeKeeper.DistributeTreasuryTax(sdk.UnwrapSDKContext(ctx), liquiditypooltypes.ModuleName, treasuryTax)
+		if err != nil {
+			return err
+		}
+	}
+	if !voteRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, liquiditypooltypes.ModuleName, types.ModuleName, voteRewards); err != nil {
+			return err
+		}
+	}
+	pot.Rewards = pot.Rewards.Add(voteRewards...)
+
+	if !pot.TotalWeight.IsPositive() {
+		k.RemoveVoteRewardPot(ctx, epochId)
+		if !pot.Rewards.IsZero() {
+			next, found := k.GetVoteRewardPot(ctx, epochId+1)
+			if !found {
+				next = newCarriedVoteRewardPot(epochId + 1)
+			}
+			next.Rewards = next.Rewards.Add(pot.Rewards...)
+			k.SetVoteRewardPot(ctx, next)
+		}
+		return nil
+	}
+
+	pot.Finalized = true
+	k.SetVoteRewardPot(ctx, pot)
+
+	return nil
+}
+
+// newCarriedVoteRewardPot returns an empty pot without voters, which only
+// holds the vote rewards carried over until the epoch is snapshotted
+func newCarriedVoteRewardPot(epochId uint64) types.VoteRewardPot {
+	return types.VoteRewardPot{
+		EpochId:     epochId,
+		Rewards:     sdk.NewCoins(),
+		TotalWeight: math.LegacyZeroDec(),
+	}
+}
+
+// voteRewardShare returns the share of the pot for the voter weight
+func voteRewardShare(pot types.VoteRewardPot, weight types.VoterWeight) sdk.Coins {
+	if weight.Weight.GTE(pot.TotalWeight) {
+		return pot.Rewards
+	}
+	share, _ := sdk.NewDecCoinsFromCoins(pot.Rewards...).MulDecTruncate(weight.Weight).QuoDecTruncate(pot.TotalWeight).TruncateDecimal()
+	return share
+}
+
+// GetClaimableVoteRewards returns the vote rewards of the ended epochs claimable by an address
+func (k Keeper) GetClaimableVoteRewards(ctx context.Context, address string) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, weight := range k.GetAllVoterWeightsByAddress(ctx, address) {
+		pot, found := k.GetVoteRewardPot(ctx, weight.EpochId)
+		if !found || !pot.Finalized {
+			continue
+		}
+		rewards = rewards.Add(voteRewardShare(pot, weight)...)
+	}
+
+	return rewards
+}
+
+// CollectVoteRewards pays out the vote rewards of the ended epochs to the voter.
+// The voter weight of an epoch is removed once paid out, so each epoch can be claimed only once.
+func (k Keeper) CollectVoteRewards(ctx context.Context, voter sdk.AccAddress) (sdk.Coins, error) {
+	address := voter.String()
+	collected := sdk.NewCoins()
+	for _, weight := range k.GetAllVoterWeightsByAddress(ctx, address) {
+		pot, found := k.GetVoteRewardPot(ctx, weight.EpochId)
+		if !found || !pot.Finalized {
+			continue
+		}
+
+		share := voteRewardShare(pot, weight)
+		collected = collected.Add(share...)
+
+		pot.Rewards = pot.Rewards.Sub(share...)
+		pot.TotalWeight = pot.TotalWeight.Sub(weight.Weight)
+		if pot.TotalWeight.IsPositive() {
+			k.SetVoteRewardPot(ctx, pot)
+		} else {
+			k.RemoveVoteRewardPot(ctx, pot.EpochId)
+		}
+		k.RemoveVoterWeight(ctx, address, weight.EpochId)
+	}
+
+	if !collected.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, voter, collected); err != nil {
+			return nil, err
+		}
+	}
+
+	return collected, nil
+}
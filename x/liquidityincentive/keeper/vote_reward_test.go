@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+	liquiditypooltypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func TestSnapshotVoterWeights(t *testing.T) {
+	k, _, ctx := keepertest.LiquidityincentiveKeeper(t)
+	addr1, addr2 := sample.AccAddress(), sample.AccAddress()
+
+	k.SnapshotVoterWeights(ctx, 1, []types.VoterWeight{
+		{Address: addr1, Weight: math.LegacyNewDec(3)},
+		{Address: addr2, Weight: math.LegacyNewDec(1)},
+	})
+
+	pot, found := k.GetVoteRewardPot(ctx, 1)
+	require.True(t, found)
+	require.False(t, pot.Finalized)
+	require.Equal(t, math.LegacyNewDec(4), pot.TotalWeight)
+	require.Len(t, k.GetAllVoterWeights(ctx), 2)
+
+	// no pot is opened when nobody voted
+	k.SnapshotVoterWeights(ctx, 2, []types.VoterWeight{})
+	_, found = k.GetVoteRewardPot(ctx, 2)
+	require.False(t, found)
+}
+
+func TestFinalizeVoteRewards(t *testing.T) {
+	k, mocks, ctx := keepertest.LiquidityincentiveKeeper(t)
+	k.SnapshotVoterWeights(ctx, 1, []types.VoterWeight{
+		{Address: sample.AccAddress(), Weight: math.LegacyOneDec()},
+	})
+
+	treasuryTax := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	// x/fee burns and funds the community pool with a part of the treasury tax
+	voteRewards := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 600))
+	mocks.BankKeeper.EXPECT().GetAllBalances(ctx, liquiditypooltypes.TreasuryAddress()).Return(treasuryTax)
+	mocks.FeeKeeper.EXPECT().DistributeTreasuryTax(ctx, liquiditypooltypes.ModuleName, treasuryTax).Return(voteRewards, nil)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, liquiditypooltypes.ModuleName, types.ModuleName, voteRewards).Return(nil)
+
+	require.NoError(t, k.FinalizeVoteRewards(ctx, 1))
+	pot, found := k.GetVoteRewardPot(ctx, 1)
+	require.True(t, found)
+	require.True(t, pot.Finalized)
+	require.Equal(t, voteRewards, pot.Rewards)
+
+	// finalizing twice does not sweep the treasury again
+	require.NoError(t, k.FinalizeVoteRewards(ctx, 1))
+
+	// without voters the treasury tax is still split, and the vote rewards
+	// share is carried over to the next epoch
+	treasuryTax = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500))
+	carried := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300))
+	mocks.BankKeeper.EXPECT().GetAllBalances(ctx, liquiditypooltypes.TreasuryAddress()).Return(treasuryTax)
+	mocks.FeeKeeper.EXPECT().DistributeTreasuryTax(ctx, liquiditypooltypes.ModuleName, treasuryTax).Return(carried, nil)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, liquiditypooltypes.ModuleName, types.ModuleName, carried).Return(nil)
+
+	require.NoError(t, k.FinalizeVoteRewards(ctx, 2))
+	_, found = k.GetVoteRewardPot(ctx, 2)
+	require.False(t, found)
+	pot, found = k.GetVoteRewardPot(ctx, 3)
+	require.True(t, found)
+	require.False(t, pot.Finalized)
+	require.Equal(t, carried, pot.Rewards)
+
+	// the carried vote rewards go to the voters of the next epoch
+	k.SnapshotVoterWeights(ctx, 3, []types.VoterWeight{
+		{Address: sample.AccAddress(), Weight: math.LegacyOneDec()},
+	})
+	pot, found = k.GetVoteRewardPot(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, carried, pot.Rewards)
+	require.Equal(t, math.LegacyOneDec(), pot.TotalWeight)
+}
+
+func TestCollectVoteRewards(t *testing.T) {
+	k, mocks, ctx := keepertest.LiquidityincentiveKeeper(t)
+	addr1, addr2 := sample.AccAddress(), sample.AccAddress()
+	voter1 := sdk.MustAccAddressFromBech32(addr1)
+	voter2 := sdk.MustAccAddressFromBech32(addr2)
+
+	k.SnapshotVoterWeights(ctx, 1, []types.VoterWeight{
+		{Address: addr1, Weight: math.LegacyNewDec(3)},
+		{Address: addr2, Weight: math.LegacyNewDec(1)},
+	})
+	k.SnapshotVoterWeights(ctx, 2, []types.VoterWeight{
+		{Address: addr1, Weight: math.LegacyNewDec(1)},
+	})
+	pot, _ := k.GetVoteRewardPot(ctx, 1)
+	pot.Rewards = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1001))
+	pot.Finalized = true
+	k.SetVoteRewardPot(ctx, pot)
+
+	// rewards of the unfinalized epoch 2 are not claimable yet
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 750)), k.GetClaimableVoteRewards(ctx, addr1))
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, voter1, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 750))).Return(nil)
+	collected, err := k.CollectVoteRewards(ctx, voter1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 750)), collected)
+	_, found := k.GetVoterWeight(ctx, addr1, 1)
+	require.False(t, found)
+	_, found = k.GetVoterWeight(ctx, addr1, 2)
+	require.True(t, found)
+
+	// claiming twice pays nothing
+	collected, err = k.CollectVoteRewards(ctx, voter1)
+	require.NoError(t, err)
+	require.True(t, collected.IsZero())
+
+	// the last voter takes the remainder and the pot is removed
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, voter2, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 251))).Return(nil)
+	collected, err = k.CollectVoteRewards(ctx, voter2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 251)), collected)
+	_, found = k.GetVoteRewardPot(ctx, 1)
+	require.False(t, found)
+}
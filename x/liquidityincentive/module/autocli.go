@@ -40,6 +40,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows a gauge",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "previous_epoch_id"}, {ProtoField: "pool_id"}},
 				},
+				{
+					RpcMethod:      "VoteRewards",
+					Use:            "vote-rewards [address]",
+					Short:          "Shows the claimable vote rewards of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "PositionIncentives",
+					Use:            "position-incentives [id]",
+					Short:          "Shows the claimable incentives of a position",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "PositionsIncentives",
+					Use:            "positions-incentives [ids]",
+					Short:          "Shows the claimable incentives of positions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "ids", Varargs: true}},
+				},
+				{
+					RpcMethod:      "AddressIncentives",
+					Use:            "address-incentives [address]",
+					Short:          "Shows the claimable incentives of the positions of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -63,6 +87,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a collect-vote-rewards tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "CollectIncentiveRewards",
+					Use:            "collect-incentive-rewards [pool_ids]",
+					Short:          "Send a collect-incentive-rewards tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_ids", Varargs: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
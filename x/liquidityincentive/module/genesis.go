@@ -24,6 +24,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, vote := range genState.Votes {
 		k.SetVote(ctx, vote)
 	}
+	// Set all the vote reward pots
+	for _, pot := range genState.VoteRewardPots {
+		k.SetVoteRewardPot(ctx, pot)
+	}
+	// Set all the voter weights
+	for _, weight := range genState.VoterWeights {
+		k.SetVoterWeight(ctx, weight)
+	}
+	// Set all the pool incentives
+	for _, poolIncentive := range genState.PoolIncentives {
+		k.SetPoolIncentive(ctx, poolIncentive)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -39,6 +51,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.EpochCount = k.GetEpochCount(ctx)
 	genesis.Gauges = k.GetAllGauges(ctx)
 	genesis.Votes = k.GetAllVotes(ctx)
+	genesis.VoteRewardPots = k.GetAllVoteRewardPots(ctx)
+	genesis.VoterWeights = k.GetAllVoterWeights(ctx)
+	genesis.PoolIncentives = k.GetAllPoolIncentives(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
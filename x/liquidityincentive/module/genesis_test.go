@@ -3,6 +3,9 @@ package liquidityincentive_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
@@ -33,6 +36,28 @@ func TestGenesis(t *testing.T) {
 				PoolId:          1,
 			},
 		},
+		VoteRewardPots: []types.VoteRewardPot{
+			{
+				EpochId:     1,
+				Rewards:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+				TotalWeight: math.LegacyOneDec(),
+				Finalized:   true,
+			},
+		},
+		VoterWeights: []types.VoterWeight{
+			{
+				EpochId: 1,
+				Address: "addr1",
+				Weight:  math.LegacyOneDec(),
+			},
+		},
+		PoolIncentives: []types.PoolIncentive{
+			{
+				PoolId:   1,
+				Inflated: sdk.NewCoins(sdk.NewInt64Coin("urise", 100)),
+				Claimed:  sdk.NewCoins(sdk.NewInt64Coin("urise", 50)),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -47,5 +72,8 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.Epochs, got.Epochs)
 	require.Equal(t, genesisState.EpochCount, got.EpochCount)
 	require.ElementsMatch(t, genesisState.Gauges, got.Gauges)
+	require.ElementsMatch(t, genesisState.VoteRewardPots, got.VoteRewardPots)
+	require.ElementsMatch(t, genesisState.VoterWeights, got.VoterWeights)
+	require.ElementsMatch(t, genesisState.PoolIncentives, got.PoolIncentives)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -186,6 +186,7 @@ type ModuleInputs struct {
 	BankKeeper          types.BankKeeper
 	StakingKeeper       types.StakingKeeper
 	LiquidityPoolKeeper types.LiquidityPoolKeeper
+	FeeKeeper           types.FeeKeeper
 }
 
 type ModuleOutputs struct {
@@ -210,6 +211,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.StakingKeeper,
 		in.LiquidityPoolKeeper,
+		in.FeeKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
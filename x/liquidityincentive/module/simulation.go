@@ -31,6 +31,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgCollectVoteRewards int = 100
 
+	opWeightMsgCollectIncentiveRewards = "op_weight_msg_collect_incentive_rewards"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCollectIncentiveRewards int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -76,6 +80,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		liquidityincentivesimulation.SimulateMsgCollectVoteRewards(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgCollectIncentiveRewards int
+	simState.AppParams.GetOrGenerate(opWeightMsgCollectIncentiveRewards, &weightMsgCollectIncentiveRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgCollectIncentiveRewards = defaultWeightMsgCollectIncentiveRewards
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCollectIncentiveRewards,
+		liquidityincentivesimulation.SimulateMsgCollectIncentiveRewards(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -100,6 +115,14 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCollectIncentiveRewards,
+			defaultWeightMsgCollectIncentiveRewards,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				liquidityincentivesimulation.SimulateMsgCollectIncentiveRewards(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/keeper"
+	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
+)
+
+func SimulateMsgCollectIncentiveRewards(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCollectIncentiveRewards{
+			Sender: simAccount.Address.String(),
+		}
+
+		// TODO: Handling the CollectIncentiveRewards simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CollectIncentiveRewards simulation not implemented"), nil, nil
+	}
+}
@@ -350,18 +350,78 @@ func (m *MockLiquidityPoolKeeper) EXPECT() *MockLiquidityPoolKeeperMockRecorder
 	return m.recorder
 }
 
-// AllocateIncentive mocks base method.
-func (m *MockLiquidityPoolKeeper) AllocateIncentive(ctx types.Context, poolId uint64, sender types.AccAddress, incentiveCoins types.Coins) error {
+// ClaimIncentiveAccumulation mocks base method.
+func (m *MockLiquidityPoolKeeper) ClaimIncentiveAccumulation(ctx types.Context, positionId uint64) (types.DecCoins, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AllocateIncentive", ctx, poolId, sender, incentiveCoins)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "ClaimIncentiveAccumulation", ctx, positionId)
+	ret0, _ := ret[0].(types.DecCoins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimIncentiveAccumulation indicates an expected call of ClaimIncentiveAccumulation.
+func (mr *MockLiquidityPoolKeeperMockRecorder) ClaimIncentiveAccumulation(ctx, positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimIncentiveAccumulation", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).ClaimIncentiveAccumulation), ctx, positionId)
+}
+
+// ClaimLimitOrderIncentiveAccumulation mocks base method.
+func (m *MockLiquidityPoolKeeper) ClaimLimitOrderIncentiveAccumulation(ctx types.Context, orderId uint64) (types.DecCoins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLimitOrderIncentiveAccumulation", ctx, orderId)
+	ret0, _ := ret[0].(types.DecCoins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLimitOrderIncentiveAccumulation indicates an expected call of ClaimLimitOrderIncentiveAccumulation.
+func (mr *MockLiquidityPoolKeeperMockRecorder) ClaimLimitOrderIncentiveAccumulation(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLimitOrderIncentiveAccumulation", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).ClaimLimitOrderIncentiveAccumulation), ctx, orderId)
+}
+
+// GetIncentiveAccumulation mocks base method.
+func (m *MockLiquidityPoolKeeper) GetIncentiveAccumulation(ctx types.Context, positionId uint64) (types.DecCoins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncentiveAccumulation", ctx, positionId)
+	ret0, _ := ret[0].(types.DecCoins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncentiveAccumulation indicates an expected call of GetIncentiveAccumulation.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetIncentiveAccumulation(ctx, positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncentiveAccumulation", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetIncentiveAccumulation), ctx, positionId)
+}
+
+// GetLimitOrderIncentiveAccumulation mocks base method.
+func (m *MockLiquidityPoolKeeper) GetLimitOrderIncentiveAccumulation(ctx types.Context, order types1.LimitOrder) (types.DecCoins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimitOrderIncentiveAccumulation", ctx, order)
+	ret0, _ := ret[0].(types.DecCoins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimitOrderIncentiveAccumulation indicates an expected call of GetLimitOrderIncentiveAccumulation.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetLimitOrderIncentiveAccumulation(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimitOrderIncentiveAccumulation", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetLimitOrderIncentiveAccumulation), ctx, order)
+}
+
+// GetLimitOrdersByAddress mocks base method.
+func (m *MockLiquidityPoolKeeper) GetLimitOrdersByAddress(ctx context.Context, addr string) []types1.LimitOrder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimitOrdersByAddress", ctx, addr)
+	ret0, _ := ret[0].([]types1.LimitOrder)
 	return ret0
 }
 
-// AllocateIncentive indicates an expected call of AllocateIncentive.
-func (mr *MockLiquidityPoolKeeperMockRecorder) AllocateIncentive(ctx, poolId, sender, incentiveCoins interface{}) *gomock.Call {
+// GetLimitOrdersByAddress indicates an expected call of GetLimitOrdersByAddress.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetLimitOrdersByAddress(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIncentive", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).AllocateIncentive), ctx, poolId, sender, incentiveCoins)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimitOrdersByAddress", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetLimitOrdersByAddress), ctx, addr)
 }
 
 // GetPool mocks base method.
@@ -378,3 +438,84 @@ func (mr *MockLiquidityPoolKeeperMockRecorder) GetPool(ctx, id interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPool), ctx, id)
 }
+
+// GetPoolIncentiveAccumulation mocks base method.
+func (m *MockLiquidityPoolKeeper) GetPoolIncentiveAccumulation(ctx types.Context, poolId uint64) types.DecCoins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolIncentiveAccumulation", ctx, poolId)
+	ret0, _ := ret[0].(types.DecCoins)
+	return ret0
+}
+
+// GetPoolIncentiveAccumulation indicates an expected call of GetPoolIncentiveAccumulation.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetPoolIncentiveAccumulation(ctx, poolId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolIncentiveAccumulation", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPoolIncentiveAccumulation), ctx, poolId)
+}
+
+// GetPosition mocks base method.
+func (m *MockLiquidityPoolKeeper) GetPosition(ctx context.Context, id uint64) (types1.Position, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition", ctx, id)
+	ret0, _ := ret[0].(types1.Position)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetPosition(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPosition), ctx, id)
+}
+
+// GetPositionsByAddress mocks base method.
+func (m *MockLiquidityPoolKeeper) GetPositionsByAddress(ctx context.Context, addr string) []types1.Position {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositionsByAddress", ctx, addr)
+	ret0, _ := ret[0].([]types1.Position)
+	return ret0
+}
+
+// GetPositionsByAddress indicates an expected call of GetPositionsByAddress.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetPositionsByAddress(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositionsByAddress", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPositionsByAddress), ctx, addr)
+}
+
+// MockFeeKeeper is a mock of FeeKeeper interface.
+type MockFeeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeKeeperMockRecorder
+}
+
+// MockFeeKeeperMockRecorder is the mock recorder for MockFeeKeeper.
+type MockFeeKeeperMockRecorder struct {
+	mock *MockFeeKeeper
+}
+
+// NewMockFeeKeeper creates a new mock instance.
+func NewMockFeeKeeper(ctrl *gomock.Controller) *MockFeeKeeper {
+	mock := &MockFeeKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeKeeper) EXPECT() *MockFeeKeeperMockRecorder {
+	return m.recorder
+}
+
+// DistributeTreasuryTax mocks base method.
+func (m *MockFeeKeeper) DistributeTreasuryTax(ctx types.Context, fromModule string, treasuryTax types.Coins) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeTreasuryTax", ctx, fromModule, treasuryTax)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DistributeTreasuryTax indicates an expected call of DistributeTreasuryTax.
+func (mr *MockFeeKeeperMockRecorder) DistributeTreasuryTax(ctx, fromModule, treasuryTax interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTreasuryTax", reflect.TypeOf((*MockFeeKeeper)(nil).DistributeTreasuryTax), ctx, fromModule, treasuryTax)
+}
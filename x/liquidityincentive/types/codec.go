@@ -11,6 +11,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVoteGauge{},
 		&MsgCollectVoteRewards{},
+		&MsgCollectIncentiveRewards{},
 		&MsgUpdateParams{},
 	)
 	// this line is used by starport scaffolding # 3
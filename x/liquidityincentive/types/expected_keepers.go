@@ -52,5 +52,17 @@ type StakingKeeper interface {
 
 type LiquidityPoolKeeper interface {
 	GetPool(ctx context.Context, id uint64) (val liquiditypooltypes.Pool, found bool)
-	AllocateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoins sdk.Coins) error
+	GetPosition(ctx context.Context, id uint64) (val liquiditypooltypes.Position, found bool)
+	GetPositionsByAddress(ctx context.Context, addr string) []liquiditypooltypes.Position
+	GetLimitOrdersByAddress(ctx context.Context, addr string) []liquiditypooltypes.LimitOrder
+	GetPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64) sdk.DecCoins
+	GetIncentiveAccumulation(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error)
+	ClaimIncentiveAccumulation(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error)
+	GetLimitOrderIncentiveAccumulation(ctx sdk.Context, order liquiditypooltypes.LimitOrder) (sdk.DecCoins, error)
+	ClaimLimitOrderIncentiveAccumulation(ctx sdk.Context, orderId uint64) (sdk.DecCoins, error)
+}
+
+// FeeKeeper defines the expected interface for the Fee module.
+type FeeKeeper interface {
+	DistributeTreasuryTax(ctx sdk.Context, fromModule string, treasuryTax sdk.Coins) (voteRewards sdk.Coins, err error)
 }
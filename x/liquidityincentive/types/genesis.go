@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"cosmossdk.io/math"
 )
 
 // DefaultIndex is the default global index
@@ -10,8 +12,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Epochs: []Epoch{},
-		Gauges: []Gauge{},
+		Epochs:         []Epoch{},
+		Gauges:         []Gauge{},
+		VoteRewardPots: []VoteRewardPot{},
+		VoterWeights:   []VoterWeight{},
+		PoolIncentives: []PoolIncentive{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -42,6 +47,69 @@ func (gs GenesisState) Validate() error {
 		}
 		gaugeIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated epoch id in vote reward pot
+	voteRewardPotMap := make(map[uint64]VoteRewardPot)
+	for _, elem := range gs.VoteRewardPots {
+		if _, ok := voteRewardPotMap[elem.EpochId]; ok {
+			return fmt.Errorf("duplicated epoch id for vote reward pot")
+		}
+		if err := elem.Rewards.Validate(); err != nil {
+			return err
+		}
+		// The pots without voters hold the vote rewards carried over to their epoch
+		if elem.TotalWeight.IsNil() || elem.TotalWeight.IsNegative() || (elem.Finalized && !elem.TotalWeight.IsPositive()) {
+			return fmt.Errorf("total weight of vote reward pot should be positive")
+		}
+		voteRewardPotMap[elem.EpochId] = elem
+	}
+	// Check for duplicated index in voter weight
+	voterWeightIndexMap := make(map[string]struct{})
+	voterWeightTotalMap := make(map[uint64]math.LegacyDec)
+	for _, elem := range gs.VoterWeights {
+		index := string(VoterWeightKey(elem.Address, elem.EpochId))
+		if _, ok := voterWeightIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for voter weight")
+		}
+		if _, ok := voteRewardPotMap[elem.EpochId]; !ok {
+			return fmt.Errorf("vote reward pot not found for voter weight of epoch %d", elem.EpochId)
+		}
+		if elem.Weight.IsNil() || !elem.Weight.IsPositive() {
+			return fmt.Errorf("voter weight should be positive")
+		}
+		voterWeightIndexMap[index] = struct{}{}
+
+		total, ok := voterWeightTotalMap[elem.EpochId]
+		if !ok {
+			total = math.LegacyZeroDec()
+		}
+		voterWeightTotalMap[elem.EpochId] = total.Add(elem.Weight)
+	}
+	for epochId, pot := range voteRewardPotMap {
+		total, ok := voterWeightTotalMap[epochId]
+		if !ok {
+			total = math.LegacyZeroDec()
+		}
+		if !total.Equal(pot.TotalWeight) {
+			return fmt.Errorf("total weight of vote reward pot of epoch %d does not match its voter weights", epochId)
+		}
+	}
+	// Check for duplicated pool id in pool incentive
+	poolIncentiveMap := make(map[uint64]struct{})
+	for _, elem := range gs.PoolIncentives {
+		if _, ok := poolIncentiveMap[elem.PoolId]; ok {
+			return fmt.Errorf("duplicated pool id for pool incentive")
+		}
+		if err := elem.Inflated.Validate(); err != nil {
+			return err
+		}
+		if err := elem.Claimed.Validate(); err != nil {
+			return err
+		}
+		if !elem.Inflated.IsAllGTE(elem.Claimed) {
+			return fmt.Errorf("claimed incentive of pool %d exceeds the inflated incentive", elem.PoolId)
+		}
+		poolIncentiveMap[elem.PoolId] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -27,11 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the liquidityincentive module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params     Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Epochs     []Epoch `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
-	EpochCount uint64  `protobuf:"varint,3,opt,name=epochCount,proto3" json:"epochCount,omitempty"`
-	Gauges     []Gauge `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges"`
-	Votes      []Vote  `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
+	Params         Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Epochs         []Epoch         `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+	EpochCount     uint64          `protobuf:"varint,3,opt,name=epochCount,proto3" json:"epochCount,omitempty"`
+	Gauges         []Gauge         `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges"`
+	Votes          []Vote          `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
+	VoteRewardPots []VoteRewardPot `protobuf:"bytes,6,rep,name=vote_reward_pots,json=voteRewardPots,proto3" json:"vote_reward_pots"`
+	VoterWeights   []VoterWeight   `protobuf:"bytes,7,rep,name=voter_weights,json=voterWeights,proto3" json:"voter_weights"`
+	PoolIncentives []PoolIncentive `protobuf:"bytes,8,rep,name=pool_incentives,json=poolIncentives,proto3" json:"pool_incentives"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +105,27 @@ func (m *GenesisState) GetVotes() []Vote {
 	return nil
 }
 
+func (m *GenesisState) GetVoteRewardPots() []VoteRewardPot {
+	if m != nil {
+		return m.VoteRewardPots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoterWeights() []VoterWeight {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolIncentives() []PoolIncentive {
+	if m != nil {
+		return m.PoolIncentives
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.liquidityincentive.GenesisState")
 }
@@ -111,28 +135,34 @@ func init() {
 }
 
 var fileDescriptor_5f3633f334ba0bf3 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0xcc, 0xcc, 0x4b,
-	0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0xaa, 0xd4, 0xc3, 0x54, 0x29, 0x25, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xca, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
-	0x10, 0x0b, 0x2a, 0xaa, 0x8e, 0xc7, 0xba, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x6d, 0x52, 0x6a,
-	0x78, 0x14, 0xa6, 0x16, 0xe4, 0x27, 0x67, 0x10, 0xa1, 0x2e, 0x3d, 0xb1, 0x34, 0x3d, 0x15, 0xa2,
-	0x4e, 0x69, 0x2b, 0x13, 0x17, 0x8f, 0x3b, 0xc4, 0x3f, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xae,
-	0x5c, 0x6c, 0x10, 0x0b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x70, 0xfb, 0x4f,
-	0x2f, 0x00, 0xac, 0xd2, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0x35, 0x0b, 0xd9, 0x73, 0xb1, 0x81, 0x9d, 0x53, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d,
-	0xa4, 0x88, 0xcf, 0x18, 0x57, 0x90, 0x4a, 0x27, 0x16, 0x90, 0x29, 0x41, 0x50, 0x6d, 0x42, 0x72,
-	0x5c, 0x5c, 0x60, 0x96, 0x73, 0x7e, 0x69, 0x5e, 0x89, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x4b, 0x10,
-	0x92, 0x08, 0xc8, 0x02, 0xb0, 0x3f, 0x8a, 0x25, 0x58, 0x08, 0x5b, 0xe0, 0x0e, 0x52, 0x09, 0xb3,
-	0x00, 0xa2, 0x4d, 0xc8, 0x86, 0x8b, 0xb5, 0x2c, 0xbf, 0x24, 0xb5, 0x58, 0x82, 0x15, 0xac, 0x5f,
-	0x01, 0x9f, 0xfe, 0xb0, 0xfc, 0x12, 0x98, 0x76, 0x88, 0x26, 0xa7, 0x90, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x87, 0x1a, 0x99, 0x93, 0x58, 0x99, 0x5a, 0x04, 0xe3, 0xe8, 0x57, 0x60, 0x8b, 0x93,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xa4, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x11, 0x7d, 0xbd, 0xdc, 0x7e, 0x02, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x13, 0x37, 0x1b, 0x75, 0x76, 0xfd, 0x37, 0x78, 0x18, 0x72, 0x88, 0x71, 0x0f, 0x6e,
+	0x5c, 0x24, 0x81, 0xf5, 0x26, 0x82, 0x50, 0x59, 0x16, 0x6f, 0x25, 0x8a, 0xff, 0x2e, 0x21, 0x6d,
+	0x87, 0x74, 0x20, 0xcd, 0x1b, 0x67, 0x26, 0xa9, 0xfd, 0x16, 0x7e, 0x0c, 0x8f, 0x7e, 0x02, 0xcf,
+	0x3d, 0xf6, 0xe8, 0x49, 0xa4, 0x3d, 0xf8, 0x35, 0x24, 0x93, 0x49, 0x0d, 0xa8, 0x89, 0x97, 0xf0,
+	0xe6, 0xe1, 0xf7, 0x3c, 0xcf, 0xcc, 0xcb, 0x20, 0x5f, 0x94, 0x39, 0x67, 0x82, 0x86, 0x19, 0xfb,
+	0x50, 0xb2, 0x19, 0x93, 0x2b, 0x96, 0x4f, 0x69, 0x2e, 0x59, 0x45, 0xc3, 0x94, 0xe6, 0x54, 0x30,
+	0x11, 0x14, 0x1c, 0x24, 0x60, 0x47, 0x93, 0xc1, 0x9f, 0xa4, 0x73, 0x27, 0x59, 0xb0, 0x1c, 0x42,
+	0xf5, 0x6d, 0x70, 0xe7, 0x6e, 0x0a, 0x29, 0xa8, 0x31, 0xac, 0x27, 0xad, 0x9e, 0xf6, 0xd4, 0x15,
+	0x09, 0x4f, 0x16, 0xba, 0xcd, 0x79, 0xd0, 0x03, 0xd2, 0x02, 0xa6, 0xf3, 0xff, 0xe0, 0xd2, 0xa4,
+	0x4c, 0xa9, 0xe6, 0x1e, 0xf5, 0x70, 0x15, 0x48, 0x1a, 0x73, 0xba, 0x4c, 0xf8, 0x4c, 0xd3, 0x67,
+	0x3d, 0xf4, 0x7e, 0x6a, 0xd8, 0x93, 0xaf, 0x16, 0x3a, 0xbe, 0x6c, 0x36, 0xf5, 0x52, 0x26, 0x92,
+	0xe2, 0x0b, 0x64, 0x37, 0x57, 0x21, 0xa6, 0x67, 0xfa, 0x47, 0xe7, 0x27, 0xc1, 0xbf, 0x37, 0x17,
+	0x8c, 0x15, 0x39, 0xba, 0xbe, 0xfe, 0x7e, 0xcf, 0xf8, 0xfc, 0xf3, 0xcb, 0x99, 0x19, 0x69, 0x33,
+	0x7e, 0x86, 0x6c, 0x75, 0x51, 0x41, 0xae, 0x78, 0x07, 0xfe, 0xd1, 0xf9, 0xfd, 0xbe, 0x98, 0x8b,
+	0x9a, 0x1c, 0x59, 0x75, 0x4a, 0xa4, 0x6d, 0xd8, 0x45, 0x48, 0x4d, 0xcf, 0xa1, 0xcc, 0x25, 0x39,
+	0xf0, 0x4c, 0xdf, 0x8a, 0x3a, 0x4a, 0x5d, 0xa0, 0x36, 0x24, 0x88, 0x35, 0x5c, 0x70, 0x59, 0x93,
+	0x6d, 0x41, 0x63, 0xc3, 0x4f, 0xd1, 0x61, 0xbd, 0x3a, 0x41, 0x0e, 0x95, 0xdf, 0xeb, 0xf3, 0xbf,
+	0x06, 0xd9, 0xda, 0x1b, 0x13, 0x7e, 0x87, 0x6e, 0x77, 0x16, 0x1f, 0x17, 0x20, 0x05, 0xb1, 0x55,
+	0xd0, 0xc3, 0xa1, 0xa0, 0x48, 0x59, 0xc6, 0x20, 0x75, 0xe2, 0xcd, 0xaa, 0x2b, 0x0a, 0x1c, 0xa1,
+	0x1b, 0xb5, 0xc2, 0xe3, 0x25, 0x65, 0xe9, 0x5c, 0x0a, 0x72, 0x55, 0xe5, 0x9e, 0x0e, 0xe5, 0xf2,
+	0x37, 0x8a, 0xd7, 0xa9, 0xc7, 0xd5, 0x6f, 0x49, 0xe0, 0xb7, 0xe8, 0x56, 0x01, 0x90, 0xc5, 0x7b,
+	0x87, 0x20, 0xd7, 0x86, 0x4f, 0x3b, 0x06, 0xc8, 0x5e, 0xb4, 0x7f, 0xed, 0x69, 0x8b, 0xae, 0x28,
+	0x46, 0xaf, 0xd6, 0x5b, 0xd7, 0xdc, 0x6c, 0x5d, 0xf3, 0xc7, 0xd6, 0x35, 0x3f, 0xed, 0x5c, 0x63,
+	0xb3, 0x73, 0x8d, 0x6f, 0x3b, 0xd7, 0x78, 0xff, 0x24, 0x65, 0x72, 0x5e, 0x4e, 0x82, 0x29, 0x2c,
+	0x42, 0x5d, 0x92, 0x25, 0x2b, 0xca, 0xdb, 0x9f, 0xf0, 0xe3, 0xdf, 0x1e, 0xa8, 0x5c, 0x15, 0x54,
+	0x4c, 0x6c, 0xf5, 0x3a, 0x1f, 0xff, 0x1a, 0x00, 0xc5, 0xc3, 0xfb, 0x92, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +185,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolIncentives) > 0 {
+		for iNdEx := len(m.PoolIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VoteRewardPots) > 0 {
+		for iNdEx := len(m.VoteRewardPots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteRewardPots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -255,6 +327,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VoteRewardPots) > 0 {
+		for _, e := range m.VoteRewardPots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolIncentives) > 0 {
+		for _, e := range m.PoolIncentives {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -447,6 +537,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteRewardPots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteRewardPots = append(m.VoteRewardPots, VoteRewardPot{})
+			if err := m.VoteRewardPots[len(m.VoteRewardPots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterWeights = append(m.VoterWeights, VoterWeight{})
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIncentives = append(m.PoolIncentives, PoolIncentive{})
+			if err := m.PoolIncentives[len(m.PoolIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,9 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 	"github.com/sunriselayer/sunrise/x/liquidityincentive/types"
 )
@@ -40,6 +43,31 @@ func TestGenesisState_Validate(t *testing.T) {
 						PoolId:          1,
 					},
 				},
+				VoteRewardPots: []types.VoteRewardPot{
+					{
+						EpochId:     1,
+						TotalWeight: math.LegacyNewDec(3),
+					},
+				},
+				VoterWeights: []types.VoterWeight{
+					{
+						EpochId: 1,
+						Address: "addr1",
+						Weight:  math.LegacyNewDec(1),
+					},
+					{
+						EpochId: 1,
+						Address: "addr2",
+						Weight:  math.LegacyNewDec(2),
+					},
+				},
+				PoolIncentives: []types.PoolIncentive{
+					{
+						PoolId:   1,
+						Inflated: sdk.NewCoins(sdk.NewInt64Coin("urise", 100)),
+						Claimed:  sdk.NewCoins(sdk.NewInt64Coin("urise", 50)),
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -86,6 +114,88 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated vote reward pot",
+			genState: &types.GenesisState{
+				VoteRewardPots: []types.VoteRewardPot{
+					{
+						EpochId:     1,
+						TotalWeight: math.LegacyOneDec(),
+					},
+					{
+						EpochId:     1,
+						TotalWeight: math.LegacyOneDec(),
+					},
+				},
+				VoterWeights: []types.VoterWeight{
+					{
+						EpochId: 1,
+						Address: "addr1",
+						Weight:  math.LegacyOneDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "voter weight without vote reward pot",
+			genState: &types.GenesisState{
+				VoterWeights: []types.VoterWeight{
+					{
+						EpochId: 1,
+						Address: "addr1",
+						Weight:  math.LegacyOneDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "voter weights not matching total weight",
+			genState: &types.GenesisState{
+				VoteRewardPots: []types.VoteRewardPot{
+					{
+						EpochId:     1,
+						TotalWeight: math.LegacyNewDec(2),
+					},
+				},
+				VoterWeights: []types.VoterWeight{
+					{
+						EpochId: 1,
+						Address: "addr1",
+						Weight:  math.LegacyOneDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pool incentive",
+			genState: &types.GenesisState{
+				PoolIncentives: []types.PoolIncentive{
+					{
+						PoolId: 1,
+					},
+					{
+						PoolId: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "claimed pool incentive exceeding inflated",
+			genState: &types.GenesisState{
+				PoolIncentives: []types.PoolIncentive{
+					{
+						PoolId:   1,
+						Inflated: sdk.NewCoins(sdk.NewInt64Coin("urise", 100)),
+						Claimed:  sdk.NewCoins(sdk.NewInt64Coin("urise", 101)),
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,11 @@
+package types
+
+import "encoding/binary"
+
+// PoolIncentiveKey returns the store key to retrieve a PoolIncentive from the pool id
+func PoolIncentiveKey(poolId uint64) []byte {
+	key := KeyPrefix(PoolIncentiveKeyPrefix)
+	key = binary.BigEndian.AppendUint64(key, poolId)
+
+	return key
+}
@@ -0,0 +1,30 @@
+package types
+
+import "encoding/binary"
+
+// VoteRewardPotKey returns the store key to retrieve a VoteRewardPot from the epoch id
+func VoteRewardPotKey(epochId uint64) []byte {
+	key := KeyPrefix(VoteRewardPotKeyPrefix)
+	key = binary.BigEndian.AppendUint64(key, epochId)
+
+	return key
+}
+
+// VoterWeightKeyPrefixByAddress returns the prefix to retrieve all VoterWeight of an address
+func VoterWeightKeyPrefixByAddress(address string) []byte {
+	var key []byte
+
+	key = append(key, []byte(VoterWeightKeyPrefix)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// VoterWeightKey returns the store key to retrieve a VoterWeight from the index fields
+func VoterWeightKey(address string, epochId uint64) []byte {
+	key := VoterWeightKeyPrefixByAddress(address)
+	key = binary.BigEndian.AppendUint64(key, epochId)
+
+	return key
+}
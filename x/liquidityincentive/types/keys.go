@@ -24,3 +24,12 @@ const (
 	EpochCountKey = "Epoch/count/"
 	VoteKeyPrefix = "Vote/value"
 )
+
+const (
+	VoteRewardPotKeyPrefix = "VoteRewardPot/value/"
+	VoterWeightKeyPrefix   = "VoterWeight/value/"
+)
+
+const (
+	PoolIncentiveKeyPrefix = "PoolIncentive/value/"
+)
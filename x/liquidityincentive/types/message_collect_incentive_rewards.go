@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCollectIncentiveRewards{}
+
+func NewMsgCollectIncentiveRewards(sender string, poolIds []uint64) *MsgCollectIncentiveRewards {
+	return &MsgCollectIncentiveRewards{
+		Sender:  sender,
+		PoolIds: poolIds,
+	}
+}
+
+func (msg *MsgCollectIncentiveRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.PoolIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty pool ids")
+	}
+	poolIdMap := make(map[uint64]struct{})
+	for _, poolId := range msg.PoolIds {
+		if _, ok := poolIdMap[poolId]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated pool id %d", poolId)
+		}
+		poolIdMap[poolId] = struct{}{}
+	}
+	return nil
+}
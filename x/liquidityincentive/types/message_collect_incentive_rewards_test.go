@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+)
+
+func TestMsgCollectIncentiveRewards_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCollectIncentiveRewards
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCollectIncentiveRewards{
+				Sender:  "invalid_address",
+				PoolIds: []uint64{1},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty pool ids",
+			msg: MsgCollectIncentiveRewards{
+				Sender: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicated pool ids",
+			msg: MsgCollectIncentiveRewards{
+				Sender:  sample.AccAddress(),
+				PoolIds: []uint64{1, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgCollectIncentiveRewards{
+				Sender:  sample.AccAddress(),
+				PoolIds: []uint64{1, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,7 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -499,6 +500,368 @@ func (m *QueryGaugesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryVoteRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVoteRewardsRequest) Reset()         { *m = QueryVoteRewardsRequest{} }
+func (m *QueryVoteRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRewardsRequest) ProtoMessage()    {}
+func (*QueryVoteRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{10}
+}
+func (m *QueryVoteRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRewardsRequest.Merge(m, src)
+}
+func (m *QueryVoteRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryVoteRewardsResponse struct {
+	// rewards claimable from the ended epochs
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// weights of the epochs not claimed yet, including the ongoing ones
+	VoterWeights []VoterWeight `protobuf:"bytes,2,rep,name=voter_weights,json=voterWeights,proto3" json:"voter_weights"`
+}
+
+func (m *QueryVoteRewardsResponse) Reset()         { *m = QueryVoteRewardsResponse{} }
+func (m *QueryVoteRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRewardsResponse) ProtoMessage()    {}
+func (*QueryVoteRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{11}
+}
+func (m *QueryVoteRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteRewardsResponse.Merge(m, src)
+}
+func (m *QueryVoteRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryVoteRewardsResponse) GetVoterWeights() []VoterWeight {
+	if m != nil {
+		return m.VoterWeights
+	}
+	return nil
+}
+
+type QueryPositionIncentivesRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPositionIncentivesRequest) Reset()         { *m = QueryPositionIncentivesRequest{} }
+func (m *QueryPositionIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionIncentivesRequest) ProtoMessage()    {}
+func (*QueryPositionIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{12}
+}
+func (m *QueryPositionIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionIncentivesRequest.Merge(m, src)
+}
+func (m *QueryPositionIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionIncentivesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryPositionIncentivesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryPositionIncentivesResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryPositionIncentivesResponse) Reset()         { *m = QueryPositionIncentivesResponse{} }
+func (m *QueryPositionIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionIncentivesResponse) ProtoMessage()    {}
+func (*QueryPositionIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{13}
+}
+func (m *QueryPositionIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionIncentivesResponse.Merge(m, src)
+}
+func (m *QueryPositionIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryPositionIncentivesResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type QueryPositionsIncentivesRequest struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *QueryPositionsIncentivesRequest) Reset()         { *m = QueryPositionsIncentivesRequest{} }
+func (m *QueryPositionsIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsIncentivesRequest) ProtoMessage()    {}
+func (*QueryPositionsIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{14}
+}
+func (m *QueryPositionsIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsIncentivesRequest.Merge(m, src)
+}
+func (m *QueryPositionsIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsIncentivesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryPositionsIncentivesRequest) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type QueryPositionsIncentivesResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryPositionsIncentivesResponse) Reset()         { *m = QueryPositionsIncentivesResponse{} }
+func (m *QueryPositionsIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsIncentivesResponse) ProtoMessage()    {}
+func (*QueryPositionsIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{15}
+}
+func (m *QueryPositionsIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsIncentivesResponse.Merge(m, src)
+}
+func (m *QueryPositionsIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryPositionsIncentivesResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type QueryAddressIncentivesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressIncentivesRequest) Reset()         { *m = QueryAddressIncentivesRequest{} }
+func (m *QueryAddressIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressIncentivesRequest) ProtoMessage()    {}
+func (*QueryAddressIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{16}
+}
+func (m *QueryAddressIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressIncentivesRequest.Merge(m, src)
+}
+func (m *QueryAddressIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressIncentivesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryAddressIncentivesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryAddressIncentivesResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryAddressIncentivesResponse) Reset()         { *m = QueryAddressIncentivesResponse{} }
+func (m *QueryAddressIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressIncentivesResponse) ProtoMessage()    {}
+func (*QueryAddressIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab94b087610c22b5, []int{17}
+}
+func (m *QueryAddressIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressIncentivesResponse.Merge(m, src)
+}
+func (m *QueryAddressIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryAddressIncentivesResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.liquidityincentive.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.liquidityincentive.QueryParamsResponse")
@@ -510,6 +873,14 @@ func init() {
 	proto.RegisterType((*QueryGaugeResponse)(nil), "sunrise.liquidityincentive.QueryGaugeResponse")
 	proto.RegisterType((*QueryGaugesRequest)(nil), "sunrise.liquidityincentive.QueryGaugesRequest")
 	proto.RegisterType((*QueryGaugesResponse)(nil), "sunrise.liquidityincentive.QueryGaugesResponse")
+	proto.RegisterType((*QueryVoteRewardsRequest)(nil), "sunrise.liquidityincentive.QueryVoteRewardsRequest")
+	proto.RegisterType((*QueryVoteRewardsResponse)(nil), "sunrise.liquidityincentive.QueryVoteRewardsResponse")
+	proto.RegisterType((*QueryPositionIncentivesRequest)(nil), "sunrise.liquidityincentive.QueryPositionIncentivesRequest")
+	proto.RegisterType((*QueryPositionIncentivesResponse)(nil), "sunrise.liquidityincentive.QueryPositionIncentivesResponse")
+	proto.RegisterType((*QueryPositionsIncentivesRequest)(nil), "sunrise.liquidityincentive.QueryPositionsIncentivesRequest")
+	proto.RegisterType((*QueryPositionsIncentivesResponse)(nil), "sunrise.liquidityincentive.QueryPositionsIncentivesResponse")
+	proto.RegisterType((*QueryAddressIncentivesRequest)(nil), "sunrise.liquidityincentive.QueryAddressIncentivesRequest")
+	proto.RegisterType((*QueryAddressIncentivesResponse)(nil), "sunrise.liquidityincentive.QueryAddressIncentivesResponse")
 }
 
 func init() {
@@ -517,49 +888,71 @@ func init() {
 }
 
 var fileDescriptor_ab94b087610c22b5 = []byte{
-	// 667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x69, 0xbb, 0xe2, 0x08, 0x4a, 0xc7, 0x82, 0x12, 0x64, 0xd5, 0x51, 0x5a, 0x0d,
-	0xb8, 0x43, 0xeb, 0x4d, 0x28, 0x42, 0x20, 0x0d, 0x39, 0x59, 0xa3, 0x07, 0x91, 0x42, 0x99, 0x24,
-	0xc3, 0x76, 0x20, 0xd9, 0xd9, 0xec, 0xec, 0x06, 0x83, 0xe4, 0xe2, 0xc9, 0xa3, 0x20, 0xe2, 0xc9,
-	0xbb, 0xc7, 0xde, 0xbc, 0x79, 0xee, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0x22, 0xf8, 0x35, 0x64, 0x67,
-	0x66, 0x93, 0x5d, 0x52, 0x93, 0x8d, 0xf5, 0x12, 0x36, 0x3b, 0xff, 0xf7, 0xde, 0xef, 0xbd, 0x37,
-	0xef, 0x2d, 0xdc, 0x94, 0x91, 0x17, 0x70, 0xc9, 0x48, 0x87, 0xf7, 0x22, 0xde, 0xe6, 0xe1, 0x80,
-	0x7b, 0x2d, 0xe6, 0x85, 0xbc, 0xcf, 0x48, 0x2f, 0x62, 0xc1, 0xc0, 0xf1, 0x03, 0x11, 0x0a, 0x54,
-	0x32, 0x3a, 0x67, 0x56, 0x57, 0x5a, 0xa7, 0x5d, 0xee, 0x09, 0xa2, 0x7e, 0xb5, 0xbc, 0xb4, 0xe1,
-	0x0a, 0x57, 0xa8, 0x47, 0x12, 0x3f, 0x99, 0xb7, 0x37, 0x5c, 0x21, 0xdc, 0x0e, 0x23, 0xd4, 0xe7,
-	0x84, 0x7a, 0x9e, 0x08, 0x69, 0xc8, 0x85, 0x27, 0xcd, 0xa9, 0xdd, 0x12, 0xb2, 0x2b, 0x24, 0x69,
-	0x52, 0xc9, 0x48, 0x7f, 0xbb, 0xc9, 0x42, 0xba, 0x4d, 0x5a, 0x82, 0x7b, 0xe6, 0xbc, 0x9c, 0x3e,
-	0x57, 0x6c, 0x13, 0x95, 0x4f, 0x5d, 0xee, 0x29, 0x67, 0x46, 0xbb, 0x35, 0x27, 0x2d, 0x9f, 0x06,
-	0xb4, 0x9b, 0x04, 0x9d, 0x97, 0x3f, 0xf3, 0x45, 0xeb, 0x28, 0x87, 0xce, 0xa5, 0x91, 0xcb, 0xb4,
-	0x0e, 0x6f, 0x40, 0xf4, 0x34, 0x46, 0xdb, 0x57, 0x41, 0x1a, 0xac, 0x17, 0x31, 0x19, 0xe2, 0x03,
-	0x78, 0x35, 0xf3, 0x56, 0xfa, 0xc2, 0x93, 0x0c, 0x55, 0xa1, 0xa5, 0x61, 0xae, 0x83, 0x5b, 0xe0,
-	0xde, 0xa5, 0x1d, 0xec, 0xfc, 0xbd, 0xca, 0x8e, 0xb6, 0xad, 0x5c, 0x3c, 0xf9, 0x71, 0xb3, 0xf0,
-	0xf9, 0xf7, 0x71, 0x19, 0x34, 0x8c, 0x31, 0xbe, 0x03, 0xd7, 0x95, 0xf7, 0x6a, 0xcc, 0x6b, 0x42,
-	0xa2, 0xcb, 0xb0, 0xc8, 0xdb, 0xca, 0xef, 0x6a, 0xa3, 0xc8, 0xdb, 0xf8, 0x99, 0x01, 0x33, 0x22,
-	0x43, 0xb0, 0x0b, 0xd7, 0xd4, 0x0b, 0x03, 0x70, 0x7b, 0x1e, 0x80, 0x12, 0x56, 0x56, 0xe3, 0xf8,
-	0x0d, 0x6d, 0x85, 0x0f, 0xd2, 0x4e, 0x93, 0x6c, 0xd1, 0x1e, 0x84, 0xd3, 0x86, 0x18, 0xcf, 0x9b,
-	0x8e, 0xee, 0x9e, 0x13, 0x77, 0xcf, 0xd1, 0x37, 0xcb, 0x74, 0xcf, 0xd9, 0xa7, 0x2e, 0x33, 0xb6,
-	0x8d, 0x94, 0x25, 0xfe, 0x04, 0x4c, 0xd9, 0x12, 0xf7, 0xb3, 0xd0, 0x2b, 0xcb, 0x43, 0xa3, 0x5a,
-	0x06, 0xaf, 0xa8, 0xf0, 0xb6, 0x16, 0xe2, 0xe9, 0xd8, 0x19, 0xbe, 0x17, 0xa6, 0xee, 0xb5, 0xb8,
-	0xff, 0x49, 0xf2, 0x65, 0xb8, 0xee, 0x07, 0xac, 0xcf, 0x45, 0x24, 0x0f, 0xd5, 0x05, 0x3a, 0x9c,
-	0xb4, 0xe1, 0x4a, 0x72, 0xa0, 0x38, 0xea, 0x6d, 0x74, 0x0d, 0x5e, 0xf0, 0x85, 0xe8, 0xc4, 0x8a,
-	0xa2, 0x52, 0x58, 0xf1, 0xdf, 0xfa, 0xb4, 0x59, 0xc6, 0xf3, 0x34, 0x6f, 0x75, 0xd5, 0xf2, 0x34,
-	0x4b, 0x59, 0x26, 0x79, 0x2b, 0x2b, 0xfc, 0x16, 0xa4, 0xbd, 0xca, 0x7f, 0x01, 0xde, 0x3b, 0xa3,
-	0x74, 0xe7, 0xea, 0x6c, 0x82, 0x32, 0x9b, 0xe1, 0xca, 0xf2, 0x19, 0xfe, 0xb7, 0xce, 0xee, 0x7c,
-	0xb5, 0xe0, 0x9a, 0xe2, 0x43, 0x1f, 0x00, 0xb4, 0xf4, 0xe4, 0x21, 0x67, 0x1e, 0xcd, 0xec, 0xd0,
-	0x97, 0x48, 0x6e, 0xbd, 0x26, 0xc0, 0xe5, 0x37, 0xdf, 0x7e, 0xbd, 0x2f, 0xde, 0x45, 0x98, 0x2c,
-	0xdc, 0x5e, 0xe8, 0x23, 0x30, 0x43, 0x80, 0x1e, 0x2c, 0x0c, 0x93, 0xde, 0x0b, 0x25, 0x27, 0xaf,
-	0xdc, 0x40, 0x11, 0x05, 0x75, 0x1f, 0x6d, 0x91, 0x45, 0x9b, 0x52, 0x92, 0xd7, 0xbc, 0x3d, 0x54,
-	0x15, 0xd3, 0x03, 0x8b, 0x72, 0xc6, 0x5a, 0xa2, 0x62, 0xd9, 0x4d, 0x90, 0xaf, 0x62, 0x1a, 0x0e,
-	0x7d, 0x01, 0x70, 0x4d, 0xdd, 0x99, 0x1c, 0x15, 0x4b, 0x4f, 0x74, 0x8e, 0x8a, 0x65, 0xc6, 0x14,
-	0x3f, 0x51, 0x50, 0x75, 0x54, 0xcb, 0x53, 0xb1, 0x99, 0xd1, 0x1b, 0xea, 0xcf, 0x49, 0x7c, 0xa4,
-	0x57, 0xc3, 0x10, 0x1d, 0x03, 0x68, 0xe9, 0x41, 0x41, 0x39, 0x59, 0x96, 0xa8, 0x68, 0x76, 0x02,
-	0x71, 0x55, 0xc1, 0x3f, 0x46, 0xbb, 0xe7, 0x82, 0xaf, 0x3c, 0x3f, 0x19, 0xd9, 0xe0, 0x74, 0x64,
-	0x83, 0x9f, 0x23, 0x1b, 0xbc, 0x1b, 0xdb, 0x85, 0xd3, 0xb1, 0x5d, 0xf8, 0x3e, 0xb6, 0x0b, 0x2f,
-	0x1f, 0xb9, 0x3c, 0x3c, 0x8a, 0x9a, 0x4e, 0x4b, 0x74, 0x93, 0x10, 0x1d, 0x3a, 0x60, 0xc1, 0x24,
-	0xde, 0xab, 0xb3, 0x22, 0x86, 0x03, 0x9f, 0xc9, 0xa6, 0xa5, 0xbe, 0xb1, 0x0f, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0xfc, 0x5e, 0x70, 0xb5, 0x08, 0x00, 0x00,
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0x92, 0x8d, 0x3a, 0x2d, 0x3f, 0x32, 0xa9, 0xd4, 0x60, 0xc1, 0xa6, 0x18, 0xd4,
+	0x94, 0x88, 0x78, 0xd2, 0xa4, 0x88, 0x36, 0x10, 0x01, 0x41, 0x69, 0x94, 0x13, 0xc5, 0x20, 0x40,
+	0xa8, 0x52, 0xe4, 0xac, 0x47, 0xce, 0x88, 0xac, 0xc7, 0xf1, 0xd8, 0x5b, 0xa2, 0x2a, 0x17, 0x24,
+	0x7e, 0x1c, 0x91, 0x10, 0xe2, 0xc4, 0x1d, 0x71, 0xea, 0x01, 0x89, 0x7f, 0xa1, 0x42, 0x1c, 0x2a,
+	0x21, 0x24, 0x4e, 0x50, 0x25, 0x48, 0xfc, 0x1b, 0xc8, 0x6f, 0x9e, 0x77, 0xbd, 0x38, 0xbb, 0xeb,
+	0x4d, 0x69, 0x2f, 0x89, 0xed, 0xf9, 0xde, 0x9b, 0xef, 0x7b, 0x6f, 0xfc, 0x3e, 0x2f, 0xbd, 0xa4,
+	0xd3, 0x30, 0x96, 0x5a, 0xf0, 0x3d, 0xb9, 0x9f, 0x4a, 0x5f, 0x26, 0x07, 0x32, 0x6c, 0x8a, 0x30,
+	0x91, 0x6d, 0xc1, 0xf7, 0x53, 0x11, 0x1f, 0x38, 0x51, 0xac, 0x12, 0xc5, 0x2c, 0xc4, 0x39, 0x65,
+	0x9c, 0x35, 0xed, 0xb5, 0x64, 0xa8, 0x38, 0xfc, 0x35, 0x70, 0xeb, 0x7c, 0xa0, 0x02, 0x05, 0x97,
+	0x3c, 0xbb, 0xc2, 0xa7, 0xcf, 0x06, 0x4a, 0x05, 0x7b, 0x82, 0x7b, 0x91, 0xe4, 0x5e, 0x18, 0xaa,
+	0xc4, 0x4b, 0xa4, 0x0a, 0x35, 0xae, 0x36, 0x9a, 0x4a, 0xb7, 0x94, 0xe6, 0x3b, 0x9e, 0x16, 0xbc,
+	0x7d, 0x65, 0x47, 0x24, 0xde, 0x15, 0xde, 0x54, 0x32, 0xc4, 0xf5, 0x85, 0xe2, 0x3a, 0x70, 0xeb,
+	0xa0, 0x22, 0x2f, 0x90, 0x21, 0x24, 0x43, 0xec, 0xfc, 0x00, 0x59, 0x91, 0x17, 0x7b, 0xad, 0x7c,
+	0xd3, 0x41, 0xfa, 0x45, 0xa4, 0x9a, 0xbb, 0x15, 0x70, 0x81, 0x97, 0x06, 0x02, 0x71, 0x2f, 0x0f,
+	0xc0, 0xb5, 0x55, 0x22, 0xb6, 0x63, 0x71, 0xdb, 0x8b, 0x7d, 0x83, 0xb6, 0xcf, 0x53, 0xf6, 0x6e,
+	0x26, 0xe4, 0x26, 0x50, 0x72, 0xc5, 0x7e, 0x2a, 0x74, 0x62, 0xdf, 0xa2, 0x33, 0x3d, 0x4f, 0x75,
+	0xa4, 0x42, 0x2d, 0xd8, 0x06, 0xad, 0x1b, 0xea, 0xb3, 0xe4, 0x22, 0xb9, 0x7c, 0x76, 0xd9, 0x76,
+	0xfa, 0xf7, 0xc4, 0x31, 0xb1, 0xeb, 0x67, 0xee, 0xfd, 0x39, 0x37, 0xf6, 0xc3, 0x3f, 0x77, 0x17,
+	0x88, 0x8b, 0xc1, 0xf6, 0x0b, 0x74, 0x1a, 0xb2, 0x6f, 0x64, 0xea, 0x70, 0x4b, 0xf6, 0x24, 0xad,
+	0x49, 0x1f, 0xf2, 0x4e, 0xb8, 0x35, 0xe9, 0xdb, 0xef, 0x51, 0x56, 0x04, 0x21, 0x83, 0x35, 0x3a,
+	0x09, 0x0f, 0x90, 0xc0, 0xf3, 0x83, 0x08, 0x00, 0x70, 0x7d, 0x22, 0xdb, 0xdf, 0x35, 0x51, 0xf6,
+	0xad, 0x62, 0xd2, 0x5c, 0x2d, 0xbb, 0x41, 0x69, 0xb7, 0x7d, 0x98, 0xf9, 0x92, 0x63, 0x7a, 0xed,
+	0x64, 0xbd, 0x76, 0xcc, 0x39, 0xc4, 0x5e, 0x3b, 0x37, 0xbd, 0x40, 0x60, 0xac, 0x5b, 0x88, 0xb4,
+	0xbf, 0x27, 0x74, 0xa6, 0x27, 0x7d, 0x99, 0xf4, 0xf8, 0xe8, 0xa4, 0xd9, 0x66, 0x0f, 0xbd, 0x1a,
+	0xd0, 0x9b, 0x1f, 0x4a, 0xcf, 0xec, 0xdd, 0xc3, 0xef, 0x23, 0xac, 0xfb, 0xa6, 0x97, 0x76, 0x04,
+	0xb0, 0x05, 0x3a, 0x1d, 0xc5, 0xa2, 0x2d, 0x55, 0xaa, 0xb7, 0xe1, 0xb8, 0x6d, 0x77, 0xda, 0xf0,
+	0x54, 0xbe, 0x00, 0x3c, 0xb6, 0x7c, 0x76, 0x81, 0x4e, 0x45, 0x4a, 0xed, 0x65, 0x88, 0x1a, 0x20,
+	0xea, 0xd9, 0xed, 0x56, 0xb7, 0x59, 0x98, 0xb9, 0xab, 0x1b, 0x0e, 0x66, 0x95, 0x66, 0x41, 0x64,
+	0xae, 0x1b, 0xa2, 0xec, 0xaf, 0x48, 0x31, 0xab, 0x3e, 0x0d, 0xe1, 0x1b, 0x27, 0x94, 0xee, 0xa1,
+	0x3a, 0x9b, 0x53, 0x29, 0x2b, 0x1c, 0x1f, 0x5d, 0xe1, 0xff, 0xd7, 0xd9, 0x15, 0x7a, 0x01, 0xe8,
+	0x7d, 0xa0, 0x12, 0xe1, 0xc2, 0xeb, 0xdd, 0x29, 0xd7, 0x2c, 0x9d, 0xf2, 0x7c, 0x3f, 0x16, 0xda,
+	0xbc, 0xb4, 0x67, 0xdc, 0xfc, 0xd6, 0xfe, 0x9d, 0xd0, 0xd9, 0x72, 0x14, 0x2a, 0x13, 0x74, 0xca,
+	0xcc, 0x09, 0x8d, 0xda, 0x9e, 0xe9, 0xe1, 0x95, 0x33, 0x7a, 0x5b, 0xc9, 0x70, 0x7d, 0x29, 0xd3,
+	0xf4, 0xe3, 0x5f, 0x73, 0x97, 0x03, 0x99, 0xec, 0xa6, 0x3b, 0x4e, 0x53, 0xb5, 0x38, 0x4e, 0x4a,
+	0xf3, 0x6f, 0x51, 0xfb, 0x9f, 0xf0, 0xe4, 0x20, 0x12, 0x1a, 0x02, 0xb4, 0x9b, 0xe7, 0x66, 0x2e,
+	0x7d, 0x22, 0x9b, 0x49, 0xf1, 0xf6, 0x6d, 0x21, 0x83, 0xdd, 0x44, 0xcf, 0xd6, 0x60, 0xb3, 0xf9,
+	0x41, 0x85, 0xcc, 0xe8, 0xc6, 0x1f, 0x02, 0x1e, 0xcb, 0x79, 0xae, 0xdd, 0x7d, 0xa4, 0xed, 0x25,
+	0xda, 0x30, 0xc3, 0x4b, 0x69, 0x99, 0x55, 0x67, 0x2b, 0x8f, 0xd4, 0xfd, 0x66, 0xcd, 0x17, 0x84,
+	0xce, 0xf5, 0x0d, 0xc1, 0x82, 0x34, 0x69, 0xdd, 0x6b, 0xa9, 0x34, 0x4c, 0x1e, 0x45, 0x3d, 0x30,
+	0xb5, 0xbd, 0xf2, 0x1f, 0x1e, 0xba, 0xcc, 0xfd, 0x69, 0x3a, 0x2e, 0xb1, 0x29, 0x13, 0x6e, 0x76,
+	0x69, 0x7f, 0x49, 0xe8, 0xc5, 0xfe, 0x51, 0x8f, 0x93, 0xfe, 0x75, 0xfa, 0x1c, 0x10, 0x79, 0xcb,
+	0x9c, 0xb0, 0x32, 0xf9, 0xfe, 0x87, 0xf1, 0x73, 0x42, 0x1b, 0xfd, 0x62, 0x1f, 0xa3, 0x84, 0xe5,
+	0x07, 0xe7, 0xe8, 0x24, 0xf0, 0x60, 0xdf, 0x12, 0x5a, 0x37, 0x1e, 0xc6, 0x9c, 0x41, 0xc7, 0xb1,
+	0x6c, 0x9f, 0x16, 0xaf, 0x8c, 0x37, 0xd2, 0xec, 0x85, 0xcf, 0x7e, 0xfb, 0xfb, 0x9b, 0xda, 0x8b,
+	0xcc, 0xe6, 0x43, 0xbf, 0x1a, 0xd8, 0x77, 0x04, 0xed, 0x84, 0x2d, 0x0e, 0xdd, 0xa6, 0xe8, 0xb0,
+	0x96, 0x53, 0x15, 0x8e, 0xa4, 0x38, 0x90, 0x7a, 0x89, 0xcd, 0xf3, 0x61, 0x5f, 0x28, 0x9a, 0xdf,
+	0x91, 0xfe, 0x21, 0x54, 0x0c, 0x52, 0x54, 0xa9, 0x58, 0x8f, 0x05, 0x5b, 0xbc, 0x32, 0x7e, 0x94,
+	0x8a, 0x19, 0x72, 0xec, 0x67, 0x42, 0x27, 0x61, 0xfa, 0x56, 0xa8, 0x58, 0xd1, 0x1b, 0x2d, 0xa7,
+	0x2a, 0x1c, 0x49, 0xbd, 0x03, 0xa4, 0xb6, 0xd8, 0x66, 0x95, 0x8a, 0x95, 0x4c, 0xec, 0xd0, 0x7c,
+	0xc6, 0x65, 0x4b, 0xc6, 0x64, 0x0f, 0xd9, 0x5d, 0x42, 0xeb, 0xb0, 0x45, 0x95, 0x8a, 0xf6, 0xd8,
+	0xa4, 0xc5, 0x2b, 0xe3, 0x91, 0xfc, 0x06, 0x90, 0x7f, 0x83, 0xad, 0x3d, 0x14, 0x79, 0xf6, 0x13,
+	0xa1, 0x67, 0x0b, 0x86, 0xc2, 0x56, 0x86, 0xf2, 0x28, 0x9b, 0x96, 0x75, 0x75, 0xb4, 0x20, 0x54,
+	0xb0, 0x0a, 0x0a, 0xae, 0xb2, 0x65, 0x3e, 0xe4, 0x13, 0x78, 0x11, 0xed, 0x87, 0xdf, 0xc1, 0xf1,
+	0x73, 0xc8, 0x7e, 0x25, 0x94, 0x95, 0xa7, 0x3f, 0x5b, 0x1d, 0xfe, 0x26, 0xf7, 0x73, 0x19, 0xeb,
+	0xb5, 0x53, 0xc5, 0xa2, 0x96, 0x35, 0xd0, 0xf2, 0x2a, 0x7b, 0x65, 0x90, 0x96, 0xce, 0x95, 0xe6,
+	0x11, 0xa6, 0xc2, 0x57, 0xf1, 0x17, 0x42, 0x67, 0x4e, 0xb0, 0x03, 0x56, 0x9d, 0x53, 0x79, 0x7a,
+	0x5b, 0xaf, 0x9f, 0x2e, 0x18, 0x15, 0x5d, 0x03, 0x45, 0xcb, 0x6c, 0x69, 0x54, 0x45, 0x59, 0x6f,
+	0xa6, 0x4b, 0xb6, 0xc0, 0xae, 0x0f, 0x65, 0xd3, 0xcf, 0x86, 0xac, 0xd5, 0xd3, 0x84, 0xa2, 0x8c,
+	0x37, 0x41, 0xc6, 0x2a, 0xbb, 0x56, 0x51, 0x06, 0x9e, 0xb0, 0xee, 0x51, 0x5b, 0x7f, 0xff, 0xde,
+	0x51, 0x83, 0xdc, 0x3f, 0x6a, 0x90, 0x07, 0x47, 0x0d, 0xf2, 0xf5, 0x71, 0x63, 0xec, 0xfe, 0x71,
+	0x63, 0xec, 0x8f, 0xe3, 0xc6, 0xd8, 0xc7, 0xab, 0x05, 0xbb, 0xc2, 0xec, 0x7b, 0xde, 0x81, 0x88,
+	0x3b, 0x5b, 0x7d, 0x7a, 0xd2, 0x66, 0x60, 0x63, 0x3b, 0x75, 0xf8, 0x3d, 0xb7, 0xf2, 0xef, 0x00,
+	0xc4, 0xff, 0xdd, 0xd2, 0x4f, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -582,6 +975,12 @@ type QueryClient interface {
 	// Queries a list of Gauge items.
 	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
 	Gauges(ctx context.Context, in *QueryGaugesRequest, opts ...grpc.CallOption) (*QueryGaugesResponse, error)
+	// Queries the vote rewards claimable by an address.
+	VoteRewards(ctx context.Context, in *QueryVoteRewardsRequest, opts ...grpc.CallOption) (*QueryVoteRewardsResponse, error)
+	// Queries the incentives claimable by positions or an address.
+	PositionIncentives(ctx context.Context, in *QueryPositionIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionIncentivesResponse, error)
+	PositionsIncentives(ctx context.Context, in *QueryPositionsIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionsIncentivesResponse, error)
+	AddressIncentives(ctx context.Context, in *QueryAddressIncentivesRequest, opts ...grpc.CallOption) (*QueryAddressIncentivesResponse, error)
 }
 
 type queryClient struct {
@@ -637,6 +1036,42 @@ func (c *queryClient) Gauges(ctx context.Context, in *QueryGaugesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VoteRewards(ctx context.Context, in *QueryVoteRewardsRequest, opts ...grpc.CallOption) (*QueryVoteRewardsResponse, error) {
+	out := new(QueryVoteRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquidityincentive.Query/VoteRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionIncentives(ctx context.Context, in *QueryPositionIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionIncentivesResponse, error) {
+	out := new(QueryPositionIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquidityincentive.Query/PositionIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionsIncentives(ctx context.Context, in *QueryPositionsIncentivesRequest, opts ...grpc.CallOption) (*QueryPositionsIncentivesResponse, error) {
+	out := new(QueryPositionsIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquidityincentive.Query/PositionsIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressIncentives(ctx context.Context, in *QueryAddressIncentivesRequest, opts ...grpc.CallOption) (*QueryAddressIncentivesResponse, error) {
+	out := new(QueryAddressIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquidityincentive.Query/AddressIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -647,6 +1082,12 @@ type QueryServer interface {
 	// Queries a list of Gauge items.
 	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
 	Gauges(context.Context, *QueryGaugesRequest) (*QueryGaugesResponse, error)
+	// Queries the vote rewards claimable by an address.
+	VoteRewards(context.Context, *QueryVoteRewardsRequest) (*QueryVoteRewardsResponse, error)
+	// Queries the incentives claimable by positions or an address.
+	PositionIncentives(context.Context, *QueryPositionIncentivesRequest) (*QueryPositionIncentivesResponse, error)
+	PositionsIncentives(context.Context, *QueryPositionsIncentivesRequest) (*QueryPositionsIncentivesResponse, error)
+	AddressIncentives(context.Context, *QueryAddressIncentivesRequest) (*QueryAddressIncentivesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -668,6 +1109,18 @@ func (*UnimplementedQueryServer) Gauge(ctx context.Context, req *QueryGaugeReque
 func (*UnimplementedQueryServer) Gauges(ctx context.Context, req *QueryGaugesRequest) (*QueryGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Gauges not implemented")
 }
+func (*UnimplementedQueryServer) VoteRewards(ctx context.Context, req *QueryVoteRewardsRequest) (*QueryVoteRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRewards not implemented")
+}
+func (*UnimplementedQueryServer) PositionIncentives(ctx context.Context, req *QueryPositionIncentivesRequest) (*QueryPositionIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionsIncentives(ctx context.Context, req *QueryPositionsIncentivesRequest) (*QueryPositionsIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionsIncentives not implemented")
+}
+func (*UnimplementedQueryServer) AddressIncentives(ctx context.Context, req *QueryAddressIncentivesRequest) (*QueryAddressIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressIncentives not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -763,21 +1216,93 @@ func _Query_Gauges_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.liquidityincentive.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Epoch",
-			Handler:    _Query_Epoch_Handler,
-		},
-		{
-			MethodName: "Epochs",
-			Handler:    _Query_Epochs_Handler,
+func _Query_VoteRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquidityincentive.Query/VoteRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteRewards(ctx, req.(*QueryVoteRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquidityincentive.Query/PositionIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionIncentives(ctx, req.(*QueryPositionIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionsIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionsIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquidityincentive.Query/PositionsIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionsIncentives(ctx, req.(*QueryPositionsIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquidityincentive.Query/AddressIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressIncentives(ctx, req.(*QueryAddressIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.liquidityincentive.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Epoch",
+			Handler:    _Query_Epoch_Handler,
+		},
+		{
+			MethodName: "Epochs",
+			Handler:    _Query_Epochs_Handler,
 		},
 		{
 			MethodName: "Gauge",
@@ -787,6 +1312,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Gauges",
 			Handler:    _Query_Gauges_Handler,
 		},
+		{
+			MethodName: "VoteRewards",
+			Handler:    _Query_VoteRewards_Handler,
+		},
+		{
+			MethodName: "PositionIncentives",
+			Handler:    _Query_PositionIncentives_Handler,
+		},
+		{
+			MethodName: "PositionsIncentives",
+			Handler:    _Query_PositionsIncentives_Handler,
+		},
+		{
+			MethodName: "AddressIncentives",
+			Handler:    _Query_AddressIncentives_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquidityincentive/query.proto",
@@ -1148,160 +1689,1150 @@ func (m *QueryGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVoteRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryVoteRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVoteRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVoteRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Epoch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEpochsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryVoteRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVoteRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Epoch) > 0 {
-		for _, e := range m.Epoch {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PreviousEpochId != 0 {
-		n += 1 + sovQuery(uint64(m.PreviousEpochId))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryPositionIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Gauge.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPositionIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPositionIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PreviousEpochId != 0 {
-		n += 1 + sovQuery(uint64(m.PreviousEpochId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Gauge) > 0 {
-		for _, e := range m.Gauge {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPositionIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPositionIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPositionIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA9 := make([]byte, len(m.Ids)*10)
+		var j8 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epoch) > 0 {
+		for _, e := range m.Epoch {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousEpochId != 0 {
+		n += 1 + sovQuery(uint64(m.PreviousEpochId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousEpochId != 0 {
+		n += 1 + sovQuery(uint64(m.PreviousEpochId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauge) > 0 {
+		for _, e := range m.Gauge {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPositionIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionsIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPositionsIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = append(m.Epoch, Epoch{})
+			if err := m.Epoch[len(m.Epoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
+			}
+			m.PreviousEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,12 +2855,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1351,7 +2915,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1374,15 +2938,154 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
+			}
+			m.PreviousEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauge = append(m.Gauge, Gauge{})
+			if err := m.Gauge[len(m.Gauge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1409,7 +3112,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1434,7 +3140,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,17 +3163,17 @@ func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1477,11 +3183,24 @@ func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1503,7 +3222,7 @@ func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1526,15 +3245,15 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1561,7 +3280,42 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterWeights = append(m.VoterWeights, VoterWeight{})
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1586,7 +3340,7 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,17 +3363,17 @@ func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1629,28 +3383,11 @@ func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1672,7 +3409,7 @@ func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1695,49 +3432,15 @@ func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Epoch = append(m.Epoch, Epoch{})
-			if err := m.Epoch[len(m.Epoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1764,10 +3467,8 @@ func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1792,7 +3493,7 @@ func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,49 +3516,87 @@ func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
-			}
-			m.PreviousEpochId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousEpochId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
 				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1880,7 +3619,7 @@ func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1903,15 +3642,15 @@ func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1938,7 +3677,8 @@ func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1963,7 +3703,7 @@ func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1986,36 +3726,17 @@ func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochId", wireType)
-			}
-			m.PreviousEpochId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousEpochId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2025,27 +3746,23 @@ func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2068,7 +3785,7 @@ func (m *QueryGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2091,49 +3808,15 @@ func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Gauge = append(m.Gauge, Gauge{})
-			if err := m.Gauge[len(m.Gauge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,10 +3843,8 @@ func (m *QueryGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -258,6 +260,7 @@ func (m *MsgCollectVoteRewards) GetSender() string {
 }
 
 type MsgCollectVoteRewardsResponse struct {
+	Collected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
 }
 
 func (m *MsgCollectVoteRewardsResponse) Reset()         { *m = MsgCollectVoteRewardsResponse{} }
@@ -293,6 +296,109 @@ func (m *MsgCollectVoteRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCollectVoteRewardsResponse proto.InternalMessageInfo
 
+func (m *MsgCollectVoteRewardsResponse) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
+type MsgCollectIncentiveRewards struct {
+	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+}
+
+func (m *MsgCollectIncentiveRewards) Reset()         { *m = MsgCollectIncentiveRewards{} }
+func (m *MsgCollectIncentiveRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectIncentiveRewards) ProtoMessage()    {}
+func (*MsgCollectIncentiveRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a8cffcd57d82c0d, []int{6}
+}
+func (m *MsgCollectIncentiveRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectIncentiveRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectIncentiveRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCollectIncentiveRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectIncentiveRewards.Merge(m, src)
+}
+func (m *MsgCollectIncentiveRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectIncentiveRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectIncentiveRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCollectIncentiveRewards proto.InternalMessageInfo
+
+func (m *MsgCollectIncentiveRewards) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCollectIncentiveRewards) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type MsgCollectIncentiveRewardsResponse struct {
+	Collected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
+}
+
+func (m *MsgCollectIncentiveRewardsResponse) Reset()         { *m = MsgCollectIncentiveRewardsResponse{} }
+func (m *MsgCollectIncentiveRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectIncentiveRewardsResponse) ProtoMessage()    {}
+func (*MsgCollectIncentiveRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a8cffcd57d82c0d, []int{7}
+}
+func (m *MsgCollectIncentiveRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectIncentiveRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectIncentiveRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCollectIncentiveRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectIncentiveRewardsResponse.Merge(m, src)
+}
+func (m *MsgCollectIncentiveRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectIncentiveRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectIncentiveRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCollectIncentiveRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgCollectIncentiveRewardsResponse) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "sunrise.liquidityincentive.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sunrise.liquidityincentive.MsgUpdateParamsResponse")
@@ -300,6 +406,8 @@ func init() {
 	proto.RegisterType((*MsgVoteGaugeResponse)(nil), "sunrise.liquidityincentive.MsgVoteGaugeResponse")
 	proto.RegisterType((*MsgCollectVoteRewards)(nil), "sunrise.liquidityincentive.MsgCollectVoteRewards")
 	proto.RegisterType((*MsgCollectVoteRewardsResponse)(nil), "sunrise.liquidityincentive.MsgCollectVoteRewardsResponse")
+	proto.RegisterType((*MsgCollectIncentiveRewards)(nil), "sunrise.liquidityincentive.MsgCollectIncentiveRewards")
+	proto.RegisterType((*MsgCollectIncentiveRewardsResponse)(nil), "sunrise.liquidityincentive.MsgCollectIncentiveRewardsResponse")
 }
 
 func init() {
@@ -307,39 +415,47 @@ func init() {
 }
 
 var fileDescriptor_2a8cffcd57d82c0d = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xb6, 0x10, 0x94, 0x4d, 0x25, 0x84, 0x15, 0xda, 0xd4, 0x12, 0x6e, 0x65, 0xa4, 0x12,
-	0x05, 0xb0, 0x69, 0x2a, 0x21, 0x11, 0x21, 0x21, 0x82, 0x80, 0x53, 0x24, 0x64, 0xbe, 0x24, 0x2e,
-	0xc8, 0x8d, 0x57, 0x9b, 0x95, 0x6c, 0xaf, 0xd9, 0x59, 0xb7, 0x8d, 0xb8, 0xa0, 0x1e, 0x39, 0xf1,
-	0x33, 0x38, 0xe6, 0xc0, 0x8f, 0xe8, 0xb1, 0x70, 0xe2, 0x84, 0x50, 0x72, 0xc8, 0x85, 0x1f, 0x81,
-	0x6c, 0xaf, 0x13, 0x9a, 0xa6, 0x2e, 0xbd, 0x24, 0xde, 0x99, 0xf7, 0xde, 0xbc, 0x37, 0x5e, 0xe3,
-	0x9b, 0x10, 0x87, 0x82, 0x01, 0xb1, 0x7d, 0xf6, 0x21, 0x66, 0x1e, 0x93, 0x03, 0x16, 0xf6, 0x48,
-	0x28, 0xd9, 0x1e, 0xb1, 0xe5, 0x81, 0x15, 0x09, 0x2e, 0xb9, 0xa6, 0x2b, 0x90, 0x75, 0x1a, 0xa4,
-	0x5f, 0x73, 0x03, 0x16, 0x72, 0x3b, 0xfd, 0xcd, 0xe0, 0xfa, 0x5a, 0x8f, 0x43, 0xc0, 0xc1, 0x0e,
-	0x80, 0xda, 0x7b, 0xdb, 0xc9, 0x9f, 0x6a, 0xac, 0x67, 0x8d, 0xf7, 0xe9, 0xc9, 0xce, 0x0e, 0xaa,
-	0x55, 0xa3, 0x9c, 0xf2, 0xac, 0x9e, 0x3c, 0xa9, 0xea, 0xad, 0x02, 0x77, 0x91, 0x2b, 0xdc, 0x20,
-	0xa7, 0x6f, 0x15, 0x00, 0xa9, 0x1b, 0x53, 0x92, 0xe1, 0xcc, 0xef, 0x08, 0x5f, 0xed, 0x02, 0x7d,
-	0x1d, 0x79, 0xae, 0x24, 0x2f, 0x52, 0x05, 0xed, 0x3e, 0xae, 0xb8, 0xb1, 0xec, 0x73, 0xc1, 0xe4,
-	0xa0, 0x8e, 0x36, 0x51, 0xa3, 0xd2, 0xa9, 0xff, 0xf8, 0x76, 0xb7, 0xa6, 0xfc, 0x3d, 0xf6, 0x3c,
-	0x41, 0x00, 0x5e, 0x4a, 0xc1, 0x42, 0xea, 0xcc, 0xa0, 0xda, 0x53, 0x5c, 0xce, 0x3c, 0xd4, 0x97,
-	0x36, 0x51, 0xa3, 0xda, 0x32, 0xad, 0xb3, 0xd7, 0x64, 0x65, 0xb3, 0x3a, 0x95, 0xa3, 0x5f, 0x1b,
-	0xa5, 0xaf, 0x93, 0x61, 0x13, 0x39, 0x8a, 0xdc, 0x7e, 0x74, 0x38, 0x19, 0x36, 0x67, 0xb2, 0x9f,
-	0x27, 0xc3, 0xe6, 0x9d, 0x3c, 0xcd, 0xc1, 0xa2, 0x3c, 0x73, 0xfe, 0xcd, 0x75, 0xbc, 0x36, 0x57,
-	0x72, 0x08, 0x44, 0x3c, 0x04, 0x62, 0x7e, 0xc4, 0x2b, 0x5d, 0xa0, 0x6f, 0xb8, 0x24, 0xcf, 0x93,
-	0x25, 0x68, 0xab, 0xb8, 0x0c, 0x24, 0xf4, 0x88, 0xc8, 0x72, 0x3a, 0xea, 0xa4, 0x3d, 0xc3, 0x57,
-	0xf6, 0x09, 0xa3, 0x7d, 0x99, 0x64, 0x59, 0x6e, 0x54, 0x5b, 0x5b, 0x85, 0x59, 0x38, 0xf7, 0xdf,
-	0xa6, 0xf0, 0xce, 0xa5, 0x24, 0x8f, 0x93, 0x93, 0xdb, 0xd5, 0x24, 0x8b, 0x12, 0x35, 0x57, 0x71,
-	0xed, 0xdf, 0xe1, 0x53, 0x53, 0x0f, 0xf1, 0xf5, 0x2e, 0xd0, 0x27, 0xdc, 0xf7, 0x49, 0x4f, 0x26,
-	0x6d, 0x87, 0xec, 0xbb, 0xc2, 0x83, 0xb3, 0xdc, 0x9d, 0x54, 0xdd, 0xc0, 0x37, 0x16, 0xb2, 0x73,
-	0xf9, 0xd6, 0x9f, 0x25, 0xbc, 0xdc, 0x05, 0xaa, 0x45, 0x78, 0xe5, 0xc4, 0x6b, 0xbe, 0x5d, 0x14,
-	0x69, 0x6e, 0x81, 0xfa, 0xce, 0x05, 0xc0, 0xf9, 0x64, 0x8d, 0xe2, 0xca, 0x6c, 0xd5, 0x8d, 0x73,
-	0x14, 0xa6, 0x48, 0xfd, 0xde, 0xff, 0x22, 0xa7, 0x83, 0x0e, 0x11, 0xd6, 0x16, 0xec, 0x6f, 0xfb,
-	0x1c, 0xa1, 0xd3, 0x14, 0xfd, 0xc1, 0x85, 0x29, 0xb9, 0x09, 0xfd, 0xf2, 0xa7, 0xe4, 0x1a, 0x77,
-	0x5e, 0x1d, 0x8d, 0x0c, 0x74, 0x3c, 0x32, 0xd0, 0xef, 0x91, 0x81, 0xbe, 0x8c, 0x8d, 0xd2, 0xf1,
-	0xd8, 0x28, 0xfd, 0x1c, 0x1b, 0xa5, 0x77, 0x6d, 0xca, 0x64, 0x3f, 0xde, 0xb5, 0x7a, 0x3c, 0xb0,
-	0xd5, 0x14, 0xdf, 0x1d, 0x10, 0x61, 0x17, 0xde, 0x6e, 0x39, 0x88, 0x08, 0xec, 0x96, 0xd3, 0xcf,
-	0x75, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x2f, 0xbf, 0x38, 0x9f, 0x04, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xfc, 0xda, 0x5f, 0x6b, 0x26, 0x05, 0x71, 0x89, 0x4d, 0xb2, 0xe0, 0xb6, 0xac, 0x50,
+	0x43, 0xb4, 0xbb, 0x4d, 0x0a, 0x05, 0x83, 0x28, 0xa6, 0xa8, 0xf4, 0x10, 0x90, 0xf5, 0x1f, 0x88,
+	0x50, 0x36, 0xbb, 0xc3, 0x64, 0x70, 0xb3, 0xb3, 0xee, 0x4c, 0xd2, 0x06, 0x2f, 0xa5, 0xc7, 0x5e,
+	0xd4, 0x6f, 0x21, 0x9e, 0x72, 0xf0, 0x43, 0xf4, 0x58, 0x3d, 0x79, 0x52, 0x49, 0x0e, 0xf9, 0x1a,
+	0xb2, 0xbb, 0xb3, 0x49, 0x9b, 0xe6, 0x4f, 0xeb, 0xc5, 0x4b, 0x26, 0x33, 0xef, 0xf3, 0x3e, 0xf3,
+	0x3c, 0x6f, 0x9e, 0x0c, 0xbc, 0xc9, 0x9a, 0xae, 0x4f, 0x18, 0xd2, 0x1d, 0xf2, 0xae, 0x49, 0x6c,
+	0xc2, 0xdb, 0xc4, 0xb5, 0x90, 0xcb, 0x49, 0x0b, 0xe9, 0x7c, 0x5f, 0xf3, 0x7c, 0xca, 0xa9, 0x24,
+	0x0b, 0x90, 0x76, 0x1e, 0x24, 0x5f, 0x33, 0x1b, 0xc4, 0xa5, 0x7a, 0xf8, 0x19, 0xc1, 0xe5, 0x8c,
+	0x45, 0x59, 0x83, 0x32, 0xbd, 0xc1, 0xb0, 0xde, 0x2a, 0x06, 0x8b, 0x28, 0xe4, 0xa2, 0xc2, 0x6e,
+	0xb8, 0xd3, 0xa3, 0x8d, 0x28, 0xa5, 0x31, 0xc5, 0x34, 0x3a, 0x0f, 0xbe, 0x89, 0x53, 0x45, 0x30,
+	0xd5, 0x4c, 0x86, 0xf4, 0x56, 0xb1, 0x86, 0xb8, 0x59, 0xd4, 0x2d, 0x4a, 0x5c, 0x51, 0xbf, 0x35,
+	0x45, 0xbd, 0x67, 0xfa, 0x66, 0x23, 0xa6, 0x5f, 0x9b, 0x02, 0xc4, 0x66, 0x13, 0xa3, 0x08, 0xa7,
+	0x7e, 0x03, 0xf0, 0x6a, 0x95, 0xe1, 0x17, 0x9e, 0x6d, 0x72, 0xf4, 0x34, 0x64, 0x90, 0xb6, 0x60,
+	0xd2, 0x6c, 0xf2, 0x3a, 0xf5, 0x09, 0x6f, 0x67, 0xc1, 0x2a, 0xc8, 0x27, 0x2b, 0xd9, 0xef, 0x5f,
+	0xd7, 0xd3, 0x42, 0xff, 0x43, 0xdb, 0xf6, 0x11, 0x63, 0xcf, 0xb8, 0x4f, 0x5c, 0x6c, 0x0c, 0xa1,
+	0xd2, 0x23, 0xb8, 0x10, 0x69, 0xc8, 0xfe, 0xb7, 0x0a, 0xf2, 0xa9, 0x92, 0xaa, 0x4d, 0x1e, 0xa3,
+	0x16, 0xdd, 0x55, 0x49, 0x1e, 0xff, 0x5c, 0x49, 0x7c, 0xee, 0x77, 0x0a, 0xc0, 0x10, 0xcd, 0xe5,
+	0x07, 0x87, 0xfd, 0x4e, 0x61, 0x48, 0x7b, 0xd4, 0xef, 0x14, 0xee, 0xc4, 0x6e, 0xf6, 0xc7, 0xf9,
+	0x19, 0xd1, 0xaf, 0xe6, 0x60, 0x66, 0xe4, 0xc8, 0x40, 0xcc, 0xa3, 0x2e, 0x43, 0xea, 0x7b, 0xb8,
+	0x54, 0x65, 0xf8, 0x25, 0xe5, 0xe8, 0x49, 0x30, 0x04, 0x69, 0x19, 0x2e, 0x30, 0xe4, 0xda, 0xc8,
+	0x8f, 0x7c, 0x1a, 0x62, 0x27, 0x3d, 0x86, 0x8b, 0x7b, 0x88, 0xe0, 0x3a, 0x0f, 0xbc, 0xcc, 0xe5,
+	0x53, 0xa5, 0xb5, 0xa9, 0x5e, 0x28, 0x75, 0x5e, 0x85, 0xf0, 0xca, 0x7c, 0xe0, 0xc7, 0x88, 0x9b,
+	0xcb, 0xa9, 0xc0, 0x8b, 0x20, 0x55, 0x97, 0x61, 0xfa, 0xf4, 0xe5, 0x03, 0x51, 0xf7, 0xe0, 0xf5,
+	0x2a, 0xc3, 0xdb, 0xd4, 0x71, 0x90, 0xc5, 0x83, 0xb2, 0x81, 0xf6, 0x4c, 0xdf, 0x66, 0x93, 0xd4,
+	0x9d, 0x65, 0x3d, 0x02, 0xf0, 0xc6, 0xd8, 0xf6, 0x98, 0x5f, 0x22, 0x30, 0x69, 0x45, 0x55, 0x64,
+	0x67, 0x41, 0x68, 0x27, 0xa7, 0x89, 0x1f, 0x33, 0x08, 0x9a, 0x26, 0x82, 0xa6, 0x6d, 0x53, 0xe2,
+	0x56, 0x36, 0x02, 0x07, 0x5f, 0x7e, 0xad, 0xe4, 0x31, 0xe1, 0xf5, 0x66, 0x4d, 0xb3, 0x68, 0x43,
+	0x24, 0x57, 0x2c, 0xeb, 0xcc, 0x7e, 0xab, 0xf3, 0xb6, 0x87, 0x58, 0xd8, 0xc0, 0x8c, 0x21, 0xbb,
+	0xfa, 0x06, 0xca, 0x43, 0x2d, 0x3b, 0xf1, 0x80, 0x66, 0xf8, 0x91, 0x72, 0xf0, 0x8a, 0x47, 0xa9,
+	0xb3, 0x4b, 0xec, 0x68, 0xdc, 0xf3, 0xc6, 0x62, 0xb0, 0xdf, 0xb1, 0x47, 0x06, 0xf8, 0x01, 0x40,
+	0x75, 0x32, 0xfd, 0x3f, 0xf0, 0x5b, 0x3a, 0x98, 0x87, 0x73, 0x55, 0x86, 0x25, 0x0f, 0x2e, 0x9d,
+	0xf9, 0x0b, 0xdd, 0x9e, 0x16, 0x97, 0x91, 0x70, 0xca, 0x9b, 0x97, 0x00, 0x0f, 0x4c, 0x62, 0x98,
+	0x1c, 0xc6, 0x38, 0x3f, 0x83, 0x61, 0x80, 0x94, 0x37, 0x2e, 0x8a, 0x1c, 0x5c, 0x74, 0x08, 0xa0,
+	0x34, 0x26, 0x9b, 0xc5, 0x19, 0x44, 0xe7, 0x5b, 0xe4, 0xbb, 0x97, 0x6e, 0x19, 0x88, 0xf8, 0x04,
+	0x60, 0x66, 0x52, 0xaa, 0xb6, 0x2e, 0x46, 0x3b, 0xda, 0x27, 0xdf, 0xff, 0xbb, 0xbe, 0x58, 0x93,
+	0xfc, 0xff, 0x41, 0xf0, 0x6c, 0x55, 0x9e, 0x1f, 0x77, 0x15, 0x70, 0xd2, 0x55, 0xc0, 0xef, 0xae,
+	0x02, 0x3e, 0xf6, 0x94, 0xc4, 0x49, 0x4f, 0x49, 0xfc, 0xe8, 0x29, 0x89, 0xd7, 0xe5, 0x53, 0x89,
+	0x12, 0x57, 0x39, 0x66, 0x1b, 0xf9, 0xfa, 0xd4, 0xd7, 0x2c, 0x4c, 0x5a, 0x6d, 0x21, 0x7c, 0x9e,
+	0x37, 0xff, 0x0c, 0x00, 0xcf, 0x64, 0x10, 0x72, 0xaf, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -359,6 +475,7 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	VoteGauge(ctx context.Context, in *MsgVoteGauge, opts ...grpc.CallOption) (*MsgVoteGaugeResponse, error)
 	CollectVoteRewards(ctx context.Context, in *MsgCollectVoteRewards, opts ...grpc.CallOption) (*MsgCollectVoteRewardsResponse, error)
+	CollectIncentiveRewards(ctx context.Context, in *MsgCollectIncentiveRewards, opts ...grpc.CallOption) (*MsgCollectIncentiveRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -396,6 +513,15 @@ func (c *msgClient) CollectVoteRewards(ctx context.Context, in *MsgCollectVoteRe
 	return out, nil
 }
 
+func (c *msgClient) CollectIncentiveRewards(ctx context.Context, in *MsgCollectIncentiveRewards, opts ...grpc.CallOption) (*MsgCollectIncentiveRewardsResponse, error) {
+	out := new(MsgCollectIncentiveRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquidityincentive.Msg/CollectIncentiveRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -403,6 +529,7 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	VoteGauge(context.Context, *MsgVoteGauge) (*MsgVoteGaugeResponse, error)
 	CollectVoteRewards(context.Context, *MsgCollectVoteRewards) (*MsgCollectVoteRewardsResponse, error)
+	CollectIncentiveRewards(context.Context, *MsgCollectIncentiveRewards) (*MsgCollectIncentiveRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -418,6 +545,9 @@ func (*UnimplementedMsgServer) VoteGauge(ctx context.Context, req *MsgVoteGauge)
 func (*UnimplementedMsgServer) CollectVoteRewards(ctx context.Context, req *MsgCollectVoteRewards) (*MsgCollectVoteRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectVoteRewards not implemented")
 }
+func (*UnimplementedMsgServer) CollectIncentiveRewards(ctx context.Context, req *MsgCollectIncentiveRewards) (*MsgCollectIncentiveRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectIncentiveRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -477,6 +607,24 @@ func _Msg_CollectVoteRewards_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CollectIncentiveRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectIncentiveRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectIncentiveRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquidityincentive.Msg/CollectIncentiveRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectIncentiveRewards(ctx, req.(*MsgCollectIncentiveRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sunrise.liquidityincentive.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -493,6 +641,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollectVoteRewards",
 			Handler:    _Msg_CollectVoteRewards_Handler,
 		},
+		{
+			MethodName: "CollectIncentiveRewards",
+			Handler:    _Msg_CollectIncentiveRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquidityincentive/tx.proto",
@@ -678,6 +830,105 @@ func (m *MsgCollectVoteRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectIncentiveRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectIncentiveRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectIncentiveRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PoolIds)*10)
+		var j2 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectIncentiveRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectIncentiveRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectIncentiveRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -763,6 +1014,47 @@ func (m *MsgCollectVoteRewardsResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCollectIncentiveRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCollectIncentiveRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1214,6 +1506,282 @@ func (m *MsgCollectVoteRewardsResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgCollectVoteRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectIncentiveRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectIncentiveRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectIncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectIncentiveRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectIncentiveRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectIncentiveRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
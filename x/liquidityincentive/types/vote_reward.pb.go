@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/liquidityincentive/vote_reward.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteRewardPot is the treasury tax collected during an epoch to be paid out
+// to the voters of the epoch.
+type VoteRewardPot struct {
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// rewards not yet claimed by the voters
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// sum of the weights of the voters who have not claimed yet
+	TotalWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_weight"`
+	// true once the epoch has ended and the rewards are claimable
+	Finalized bool `protobuf:"varint,4,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *VoteRewardPot) Reset()         { *m = VoteRewardPot{} }
+func (m *VoteRewardPot) String() string { return proto.CompactTextString(m) }
+func (*VoteRewardPot) ProtoMessage()    {}
+func (*VoteRewardPot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c24c03aa86be9a2, []int{0}
+}
+func (m *VoteRewardPot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteRewardPot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteRewardPot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteRewardPot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRewardPot.Merge(m, src)
+}
+func (m *VoteRewardPot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteRewardPot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRewardPot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRewardPot proto.InternalMessageInfo
+
+func (m *VoteRewardPot) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *VoteRewardPot) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *VoteRewardPot) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+// VoterWeight is the voting power of a voter snapshotted when the epoch was
+// created.
+type VoterWeight struct {
+	EpochId uint64                      `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Address string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *VoterWeight) Reset()         { *m = VoterWeight{} }
+func (m *VoterWeight) String() string { return proto.CompactTextString(m) }
+func (*VoterWeight) ProtoMessage()    {}
+func (*VoterWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c24c03aa86be9a2, []int{1}
+}
+func (m *VoterWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterWeight.Merge(m, src)
+}
+func (m *VoterWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterWeight proto.InternalMessageInfo
+
+func (m *VoterWeight) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *VoterWeight) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*VoteRewardPot)(nil), "sunrise.liquidityincentive.VoteRewardPot")
+	proto.RegisterType((*VoterWeight)(nil), "sunrise.liquidityincentive.VoterWeight")
+}
+
+func init() {
+	proto.RegisterFile("sunrise/liquidityincentive/vote_reward.proto", fileDescriptor_0c24c03aa86be9a2)
+}
+
+var fileDescriptor_0c24c03aa86be9a2 = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0x8f, 0xd3, 0x30,
+	0x14, 0xc6, 0xe3, 0xbb, 0xd3, 0xf5, 0xea, 0xc2, 0x12, 0x31, 0xa4, 0x05, 0xa5, 0xd1, 0x4d, 0x19,
+	0x38, 0x9b, 0xc2, 0xc6, 0x58, 0x6e, 0x39, 0x89, 0x01, 0x45, 0x27, 0x90, 0x58, 0x22, 0xc7, 0x7e,
+	0x24, 0xd6, 0xa5, 0x71, 0x89, 0xdd, 0x96, 0xb0, 0x33, 0x22, 0xf1, 0x77, 0x30, 0xf3, 0x47, 0x74,
+	0xac, 0x98, 0x10, 0x43, 0x41, 0xed, 0x3f, 0x82, 0xe2, 0xb8, 0x02, 0x09, 0xc4, 0x72, 0x53, 0xf2,
+	0xbd, 0x7c, 0xef, 0xa7, 0x4f, 0x5f, 0x1e, 0x7e, 0xa8, 0x17, 0x55, 0x2d, 0x35, 0xd0, 0x52, 0xbe,
+	0x5d, 0x48, 0x21, 0x4d, 0x23, 0x2b, 0x0e, 0x95, 0x91, 0x4b, 0xa0, 0x4b, 0x65, 0x20, 0xad, 0x61,
+	0xc5, 0x6a, 0x41, 0xe6, 0xb5, 0x32, 0xca, 0x1f, 0x39, 0x37, 0xf9, 0xdb, 0x3d, 0x1a, 0x72, 0xa5,
+	0x67, 0x4a, 0xa7, 0xd6, 0x49, 0x3b, 0xd1, 0xad, 0x8d, 0xee, 0xe5, 0x2a, 0x57, 0xdd, 0xbc, 0x7d,
+	0x73, 0xd3, 0xb0, 0xf3, 0xd0, 0x8c, 0x69, 0xa0, 0xcb, 0x49, 0x06, 0x86, 0x4d, 0x28, 0x57, 0xb2,
+	0xea, 0xbe, 0x9f, 0x7f, 0x38, 0xc2, 0x77, 0x5f, 0x2a, 0x03, 0x89, 0x4d, 0xf0, 0x42, 0x19, 0x7f,
+	0x88, 0xcf, 0x60, 0xae, 0x78, 0x91, 0x4a, 0x11, 0xa0, 0x08, 0xc5, 0x27, 0x49, 0xcf, 0xea, 0x2b,
+	0xe1, 0x03, 0xee, 0x75, 0x49, 0x75, 0x70, 0x14, 0x1d, 0xc7, 0x83, 0xc7, 0x43, 0xe2, 0x22, 0xb4,
+	0x78, 0xe2, 0xf0, 0xe4, 0x99, 0x92, 0xd5, 0xf4, 0xd1, 0x7a, 0x3b, 0xf6, 0x3e, 0xff, 0x18, 0xc7,
+	0xb9, 0x34, 0xc5, 0x22, 0x23, 0x5c, 0xcd, 0x5c, 0x5e, 0xf7, 0xb8, 0xd0, 0xe2, 0x86, 0x9a, 0x66,
+	0x0e, 0xda, 0x2e, 0xe8, 0xe4, 0xc0, 0xf6, 0xaf, 0xf1, 0x1d, 0xa3, 0x0c, 0x2b, 0xd3, 0x15, 0xc8,
+	0xbc, 0x30, 0xc1, 0x71, 0x84, 0xe2, 0xfe, 0x74, 0xd2, 0x02, 0xbf, 0x6f, 0xc7, 0xf7, 0xbb, 0x75,
+	0x2d, 0x6e, 0x88, 0x54, 0x74, 0xc6, 0x4c, 0x41, 0x9e, 0x43, 0xce, 0x78, 0x73, 0x09, 0xfc, 0xeb,
+	0x97, 0x0b, 0xec, 0x12, 0x5d, 0x02, 0x4f, 0x06, 0x16, 0xf3, 0xca, 0x52, 0xfc, 0x07, 0xb8, 0xff,
+	0x46, 0x56, 0xac, 0x94, 0xef, 0x41, 0x04, 0x27, 0x11, 0x8a, 0xcf, 0x92, 0xdf, 0x83, 0xf3, 0x8f,
+	0x08, 0x0f, 0xda, 0x1e, 0x6a, 0xe7, 0xfe, 0x4f, 0x0b, 0x01, 0xee, 0x31, 0x21, 0x6a, 0xd0, 0x6d,
+	0x0b, 0x28, 0xee, 0x27, 0x07, 0xe9, 0x5f, 0xe1, 0xd3, 0xdb, 0x46, 0x76, 0x80, 0xe9, 0xf5, 0x7a,
+	0x17, 0xa2, 0xcd, 0x2e, 0x44, 0x3f, 0x77, 0x21, 0xfa, 0xb4, 0x0f, 0xbd, 0xcd, 0x3e, 0xf4, 0xbe,
+	0xed, 0x43, 0xef, 0xf5, 0xd3, 0x3f, 0x0a, 0x75, 0x97, 0x52, 0xb2, 0x06, 0xea, 0x83, 0xa0, 0xef,
+	0xfe, 0x75, 0x66, 0xb6, 0xe8, 0xec, 0xd4, 0xfe, 0xf4, 0x27, 0xbf, 0x06, 0x00, 0x25, 0x6a, 0xd4,
+	0xdd, 0x91, 0x02, 0x00, 0x00,
+}
+
+func (m *VoteRewardPot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteRewardPot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteRewardPot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVoteReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintVoteReward(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoterWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoterWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVoteReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVoteReward(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintVoteReward(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteReward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteReward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoteRewardPot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovVoteReward(uint64(m.EpochId))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovVoteReward(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovVoteReward(uint64(l))
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *VoterWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovVoteReward(uint64(m.EpochId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVoteReward(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovVoteReward(uint64(l))
+	return n
+}
+
+func sovVoteReward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteReward(x uint64) (n int) {
+	return sovVoteReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoteRewardPot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteRewardPot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteRewardPot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteReward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteReward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteReward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteReward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteReward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteReward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteReward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteReward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
@@ -57,6 +60,48 @@ func (k Keeper) SetAccumulator(ctx context.Context, accumulator types.Accumulato
 	return store.Set(types.FormatKeyAccumPrefix(accumulator.Name), bz)
 }
 
+// GetAllAccumulators returns all accumulators
+func (k Keeper) GetAllAccumulators(ctx context.Context) (list []types.AccumulatorObject) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(storeAdapter, types.KeyPrefix(types.KeyAccumPrefix))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccumulatorObject
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllAccumulatorPositions returns all accumulator positions with the names they are stored under
+func (k Keeper) GetAllAccumulatorPositions(ctx context.Context) (list []types.GenesisAccumulatorPosition) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(storeAdapter, types.KeyPrefix(types.KeyAccumulatorPositionPrefix))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := strings.TrimPrefix(string(iterator.Key()), types.KeyAccumulatorPositionPrefix)
+		accumName, name, found := strings.Cut(key, types.KeySeparator)
+		if !found {
+			panic(fmt.Sprintf("invalid accumulator position key: %s", key))
+		}
+
+		var val types.AccumulatorPosition
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, types.GenesisAccumulatorPosition{
+			AccumName: accumName,
+			Name:      name,
+			Position:  val,
+		})
+	}
+
+	return
+}
+
 func (k Keeper) AddToAccumulator(ctx context.Context, accumulator types.AccumulatorObject, amt sdk.DecCoins) {
 	accumulator.AccumValue = accumulator.AccumValue.Add(amt...)
 	err := k.SetAccumulator(ctx, accumulator)
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// ClearPoolByDenoms deletes the PoolByDenoms index as in the stores before it was introduced
+func (k Keeper) ClearPoolByDenoms(ctx context.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte(types.PoolByDenoms))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ClearPoolIncentiveAccumulation resets the unclaimed incentive accumulation kept for a pool
+// as in the stores before it was introduced
+func (k Keeper) ClearPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64) {
+	k.setPoolIncentiveAccumulation(ctx, poolId, sdk.NewDecCoins())
+}
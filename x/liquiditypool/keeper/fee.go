@@ -178,6 +178,11 @@ func (k Keeper) prepareClaimableFees(ctx sdk.Context, positionId uint64) (sdk.Co
 		if !totalSharesRemaining.IsZero() {
 			forfeitedDustPerShareScaled := forfeitedDust.QuoDecTruncate(totalSharesRemaining)
 			k.AddToAccumulator(ctx, feeAccumulator, forfeitedDustPerShareScaled)
+
+			// The redistributed dust is also accumulated by the incentive positions in range
+			if pool, found := k.GetPool(ctx, position.PoolId); found {
+				k.addPoolIncentiveAccumulation(ctx, pool.Id, forfeitedDustPerShareScaled.MulDec(pool.CurrentTickLiquidity))
+			}
 		}
 	}
 
@@ -1,21 +1,210 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
 
-func (k Keeper) AllocateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoins sdk.Coins) error {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+// The incentive accumulation of a position is the fee growth earned by the position since it last
+// claimed incentives in `x/liquidityincentive`.
+// It is tracked as a position on the fee accumulator of the pool that does not count towards the total shares,
+// so that collecting fees does not reset it.
+// The sum of the unclaimed incentive accumulation of the positions of a pool is kept as the value of an
+// accumulator without shares, so that the share of a position is known without walking the positions of the pool.
+// It is rounded up with respect to the accumulation of the positions, which is truncated.
+
+// GetPoolIncentiveAccumulation returns the sum of the unclaimed incentive accumulation of the positions of a pool
+func (k Keeper) GetPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64) sdk.DecCoins {
+	accumulator, err := k.GetAccumulator(ctx, types.KeyIncentivePoolAccumulator(poolId))
+	if err != nil {
+		return sdk.NewDecCoins()
+	}
+	return accumulator.AccumValue
+}
+
+func (k Keeper) setPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64, accumulation sdk.DecCoins) {
+	err := k.SetAccumulator(ctx, types.AccumulatorObject{
+		Name:        types.KeyIncentivePoolAccumulator(poolId),
+		AccumValue:  accumulation,
+		TotalShares: math.LegacyZeroDec(),
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) addPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64, accumulation sdk.DecCoins) {
+	if accumulation.IsZero() {
+		return
+	}
+	k.setPoolIncentiveAccumulation(ctx, poolId, k.GetPoolIncentiveAccumulation(ctx, poolId).Add(accumulation...))
+}
+
+func (k Keeper) subPoolIncentiveAccumulation(ctx sdk.Context, poolId uint64, accumulation sdk.DecCoins) {
+	if accumulation.IsZero() {
+		return
+	}
+	// Clamped at zero against the rounding of the positions accumulation
+	remaining := sdk.NewDecCoins()
+	for _, coin := range k.GetPoolIncentiveAccumulation(ctx, poolId) {
+		amount := coin.Amount.Sub(accumulation.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	k.setPoolIncentiveAccumulation(ctx, poolId, remaining)
+}
+
+// setIncentiveAccumulation syncs the incentive accumulation position with the liquidity of the position
+func (k Keeper) setIncentiveAccumulation(ctx sdk.Context, position types.Position) error {
+	feeAccumulator, feeGrowthInside, err := k.getFeeGrowthInside(ctx, position)
 	if err != nil {
 		return err
 	}
 
-	feeAccumulator, err := k.GetFeeAccumulator(ctx, poolId)
+	positionKey := types.KeyIncentivePositionAccumulator(position.Id)
+	accumulation := sdk.NewDecCoins()
+	if k.HasPosition(ctx, feeAccumulator.Name, positionKey) {
+		accumulation, err = k.getIncentiveAccumulation(ctx, feeAccumulator.Name, positionKey, feeGrowthInside)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetAccumulatorPosition(ctx, feeAccumulator.Name, feeGrowthInside, positionKey, position.Liquidity, accumulation)
+	return nil
+}
+
+// removeIncentiveAccumulation removes the incentive accumulation position of a removed position.
+// The accumulation not claimed yet is forfeited and returned.
+func (k Keeper) removeIncentiveAccumulation(ctx sdk.Context, position types.Position) (sdk.DecCoins, error) {
+	accumulation, err := k.GetIncentiveAccumulation(ctx, position.Id)
 	if err != nil {
+		return nil, err
+	}
+
+	positionKey := types.KeyIncentivePositionAccumulator(position.Id)
+	accumName := types.KeyFeePoolAccumulator(position.PoolId)
+	if k.HasPosition(ctx, accumName, positionKey) {
+		k.deletePosition(ctx, accumName, positionKey)
+	}
+	k.subPoolIncentiveAccumulation(ctx, position.PoolId, accumulation)
+	return accumulation, nil
+}
+
+// addIncentiveAccumulation adds the accumulation to the incentive accumulation position of the position
+func (k Keeper) addIncentiveAccumulation(ctx sdk.Context, positionId uint64, accumulation sdk.DecCoins) error {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return types.ErrPositionNotFound
+	}
+	if accumulation.IsZero() {
+		return nil
+	}
+
+	if err := k.AddToUnclaimedRewards(ctx, types.KeyFeePoolAccumulator(position.PoolId), types.KeyIncentivePositionAccumulator(positionId), accumulation); err != nil {
 		return err
 	}
-	feeGrowth := sdk.NewDecCoinsFromCoins(incentiveCoins...).QuoDecTruncate(pool.CurrentTickLiquidity)
-	k.AddToAccumulator(ctx, feeAccumulator, feeGrowth)
+	k.addPoolIncentiveAccumulation(ctx, position.PoolId, accumulation)
+	return nil
+}
+
+func (k Keeper) getFeeGrowthInside(ctx sdk.Context, position types.Position) (types.AccumulatorObject, sdk.DecCoins, error) {
+	feeAccumulator, err := k.GetFeeAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return types.AccumulatorObject{}, nil, err
+	}
+
+	feeGrowthOutside, err := k.getFeeGrowthOutside(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return types.AccumulatorObject{}, nil, err
+	}
+
+	feeGrowthInside, _ := feeAccumulator.AccumValue.SafeSub(feeGrowthOutside)
+	return feeAccumulator, feeGrowthInside, nil
+}
+
+func (k Keeper) getIncentiveAccumulation(ctx sdk.Context, accumName, positionKey string, feeGrowthInside sdk.DecCoins) (sdk.DecCoins, error) {
+	accumPosition, err := k.GetAccumulatorPosition(ctx, accumName, positionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	feeGrowth, _ := feeGrowthInside.SafeSub(accumPosition.AccumValuePerShare)
+	return accumPosition.UnclaimedRewardsTotal.Add(feeGrowth.MulDecTruncate(accumPosition.NumShares)...), nil
+}
+
+// GetIncentiveAccumulation returns the fee rewards accumulated by the position since its last incentive claim
+func (k Keeper) GetIncentiveAccumulation(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error) {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return nil, types.ErrPositionNotFound
+	}
+
+	feeAccumulator, feeGrowthInside, err := k.getFeeGrowthInside(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	positionKey := types.KeyIncentivePositionAccumulator(positionId)
+	if !k.HasPosition(ctx, feeAccumulator.Name, positionKey) {
+		return sdk.NewDecCoins(), nil
+	}
+
+	return k.getIncentiveAccumulation(ctx, feeAccumulator.Name, positionKey, feeGrowthInside)
+}
+
+// ClaimIncentiveAccumulation returns the fee rewards accumulated by the position since its last incentive claim
+// and resets the accumulation
+func (k Keeper) ClaimIncentiveAccumulation(ctx sdk.Context, positionId uint64) (sdk.DecCoins, error) {
+	position, found := k.GetPosition(ctx, positionId)
+	if !found {
+		return nil, types.ErrPositionNotFound
+	}
+
+	feeAccumulator, feeGrowthInside, err := k.getFeeGrowthInside(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	positionKey := types.KeyIncentivePositionAccumulator(positionId)
+	accumulation := sdk.NewDecCoins()
+	if k.HasPosition(ctx, feeAccumulator.Name, positionKey) {
+		accumulation, err = k.getIncentiveAccumulation(ctx, feeAccumulator.Name, positionKey, feeGrowthInside)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetAccumulatorPosition(ctx, feeAccumulator.Name, feeGrowthInside, positionKey, position.Liquidity, sdk.NewDecCoins())
+	k.subPoolIncentiveAccumulation(ctx, position.PoolId, accumulation)
+	return accumulation, nil
+}
+
+// GetLimitOrderIncentiveAccumulation returns the incentive accumulation of the position of an open order,
+// or the accumulation carried over by a filled order
+func (k Keeper) GetLimitOrderIncentiveAccumulation(ctx sdk.Context, order types.LimitOrder) (sdk.DecCoins, error) {
+	if order.Filled {
+		return order.IncentiveAccumulation, nil
+	}
+	return k.GetIncentiveAccumulation(ctx, order.PositionId)
+}
+
+// ClaimLimitOrderIncentiveAccumulation returns the incentive accumulation of a limit order and resets it
+func (k Keeper) ClaimLimitOrderIncentiveAccumulation(ctx sdk.Context, orderId uint64) (sdk.DecCoins, error) {
+	order, found := k.GetLimitOrder(ctx, orderId)
+	if !found {
+		return nil, types.ErrLimitOrderNotFound
+	}
+	if !order.Filled {
+		return k.ClaimIncentiveAccumulation(ctx, order.PositionId)
+	}
 
-	return k.bankKeeper.SendCoins(ctx, sender, pool.GetFeesAddress(), incentiveCoins)
+	accumulation := order.IncentiveAccumulation
+	order.IncentiveAccumulation = sdk.NewDecCoins()
+	k.SetLimitOrder(ctx, order)
+	k.subPoolIncentiveAccumulation(ctx, order.PoolId, accumulation)
+	return accumulation, nil
 }
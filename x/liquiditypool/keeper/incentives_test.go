@@ -1,3 +1,110 @@
 package keeper_test
 
-// TODO: add test for AllocateIncentive
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func TestIncentiveAccumulation(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+	k, bk, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
+		Sender:         sender.String(),
+		PoolId:         0,
+		LowerTick:      -10,
+		UpperTick:      10,
+		TokenBase:      sdk.NewInt64Coin("base", 1000000),
+		TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+		MinAmountBase:  math.NewInt(0),
+		MinAmountQuote: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	accumulation, err := k.GetIncentiveAccumulation(wctx, 0)
+	require.NoError(t, err)
+	require.True(t, accumulation.IsZero())
+
+	pool, found := k.GetPool(ctx, 0)
+	require.True(t, found)
+	_, err = k.SwapExactAmountIn(wctx, sender, pool, sdk.NewInt64Coin("base", 100000), "quote", true)
+	require.NoError(t, err)
+
+	accumulation, err = k.GetIncentiveAccumulation(wctx, 0)
+	require.NoError(t, err)
+	require.False(t, accumulation.IsZero())
+	requirePoolIncentiveAccumulation(t, k.GetPoolIncentiveAccumulation(wctx, 0), accumulation)
+
+	// Collecting fees does not reset the incentive accumulation (the forfeited dust is redistributed)
+	_, err = srv.ClaimRewards(wctx, &types.MsgClaimRewards{
+		Sender:      sender.String(),
+		PositionIds: []uint64{0},
+	})
+	require.NoError(t, err)
+	accumulationAfterFees, err := k.GetIncentiveAccumulation(wctx, 0)
+	require.NoError(t, err)
+	require.True(t, accumulationAfterFees.AmountOf("base").GTE(accumulation.AmountOf("base")))
+	accumulation = accumulationAfterFees
+	requirePoolIncentiveAccumulation(t, k.GetPoolIncentiveAccumulation(wctx, 0), accumulation)
+
+	// Increasing liquidity carries the accumulation over to the new position
+	res, err := srv.IncreaseLiquidity(wctx, &types.MsgIncreaseLiquidity{
+		Sender:         sender.String(),
+		Id:             0,
+		AmountBase:     math.NewInt(1000),
+		AmountQuote:    math.NewInt(1000),
+		MinAmountBase:  math.NewInt(0),
+		MinAmountQuote: math.NewInt(0),
+	})
+	require.NoError(t, err)
+	accumulationIncreased, err := k.GetIncentiveAccumulation(wctx, res.PositionId)
+	require.NoError(t, err)
+	require.Equal(t, accumulation, accumulationIncreased)
+	requirePoolIncentiveAccumulation(t, k.GetPoolIncentiveAccumulation(wctx, 0), accumulation)
+
+	claimed, err := k.ClaimIncentiveAccumulation(wctx, res.PositionId)
+	require.NoError(t, err)
+	require.Equal(t, accumulation, claimed)
+	accumulation, err = k.GetIncentiveAccumulation(wctx, res.PositionId)
+	require.NoError(t, err)
+	require.True(t, accumulation.IsZero())
+	requirePoolIncentiveAccumulation(t, k.GetPoolIncentiveAccumulation(wctx, 0), accumulation)
+
+	// Removing the position removes its incentive accumulation
+	position, found := k.GetPosition(wctx, res.PositionId)
+	require.True(t, found)
+	_, _, err = k.DecreaseLiquidity(wctx, sender, res.PositionId, position.Liquidity)
+	require.NoError(t, err)
+	require.False(t, k.HasPosition(wctx, types.KeyFeePoolAccumulator(0), types.KeyIncentivePositionAccumulator(res.PositionId)))
+	requirePoolIncentiveAccumulation(t, k.GetPoolIncentiveAccumulation(wctx, 0), sdk.NewDecCoins())
+}
+
+// requirePoolIncentiveAccumulation checks that the sum kept for the pool is the accumulation of its positions
+// rounded up
+func requirePoolIncentiveAccumulation(t *testing.T, poolAccumulation, accumulation sdk.DecCoins) {
+	t.Helper()
+	rounding, hasNeg := poolAccumulation.SafeSub(accumulation)
+	require.False(t, hasNeg, "%s < %s", poolAccumulation, accumulation)
+	for _, coin := range rounding {
+		require.True(t, coin.Amount.LT(math.LegacyNewDecWithPrec(1, 6)), "rounding %s", coin)
+	}
+}
@@ -24,7 +24,8 @@ type (
 		// should be the x/gov module account.
 		authority string
 
-		bankKeeper types.BankKeeper
+		bankKeeper         types.BankKeeper
+		distributionKeeper types.DistributionKeeper
 	}
 )
 
@@ -35,6 +36,7 @@ func NewKeeper(
 	authority string,
 
 	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -46,7 +48,8 @@ func NewKeeper(
 		authority:    authority,
 		logger:       logger,
 
-		bankKeeper: bankKeeper,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
 	}
 }
 
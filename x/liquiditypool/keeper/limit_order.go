@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// GetLimitOrderCount get the total number of limit order
+func (k Keeper) GetLimitOrderCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.LimitOrderCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLimitOrderCount set the total number of limit order
+func (k Keeper) SetLimitOrderCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.LimitOrderCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendLimitOrder appends a limit order in the store with a new id and update the count
+func (k Keeper) AppendLimitOrder(ctx context.Context, order types.LimitOrder) uint64 {
+	count := k.GetLimitOrderCount(ctx)
+
+	order.Id = count
+	k.SetLimitOrder(ctx, order)
+
+	k.SetLimitOrderCount(ctx, count+1)
+
+	return count
+}
+
+// SetLimitOrder set a specific limit order in the store.
+// Open orders are indexed by the tick whose crossing fills them.
+func (k Keeper) SetLimitOrder(ctx context.Context, order types.LimitOrder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LimitOrderKey))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(GetLimitOrderIDBytes(order.Id), b)
+
+	orderKey := sdk.Uint64ToBigEndian(order.Id)
+	store = prefix.NewStore(storeAdapter, types.LimitOrderByPoolPrefix(order.PoolId))
+	store.Set(orderKey, orderKey)
+
+	store = prefix.NewStore(storeAdapter, types.LimitOrderByAddressPrefix(order.Address))
+	store.Set(orderKey, orderKey)
+
+	if triggerKey, found := k.limitOrderTriggerKey(ctx, order); found {
+		if order.Filled {
+			storeAdapter.Delete(triggerKey)
+		} else {
+			storeAdapter.Set(triggerKey, orderKey)
+		}
+	}
+}
+
+// GetLimitOrder returns a limit order from its id
+func (k Keeper) GetLimitOrder(ctx context.Context, id uint64) (val types.LimitOrder, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LimitOrderKey))
+	b := store.Get(GetLimitOrderIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveLimitOrder removes a limit order and its indexes from the store
+func (k Keeper) RemoveLimitOrder(ctx context.Context, id uint64) {
+	order, found := k.GetLimitOrder(ctx, id)
+	if !found {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LimitOrderKey))
+	store.Delete(GetLimitOrderIDBytes(id))
+
+	orderKey := sdk.Uint64ToBigEndian(order.Id)
+	store = prefix.NewStore(storeAdapter, types.LimitOrderByPoolPrefix(order.PoolId))
+	store.Delete(orderKey)
+
+	store = prefix.NewStore(storeAdapter, types.LimitOrderByAddressPrefix(order.Address))
+	store.Delete(orderKey)
+
+	if triggerKey, found := k.limitOrderTriggerKey(ctx, order); found {
+		storeAdapter.Delete(triggerKey)
+	}
+}
+
+// GetAllLimitOrders returns all limit order
+func (k Keeper) GetAllLimitOrders(ctx context.Context) (list []types.LimitOrder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LimitOrderKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetLimitOrdersByPool(ctx context.Context, poolId uint64) []types.LimitOrder {
+	return k.getLimitOrdersByIndex(ctx, types.LimitOrderByPoolPrefix(poolId))
+}
+
+func (k Keeper) GetLimitOrdersByAddress(ctx context.Context, addr string) []types.LimitOrder {
+	return k.getLimitOrdersByIndex(ctx, types.LimitOrderByAddressPrefix(addr))
+}
+
+// getTriggeredLimitOrders returns up to limit open orders of the pool filled by the crossing of the ticks
+// in [fromTick, toTick], in the order in which the ticks are crossed
+func (k Keeper) getTriggeredLimitOrders(ctx context.Context, poolId uint64, up bool, fromTick, toTick int64, limit uint64) []types.LimitOrder {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.LimitOrderByTriggerPrefix(poolId, up))
+
+	end := types.TickIndexToBytes(toTick)
+	end = append(end, sdk.Uint64ToBigEndian(^uint64(0))...)
+	var iterator storetypes.Iterator
+	if up {
+		iterator = store.Iterator(types.TickIndexToBytes(fromTick), storetypes.InclusiveEndBytes(end))
+	} else {
+		iterator = store.ReverseIterator(types.TickIndexToBytes(fromTick), storetypes.InclusiveEndBytes(end))
+	}
+
+	defer iterator.Close()
+
+	orders := []types.LimitOrder{}
+	for ; iterator.Valid() && uint64(len(orders)) < limit; iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+func (k Keeper) getLimitOrdersByIndex(ctx context.Context, indexPrefix []byte) []types.LimitOrder {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, indexPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	orders := []types.LimitOrder{}
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+func (k Keeper) limitOrderTriggerKey(ctx context.Context, order types.LimitOrder) ([]byte, bool) {
+	pool, found := k.GetPool(ctx, order.PoolId)
+	if !found {
+		return nil, false
+	}
+	up, tick := order.Trigger(pool.DenomBase)
+	return types.LimitOrderByTriggerKey(order.PoolId, up, tick, order.Id), true
+}
+
+// GetLimitOrderIDBytes returns the byte representation of the ID
+func GetLimitOrderIDBytes(id uint64) []byte {
+	bz := types.KeyPrefix(types.LimitOrderKey)
+	bz = append(bz, []byte("/")...)
+	bz = binary.BigEndian.AppendUint64(bz, id)
+	return bz
+}
+
+// closeLimitOrderPosition withdraws the whole liquidity of the order position to the limit order address
+// and returns the withdrawn amounts including the fees earned by the position
+func (k Keeper) closeLimitOrderPosition(ctx sdk.Context, order types.LimitOrder) (sdk.Coins, error) {
+	position, found := k.GetPosition(ctx, order.PositionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPositionNotFound, "id: %d", order.PositionId)
+	}
+	pool, found := k.GetPool(ctx, order.PoolId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool id: %d", order.PoolId)
+	}
+
+	amountBase, amountQuote, fees, err := k.decreaseLiquidity(ctx, types.LimitOrderAddress(), position.Id, position.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(pool.DenomBase, amountBase), sdk.NewCoin(pool.DenomQuote, amountQuote))
+	return amount.Add(fees...), nil
+}
+
+// fillLimitOrders closes the open orders whose trigger tick was crossed by the swap moving
+// the current tick of the pool from fromTick to toTick. The output asset is kept by the
+// limit order address until the order is claimed.
+// At most MaxLimitOrderFillsPerSwap orders are closed, the first crossed first, and the
+// remaining crossed orders are left to be filled when claimed.
+func (k Keeper) fillLimitOrders(ctx sdk.Context, poolId uint64, fromTick, toTick int64) error {
+	limit := k.GetParams(ctx).MaxLimitOrderFillsPerSwap
+
+	var orders []types.LimitOrder
+	switch {
+	case toTick > fromTick:
+		// Moving up through tick T sets the current tick to T
+		orders = k.getTriggeredLimitOrders(ctx, poolId, true, fromTick+1, toTick, limit)
+	case toTick < fromTick:
+		// Moving down through tick T sets the current tick to T-1
+		orders = k.getTriggeredLimitOrders(ctx, poolId, false, toTick+1, fromTick, limit)
+	default:
+		return nil
+	}
+
+	for _, order := range orders {
+		if err := k.fillLimitOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillCrossedLimitOrder fills the open order if the current tick of the pool is past its trigger tick
+func (k Keeper) fillCrossedLimitOrder(ctx sdk.Context, order types.LimitOrder) (types.LimitOrder, error) {
+	pool, found := k.GetPool(ctx, order.PoolId)
+	if !found {
+		return order, errorsmod.Wrapf(types.ErrPoolNotFound, "pool id: %d", order.PoolId)
+	}
+	if !order.Crossed(pool.DenomBase, pool.CurrentTick) {
+		return order, errorsmod.Wrapf(types.ErrLimitOrderNotFilled, "id: %d", order.Id)
+	}
+	if err := k.fillLimitOrder(ctx, order); err != nil {
+		return order, err
+	}
+	order, _ = k.GetLimitOrder(ctx, order.Id)
+	return order, nil
+}
+
+// fillLimitOrder closes the position of the order and carries its incentive accumulation over
+// to the order, so that the owner can still claim the incentives earned while the order was open
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	incentiveAccumulation, err := k.GetIncentiveAccumulation(ctx, order.PositionId)
+	if err != nil {
+		return err
+	}
+	amount, err := k.closeLimitOrderPosition(ctx, order)
+	if err != nil {
+		return err
+	}
+	k.addPoolIncentiveAccumulation(ctx, order.PoolId, incentiveAccumulation)
+
+	order.Filled = true
+	order.FilledAmount = amount
+	order.IncentiveAccumulation = incentiveAccumulation
+	k.SetLimitOrder(ctx, order)
+	return nil
+}
@@ -0,0 +1,374 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/nullify"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/keeper"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func createNLimitOrder(keeper keeper.Keeper, ctx context.Context, n int) []types.LimitOrder {
+	items := make([]types.LimitOrder, n)
+	for i := range items {
+		items[i] = types.LimitOrder{
+			Address:      sdk.AccAddress("trader").String(),
+			PoolId:       0,
+			PositionId:   uint64(i),
+			Tick:         int64(i),
+			TokenIn:      sdk.NewInt64Coin("base", 1000),
+			FilledAmount: sdk.Coins{},
+		}
+		items[i].Id = keeper.AppendLimitOrder(ctx, items[i])
+	}
+	return items
+}
+
+func TestLimitOrderGet(t *testing.T) {
+	keeper, _, ctx := keepertest.LiquiditypoolKeeper(t)
+	items := createNLimitOrder(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetLimitOrder(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Len(t, keeper.GetLimitOrdersByAddress(ctx, sdk.AccAddress("trader").String()), 10)
+	require.Len(t, keeper.GetLimitOrdersByPool(ctx, 0), 10)
+}
+
+func TestLimitOrderRemove(t *testing.T) {
+	keeper, _, ctx := keepertest.LiquiditypoolKeeper(t)
+	items := createNLimitOrder(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveLimitOrder(ctx, item.Id)
+		_, found := keeper.GetLimitOrder(ctx, item.Id)
+		require.False(t, found)
+	}
+	require.Len(t, keeper.GetLimitOrdersByAddress(ctx, sdk.AccAddress("trader").String()), 0)
+	require.Len(t, keeper.GetLimitOrdersByPool(ctx, 0), 0)
+}
+
+func setupLimitOrderPool(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context) {
+	provider := sdk.AccAddress("provider")
+	k, bk, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
+		Sender:         provider.String(),
+		PoolId:         0,
+		LowerTick:      -10,
+		UpperTick:      10,
+		TokenBase:      sdk.NewInt64Coin("base", 1000000),
+		TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+		MinAmountBase:  math.NewInt(0),
+		MinAmountQuote: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	pool, found := k.GetPool(wctx, 0)
+	require.True(t, found)
+	require.Equal(t, int64(0), pool.CurrentTick)
+
+	return k, srv, wctx
+}
+
+func TestPlaceLimitOrder(t *testing.T) {
+	trader := sdk.AccAddress("trader")
+
+	tests := []struct {
+		desc    string
+		tick    int64
+		tokenIn sdk.Coin
+		err     error
+	}{
+		{
+			desc:    "sell base above current tick",
+			tick:    1,
+			tokenIn: sdk.NewInt64Coin("base", 1000),
+		},
+		{
+			desc:    "sell quote below current tick",
+			tick:    -2,
+			tokenIn: sdk.NewInt64Coin("quote", 1000),
+		},
+		{
+			desc:    "sell base at current tick",
+			tick:    0,
+			tokenIn: sdk.NewInt64Coin("base", 1000),
+			err:     types.ErrInvalidLimitOrderTick,
+		},
+		{
+			desc:    "sell quote at current tick",
+			tick:    0,
+			tokenIn: sdk.NewInt64Coin("quote", 1000),
+			err:     types.ErrInvalidLimitOrderTick,
+		},
+		{
+			desc:    "too small",
+			tick:    1,
+			tokenIn: sdk.NewInt64Coin("base", 10),
+			err:     types.ErrLimitOrderTooSmall,
+		},
+		{
+			desc:    "unknown denom",
+			tick:    1,
+			tokenIn: sdk.NewInt64Coin("other", 1000),
+			err:     types.ErrInvalidInDenom,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupLimitOrderPool(t)
+
+			resp, err := srv.PlaceLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+				Sender:  trader.String(),
+				PoolId:  0,
+				Tick:    tc.tick,
+				TokenIn: tc.tokenIn,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.tokenIn.Denom, resp.TokenIn.Denom)
+			require.True(t, resp.TokenIn.Amount.LTE(tc.tokenIn.Amount.AddRaw(1)))
+
+			order, found := k.GetLimitOrder(ctx, resp.Id)
+			require.True(t, found)
+			require.False(t, order.Filled)
+
+			position, found := k.GetPosition(ctx, order.PositionId)
+			require.True(t, found)
+			require.Equal(t, types.LimitOrderAddress().String(), position.Address)
+			require.Equal(t, tc.tick, position.LowerTick)
+			require.Equal(t, tc.tick+1, position.UpperTick)
+		})
+	}
+}
+
+func TestLimitOrderFill(t *testing.T) {
+	trader := sdk.AccAddress("trader")
+	swapper := sdk.AccAddress("swapper")
+
+	tests := []struct {
+		desc      string
+		tick      int64
+		tokenIn   sdk.Coin
+		swapIn    sdk.Coin
+		denomOut  string
+		expFilled bool
+	}{
+		{
+			desc:      "sell base crossed",
+			tick:      1,
+			tokenIn:   sdk.NewInt64Coin("base", 1000),
+			swapIn:    sdk.NewInt64Coin("quote", 300000),
+			denomOut:  "base",
+			expFilled: true,
+		},
+		{
+			desc:      "sell base not crossed",
+			tick:      1,
+			tokenIn:   sdk.NewInt64Coin("base", 1000),
+			swapIn:    sdk.NewInt64Coin("quote", 100000),
+			denomOut:  "base",
+			expFilled: false,
+		},
+		{
+			desc:      "sell base moving away",
+			tick:      1,
+			tokenIn:   sdk.NewInt64Coin("base", 1000),
+			swapIn:    sdk.NewInt64Coin("base", 300000),
+			denomOut:  "quote",
+			expFilled: false,
+		},
+		{
+			desc:      "sell quote crossed",
+			tick:      -2,
+			tokenIn:   sdk.NewInt64Coin("quote", 1000),
+			swapIn:    sdk.NewInt64Coin("base", 300000),
+			denomOut:  "quote",
+			expFilled: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupLimitOrderPool(t)
+
+			resp, err := srv.PlaceLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+				Sender:  trader.String(),
+				PoolId:  0,
+				Tick:    tc.tick,
+				TokenIn: tc.tokenIn,
+			})
+			require.NoError(t, err)
+
+			pool, found := k.GetPool(ctx, 0)
+			require.True(t, found)
+			_, err = k.SwapExactAmountIn(ctx, swapper, pool, tc.swapIn, tc.denomOut, true)
+			require.NoError(t, err)
+
+			order, found := k.GetLimitOrder(ctx, resp.Id)
+			require.True(t, found)
+			require.Equal(t, tc.expFilled, order.Filled)
+
+			_, found = k.GetPosition(ctx, order.PositionId)
+			require.Equal(t, !tc.expFilled, found)
+
+			openOrders, err := k.AddressLimitOrders(ctx, &types.QueryAddressLimitOrdersRequest{Address: trader.String(), OnlyOpen: true})
+			require.NoError(t, err)
+			filledOrders, err := k.PoolLimitOrders(ctx, &types.QueryPoolLimitOrdersRequest{PoolId: 0, OnlyFilled: true})
+			require.NoError(t, err)
+
+			if !tc.expFilled {
+				require.Len(t, openOrders.LimitOrders, 1)
+				require.Len(t, filledOrders.LimitOrders, 0)
+
+				_, err = srv.ClaimLimitOrder(ctx, &types.MsgClaimLimitOrder{Sender: trader.String(), Id: resp.Id})
+				require.ErrorIs(t, err, types.ErrLimitOrderNotFilled)
+				return
+			}
+			require.Len(t, openOrders.LimitOrders, 0)
+			require.Len(t, filledOrders.LimitOrders, 1)
+
+			// The order is fully converted to the output asset
+			require.True(t, order.FilledAmount.AmountOf(tc.tokenIn.Denom).IsZero())
+			require.True(t, order.FilledAmount.AmountOf(tc.swapIn.Denom).IsPositive())
+
+			// The incentive accumulation of the position is carried over to the order
+			require.False(t, order.IncentiveAccumulation.IsZero())
+			_, hasNeg := k.GetPoolIncentiveAccumulation(ctx, 0).SafeSub(order.IncentiveAccumulation)
+			require.False(t, hasNeg)
+			accumulation, err := k.GetLimitOrderIncentiveAccumulation(ctx, order)
+			require.NoError(t, err)
+			require.Equal(t, order.IncentiveAccumulation, accumulation)
+			accumulation, err = k.ClaimLimitOrderIncentiveAccumulation(ctx, order.Id)
+			require.NoError(t, err)
+			require.Equal(t, order.IncentiveAccumulation, accumulation)
+			order, found = k.GetLimitOrder(ctx, resp.Id)
+			require.True(t, found)
+			require.True(t, order.IncentiveAccumulation.IsZero())
+
+			_, err = srv.CancelLimitOrder(ctx, &types.MsgCancelLimitOrder{Sender: trader.String(), Id: resp.Id})
+			require.ErrorIs(t, err, types.ErrLimitOrderFilled)
+
+			_, err = srv.ClaimLimitOrder(ctx, &types.MsgClaimLimitOrder{Sender: swapper.String(), Id: resp.Id})
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			claimed, err := srv.ClaimLimitOrder(ctx, &types.MsgClaimLimitOrder{Sender: trader.String(), Id: resp.Id})
+			require.NoError(t, err)
+			require.Equal(t, order.FilledAmount, claimed.Amount)
+
+			_, found = k.GetLimitOrder(ctx, resp.Id)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestLimitOrderFillCap(t *testing.T) {
+	trader := sdk.AccAddress("trader")
+	swapper := sdk.AccAddress("swapper")
+	k, srv, ctx := setupLimitOrderPool(t)
+
+	params := k.GetParams(ctx)
+	params.MaxLimitOrderFillsPerSwap = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ids := []uint64{}
+	for _, tick := range []int64{2, 1} {
+		resp, err := srv.PlaceLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+			Sender:  trader.String(),
+			PoolId:  0,
+			Tick:    tick,
+			TokenIn: sdk.NewInt64Coin("base", 1000),
+		})
+		require.NoError(t, err)
+		ids = append(ids, resp.Id)
+	}
+
+	pool, found := k.GetPool(ctx, 0)
+	require.True(t, found)
+	_, err := k.SwapExactAmountIn(ctx, swapper, pool, sdk.NewInt64Coin("quote", 500000), "base", true)
+	require.NoError(t, err)
+
+	pool, found = k.GetPool(ctx, 0)
+	require.True(t, found)
+	require.Greater(t, pool.CurrentTick, int64(3))
+
+	// Only the order crossed first is filled by the swap
+	first, found := k.GetLimitOrder(ctx, ids[1])
+	require.True(t, found)
+	require.True(t, first.Filled)
+	second, found := k.GetLimitOrder(ctx, ids[0])
+	require.True(t, found)
+	require.False(t, second.Filled)
+
+	// The other one is filled when claimed
+	claimed, err := srv.ClaimLimitOrder(ctx, &types.MsgClaimLimitOrder{Sender: trader.String(), Id: second.Id})
+	require.NoError(t, err)
+	require.True(t, claimed.Amount.AmountOf("base").IsZero())
+	require.True(t, claimed.Amount.AmountOf("quote").IsPositive())
+
+	_, found = k.GetLimitOrder(ctx, second.Id)
+	require.False(t, found)
+	_, found = k.GetPosition(ctx, second.PositionId)
+	require.False(t, found)
+}
+
+func TestCancelLimitOrder(t *testing.T) {
+	trader := sdk.AccAddress("trader")
+	k, srv, ctx := setupLimitOrderPool(t)
+
+	resp, err := srv.PlaceLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Sender:  trader.String(),
+		PoolId:  0,
+		Tick:    1,
+		TokenIn: sdk.NewInt64Coin("base", 1000),
+	})
+	require.NoError(t, err)
+
+	order, found := k.GetLimitOrder(ctx, resp.Id)
+	require.True(t, found)
+
+	_, err = srv.CancelLimitOrder(ctx, &types.MsgCancelLimitOrder{Sender: sdk.AccAddress("other").String(), Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	cancelled, err := srv.CancelLimitOrder(ctx, &types.MsgCancelLimitOrder{Sender: trader.String(), Id: resp.Id})
+	require.NoError(t, err)
+	require.True(t, cancelled.Amount.AmountOf("base").LTE(resp.TokenIn.Amount))
+	require.True(t, cancelled.Amount.AmountOf("base").GTE(resp.TokenIn.Amount.SubRaw(1)))
+	require.True(t, cancelled.Amount.AmountOf("quote").IsZero())
+
+	_, found = k.GetLimitOrder(ctx, resp.Id)
+	require.False(t, found)
+	_, found = k.GetPosition(ctx, order.PositionId)
+	require.False(t, found)
+
+	_, err = srv.CancelLimitOrder(ctx, &types.MsgCancelLimitOrder{Sender: trader.String(), Id: resp.Id})
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// The PoolByDenoms index is rebuilt from the pools, so that the pools created
+// before the index are indexed and the denoms of the keys are sorted.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	storeAdapter := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte(types.PoolByDenoms))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, pool := range m.keeper.GetAllPools(ctx) {
+		m.keeper.SetPool(ctx, pool)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+// The unclaimed incentive accumulation of the pools is summed up from their positions.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, pool := range m.keeper.GetAllPools(ctx) {
+		accumulation := sdk.NewDecCoins()
+		for _, position := range m.keeper.GetPositionsByPool(ctx, pool.Id) {
+			positionAccumulation, err := m.keeper.GetIncentiveAccumulation(ctx, position.Id)
+			if err != nil {
+				return err
+			}
+			accumulation = accumulation.Add(positionAccumulation...)
+		}
+		m.keeper.setPoolIncentiveAccumulation(ctx, pool.Id, accumulation)
+	}
+
+	return nil
+}
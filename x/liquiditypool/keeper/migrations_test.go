@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/keeper"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, _, ctx := keepertest.LiquiditypoolKeeper(t)
+	items := createNPool(k, ctx, 2)
+	k.ClearPoolByDenoms(ctx)
+	require.Empty(t, k.GetPoolsByDenoms(ctx, "base", "quote"))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	require.Len(t, k.GetPoolsByDenoms(ctx, "base", "quote"), 1)
+	require.Len(t, k.GetPoolsByDenoms(ctx, "quote", "base"), 1)
+	pool, found := k.GetPoolByDenomsAndFeeRate(ctx, "quote", "base", items[1].FeeRate)
+	require.True(t, found)
+	require.Equal(t, items[1].Id, pool.Id)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+	k, bk, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+	_, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
+		Sender:         sender.String(),
+		PoolId:         0,
+		LowerTick:      -10,
+		UpperTick:      10,
+		TokenBase:      sdk.NewInt64Coin("base", 1000000),
+		TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+		MinAmountBase:  math.NewInt(0),
+		MinAmountQuote: math.NewInt(0),
+	})
+	require.NoError(t, err)
+	pool, found := k.GetPool(wctx, 0)
+	require.True(t, found)
+	_, err = k.SwapExactAmountIn(wctx, sender, pool, sdk.NewInt64Coin("base", 100000), "quote", true)
+	require.NoError(t, err)
+
+	k.ClearPoolIncentiveAccumulation(wctx, 0)
+	require.True(t, k.GetPoolIncentiveAccumulation(wctx, 0).IsZero())
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(wctx))
+
+	accumulation, err := k.GetIncentiveAccumulation(wctx, 0)
+	require.NoError(t, err)
+	require.False(t, accumulation.IsZero())
+	require.Equal(t, accumulation, k.GetPoolIncentiveAccumulation(wctx, 0))
+}
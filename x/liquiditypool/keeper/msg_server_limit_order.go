@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func (k msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool id: %d", msg.PoolId)
+	}
+	if !pool.HasPosition(ctx) {
+		return nil, types.ErrEmptyLiquidity
+	}
+
+	lowerTick := msg.Tick
+	upperTick := msg.Tick + 1
+	if err := checkTicks(lowerTick, upperTick); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTickers, err.Error())
+	}
+
+	if !msg.TokenIn.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTokenAmounts, "token in %s", msg.TokenIn.String())
+	}
+
+	// Base is sold above the current tick and quote is sold below it
+	amountBaseDesired := math.ZeroInt()
+	amountQuoteDesired := math.ZeroInt()
+	switch msg.TokenIn.Denom {
+	case pool.DenomBase:
+		if pool.CurrentTick >= lowerTick {
+			return nil, errorsmod.Wrapf(types.ErrInvalidLimitOrderTick, "tick %d, current tick %d", msg.Tick, pool.CurrentTick)
+		}
+		amountBaseDesired = msg.TokenIn.Amount
+	case pool.DenomQuote:
+		if pool.CurrentTick < upperTick {
+			return nil, errorsmod.Wrapf(types.ErrInvalidLimitOrderTick, "tick %d, current tick %d", msg.Tick, pool.CurrentTick)
+		}
+		amountQuoteDesired = msg.TokenIn.Amount
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidInDenom, "denom %s", msg.TokenIn.Denom)
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := types.TicksToSqrtPrice(lowerTick, upperTick, pool.TickParams)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidityDelta := types.GetLiquidityFromAmounts(pool.CurrentSqrtPrice, sqrtPriceLowerTick, sqrtPriceUpperTick, amountBaseDesired, amountQuoteDesired)
+	if !liquidityDelta.IsPositive() {
+		return nil, types.ErrZeroLiquidity
+	}
+	if minLiquidity := k.GetParams(ctx).MinLimitOrderLiquidity; liquidityDelta.LT(minLiquidity) {
+		return nil, errorsmod.Wrapf(types.ErrLimitOrderTooSmall, "liquidity %s, min %s", liquidityDelta, minLiquidity)
+	}
+
+	// The position is owned by the limit order address so that it can only be closed through the order
+	escrow := types.LimitOrderAddress()
+	positionId := k.AppendPosition(ctx, types.Position{
+		PoolId:    msg.PoolId,
+		Address:   escrow.String(),
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Liquidity: math.LegacyZeroDec(),
+	})
+
+	amountBase, amountQuote, _, _, err := k.UpdatePosition(ctx, msg.PoolId, escrow, lowerTick, upperTick, liquidityDelta, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn := sdk.NewCoin(pool.DenomBase, amountBase)
+	if msg.TokenIn.Denom == pool.DenomQuote {
+		tokenIn = sdk.NewCoin(pool.DenomQuote, amountQuote)
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, tokenIn); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{tokenIn}); err != nil {
+		return nil, err
+	}
+
+	id := k.AppendLimitOrder(ctx, types.LimitOrder{
+		Address:    msg.Sender,
+		PoolId:     msg.PoolId,
+		PositionId: positionId,
+		Tick:       msg.Tick,
+		TokenIn:    tokenIn,
+	})
+
+	return &types.MsgPlaceLimitOrderResponse{
+		Id:      id,
+		TokenIn: tokenIn,
+	}, nil
+}
+
+func (k msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetLimitOrder(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "id: %d", msg.Id)
+	}
+	if msg.Sender != order.Address {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	if order.Filled {
+		return nil, errorsmod.Wrapf(types.ErrLimitOrderFilled, "id: %d", msg.Id)
+	}
+
+	amount, err := k.closeLimitOrderPosition(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if !amount.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.LimitOrderAddress(), sender, amount); err != nil {
+			return nil, err
+		}
+	}
+	k.RemoveLimitOrder(ctx, order.Id)
+
+	return &types.MsgCancelLimitOrderResponse{
+		Amount: amount,
+	}, nil
+}
+
+func (k msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetLimitOrder(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrLimitOrderNotFound, "id: %d", msg.Id)
+	}
+	if msg.Sender != order.Address {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	if !order.Filled {
+		// The crossed orders beyond the fill cap of the swap are filled when claimed
+		order, err = k.fillCrossedLimitOrder(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !order.FilledAmount.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.LimitOrderAddress(), sender, order.FilledAmount); err != nil {
+			return nil, err
+		}
+	}
+	// The incentive accumulation not claimed yet is forfeited with the order
+	k.subPoolIncentiveAccumulation(ctx, order.PoolId, order.IncentiveAccumulation)
+	k.RemoveLimitOrder(ctx, order.Id)
+
+	return &types.MsgClaimLimitOrderResponse{
+		Amount: order.FilledAmount,
+	}, nil
+}
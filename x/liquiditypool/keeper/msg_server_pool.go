@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
@@ -11,11 +12,6 @@ import (
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: enable later after completion of module - for now, disabled for testing
-	// if msg.Authority != k.authority {
-	// 	return nil, types.ErrInvalidSigner
-	// }
-
 	feeRate, err := math.LegacyNewDecFromStr(msg.FeeRate)
 	if err != nil {
 		return nil, err
@@ -31,14 +27,36 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 		return nil, err
 	}
 
+	tickParams := types.TickParams{
+		PriceRatio: priceRatio,
+		BaseOffset: baseOffset,
+	}
+
+	if err := (types.FeeTier{FeeRate: feeRate, TickParams: tickParams}).Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFeeTier, err.Error())
+	}
+
+	// A pool of the pair in the other order is also a duplicate
+	if _, found := k.GetPoolByDenomsAndFeeRate(ctx, msg.DenomBase, msg.DenomQuote, feeRate); found {
+		return nil, errorsmod.Wrapf(types.ErrDuplicatePool, "%s/%s with fee rate %s", msg.DenomBase, msg.DenomQuote, feeRate)
+	}
+
+	// The authority may create pools with any fee tier without paying the creation fee
+	if msg.Sender != k.authority {
+		params := k.GetParams(ctx)
+		if !params.IsAllowedFeeTier(feeRate, tickParams) {
+			return nil, errorsmod.Wrapf(types.ErrFeeTierNotAllowed, "fee rate %s, price ratio %s, base offset %s", feeRate, priceRatio, baseOffset)
+		}
+		if err := k.chargePoolCreationFee(ctx, msg.Sender, params); err != nil {
+			return nil, err
+		}
+	}
+
 	var pool = types.Pool{
-		DenomBase:  msg.DenomBase,
-		DenomQuote: msg.DenomQuote,
-		FeeRate:    feeRate,
-		TickParams: types.TickParams{
-			PriceRatio: priceRatio,
-			BaseOffset: baseOffset,
-		},
+		DenomBase:            msg.DenomBase,
+		DenomQuote:           msg.DenomQuote,
+		FeeRate:              feeRate,
+		TickParams:           tickParams,
 		CurrentTick:          0,
 		CurrentTickLiquidity: math.LegacyZeroDec(),
 		CurrentSqrtPrice:     math.LegacyZeroDec(),
@@ -52,3 +70,24 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 		Id: id,
 	}, nil
 }
+
+// chargePoolCreationFee burns the pool creation fee or sends it to the community pool
+func (k Keeper) chargePoolCreationFee(ctx sdk.Context, creator string, params types.Params) error {
+	if params.PoolCreationFee.IsZero() {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return err
+	}
+
+	if !params.BurnPoolCreationFee {
+		return k.distributionKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, params.PoolCreationFee); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.PoolCreationFee)
+}
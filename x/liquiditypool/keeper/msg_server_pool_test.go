@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/keeper"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
 
@@ -13,14 +17,13 @@ func TestPoolMsgServerCreate(t *testing.T) {
 	k, _, srv, ctx := setupMsgServer(t)
 	wctx := sdk.UnwrapSDKContext(ctx)
 
-	sender := sdk.AccAddress("sender")
 	resp, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-		Authority:  sender.String(),
+		Sender:     k.GetAuthority(),
 		DenomBase:  "base",
 		DenomQuote: "quote",
 		FeeRate:    "0.01",
 		PriceRatio: "1.0001",
-		BaseOffset: "0.5",
+		BaseOffset: "0",
 	})
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), resp.Id)
@@ -29,7 +32,7 @@ func TestPoolMsgServerCreate(t *testing.T) {
 	pool, found := k.GetPool(ctx, resp.Id)
 	require.True(t, found)
 	require.Equal(t, pool.TickParams.PriceRatio.String(), "1.000100000000000000")
-	require.Equal(t, pool.TickParams.BaseOffset.String(), "0.500000000000000000")
+	require.Equal(t, pool.TickParams.BaseOffset.String(), "0.000000000000000000")
 	require.Equal(t, pool.FeeRate.String(), "0.010000000000000000")
 	require.Equal(t, pool.CurrentTick, int64(0))
 	require.Equal(t, pool.CurrentTickLiquidity.String(), "0.000000000000000000")
@@ -39,12 +42,141 @@ func TestPoolMsgServerCreate(t *testing.T) {
 
 	// try creating another pool with same info
 	_, err = srv.CreatePool(wctx, &types.MsgCreatePool{
-		Authority:  sender.String(),
+		Sender:     k.GetAuthority(),
 		DenomBase:  "base",
 		DenomQuote: "quote",
 		FeeRate:    "0.01",
 		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.ErrorIs(t, err, types.ErrDuplicatePool)
+
+	// the same pair in the other order
+	_, err = srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "quote",
+		DenomQuote: "base",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.ErrorIs(t, err, types.ErrDuplicatePool)
+
+	// the authority may not use invalid tick params either
+	_, err = srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "other",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
 		BaseOffset: "0.5",
 	})
+	require.ErrorIs(t, err, types.ErrInvalidFeeTier)
+
+	// same denoms with another fee rate
+	resp, err = srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.003",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Id)
+	require.Len(t, k.GetPoolsByDenoms(ctx, "base", "quote"), 2)
+	require.Len(t, k.GetPoolsByDenoms(ctx, "quote", "base"), 2)
+}
+
+func TestPoolMsgServerCreatePermissionless(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+
+	tests := []struct {
+		desc       string
+		feeRate    string
+		baseOffset string
+		defaultFee bool
+		burn       bool
+		err        error
+	}{
+		{
+			desc:       "default fee to community pool",
+			feeRate:    "0.003",
+			baseOffset: "0",
+			defaultFee: true,
+		},
+		{
+			desc:       "fee to community pool",
+			feeRate:    "0.003",
+			baseOffset: "0",
+		},
+		{
+			desc:       "fee burned",
+			feeRate:    "0.003",
+			baseOffset: "0",
+			burn:       true,
+		},
+		{
+			desc:       "fee rate not allowed",
+			feeRate:    "0.02",
+			baseOffset: "0",
+			err:        types.ErrFeeTierNotAllowed,
+		},
+		{
+			desc:       "tick params not allowed",
+			feeRate:    "0.003",
+			baseOffset: "-0.5",
+			err:        types.ErrFeeTierNotAllowed,
+		},
+		{
+			desc:       "invalid tick params",
+			feeRate:    "0.003",
+			baseOffset: "0.5",
+			err:        types.ErrInvalidFeeTier,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, mocks, ctx := keepertest.LiquiditypoolKeeperWithMocks(t)
+			srv := keeper.NewMsgServerImpl(k)
+
+			params := types.DefaultParams()
+			require.False(t, params.PoolCreationFee.IsZero())
+			creationFee := params.PoolCreationFee
+			if !tc.defaultFee {
+				creationFee = sdk.NewCoins(sdk.NewInt64Coin("fee", 1000))
+				params.PoolCreationFee = creationFee
+			}
+			params.BurnPoolCreationFee = tc.burn
+			require.NoError(t, k.SetParams(ctx, params))
+
+			if tc.err == nil {
+				if tc.burn {
+					mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, types.ModuleName, creationFee).Return(nil).Times(1)
+					mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, creationFee).Return(nil).Times(1)
+				} else {
+					mocks.DistributionKeeper.EXPECT().FundCommunityPool(gomock.Any(), creationFee, sender).Return(nil).Times(1)
+				}
+			}
+
+			resp, err := srv.CreatePool(ctx, &types.MsgCreatePool{
+				Sender:     sender.String(),
+				DenomBase:  "base",
+				DenomQuote: "quote",
+				FeeRate:    tc.feeRate,
+				PriceRatio: "1.0001",
+				BaseOffset: tc.baseOffset,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, uint64(0), k.GetPoolCount(ctx))
+				return
+			}
+			require.NoError(t, err)
+
+			pool, found := k.GetPoolByDenomsAndFeeRate(ctx, "base", "quote", math.LegacyMustNewDecFromStr(tc.feeRate))
+			require.True(t, found)
+			require.Equal(t, resp.Id, pool.Id)
+		})
+	}
 }
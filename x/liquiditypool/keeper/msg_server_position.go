@@ -153,6 +153,11 @@ func (k msgServer) IncreaseLiquidity(goCtx context.Context, msg *types.MsgIncrea
 	if err != nil {
 		return nil, err
 	}
+	// Carry the incentive accumulation over to the new position
+	incentiveAccumulation, err := k.GetIncentiveAccumulation(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
 	amountBaseWithdrawn, amountQuoteWithdrawn, err := k.Keeper.DecreaseLiquidity(ctx, sender, msg.Id, position.Liquidity)
 	if err != nil {
 		return nil, err
@@ -183,6 +188,10 @@ func (k msgServer) IncreaseLiquidity(goCtx context.Context, msg *types.MsgIncrea
 		return nil, err
 	}
 
+	if err := k.addIncentiveAccumulation(ctx, res.Id, incentiveAccumulation); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgIncreaseLiquidityResponse{
 		PositionId:  res.Id,
 		AmountBase:  res.AmountBase,
@@ -191,53 +200,63 @@ func (k msgServer) IncreaseLiquidity(goCtx context.Context, msg *types.MsgIncrea
 }
 
 func (k Keeper) DecreaseLiquidity(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, liquidity math.LegacyDec) (amountBase math.Int, amountQuote math.Int, err error) {
+	amountBase, amountQuote, _, err = k.decreaseLiquidity(ctx, sender, positionId, liquidity)
+	return amountBase, amountQuote, err
+}
+
+// decreaseLiquidity withdraws the liquidity from the position and also returns the fees collected when the position is closed
+func (k Keeper) decreaseLiquidity(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, liquidity math.LegacyDec) (amountBase math.Int, amountQuote math.Int, feesCollected sdk.Coins, err error) {
 	// Checks that the element exists
 	position, found := k.GetPosition(ctx, positionId)
 	if !found {
-		return math.Int{}, math.Int{}, errorsmod.Wrap(types.ErrPositionNotFound, fmt.Sprintf("id: %d", positionId))
+		return math.Int{}, math.Int{}, nil, errorsmod.Wrap(types.ErrPositionNotFound, fmt.Sprintf("id: %d", positionId))
 	}
 
 	// Checks if the msg sender is the same as the current owner
 	if sender.String() != position.Address {
-		return math.Int{}, math.Int{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+		return math.Int{}, math.Int{}, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
 	// Check if withdrawing negative amount
 	if liquidity.IsNegative() {
-		return math.Int{}, math.Int{}, types.ErrNegativeTokenAmount
+		return math.Int{}, math.Int{}, nil, types.ErrNegativeTokenAmount
 	}
 
 	if position.Liquidity.LT(liquidity) {
-		return math.Int{}, math.Int{}, types.ErrInsufficientLiquidity
+		return math.Int{}, math.Int{}, nil, types.ErrInsufficientLiquidity
 	}
 
 	pool, found := k.GetPool(ctx, position.PoolId)
 	if !found {
-		return math.Int{}, math.Int{}, errorsmod.Wrapf(types.ErrPoolNotFound, "pool id: %d", position.PoolId)
+		return math.Int{}, math.Int{}, nil, errorsmod.Wrapf(types.ErrPoolNotFound, "pool id: %d", position.PoolId)
 	}
 
 	liquiditDelta := liquidity.Neg()
 	amountBase, amountQuote, lowerTickEmpty, upperTickEmpty, err := k.UpdatePosition(ctx, position.PoolId, sender, position.LowerTick, position.UpperTick, liquiditDelta, positionId)
 	if err != nil {
-		return math.Int{}, math.Int{}, err
+		return math.Int{}, math.Int{}, nil, err
 	}
 
 	coins := sdk.Coins{sdk.NewCoin(pool.DenomBase, amountBase.Abs())}
 	coins = coins.Add(sdk.NewCoin(pool.DenomQuote, amountQuote.Abs()))
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
-		return math.Int{}, math.Int{}, err
+		return math.Int{}, math.Int{}, nil, err
 	}
 
 	// refund the liquidity to the sender
 	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, coins)
 	if err != nil {
-		return math.Int{}, math.Int{}, err
+		return math.Int{}, math.Int{}, nil, err
 	}
 
 	if liquidity.Equal(position.Liquidity) {
 		// Collect fees
-		if _, err := k.collectFees(ctx, sender, positionId); err != nil {
-			return math.Int{}, math.Int{}, err
+		feesCollected, err = k.collectFees(ctx, sender, positionId)
+		if err != nil {
+			return math.Int{}, math.Int{}, nil, err
+		}
+		if _, err := k.removeIncentiveAccumulation(ctx, position); err != nil {
+			return math.Int{}, math.Int{}, nil, err
 		}
 		k.RemovePosition(ctx, position.Id)
 
@@ -252,7 +271,7 @@ func (k Keeper) DecreaseLiquidity(ctx sdk.Context, sender sdk.AccAddress, positi
 	if upperTickEmpty {
 		k.RemoveTickInfo(ctx, position.PoolId, position.UpperTick)
 	}
-	return amountBase.Abs(), amountQuote.Abs(), nil
+	return amountBase.Abs(), amountQuote.Abs(), feesCollected, nil
 }
 
 func (k msgServer) DecreaseLiquidity(goCtx context.Context, msg *types.MsgDecreaseLiquidity) (*types.MsgDecreaseLiquidityResponse, error) {
@@ -343,6 +362,8 @@ func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return math.Int{}, math.Int{}, false, false, err
 	}
 
+	// Record the liquidity before it changes
+	k.writeTwapObservation(ctx, pool)
 	pool.UpdateLiquidityIfActivePosition(ctx, lowerTick, upperTick, liquidityDelta)
 
 	k.SetPool(ctx, pool)
@@ -352,5 +373,10 @@ func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return math.Int{}, math.Int{}, false, false, err
 	}
 
+	// update incentive accumulation
+	if err := k.setIncentiveAccumulation(ctx, position); err != nil {
+		return math.Int{}, math.Int{}, false, false, err
+	}
+
 	return actualAmountBase.TruncateInt(), actualAmountQuote.TruncateInt(), lowerTickIsEmpty, upperTickIsEmpty, nil
 }
@@ -13,17 +13,17 @@ import (
 )
 
 func TestMsgServerCreatePosition(t *testing.T) {
-	_, bk, srv, ctx := setupMsgServer(t)
+	k, bk, srv, ctx := setupMsgServer(t)
 	wctx := sdk.UnwrapSDKContext(ctx)
 
 	sender := sdk.AccAddress("sender")
 	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-		Authority:  sender.String(),
+		Sender:     k.GetAuthority(),
 		DenomBase:  "base",
 		DenomQuote: "quote",
 		FeeRate:    "0.01",
 		PriceRatio: "1.0001",
-		BaseOffset: "0.5",
+		BaseOffset: "0",
 	})
 	require.NoError(t, err)
 
@@ -43,9 +43,9 @@ func TestMsgServerCreatePosition(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, resp.Id, uint64(0))
-	require.Equal(t, resp.AmountBase.String(), "10001")
+	require.Equal(t, resp.AmountBase.String(), "10000")
 	require.Equal(t, resp.AmountQuote.String(), "0")
-	require.Equal(t, resp.Liquidity.String(), "200020000.062502249619530703")
+	require.Equal(t, resp.Liquidity.String(), "200014999.875006625721874765")
 
 	// Create 2nd position with same tick
 	resp, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
@@ -60,9 +60,9 @@ func TestMsgServerCreatePosition(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, resp.Id, uint64(1))
-	require.Equal(t, resp.AmountBase.String(), "10001")
+	require.Equal(t, resp.AmountBase.String(), "10000")
 	require.Equal(t, resp.AmountQuote.String(), "0")
-	require.Equal(t, resp.Liquidity.String(), "200020000.062502249619530703")
+	require.Equal(t, resp.Liquidity.String(), "200014999.875006625721874765")
 
 	// Create 3rd position with different tick
 	resp, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
@@ -78,8 +78,8 @@ func TestMsgServerCreatePosition(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp.Id, uint64(2))
 	require.Equal(t, resp.AmountBase.String(), "10000")
-	require.Equal(t, resp.AmountQuote.String(), "9048")
-	require.Equal(t, resp.Liquidity.String(), "19053571.850177307210510444")
+	require.Equal(t, resp.AmountQuote.String(), "10000")
+	require.Equal(t, resp.Liquidity.String(), "20006000.399980000000000020")
 }
 
 func TestMsgServerIncreaseLiquidity(t *testing.T) {
@@ -128,19 +128,19 @@ func TestMsgServerIncreaseLiquidity(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			_, bk, srv, ctx := setupMsgServer(t)
+			k, bk, srv, ctx := setupMsgServer(t)
 			wctx := sdk.UnwrapSDKContext(ctx)
 
 			bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-				Authority:  sender,
+				Sender:     k.GetAuthority(),
 				DenomBase:  "base",
 				DenomQuote: "quote",
 				FeeRate:    "0.01",
 				PriceRatio: "1.0001",
-				BaseOffset: "0.5",
+				BaseOffset: "0",
 			})
 			require.NoError(t, err)
 
@@ -162,7 +162,7 @@ func TestMsgServerIncreaseLiquidity(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, resp.AmountBase.String(), "110000")
-				require.Equal(t, resp.AmountQuote.String(), "99527")
+				require.Equal(t, resp.AmountQuote.String(), "109999")
 				require.Equal(t, resp.PositionId, uint64(1))
 			}
 		})
@@ -171,19 +171,19 @@ func TestMsgServerIncreaseLiquidity(t *testing.T) {
 
 func TestMsgServerDecreaseLiquidity(t *testing.T) {
 	sender := "sunrise126ss57ayztn5287spvxq0dpdfarj6rk0v3p06f"
-	_, bk, srv, ctx := setupMsgServer(t)
+	k, bk, srv, ctx := setupMsgServer(t)
 	wctx := sdk.UnwrapSDKContext(ctx)
 
 	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-		Authority:  sender,
+		Sender:     k.GetAuthority(),
 		DenomBase:  "base",
 		DenomQuote: "quote",
 		FeeRate:    "0.01",
 		PriceRatio: "1.0001",
-		BaseOffset: "0.5",
+		BaseOffset: "0",
 	})
 	require.NoError(t, err)
 
@@ -239,7 +239,7 @@ func TestMsgServerDecreaseLiquidity(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, res.AmountBase.String(), "10000")
-				require.Equal(t, res.AmountQuote.String(), "9047")
+				require.Equal(t, res.AmountQuote.String(), "9999")
 			}
 		})
 	}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/binary"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
 
@@ -57,6 +59,9 @@ func (k Keeper) SetPool(ctx context.Context, pool types.Pool) {
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolKey))
 	b := k.cdc.MustMarshal(&pool)
 	store.Set(GetPoolIDBytes(pool.Id), b)
+
+	store = prefix.NewStore(storeAdapter, types.PoolByDenomsPrefix(pool.DenomBase, pool.DenomQuote))
+	store.Set([]byte(pool.FeeRate.String()), sdk.Uint64ToBigEndian(pool.Id))
 }
 
 // GetPool returns a pool from its id
@@ -71,11 +76,51 @@ func (k Keeper) GetPool(ctx context.Context, id uint64) (val types.Pool, found b
 	return val, true
 }
 
+// GetPoolByDenomsAndFeeRate returns a pool from its denoms in either order and fee rate
+func (k Keeper) GetPoolByDenomsAndFeeRate(ctx context.Context, denomBase, denomQuote string, feeRate math.LegacyDec) (val types.Pool, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolByDenomsPrefix(denomBase, denomQuote))
+	bz := store.Get([]byte(feeRate.String()))
+	if bz == nil {
+		return val, false
+	}
+	return k.GetPool(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetPoolsByDenoms returns all pools of the denom pair in either order
+func (k Keeper) GetPoolsByDenoms(ctx context.Context, denomBase, denomQuote string) []types.Pool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolByDenomsPrefix(denomBase, denomQuote))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	pools := []types.Pool{}
+	for ; iterator.Valid(); iterator.Next() {
+		pool, found := k.GetPool(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
+
 // RemovePool removes a pool from the store
 func (k Keeper) RemovePool(ctx context.Context, id uint64) {
+	pool, found := k.GetPool(ctx, id)
+	if !found {
+		return
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolKey))
 	store.Delete(GetPoolIDBytes(id))
+
+	store = prefix.NewStore(storeAdapter, types.PoolByDenomsPrefix(pool.DenomBase, pool.DenomQuote))
+	indexKey := []byte(pool.FeeRate.String())
+	if bz := store.Get(indexKey); bz != nil && sdk.BigEndianToUint64(bz) == id {
+		store.Delete(indexKey)
+	}
 }
 
 // GetAllPools returns all pool
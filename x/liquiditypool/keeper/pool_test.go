@@ -22,7 +22,7 @@ func createNPool(keeper keeper.Keeper, ctx context.Context, n int) []types.Pool
 			FeeRate:    math.LegacyNewDecWithPrec(1, 2),
 			TickParams: types.TickParams{
 				PriceRatio: math.LegacyNewDecWithPrec(10001, 4),
-				BaseOffset: math.LegacyNewDecWithPrec(5, 1),
+				BaseOffset: math.LegacyZeroDec(),
 			},
 			CurrentTick:          0,
 			CurrentTickLiquidity: math.LegacyOneDec(),
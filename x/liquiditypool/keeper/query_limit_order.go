@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LimitOrder(ctx context.Context, req *types.QueryLimitOrderRequest) (*types.QueryLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	order, found := k.GetLimitOrder(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryLimitOrderResponse{LimitOrder: order}, nil
+}
+
+func (k Keeper) PoolLimitOrders(ctx context.Context, req *types.QueryPoolLimitOrdersRequest) (*types.QueryPoolLimitOrdersResponse, error) {
+	if req == nil || (req.OnlyOpen && req.OnlyFilled) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	orders := filterLimitOrders(k.GetLimitOrdersByPool(ctx, req.PoolId), req.OnlyOpen, req.OnlyFilled)
+
+	return &types.QueryPoolLimitOrdersResponse{LimitOrders: orders}, nil
+}
+
+func (k Keeper) AddressLimitOrders(ctx context.Context, req *types.QueryAddressLimitOrdersRequest) (*types.QueryAddressLimitOrdersResponse, error) {
+	if req == nil || (req.OnlyOpen && req.OnlyFilled) {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	orders := filterLimitOrders(k.GetLimitOrdersByAddress(ctx, req.Address), req.OnlyOpen, req.OnlyFilled)
+
+	return &types.QueryAddressLimitOrdersResponse{LimitOrders: orders}, nil
+}
+
+func filterLimitOrders(orders []types.LimitOrder, onlyOpen, onlyFilled bool) []types.LimitOrder {
+	filtered := []types.LimitOrder{}
+	for _, order := range orders {
+		if (onlyOpen && order.Filled) || (onlyFilled && !order.Filled) {
+			continue
+		}
+		filtered = append(filtered, order)
+	}
+	return filtered
+}
@@ -61,3 +61,16 @@ func (k Keeper) Pool(ctx context.Context, req *types.QueryPoolRequest) (*types.Q
 
 	return &types.QueryPoolResponse{Pool: k.WrapPoolInfo(ctx, pool)}, nil
 }
+
+func (k Keeper) PoolsByDenoms(ctx context.Context, req *types.QueryPoolsByDenomsRequest) (*types.QueryPoolsByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pools []types.PoolInfo
+	for _, pool := range k.GetPoolsByDenoms(ctx, req.DenomBase, req.DenomQuote) {
+		pools = append(pools, k.WrapPoolInfo(ctx, pool))
+	}
+
+	return &types.QueryPoolsByDenomsResponse{Pools: pools}, nil
+}
@@ -109,3 +109,47 @@ func TestPoolQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestPoolQueryByDenoms(t *testing.T) {
+	keeper, bk, ctx := keepertest.LiquiditypoolKeeper(t)
+
+	bk.EXPECT().GetBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(sdk.Coin{}).AnyTimes()
+
+	msgs := createNPool(keeper, ctx, 1)
+	tests := []struct {
+		desc     string
+		request  *types.QueryPoolsByDenomsRequest
+		response *types.QueryPoolsByDenomsResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryPoolsByDenomsRequest{DenomBase: "base", DenomQuote: "quote"},
+			response: &types.QueryPoolsByDenomsResponse{Pools: []types.PoolInfo{{Pool: msgs[0]}}},
+		},
+		{
+			desc:     "Reversed",
+			request:  &types.QueryPoolsByDenomsRequest{DenomBase: "quote", DenomQuote: "base"},
+			response: &types.QueryPoolsByDenomsResponse{Pools: []types.PoolInfo{{Pool: msgs[0]}}},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PoolsByDenoms(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, response.Pools, len(tc.response.Pools))
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Twap(ctx context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	price, averageLiquidity, err := k.GetTwap(sdk.UnwrapSDKContext(ctx), req.PoolId, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{Price: price, AverageLiquidity: averageLiquidity}, nil
+}
@@ -6,6 +6,7 @@ import (
 	db "github.com/cometbft/cometbft-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
@@ -19,6 +20,7 @@ type SwapState struct {
 	liquidity                       math.LegacyDec
 	globalFeeGrowthPerUnitLiquidity math.LegacyDec
 	globalFeeGrowth                 math.LegacyDec
+	incentiveAccumulation           math.LegacyDec
 	swapHelper                      SwapHelper
 }
 
@@ -39,6 +41,7 @@ func newSwapState(specifiedAmount math.Int, p types.Pool, strategy SwapHelper) S
 		liquidity:                       p.CurrentTickLiquidity,
 		globalFeeGrowthPerUnitLiquidity: math.LegacyZeroDec(),
 		globalFeeGrowth:                 math.LegacyZeroDec(),
+		incentiveAccumulation:           math.LegacyZeroDec(),
 		swapHelper:                      strategy,
 	}
 }
@@ -55,8 +58,11 @@ type PoolUpdates struct {
 	NewSqrtPrice   math.LegacyDec
 }
 
-func (ss *SwapState) updateFeeGrowthGlobal(feeChargeTotal math.LegacyDec) (math.LegacyDec, error) {
-	feeChargeTotalScaled := feeChargeTotal
+// updateFeeGrowthGlobal accrues the fee charge to the swap state.
+// The treasury tax portion of the fee is excluded from the growth distributed to liquidity providers,
+// which is also the incentive accumulation of the positions in range.
+func (ss *SwapState) updateFeeGrowthGlobal(feeChargeTotal math.LegacyDec, treasuryTaxRate math.LegacyDec) (math.LegacyDec, error) {
+	feeChargeTotalScaled := feeChargeTotal.Mul(math.LegacyOneDec().Sub(treasuryTaxRate))
 	ss.globalFeeGrowth = ss.globalFeeGrowth.Add(feeChargeTotal)
 
 	if ss.liquidity.IsZero() {
@@ -66,6 +72,7 @@ func (ss *SwapState) updateFeeGrowthGlobal(feeChargeTotal math.LegacyDec) (math.
 	feeRatesAccruedPerUnitOfLiquidityScaled := feeChargeTotalScaled.QuoTruncate(ss.liquidity)
 
 	ss.globalFeeGrowthPerUnitLiquidity.AddMut(feeRatesAccruedPerUnitOfLiquidityScaled)
+	ss.incentiveAccumulation.AddMut(feeChargeTotalScaled)
 
 	return feeRatesAccruedPerUnitOfLiquidityScaled, nil
 }
@@ -78,24 +85,41 @@ func (k Keeper) SwapExactAmountIn(
 	denomOut string,
 	feeEnabled bool,
 ) (amountOut math.Int, err error) {
+	_, amountOut, err = k.SwapExactAmountInWithPriceLimit(ctx, sender, pool, tokenIn, denomOut, feeEnabled, unboundedPriceLimit)
+	return amountOut, err
+}
+
+// SwapExactAmountInWithPriceLimit swaps tokenIn until the spot price of the pool reaches priceLimit,
+// a zero priceLimit meaning no limit. The part of tokenIn left once the limit is reached is not swapped,
+// and amountIn is the part actually swapped.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.Pool,
+	tokenIn sdk.Coin,
+	denomOut string,
+	feeEnabled bool,
+	priceLimit math.LegacyDec,
+) (amountIn math.Int, amountOut math.Int, err error) {
 	if tokenIn.Denom == denomOut {
-		return math.Int{}, types.ErrDenomDuplication
+		return math.Int{}, math.Int{}, types.ErrDenomDuplication
 	}
 
 	baseForQuote := isBaseForQuote(tokenIn.Denom, pool.DenomBase)
 
-	priceLimit := GetPriceLimit(baseForQuote)
+	if priceLimit.IsZero() {
+		priceLimit = GetPriceLimit(baseForQuote)
+	}
 	feeRate := math.LegacyZeroDec()
 	if feeEnabled {
 		feeRate = pool.FeeRate
 	}
 	tokenIn, tokenOut, _, err := k.swapOutAmtGivenIn(ctx, sender, pool, tokenIn, denomOut, feeRate, priceLimit)
 	if err != nil {
-		return math.Int{}, err
+		return math.Int{}, math.Int{}, err
 	}
-	amountOut = tokenOut.Amount
 
-	return amountOut, nil
+	return tokenIn.Amount, tokenOut.Amount, nil
 }
 
 func (k Keeper) SwapExactAmountOut(
@@ -105,6 +129,20 @@ func (k Keeper) SwapExactAmountOut(
 	tokenOut sdk.Coin,
 	denomIn string,
 	feeEnabled bool,
+) (amountIn math.Int, err error) {
+	return k.SwapExactAmountOutWithPriceLimit(ctx, sender, pool, tokenOut, denomIn, feeEnabled, unboundedPriceLimit)
+}
+
+// SwapExactAmountOutWithPriceLimit swaps for tokenOut unless the spot price of the pool reaches priceLimit
+// before, a zero priceLimit meaning no limit.
+func (k Keeper) SwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.Pool,
+	tokenOut sdk.Coin,
+	denomIn string,
+	feeEnabled bool,
+	priceLimit math.LegacyDec,
 ) (amountIn math.Int, err error) {
 	if tokenOut.Denom == denomIn {
 		return math.Int{}, types.ErrDenomDuplication
@@ -112,15 +150,21 @@ func (k Keeper) SwapExactAmountOut(
 
 	baseForQuote := isBaseForQuote(denomIn, pool.DenomBase)
 
-	priceLimit := GetPriceLimit(baseForQuote)
+	limited := !priceLimit.IsZero()
+	if !limited {
+		priceLimit = GetPriceLimit(baseForQuote)
+	}
 	feeRate := math.LegacyZeroDec()
 	if feeEnabled {
 		feeRate = pool.FeeRate
 	}
-	tokenIn, tokenOut, _, err := k.swapInAmtGivenOut(ctx, sender, pool, tokenOut, denomIn, feeRate, priceLimit)
+	tokenIn, swappedTokenOut, _, err := k.swapInAmtGivenOut(ctx, sender, pool, tokenOut, denomIn, feeRate, priceLimit)
 	if err != nil {
 		return math.Int{}, err
 	}
+	if limited && swappedTokenOut.Amount.LT(tokenOut.Amount) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrPriceLimitReached, "%s out of %s", swappedTokenOut, tokenOut)
+	}
 	amountIn = tokenIn.Amount
 
 	return amountIn, nil
@@ -276,6 +320,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	}
 
 	swapState := newSwapState(minTokenIn.Amount, p, swapHelper)
+	treasuryTaxRate := k.GetParams(ctx).SwapTreasuryTaxRate
 
 	nextInitTickIter := swapHelper.NextTickIterator(ctx, k.storeService, poolId, swapState.tick)
 	defer nextInitTickIter.Close()
@@ -301,7 +346,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		}
 
 		if updateAccumulators {
-			_, err := swapState.updateFeeGrowthGlobal(feeCharge)
+			_, err := swapState.updateFeeGrowthGlobal(feeCharge, treasuryTaxRate)
 			if err != nil {
 				return SwapResult{}, PoolUpdates{}, err
 			}
@@ -341,6 +386,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	if updateAccumulators {
 		feeGrowth := sdk.DecCoin{Denom: minTokenIn.Denom, Amount: swapState.globalFeeGrowthPerUnitLiquidity}
 		k.AddToAccumulator(ctx, feeAccumulator, sdk.NewDecCoins(feeGrowth))
+		k.addPoolIncentiveAccumulation(ctx, poolId, sdk.NewDecCoins(sdk.NewDecCoinFromDec(minTokenIn.Denom, swapState.incentiveAccumulation)))
 	}
 
 	amountIn := minTokenIn.Amount.ToLegacyDec().SubMut(swapState.amountSpecifiedRemaining).Ceil().TruncateInt()
@@ -373,6 +419,7 @@ func (k Keeper) computeInAmtGivenOut(
 	}
 
 	swapState := newSwapState(desiredTokenOut.Amount, p, swapHelper)
+	treasuryTaxRate := k.GetParams(ctx).SwapTreasuryTaxRate
 
 	nextInitTickIter := swapHelper.NextTickIterator(ctx, k.storeService, poolId, swapState.tick)
 	defer nextInitTickIter.Close()
@@ -398,7 +445,7 @@ func (k Keeper) computeInAmtGivenOut(
 		}
 
 		if updateAccumulators {
-			_, err := swapState.updateFeeGrowthGlobal(feeChargeTotal)
+			_, err := swapState.updateFeeGrowthGlobal(feeChargeTotal, treasuryTaxRate)
 			if err != nil {
 				return SwapResult{}, PoolUpdates{}, err
 			}
@@ -437,6 +484,7 @@ func (k Keeper) computeInAmtGivenOut(
 
 	if updateAccumulators {
 		k.AddToAccumulator(ctx, feeAccumulator, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomIn, swapState.globalFeeGrowthPerUnitLiquidity)))
+		k.addPoolIncentiveAccumulation(ctx, poolId, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomIn, swapState.incentiveAccumulation)))
 	}
 
 	amountIn := swapState.amountCalculated.Ceil().TruncateInt()
@@ -500,6 +548,10 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	// The treasury tax is carved out of the swap fee
+	treasuryTax := sdk.NewCoin(swapDetails.TokenIn.Denom, totalFees.Mul(k.GetParams(ctx).SwapTreasuryTaxRate).TruncateInt())
+	feeRatesRoundedUp = feeRatesRoundedUp.Sub(treasuryTax)
+
 	if !feeRatesRoundedUp.IsZero() {
 		err = k.bankKeeper.SendCoins(ctx, swapDetails.Sender, pool.GetFeesAddress(), sdk.Coins{feeRatesRoundedUp})
 		if err != nil {
@@ -507,6 +559,13 @@ func (k Keeper) updatePoolForSwap(
 		}
 	}
 
+	if !treasuryTax.IsZero() {
+		err = k.bankKeeper.SendCoins(ctx, swapDetails.Sender, types.TreasuryAddress(), sdk.Coins{treasuryTax})
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, swapDetails.TokenOut); err != nil {
 		return err
 	}
@@ -516,6 +575,10 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	// Record the price before it changes
+	k.writeTwapObservation(ctx, pool)
+
+	currentTick := pool.CurrentTick
 	err = pool.ApplySwap(poolUpdates.NewLiquidity, poolUpdates.NewCurrentTick, poolUpdates.NewSqrtPrice)
 	if err != nil {
 		return fmt.Errorf("error applying swap: %w", err)
@@ -523,7 +586,7 @@ func (k Keeper) updatePoolForSwap(
 
 	k.SetPool(ctx, pool)
 
-	return err
+	return k.fillLimitOrders(ctx, pool.Id, currentTick, pool.CurrentTick)
 }
 
 func isBaseForQuote(inDenom, assetBase string) bool {
@@ -46,24 +46,24 @@ func TestSwapExactAmountIn_SinglePosition(t *testing.T) {
 			tokenIn:      sdk.NewInt64Coin("base", 100000),
 			denomOut:     "quote",
 			feeEnabled:   false,
-			expAmountOut: math.NewInt(99994),
-			expTickIndex: -2,
+			expAmountOut: math.NewInt(99995),
+			expTickIndex: -1,
 		},
 		{
 			desc:         "Quote to base",
 			tokenIn:      sdk.NewInt64Coin("base", 100000),
 			denomOut:     "quote",
 			feeEnabled:   false,
-			expAmountOut: math.NewInt(99994),
-			expTickIndex: -2,
+			expAmountOut: math.NewInt(99995),
+			expTickIndex: -1,
 		},
 		{
 			desc:         "Fee enabled",
 			tokenIn:      sdk.NewInt64Coin("base", 100000),
 			denomOut:     "quote",
 			feeEnabled:   true,
-			expAmountOut: math.NewInt(98994),
-			expTickIndex: -2,
+			expAmountOut: math.NewInt(98995),
+			expTickIndex: -1,
 		},
 		{
 			desc:       "Ran out of ticks",
@@ -106,12 +106,12 @@ func TestSwapExactAmountIn_SinglePosition(t *testing.T) {
 			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-				Authority:  sender.String(),
+				Sender:     k.GetAuthority(),
 				DenomBase:  "base",
 				DenomQuote: "quote",
 				FeeRate:    "0.01",
 				PriceRatio: "1.0001",
-				BaseOffset: "0.5",
+				BaseOffset: "0",
 			})
 			require.NoError(t, err)
 
@@ -145,6 +145,154 @@ func TestSwapExactAmountIn_SinglePosition(t *testing.T) {
 	}
 }
 
+func TestSwapExactAmountIn_TreasuryTax(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+	k, bk, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	// 1% treasury tax on the 1% swap fee of 100000
+	bk.EXPECT().SendCoins(gomock.Any(), sender, types.TreasuryAddress(), sdk.NewCoins(sdk.NewInt64Coin("base", 10))).Return(nil).Times(1)
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
+		Sender:         sender.String(),
+		PoolId:         0,
+		LowerTick:      -10,
+		UpperTick:      10,
+		TokenBase:      sdk.NewInt64Coin("base", 1000000),
+		TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+		MinAmountBase:  math.NewInt(0),
+		MinAmountQuote: math.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	pool, found := k.GetPool(ctx, 0)
+	require.True(t, found)
+
+	_, err = k.SwapExactAmountIn(wctx, sender, pool, sdk.NewInt64Coin("base", 100000), "quote", true)
+	require.NoError(t, err)
+}
+
+func TestSwapWithPriceLimit(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+
+	tests := []struct {
+		desc         string
+		exactOut     bool
+		token        sdk.Coin
+		denom        string
+		priceLimit   math.LegacyDec
+		expAmountIn  math.Int
+		expAmountOut math.Int
+		err          error
+	}{
+		{
+			desc:         "Exact in filled within limit",
+			token:        sdk.NewInt64Coin("base", 100000),
+			denom:        "quote",
+			priceLimit:   math.LegacyMustNewDecFromStr("0.999"),
+			expAmountIn:  math.NewInt(100000),
+			expAmountOut: math.NewInt(98995),
+		},
+		{
+			desc:         "Exact in partially filled up to limit",
+			token:        sdk.NewInt64Coin("base", 1000000),
+			denom:        "quote",
+			priceLimit:   math.LegacyMustNewDecFromStr("0.9995"),
+			expAmountIn:  math.NewInt(505392),
+			expAmountOut: math.NewInt(500212),
+		},
+		{
+			desc:       "Exact in limit on the wrong side",
+			token:      sdk.NewInt64Coin("base", 100000),
+			denom:      "quote",
+			priceLimit: math.LegacyMustNewDecFromStr("1.001"),
+			err:        types.ErrInvalidSqrtPrice,
+		},
+		{
+			desc:         "Exact out filled within limit",
+			exactOut:     true,
+			token:        sdk.NewInt64Coin("quote", 98994),
+			denom:        "base",
+			priceLimit:   math.LegacyMustNewDecFromStr("0.999"),
+			expAmountIn:  math.NewInt(99999),
+			expAmountOut: math.NewInt(98994),
+		},
+		{
+			desc:       "Exact out reaching limit",
+			exactOut:   true,
+			token:      sdk.NewInt64Coin("quote", 1000000),
+			denom:      "base",
+			priceLimit: math.LegacyMustNewDecFromStr("0.9995"),
+			err:        types.ErrPriceLimitReached,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, bk, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
+				Sender:     k.GetAuthority(),
+				DenomBase:  "base",
+				DenomQuote: "quote",
+				FeeRate:    "0.01",
+				PriceRatio: "1.0001",
+				BaseOffset: "0",
+			})
+			require.NoError(t, err)
+
+			_, err = srv.CreatePosition(wctx, &types.MsgCreatePosition{
+				Sender:         sender.String(),
+				PoolId:         0,
+				LowerTick:      -10,
+				UpperTick:      10,
+				TokenBase:      sdk.NewInt64Coin("base", 1000000),
+				TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+				MinAmountBase:  math.NewInt(0),
+				MinAmountQuote: math.NewInt(0),
+			})
+			require.NoError(t, err)
+
+			pool, found := k.GetPool(ctx, 0)
+			require.True(t, found)
+
+			var amountIn, amountOut math.Int
+			if tc.exactOut {
+				amountOut = tc.token.Amount
+				amountIn, err = k.SwapExactAmountOutWithPriceLimit(wctx, sender, pool, tc.token, tc.denom, true, tc.priceLimit)
+			} else {
+				amountIn, amountOut, err = k.SwapExactAmountInWithPriceLimit(wctx, sender, pool, tc.token, tc.denom, true, tc.priceLimit)
+			}
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expAmountIn.String(), amountIn.String())
+			require.Equal(t, tc.expAmountOut.String(), amountOut.String())
+
+			pool, found = k.GetPool(ctx, 0)
+			require.True(t, found)
+			require.True(t, pool.CurrentSqrtPrice.Mul(pool.CurrentSqrtPrice).GTE(tc.priceLimit.Sub(math.LegacyNewDecWithPrec(1, 8))))
+		})
+	}
+}
+
 func TestSwapExactAmountIn_MultiplePositions(t *testing.T) {
 	sender := sdk.AccAddress("sender")
 
@@ -162,8 +310,8 @@ func TestSwapExactAmountIn_MultiplePositions(t *testing.T) {
 			tokenIn:      sdk.NewInt64Coin("base", 110000),
 			denomOut:     "quote",
 			feeEnabled:   false,
-			expAmountOut: math.NewInt(109947),
-			expTickIndex: -11,
+			expAmountOut: math.NewInt(109949),
+			expTickIndex: -10,
 		},
 		{
 			desc:       "Ran out of ticks",
@@ -182,12 +330,12 @@ func TestSwapExactAmountIn_MultiplePositions(t *testing.T) {
 			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-				Authority:  sender.String(),
+				Sender:     k.GetAuthority(),
 				DenomBase:  "base",
 				DenomQuote: "quote",
 				FeeRate:    "0.01",
 				PriceRatio: "1.0001",
-				BaseOffset: "0.5",
+				BaseOffset: "0",
 			})
 			require.NoError(t, err)
 
@@ -250,7 +398,7 @@ func TestSwapExactAmountOut_SinglePosition(t *testing.T) {
 			tokenOut:     sdk.NewInt64Coin("base", 100000),
 			denomIn:      "quote",
 			feeEnabled:   false,
-			expAmountIn:  math.NewInt(100006),
+			expAmountIn:  math.NewInt(100005),
 			expTickIndex: 0,
 		},
 		{
@@ -258,7 +406,7 @@ func TestSwapExactAmountOut_SinglePosition(t *testing.T) {
 			tokenOut:     sdk.NewInt64Coin("base", 100000),
 			denomIn:      "quote",
 			feeEnabled:   false,
-			expAmountIn:  math.NewInt(100006),
+			expAmountIn:  math.NewInt(100005),
 			expTickIndex: 0,
 		},
 		{
@@ -266,7 +414,7 @@ func TestSwapExactAmountOut_SinglePosition(t *testing.T) {
 			tokenOut:     sdk.NewInt64Coin("base", 100000),
 			denomIn:      "quote",
 			feeEnabled:   true,
-			expAmountIn:  math.NewInt(101017),
+			expAmountIn:  math.NewInt(101016),
 			expTickIndex: 0,
 		},
 		{
@@ -310,12 +458,12 @@ func TestSwapExactAmountOut_SinglePosition(t *testing.T) {
 			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-				Authority:  sender.String(),
+				Sender:     k.GetAuthority(),
 				DenomBase:  "base",
 				DenomQuote: "quote",
 				FeeRate:    "0.01",
 				PriceRatio: "1.0001",
-				BaseOffset: "0.5",
+				BaseOffset: "0",
 			})
 			require.NoError(t, err)
 
@@ -366,7 +514,7 @@ func TestSwapExactAmountOut_MultiplePositions(t *testing.T) {
 			tokenOut:     sdk.NewInt64Coin("base", 110000),
 			denomIn:      "quote",
 			feeEnabled:   false,
-			expAmountIn:  math.NewInt(110053),
+			expAmountIn:  math.NewInt(110051),
 			expTickIndex: 9,
 		},
 		{
@@ -386,12 +534,12 @@ func TestSwapExactAmountOut_MultiplePositions(t *testing.T) {
 			bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			_, err := srv.CreatePool(wctx, &types.MsgCreatePool{
-				Authority:  sender.String(),
+				Sender:     k.GetAuthority(),
 				DenomBase:  "base",
 				DenomQuote: "quote",
 				FeeRate:    "0.01",
 				PriceRatio: "1.0001",
-				BaseOffset: "0.5",
+				BaseOffset: "0",
 			})
 			require.NoError(t, err)
 
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+// SetTwapRing set the state of the observation ring buffer of a pool
+func (k Keeper) SetTwapRing(ctx context.Context, ring types.TwapRing) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TwapRingKey))
+	b := k.cdc.MustMarshal(&ring)
+	store.Set(sdk.Uint64ToBigEndian(ring.PoolId), b)
+}
+
+// GetTwapRing returns the state of the observation ring buffer of a pool
+func (k Keeper) GetTwapRing(ctx context.Context, poolId uint64) (val types.TwapRing, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TwapRingKey))
+	b := store.Get(sdk.Uint64ToBigEndian(poolId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTwapRings returns the ring buffer states of all pools
+func (k Keeper) GetAllTwapRings(ctx context.Context) (list []types.TwapRing) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TwapRingKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TwapRing
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetTwapObservation set an observation in its slot of the ring buffer
+func (k Keeper) SetTwapObservation(ctx context.Context, observation types.TwapObservation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TwapObservationPrefix(observation.PoolId))
+	b := k.cdc.MustMarshal(&observation)
+	store.Set(sdk.Uint64ToBigEndian(observation.Index), b)
+}
+
+// GetTwapObservation returns the observation in a slot of the ring buffer
+func (k Keeper) GetTwapObservation(ctx context.Context, poolId, index uint64) (val types.TwapObservation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TwapObservationPrefix(poolId))
+	b := store.Get(sdk.Uint64ToBigEndian(index))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTwapObservation removes the observation in a slot of the ring buffer
+func (k Keeper) RemoveTwapObservation(ctx context.Context, poolId, index uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TwapObservationPrefix(poolId))
+	store.Delete(sdk.Uint64ToBigEndian(index))
+}
+
+// GetAllTwapObservations returns the observations of all pools
+func (k Keeper) GetAllTwapObservations(ctx context.Context) (list []types.TwapObservation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TwapObservationKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TwapObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTwapObservationsByPool returns the observations of a pool from the oldest to the latest
+func (k Keeper) GetTwapObservationsByPool(ctx context.Context, poolId uint64) []types.TwapObservation {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TwapObservationPrefix(poolId))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.TwapObservation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TwapObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Time.Before(list[j].Time)
+	})
+	return list
+}
+
+// writeTwapObservation records the state of the pool since the latest observation.
+// It must be called before the price or the liquidity of the pool changes and
+// records at most one observation per block. Idle pools are not observed; their
+// cumulative values are extrapolated from the latest observation at query time.
+func (k Keeper) writeTwapObservation(ctx sdk.Context, pool types.Pool) {
+	now := ctx.BlockTime()
+	ring, found := k.GetTwapRing(ctx, pool.Id)
+	if !found {
+		k.SetTwapObservation(ctx, types.NewTwapObservation(pool.Id, now))
+		k.SetTwapRing(ctx, types.TwapRing{PoolId: pool.Id, LastIndex: 0, Length: 1})
+		return
+	}
+
+	last, found := k.GetTwapObservation(ctx, pool.Id, ring.LastIndex)
+	if !found || !now.After(last.Time) {
+		return
+	}
+
+	maxObservations := k.GetParams(ctx).TwapObservations
+	if maxObservations == 0 {
+		return
+	}
+
+	// Drop the slots out of the ring buffer if it has been shrunk
+	if ring.Length > maxObservations {
+		for index := maxObservations; index < ring.Length; index++ {
+			k.RemoveTwapObservation(ctx, pool.Id, index)
+		}
+		ring.Length = maxObservations
+	}
+
+	observation := last.Accumulate(pool.CurrentTick, pool.CurrentTickLiquidity, now)
+	observation.Index = (ring.LastIndex + 1) % maxObservations
+	k.SetTwapObservation(ctx, observation)
+
+	ring.LastIndex = observation.Index
+	if ring.Length < maxObservations {
+		ring.Length++
+	}
+	k.SetTwapRing(ctx, ring)
+}
+
+// getTwapObservationAt returns the cumulative values of the pool at time t
+// by interpolating the observations around it.
+func (k Keeper) getTwapObservationAt(pool types.Pool, observations []types.TwapObservation, t time.Time) (types.TwapObservation, error) {
+	if len(observations) == 0 || t.Before(observations[0].Time) {
+		return types.TwapObservation{}, errorsmod.Wrapf(types.ErrTwapNotAvailable, "no observation before %s", t)
+	}
+
+	// index of the first observation after t
+	i := sort.Search(len(observations), func(i int) bool {
+		return observations[i].Time.After(t)
+	})
+	if i == len(observations) {
+		// The pool stayed at its current state since the latest observation
+		return observations[i-1].Accumulate(pool.CurrentTick, pool.CurrentTickLiquidity, t), nil
+	}
+	return types.InterpolateTwapObservation(observations[i-1], observations[i], t), nil
+}
+
+// GetTwap returns the time weighted geometric mean price (quote per base) and
+// the time weighted average liquidity of the pool over [start, end].
+func (k Keeper) GetTwap(ctx sdk.Context, poolId uint64, start, end time.Time) (price math.LegacyDec, averageLiquidity math.LegacyDec, err error) {
+	if !start.Before(end) {
+		return price, averageLiquidity, errorsmod.Wrapf(types.ErrInvalidTwapPeriod, "start %s must be before end %s", start, end)
+	}
+	if end.After(ctx.BlockTime()) {
+		return price, averageLiquidity, errorsmod.Wrapf(types.ErrInvalidTwapPeriod, "end %s is after the block time %s", end, ctx.BlockTime())
+	}
+
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return price, averageLiquidity, errorsmod.Wrapf(types.ErrPoolNotFound, "id: %d", poolId)
+	}
+
+	observations := k.GetTwapObservationsByPool(ctx, poolId)
+	startObservation, err := k.getTwapObservationAt(pool, observations, start)
+	if err != nil {
+		return price, averageLiquidity, err
+	}
+	endObservation, err := k.getTwapObservationAt(pool, observations, end)
+	if err != nil {
+		return price, averageLiquidity, err
+	}
+
+	elapsed := math.LegacyNewDecWithPrec(end.Sub(start).Nanoseconds(), 9)
+	averageTick := endObservation.TickCumulative.Sub(startObservation.TickCumulative).Quo(elapsed)
+	averageLiquidity = endObservation.LiquidityCumulative.Sub(startObservation.LiquidityCumulative).Quo(elapsed)
+
+	price, err = types.AverageTickToPrice(averageTick, pool.TickParams)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	return price, averageLiquidity, nil
+}
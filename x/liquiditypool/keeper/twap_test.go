@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
+)
+
+func requireDecApprox(t *testing.T, expected, actual math.LegacyDec) {
+	t.Helper()
+	require.True(t, expected.Sub(actual).Abs().LT(math.LegacyNewDecWithPrec(1, 8)), "expected %s, got %s", expected, actual)
+}
+
+func TestTwap(t *testing.T) {
+	swapper := sdk.AccAddress("swapper")
+	k, _, ctx := setupLimitOrderPool(t)
+
+	t0 := ctx.BlockTime().Add(100 * time.Second)
+	t1 := t0.Add(100 * time.Second)
+	t2 := t1.Add(100 * time.Second)
+
+	// The pool stays at tick 0 in [t0, t1] and at tick 2 in [t1, t2]
+	ctx = ctx.WithBlockTime(t1)
+	pool, found := k.GetPool(ctx, 0)
+	require.True(t, found)
+	_, err := k.SwapExactAmountIn(ctx, swapper, pool, sdk.NewInt64Coin("quote", 300000), "base", true)
+	require.NoError(t, err)
+	pool, found = k.GetPool(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, int64(2), pool.CurrentTick)
+
+	// Only the position creation and the swap are observed, the idle blocks are not
+	ctx = ctx.WithBlockTime(t2)
+	require.Len(t, k.GetTwapObservationsByPool(ctx, 0), 2)
+
+	tickPrice := func(tick int64) math.LegacyDec {
+		price, err := types.TickToPrice(tick, pool.TickParams)
+		require.NoError(t, err)
+		return price
+	}
+
+	price, liquidity, err := k.GetTwap(ctx, 0, t0, t1)
+	require.NoError(t, err)
+	requireDecApprox(t, tickPrice(0), price)
+	require.True(t, liquidity.IsPositive())
+
+	price, _, err = k.GetTwap(ctx, 0, t1, t2)
+	require.NoError(t, err)
+	requireDecApprox(t, tickPrice(2), price)
+
+	// Average tick 1
+	price, _, err = k.GetTwap(ctx, 0, t0, t2)
+	require.NoError(t, err)
+	requireDecApprox(t, math.LegacyNewDecWithPrec(10001, 4), price)
+
+	// Interpolated between the observations
+	price, _, err = k.GetTwap(ctx, 0, t0.Add(50*time.Second), t1.Add(50*time.Second))
+	require.NoError(t, err)
+	requireDecApprox(t, math.LegacyNewDecWithPrec(10001, 4), price)
+
+	// Extrapolated from the latest observation
+	ctx = ctx.WithBlockTime(t2.Add(100 * time.Second))
+	price, _, err = k.GetTwap(ctx, 0, t2, t2.Add(100*time.Second))
+	require.NoError(t, err)
+	requireDecApprox(t, tickPrice(2), price)
+
+	res, err := k.Twap(ctx, &types.QueryTwapRequest{PoolId: 0, Start: t0, End: t2})
+	require.NoError(t, err)
+	requireDecApprox(t, math.LegacyNewDecWithPrec(10001, 4), res.Price)
+
+	_, _, err = k.GetTwap(ctx, 0, t1, t0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapPeriod)
+
+	_, _, err = k.GetTwap(ctx, 0, t0, ctx.BlockTime().Add(time.Second))
+	require.ErrorIs(t, err, types.ErrInvalidTwapPeriod)
+
+	_, _, err = k.GetTwap(ctx, 0, time.Time{}.Add(-time.Hour), t0)
+	require.ErrorIs(t, err, types.ErrTwapNotAvailable)
+
+	_, _, err = k.GetTwap(ctx, 1, t0, t1)
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
+
+func TestTwapRingBuffer(t *testing.T) {
+	swapper := sdk.AccAddress("swapper")
+	k, _, ctx := setupLimitOrderPool(t)
+
+	swap := func() {
+		pool, found := k.GetPool(ctx, 0)
+		require.True(t, found)
+		_, err := k.SwapExactAmountIn(ctx, swapper, pool, sdk.NewInt64Coin("quote", 100), "base", true)
+		require.NoError(t, err)
+	}
+
+	params := k.GetParams(ctx)
+	params.TwapObservations = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	start := ctx.BlockTime()
+	for i := 1; i <= 5; i++ {
+		ctx = ctx.WithBlockTime(start.Add(time.Duration(i) * time.Second))
+		swap()
+		// At most one observation per block
+		swap()
+	}
+
+	observations := k.GetTwapObservationsByPool(ctx, 0)
+	require.Len(t, observations, 3)
+	require.Equal(t, start.Add(3*time.Second), observations[0].Time)
+	require.Equal(t, start.Add(5*time.Second), observations[2].Time)
+
+	ring, found := k.GetTwapRing(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(3), ring.Length)
+	require.Equal(t, observations[2].Index, ring.LastIndex)
+
+	// Shrinking the ring buffer drops the extra slots
+	params.TwapObservations = 2
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockTime(start.Add(6 * time.Second))
+	swap()
+
+	observations = k.GetTwapObservationsByPool(ctx, 0)
+	require.Len(t, observations, 2)
+	require.Equal(t, start.Add(6*time.Second), observations[1].Time)
+
+	_, _, err := k.GetTwap(ctx, 0, start.Add(time.Second), start.Add(6*time.Second))
+	require.ErrorIs(t, err, types.ErrTwapNotAvailable)
+}
@@ -28,6 +28,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows a pool by id",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "PoolsByDenoms",
+					Use:            "pools-by-denoms [denom_base] [denom_quote]",
+					Short:          "List pools by base and quote denoms",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom_base"}, {ProtoField: "denom_quote"}},
+				},
 				{
 					RpcMethod: "Positions",
 					Use:       "list-positions",
@@ -57,6 +63,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows fees in a position by id",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "LimitOrder",
+					Use:            "show-limit-order [id]",
+					Short:          "Shows a limit order by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "PoolLimitOrders",
+					Use:            "pool-limit-orders [pool_id]",
+					Short:          "List limit orders by pool id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}},
+				},
+				{
+					RpcMethod:      "AddressLimitOrders",
+					Use:            "address-limit-orders [address]",
+					Short:          "List limit orders by address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Twap",
+					Use:            "twap [pool_id] [start] [end]",
+					Short:          "Shows the time weighted average price of a pool between two RFC3339 timestamps",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "start"}, {ProtoField: "end"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -122,6 +152,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						// {ProtoField: "position_ids"},
 					},
 				},
+				{
+					RpcMethod:      "PlaceLimitOrder",
+					Use:            "place-limit-order [pool_id] [tick] [token_in]",
+					Short:          "Place a limit order on a single tick range of a pool",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}, {ProtoField: "tick"}, {ProtoField: "token_in"}},
+				},
+				{
+					RpcMethod:      "CancelLimitOrder",
+					Use:            "cancel-limit-order [id]",
+					Short:          "Cancel an open limit order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ClaimLimitOrder",
+					Use:            "claim-limit-order [id]",
+					Short:          "Claim a filled limit order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -23,6 +23,44 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set position count
 	k.SetPositionCount(ctx, genState.PositionCount)
+	// Set all the tick info
+	for _, elem := range genState.TickInfoList {
+		k.SetTickInfo(ctx, elem)
+	}
+	// Set all the accumulator
+	for _, elem := range genState.AccumulatorList {
+		if err := k.SetAccumulator(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the accumulator position
+	for _, elem := range genState.AccumulatorPositionList {
+		k.SetAccumulatorPosition(ctx, elem.AccumName, elem.Position.AccumValuePerShare, elem.Name, elem.Position.NumShares, elem.Position.UnclaimedRewardsTotal)
+	}
+	// Set all the limit order
+	for _, elem := range genState.LimitOrderList {
+		k.SetLimitOrder(ctx, elem)
+	}
+
+	// Set limit order count
+	k.SetLimitOrderCount(ctx, genState.LimitOrderCount)
+	// Set all the twap observation
+	for _, elem := range genState.TwapObservationList {
+		k.SetTwapObservation(ctx, elem)
+	}
+	// Set all the twap ring
+	for _, elem := range genState.TwapRingList {
+		k.SetTwapRing(ctx, elem)
+	}
+	// Initialize the fee accumulator of pools exported without one
+	for _, elem := range genState.PoolList {
+		if _, err := k.GetFeeAccumulator(ctx, elem.Id); err == nil {
+			continue
+		}
+		if err := k.InitAccumulator(ctx, types.KeyFeePoolAccumulator(elem.Id)); err != nil {
+			panic(err)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -38,6 +76,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PoolCount = k.GetPoolCount(ctx)
 	genesis.PositionList = k.GetAllPositions(ctx)
 	genesis.PositionCount = k.GetPositionCount(ctx)
+	genesis.TickInfoList = k.GetAllTickInfos(ctx)
+	genesis.AccumulatorList = k.GetAllAccumulators(ctx)
+	genesis.AccumulatorPositionList = k.GetAllAccumulatorPositions(ctx)
+	genesis.LimitOrderList = k.GetAllLimitOrders(ctx)
+	genesis.LimitOrderCount = k.GetLimitOrderCount(ctx)
+	genesis.TwapObservationList = k.GetAllTwapObservations(ctx)
+	genesis.TwapRingList = k.GetAllTwapRings(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,10 +2,15 @@ package liquiditypool_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
+	"github.com/sunriselayer/sunrise/x/liquiditypool/keeper"
 	liquiditypool "github.com/sunriselayer/sunrise/x/liquiditypool/module"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
@@ -32,6 +37,25 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		PositionCount: 2,
+		LimitOrderList: []types.LimitOrder{
+			{
+				Id:     0,
+				Filled: true,
+			},
+			{
+				Id:         1,
+				PositionId: 1,
+			},
+		},
+		LimitOrderCount: 2,
+		TwapObservationList: []types.TwapObservation{
+			types.NewTwapObservation(0, time.Unix(0, 0).UTC()),
+			types.NewTwapObservation(1, time.Unix(0, 0).UTC()),
+		},
+		TwapRingList: []types.TwapRing{
+			{PoolId: 0, LastIndex: 0, Length: 1},
+			{PoolId: 1, LastIndex: 0, Length: 1},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -47,5 +71,86 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PoolCount, got.PoolCount)
 	require.ElementsMatch(t, genesisState.PositionList, got.PositionList)
 	require.Equal(t, genesisState.PositionCount, got.PositionCount)
+	require.ElementsMatch(t, genesisState.LimitOrderList, got.LimitOrderList)
+	require.Equal(t, genesisState.LimitOrderCount, got.LimitOrderCount)
+	require.ElementsMatch(t, genesisState.TwapObservationList, got.TwapObservationList)
+	require.ElementsMatch(t, genesisState.TwapRingList, got.TwapRingList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisExportImport(t *testing.T) {
+	k, bk, ctx := keepertest.LiquiditypoolKeeper(t)
+	bk.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	srv := keeper.NewMsgServerImpl(k)
+
+	sender := sdk.AccAddress("sender")
+	_, err := srv.CreatePool(ctx, &types.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  "base",
+		DenomQuote: "quote",
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+
+	for _, ticks := range [][2]int64{{-10, 10}, {-5, 20}} {
+		_, err = srv.CreatePosition(ctx, &types.MsgCreatePosition{
+			Sender:         sender.String(),
+			PoolId:         0,
+			LowerTick:      ticks[0],
+			UpperTick:      ticks[1],
+			TokenBase:      sdk.NewInt64Coin("base", 1000000),
+			TokenQuote:     sdk.NewInt64Coin("quote", 1000000),
+			MinAmountBase:  math.NewInt(0),
+			MinAmountQuote: math.NewInt(0),
+		})
+		require.NoError(t, err)
+	}
+
+	pool, found := k.GetPool(ctx, 0)
+	require.True(t, found)
+	_, err = k.SwapExactAmountIn(ctx, sender, pool, sdk.NewInt64Coin("base", 150000), "quote", true)
+	require.NoError(t, err)
+
+	exported := liquiditypool.ExportGenesis(ctx, k)
+	require.NoError(t, exported.Validate())
+	require.NotEmpty(t, exported.TickInfoList)
+	require.NotEmpty(t, exported.AccumulatorList)
+	// fee and incentive accumulation positions of both positions
+	require.Len(t, exported.AccumulatorPositionList, 4)
+
+	k2, bk2, ctx2 := keepertest.LiquiditypoolKeeper(t)
+	bk2.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk2.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	liquiditypool.InitGenesis(ctx2, k2, *exported)
+	reexported := liquiditypool.ExportGenesis(ctx2, k2)
+	require.Equal(t, exported, reexported)
+
+	for _, position := range exported.PositionList {
+		fees, err := k.GetClaimableFees(ctx, position.Id)
+		require.NoError(t, err)
+		require.False(t, fees.IsZero())
+		fees2, err := k2.GetClaimableFees(ctx2, position.Id)
+		require.NoError(t, err)
+		require.Equal(t, fees, fees2)
+
+		accumulation, err := k.GetIncentiveAccumulation(ctx, position.Id)
+		require.NoError(t, err)
+		require.False(t, accumulation.IsZero())
+		accumulation2, err := k2.GetIncentiveAccumulation(ctx2, position.Id)
+		require.NoError(t, err)
+		require.Equal(t, accumulation, accumulation2)
+	}
+
+	// Swapping back across the imported ticks behaves identically on both chains
+	pool, _ = k.GetPool(ctx, 0)
+	amountOut, err := k.SwapExactAmountIn(ctx, sender, pool, sdk.NewInt64Coin("quote", 100000), "base", true)
+	require.NoError(t, err)
+	pool2, _ := k2.GetPool(ctx2, 0)
+	amountOut2, err := k2.SwapExactAmountIn(ctx2, sender, pool2, sdk.NewInt64Coin("quote", 100000), "base", true)
+	require.NoError(t, err)
+	require.Equal(t, amountOut, amountOut2)
+	require.Equal(t, liquiditypool.ExportGenesis(ctx, k), liquiditypool.ExportGenesis(ctx2, k2))
+}
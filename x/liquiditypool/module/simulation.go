@@ -51,6 +51,18 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgClaimRewards int = 100
 
+	opWeightMsgPlaceLimitOrder = "op_weight_msg_place_limit_order"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPlaceLimitOrder int = 100
+
+	opWeightMsgCancelLimitOrder = "op_weight_msg_cancel_limit_order"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelLimitOrder int = 100
+
+	opWeightMsgClaimLimitOrder = "op_weight_msg_claim_limit_order"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgClaimLimitOrder int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -149,6 +161,39 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		liquiditypoolsimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgPlaceLimitOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgPlaceLimitOrder, &weightMsgPlaceLimitOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceLimitOrder = defaultWeightMsgPlaceLimitOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlaceLimitOrder,
+		liquiditypoolsimulation.SimulateMsgPlaceLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelLimitOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelLimitOrder, &weightMsgCancelLimitOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelLimitOrder = defaultWeightMsgCancelLimitOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelLimitOrder,
+		liquiditypoolsimulation.SimulateMsgCancelLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgClaimLimitOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimLimitOrder, &weightMsgClaimLimitOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimLimitOrder = defaultWeightMsgClaimLimitOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimLimitOrder,
+		liquiditypoolsimulation.SimulateMsgClaimLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -197,6 +242,30 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgPlaceLimitOrder,
+			defaultWeightMsgPlaceLimitOrder,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				liquiditypoolsimulation.SimulateMsgPlaceLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCancelLimitOrder,
+			defaultWeightMsgCancelLimitOrder,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				liquiditypoolsimulation.SimulateMsgCancelLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgClaimLimitOrder,
+			defaultWeightMsgClaimLimitOrder,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				liquiditypoolsimulation.SimulateMsgClaimLimitOrder(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -22,7 +22,7 @@ func SimulateMsgCreatePool(
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 
 		msg := &types.MsgCreatePool{
-			Authority: simAccount.Address.String(),
+			Sender: simAccount.Address.String(),
 		}
 
 		txCtx := simulation.OperationInput{
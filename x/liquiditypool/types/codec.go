@@ -17,6 +17,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDecreaseLiquidity{},
 		&MsgClaimRewards{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -48,4 +48,15 @@ var (
 	ErrZeroShares               = sdkerrors.Register(ModuleName, 1137, "zero shares")
 	ErrAccumDoesNotExist        = sdkerrors.Register(ModuleName, 1138, "accumulator does not exist")
 	ErrNegRewardAddition        = sdkerrors.Register(ModuleName, 1139, "negative reward addition")
+	ErrDuplicatePool            = sdkerrors.Register(ModuleName, 1140, "pool with the same denoms and fee rate already exists")
+	ErrFeeTierNotAllowed        = sdkerrors.Register(ModuleName, 1141, "fee tier not allowed")
+	ErrLimitOrderNotFound       = sdkerrors.Register(ModuleName, 1142, "limit order not found")
+	ErrInvalidLimitOrderTick    = sdkerrors.Register(ModuleName, 1143, "limit order tick must be on the input side of the current tick")
+	ErrLimitOrderFilled         = sdkerrors.Register(ModuleName, 1144, "limit order already filled")
+	ErrLimitOrderNotFilled      = sdkerrors.Register(ModuleName, 1145, "limit order not filled")
+	ErrTwapNotAvailable         = sdkerrors.Register(ModuleName, 1146, "twap not available for the requested period")
+	ErrInvalidTwapPeriod        = sdkerrors.Register(ModuleName, 1147, "invalid twap period")
+	ErrPriceLimitReached        = sdkerrors.Register(ModuleName, 1148, "price limit reached")
+	ErrLimitOrderTooSmall       = sdkerrors.Register(ModuleName, 1149, "limit order too small")
+	ErrInvalidFeeTier           = sdkerrors.Register(ModuleName, 1150, "invalid fee rate or tick params")
 )
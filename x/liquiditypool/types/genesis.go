@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,8 +14,14 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PoolList:     []Pool{},
-		PositionList: []Position{},
+		PoolList:                []Pool{},
+		PositionList:            []Position{},
+		TickInfoList:            []TickInfo{},
+		AccumulatorList:         []AccumulatorObject{},
+		AccumulatorPositionList: []GenesisAccumulatorPosition{},
+		LimitOrderList:          []LimitOrder{},
+		TwapObservationList:     []TwapObservation{},
+		TwapRingList:            []TwapRing{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -22,6 +32,7 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// Check for duplicated ID in pool
 	poolIdMap := make(map[uint64]bool)
+	poolDenomsMap := make(map[string]bool)
 	poolCount := gs.GetPoolCount()
 	for _, elem := range gs.PoolList {
 		if _, ok := poolIdMap[elem.Id]; ok {
@@ -31,6 +42,12 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("pool id should be lower or equal than the last id")
 		}
 		poolIdMap[elem.Id] = true
+
+		key := string(PoolByDenomsPrefix(elem.DenomBase, elem.DenomQuote)) + elem.FeeRate.String()
+		if poolDenomsMap[key] {
+			return fmt.Errorf("duplicated pool for %s/%s with fee rate %s", elem.DenomBase, elem.DenomQuote, elem.FeeRate)
+		}
+		poolDenomsMap[key] = true
 	}
 	// Check for duplicated ID in position
 	positionIdMap := make(map[uint64]bool)
@@ -44,6 +61,148 @@ func (gs GenesisState) Validate() error {
 		}
 		positionIdMap[elem.Id] = true
 	}
+	// Check for duplicated tick info
+	tickInfoMap := make(map[string]bool)
+	for _, elem := range gs.TickInfoList {
+		key := string(GetTickInfoIDBytes(elem.PoolId, elem.TickIndex))
+		if _, ok := tickInfoMap[key]; ok {
+			return fmt.Errorf("duplicated tick info for pool %d tick %d", elem.PoolId, elem.TickIndex)
+		}
+		if !poolIdMap[elem.PoolId] {
+			return fmt.Errorf("tick info for unknown pool %d", elem.PoolId)
+		}
+		tickInfoMap[key] = true
+	}
+	// Check for duplicated accumulator
+	accumulatorMap := make(map[string]AccumulatorObject)
+	for _, elem := range gs.AccumulatorList {
+		if _, ok := accumulatorMap[elem.Name]; ok {
+			return fmt.Errorf("duplicated accumulator %s", elem.Name)
+		}
+		accumulatorMap[elem.Name] = elem
+	}
+	// Check for duplicated accumulator position and that the shares sum up to the accumulator total
+	accumulatorPositionMap := make(map[string]AccumulatorPosition)
+	accumulatorShares := make(map[string]math.LegacyDec)
+	for _, elem := range gs.AccumulatorPositionList {
+		key := string(FormatKeyAccumulatorPositionPrefix(elem.AccumName, elem.Name))
+		if _, ok := accumulatorPositionMap[key]; ok {
+			return fmt.Errorf("duplicated accumulator position %s in %s", elem.Name, elem.AccumName)
+		}
+		if _, ok := accumulatorMap[elem.AccumName]; !ok {
+			return fmt.Errorf("accumulator position %s refers to unknown accumulator %s", elem.Name, elem.AccumName)
+		}
+		accumulatorPositionMap[key] = elem.Position
+		// Incentive accumulation positions do not count towards the total shares
+		if strings.HasPrefix(elem.Name, IncentivePositionAccumulatorPrefix) {
+			continue
+		}
+		shares, ok := accumulatorShares[elem.AccumName]
+		if !ok {
+			shares = math.LegacyZeroDec()
+		}
+		accumulatorShares[elem.AccumName] = shares.Add(elem.Position.NumShares)
+	}
+	for _, elem := range gs.AccumulatorList {
+		shares, ok := accumulatorShares[elem.Name]
+		if !ok {
+			shares = math.LegacyZeroDec()
+		}
+		if !elem.TotalShares.Equal(shares) {
+			return fmt.Errorf("accumulator %s total shares %s does not match positions shares %s", elem.Name, elem.TotalShares, shares)
+		}
+	}
+	// Check that every position refers to existing ticks and a fee accumulator position holding its liquidity
+	for _, elem := range gs.PositionList {
+		if !poolIdMap[elem.PoolId] {
+			return fmt.Errorf("position %d refers to unknown pool %d", elem.Id, elem.PoolId)
+		}
+		if !tickInfoMap[string(GetTickInfoIDBytes(elem.PoolId, elem.LowerTick))] {
+			return fmt.Errorf("lower tick %d of position %d not found", elem.LowerTick, elem.Id)
+		}
+		if !tickInfoMap[string(GetTickInfoIDBytes(elem.PoolId, elem.UpperTick))] {
+			return fmt.Errorf("upper tick %d of position %d not found", elem.UpperTick, elem.Id)
+		}
+		key := string(FormatKeyAccumulatorPositionPrefix(KeyFeePoolAccumulator(elem.PoolId), KeyFeePositionAccumulator(elem.Id)))
+		accumPosition, ok := accumulatorPositionMap[key]
+		if !ok {
+			return fmt.Errorf("fee accumulator position of position %d not found", elem.Id)
+		}
+		if !accumPosition.NumShares.Equal(elem.Liquidity) {
+			return fmt.Errorf("fee accumulator shares %s of position %d does not match liquidity %s", accumPosition.NumShares, elem.Id, elem.Liquidity)
+		}
+		key = string(FormatKeyAccumulatorPositionPrefix(KeyFeePoolAccumulator(elem.PoolId), KeyIncentivePositionAccumulator(elem.Id)))
+		if accumPosition, ok := accumulatorPositionMap[key]; ok && !accumPosition.NumShares.Equal(elem.Liquidity) {
+			return fmt.Errorf("incentive accumulation shares %s of position %d does not match liquidity %s", accumPosition.NumShares, elem.Id, elem.Liquidity)
+		}
+	}
+	// Check for duplicated ID in limit order and that open orders refer to a position of the limit order address
+	positionMap := make(map[uint64]Position)
+	for _, elem := range gs.PositionList {
+		positionMap[elem.Id] = elem
+	}
+	limitOrderIdMap := make(map[uint64]bool)
+	limitOrderCount := gs.GetLimitOrderCount()
+	for _, elem := range gs.LimitOrderList {
+		if _, ok := limitOrderIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for limit order")
+		}
+		if elem.Id >= limitOrderCount {
+			return fmt.Errorf("limit order id should be lower or equal than the last id")
+		}
+		limitOrderIdMap[elem.Id] = true
+
+		if !poolIdMap[elem.PoolId] {
+			return fmt.Errorf("limit order %d refers to unknown pool %d", elem.Id, elem.PoolId)
+		}
+		if elem.Filled {
+			continue
+		}
+		position, ok := positionMap[elem.PositionId]
+		if !ok {
+			return fmt.Errorf("position %d of limit order %d not found", elem.PositionId, elem.Id)
+		}
+		if position.Address != LimitOrderAddress().String() || position.PoolId != elem.PoolId ||
+			position.LowerTick != elem.Tick || position.UpperTick != elem.Tick+1 {
+			return fmt.Errorf("position %d does not match limit order %d", elem.PositionId, elem.Id)
+		}
+	}
+	// Check for duplicated twap observation and that the ring of each pool refers to its latest observation
+	twapObservationMap := make(map[string]bool)
+	twapObservationCount := make(map[uint64]uint64)
+	for _, elem := range gs.TwapObservationList {
+		key := string(append(TwapObservationPrefix(elem.PoolId), sdk.Uint64ToBigEndian(elem.Index)...))
+		if twapObservationMap[key] {
+			return fmt.Errorf("duplicated twap observation %d of pool %d", elem.Index, elem.PoolId)
+		}
+		if !poolIdMap[elem.PoolId] {
+			return fmt.Errorf("twap observation for unknown pool %d", elem.PoolId)
+		}
+		if elem.TickCumulative.IsNil() || elem.LiquidityCumulative.IsNil() {
+			return fmt.Errorf("invalid cumulative values of twap observation %d of pool %d", elem.Index, elem.PoolId)
+		}
+		twapObservationMap[key] = true
+		twapObservationCount[elem.PoolId]++
+	}
+	twapRingMap := make(map[uint64]bool)
+	for _, elem := range gs.TwapRingList {
+		if twapRingMap[elem.PoolId] {
+			return fmt.Errorf("duplicated twap ring of pool %d", elem.PoolId)
+		}
+		twapRingMap[elem.PoolId] = true
+
+		if !twapObservationMap[string(append(TwapObservationPrefix(elem.PoolId), sdk.Uint64ToBigEndian(elem.LastIndex)...))] {
+			return fmt.Errorf("latest twap observation %d of pool %d not found", elem.LastIndex, elem.PoolId)
+		}
+		if twapObservationCount[elem.PoolId] != elem.Length {
+			return fmt.Errorf("twap ring length %d of pool %d does not match its observations", elem.Length, elem.PoolId)
+		}
+	}
+	for poolId := range twapObservationCount {
+		if !twapRingMap[poolId] {
+			return fmt.Errorf("twap ring of pool %d not found", poolId)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
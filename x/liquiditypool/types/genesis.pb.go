@@ -27,11 +27,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the liquiditypool module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params        Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PoolList      []Pool     `protobuf:"bytes,2,rep,name=poolList,proto3" json:"poolList"`
-	PoolCount     uint64     `protobuf:"varint,3,opt,name=poolCount,proto3" json:"poolCount,omitempty"`
-	PositionList  []Position `protobuf:"bytes,4,rep,name=positionList,proto3" json:"positionList"`
-	PositionCount uint64     `protobuf:"varint,5,opt,name=positionCount,proto3" json:"positionCount,omitempty"`
+	Params                  Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PoolList                []Pool                       `protobuf:"bytes,2,rep,name=poolList,proto3" json:"poolList"`
+	PoolCount               uint64                       `protobuf:"varint,3,opt,name=poolCount,proto3" json:"poolCount,omitempty"`
+	PositionList            []Position                   `protobuf:"bytes,4,rep,name=positionList,proto3" json:"positionList"`
+	PositionCount           uint64                       `protobuf:"varint,5,opt,name=positionCount,proto3" json:"positionCount,omitempty"`
+	TickInfoList            []TickInfo                   `protobuf:"bytes,6,rep,name=tickInfoList,proto3" json:"tickInfoList"`
+	AccumulatorList         []AccumulatorObject          `protobuf:"bytes,7,rep,name=accumulatorList,proto3" json:"accumulatorList"`
+	AccumulatorPositionList []GenesisAccumulatorPosition `protobuf:"bytes,8,rep,name=accumulatorPositionList,proto3" json:"accumulatorPositionList"`
+	LimitOrderList          []LimitOrder                 `protobuf:"bytes,9,rep,name=limitOrderList,proto3" json:"limitOrderList"`
+	LimitOrderCount         uint64                       `protobuf:"varint,10,opt,name=limitOrderCount,proto3" json:"limitOrderCount,omitempty"`
+	TwapObservationList     []TwapObservation            `protobuf:"bytes,11,rep,name=twapObservationList,proto3" json:"twapObservationList"`
+	TwapRingList            []TwapRing                   `protobuf:"bytes,12,rep,name=twapRingList,proto3" json:"twapRingList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,8 +109,120 @@ func (m *GenesisState) GetPositionCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTickInfoList() []TickInfo {
+	if m != nil {
+		return m.TickInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatorList() []AccumulatorObject {
+	if m != nil {
+		return m.AccumulatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatorPositionList() []GenesisAccumulatorPosition {
+	if m != nil {
+		return m.AccumulatorPositionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderList() []LimitOrder {
+	if m != nil {
+		return m.LimitOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderCount() uint64 {
+	if m != nil {
+		return m.LimitOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTwapObservationList() []TwapObservation {
+	if m != nil {
+		return m.TwapObservationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRingList() []TwapRing {
+	if m != nil {
+		return m.TwapRingList
+	}
+	return nil
+}
+
+// GenesisAccumulatorPosition is an accumulator position together with the
+// accumulator and position names it is stored under.
+type GenesisAccumulatorPosition struct {
+	AccumName string              `protobuf:"bytes,1,opt,name=accum_name,json=accumName,proto3" json:"accum_name,omitempty"`
+	Name      string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position  AccumulatorPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position"`
+}
+
+func (m *GenesisAccumulatorPosition) Reset()         { *m = GenesisAccumulatorPosition{} }
+func (m *GenesisAccumulatorPosition) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccumulatorPosition) ProtoMessage()    {}
+func (*GenesisAccumulatorPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ed29dc4c593bc5, []int{1}
+}
+func (m *GenesisAccumulatorPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccumulatorPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccumulatorPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccumulatorPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccumulatorPosition.Merge(m, src)
+}
+func (m *GenesisAccumulatorPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccumulatorPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccumulatorPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccumulatorPosition proto.InternalMessageInfo
+
+func (m *GenesisAccumulatorPosition) GetAccumName() string {
+	if m != nil {
+		return m.AccumName
+	}
+	return ""
+}
+
+func (m *GenesisAccumulatorPosition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GenesisAccumulatorPosition) GetPosition() AccumulatorPosition {
+	if m != nil {
+		return m.Position
+	}
+	return AccumulatorPosition{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.liquiditypool.GenesisState")
+	proto.RegisterType((*GenesisAccumulatorPosition)(nil), "sunrise.liquiditypool.GenesisAccumulatorPosition")
 }
 
 func init() {
@@ -111,28 +230,43 @@ func init() {
 }
 
 var fileDescriptor_e9ed29dc4c593bc5 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0x2c, 0xc8, 0xcf,
-	0xcf, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x2a, 0xd2, 0x43, 0x51, 0x24, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0xaa, 0x84,
-	0xdd, 0x92, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x1d, 0x52, 0x0a, 0x38, 0xd4, 0xe4, 0xe7, 0xe7,
-	0x40, 0x55, 0xa8, 0xe0, 0x52, 0x51, 0x9c, 0x59, 0x92, 0x99, 0x9f, 0x07, 0x51, 0xa5, 0x34, 0x8f,
-	0x89, 0x8b, 0xc7, 0x1d, 0xe2, 0xfa, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x07, 0x2e, 0x36, 0x88,
-	0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xb2, 0x7a, 0x58, 0x7d, 0xa3, 0x17, 0x00, 0x56,
-	0xe4, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0xfa, 0x84,
-	0x6c, 0xb9, 0x38, 0x40, 0x2a, 0x7c, 0x32, 0x8b, 0x4b, 0x24, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d,
-	0xa4, 0x71, 0x99, 0x91, 0x9f, 0x9f, 0xe3, 0xc4, 0x02, 0x32, 0x21, 0x08, 0xae, 0x45, 0x48, 0x86,
-	0x8b, 0x13, 0xc4, 0x76, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x56, 0x60, 0xd4, 0x60, 0x09, 0x42,
-	0x08, 0x08, 0x79, 0x72, 0xf1, 0xc0, 0x7c, 0x00, 0xb6, 0x80, 0x05, 0x6c, 0x81, 0x3c, 0x4e, 0x0b,
-	0x20, 0x4a, 0xa1, 0x96, 0xa0, 0x68, 0x15, 0x52, 0xe1, 0xe2, 0x85, 0xf1, 0x21, 0x96, 0xb1, 0x82,
-	0x2d, 0x43, 0x15, 0x74, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
-	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
-	0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0xf5, 0x39, 0x89,
-	0x95, 0xa9, 0x45, 0x30, 0x8e, 0x7e, 0x05, 0x5a, 0xd0, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0x03, 0xde, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xcf, 0x94, 0xf3, 0x4b, 0x02, 0x00,
-	0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xad, 0x94, 0xd5, 0x2d, 0x4c, 0x18, 0x10, 0x55, 0x61, 0x59, 0x29, 0x13, 0x54,
+	0x3b, 0x34, 0xa2, 0x88, 0x23, 0x12, 0x2b, 0x07, 0x34, 0xa9, 0x22, 0x53, 0xe0, 0x80, 0x38, 0x30,
+	0xb9, 0x99, 0x09, 0x86, 0x24, 0xce, 0x12, 0x87, 0xd1, 0xb7, 0xe0, 0x05, 0xb8, 0x73, 0xe4, 0x31,
+	0x76, 0xdc, 0x91, 0x03, 0x42, 0xa8, 0x3d, 0xf0, 0x1a, 0x28, 0x5f, 0x9c, 0x34, 0x0d, 0x75, 0xb8,
+	0x54, 0xce, 0x97, 0xff, 0xff, 0xff, 0x73, 0xbf, 0xcf, 0x0e, 0xba, 0x17, 0xc5, 0x7e, 0xc8, 0x22,
+	0x6a, 0xb8, 0xec, 0x34, 0x66, 0x27, 0x4c, 0xcc, 0x02, 0xce, 0x5d, 0xc3, 0xa1, 0x3e, 0x8d, 0x58,
+	0x34, 0x0c, 0x42, 0x2e, 0x38, 0xbe, 0x29, 0x45, 0xc3, 0x15, 0x51, 0xf7, 0x1a, 0xf1, 0x98, 0xcf,
+	0x0d, 0xf8, 0x4d, 0x95, 0xdd, 0x1b, 0x0e, 0x77, 0x38, 0x2c, 0x8d, 0x64, 0x25, 0xab, 0x0f, 0xd6,
+	0x43, 0x88, 0x6d, 0xc7, 0x5e, 0xec, 0x12, 0xc1, 0xc3, 0x6a, 0xa1, 0xcb, 0x3c, 0x26, 0x8e, 0x79,
+	0x78, 0x42, 0x33, 0x61, 0x7f, 0xbd, 0x30, 0x20, 0x21, 0xf1, 0xe4, 0xae, 0xbb, 0x3d, 0x85, 0x86,
+	0x73, 0x57, 0x2a, 0xf6, 0x54, 0x8a, 0x88, 0x09, 0xc6, 0xfd, 0x6a, 0x96, 0x60, 0xf6, 0x47, 0x1a,
+	0x56, 0xb3, 0xc4, 0x19, 0x09, 0x52, 0x45, 0xff, 0x67, 0x03, 0xb5, 0x9f, 0xa7, 0x5d, 0x7d, 0x29,
+	0x88, 0xa0, 0xf8, 0x29, 0x6a, 0xa4, 0xdb, 0xed, 0x68, 0x3d, 0x6d, 0xd0, 0x1a, 0xed, 0x0c, 0xd7,
+	0x76, 0x79, 0x78, 0x04, 0xa2, 0x71, 0xf3, 0xfc, 0xd7, 0x6e, 0xed, 0xdb, 0x9f, 0xef, 0xfb, 0x9a,
+	0x25, 0x7d, 0xf8, 0x09, 0xda, 0x4a, 0x14, 0x13, 0x16, 0x89, 0xce, 0x46, 0x6f, 0x73, 0xd0, 0x1a,
+	0xdd, 0x56, 0x65, 0x70, 0xee, 0x8e, 0xeb, 0x49, 0x82, 0x95, 0x5b, 0xf0, 0x1d, 0xd4, 0x4c, 0xd6,
+	0xcf, 0x78, 0xec, 0x8b, 0xce, 0x66, 0x4f, 0x1b, 0xd4, 0xad, 0x65, 0x01, 0x1f, 0xa2, 0x76, 0xd6,
+	0x07, 0x00, 0xd4, 0x01, 0xb0, 0xab, 0x04, 0xa4, 0x52, 0x09, 0x59, 0xb1, 0xe2, 0x3d, 0x74, 0x25,
+	0x7b, 0x4e, 0x61, 0x97, 0x00, 0xb6, 0x5a, 0x4c, 0x80, 0x49, 0x4b, 0x0f, 0xfd, 0x77, 0x1c, 0x80,
+	0x8d, 0x4a, 0xe0, 0x2b, 0x29, 0xcd, 0x80, 0x45, 0x2b, 0x7e, 0x8d, 0xb6, 0x0b, 0x67, 0x0b, 0xd2,
+	0x2e, 0x43, 0xda, 0x40, 0x91, 0x76, 0xb0, 0x54, 0x9b, 0xd3, 0x0f, 0xd4, 0x16, 0x32, 0xb6, 0x1c,
+	0x83, 0x4f, 0xd1, 0xad, 0x42, 0xe9, 0xa8, 0xd8, 0xa0, 0x2d, 0x20, 0x3c, 0x54, 0x10, 0xe4, 0xe8,
+	0x0f, 0xfe, 0x35, 0x4b, 0x94, 0x2a, 0x17, 0x9b, 0xe8, 0x2a, 0x9c, 0x7f, 0x33, 0x39, 0xfe, 0x40,
+	0x6a, 0x02, 0xe9, 0xae, 0x82, 0x34, 0xc9, 0xc5, 0x32, 0xb9, 0x64, 0xc7, 0x03, 0xb4, 0xbd, 0xac,
+	0xa4, 0x03, 0x41, 0x30, 0x90, 0x72, 0x19, 0xbf, 0x45, 0xd7, 0x93, 0x13, 0x6c, 0x4e, 0x23, 0x1a,
+	0x7e, 0x22, 0xf9, 0x3f, 0x6d, 0x01, 0xff, 0xbe, 0x6a, 0x32, 0xab, 0x0e, 0xb9, 0x89, 0x75, 0x41,
+	0x30, 0xf2, 0x33, 0x12, 0x58, 0xcc, 0x77, 0x20, 0xb8, 0x5d, 0x3d, 0x72, 0x29, 0xcd, 0x47, 0x5e,
+	0xb0, 0xf6, 0xbf, 0x6a, 0xa8, 0xab, 0xee, 0x31, 0xde, 0x41, 0x08, 0xfa, 0x7b, 0xec, 0x13, 0x8f,
+	0xc2, 0x85, 0x6b, 0x5a, 0x4d, 0xa8, 0xbc, 0x20, 0x1e, 0xc5, 0x18, 0xd5, 0xe1, 0xc5, 0x06, 0xbc,
+	0x80, 0x35, 0x9e, 0x24, 0xb7, 0x2b, 0xb5, 0xc3, 0xed, 0x68, 0x8d, 0xf6, 0xff, 0x7f, 0x7a, 0x4a,
+	0x43, 0xcd, 0x13, 0xc6, 0xe6, 0xf9, 0x5c, 0xd7, 0x2e, 0xe6, 0xba, 0xf6, 0x7b, 0xae, 0x6b, 0x5f,
+	0x16, 0x7a, 0xed, 0x62, 0xa1, 0xd7, 0x7e, 0x2c, 0xf4, 0xda, 0x9b, 0xc7, 0x0e, 0x13, 0xef, 0xe3,
+	0xe9, 0xd0, 0xe6, 0x9e, 0x21, 0xf3, 0x5d, 0x32, 0xa3, 0x61, 0xf6, 0x60, 0x7c, 0x2e, 0x7f, 0x54,
+	0x66, 0x01, 0x8d, 0xa6, 0x0d, 0xf8, 0xac, 0x3c, 0xfa, 0x3b, 0x00, 0x04, 0x8e, 0x1c, 0x78, 0xc1,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +289,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TwapRingList) > 0 {
+		for iNdEx := len(m.TwapRingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TwapObservationList) > 0 {
+		for iNdEx := len(m.TwapObservationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapObservationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LimitOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LimitOrderCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LimitOrderList) > 0 {
+		for iNdEx := len(m.LimitOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AccumulatorPositionList) > 0 {
+		for iNdEx := len(m.AccumulatorPositionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatorPositionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AccumulatorList) > 0 {
+		for iNdEx := len(m.AccumulatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TickInfoList) > 0 {
+		for iNdEx := len(m.TickInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.PositionCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PositionCount))
 		i--
@@ -206,6 +429,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAccumulatorPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccumulatorPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccumulatorPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccumName) > 0 {
+		i -= len(m.AccumName)
+		copy(dAtA[i:], m.AccumName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AccumName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -243,6 +513,64 @@ func (m *GenesisState) Size() (n int) {
 	if m.PositionCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PositionCount))
 	}
+	if len(m.TickInfoList) > 0 {
+		for _, e := range m.TickInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccumulatorList) > 0 {
+		for _, e := range m.AccumulatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccumulatorPositionList) > 0 {
+		for _, e := range m.AccumulatorPositionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrderList) > 0 {
+		for _, e := range m.LimitOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LimitOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.LimitOrderCount))
+	}
+	if len(m.TwapObservationList) > 0 {
+		for _, e := range m.TwapObservationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRingList) > 0 {
+		for _, e := range m.TwapRingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAccumulatorPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccumName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -420,6 +748,376 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickInfoList = append(m.TickInfoList, TickInfo{})
+			if err := m.TickInfoList[len(m.TickInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatorList = append(m.AccumulatorList, AccumulatorObject{})
+			if err := m.AccumulatorList[len(m.AccumulatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorPositionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatorPositionList = append(m.AccumulatorPositionList, GenesisAccumulatorPosition{})
+			if err := m.AccumulatorPositionList[len(m.AccumulatorPositionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderList = append(m.LimitOrderList, LimitOrder{})
+			if err := m.LimitOrderList[len(m.LimitOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderCount", wireType)
+			}
+			m.LimitOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapObservationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapObservationList = append(m.TwapObservationList, TwapObservation{})
+			if err := m.TwapObservationList[len(m.TwapObservationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRingList = append(m.TwapRingList, TwapRing{})
+			if err := m.TwapRingList[len(m.TwapRingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccumulatorPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccumulatorPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccumulatorPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 	"github.com/sunriselayer/sunrise/x/liquiditypool/types"
 )
@@ -21,25 +23,74 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
 				PoolList: []types.Pool{
 					{
-						Id: 0,
+						Id:         0,
+						DenomBase:  "base",
+						DenomQuote: "quote",
+						FeeRate:    math.LegacyNewDecWithPrec(1, 2),
 					},
 					{
-						Id: 1,
+						Id:         1,
+						DenomBase:  "base",
+						DenomQuote: "quote",
+						FeeRate:    math.LegacyNewDecWithPrec(3, 3),
 					},
 				},
 				PoolCount: 2,
 				PositionList: []types.Position{
 					{
-						Id: 0,
+						Id:        0,
+						PoolId:    0,
+						LowerTick: -10,
+						UpperTick: 10,
+						Liquidity: math.LegacyNewDec(100),
 					},
 					{
-						Id: 1,
+						Id:        1,
+						PoolId:    1,
+						LowerTick: 0,
+						UpperTick: 10,
+						Liquidity: math.LegacyNewDec(50),
 					},
 				},
 				PositionCount: 2,
+				TickInfoList:  validTickInfoList(),
+				AccumulatorList: []types.AccumulatorObject{
+					{
+						Name:        types.KeyFeePoolAccumulator(0),
+						TotalShares: math.LegacyNewDec(100),
+					},
+					{
+						Name:        types.KeyFeePoolAccumulator(1),
+						TotalShares: math.LegacyNewDec(50),
+					},
+				},
+				AccumulatorPositionList: []types.GenesisAccumulatorPosition{
+					{
+						AccumName: types.KeyFeePoolAccumulator(0),
+						Name:      types.KeyFeePositionAccumulator(0),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(100)},
+					},
+					{
+						AccumName: types.KeyFeePoolAccumulator(1),
+						Name:      types.KeyFeePositionAccumulator(1),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(50)},
+					},
+				},
+				TwapObservationList: []types.TwapObservation{
+					types.NewTwapObservation(0, time.Unix(0, 0)),
+					{
+						PoolId:              0,
+						Index:               1,
+						Time:                time.Unix(5, 0),
+						TickCumulative:      math.LegacyNewDec(-5),
+						LiquidityCumulative: math.LegacyNewDec(500),
+					},
+				},
+				TwapRingList: []types.TwapRing{{PoolId: 0, LastIndex: 1, Length: 2}},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -58,6 +109,79 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated pool denoms and fee rate",
+			genState: &types.GenesisState{
+				PoolList: []types.Pool{
+					{
+						Id:         0,
+						DenomBase:  "base",
+						DenomQuote: "quote",
+						FeeRate:    math.LegacyNewDecWithPrec(1, 2),
+					},
+					{
+						Id:         1,
+						DenomBase:  "base",
+						DenomQuote: "quote",
+						FeeRate:    math.LegacyNewDecWithPrec(1, 2),
+					},
+				},
+				PoolCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated limit order",
+			genState: &types.GenesisState{
+				PoolList:        []types.Pool{{Id: 0}},
+				PoolCount:       1,
+				LimitOrderList:  []types.LimitOrder{{Id: 0, Filled: true}, {Id: 0, Filled: true}},
+				LimitOrderCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "open limit order without position",
+			genState: &types.GenesisState{
+				PoolList:        []types.Pool{{Id: 0}},
+				PoolCount:       1,
+				LimitOrderList:  []types.LimitOrder{{Id: 0, PositionId: 0}},
+				LimitOrderCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated twap observation",
+			genState: &types.GenesisState{
+				PoolList:  []types.Pool{{Id: 0}},
+				PoolCount: 1,
+				TwapObservationList: []types.TwapObservation{
+					types.NewTwapObservation(0, time.Unix(0, 0)),
+					types.NewTwapObservation(0, time.Unix(1, 0)),
+				},
+				TwapRingList: []types.TwapRing{{PoolId: 0, LastIndex: 0, Length: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "twap observations without ring",
+			genState: &types.GenesisState{
+				PoolList:            []types.Pool{{Id: 0}},
+				PoolCount:           1,
+				TwapObservationList: []types.TwapObservation{types.NewTwapObservation(0, time.Unix(0, 0))},
+			},
+			valid: false,
+		},
+		{
+			desc: "twap ring without latest observation",
+			genState: &types.GenesisState{
+				PoolList:            []types.Pool{{Id: 0}},
+				PoolCount:           1,
+				TwapObservationList: []types.TwapObservation{types.NewTwapObservation(0, time.Unix(0, 0))},
+				TwapRingList:        []types.TwapRing{{PoolId: 0, LastIndex: 1, Length: 1}},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid pool count",
 			genState: &types.GenesisState{
@@ -96,6 +220,118 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated tick info",
+			genState: &types.GenesisState{
+				PoolList:  []types.Pool{{Id: 0}},
+				PoolCount: 1,
+				TickInfoList: []types.TickInfo{
+					{PoolId: 0, TickIndex: 10},
+					{PoolId: 0, TickIndex: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tick info of unknown pool",
+			genState: &types.GenesisState{
+				TickInfoList: []types.TickInfo{
+					{PoolId: 0, TickIndex: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "missing position tick",
+			genState: &types.GenesisState{
+				PoolList:  []types.Pool{{Id: 0}},
+				PoolCount: 1,
+				PositionList: []types.Position{
+					{Id: 0, PoolId: 0, LowerTick: -10, UpperTick: 20, Liquidity: math.LegacyNewDec(100)},
+				},
+				PositionCount: 1,
+				TickInfoList:  validTickInfoList()[:2],
+				AccumulatorList: []types.AccumulatorObject{
+					{Name: types.KeyFeePoolAccumulator(0), TotalShares: math.LegacyNewDec(100)},
+				},
+				AccumulatorPositionList: []types.GenesisAccumulatorPosition{
+					{
+						AccumName: types.KeyFeePoolAccumulator(0),
+						Name:      types.KeyFeePositionAccumulator(0),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(100)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "missing fee accumulator position",
+			genState: &types.GenesisState{
+				PoolList:  []types.Pool{{Id: 0}},
+				PoolCount: 1,
+				PositionList: []types.Position{
+					{Id: 0, PoolId: 0, LowerTick: -10, UpperTick: 10, Liquidity: math.LegacyNewDec(100)},
+				},
+				PositionCount: 1,
+				TickInfoList:  validTickInfoList()[:2],
+				AccumulatorList: []types.AccumulatorObject{
+					{Name: types.KeyFeePoolAccumulator(0), TotalShares: math.LegacyZeroDec()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "accumulator shares not equal to position liquidity",
+			genState: &types.GenesisState{
+				PoolList:  []types.Pool{{Id: 0}},
+				PoolCount: 1,
+				PositionList: []types.Position{
+					{Id: 0, PoolId: 0, LowerTick: -10, UpperTick: 10, Liquidity: math.LegacyNewDec(100)},
+				},
+				PositionCount: 1,
+				TickInfoList:  validTickInfoList()[:2],
+				AccumulatorList: []types.AccumulatorObject{
+					{Name: types.KeyFeePoolAccumulator(0), TotalShares: math.LegacyNewDec(99)},
+				},
+				AccumulatorPositionList: []types.GenesisAccumulatorPosition{
+					{
+						AccumName: types.KeyFeePoolAccumulator(0),
+						Name:      types.KeyFeePositionAccumulator(0),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(99)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "accumulator total shares not equal to positions shares",
+			genState: &types.GenesisState{
+				AccumulatorList: []types.AccumulatorObject{
+					{Name: types.KeyFeePoolAccumulator(0), TotalShares: math.LegacyNewDec(100)},
+				},
+				AccumulatorPositionList: []types.GenesisAccumulatorPosition{
+					{
+						AccumName: types.KeyFeePoolAccumulator(0),
+						Name:      types.KeyFeePositionAccumulator(0),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(50)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "accumulator position of unknown accumulator",
+			genState: &types.GenesisState{
+				AccumulatorPositionList: []types.GenesisAccumulatorPosition{
+					{
+						AccumName: types.KeyFeePoolAccumulator(0),
+						Name:      types.KeyFeePositionAccumulator(0),
+						Position:  types.AccumulatorPosition{NumShares: math.LegacyNewDec(50)},
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -109,3 +345,12 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func validTickInfoList() []types.TickInfo {
+	return []types.TickInfo{
+		{PoolId: 0, TickIndex: -10},
+		{PoolId: 0, TickIndex: 10},
+		{PoolId: 1, TickIndex: 0},
+		{PoolId: 1, TickIndex: 10},
+	}
+}
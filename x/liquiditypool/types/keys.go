@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -31,6 +32,7 @@ func KeyPrefix(p string) []byte {
 const (
 	PoolKey      = "Pool/value/"
 	PoolCountKey = "Pool/count/"
+	PoolByDenoms = "PoolByDenoms/"
 )
 
 const (
@@ -41,14 +43,29 @@ const (
 )
 
 const (
-	TickInfoKey                  = "TickInfo/value/"
-	TickNegativePrefix           = "N"
-	TickPositivePrefix           = "P"
-	FeePositionAccumulatorPrefix = "FeePositionAccumulator/value/"
-	KeyFeePoolAccumulatorPrefix  = "FeePoolAccumulator/value/"
-	KeyAccumPrefix               = "Accumulator/Acc/value/"
-	KeyAccumulatorPositionPrefix = "Accumulator/Pos/value"
-	KeySeparator                 = "||"
+	LimitOrderKey       = "LimitOrder/value/"
+	LimitOrderCountKey  = "LimitOrder/count/"
+	LimitOrderByPool    = "LimitOrderByPool/"
+	LimitOrderByAddress = "LimitOrderByAddress/"
+	LimitOrderByTrigger = "LimitOrderByTrigger/"
+)
+
+const (
+	TwapObservationKey = "TwapObservation/value/"
+	TwapRingKey        = "TwapRing/value/"
+)
+
+const (
+	TickInfoKey                        = "TickInfo/value/"
+	TickNegativePrefix                 = "N"
+	TickPositivePrefix                 = "P"
+	FeePositionAccumulatorPrefix       = "FeePositionAccumulator/value/"
+	IncentivePositionAccumulatorPrefix = "IncentivePositionAccumulator/value/"
+	KeyFeePoolAccumulatorPrefix        = "FeePoolAccumulator/value/"
+	KeyIncentivePoolAccumulatorPrefix  = "IncentivePoolAccumulator/value/"
+	KeyAccumPrefix                     = "Accumulator/Acc/value/"
+	KeyAccumulatorPositionPrefix       = "Accumulator/Pos/value"
+	KeySeparator                       = "||"
 )
 
 func TickIndexFromBytes(bz []byte) (int64, error) {
@@ -95,12 +112,23 @@ func KeyFeePositionAccumulator(positionId uint64) string {
 	return strings.Join([]string{string(FeePositionAccumulatorPrefix), strconv.FormatUint(positionId, 10)}, "|")
 }
 
+func KeyIncentivePositionAccumulator(positionId uint64) string {
+	return strings.Join([]string{string(IncentivePositionAccumulatorPrefix), strconv.FormatUint(positionId, 10)}, "|")
+}
+
 // This is guaranteed to not contain "||" so it can be used as an accumulator name.
 func KeyFeePoolAccumulator(poolId uint64) string {
 	poolIdStr := strconv.FormatUint(poolId, 10)
 	return strings.Join([]string{string(KeyFeePoolAccumulatorPrefix), poolIdStr}, "/")
 }
 
+// KeyIncentivePoolAccumulator returns the name of the accumulator without shares holding
+// the unclaimed incentive accumulation of the positions of a pool.
+func KeyIncentivePoolAccumulator(poolId uint64) string {
+	poolIdStr := strconv.FormatUint(poolId, 10)
+	return strings.Join([]string{string(KeyIncentivePoolAccumulatorPrefix), poolIdStr}, "/")
+}
+
 func FormatKeyAccumPrefix(accumName string) []byte {
 	return []byte(fmt.Sprintf(KeyAccumPrefix+"%s", accumName))
 }
@@ -116,3 +144,43 @@ func PositionByPoolPrefix(poolId uint64) []byte {
 func PositionByAddressPrefix(addr string) []byte {
 	return append([]byte(PositionByAddress), addr...)
 }
+
+// PoolByDenomsPrefix returns the prefix of the pools of the denom pair.
+// The denoms are sorted so that both orders of a pair share the prefix.
+func PoolByDenomsPrefix(denomBase, denomQuote string) []byte {
+	if denomQuote < denomBase {
+		denomBase, denomQuote = denomQuote, denomBase
+	}
+	bz := []byte(PoolByDenoms)
+	bz = append(bz, address.MustLengthPrefix([]byte(denomBase))...)
+	bz = append(bz, address.MustLengthPrefix([]byte(denomQuote))...)
+	return bz
+}
+
+func LimitOrderByPoolPrefix(poolId uint64) []byte {
+	return append([]byte(LimitOrderByPool), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func LimitOrderByAddressPrefix(addr string) []byte {
+	return append([]byte(LimitOrderByAddress), addr...)
+}
+
+// LimitOrderByTriggerPrefix returns the prefix of open limit orders of a pool
+// which are filled when the price moves up (sell base) or down (sell quote).
+func LimitOrderByTriggerPrefix(poolId uint64, up bool) []byte {
+	bz := append([]byte(LimitOrderByTrigger), sdk.Uint64ToBigEndian(poolId)...)
+	if up {
+		return append(bz, 1)
+	}
+	return append(bz, 0)
+}
+
+func LimitOrderByTriggerKey(poolId uint64, up bool, tick int64, orderId uint64) []byte {
+	bz := LimitOrderByTriggerPrefix(poolId, up)
+	bz = append(bz, TickIndexToBytes(tick)...)
+	return append(bz, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+func TwapObservationPrefix(poolId uint64) []byte {
+	return append([]byte(TwapObservationKey), sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,22 @@
+package types
+
+// Trigger returns the tick whose crossing fills the order and the direction of
+// the crossing. Orders selling base are filled when the price moves up through
+// the upper tick and orders selling quote when it moves down through the lower tick.
+func (o LimitOrder) Trigger(denomBase string) (up bool, tick int64) {
+	if o.TokenIn.Denom == denomBase {
+		return true, o.Tick + 1
+	}
+	return false, o.Tick
+}
+
+// Crossed returns true if the current tick of the pool is past the trigger tick of the order,
+// so that the position of the order is fully converted to the output asset.
+func (o LimitOrder) Crossed(denomBase string, currentTick int64) bool {
+	up, tick := o.Trigger(denomBase)
+	if up {
+		return currentTick >= tick
+	}
+	// Moving down through tick T sets the current tick to T-1
+	return currentTick < tick
+}
@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/liquiditypool/limit_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitOrder is a single tick width position on one side of the current tick
+// which is closed once the price crosses it.
+type LimitOrder struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PoolId     uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PositionId uint64 `protobuf:"varint,4,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// The order covers [tick, tick + 1]
+	Tick    int64      `protobuf:"varint,5,opt,name=tick,proto3" json:"tick,omitempty"`
+	TokenIn types.Coin `protobuf:"bytes,6,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	Filled  bool       `protobuf:"varint,7,opt,name=filled,proto3" json:"filled,omitempty"`
+	// Amount converted to the output asset including fees, set once filled
+	FilledAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=filled_amount,json=filledAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"filled_amount"`
+	// Incentive accumulation of the position carried over when the order is filled,
+	// claimable by the owner in x/liquidityincentive until the order is claimed
+	IncentiveAccumulation github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=incentive_accumulation,json=incentiveAccumulation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"incentive_accumulation"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db35ba0c5488b93, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *LimitOrder) GetFilledAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FilledAmount
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetIncentiveAccumulation() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.IncentiveAccumulation
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LimitOrder)(nil), "sunrise.liquiditypool.LimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("sunrise/liquiditypool/limit_order.proto", fileDescriptor_2db35ba0c5488b93)
+}
+
+var fileDescriptor_2db35ba0c5488b93 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xb6, 0xb4, 0x9d, 0x07, 0x1c, 0x2c, 0x36, 0xcc, 0x84, 0xd2, 0x88, 0x0b, 0x91,
+	0x10, 0x31, 0x63, 0xe2, 0xc2, 0x6d, 0x83, 0x4b, 0x25, 0xa4, 0x49, 0x39, 0x72, 0x89, 0x92, 0xd8,
+	0x94, 0xa7, 0x26, 0x7e, 0x21, 0x76, 0x26, 0xfa, 0x0d, 0x38, 0xf2, 0x39, 0xf8, 0x24, 0x3b, 0xee,
+	0x82, 0xc4, 0x09, 0x50, 0xfb, 0x45, 0x90, 0x9d, 0x14, 0x0d, 0x84, 0xd0, 0x4e, 0x7e, 0x7f, 0xbf,
+	0xff, 0x7b, 0xbf, 0x67, 0xeb, 0xd1, 0xc7, 0xa6, 0xd5, 0x0d, 0x18, 0x25, 0x4a, 0xf8, 0xd0, 0x82,
+	0x04, 0xbb, 0xae, 0x11, 0x4b, 0x51, 0x42, 0x05, 0x36, 0xc5, 0x46, 0xaa, 0x26, 0xae, 0x1b, 0xb4,
+	0xc8, 0x0e, 0x7a, 0x63, 0xfc, 0x87, 0xf1, 0xe8, 0xde, 0x12, 0x97, 0xe8, 0x1d, 0xc2, 0x45, 0x9d,
+	0xf9, 0x28, 0x28, 0xd0, 0x54, 0x68, 0x44, 0x9e, 0x19, 0x25, 0x2e, 0x8e, 0x73, 0x65, 0xb3, 0x63,
+	0x51, 0x20, 0xe8, 0x2e, 0xff, 0xe8, 0xeb, 0x88, 0xd2, 0x37, 0x0e, 0x71, 0xee, 0x08, 0xec, 0x2e,
+	0x1d, 0x82, 0xe4, 0x24, 0x24, 0xd1, 0x38, 0x19, 0x82, 0x64, 0x9c, 0x4e, 0x33, 0x29, 0x1b, 0x65,
+	0x0c, 0x1f, 0x86, 0x24, 0xda, 0x4b, 0x76, 0x92, 0xdd, 0xa7, 0x53, 0x87, 0x4d, 0x41, 0xf2, 0x91,
+	0xb7, 0x4f, 0x9c, 0x5c, 0x48, 0x36, 0xa7, 0xfb, 0x35, 0x1a, 0xb0, 0x80, 0xda, 0x25, 0xc7, 0x3e,
+	0x49, 0x77, 0x57, 0x0b, 0xc9, 0x18, 0x1d, 0x5b, 0x28, 0x56, 0xfc, 0x56, 0x48, 0xa2, 0x51, 0xe2,
+	0x63, 0xf6, 0x92, 0xce, 0x2c, 0xae, 0x94, 0x4e, 0x41, 0xf3, 0x49, 0x48, 0xa2, 0xfd, 0xe7, 0x0f,
+	0xe2, 0x6e, 0xf2, 0xd8, 0x4d, 0x1e, 0xf7, 0x93, 0xc7, 0xaf, 0x10, 0xf4, 0xd9, 0xf8, 0xf2, 0xfb,
+	0x7c, 0x90, 0x4c, 0x7d, 0xc1, 0x42, 0xb3, 0x43, 0x3a, 0x79, 0x07, 0x65, 0xa9, 0x24, 0x9f, 0x86,
+	0x24, 0x9a, 0x25, 0xbd, 0x62, 0x35, 0xbd, 0xd3, 0x45, 0x69, 0x56, 0x61, 0xab, 0x2d, 0x9f, 0x85,
+	0xa3, 0xff, 0x37, 0x7e, 0xe6, 0x1a, 0x7f, 0xf9, 0x31, 0x8f, 0x96, 0x60, 0xdf, 0xb7, 0x79, 0x5c,
+	0x60, 0x25, 0xfa, 0xff, 0xeb, 0x8e, 0xa7, 0x46, 0xae, 0x84, 0x5d, 0xd7, 0xca, 0xf8, 0x02, 0x93,
+	0xdc, 0xee, 0x08, 0xa7, 0x1e, 0xc0, 0x3e, 0x11, 0x7a, 0x08, 0xba, 0x50, 0xda, 0xc2, 0x85, 0x4a,
+	0xb3, 0xa2, 0x68, 0xab, 0xb6, 0xcc, 0xdc, 0xb3, 0xf9, 0x9e, 0x67, 0x3f, 0xfc, 0x27, 0xfb, 0xb5,
+	0x2a, 0x3c, 0xfe, 0xa4, 0xc7, 0x3f, 0xb9, 0x01, 0xbe, 0xaf, 0x31, 0xc9, 0xc1, 0x6f, 0xe0, 0xe9,
+	0x35, 0xde, 0xd9, 0xf9, 0xe5, 0x26, 0x20, 0x57, 0x9b, 0x80, 0xfc, 0xdc, 0x04, 0xe4, 0xf3, 0x36,
+	0x18, 0x5c, 0x6d, 0x83, 0xc1, 0xb7, 0x6d, 0x30, 0x78, 0xfb, 0xe2, 0x5a, 0xf7, 0x7e, 0x93, 0xca,
+	0x6c, 0xad, 0x9a, 0x9d, 0x10, 0x1f, 0xff, 0xda, 0x40, 0x0f, 0xcc, 0x27, 0x7e, 0x5f, 0x4e, 0x7e,
+	0x0d, 0x00, 0xed, 0x5e, 0xc6, 0x0e, 0xa7, 0x02, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncentiveAccumulation) > 0 {
+		for iNdEx := len(m.IncentiveAccumulation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveAccumulation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FilledAmount) > 0 {
+		for iNdEx := len(m.FilledAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilledAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Tick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PositionId))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Tick))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	if m.Filled {
+		n += 2
+	}
+	if len(m.FilledAmount) > 0 {
+		for _, e := range m.FilledAmount {
+			l = e.Size()
+			n += 1 + l + sovLimitOrder(uint64(l))
+		}
+	}
+	if len(m.IncentiveAccumulation) > 0 {
+		for _, e := range m.IncentiveAccumulation {
+			l = e.Size()
+			n += 1 + l + sovLimitOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitOrder(x uint64) (n int) {
+	return sovLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledAmount = append(m.FilledAmount, types.Coin{})
+			if err := m.FilledAmount[len(m.FilledAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAccumulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveAccumulation = append(m.IncentiveAccumulation, types.DecCoin{})
+			if err := m.IncentiveAccumulation[len(m.IncentiveAccumulation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
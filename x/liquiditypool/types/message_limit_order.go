@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func NewMsgPlaceLimitOrder(sender string, poolId uint64, tick int64, tokenIn sdk.Coin) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Sender:  sender,
+		PoolId:  poolId,
+		Tick:    tick,
+		TokenIn: tokenIn,
+	}
+}
+
+func (msg *MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.Tick >= TICK_MAX {
+		return errorsmod.Wrapf(ErrInvalidTickers, "tick %d is out of range", msg.Tick)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidTokenAmounts, "token in %s", msg.TokenIn)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func NewMsgCancelLimitOrder(sender string, id uint64) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+func (msg *MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func NewMsgClaimLimitOrder(sender string, id uint64) *MsgClaimLimitOrder {
+	return &MsgClaimLimitOrder{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+func (msg *MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
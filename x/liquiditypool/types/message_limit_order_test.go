@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+)
+
+func TestMsgPlaceLimitOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlaceLimitOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlaceLimitOrder{
+				Sender:  "invalid_address",
+				TokenIn: sdk.NewInt64Coin("base", 1000),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "tick out of range",
+			msg: MsgPlaceLimitOrder{
+				Sender:  sample.AccAddress(),
+				Tick:    TICK_MAX,
+				TokenIn: sdk.NewInt64Coin("base", 1000),
+			},
+			err: ErrInvalidTickers,
+		},
+		{
+			name: "zero amount",
+			msg: MsgPlaceLimitOrder{
+				Sender:  sample.AccAddress(),
+				TokenIn: sdk.NewInt64Coin("base", 0),
+			},
+			err: ErrInvalidTokenAmounts,
+		},
+		{
+			name: "valid address",
+			msg: MsgPlaceLimitOrder{
+				Sender:  sample.AccAddress(),
+				Tick:    -10,
+				TokenIn: sdk.NewInt64Coin("base", 1000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelLimitOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelLimitOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelLimitOrder{
+				Sender: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCancelLimitOrder{
+				Sender: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgClaimLimitOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgClaimLimitOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgClaimLimitOrder{
+				Sender: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgClaimLimitOrder{
+				Sender: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
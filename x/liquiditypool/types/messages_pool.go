@@ -10,16 +10,16 @@ var _ sdk.Msg = &MsgCreatePool{}
 
 func NewMsgCreatePool(authority string, denomBase string, denomQuote string) *MsgCreatePool {
 	return &MsgCreatePool{
-		Authority:  authority,
+		Sender:     authority,
 		DenomBase:  denomBase,
 		DenomQuote: denomQuote,
 	}
 }
 
 func (msg *MsgCreatePool) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 	return nil
 }
@@ -17,13 +17,13 @@ func TestMsgCreatePool_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid address",
 			msg: MsgCreatePool{
-				Authority: "invalid_address",
+				Sender: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
 			name: "valid address",
 			msg: MsgCreatePool{
-				Authority: sample.AccAddress(),
+				Sender: sample.AccAddress(),
 			},
 		},
 	}
@@ -1,8 +1,13 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/sunriselayer/sunrise/pkg/appconsts"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -13,10 +18,55 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(withdrawFeeRate, swapTreasuryTaxRate math.LegacyDec) Params {
+func NewParams(
+	withdrawFeeRate, swapTreasuryTaxRate math.LegacyDec,
+	allowedFeeTiers []FeeTier,
+	poolCreationFee sdk.Coins,
+	burnPoolCreationFee bool,
+	twapObservations uint64,
+	minLimitOrderLiquidity math.LegacyDec,
+	maxLimitOrderFillsPerSwap uint64,
+) Params {
 	return Params{
 		WithdrawFeeRate:     withdrawFeeRate,
 		SwapTreasuryTaxRate: swapTreasuryTaxRate,
+		AllowedFeeTiers:     allowedFeeTiers,
+		PoolCreationFee:     poolCreationFee,
+		BurnPoolCreationFee: burnPoolCreationFee,
+		TwapObservations:    twapObservations,
+
+		MinLimitOrderLiquidity:    minLimitOrderLiquidity,
+		MaxLimitOrderFillsPerSwap: maxLimitOrderFillsPerSwap,
+	}
+}
+
+// DefaultTwapObservations is the default size of the TWAP ring buffer of each pool
+const DefaultTwapObservations uint64 = 1000
+
+// DefaultMaxLimitOrderFillsPerSwap is the default number of limit orders filled by a single swap
+const DefaultMaxLimitOrderFillsPerSwap uint64 = 100
+
+// DefaultMinLimitOrderLiquidity returns the minimum liquidity of a limit order by default
+func DefaultMinLimitOrderLiquidity() math.LegacyDec {
+	return math.LegacyNewDec(1_000_000)
+}
+
+// DefaultPoolCreationFee returns the fee charged for a permissionless pool creation by default
+func DefaultPoolCreationFee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100_000_000))
+}
+
+// DefaultFeeTiers returns the fee tiers allowed by default
+func DefaultFeeTiers() []FeeTier {
+	tickParams := TickParams{
+		PriceRatio: math.LegacyNewDecWithPrec(10001, 4),
+		BaseOffset: math.LegacyZeroDec(),
+	}
+	return []FeeTier{
+		{FeeRate: math.LegacyNewDecWithPrec(1, 4), TickParams: tickParams},
+		{FeeRate: math.LegacyNewDecWithPrec(5, 4), TickParams: tickParams},
+		{FeeRate: math.LegacyNewDecWithPrec(3, 3), TickParams: tickParams},
+		{FeeRate: math.LegacyNewDecWithPrec(1, 2), TickParams: tickParams},
 	}
 }
 
@@ -25,6 +75,12 @@ func DefaultParams() Params {
 	return NewParams(
 		math.LegacyNewDecWithPrec(1, 2),
 		math.LegacyNewDecWithPrec(1, 2),
+		DefaultFeeTiers(),
+		DefaultPoolCreationFee(),
+		false,
+		DefaultTwapObservations,
+		DefaultMinLimitOrderLiquidity(),
+		DefaultMaxLimitOrderFillsPerSwap,
 	)
 }
 
@@ -35,5 +91,64 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	for i, tier := range p.AllowedFeeTiers {
+		if err := tier.Validate(); err != nil {
+			return err
+		}
+		for _, other := range p.AllowedFeeTiers[:i] {
+			if tier.Equal(other) {
+				return fmt.Errorf("duplicated fee tier: %s", tier.String())
+			}
+		}
+	}
+
+	if err := p.PoolCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid pool creation fee: %w", err)
+	}
+
+	if p.TwapObservations == 0 {
+		return fmt.Errorf("twap observations must be positive")
+	}
+
+	if p.MinLimitOrderLiquidity.IsNil() || p.MinLimitOrderLiquidity.IsNegative() {
+		return fmt.Errorf("min limit order liquidity must not be negative: %s", p.MinLimitOrderLiquidity)
+	}
+
+	if p.MaxLimitOrderFillsPerSwap == 0 {
+		return fmt.Errorf("max limit order fills per swap must be positive")
+	}
+
 	return nil
 }
+
+// Validate validates the fee rate and the tick params of the fee tier
+func (t FeeTier) Validate() error {
+	if t.FeeRate.IsNil() || t.FeeRate.IsNegative() || t.FeeRate.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("fee rate must be in [0, 1): %s", t.FeeRate)
+	}
+	return t.TickParams.Validate()
+}
+
+// Validate validates the price ratio and the base offset of the tick params
+func (p TickParams) Validate() error {
+	if p.PriceRatio.IsNil() || p.PriceRatio.LTE(math.LegacyOneDec()) {
+		return fmt.Errorf("price ratio must be greater than 1: %s", p.PriceRatio)
+	}
+	if p.BaseOffset.IsNil() || p.BaseOffset.GT(math.LegacyZeroDec()) || p.BaseOffset.LTE(math.LegacyOneDec().Neg()) {
+		return fmt.Errorf("base offset must be in (-1, 0]: %s", p.BaseOffset)
+	}
+
+	return nil
+}
+
+// IsAllowedFeeTier returns true if the fee rate and tick params are whitelisted
+func (p Params) IsAllowedFeeTier(feeRate math.LegacyDec, tickParams TickParams) bool {
+	for _, tier := range p.AllowedFeeTiers {
+		if tier.FeeRate.Equal(feeRate) &&
+			tier.TickParams.PriceRatio.Equal(tickParams.PriceRatio) &&
+			tier.TickParams.BaseOffset.Equal(tickParams.BaseOffset) {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	WithdrawFeeRate     cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"withdraw_fee_rate"`
 	SwapTreasuryTaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=swap_treasury_tax_rate,json=swapTreasuryTaxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"swap_treasury_tax_rate"`
+	// Fee tiers and tick params that can be used in permissionless pool
+	// creation. The authority may create pools with any fee tier.
+	AllowedFeeTiers []FeeTier `protobuf:"bytes,3,rep,name=allowed_fee_tiers,json=allowedFeeTiers,proto3" json:"allowed_fee_tiers"`
+	// Fee charged to the creator of a permissionless pool.
+	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee"`
+	// If true the pool creation fee is burned, otherwise it is sent to the
+	// community pool.
+	BurnPoolCreationFee bool `protobuf:"varint,5,opt,name=burn_pool_creation_fee,json=burnPoolCreationFee,proto3" json:"burn_pool_creation_fee,omitempty"`
+	// Number of observations kept in the TWAP ring buffer of each pool.
+	TwapObservations uint64 `protobuf:"varint,6,opt,name=twap_observations,json=twapObservations,proto3" json:"twap_observations,omitempty"`
+	// Minimum liquidity of the position of a limit order.
+	MinLimitOrderLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_limit_order_liquidity,json=minLimitOrderLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_limit_order_liquidity"`
+	// Maximum number of limit orders filled by a single swap. The crossed orders
+	// beyond it are filled when claimed.
+	MaxLimitOrderFillsPerSwap uint64 `protobuf:"varint,8,opt,name=max_limit_order_fills_per_swap,json=maxLimitOrderFillsPerSwap,proto3" json:"max_limit_order_fills_per_swap,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,8 +82,90 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedFeeTiers() []FeeTier {
+	if m != nil {
+		return m.AllowedFeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PoolCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnPoolCreationFee() bool {
+	if m != nil {
+		return m.BurnPoolCreationFee
+	}
+	return false
+}
+
+func (m *Params) GetTwapObservations() uint64 {
+	if m != nil {
+		return m.TwapObservations
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLimitOrderFillsPerSwap() uint64 {
+	if m != nil {
+		return m.MaxLimitOrderFillsPerSwap
+	}
+	return 0
+}
+
+// FeeTier is a pair of fee rate and tick params allowed for pool creation.
+type FeeTier struct {
+	FeeRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate"`
+	TickParams TickParams                  `protobuf:"bytes,2,opt,name=tick_params,json=tickParams,proto3" json:"tick_params"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99b3b5fdb152ba71, []int{1}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetTickParams() TickParams {
+	if m != nil {
+		return m.TickParams
+	}
+	return TickParams{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sunrise.liquiditypool.Params")
+	proto.RegisterType((*FeeTier)(nil), "sunrise.liquiditypool.FeeTier")
 }
 
 func init() {
@@ -74,27 +173,46 @@ func init() {
 }
 
 var fileDescriptor_99b3b5fdb152ba71 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0x2c, 0xc8, 0xcf,
-	0xcf, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xaa, 0xd1, 0x43, 0x51, 0x23, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
-	0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x99, 0x9c,
-	0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0x91, 0x80, 0x70, 0x20, 0x52, 0x4a, 0x4d, 0x4c, 0x5c, 0x6c,
-	0x01, 0x60, 0xbb, 0x84, 0x92, 0xb8, 0x04, 0xcb, 0x33, 0x4b, 0x32, 0x52, 0x8a, 0x12, 0xcb, 0xe3,
-	0xd3, 0x52, 0x53, 0xe3, 0x8b, 0x12, 0x4b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0xcc,
-	0x4e, 0xdc, 0x93, 0x67, 0xb8, 0x75, 0x4f, 0x5e, 0x1a, 0xa2, 0xb7, 0x38, 0x25, 0x5b, 0x2f, 0x33,
-	0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31, 0xb9, 0xd2, 0x25, 0x35, 0xf9,
-	0xd2, 0x16, 0x5d, 0x2e, 0xa8, 0xd1, 0x2e, 0xa9, 0xc9, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0xe2,
-	0x87, 0x19, 0xe8, 0x96, 0x9a, 0x1a, 0x94, 0x58, 0x92, 0x2a, 0x94, 0xcd, 0x25, 0x56, 0x5c, 0x9e,
-	0x58, 0x10, 0x5f, 0x52, 0x94, 0x9a, 0x58, 0x5c, 0x5a, 0x54, 0x19, 0x5f, 0x92, 0x58, 0x01, 0xb1,
-	0x88, 0x89, 0x22, 0x8b, 0x84, 0x41, 0xa6, 0x86, 0x40, 0x0d, 0x0d, 0x49, 0xac, 0x00, 0x59, 0x66,
-	0xa5, 0xfe, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x72, 0xb0, 0x30, 0xae, 0x40, 0x0b,
-	0x65, 0x88, 0xcf, 0x9d, 0xfc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0x48, 0x4e, 0x62,
-	0x65, 0x6a, 0x91, 0x3e, 0x2e, 0x13, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x6b,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xb7, 0x5c, 0x75, 0xdd, 0x01, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0x13, 0x31,
+	0x1c, 0xcd, 0xd1, 0xff, 0xee, 0x00, 0xb9, 0x42, 0x75, 0x29, 0xd2, 0x25, 0x74, 0x21, 0x2a, 0xea,
+	0x9d, 0xda, 0xaa, 0x0c, 0xdd, 0x48, 0xab, 0x4c, 0x45, 0x2d, 0x21, 0x2c, 0x2c, 0x96, 0xef, 0xee,
+	0xd7, 0xd4, 0xca, 0xdd, 0xf9, 0x6a, 0x3b, 0x4d, 0x22, 0xf1, 0x09, 0x98, 0xf8, 0x08, 0x8c, 0x88,
+	0xa9, 0x48, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x62, 0x28, 0xa8, 0x19, 0xca, 0xc6, 0x57, 0x40, 0xf6,
+	0x39, 0x25, 0x2d, 0x64, 0x2a, 0x4b, 0x62, 0xff, 0x7e, 0x3f, 0xbf, 0xf7, 0xec, 0xf7, 0x12, 0xb4,
+	0x2c, 0x3a, 0x29, 0xa7, 0x02, 0xfc, 0x98, 0x1e, 0x75, 0x68, 0x44, 0x65, 0x3f, 0x63, 0x2c, 0xf6,
+	0x33, 0xc2, 0x49, 0x22, 0xbc, 0x8c, 0x33, 0xc9, 0xec, 0x07, 0x66, 0xc6, 0xbb, 0x36, 0xb3, 0x54,
+	0x24, 0x09, 0x4d, 0x99, 0xaf, 0x3f, 0xf3, 0xc9, 0xa5, 0xfb, 0x2d, 0xd6, 0x62, 0x7a, 0xe9, 0xab,
+	0x95, 0xa9, 0x96, 0x42, 0x26, 0x12, 0x26, 0x70, 0xde, 0xc8, 0x37, 0xa6, 0xe5, 0xe6, 0x3b, 0x3f,
+	0x20, 0x02, 0xfc, 0xe3, 0xb5, 0x00, 0x24, 0x59, 0xf3, 0x43, 0x46, 0x53, 0xd3, 0xaf, 0x8c, 0x91,
+	0xc7, 0x58, 0x9c, 0x4f, 0x2c, 0xff, 0x9a, 0x42, 0xd3, 0xfb, 0x5a, 0xad, 0x1d, 0xa0, 0x62, 0x97,
+	0xca, 0xc3, 0x88, 0x93, 0x2e, 0x3e, 0x00, 0xc0, 0x9c, 0x48, 0x70, 0xac, 0x8a, 0x55, 0x9d, 0xab,
+	0x3d, 0x3d, 0x3d, 0x2f, 0x17, 0xbe, 0x9d, 0x97, 0x1f, 0xe6, 0x7c, 0x22, 0x6a, 0x7b, 0x94, 0xf9,
+	0x09, 0x91, 0x87, 0xde, 0x2e, 0xb4, 0x48, 0xd8, 0xdf, 0x81, 0xf0, 0xcb, 0xe7, 0x55, 0x64, 0xc4,
+	0xed, 0x40, 0xf8, 0xe1, 0xf2, 0x64, 0xc5, 0x6a, 0xdc, 0x1d, 0x02, 0xd6, 0x01, 0x1a, 0x44, 0x82,
+	0xdd, 0x46, 0x8b, 0xa2, 0x4b, 0x32, 0x2c, 0x39, 0x10, 0xd1, 0xe1, 0x7d, 0x2c, 0x49, 0x2f, 0x27,
+	0xba, 0x73, 0x2b, 0xa2, 0x05, 0x85, 0xda, 0x34, 0xa0, 0x4d, 0xd2, 0xd3, 0x64, 0xaf, 0x50, 0x91,
+	0xc4, 0x31, 0xeb, 0x42, 0xa4, 0xef, 0x23, 0x29, 0x70, 0xe1, 0x4c, 0x54, 0x26, 0xaa, 0xf3, 0xeb,
+	0xae, 0xf7, 0x4f, 0x53, 0xbc, 0x3a, 0x40, 0x93, 0x02, 0xaf, 0xcd, 0x29, 0x1d, 0xe6, 0x0e, 0x06,
+	0xc3, 0xb4, 0x84, 0xfd, 0x06, 0x15, 0xd5, 0x2c, 0x0e, 0x39, 0x10, 0x49, 0x59, 0xaa, 0xc0, 0x9d,
+	0x49, 0x0d, 0x5b, 0xf2, 0x8c, 0x30, 0x65, 0x88, 0x67, 0x0c, 0xf1, 0xb6, 0x19, 0x4d, 0x6b, 0x9b,
+	0x0a, 0xf1, 0xe3, 0xf7, 0x72, 0xb5, 0x45, 0xe5, 0x61, 0x27, 0xf0, 0x42, 0x96, 0x18, 0x2f, 0xcd,
+	0xd7, 0xaa, 0x88, 0xda, 0xbe, 0xec, 0x67, 0x20, 0xf4, 0x01, 0x61, 0xd8, 0x15, 0xd5, 0xb6, 0x61,
+	0xaa, 0x03, 0xd8, 0x1b, 0x68, 0x31, 0xe8, 0xf0, 0x14, 0xff, 0x2d, 0x61, 0xaa, 0x62, 0x55, 0x67,
+	0x1b, 0x0b, 0xaa, 0xbb, 0x7f, 0xe3, 0xd0, 0x13, 0x54, 0x94, 0xea, 0xd9, 0x59, 0x20, 0x80, 0x1f,
+	0xeb, 0xb2, 0x70, 0xa6, 0x2b, 0x56, 0x75, 0xb2, 0x71, 0x4f, 0x35, 0xf6, 0x46, 0xea, 0xf6, 0x11,
+	0x2a, 0x25, 0x34, 0xc5, 0x31, 0x4d, 0xa8, 0xc4, 0x8c, 0x47, 0xc0, 0xf1, 0xd5, 0x23, 0x39, 0x33,
+	0xb7, 0xb2, 0x69, 0x31, 0xa1, 0xe9, 0xae, 0xc2, 0xdd, 0x53, 0xb0, 0xbb, 0x43, 0x54, 0xfb, 0x19,
+	0x72, 0x13, 0xd2, 0xbb, 0x46, 0x79, 0x40, 0xe3, 0x58, 0xe0, 0x0c, 0x38, 0x56, 0xd6, 0x3a, 0xb3,
+	0x5a, 0x6c, 0x29, 0x21, 0xbd, 0x3f, 0xe7, 0xeb, 0x6a, 0x64, 0x1f, 0xf8, 0xcb, 0x2e, 0xc9, 0xb6,
+	0x1e, 0xff, 0x7c, 0x5f, 0xb6, 0xde, 0x5e, 0x9e, 0xac, 0xb8, 0xc3, 0xcc, 0xf7, 0x6e, 0xa4, 0x3e,
+	0x8f, 0xf9, 0xf2, 0x27, 0x0b, 0xcd, 0x18, 0x2f, 0xed, 0x17, 0x68, 0xf6, 0x3f, 0x25, 0x7d, 0xe6,
+	0xc0, 0x24, 0xfc, 0x39, 0x9a, 0x97, 0x34, 0x6c, 0xe3, 0xfc, 0x2f, 0x40, 0xc7, 0x7a, 0x7e, 0xfd,
+	0xd1, 0x98, 0xb8, 0x35, 0x69, 0xd8, 0xce, 0x65, 0x8d, 0x26, 0x0e, 0xc9, 0xab, 0xf2, 0xd6, 0xa4,
+	0xba, 0x56, 0x6d, 0xef, 0xf4, 0xc2, 0xb5, 0xce, 0x2e, 0x5c, 0xeb, 0xc7, 0x85, 0x6b, 0xbd, 0x1b,
+	0xb8, 0x85, 0xb3, 0x81, 0x5b, 0xf8, 0x3a, 0x70, 0x0b, 0xaf, 0x37, 0x47, 0xe2, 0x64, 0x38, 0x62,
+	0xd2, 0x07, 0xee, 0x8f, 0x7b, 0x05, 0x9d, 0xb0, 0x60, 0x5a, 0xff, 0xfa, 0x37, 0x7e, 0x0f, 0x00,
+	0x25, 0xb0, 0x2d, 0x0c, 0xc0, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -122,6 +240,61 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SwapTreasuryTaxRate.Equal(that1.SwapTreasuryTaxRate) {
 		return false
 	}
+	if len(this.AllowedFeeTiers) != len(that1.AllowedFeeTiers) {
+		return false
+	}
+	for i := range this.AllowedFeeTiers {
+		if !this.AllowedFeeTiers[i].Equal(&that1.AllowedFeeTiers[i]) {
+			return false
+		}
+	}
+	if len(this.PoolCreationFee) != len(that1.PoolCreationFee) {
+		return false
+	}
+	for i := range this.PoolCreationFee {
+		if !this.PoolCreationFee[i].Equal(&that1.PoolCreationFee[i]) {
+			return false
+		}
+	}
+	if this.BurnPoolCreationFee != that1.BurnPoolCreationFee {
+		return false
+	}
+	if this.TwapObservations != that1.TwapObservations {
+		return false
+	}
+	if !this.MinLimitOrderLiquidity.Equal(that1.MinLimitOrderLiquidity) {
+		return false
+	}
+	if this.MaxLimitOrderFillsPerSwap != that1.MaxLimitOrderFillsPerSwap {
+		return false
+	}
+	return true
+}
+func (this *FeeTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeTier)
+	if !ok {
+		that2, ok := that.(FeeTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FeeRate.Equal(that1.FeeRate) {
+		return false
+	}
+	if !this.TickParams.Equal(&that1.TickParams) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -144,6 +317,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLimitOrderFillsPerSwap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLimitOrderFillsPerSwap))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinLimitOrderLiquidity.Size()
+		i -= size
+		if _, err := m.MinLimitOrderLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TwapObservations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TwapObservations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BurnPoolCreationFee {
+		i--
+		if m.BurnPoolCreationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedFeeTiers) > 0 {
+		for iNdEx := len(m.AllowedFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.SwapTreasuryTaxRate.Size()
 		i -= size
@@ -167,6 +398,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TickParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -188,6 +462,42 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.SwapTreasuryTaxRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedFeeTiers) > 0 {
+		for _, e := range m.AllowedFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BurnPoolCreationFee {
+		n += 2
+	}
+	if m.TwapObservations != 0 {
+		n += 1 + sovParams(uint64(m.TwapObservations))
+	}
+	l = m.MinLimitOrderLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxLimitOrderFillsPerSwap != 0 {
+		n += 1 + sovParams(uint64(m.MaxLimitOrderFillsPerSwap))
+	}
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TickParams.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -294,6 +604,283 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeTiers = append(m.AllowedFeeTiers, FeeTier{})
+			if err := m.AllowedFeeTiers[len(m.AllowedFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPoolCreationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPoolCreationFee = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapObservations", wireType)
+			}
+			m.TwapObservations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapObservations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLimitOrderLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLimitOrderLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLimitOrderFillsPerSwap", wireType)
+			}
+			m.MaxLimitOrderFillsPerSwap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLimitOrderFillsPerSwap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -16,6 +16,17 @@ func NewPoolFeesAddress(poolId uint64) sdk.AccAddress {
 	return authtypes.NewModuleAddress(fmt.Sprintf("poolfees/%d", poolId))
 }
 
+// TreasuryAddress returns the address collecting the swap treasury tax
+func TreasuryAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
+
+// LimitOrderAddress returns the address owning the positions of limit orders
+// and holding the filled amounts until they are claimed
+func LimitOrderAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress("liquiditypool/limit-orders")
+}
+
 func (p Pool) GetAddress() sdk.AccAddress {
 	return NewPoolAddress(p.Id)
 }
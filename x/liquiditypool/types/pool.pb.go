@@ -154,39 +154,66 @@ func init() {
 func init() { proto.RegisterFile("sunrise/liquiditypool/pool.proto", fileDescriptor_b5e900f89b7804df) }
 
 var fileDescriptor_b5e900f89b7804df = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xb6, 0x6c, 0xab, 0x83, 0x10, 0x58, 0x03, 0x85, 0x21, 0xd2, 0x6c, 0xa7, 0x0a,
-	0x89, 0x44, 0x02, 0xc1, 0x07, 0xa8, 0x76, 0x1c, 0x5a, 0x17, 0x90, 0x90, 0xb8, 0x44, 0x6e, 0xf2,
-	0xda, 0x59, 0x4d, 0xfa, 0x52, 0xdb, 0x91, 0xe8, 0xb7, 0xe0, 0x63, 0x70, 0xe4, 0xc0, 0x99, 0xf3,
-	0x8e, 0x13, 0x27, 0xc4, 0x61, 0x42, 0xed, 0x81, 0x23, 0x5f, 0x01, 0xd9, 0x4e, 0xc7, 0x40, 0x9c,
-	0xb6, 0x8b, 0xd5, 0xf7, 0x7f, 0x7f, 0xff, 0xfe, 0xae, 0xf3, 0x4c, 0x43, 0x55, 0xcf, 0xa5, 0x50,
-	0x10, 0x17, 0x62, 0x51, 0x8b, 0x5c, 0xe8, 0x65, 0x85, 0x58, 0xc4, 0x66, 0x89, 0x2a, 0x89, 0x1a,
-	0xd9, 0xfd, 0xc6, 0x11, 0xfd, 0xe5, 0xd8, 0xbb, 0xc7, 0x4b, 0x31, 0xc7, 0xd8, 0xae, 0xce, 0xb9,
-	0xf7, 0x30, 0x43, 0x55, 0xa2, 0x4a, 0x6d, 0x15, 0xbb, 0xa2, 0x69, 0xed, 0x4e, 0x71, 0x8a, 0x4e,
-	0x37, 0xbf, 0x9c, 0x7a, 0xf0, 0xab, 0x43, 0xbb, 0x23, 0xc4, 0x82, 0xdd, 0xa1, 0x6d, 0x91, 0xfb,
-	0x24, 0x24, 0x83, 0x6e, 0xd2, 0x16, 0x39, 0x7b, 0x4c, 0x69, 0x0e, 0x73, 0x2c, 0xd3, 0x31, 0x57,
-	0xe0, 0xb7, 0x43, 0x32, 0xe8, 0x25, 0x3d, 0xab, 0x0c, 0xb9, 0x02, 0xd6, 0xa7, 0x9e, 0x6b, 0x2f,
-	0x6a, 0xd4, 0xe0, 0x77, 0x6c, 0xdf, 0xed, 0x38, 0x31, 0x0a, 0x3b, 0xa1, 0x3b, 0x13, 0x80, 0x54,
-	0x72, 0x0d, 0x7e, 0xd7, 0x74, 0x87, 0x2f, 0xcf, 0x2e, 0xfa, 0xad, 0xef, 0x17, 0xfd, 0x47, 0xee,
-	0x58, 0x2a, 0x9f, 0x45, 0x02, 0xe3, 0x92, 0xeb, 0xd3, 0xe8, 0x08, 0xa6, 0x3c, 0x5b, 0x1e, 0x42,
-	0xf6, 0xf5, 0xf3, 0x53, 0xda, 0x9c, 0xfa, 0x10, 0xb2, 0x8f, 0x3f, 0x3f, 0x3d, 0x21, 0xc9, 0xf6,
-	0x04, 0x20, 0xe1, 0x1a, 0xd8, 0x2b, 0xea, 0x69, 0x91, 0xcd, 0xd2, 0x8a, 0x4b, 0x5e, 0x2a, 0xff,
-	0x56, 0x48, 0x06, 0xde, 0xb3, 0xfd, 0xe8, 0xbf, 0x97, 0x13, 0xbd, 0x11, 0xd9, 0x6c, 0x64, 0x8d,
-	0xc3, 0x9e, 0x09, 0x76, 0x2c, 0xaa, 0x2f, 0x65, 0xb6, 0x4f, 0x6f, 0x67, 0xb5, 0x94, 0x30, 0xd7,
-	0xa9, 0x51, 0xfd, 0xad, 0x90, 0x0c, 0x3a, 0x89, 0xd7, 0x68, 0x66, 0x3f, 0x2b, 0xe8, 0x83, 0xab,
-	0x96, 0xf4, 0x32, 0xc2, 0xdf, 0xbe, 0xd1, 0x5f, 0xda, 0xbd, 0x12, 0x72, 0xb4, 0x61, 0xb2, 0x9c,
-	0xb2, 0x4d, 0x9a, 0x5a, 0x48, 0x9d, 0x56, 0x52, 0x64, 0xe0, 0xef, 0xdc, 0x28, 0xe9, 0x6e, 0x43,
-	0x7c, 0xbd, 0x90, 0x7a, 0x64, 0x78, 0x07, 0x5f, 0x08, 0xa5, 0x7f, 0x2e, 0x87, 0xbd, 0xa5, 0x9e,
-	0xcd, 0x31, 0x5f, 0x4a, 0xa0, 0x1d, 0x80, 0xeb, 0xa7, 0x51, 0x8b, 0x4a, 0x0c, 0xc9, 0x80, 0xcd,
-	0xe8, 0xa4, 0x38, 0x99, 0x28, 0xd0, 0x6e, 0x82, 0xae, 0x0f, 0x36, 0xa8, 0x63, 0x4b, 0x1a, 0x1e,
-	0x9f, 0xad, 0x02, 0x72, 0xbe, 0x0a, 0xc8, 0x8f, 0x55, 0x40, 0x3e, 0xac, 0x83, 0xd6, 0xf9, 0x3a,
-	0x68, 0x7d, 0x5b, 0x07, 0xad, 0x77, 0x2f, 0xa6, 0x42, 0x9f, 0xd6, 0xe3, 0x28, 0xc3, 0x32, 0x6e,
-	0xa6, 0xa2, 0xe0, 0x4b, 0x90, 0x9b, 0x22, 0x7e, 0xff, 0xcf, 0x1b, 0xd3, 0xcb, 0x0a, 0xd4, 0x78,
-	0xcb, 0x3e, 0x85, 0xe7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x38, 0x40, 0x39, 0x9a, 0x89, 0x03,
-	0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xb6, 0x6c, 0xab, 0x83, 0x10, 0x58, 0x03, 0x99, 0x21, 0xd2, 0x6c, 0xa7, 0x0a,
+	0x89, 0x44, 0x02, 0xc1, 0x81, 0x63, 0xb5, 0xe3, 0xd0, 0xba, 0x80, 0x84, 0xc4, 0x25, 0x72, 0x9d,
+	0xb7, 0x9d, 0xd5, 0xa4, 0x4e, 0x6d, 0x47, 0xa2, 0xdf, 0x82, 0x8f, 0xc0, 0x91, 0x23, 0x07, 0x3e,
+	0x02, 0x87, 0x1d, 0x27, 0x4e, 0x88, 0xc3, 0x84, 0xda, 0x03, 0xdc, 0xf8, 0x0a, 0xc8, 0x4e, 0x3a,
+	0x06, 0xda, 0x2d, 0x17, 0x2b, 0xef, 0xf3, 0x3e, 0xfe, 0x3d, 0x8e, 0xff, 0xe0, 0x40, 0x97, 0x73,
+	0x25, 0x34, 0x44, 0x99, 0x58, 0x94, 0x22, 0x15, 0x66, 0x59, 0x48, 0x99, 0x45, 0x76, 0x08, 0x0b,
+	0x25, 0x8d, 0x24, 0x77, 0x6b, 0x47, 0xf8, 0x8f, 0x63, 0xef, 0x0e, 0xcb, 0xc5, 0x5c, 0x46, 0x6e,
+	0xac, 0x9c, 0x7b, 0xf7, 0xb9, 0xd4, 0xb9, 0xd4, 0x89, 0xab, 0xa2, 0xaa, 0xa8, 0x5b, 0xbb, 0x53,
+	0x39, 0x95, 0x95, 0x6e, 0xbf, 0x2a, 0xf5, 0xe0, 0x77, 0x07, 0x77, 0x47, 0x52, 0x66, 0xe4, 0x16,
+	0x6e, 0x8b, 0x94, 0xa2, 0x00, 0x0d, 0xba, 0x71, 0x5b, 0xa4, 0xe4, 0x21, 0xc6, 0x29, 0xcc, 0x65,
+	0x9e, 0x8c, 0x99, 0x06, 0xda, 0x0e, 0xd0, 0xa0, 0x17, 0xf7, 0x9c, 0x32, 0x64, 0x1a, 0x48, 0x1f,
+	0x7b, 0x55, 0x7b, 0x51, 0x4a, 0x03, 0xb4, 0xe3, 0xfa, 0xd5, 0x8c, 0x13, 0xab, 0x90, 0x13, 0xbc,
+	0x33, 0x01, 0x48, 0x14, 0x33, 0x40, 0xbb, 0xb6, 0x3b, 0x7c, 0x7e, 0x76, 0xd1, 0x6f, 0x7d, 0xbf,
+	0xe8, 0x3f, 0xa8, 0x96, 0xa5, 0xd3, 0x59, 0x28, 0x64, 0x94, 0x33, 0x73, 0x1a, 0x1e, 0xc1, 0x94,
+	0xf1, 0xe5, 0x21, 0xf0, 0xaf, 0x9f, 0x1f, 0xe3, 0x7a, 0xd5, 0x87, 0xc0, 0x3f, 0xfe, 0xfc, 0xf4,
+	0x08, 0xc5, 0xdb, 0x13, 0x80, 0x98, 0x19, 0x20, 0x2f, 0xb1, 0x67, 0x04, 0x9f, 0x25, 0x05, 0x53,
+	0x2c, 0xd7, 0xf4, 0x46, 0x80, 0x06, 0xde, 0x93, 0xfd, 0xf0, 0xda, 0xcd, 0x09, 0x5f, 0x0b, 0x3e,
+	0x1b, 0x39, 0xe3, 0xb0, 0x67, 0x83, 0x2b, 0x16, 0x36, 0x97, 0x32, 0xd9, 0xc7, 0x37, 0x79, 0xa9,
+	0x14, 0xcc, 0x4d, 0x62, 0x55, 0xba, 0x15, 0xa0, 0x41, 0x27, 0xf6, 0x6a, 0xcd, 0xce, 0x27, 0x19,
+	0xbe, 0x77, 0xd5, 0x92, 0x5c, 0x46, 0xd0, 0xed, 0x46, 0xbf, 0xb4, 0x7b, 0x25, 0xe4, 0x68, 0xc3,
+	0x24, 0x29, 0x26, 0x9b, 0x34, 0xbd, 0x50, 0x26, 0x29, 0x94, 0xe0, 0x40, 0x77, 0x1a, 0x25, 0xdd,
+	0xae, 0x89, 0xaf, 0x16, 0xca, 0x8c, 0x2c, 0xef, 0xe0, 0x0b, 0xc2, 0xf8, 0xef, 0xe6, 0x90, 0x37,
+	0xd8, 0x73, 0x39, 0xf6, 0xa4, 0x84, 0xa4, 0xa8, 0x51, 0x1a, 0x76, 0xa8, 0xd8, 0x92, 0x2c, 0xd8,
+	0x5e, 0x9d, 0x44, 0x4e, 0x26, 0x1a, 0x0c, 0x6d, 0x37, 0x03, 0x5b, 0xd4, 0xb1, 0x23, 0xbd, 0xe8,
+	0xfe, 0xfa, 0xd0, 0x47, 0xc3, 0xe3, 0xb3, 0x95, 0x8f, 0xce, 0x57, 0x3e, 0xfa, 0xb1, 0xf2, 0xd1,
+	0xfb, 0xb5, 0xdf, 0x3a, 0x5f, 0xfb, 0xad, 0x6f, 0x6b, 0xbf, 0xf5, 0xf6, 0xd9, 0x54, 0x98, 0xd3,
+	0x72, 0x1c, 0x72, 0x99, 0x47, 0xf5, 0xdd, 0xc8, 0xd8, 0x12, 0xd4, 0xa6, 0x88, 0xde, 0xfd, 0xf7,
+	0xd2, 0xcc, 0xb2, 0x00, 0x3d, 0xde, 0x72, 0x0f, 0xe2, 0xe9, 0x9f, 0x01, 0x00, 0x7c, 0x84, 0x17,
+	0xd9, 0x8f, 0x03, 0x00, 0x00,
 }
 
+func (this *TickParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TickParams)
+	if !ok {
+		that2, ok := that.(TickParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PriceRatio.Equal(that1.PriceRatio) {
+		return false
+	}
+	if !this.BaseOffset.Equal(that1.BaseOffset) {
+		return false
+	}
+	return true
+}
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
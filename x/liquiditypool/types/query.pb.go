@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -13,19 +15,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -360,6 +366,102 @@ func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryPoolsByDenomsRequest struct {
+	DenomBase  string `protobuf:"bytes,1,opt,name=denom_base,json=denomBase,proto3" json:"denom_base,omitempty"`
+	DenomQuote string `protobuf:"bytes,2,opt,name=denom_quote,json=denomQuote,proto3" json:"denom_quote,omitempty"`
+}
+
+func (m *QueryPoolsByDenomsRequest) Reset()         { *m = QueryPoolsByDenomsRequest{} }
+func (m *QueryPoolsByDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomsRequest) ProtoMessage()    {}
+func (*QueryPoolsByDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{7}
+}
+func (m *QueryPoolsByDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomsRequest.Merge(m, src)
+}
+func (m *QueryPoolsByDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomsRequest) GetDenomBase() string {
+	if m != nil {
+		return m.DenomBase
+	}
+	return ""
+}
+
+func (m *QueryPoolsByDenomsRequest) GetDenomQuote() string {
+	if m != nil {
+		return m.DenomQuote
+	}
+	return ""
+}
+
+type QueryPoolsByDenomsResponse struct {
+	Pools []PoolInfo `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryPoolsByDenomsResponse) Reset()         { *m = QueryPoolsByDenomsResponse{} }
+func (m *QueryPoolsByDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomsResponse) ProtoMessage()    {}
+func (*QueryPoolsByDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{8}
+}
+func (m *QueryPoolsByDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomsResponse.Merge(m, src)
+}
+func (m *QueryPoolsByDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomsResponse) GetPools() []PoolInfo {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 type QueryPositionRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -368,7 +470,7 @@ func (m *QueryPositionRequest) Reset()         { *m = QueryPositionRequest{} }
 func (m *QueryPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionRequest) ProtoMessage()    {}
 func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{7}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{9}
 }
 func (m *QueryPositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +516,7 @@ func (m *PositionInfo) Reset()         { *m = PositionInfo{} }
 func (m *PositionInfo) String() string { return proto.CompactTextString(m) }
 func (*PositionInfo) ProtoMessage()    {}
 func (*PositionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{8}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{10}
 }
 func (m *PositionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +574,7 @@ func (m *QueryPositionResponse) Reset()         { *m = QueryPositionResponse{} }
 func (m *QueryPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionResponse) ProtoMessage()    {}
 func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{9}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{11}
 }
 func (m *QueryPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +618,7 @@ func (m *QueryPositionsRequest) Reset()         { *m = QueryPositionsRequest{} }
 func (m *QueryPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionsRequest) ProtoMessage()    {}
 func (*QueryPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{10}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{12}
 }
 func (m *QueryPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +663,7 @@ func (m *QueryPositionsResponse) Reset()         { *m = QueryPositionsResponse{}
 func (m *QueryPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionsResponse) ProtoMessage()    {}
 func (*QueryPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{11}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{13}
 }
 func (m *QueryPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +714,7 @@ func (m *QueryPoolPositionsRequest) Reset()         { *m = QueryPoolPositionsReq
 func (m *QueryPoolPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolPositionsRequest) ProtoMessage()    {}
 func (*QueryPoolPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{12}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{14}
 }
 func (m *QueryPoolPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +758,7 @@ func (m *QueryPoolPositionsResponse) Reset()         { *m = QueryPoolPositionsRe
 func (m *QueryPoolPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolPositionsResponse) ProtoMessage()    {}
 func (*QueryPoolPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{13}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{15}
 }
 func (m *QueryPoolPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +802,7 @@ func (m *QueryAddressPositionsRequest) Reset()         { *m = QueryAddressPositi
 func (m *QueryAddressPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressPositionsRequest) ProtoMessage()    {}
 func (*QueryAddressPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{14}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{16}
 }
 func (m *QueryAddressPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +846,7 @@ func (m *QueryAddressPositionsResponse) Reset()         { *m = QueryAddressPosit
 func (m *QueryAddressPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressPositionsResponse) ProtoMessage()    {}
 func (*QueryAddressPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{15}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{17}
 }
 func (m *QueryAddressPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +890,7 @@ func (m *QueryPositionFeesRequest) Reset()         { *m = QueryPositionFeesReque
 func (m *QueryPositionFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionFeesRequest) ProtoMessage()    {}
 func (*QueryPositionFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{16}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{18}
 }
 func (m *QueryPositionFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +934,7 @@ func (m *QueryPositionFeesResponse) Reset()         { *m = QueryPositionFeesResp
 func (m *QueryPositionFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionFeesResponse) ProtoMessage()    {}
 func (*QueryPositionFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{17}
+	return fileDescriptor_c5e0418c1aefaa4d, []int{19}
 }
 func (m *QueryPositionFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,26 +970,22 @@ func (m *QueryPositionFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
-type QueryCalculationCreatePositionRequest struct {
-	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	LowerTick string `protobuf:"bytes,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
-	UpperTick string `protobuf:"bytes,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
-	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom     string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+type QueryLimitOrderRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryCalculationCreatePositionRequest) Reset()         { *m = QueryCalculationCreatePositionRequest{} }
-func (m *QueryCalculationCreatePositionRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalculationCreatePositionRequest) ProtoMessage()    {}
-func (*QueryCalculationCreatePositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{18}
+func (m *QueryLimitOrderRequest) Reset()         { *m = QueryLimitOrderRequest{} }
+func (m *QueryLimitOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrderRequest) ProtoMessage()    {}
+func (*QueryLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{20}
 }
-func (m *QueryCalculationCreatePositionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLimitOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculationCreatePositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLimitOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculationCreatePositionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLimitOrderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -897,71 +995,146 @@ func (m *QueryCalculationCreatePositionRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculationCreatePositionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculationCreatePositionRequest.Merge(m, src)
+func (m *QueryLimitOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrderRequest.Merge(m, src)
 }
-func (m *QueryCalculationCreatePositionRequest) XXX_Size() int {
+func (m *QueryLimitOrderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculationCreatePositionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculationCreatePositionRequest.DiscardUnknown(m)
+func (m *QueryLimitOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalculationCreatePositionRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLimitOrderRequest proto.InternalMessageInfo
 
-func (m *QueryCalculationCreatePositionRequest) GetPoolId() uint64 {
+func (m *QueryLimitOrderRequest) GetId() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.Id
 	}
 	return 0
 }
 
-func (m *QueryCalculationCreatePositionRequest) GetLowerTick() string {
-	if m != nil {
-		return m.LowerTick
+type QueryLimitOrderResponse struct {
+	LimitOrder LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+}
+
+func (m *QueryLimitOrderResponse) Reset()         { *m = QueryLimitOrderResponse{} }
+func (m *QueryLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLimitOrderResponse) ProtoMessage()    {}
+func (*QueryLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{21}
+}
+func (m *QueryLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLimitOrderResponse.Merge(m, src)
+}
+func (m *QueryLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLimitOrderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCalculationCreatePositionRequest) GetUpperTick() string {
+var xxx_messageInfo_QueryLimitOrderResponse proto.InternalMessageInfo
+
+func (m *QueryLimitOrderResponse) GetLimitOrder() LimitOrder {
 	if m != nil {
-		return m.UpperTick
+		return m.LimitOrder
 	}
-	return ""
+	return LimitOrder{}
 }
 
-func (m *QueryCalculationCreatePositionRequest) GetAmount() string {
+// Orders are filtered by filled if only_open or only_filled is set
+type QueryPoolLimitOrdersRequest struct {
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	OnlyOpen   bool   `protobuf:"varint,2,opt,name=only_open,json=onlyOpen,proto3" json:"only_open,omitempty"`
+	OnlyFilled bool   `protobuf:"varint,3,opt,name=only_filled,json=onlyFilled,proto3" json:"only_filled,omitempty"`
+}
+
+func (m *QueryPoolLimitOrdersRequest) Reset()         { *m = QueryPoolLimitOrdersRequest{} }
+func (m *QueryPoolLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolLimitOrdersRequest) ProtoMessage()    {}
+func (*QueryPoolLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{22}
+}
+func (m *QueryPoolLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolLimitOrdersRequest.Merge(m, src)
+}
+func (m *QueryPoolLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryPoolLimitOrdersRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryCalculationCreatePositionRequest) GetDenom() string {
+func (m *QueryPoolLimitOrdersRequest) GetOnlyOpen() bool {
 	if m != nil {
-		return m.Denom
+		return m.OnlyOpen
 	}
-	return ""
+	return false
 }
 
-type QueryCalculationCreatePositionResponse struct {
-	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+func (m *QueryPoolLimitOrdersRequest) GetOnlyFilled() bool {
+	if m != nil {
+		return m.OnlyFilled
+	}
+	return false
 }
 
-func (m *QueryCalculationCreatePositionResponse) Reset() {
-	*m = QueryCalculationCreatePositionResponse{}
+type QueryPoolLimitOrdersResponse struct {
+	LimitOrders []LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
 }
-func (m *QueryCalculationCreatePositionResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCalculationCreatePositionResponse) ProtoMessage()    {}
-func (*QueryCalculationCreatePositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{19}
+
+func (m *QueryPoolLimitOrdersResponse) Reset()         { *m = QueryPoolLimitOrdersResponse{} }
+func (m *QueryPoolLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolLimitOrdersResponse) ProtoMessage()    {}
+func (*QueryPoolLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{23}
 }
-func (m *QueryCalculationCreatePositionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculationCreatePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculationCreatePositionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolLimitOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -971,45 +1144,44 @@ func (m *QueryCalculationCreatePositionResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculationCreatePositionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculationCreatePositionResponse.Merge(m, src)
+func (m *QueryPoolLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolLimitOrdersResponse.Merge(m, src)
 }
-func (m *QueryCalculationCreatePositionResponse) XXX_Size() int {
+func (m *QueryPoolLimitOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculationCreatePositionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculationCreatePositionResponse.DiscardUnknown(m)
+func (m *QueryPoolLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolLimitOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalculationCreatePositionResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolLimitOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryCalculationCreatePositionResponse) GetAmount() types.Coin {
+func (m *QueryPoolLimitOrdersResponse) GetLimitOrders() []LimitOrder {
 	if m != nil {
-		return m.Amount
+		return m.LimitOrders
 	}
-	return types.Coin{}
+	return nil
 }
 
-type QueryCalculationIncreaseLiquidityRequest struct {
-	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AmountIn string `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
-	DenomIn  string `protobuf:"bytes,3,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty"`
+// Orders are filtered by filled if only_open or only_filled is set
+type QueryAddressLimitOrdersRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OnlyOpen   bool   `protobuf:"varint,2,opt,name=only_open,json=onlyOpen,proto3" json:"only_open,omitempty"`
+	OnlyFilled bool   `protobuf:"varint,3,opt,name=only_filled,json=onlyFilled,proto3" json:"only_filled,omitempty"`
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) Reset() {
-	*m = QueryCalculationIncreaseLiquidityRequest{}
+func (m *QueryAddressLimitOrdersRequest) Reset()         { *m = QueryAddressLimitOrdersRequest{} }
+func (m *QueryAddressLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLimitOrdersRequest) ProtoMessage()    {}
+func (*QueryAddressLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{24}
 }
-func (m *QueryCalculationIncreaseLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalculationIncreaseLiquidityRequest) ProtoMessage()    {}
-func (*QueryCalculationIncreaseLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{20}
-}
-func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAddressLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAddressLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAddressLimitOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1019,59 +1191,55 @@ func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.Merge(m, src)
+func (m *QueryAddressLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLimitOrdersRequest.Merge(m, src)
 }
-func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Size() int {
+func (m *QueryAddressLimitOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculationIncreaseLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.DiscardUnknown(m)
+func (m *QueryAddressLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLimitOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAddressLimitOrdersRequest proto.InternalMessageInfo
 
-func (m *QueryCalculationIncreaseLiquidityRequest) GetId() uint64 {
+func (m *QueryAddressLimitOrdersRequest) GetAddress() string {
 	if m != nil {
-		return m.Id
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) GetAmountIn() string {
+func (m *QueryAddressLimitOrdersRequest) GetOnlyOpen() bool {
 	if m != nil {
-		return m.AmountIn
+		return m.OnlyOpen
 	}
-	return ""
+	return false
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) GetDenomIn() string {
+func (m *QueryAddressLimitOrdersRequest) GetOnlyFilled() bool {
 	if m != nil {
-		return m.DenomIn
+		return m.OnlyFilled
 	}
-	return ""
+	return false
 }
 
-type QueryCalculationIncreaseLiquidityResponse struct {
-	TokenRequired types.Coin `protobuf:"bytes,1,opt,name=token_required,json=tokenRequired,proto3" json:"token_required"`
+type QueryAddressLimitOrdersResponse struct {
+	LimitOrders []LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
 }
 
-func (m *QueryCalculationIncreaseLiquidityResponse) Reset() {
-	*m = QueryCalculationIncreaseLiquidityResponse{}
-}
-func (m *QueryCalculationIncreaseLiquidityResponse) String() string {
-	return proto.CompactTextString(m)
+func (m *QueryAddressLimitOrdersResponse) Reset()         { *m = QueryAddressLimitOrdersResponse{} }
+func (m *QueryAddressLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLimitOrdersResponse) ProtoMessage()    {}
+func (*QueryAddressLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{25}
 }
-func (*QueryCalculationIncreaseLiquidityResponse) ProtoMessage() {}
-func (*QueryCalculationIncreaseLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5e0418c1aefaa4d, []int{21}
-}
-func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAddressLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAddressLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAddressLimitOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1081,1029 +1249,1129 @@ func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.Merge(m, src)
+func (m *QueryAddressLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLimitOrdersResponse.Merge(m, src)
 }
-func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Size() int {
+func (m *QueryAddressLimitOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculationIncreaseLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.DiscardUnknown(m)
+func (m *QueryAddressLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLimitOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAddressLimitOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryCalculationIncreaseLiquidityResponse) GetTokenRequired() types.Coin {
+func (m *QueryAddressLimitOrdersResponse) GetLimitOrders() []LimitOrder {
 	if m != nil {
-		return m.TokenRequired
+		return m.LimitOrders
 	}
-	return types.Coin{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.liquiditypool.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.liquiditypool.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "sunrise.liquiditypool.QueryPoolRequest")
-	proto.RegisterType((*PoolInfo)(nil), "sunrise.liquiditypool.PoolInfo")
-	proto.RegisterType((*QueryPoolResponse)(nil), "sunrise.liquiditypool.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "sunrise.liquiditypool.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "sunrise.liquiditypool.QueryPoolsResponse")
-	proto.RegisterType((*QueryPositionRequest)(nil), "sunrise.liquiditypool.QueryPositionRequest")
-	proto.RegisterType((*PositionInfo)(nil), "sunrise.liquiditypool.PositionInfo")
-	proto.RegisterType((*QueryPositionResponse)(nil), "sunrise.liquiditypool.QueryPositionResponse")
-	proto.RegisterType((*QueryPositionsRequest)(nil), "sunrise.liquiditypool.QueryPositionsRequest")
-	proto.RegisterType((*QueryPositionsResponse)(nil), "sunrise.liquiditypool.QueryPositionsResponse")
-	proto.RegisterType((*QueryPoolPositionsRequest)(nil), "sunrise.liquiditypool.QueryPoolPositionsRequest")
-	proto.RegisterType((*QueryPoolPositionsResponse)(nil), "sunrise.liquiditypool.QueryPoolPositionsResponse")
-	proto.RegisterType((*QueryAddressPositionsRequest)(nil), "sunrise.liquiditypool.QueryAddressPositionsRequest")
-	proto.RegisterType((*QueryAddressPositionsResponse)(nil), "sunrise.liquiditypool.QueryAddressPositionsResponse")
-	proto.RegisterType((*QueryPositionFeesRequest)(nil), "sunrise.liquiditypool.QueryPositionFeesRequest")
-	proto.RegisterType((*QueryPositionFeesResponse)(nil), "sunrise.liquiditypool.QueryPositionFeesResponse")
-	proto.RegisterType((*QueryCalculationCreatePositionRequest)(nil), "sunrise.liquiditypool.QueryCalculationCreatePositionRequest")
-	proto.RegisterType((*QueryCalculationCreatePositionResponse)(nil), "sunrise.liquiditypool.QueryCalculationCreatePositionResponse")
-	proto.RegisterType((*QueryCalculationIncreaseLiquidityRequest)(nil), "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest")
-	proto.RegisterType((*QueryCalculationIncreaseLiquidityResponse)(nil), "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse")
+type QueryTwapRequest struct {
+	PoolId uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Start  time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	End    time.Time `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end"`
 }
 
-func init() { proto.RegisterFile("sunrise/liquiditypool/query.proto", fileDescriptor_c5e0418c1aefaa4d) }
-
-var fileDescriptor_c5e0418c1aefaa4d = []byte{
-	// 1202 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x55,
-	0x10, 0xcf, 0x26, 0xce, 0x87, 0xa7, 0xa4, 0x6a, 0x1f, 0x69, 0x71, 0xdc, 0xc4, 0x49, 0xb7, 0xcd,
-	0x27, 0x8d, 0x37, 0x49, 0x53, 0x51, 0xbe, 0xdb, 0x44, 0x49, 0x14, 0xf1, 0xd5, 0x5a, 0x48, 0x48,
-	0x20, 0x61, 0x6d, 0xec, 0x57, 0x77, 0x15, 0x7b, 0x9f, 0xb3, 0x6f, 0x0d, 0x44, 0xa5, 0x97, 0x0a,
-	0x71, 0x46, 0xc0, 0x89, 0x3b, 0x12, 0xaa, 0x10, 0xe2, 0x08, 0x67, 0x2e, 0x3d, 0x56, 0xea, 0x85,
-	0x13, 0xa0, 0x04, 0xc4, 0x95, 0x3f, 0x01, 0xed, 0xbc, 0xd9, 0xcd, 0xae, 0x9d, 0x5d, 0x6f, 0xaa,
-	0x1c, 0x7a, 0x49, 0x76, 0xdf, 0xcc, 0x6f, 0xe6, 0x37, 0x1f, 0xfb, 0x66, 0x64, 0xb8, 0x28, 0x5b,
-	0xb6, 0x63, 0x49, 0x6e, 0xd4, 0xad, 0xdd, 0x96, 0x55, 0xb5, 0xdc, 0xbd, 0xa6, 0x10, 0x75, 0x63,
-	0xb7, 0xc5, 0x9d, 0xbd, 0x62, 0xd3, 0x11, 0xae, 0x60, 0xe7, 0x48, 0xa5, 0x18, 0x51, 0xc9, 0x9f,
-	0x35, 0x1b, 0x96, 0x2d, 0x0c, 0xfc, 0xab, 0x34, 0xf3, 0x23, 0x35, 0x51, 0x13, 0xf8, 0x68, 0x78,
-	0x4f, 0x74, 0x3a, 0x56, 0x13, 0xa2, 0x56, 0xe7, 0x86, 0xd9, 0xb4, 0x0c, 0xd3, 0xb6, 0x85, 0x6b,
-	0xba, 0x96, 0xb0, 0x25, 0x49, 0x0b, 0x15, 0x21, 0x1b, 0x42, 0x1a, 0xdb, 0xa6, 0xe4, 0xc6, 0x27,
-	0x4b, 0xdb, 0xdc, 0x35, 0x97, 0x8c, 0x8a, 0xb0, 0x6c, 0x92, 0xcf, 0x87, 0xe5, 0x48, 0x2b, 0xd0,
-	0x6a, 0x9a, 0x35, 0xcb, 0x46, 0x63, 0xa4, 0xab, 0x1f, 0x1d, 0x4c, 0xd3, 0x74, 0xcc, 0x86, 0xef,
-	0x6f, 0x32, 0x46, 0x47, 0x88, 0x3a, 0x69, 0x5c, 0x8e, 0xd3, 0x90, 0xd6, 0xa1, 0x2f, 0x7d, 0x04,
-	0xd8, 0x6d, 0x8f, 0xcd, 0x2d, 0x34, 0x5e, 0xe2, 0xbb, 0x2d, 0x2e, 0x5d, 0xfd, 0x03, 0x78, 0x3e,
-	0x72, 0x2a, 0x9b, 0xc2, 0x96, 0x9c, 0xdd, 0x80, 0x01, 0x45, 0x22, 0xa7, 0x4d, 0x6a, 0xb3, 0xa7,
-	0x96, 0xc7, 0x8b, 0x47, 0xe6, 0xb4, 0xa8, 0x60, 0xab, 0xd9, 0x47, 0x7f, 0x4c, 0xf4, 0xfc, 0xf0,
-	0xef, 0xcf, 0xf3, 0x5a, 0x89, 0x70, 0xba, 0x0e, 0x67, 0x94, 0x61, 0x21, 0xea, 0xe4, 0x8c, 0x9d,
-	0x86, 0x5e, 0xab, 0x8a, 0x16, 0x33, 0xa5, 0x5e, 0xab, 0xaa, 0xff, 0xa6, 0xc1, 0x90, 0x27, 0xdf,
-	0xb2, 0xef, 0x08, 0x76, 0x0d, 0x32, 0x9e, 0x49, 0x72, 0x78, 0x21, 0xce, 0xa1, 0x10, 0xf5, 0xd5,
-	0x8c, 0xe7, 0xae, 0x84, 0xea, 0xec, 0x0d, 0x00, 0x57, 0xec, 0x70, 0xbb, 0xec, 0xe5, 0x3b, 0xd7,
-	0x8b, 0xe0, 0xd1, 0xa2, 0xaa, 0x41, 0xd1, 0x3b, 0x2b, 0x52, 0xf6, 0x8b, 0x6b, 0xc2, 0xb2, 0x09,
-	0x9a, 0x45, 0xc8, 0xaa, 0x89, 0x91, 0x9e, 0x52, 0xf8, 0xdd, 0x96, 0x70, 0x79, 0xae, 0x2f, 0x9d,
-	0x01, 0xe5, 0xf3, 0xb6, 0x07, 0xd1, 0xdf, 0x85, 0xb3, 0xa1, 0x48, 0x29, 0x81, 0x2f, 0x47, 0xa2,
-	0x99, 0x48, 0x88, 0xc6, 0x0b, 0x3e, 0x1c, 0x91, 0xfe, 0x51, 0xc8, 0x9e, 0x5f, 0x27, 0xb6, 0x01,
-	0x70, 0xd8, 0x3d, 0x64, 0x75, 0x3a, 0xc2, 0x52, 0x7d, 0x01, 0x3e, 0xd7, 0x5b, 0x66, 0x8d, 0x13,
-	0xb6, 0x14, 0x42, 0xea, 0xdf, 0x69, 0x7e, 0x1b, 0x28, 0xeb, 0x44, 0xf7, 0x55, 0xe8, 0xf7, 0x7c,
-	0x7b, 0xe5, 0xee, 0x4b, 0xcf, 0x57, 0x61, 0xd8, 0x66, 0x84, 0x9b, 0x2a, 0xc1, 0x4c, 0x57, 0x6e,
-	0xca, 0x73, 0x84, 0xdc, 0x34, 0x8c, 0x10, 0x37, 0xd5, 0xb9, 0x71, 0x7d, 0xf3, 0x44, 0x83, 0xe7,
-	0x7c, 0x1d, 0xec, 0x9d, 0x9b, 0x30, 0xe4, 0x77, 0x7b, 0xd7, 0x8c, 0x2b, 0x35, 0x8a, 0x20, 0x80,
-	0x3d, 0x03, 0x7d, 0xf4, 0x31, 0x9c, 0x6b, 0x8b, 0x9e, 0x8a, 0xb3, 0xde, 0x11, 0xdd, 0xa5, 0x2e,
-	0xd1, 0x85, 0x6a, 0x14, 0x40, 0xf5, 0x72, 0x9b, 0xfd, 0x13, 0xef, 0xad, 0x87, 0x1a, 0x9c, 0x6f,
-	0xf7, 0x40, 0x21, 0x6c, 0x42, 0xd6, 0xe7, 0xe1, 0xf7, 0xd8, 0x31, 0x62, 0x38, 0xc4, 0x9e, 0x5c,
-	0xaf, 0xad, 0xc0, 0x68, 0xf0, 0x1d, 0x74, 0x64, 0xe4, 0x05, 0x18, 0xf4, 0xb8, 0x94, 0x83, 0xae,
-	0x1b, 0xf0, 0x5e, 0xb7, 0xaa, 0x3a, 0x87, 0xfc, 0x51, 0xa8, 0x13, 0x8e, 0x52, 0xbf, 0x0e, 0x63,
-	0xe8, 0xe6, 0x66, 0xb5, 0xea, 0x70, 0x29, 0x3b, 0xf8, 0xe5, 0x60, 0xd0, 0x54, 0x22, 0xe4, 0x97,
-	0x2d, 0xf9, 0xaf, 0xfa, 0x5d, 0x18, 0x8f, 0x41, 0x9e, 0x34, 0xc7, 0x79, 0xc8, 0x45, 0x8a, 0xbd,
-	0xc1, 0xb9, 0x8c, 0xfb, 0x60, 0x3f, 0x0f, 0x92, 0x1d, 0xd6, 0x25, 0x46, 0x65, 0xc8, 0xdc, 0xe1,
-	0xdc, 0x27, 0x93, 0xf0, 0xc9, 0x2c, 0x7a, 0x14, 0x1e, 0xfe, 0x39, 0x31, 0x5b, 0xb3, 0xdc, 0xbb,
-	0xad, 0xed, 0x62, 0x45, 0x34, 0x0c, 0x1a, 0xb6, 0xea, 0xdf, 0x82, 0xac, 0xee, 0x18, 0xee, 0x5e,
-	0x93, 0x4b, 0x04, 0xc8, 0x12, 0x1a, 0xd6, 0x7f, 0xd4, 0x60, 0x0a, 0xdd, 0xaf, 0x99, 0xf5, 0x4a,
-	0xab, 0x8e, 0xf5, 0x5f, 0x73, 0xb8, 0xe9, 0xf2, 0xf6, 0x8b, 0x26, 0xae, 0xee, 0x6c, 0x1c, 0xa0,
-	0x2e, 0x3e, 0xe5, 0x4e, 0xd9, 0xb5, 0x2a, 0x3b, 0xd8, 0x76, 0xd9, 0x52, 0x16, 0x4f, 0xde, 0xb7,
-	0x2a, 0x3b, 0x9e, 0xb8, 0xd5, 0x6c, 0xfa, 0xe2, 0x3e, 0x25, 0xc6, 0x13, 0x14, 0x9f, 0x87, 0x01,
-	0xb3, 0x21, 0x5a, 0xb6, 0x9b, 0xcb, 0xa0, 0x88, 0xde, 0xd8, 0x08, 0xf4, 0x57, 0xb9, 0x2d, 0x1a,
-	0xb9, 0x7e, 0x3c, 0x56, 0x2f, 0xba, 0x09, 0xd3, 0xdd, 0xd8, 0x52, 0xe6, 0x5e, 0x0a, 0xec, 0x6a,
-	0xe9, 0xae, 0x1b, 0x52, 0xd7, 0x1d, 0x98, 0x6d, 0x77, 0xb1, 0x65, 0x57, 0x1c, 0x6e, 0x4a, 0xfe,
-	0xb6, 0xdf, 0x02, 0x31, 0xb5, 0x64, 0x17, 0x20, 0xab, 0xac, 0x94, 0x2d, 0x9b, 0x32, 0x31, 0xa4,
-	0x0e, 0xb6, 0x6c, 0x36, 0x0a, 0x43, 0x18, 0x84, 0x27, 0x53, 0x69, 0x18, 0xc4, 0xf7, 0x2d, 0x5b,
-	0x97, 0x30, 0x97, 0xc2, 0x27, 0x45, 0xb6, 0x01, 0xa7, 0xd5, 0x6d, 0xea, 0xf0, 0xdd, 0x96, 0xe5,
-	0xf0, 0x6a, 0xda, 0x08, 0x87, 0x11, 0x56, 0x22, 0xd4, 0xf2, 0x2f, 0xc3, 0xd0, 0x8f, 0x5e, 0xd9,
-	0x97, 0x1a, 0x0c, 0xa8, 0x6d, 0x85, 0xcd, 0xc5, 0xf4, 0x7b, 0xe7, 0x7a, 0x94, 0x9f, 0x4f, 0xa3,
-	0xaa, 0x38, 0xeb, 0x53, 0x0f, 0x9e, 0xfc, 0xfd, 0x4d, 0xef, 0x04, 0x1b, 0x37, 0x92, 0xb6, 0x3a,
-	0xf6, 0x85, 0x06, 0x19, 0xef, 0xfa, 0x60, 0x33, 0x89, 0xb6, 0x0f, 0xd7, 0xa6, 0xfc, 0x6c, 0x77,
-	0x45, 0xa2, 0x30, 0x87, 0x14, 0x2e, 0xb1, 0x8b, 0x46, 0xfc, 0xd2, 0x28, 0x8d, 0x7b, 0x56, 0xf5,
-	0x3e, 0x7b, 0xa0, 0x41, 0x3f, 0xee, 0x00, 0xac, 0xab, 0xf9, 0x20, 0x1b, 0x73, 0x29, 0x34, 0x89,
-	0xc9, 0x65, 0x64, 0x52, 0x60, 0x63, 0x49, 0x4c, 0xd8, 0xb7, 0xb8, 0x00, 0xd2, 0x04, 0x7e, 0x31,
-	0xd9, 0x7a, 0xe4, 0x4b, 0xcd, 0x5f, 0x49, 0xa7, 0x4c, 0x6c, 0x16, 0x90, 0xcd, 0x0c, 0x9b, 0x32,
-	0x92, 0x57, 0x65, 0xca, 0xcd, 0xd7, 0x1a, 0x64, 0x83, 0x9b, 0x93, 0xa5, 0x72, 0x15, 0xe4, 0x68,
-	0x21, 0xa5, 0x36, 0x31, 0x9b, 0x45, 0x66, 0x3a, 0x9b, 0xec, 0xc6, 0x8c, 0xfd, 0xa4, 0xc1, 0x70,
-	0x64, 0xec, 0xb0, 0xc5, 0x6e, 0xe5, 0xe8, 0x20, 0xb7, 0x74, 0x0c, 0x04, 0x11, 0xbc, 0x8e, 0x04,
-	0x97, 0xd9, 0x62, 0x72, 0x4b, 0xd1, 0xb5, 0x79, 0x3f, 0x44, 0xf8, 0x57, 0x0d, 0xce, 0xb4, 0x8f,
-	0x21, 0x76, 0x35, 0x89, 0x41, 0xcc, 0xb8, 0xcb, 0xaf, 0x1c, 0x0f, 0x44, 0xcc, 0x5f, 0x41, 0xe6,
-	0x2b, 0x6c, 0x39, 0x86, 0x39, 0x8d, 0x4c, 0xe3, 0x1e, 0x3d, 0x84, 0xb9, 0x7f, 0x1f, 0xda, 0x30,
-	0xbd, 0x61, 0xc5, 0x8c, 0x34, 0x65, 0x0d, 0x8d, 0xc0, 0xfc, 0x62, 0x7a, 0x00, 0xf1, 0x5d, 0x46,
-	0xbe, 0x57, 0xd8, 0x7c, 0xaa, 0x26, 0x35, 0xbc, 0xd1, 0xc6, 0xfe, 0xd1, 0x60, 0x34, 0x76, 0x4e,
-	0xb0, 0xd7, 0x92, 0x38, 0x74, 0x1b, 0x86, 0xf9, 0xd7, 0x9f, 0x12, 0x4d, 0xe1, 0xbc, 0x85, 0xe1,
-	0xac, 0xb3, 0xb5, 0x94, 0x8d, 0x53, 0x39, 0xb4, 0x28, 0x8d, 0x0a, 0xda, 0x2c, 0x07, 0xdb, 0xf9,
-	0x7f, 0x1a, 0x8c, 0x25, 0x0d, 0x0e, 0xf6, 0x66, 0x4a, 0xb2, 0x71, 0x63, 0x2e, 0x7f, 0xe3, 0xe9,
-	0x0d, 0x50, 0xc0, 0xef, 0x60, 0xc0, 0x9b, 0x6c, 0x3d, 0x5d, 0xfd, 0x22, 0xf1, 0x5a, 0x64, 0xb5,
-	0x1c, 0x40, 0x56, 0xdf, 0x7b, 0xb4, 0x5f, 0xd0, 0x1e, 0xef, 0x17, 0xb4, 0xbf, 0xf6, 0x0b, 0xda,
-	0x57, 0x07, 0x85, 0x9e, 0xc7, 0x07, 0x85, 0x9e, 0xdf, 0x0f, 0x0a, 0x3d, 0x1f, 0x5e, 0x0b, 0xed,
-	0x3f, 0xe4, 0xaa, 0x6e, 0xee, 0x71, 0x27, 0xf0, 0xfb, 0x59, 0x9b, 0x67, 0x5c, 0x89, 0xb6, 0x07,
-	0xf0, 0x77, 0x80, 0xab, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xea, 0x24, 0xce, 0x42, 0x11,
-	0x00, 0x00,
+func (m *QueryTwapRequest) Reset()         { *m = QueryTwapRequest{} }
+func (m *QueryTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapRequest) ProtoMessage()    {}
+func (*QueryTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{26}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Queries a list of Position items.
-	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
-	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
-	PoolPositions(ctx context.Context, in *QueryPoolPositionsRequest, opts ...grpc.CallOption) (*QueryPoolPositionsResponse, error)
-	AddressPositions(ctx context.Context, in *QueryAddressPositionsRequest, opts ...grpc.CallOption) (*QueryAddressPositionsResponse, error)
-	// Query fees by position id
-	PositionFees(ctx context.Context, in *QueryPositionFeesRequest, opts ...grpc.CallOption) (*QueryPositionFeesResponse, error)
-	// Query calculation another amount of create position
-	CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error)
-	// Query calculation another amount of increase liquidity
-	CalculationIncreaseLiquidity(ctx context.Context, in *QueryCalculationIncreaseLiquidityRequest, opts ...grpc.CallOption) (*QueryCalculationIncreaseLiquidityResponse, error)
+func (m *QueryTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapRequest.Merge(m, src)
+}
+func (m *QueryTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTwapRequest proto.InternalMessageInfo
+
+func (m *QueryTwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTwapRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTwapRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
-	out := new(QueryPositionResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Position", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryTwapResponse struct {
+	// Geometric mean of the quote per base price over [start, end]
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// Arithmetic mean of the current tick liquidity over [start, end]
+	AverageLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=average_liquidity,json=averageLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"average_liquidity"`
 }
 
-func (c *queryClient) Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
-	out := new(QueryPositionsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Positions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTwapResponse) Reset()         { *m = QueryTwapResponse{} }
+func (m *QueryTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapResponse) ProtoMessage()    {}
+func (*QueryTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{27}
+}
+func (m *QueryTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapResponse.Merge(m, src)
+}
+func (m *QueryTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolPositions(ctx context.Context, in *QueryPoolPositionsRequest, opts ...grpc.CallOption) (*QueryPoolPositionsResponse, error) {
-	out := new(QueryPoolPositionsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PoolPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryTwapResponse proto.InternalMessageInfo
+
+type QueryCalculationCreatePositionRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	LowerTick string `protobuf:"bytes,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick string `protobuf:"bytes,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom     string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *queryClient) AddressPositions(ctx context.Context, in *QueryAddressPositionsRequest, opts ...grpc.CallOption) (*QueryAddressPositionsResponse, error) {
-	out := new(QueryAddressPositionsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/AddressPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculationCreatePositionRequest) Reset()         { *m = QueryCalculationCreatePositionRequest{} }
+func (m *QueryCalculationCreatePositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculationCreatePositionRequest) ProtoMessage()    {}
+func (*QueryCalculationCreatePositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{28}
+}
+func (m *QueryCalculationCreatePositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculationCreatePositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculationCreatePositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCalculationCreatePositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculationCreatePositionRequest.Merge(m, src)
+}
+func (m *QueryCalculationCreatePositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculationCreatePositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculationCreatePositionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PositionFees(ctx context.Context, in *QueryPositionFeesRequest, opts ...grpc.CallOption) (*QueryPositionFeesResponse, error) {
-	out := new(QueryPositionFeesResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PositionFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCalculationCreatePositionRequest proto.InternalMessageInfo
+
+func (m *QueryCalculationCreatePositionRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error) {
-	out := new(QueryCalculationCreatePositionResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/CalculationCreatePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculationCreatePositionRequest) GetLowerTick() string {
+	if m != nil {
+		return m.LowerTick
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CalculationIncreaseLiquidity(ctx context.Context, in *QueryCalculationIncreaseLiquidityRequest, opts ...grpc.CallOption) (*QueryCalculationIncreaseLiquidityResponse, error) {
-	out := new(QueryCalculationIncreaseLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/CalculationIncreaseLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculationCreatePositionRequest) GetUpperTick() string {
+	if m != nil {
+		return m.UpperTick
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Queries a list of Position items.
-	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
-	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
-	PoolPositions(context.Context, *QueryPoolPositionsRequest) (*QueryPoolPositionsResponse, error)
-	AddressPositions(context.Context, *QueryAddressPositionsRequest) (*QueryAddressPositionsResponse, error)
-	// Query fees by position id
-	PositionFees(context.Context, *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error)
-	// Query calculation another amount of create position
-	CalculationCreatePosition(context.Context, *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error)
-	// Query calculation another amount of increase liquidity
-	CalculationIncreaseLiquidity(context.Context, *QueryCalculationIncreaseLiquidityRequest) (*QueryCalculationIncreaseLiquidityResponse, error)
+func (m *QueryCalculationCreatePositionRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCalculationCreatePositionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+type QueryCalculationCreatePositionResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+
+func (m *QueryCalculationCreatePositionResponse) Reset() {
+	*m = QueryCalculationCreatePositionResponse{}
 }
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QueryCalculationCreatePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculationCreatePositionResponse) ProtoMessage()    {}
+func (*QueryCalculationCreatePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{29}
 }
-func (*UnimplementedQueryServer) Position(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+func (m *QueryCalculationCreatePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Positions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Positions not implemented")
+func (m *QueryCalculationCreatePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculationCreatePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoolPositions(ctx context.Context, req *QueryPoolPositionsRequest) (*QueryPoolPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolPositions not implemented")
+func (m *QueryCalculationCreatePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculationCreatePositionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AddressPositions(ctx context.Context, req *QueryAddressPositionsRequest) (*QueryAddressPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressPositions not implemented")
-}
-func (*UnimplementedQueryServer) PositionFees(ctx context.Context, req *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionFees not implemented")
-}
-func (*UnimplementedQueryServer) CalculationCreatePosition(ctx context.Context, req *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculationCreatePosition not implemented")
+func (m *QueryCalculationCreatePositionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CalculationIncreaseLiquidity(ctx context.Context, req *QueryCalculationIncreaseLiquidityRequest) (*QueryCalculationIncreaseLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculationIncreaseLiquidity not implemented")
+func (m *QueryCalculationCreatePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculationCreatePositionResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryCalculationCreatePositionResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryCalculationCreatePositionResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCalculationIncreaseLiquidityRequest struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AmountIn string `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	DenomIn  string `protobuf:"bytes,3,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty"`
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+func (m *QueryCalculationIncreaseLiquidityRequest) Reset() {
+	*m = QueryCalculationIncreaseLiquidityRequest{}
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculationIncreaseLiquidityRequest) ProtoMessage()    {}
+func (*QueryCalculationIncreaseLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{30}
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.Merge(m, src)
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculationIncreaseLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest.DiscardUnknown(m)
 }
 
-func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Position(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/Position",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+var xxx_messageInfo_QueryCalculationIncreaseLiquidityRequest proto.InternalMessageInfo
+
+func (m *QueryCalculationIncreaseLiquidityRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Positions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Positions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/Positions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Positions(ctx, req.(*QueryPositionsRequest))
+func (m *QueryCalculationIncreaseLiquidityRequest) GetAmountIn() string {
+	if m != nil {
+		return m.AmountIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PoolPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/PoolPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolPositions(ctx, req.(*QueryPoolPositionsRequest))
+func (m *QueryCalculationIncreaseLiquidityRequest) GetDenomIn() string {
+	if m != nil {
+		return m.DenomIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AddressPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/AddressPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressPositions(ctx, req.(*QueryAddressPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCalculationIncreaseLiquidityResponse struct {
+	TokenRequired types.Coin `protobuf:"bytes,1,opt,name=token_required,json=tokenRequired,proto3" json:"token_required"`
 }
 
-func _Query_PositionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/PositionFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionFees(ctx, req.(*QueryPositionFeesRequest))
+func (m *QueryCalculationIncreaseLiquidityResponse) Reset() {
+	*m = QueryCalculationIncreaseLiquidityResponse{}
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCalculationIncreaseLiquidityResponse) ProtoMessage() {}
+func (*QueryCalculationIncreaseLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5e0418c1aefaa4d, []int{31}
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.Merge(m, src)
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculationIncreaseLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse.DiscardUnknown(m)
 }
 
-func _Query_CalculationCreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalculationCreatePositionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculationCreatePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/CalculationCreatePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculationCreatePosition(ctx, req.(*QueryCalculationCreatePositionRequest))
+var xxx_messageInfo_QueryCalculationIncreaseLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryCalculationIncreaseLiquidityResponse) GetTokenRequired() types.Coin {
+	if m != nil {
+		return m.TokenRequired
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_CalculationIncreaseLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalculationIncreaseLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculationIncreaseLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.liquiditypool.Query/CalculationIncreaseLiquidity",
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.liquiditypool.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.liquiditypool.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "sunrise.liquiditypool.QueryPoolRequest")
+	proto.RegisterType((*PoolInfo)(nil), "sunrise.liquiditypool.PoolInfo")
+	proto.RegisterType((*QueryPoolResponse)(nil), "sunrise.liquiditypool.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "sunrise.liquiditypool.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "sunrise.liquiditypool.QueryPoolsResponse")
+	proto.RegisterType((*QueryPoolsByDenomsRequest)(nil), "sunrise.liquiditypool.QueryPoolsByDenomsRequest")
+	proto.RegisterType((*QueryPoolsByDenomsResponse)(nil), "sunrise.liquiditypool.QueryPoolsByDenomsResponse")
+	proto.RegisterType((*QueryPositionRequest)(nil), "sunrise.liquiditypool.QueryPositionRequest")
+	proto.RegisterType((*PositionInfo)(nil), "sunrise.liquiditypool.PositionInfo")
+	proto.RegisterType((*QueryPositionResponse)(nil), "sunrise.liquiditypool.QueryPositionResponse")
+	proto.RegisterType((*QueryPositionsRequest)(nil), "sunrise.liquiditypool.QueryPositionsRequest")
+	proto.RegisterType((*QueryPositionsResponse)(nil), "sunrise.liquiditypool.QueryPositionsResponse")
+	proto.RegisterType((*QueryPoolPositionsRequest)(nil), "sunrise.liquiditypool.QueryPoolPositionsRequest")
+	proto.RegisterType((*QueryPoolPositionsResponse)(nil), "sunrise.liquiditypool.QueryPoolPositionsResponse")
+	proto.RegisterType((*QueryAddressPositionsRequest)(nil), "sunrise.liquiditypool.QueryAddressPositionsRequest")
+	proto.RegisterType((*QueryAddressPositionsResponse)(nil), "sunrise.liquiditypool.QueryAddressPositionsResponse")
+	proto.RegisterType((*QueryPositionFeesRequest)(nil), "sunrise.liquiditypool.QueryPositionFeesRequest")
+	proto.RegisterType((*QueryPositionFeesResponse)(nil), "sunrise.liquiditypool.QueryPositionFeesResponse")
+	proto.RegisterType((*QueryLimitOrderRequest)(nil), "sunrise.liquiditypool.QueryLimitOrderRequest")
+	proto.RegisterType((*QueryLimitOrderResponse)(nil), "sunrise.liquiditypool.QueryLimitOrderResponse")
+	proto.RegisterType((*QueryPoolLimitOrdersRequest)(nil), "sunrise.liquiditypool.QueryPoolLimitOrdersRequest")
+	proto.RegisterType((*QueryPoolLimitOrdersResponse)(nil), "sunrise.liquiditypool.QueryPoolLimitOrdersResponse")
+	proto.RegisterType((*QueryAddressLimitOrdersRequest)(nil), "sunrise.liquiditypool.QueryAddressLimitOrdersRequest")
+	proto.RegisterType((*QueryAddressLimitOrdersResponse)(nil), "sunrise.liquiditypool.QueryAddressLimitOrdersResponse")
+	proto.RegisterType((*QueryTwapRequest)(nil), "sunrise.liquiditypool.QueryTwapRequest")
+	proto.RegisterType((*QueryTwapResponse)(nil), "sunrise.liquiditypool.QueryTwapResponse")
+	proto.RegisterType((*QueryCalculationCreatePositionRequest)(nil), "sunrise.liquiditypool.QueryCalculationCreatePositionRequest")
+	proto.RegisterType((*QueryCalculationCreatePositionResponse)(nil), "sunrise.liquiditypool.QueryCalculationCreatePositionResponse")
+	proto.RegisterType((*QueryCalculationIncreaseLiquidityRequest)(nil), "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityRequest")
+	proto.RegisterType((*QueryCalculationIncreaseLiquidityResponse)(nil), "sunrise.liquiditypool.QueryCalculationIncreaseLiquidityResponse")
+}
+
+func init() { proto.RegisterFile("sunrise/liquiditypool/query.proto", fileDescriptor_c5e0418c1aefaa4d) }
+
+var fileDescriptor_c5e0418c1aefaa4d = []byte{
+	// 1698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x14, 0xc9,
+	0x15, 0x77, 0xfb, 0x8b, 0x99, 0x67, 0x20, 0xb8, 0x62, 0x60, 0xdc, 0xb6, 0x67, 0xa0, 0x01, 0x7b,
+	0x6c, 0x98, 0x6e, 0x7f, 0x60, 0x20, 0x10, 0x12, 0xb0, 0xc1, 0xc4, 0x09, 0x09, 0x30, 0x42, 0x8a,
+	0x12, 0x24, 0x46, 0xed, 0x99, 0xf2, 0xd0, 0xf1, 0x4c, 0xd7, 0xb8, 0xbb, 0x07, 0x18, 0x11, 0x2e,
+	0x28, 0xca, 0x31, 0x41, 0x49, 0x4e, 0x91, 0xa2, 0x5c, 0x12, 0x29, 0x42, 0x51, 0x94, 0xc3, 0x4a,
+	0xab, 0x95, 0xf6, 0xb4, 0x7b, 0xe1, 0x88, 0x96, 0xcb, 0x6a, 0x0f, 0xb0, 0x82, 0x5d, 0xed, 0x75,
+	0xff, 0x84, 0x55, 0x55, 0xbd, 0x9e, 0xe9, 0xf9, 0xe8, 0x99, 0x36, 0xeb, 0x95, 0xf6, 0x02, 0x53,
+	0x55, 0xef, 0xf7, 0xde, 0xef, 0xd5, 0x7b, 0x55, 0xfe, 0x55, 0xc3, 0x51, 0xb7, 0x6a, 0x3b, 0x96,
+	0x4b, 0x8d, 0x92, 0xb5, 0x5d, 0xb5, 0x0a, 0x96, 0x57, 0xab, 0x30, 0x56, 0x32, 0xb6, 0xab, 0xd4,
+	0xa9, 0xe9, 0x15, 0x87, 0x79, 0x8c, 0x1c, 0x44, 0x13, 0xbd, 0xc9, 0x44, 0x1d, 0x35, 0xcb, 0x96,
+	0xcd, 0x0c, 0xf1, 0xaf, 0xb4, 0x54, 0xc7, 0x8a, 0xac, 0xc8, 0xc4, 0x4f, 0x83, 0xff, 0xc2, 0xd9,
+	0xc9, 0x22, 0x63, 0xc5, 0x12, 0x35, 0xcc, 0x8a, 0x65, 0x98, 0xb6, 0xcd, 0x3c, 0xd3, 0xb3, 0x98,
+	0xed, 0xe2, 0x6a, 0x0a, 0x57, 0xc5, 0x68, 0xa3, 0xba, 0x69, 0x78, 0x56, 0x99, 0xba, 0x9e, 0x59,
+	0xae, 0xa0, 0xc1, 0x78, 0x9e, 0xb9, 0x65, 0xe6, 0xe6, 0xa4, 0x5f, 0x39, 0xc0, 0xa5, 0xa4, 0x1c,
+	0x19, 0x1b, 0xa6, 0x4b, 0x8d, 0xfb, 0x0b, 0x1b, 0xd4, 0x33, 0x17, 0x8c, 0x3c, 0xb3, 0x6c, 0x5c,
+	0x9f, 0x0b, 0xae, 0x8b, 0x94, 0xea, 0x56, 0x15, 0xb3, 0x68, 0xd9, 0x82, 0x08, 0xda, 0xce, 0x74,
+	0xde, 0x88, 0x92, 0x55, 0xb6, 0xbc, 0x1c, 0x73, 0x0a, 0xd4, 0x41, 0x43, 0xad, 0xb3, 0x61, 0xc5,
+	0x74, 0xcc, 0xb2, 0x4f, 0xec, 0x48, 0x88, 0x0d, 0x63, 0x25, 0xb4, 0x38, 0x1e, 0x66, 0xe1, 0x5a,
+	0x0d, 0x52, 0xda, 0x18, 0x90, 0x5b, 0x9c, 0xf6, 0x4d, 0xe1, 0x3c, 0x4b, 0xb7, 0xab, 0xd4, 0xf5,
+	0xb4, 0x5f, 0xc3, 0x0f, 0x9b, 0x66, 0xdd, 0x0a, 0xb3, 0x5d, 0x4a, 0x2e, 0xc1, 0xb0, 0x24, 0x91,
+	0x50, 0x8e, 0x28, 0xe9, 0x91, 0xc5, 0x29, 0xbd, 0x63, 0xe1, 0x74, 0x09, 0x5b, 0x89, 0x3f, 0x7f,
+	0x95, 0xea, 0xfb, 0xcf, 0x57, 0xff, 0x9f, 0x53, 0xb2, 0x88, 0xd3, 0x34, 0x38, 0x20, 0x1d, 0x33,
+	0x56, 0xc2, 0x60, 0x64, 0x3f, 0xf4, 0x5b, 0x05, 0xe1, 0x71, 0x30, 0xdb, 0x6f, 0x15, 0xb4, 0x8f,
+	0x15, 0x88, 0xf1, 0xf5, 0x75, 0x7b, 0x93, 0x91, 0x65, 0x18, 0xe4, 0x2e, 0x31, 0xe0, 0x44, 0x58,
+	0x40, 0xc6, 0x4a, 0x2b, 0x83, 0x3c, 0x5c, 0x56, 0x98, 0x93, 0x9f, 0x00, 0x78, 0x6c, 0x8b, 0xda,
+	0x39, 0x5e, 0x98, 0x44, 0xbf, 0x00, 0x8f, 0xeb, 0x58, 0x5a, 0x3e, 0xa7, 0x63, 0x99, 0xf4, 0x55,
+	0x66, 0xd9, 0x08, 0x8d, 0x0b, 0xc8, 0x8a, 0x29, 0x32, 0x1d, 0x91, 0xf8, 0xed, 0x2a, 0xf3, 0x68,
+	0x62, 0x20, 0x9a, 0x03, 0x19, 0xf3, 0x16, 0x87, 0x68, 0xbf, 0x82, 0xd1, 0x40, 0xa6, 0xb8, 0x81,
+	0x3f, 0x6a, 0xca, 0x26, 0xd5, 0x25, 0x1b, 0x9e, 0x7c, 0x30, 0x23, 0xed, 0x4e, 0xc0, 0x9f, 0x5f,
+	0x27, 0xb2, 0x06, 0xd0, 0x68, 0x33, 0xf4, 0x3a, 0xdd, 0xc4, 0x52, 0x1e, 0x33, 0x9f, 0xeb, 0x4d,
+	0xb3, 0x48, 0x11, 0x9b, 0x0d, 0x20, 0xb5, 0xbf, 0x2b, 0x40, 0x82, 0xde, 0x91, 0xee, 0x05, 0x18,
+	0xe2, 0xb1, 0x79, 0xb9, 0x07, 0xa2, 0xf3, 0x95, 0x18, 0x72, 0xad, 0x89, 0x9b, 0x2c, 0xc1, 0x4c,
+	0x4f, 0x6e, 0x32, 0x72, 0x13, 0xb9, 0x3b, 0x30, 0xde, 0xe0, 0xb6, 0x52, 0xbb, 0x42, 0x6d, 0x56,
+	0xef, 0x54, 0x32, 0x05, 0x50, 0xe0, 0x13, 0xb2, 0xd0, 0x7c, 0x07, 0xe2, 0xd9, 0xb8, 0x98, 0x11,
+	0x75, 0x4c, 0xc1, 0x88, 0x5c, 0x96, 0x75, 0xec, 0x17, 0xeb, 0x12, 0x21, 0xcb, 0xf4, 0x1b, 0x50,
+	0x3b, 0x39, 0xdf, 0x85, 0x0d, 0xd0, 0xa6, 0x61, 0x0c, 0x5d, 0xcb, 0x13, 0x17, 0xd6, 0xef, 0x2f,
+	0x15, 0xd8, 0xeb, 0xdb, 0x88, 0x9e, 0xbf, 0x0c, 0x31, 0xff, 0x94, 0xf6, 0xec, 0x14, 0x69, 0x86,
+	0x81, 0xeb, 0xb0, 0xef, 0x41, 0xff, 0xdf, 0x85, 0x83, 0x2d, 0xd9, 0xe3, 0x9e, 0x5e, 0x6d, 0xcb,
+	0xee, 0x58, 0x8f, 0xec, 0x02, 0x5b, 0x5b, 0x87, 0x6a, 0xb9, 0x16, 0xff, 0xbb, 0x7e, 0x26, 0x9e,
+	0x29, 0x70, 0xa8, 0x35, 0x02, 0xa6, 0x70, 0x0d, 0xe2, 0x3e, 0x0f, 0xbf, 0x35, 0x76, 0x90, 0x43,
+	0x03, 0xbb, 0x7b, 0x67, 0xe4, 0x74, 0xe0, 0x8c, 0xb4, 0xed, 0xc8, 0x61, 0xd8, 0xc3, 0xb9, 0xe4,
+	0xea, 0x5d, 0x37, 0xcc, 0x87, 0xeb, 0x05, 0x8d, 0x82, 0xda, 0x09, 0xb5, 0xcb, 0x59, 0x6a, 0xe7,
+	0x60, 0x52, 0x84, 0xb9, 0x5c, 0x28, 0x38, 0xd4, 0x75, 0xdb, 0xf8, 0x25, 0x60, 0x8f, 0x29, 0x97,
+	0xf0, 0x00, 0xfb, 0x43, 0xed, 0x1e, 0x4c, 0x85, 0x20, 0x77, 0x9b, 0xe3, 0x1c, 0x24, 0x9a, 0x8a,
+	0xbd, 0x46, 0xa9, 0x1b, 0x76, 0x60, 0x7f, 0x0f, 0xe3, 0x1d, 0x6c, 0x91, 0x51, 0x0e, 0x06, 0x37,
+	0x29, 0xf5, 0xc9, 0x74, 0x39, 0x32, 0xf3, 0x9c, 0xc2, 0xb3, 0xd7, 0xa9, 0x74, 0xd1, 0xf2, 0xee,
+	0x55, 0x37, 0xf4, 0x3c, 0x2b, 0xa3, 0xf6, 0xc0, 0xff, 0x32, 0x6e, 0x61, 0xcb, 0xf0, 0x6a, 0x15,
+	0xea, 0x0a, 0x80, 0x9b, 0x15, 0x8e, 0xb5, 0x34, 0xb6, 0xe5, 0x75, 0xae, 0x1b, 0x6e, 0x70, 0xd9,
+	0x10, 0xc6, 0x33, 0x0f, 0x87, 0xdb, 0x2c, 0x91, 0xe5, 0xcf, 0x60, 0x24, 0xa0, 0x3b, 0xf0, 0x94,
+	0x1c, 0x0d, 0xd9, 0xb9, 0x06, 0xde, 0x3f, 0xe7, 0xa5, 0xfa, 0x8c, 0xe6, 0xc1, 0x44, 0xbd, 0x87,
+	0x1a, 0x86, 0x3d, 0x7b, 0x8f, 0x4c, 0x40, 0x9c, 0xd9, 0xa5, 0x5a, 0x8e, 0x55, 0xa8, 0xec, 0xfc,
+	0x58, 0x36, 0xc6, 0x27, 0x6e, 0x54, 0xa8, 0xcd, 0xaf, 0x6d, 0xb1, 0xb8, 0x69, 0x95, 0x4a, 0xb4,
+	0x20, 0xae, 0x9f, 0x58, 0x16, 0xf8, 0xd4, 0x9a, 0x98, 0xd1, 0x7e, 0x07, 0x93, 0x9d, 0xa3, 0x62,
+	0x7e, 0x3f, 0x87, 0xbd, 0x81, 0xfc, 0xfc, 0x6a, 0x44, 0x4e, 0x70, 0xa4, 0x91, 0xa0, 0xab, 0x3d,
+	0x84, 0x64, 0xb0, 0x09, 0x3b, 0x24, 0x19, 0xda, 0xc0, 0xdf, 0x32, 0xcb, 0x32, 0xa4, 0x42, 0x23,
+	0x7f, 0x07, 0x89, 0xfe, 0x53, 0x41, 0x75, 0x76, 0xfb, 0x81, 0x59, 0xe9, 0x59, 0xc0, 0xf3, 0x30,
+	0xe4, 0x7a, 0xa6, 0xe3, 0xe1, 0xb5, 0xa5, 0xea, 0x52, 0x66, 0xeb, 0xbe, 0xcc, 0xd6, 0x6f, 0xfb,
+	0x32, 0x7b, 0x25, 0xc6, 0x63, 0x3d, 0x7d, 0x9d, 0x52, 0xb2, 0x12, 0x42, 0xce, 0xc0, 0x00, 0xb5,
+	0x0b, 0x89, 0x81, 0x1d, 0x20, 0x39, 0x40, 0xfb, 0x50, 0x81, 0xd1, 0x00, 0xc3, 0xfa, 0x25, 0x30,
+	0x54, 0x71, 0xac, 0x3c, 0xfe, 0xf9, 0x5f, 0x59, 0xe0, 0x98, 0xcf, 0x5e, 0xa5, 0x26, 0xe4, 0x31,
+	0x72, 0x0b, 0x5b, 0xba, 0xc5, 0x8c, 0xb2, 0xe9, 0xdd, 0xd3, 0xaf, 0xd3, 0xa2, 0x99, 0xaf, 0x5d,
+	0xa1, 0xf9, 0x4f, 0xde, 0xcb, 0x80, 0x5c, 0xd6, 0xaf, 0xd0, 0x7c, 0x56, 0xe2, 0xc9, 0x5d, 0x18,
+	0x35, 0xef, 0x53, 0xc7, 0x2c, 0xd2, 0x5c, 0x7d, 0xdf, 0x12, 0xfd, 0xef, 0xea, 0xf4, 0x00, 0xfa,
+	0xba, 0xee, 0xbb, 0xd2, 0xfe, 0xab, 0xc0, 0x09, 0x41, 0x7f, 0xd5, 0x2c, 0xe5, 0xab, 0x25, 0x71,
+	0x75, 0xaf, 0x3a, 0xd4, 0xf4, 0x68, 0xab, 0x46, 0x08, 0xdd, 0xf5, 0x29, 0x80, 0x12, 0x7b, 0x40,
+	0x9d, 0x9c, 0x67, 0xe5, 0xb7, 0x50, 0xcf, 0xc4, 0xc5, 0xcc, 0x6d, 0x2b, 0xbf, 0xc5, 0x97, 0xab,
+	0x95, 0x8a, 0xbf, 0x3c, 0x20, 0x97, 0xc5, 0x8c, 0x58, 0x3e, 0x04, 0xc3, 0x66, 0x99, 0x55, 0x6d,
+	0x2f, 0x31, 0x28, 0x96, 0x70, 0x44, 0xc6, 0x60, 0x48, 0x68, 0xa2, 0xc4, 0x90, 0x98, 0x96, 0x03,
+	0xcd, 0x84, 0xe9, 0x5e, 0x6c, 0xb1, 0x02, 0x67, 0xeb, 0x7e, 0x95, 0x68, 0x4a, 0x01, 0xcd, 0x35,
+	0x07, 0xd2, 0xad, 0x21, 0xd6, 0xed, 0xbc, 0x43, 0x4d, 0xb7, 0xb1, 0x6d, 0x21, 0xd7, 0x1b, 0x3f,
+	0x5b, 0xd2, 0x4b, 0xce, 0xb2, 0x71, 0x27, 0x62, 0x72, 0x62, 0xdd, 0x26, 0xe3, 0x10, 0x93, 0xc2,
+	0xcf, 0xb2, 0x71, 0x1b, 0xf6, 0x88, 0xf1, 0xba, 0xad, 0xb9, 0x30, 0x1b, 0x21, 0x26, 0x66, 0xb6,
+	0x06, 0xfb, 0xa5, 0x10, 0x72, 0xe8, 0x76, 0xd5, 0x72, 0x68, 0x21, 0x6a, 0x86, 0xfb, 0x04, 0x2c,
+	0x8b, 0xa8, 0xc5, 0x3f, 0x8f, 0xc1, 0x90, 0x88, 0x4a, 0xfe, 0xa8, 0xc0, 0xb0, 0x7c, 0x20, 0x91,
+	0xd9, 0x90, 0x63, 0xda, 0xfe, 0x22, 0x53, 0xe7, 0xa2, 0x98, 0x4a, 0xce, 0xda, 0x89, 0x27, 0x2f,
+	0xbf, 0xf8, 0x6b, 0x7f, 0x8a, 0x4c, 0x19, 0xdd, 0x1e, 0x92, 0xe4, 0x0f, 0x0a, 0x0c, 0xf2, 0xfb,
+	0x93, 0xcc, 0x74, 0xf5, 0xdd, 0x78, 0xa9, 0xa9, 0xe9, 0xde, 0x86, 0x48, 0x61, 0x56, 0x50, 0x38,
+	0x46, 0x8e, 0x1a, 0xe1, 0xef, 0x54, 0xd7, 0x78, 0x64, 0x15, 0x1e, 0x93, 0x27, 0x0a, 0x0c, 0x71,
+	0xac, 0x4b, 0x7a, 0xba, 0xaf, 0xef, 0xc6, 0x6c, 0x04, 0x4b, 0x64, 0x72, 0x5c, 0x30, 0x49, 0x92,
+	0xc9, 0x6e, 0x4c, 0xc8, 0xbf, 0x14, 0xd8, 0xd7, 0xf4, 0x04, 0x20, 0xf3, 0x3d, 0x43, 0xb4, 0x3c,
+	0x45, 0xd4, 0x85, 0x1d, 0x20, 0x90, 0x9c, 0x2e, 0xc8, 0xa5, 0xc9, 0x74, 0x37, 0x72, 0x99, 0x8d,
+	0x5a, 0xa6, 0x20, 0x49, 0xfd, 0x4d, 0x3c, 0x8d, 0x51, 0xe3, 0x9f, 0xec, 0x1e, 0xaf, 0xe9, 0x42,
+	0x51, 0x4f, 0x45, 0x33, 0x46, 0x5e, 0x19, 0xc1, 0x6b, 0x86, 0x9c, 0x30, 0xba, 0x7f, 0x44, 0xc0,
+	0x12, 0xfe, 0x45, 0x81, 0xb8, 0xef, 0xc3, 0x25, 0x91, 0x42, 0xd5, 0x77, 0x2d, 0x13, 0xd1, 0x1a,
+	0x99, 0xa5, 0x05, 0x33, 0x8d, 0x1c, 0xe9, 0xc5, 0x8c, 0xfc, 0x0f, 0x4b, 0xda, 0x20, 0xd6, 0xb3,
+	0xa4, 0x6d, 0xe4, 0x16, 0x76, 0x80, 0x40, 0x82, 0xe7, 0x04, 0xc1, 0x45, 0x32, 0xdf, 0xbd, 0xf3,
+	0xf1, 0x76, 0x7f, 0x1c, 0x20, 0xfc, 0x81, 0x02, 0x07, 0x5a, 0x85, 0x2e, 0x59, 0xea, 0xc6, 0x20,
+	0x44, 0x50, 0xab, 0xa7, 0x77, 0x06, 0x42, 0xe6, 0xe7, 0x05, 0xf3, 0xd3, 0x64, 0x31, 0x84, 0x39,
+	0x6a, 0x1a, 0xe3, 0x11, 0xfe, 0x08, 0x72, 0xff, 0x77, 0xe0, 0x0d, 0xcb, 0xe5, 0x30, 0x31, 0xa2,
+	0x94, 0x35, 0x20, 0xb2, 0xd5, 0xf9, 0xe8, 0x00, 0xe4, 0xbb, 0x28, 0xf8, 0x9e, 0x22, 0x73, 0x91,
+	0x9a, 0xd4, 0xe0, 0xe2, 0x99, 0xfc, 0x43, 0x01, 0x68, 0x88, 0x20, 0xd2, 0xb5, 0xf9, 0xda, 0x04,
+	0xb6, 0xaa, 0x47, 0x35, 0x47, 0x86, 0xf3, 0x82, 0xe1, 0x1c, 0x49, 0x1b, 0x5d, 0x3e, 0xfd, 0x65,
+	0xa4, 0x72, 0x93, 0x27, 0xe9, 0x7d, 0x05, 0x7e, 0xd0, 0xa2, 0x69, 0xc9, 0x62, 0xaf, 0x26, 0x6c,
+	0x57, 0xa4, 0xea, 0xd2, 0x8e, 0x30, 0x48, 0xf7, 0x82, 0xa0, 0xbb, 0x4c, 0x96, 0x22, 0xb6, 0x6e,
+	0x90, 0x3e, 0xf9, 0x48, 0x01, 0xd2, 0xae, 0x53, 0xc9, 0x72, 0x84, 0x56, 0xec, 0xc0, 0xff, 0xcc,
+	0x4e, 0x61, 0x98, 0xc2, 0x45, 0x91, 0xc2, 0x59, 0xb2, 0x1c, 0xb9, 0x87, 0x9b, 0x92, 0xf8, 0x93,
+	0x02, 0x83, 0x5c, 0x5a, 0x76, 0xff, 0x93, 0x18, 0x90, 0xc7, 0x6a, 0xba, 0xb7, 0x21, 0x52, 0x5b,
+	0x12, 0xd4, 0x32, 0xe4, 0x64, 0xc4, 0xdd, 0xf5, 0x38, 0x8f, 0x2f, 0x15, 0x18, 0x0f, 0x95, 0x5f,
+	0xe4, 0xc7, 0xdd, 0x82, 0xf7, 0xd2, 0x98, 0xea, 0xc5, 0x77, 0x44, 0x63, 0x3e, 0xbf, 0x10, 0xf9,
+	0x5c, 0x25, 0xab, 0x11, 0xf3, 0xc9, 0x37, 0x3c, 0xba, 0x46, 0x5e, 0xf8, 0xcc, 0xd5, 0xbf, 0x57,
+	0x7d, 0xad, 0xc0, 0x64, 0x37, 0x3d, 0x46, 0x7e, 0x1a, 0x91, 0x6c, 0x98, 0x7a, 0x54, 0x2f, 0xbd,
+	0xbb, 0x03, 0x4c, 0xf8, 0x97, 0x22, 0xe1, 0x6b, 0xe4, 0x6a, 0xb4, 0xfb, 0xa6, 0x29, 0x5f, 0x0b,
+	0xbd, 0x36, 0xde, 0x15, 0x2b, 0x37, 0x9e, 0xbf, 0x49, 0x2a, 0x2f, 0xde, 0x24, 0x95, 0xcf, 0xdf,
+	0x24, 0x95, 0xa7, 0x6f, 0x93, 0x7d, 0x2f, 0xde, 0x26, 0xfb, 0x3e, 0x7d, 0x9b, 0xec, 0xfb, 0xed,
+	0x72, 0xe0, 0x8b, 0x00, 0x86, 0x2a, 0x99, 0x35, 0xea, 0xd4, 0xe3, 0x3e, 0x6c, 0x89, 0x2c, 0x3e,
+	0x12, 0x6c, 0x0c, 0x8b, 0xf7, 0xd3, 0xd2, 0x37, 0x03, 0x00, 0x5f, 0x99, 0x51, 0xa5, 0x71, 0x19,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	PoolsByDenoms(ctx context.Context, in *QueryPoolsByDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomsResponse, error)
+	// Queries a list of Position items.
+	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
+	PoolPositions(ctx context.Context, in *QueryPoolPositionsRequest, opts ...grpc.CallOption) (*QueryPoolPositionsResponse, error)
+	AddressPositions(ctx context.Context, in *QueryAddressPositionsRequest, opts ...grpc.CallOption) (*QueryAddressPositionsResponse, error)
+	// Query fees by position id
+	PositionFees(ctx context.Context, in *QueryPositionFeesRequest, opts ...grpc.CallOption) (*QueryPositionFeesResponse, error)
+	// Queries a list of LimitOrder items.
+	LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error)
+	PoolLimitOrders(ctx context.Context, in *QueryPoolLimitOrdersRequest, opts ...grpc.CallOption) (*QueryPoolLimitOrdersResponse, error)
+	AddressLimitOrders(ctx context.Context, in *QueryAddressLimitOrdersRequest, opts ...grpc.CallOption) (*QueryAddressLimitOrdersResponse, error)
+	// Queries the time weighted average price of a pool
+	Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
+	// Query calculation another amount of create position
+	CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error)
+	// Query calculation another amount of increase liquidity
+	CalculationIncreaseLiquidity(ctx context.Context, in *QueryCalculationIncreaseLiquidityRequest, opts ...grpc.CallOption) (*QueryCalculationIncreaseLiquidityResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculationIncreaseLiquidity(ctx, req.(*QueryCalculationIncreaseLiquidityRequest))
+	return out, nil
+}
+
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.liquiditypool.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Position",
-			Handler:    _Query_Position_Handler,
-		},
-		{
-			MethodName: "Positions",
-			Handler:    _Query_Positions_Handler,
-		},
-		{
-			MethodName: "PoolPositions",
-			Handler:    _Query_PoolPositions_Handler,
-		},
-		{
-			MethodName: "AddressPositions",
-			Handler:    _Query_AddressPositions_Handler,
-		},
-		{
-			MethodName: "PositionFees",
-			Handler:    _Query_PositionFees_Handler,
-		},
-		{
-			MethodName: "CalculationCreatePosition",
-			Handler:    _Query_CalculationCreatePosition_Handler,
-		},
-		{
-			MethodName: "CalculationIncreaseLiquidity",
-			Handler:    _Query_CalculationIncreaseLiquidity_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sunrise/liquiditypool/query.proto",
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolsByDenoms(ctx context.Context, in *QueryPoolsByDenomsRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomsResponse, error) {
+	out := new(QueryPoolsByDenomsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PoolsByDenoms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Position", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
+	out := new(QueryPositionsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Positions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolPositions(ctx context.Context, in *QueryPoolPositionsRequest, opts ...grpc.CallOption) (*QueryPoolPositionsResponse, error) {
+	out := new(QueryPoolPositionsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PoolPositions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AddressPositions(ctx context.Context, in *QueryAddressPositionsRequest, opts ...grpc.CallOption) (*QueryAddressPositionsResponse, error) {
+	out := new(QueryAddressPositionsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/AddressPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) PositionFees(ctx context.Context, in *QueryPositionFeesRequest, opts ...grpc.CallOption) (*QueryPositionFeesResponse, error) {
+	out := new(QueryPositionFeesResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PositionFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrder(ctx context.Context, in *QueryLimitOrderRequest, opts ...grpc.CallOption) (*QueryLimitOrderResponse, error) {
+	out := new(QueryLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/LimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolLimitOrders(ctx context.Context, in *QueryPoolLimitOrdersRequest, opts ...grpc.CallOption) (*QueryPoolLimitOrdersResponse, error) {
+	out := new(QueryPoolLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/PoolLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) AddressLimitOrders(ctx context.Context, in *QueryAddressLimitOrdersRequest, opts ...grpc.CallOption) (*QueryAddressLimitOrdersResponse, error) {
+	out := new(QueryAddressLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/AddressLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/Twap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PoolInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalculationCreatePosition(ctx context.Context, in *QueryCalculationCreatePositionRequest, opts ...grpc.CallOption) (*QueryCalculationCreatePositionResponse, error) {
+	out := new(QueryCalculationCreatePositionResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/CalculationCreatePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PoolInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenQuote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.TokenBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalculationIncreaseLiquidity(ctx context.Context, in *QueryCalculationIncreaseLiquidityRequest, opts ...grpc.CallOption) (*QueryCalculationIncreaseLiquidityResponse, error) {
+	out := new(QueryCalculationIncreaseLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Query/CalculationIncreaseLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	PoolsByDenoms(context.Context, *QueryPoolsByDenomsRequest) (*QueryPoolsByDenomsResponse, error)
+	// Queries a list of Position items.
+	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
+	PoolPositions(context.Context, *QueryPoolPositionsRequest) (*QueryPoolPositionsResponse, error)
+	AddressPositions(context.Context, *QueryAddressPositionsRequest) (*QueryAddressPositionsResponse, error)
+	// Query fees by position id
+	PositionFees(context.Context, *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error)
+	// Queries a list of LimitOrder items.
+	LimitOrder(context.Context, *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error)
+	PoolLimitOrders(context.Context, *QueryPoolLimitOrdersRequest) (*QueryPoolLimitOrdersResponse, error)
+	AddressLimitOrders(context.Context, *QueryAddressLimitOrdersRequest) (*QueryAddressLimitOrdersResponse, error)
+	// Queries the time weighted average price of a pool
+	Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
+	// Query calculation another amount of create position
+	CalculationCreatePosition(context.Context, *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error)
+	// Query calculation another amount of increase liquidity
+	CalculationIncreaseLiquidity(context.Context, *QueryCalculationIncreaseLiquidityRequest) (*QueryCalculationIncreaseLiquidityResponse, error)
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) PoolsByDenoms(ctx context.Context, req *QueryPoolsByDenomsRequest) (*QueryPoolsByDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Position(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+}
+func (*UnimplementedQueryServer) Positions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Positions not implemented")
+}
+func (*UnimplementedQueryServer) PoolPositions(ctx context.Context, req *QueryPoolPositionsRequest) (*QueryPoolPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolPositions not implemented")
+}
+func (*UnimplementedQueryServer) AddressPositions(ctx context.Context, req *QueryAddressPositionsRequest) (*QueryAddressPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressPositions not implemented")
+}
+func (*UnimplementedQueryServer) PositionFees(ctx context.Context, req *QueryPositionFeesRequest) (*QueryPositionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionFees not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrder(ctx context.Context, req *QueryLimitOrderRequest) (*QueryLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) PoolLimitOrders(ctx context.Context, req *QueryPoolLimitOrdersRequest) (*QueryPoolLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) AddressLimitOrders(ctx context.Context, req *QueryAddressLimitOrdersRequest) (*QueryAddressLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) Twap(ctx context.Context, req *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twap not implemented")
+}
+func (*UnimplementedQueryServer) CalculationCreatePosition(ctx context.Context, req *QueryCalculationCreatePositionRequest) (*QueryCalculationCreatePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculationCreatePosition not implemented")
+}
+func (*UnimplementedQueryServer) CalculationIncreaseLiquidity(ctx context.Context, req *QueryCalculationIncreaseLiquidityRequest) (*QueryCalculationIncreaseLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculationIncreaseLiquidity not implemented")
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolsByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/PoolsByDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByDenoms(ctx, req.(*QueryPoolsByDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Position(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Position",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PositionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Positions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PositionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PositionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenQuote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Positions(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.TokenBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Positions",
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Positions(ctx, req.(*QueryPositionsRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolPositions(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/PoolPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolPositions(ctx, req.(*QueryPoolPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AddressPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).AddressPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/AddressPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressPositions(ctx, req.(*QueryAddressPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_PositionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PositionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/PositionFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionFees(ctx, req.(*QueryPositionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLimitOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/LimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrder(ctx, req.(*QueryLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/PoolLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolLimitOrders(ctx, req.(*QueryPoolLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AddressLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressLimitOrders(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/AddressLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressLimitOrders(ctx, req.(*QueryAddressLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Twap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Twap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/Twap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Query_CalculationCreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculationCreatePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculationCreatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/CalculationCreatePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculationCreatePosition(ctx, req.(*QueryCalculationCreatePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalculationIncreaseLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculationIncreaseLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculationIncreaseLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Query/CalculationIncreaseLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculationIncreaseLiquidity(ctx, req.(*QueryCalculationIncreaseLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.liquiditypool.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "PoolsByDenoms",
+			Handler:    _Query_PoolsByDenoms_Handler,
+		},
+		{
+			MethodName: "Position",
+			Handler:    _Query_Position_Handler,
+		},
+		{
+			MethodName: "Positions",
+			Handler:    _Query_Positions_Handler,
+		},
+		{
+			MethodName: "PoolPositions",
+			Handler:    _Query_PoolPositions_Handler,
+		},
+		{
+			MethodName: "AddressPositions",
+			Handler:    _Query_AddressPositions_Handler,
+		},
+		{
+			MethodName: "PositionFees",
+			Handler:    _Query_PositionFees_Handler,
+		},
+		{
+			MethodName: "LimitOrder",
+			Handler:    _Query_LimitOrder_Handler,
+		},
+		{
+			MethodName: "PoolLimitOrders",
+			Handler:    _Query_PoolLimitOrders_Handler,
+		},
+		{
+			MethodName: "AddressLimitOrders",
+			Handler:    _Query_AddressLimitOrders_Handler,
+		},
+		{
+			MethodName: "Twap",
+			Handler:    _Query_Twap_Handler,
+		},
+		{
+			MethodName: "CalculationCreatePosition",
+			Handler:    _Query_CalculationCreatePosition_Handler,
+		},
+		{
+			MethodName: "CalculationIncreaseLiquidity",
+			Handler:    _Query_CalculationIncreaseLiquidity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/liquiditypool/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2113,34 +2381,30 @@ func (m *QueryPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2150,27 +2414,25 @@ func (m *QueryAddressPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2180,34 +2442,50 @@ func (m *QueryAddressPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.TokenQuote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenBase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPositionFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2217,25 +2495,30 @@ func (m *QueryPositionFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPositionFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPositionFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPositionFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2245,34 +2528,32 @@ func (m *QueryPositionFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPositionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPositionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationCreatePositionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2282,53 +2563,83 @@ func (m *QueryCalculationCreatePositionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalculationCreatePositionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalculationCreatePositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if len(m.UpperTick) > 0 {
-		i -= len(m.UpperTick)
-		copy(dAtA[i:], m.UpperTick)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpperTick)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.LowerTick) > 0 {
-		i -= len(m.LowerTick)
-		copy(dAtA[i:], m.LowerTick)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LowerTick)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomQuote) > 0 {
+		i -= len(m.DenomQuote)
+		copy(dAtA[i:], m.DenomQuote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomQuote)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if len(m.DenomBase) > 0 {
+		i -= len(m.DenomBase)
+		copy(dAtA[i:], m.DenomBase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomBase)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationCreatePositionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsByDenomsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2338,30 +2649,34 @@ func (m *QueryCalculationCreatePositionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalculationCreatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalculationCreatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2371,30 +2686,16 @@ func (m *QueryCalculationIncreaseLiquidityRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPositionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalculationIncreaseLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomIn) > 0 {
-		i -= len(m.DenomIn)
-		copy(dAtA[i:], m.DenomIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AmountIn) > 0 {
-		i -= len(m.AmountIn)
-		copy(dAtA[i:], m.AmountIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountIn)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.Id != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
@@ -2403,7 +2704,7 @@ func (m *QueryCalculationIncreaseLiquidityRequest) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationIncreaseLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *PositionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2413,18 +2714,38 @@ func (m *QueryCalculationIncreaseLiquidityResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalculationIncreaseLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalculationIncreaseLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TokenRequired.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TokenQuote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenBase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2436,336 +2757,1925 @@ func (m *QueryCalculationIncreaseLiquidityResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TokenBase.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TokenQuote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPositionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TokenBase.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TokenQuote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *QueryPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPositionFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPositionFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryPositionFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationCreatePositionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.OnlyFilled {
+		i--
+		if m.OnlyFilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnlyOpen {
+		i--
+		if m.OnlyOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.LowerTick)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.UpperTick)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnlyFilled {
+		i--
+		if m.OnlyFilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.OnlyOpen {
+		i--
+		if m.OnlyOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationCreatePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageLiquidity.Size()
+		i -= size
+		if _, err := m.AverageLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationCreatePositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationCreatePositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationCreatePositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpperTick) > 0 {
+		i -= len(m.UpperTick)
+		copy(dAtA[i:], m.UpperTick)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpperTick)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowerTick) > 0 {
+		i -= len(m.LowerTick)
+		copy(dAtA[i:], m.LowerTick)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LowerTick)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationCreatePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationCreatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationCreatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationIncreaseLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationIncreaseLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationIncreaseLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomIn) > 0 {
+		i -= len(m.DenomIn)
+		copy(dAtA[i:], m.DenomIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountIn) > 0 {
+		i -= len(m.AmountIn)
+		copy(dAtA[i:], m.AmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationIncreaseLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationIncreaseLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationIncreaseLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenRequired.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *PoolInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenBase.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenQuote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomBase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomQuote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *PositionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenBase.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenQuote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPositionFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.OnlyOpen {
+		n += 2
+	}
+	if m.OnlyFilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPoolLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnlyOpen {
+		n += 2
+	}
+	if m.OnlyFilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAddressLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalculationCreatePositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.LowerTick)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UpperTick)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculationCreatePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalculationIncreaseLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.AmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculationIncreaseLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenRequired.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenQuote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryCalculationIncreaseLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	l = len(m.AmountIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DenomIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryCalculationIncreaseLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolInfo{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.TokenRequired.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2788,12 +4698,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2815,7 +4789,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2838,15 +4812,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2873,7 +4847,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, PoolInfo{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2898,7 +4873,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2921,10 +4896,10 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2967,7 +4942,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolInfo) Unmarshal(dAtA []byte) error {
+func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2990,15 +4965,15 @@ func (m *PoolInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3025,7 +5000,7 @@ func (m *PoolInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3116,7 +5091,7 @@ func (m *PoolInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3139,15 +5114,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3174,7 +5149,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3199,7 +5174,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,10 +5197,10 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3285,7 +5260,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,15 +5283,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3343,8 +5318,8 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, PoolInfo{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, PositionInfo{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3405,7 +5380,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3428,17 +5403,17 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3448,7 +5423,7 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3474,7 +5449,7 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,15 +5472,15 @@ func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3532,15 +5507,66 @@ func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, PositionInfo{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3550,28 +5576,77 @@ func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3598,7 +5673,8 @@ func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenQuote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, PositionInfo{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3623,7 +5699,7 @@ func (m *PositionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3646,17 +5722,17 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3666,25 +5742,11 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3706,7 +5768,7 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3729,15 +5791,15 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3764,13 +5826,80 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLimitOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLimitOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3792,7 +5921,7 @@ func (m *QueryPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3815,49 +5944,15 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Positions = append(m.Positions, PositionInfo{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3884,10 +5979,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3912,7 +6004,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3935,10 +6027,10 @@ func (m *QueryPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3960,6 +6052,46 @@ func (m *QueryPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyOpen = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyFilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyFilled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3981,7 +6113,7 @@ func (m *QueryPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4004,15 +6136,15 @@ func (m *QueryPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4039,8 +6171,8 @@ func (m *QueryPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, PositionInfo{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4065,7 +6197,7 @@ func (m *QueryPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4088,10 +6220,10 @@ func (m *QueryAddressPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4126,6 +6258,46 @@ func (m *QueryAddressPositionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyOpen = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyFilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyFilled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4147,7 +6319,7 @@ func (m *QueryAddressPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4170,15 +6342,15 @@ func (m *QueryAddressPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4205,8 +6377,8 @@ func (m *QueryAddressPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, PositionInfo{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4231,7 +6403,7 @@ func (m *QueryAddressPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4254,17 +6426,17 @@ func (m *QueryPositionFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4274,11 +6446,77 @@ func (m *QueryPositionFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4300,7 +6538,7 @@ func (m *QueryPositionFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4323,17 +6561,17 @@ func (m *QueryPositionFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4343,23 +6581,57 @@ func (m *QueryPositionFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, types.Coin{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
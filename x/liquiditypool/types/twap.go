@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// NewTwapObservation returns the first observation of a pool
+func NewTwapObservation(poolId uint64, t time.Time) TwapObservation {
+	return TwapObservation{
+		PoolId:              poolId,
+		Index:               0,
+		Time:                t,
+		TickCumulative:      math.LegacyZeroDec(),
+		LiquidityCumulative: math.LegacyZeroDec(),
+	}
+}
+
+// Accumulate returns the observation at time t assuming the pool stayed at
+// the given tick and liquidity since o.Time
+func (o TwapObservation) Accumulate(tick int64, liquidity math.LegacyDec, t time.Time) TwapObservation {
+	elapsed := elapsedSeconds(o.Time, t)
+	o.TickCumulative = o.TickCumulative.Add(math.LegacyNewDec(tick).Mul(elapsed))
+	o.LiquidityCumulative = o.LiquidityCumulative.Add(liquidity.Mul(elapsed))
+	o.Time = t
+	return o
+}
+
+// InterpolateTwapObservation returns the observation at time t in [a.Time, b.Time]
+func InterpolateTwapObservation(a, b TwapObservation, t time.Time) TwapObservation {
+	total := elapsedSeconds(a.Time, b.Time)
+	if total.IsZero() {
+		return a
+	}
+	ratio := elapsedSeconds(a.Time, t).Quo(total)
+
+	a.TickCumulative = a.TickCumulative.Add(b.TickCumulative.Sub(a.TickCumulative).Mul(ratio))
+	a.LiquidityCumulative = a.LiquidityCumulative.Add(b.LiquidityCumulative.Sub(a.LiquidityCumulative).Mul(ratio))
+	a.Time = t
+	return a
+}
+
+// AverageTickToPrice converts a (fractional) average tick to the price
+func AverageTickToPrice(averageTick math.LegacyDec, tickParams TickParams) (math.LegacyDec, error) {
+	offsetPrice := Pow(tickParams.PriceRatio, tickParams.BaseOffset)
+
+	var price math.LegacyDec
+	if averageTick.IsNegative() {
+		price = math.LegacyOneDec().Quo(Pow(tickParams.PriceRatio, averageTick.Neg()))
+	} else {
+		price = Pow(tickParams.PriceRatio, averageTick)
+	}
+
+	price = price.Mul(offsetPrice)
+
+	if price.GT(MaxSpotPrice) || price.LT(MinSpotPrice) {
+		return math.LegacyDec{}, ErrPriceOutOfBound
+	}
+	return price, nil
+}
+
+func elapsedSeconds(from, to time.Time) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(to.Sub(from).Nanoseconds(), 9)
+}
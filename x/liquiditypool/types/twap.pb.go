@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/liquiditypool/twap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapObservation is an entry of the ring buffer of a pool used to compute
+// time weighted averages.
+type TwapObservation struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Index of the observation in the ring buffer of the pool
+	Index uint64    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Time  time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Sum of current tick * elapsed seconds, i.e. the cumulative log-price in
+	// base price_ratio without the base offset
+	TickCumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=tick_cumulative,json=tickCumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tick_cumulative"`
+	// Sum of current tick liquidity * elapsed seconds
+	LiquidityCumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=liquidity_cumulative,json=liquidityCumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_cumulative"`
+}
+
+func (m *TwapObservation) Reset()         { *m = TwapObservation{} }
+func (m *TwapObservation) String() string { return proto.CompactTextString(m) }
+func (*TwapObservation) ProtoMessage()    {}
+func (*TwapObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42707c47744f0355, []int{0}
+}
+func (m *TwapObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapObservation.Merge(m, src)
+}
+func (m *TwapObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapObservation proto.InternalMessageInfo
+
+func (m *TwapObservation) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapObservation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TwapObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// TwapRing is the state of the observation ring buffer of a pool.
+type TwapRing struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Index of the latest observation
+	LastIndex uint64 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	// Number of observations stored
+	Length uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *TwapRing) Reset()         { *m = TwapRing{} }
+func (m *TwapRing) String() string { return proto.CompactTextString(m) }
+func (*TwapRing) ProtoMessage()    {}
+func (*TwapRing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42707c47744f0355, []int{1}
+}
+func (m *TwapRing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRing.Merge(m, src)
+}
+func (m *TwapRing) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRing) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRing proto.InternalMessageInfo
+
+func (m *TwapRing) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRing) GetLastIndex() uint64 {
+	if m != nil {
+		return m.LastIndex
+	}
+	return 0
+}
+
+func (m *TwapRing) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TwapObservation)(nil), "sunrise.liquiditypool.TwapObservation")
+	proto.RegisterType((*TwapRing)(nil), "sunrise.liquiditypool.TwapRing")
+}
+
+func init() { proto.RegisterFile("sunrise/liquiditypool/twap.proto", fileDescriptor_42707c47744f0355) }
+
+var fileDescriptor_42707c47744f0355 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xce, 0xac, 0xd9, 0xba, 0x3b, 0x82, 0x8b, 0xb1, 0x6a, 0xac, 0x98, 0x84, 0x3d, 0x15, 0xc1,
+	0x19, 0x50, 0x14, 0xcf, 0x75, 0x2f, 0x0b, 0xc2, 0x42, 0xd8, 0xd3, 0x5e, 0xc2, 0x24, 0x19, 0xa7,
+	0xc3, 0x26, 0x99, 0x98, 0x99, 0xb4, 0xcd, 0xbf, 0xe8, 0xcf, 0xf0, 0xe8, 0xc1, 0xdf, 0x20, 0x3d,
+	0x16, 0x4f, 0xe2, 0xa1, 0x4a, 0x7b, 0xf0, 0x6f, 0xc8, 0x4c, 0xd2, 0x5a, 0x05, 0x4f, 0x7b, 0x09,
+	0xef, 0x7b, 0xef, 0xcb, 0xf7, 0xe5, 0x7d, 0x79, 0x30, 0x90, 0x75, 0x51, 0x71, 0x49, 0x71, 0xc6,
+	0x3f, 0xd4, 0x3c, 0xe5, 0xaa, 0x29, 0x85, 0xc8, 0xb0, 0x9a, 0x92, 0x12, 0x95, 0x95, 0x50, 0xc2,
+	0x79, 0xd0, 0x31, 0xd0, 0x5f, 0x8c, 0xc1, 0x3d, 0x92, 0xf3, 0x42, 0x60, 0xf3, 0x6c, 0x99, 0x83,
+	0x3e, 0x13, 0x4c, 0x98, 0x12, 0xeb, 0xaa, 0xeb, 0x3e, 0x4e, 0x84, 0xcc, 0x85, 0x8c, 0xda, 0x41,
+	0x0b, 0xba, 0x91, 0xcf, 0x84, 0x60, 0x19, 0xc5, 0x06, 0xc5, 0xf5, 0x7b, 0xac, 0x78, 0x4e, 0xa5,
+	0x22, 0x79, 0xe7, 0x7d, 0xfa, 0xe5, 0x00, 0x9e, 0x5c, 0x4e, 0x49, 0x79, 0x11, 0x4b, 0x5a, 0x4d,
+	0x88, 0xe2, 0xa2, 0x70, 0x1e, 0xc1, 0xdb, 0xfa, 0x03, 0x22, 0x9e, 0xba, 0x20, 0x00, 0x43, 0x3b,
+	0xec, 0x69, 0x78, 0x9e, 0x3a, 0x7d, 0x78, 0xc8, 0x8b, 0x94, 0xce, 0xdc, 0x03, 0xd3, 0x6e, 0x81,
+	0xf3, 0x06, 0xda, 0x5a, 0xd5, 0xbd, 0x15, 0x80, 0xe1, 0x9d, 0x17, 0x03, 0xd4, 0x5a, 0xa2, 0xad,
+	0x25, 0xba, 0xdc, 0x5a, 0x8e, 0x8e, 0x16, 0x2b, 0xdf, 0x9a, 0xff, 0xf0, 0x41, 0x68, 0xde, 0x70,
+	0x22, 0x78, 0xa2, 0x78, 0x72, 0x1d, 0x25, 0x75, 0x5e, 0x67, 0x44, 0xf1, 0x09, 0x75, 0xed, 0x00,
+	0x0c, 0x8f, 0x47, 0xaf, 0x35, 0xf1, 0xfb, 0xca, 0x7f, 0xd2, 0x2e, 0x23, 0xd3, 0x6b, 0xc4, 0x05,
+	0xce, 0x89, 0x1a, 0xa3, 0x77, 0x94, 0x91, 0xa4, 0x39, 0xa3, 0xc9, 0xd7, 0xcf, 0xcf, 0x61, 0xb7,
+	0xeb, 0x19, 0x4d, 0x3e, 0xfe, 0xfa, 0xf4, 0x0c, 0x84, 0x77, 0xb5, 0xdc, 0xdb, 0x9d, 0x9a, 0xc3,
+	0x61, 0x7f, 0x97, 0xe9, 0xbe, 0xcb, 0xe1, 0x8d, 0x5c, 0xee, 0xef, 0x34, 0xff, 0x58, 0x9d, 0x5e,
+	0xc1, 0x23, 0x9d, 0x63, 0xc8, 0x0b, 0xf6, 0xff, 0x00, 0x9f, 0x42, 0x98, 0x11, 0xa9, 0xa2, 0xfd,
+	0x14, 0x8f, 0x75, 0xe7, 0xdc, 0x24, 0xf9, 0x10, 0xf6, 0x32, 0x5a, 0x30, 0x35, 0x36, 0x59, 0xda,
+	0x61, 0x87, 0x46, 0x17, 0x8b, 0xb5, 0x07, 0x96, 0x6b, 0x0f, 0xfc, 0x5c, 0x7b, 0x60, 0xbe, 0xf1,
+	0xac, 0xe5, 0xc6, 0xb3, 0xbe, 0x6d, 0x3c, 0xeb, 0xea, 0x15, 0xe3, 0x6a, 0x5c, 0xc7, 0x28, 0x11,
+	0x39, 0xee, 0xae, 0x28, 0x23, 0x0d, 0xad, 0xb6, 0x00, 0xcf, 0xfe, 0x3d, 0xbb, 0xa6, 0xa4, 0x32,
+	0xee, 0x99, 0x9f, 0xf3, 0xf2, 0xf7, 0x00, 0x1e, 0xaa, 0x97, 0x44, 0x9c, 0x02, 0x00, 0x00,
+}
+
+func (m *TwapObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityCumulative.Size()
+		i -= size
+		if _, err := m.LiquidityCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TickCumulative.Size()
+		i -= size
+		if _, err := m.TickCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastIndex != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.LastIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwap(uint64(m.PoolId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTwap(uint64(m.Index))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.TickCumulative.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.LiquidityCumulative.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	return n
+}
+
+func (m *TwapRing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwap(uint64(m.PoolId))
+	}
+	if m.LastIndex != 0 {
+		n += 1 + sovTwap(uint64(m.LastIndex))
+	}
+	if m.Length != 0 {
+		n += 1 + sovTwap(uint64(m.Length))
+	}
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
+			}
+			m.LastIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)
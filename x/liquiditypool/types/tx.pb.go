@@ -129,7 +129,9 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 type MsgCreatePool struct {
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The creator of the pool. Permissionless pools must use an allowed fee tier
+	// and the creator pays the pool creation fee, unless it is the authority.
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	DenomBase  string `protobuf:"bytes,2,opt,name=denom_base,json=denomBase,proto3" json:"denom_base,omitempty"`
 	DenomQuote string `protobuf:"bytes,3,opt,name=denom_quote,json=denomQuote,proto3" json:"denom_quote,omitempty"`
 	FeeRate    string `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
@@ -172,9 +174,9 @@ func (m *MsgCreatePool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePool proto.InternalMessageInfo
 
-func (m *MsgCreatePool) GetAuthority() string {
+func (m *MsgCreatePool) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
@@ -687,6 +689,320 @@ func (m *MsgClaimRewardsResponse) GetCollectedFees() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+type MsgPlaceLimitOrder struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The order covers [tick, tick + 1]. Base is sold above the current tick and
+	// quote is sold below it.
+	Tick    int64      `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	TokenIn types.Coin `protobuf:"bytes,4,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+}
+
+func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
+func (m *MsgPlaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrder) ProtoMessage()    {}
+func (*MsgPlaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{12}
+}
+func (m *MsgPlaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrder.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPlaceLimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgPlaceLimitOrder) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *MsgPlaceLimitOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+type MsgPlaceLimitOrderResponse struct {
+	Id      uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenIn types.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+}
+
+func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
+func (m *MsgPlaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{13}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrderResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgPlaceLimitOrderResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+type MsgCancelLimitOrder struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelLimitOrder) Reset()         { *m = MsgCancelLimitOrder{} }
+func (m *MsgCancelLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrder) ProtoMessage()    {}
+func (*MsgCancelLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{14}
+}
+func (m *MsgCancelLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrder.Merge(m, src)
+}
+func (m *MsgCancelLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrder proto.InternalMessageInfo
+
+func (m *MsgCancelLimitOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelLimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelLimitOrderResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgCancelLimitOrderResponse) Reset()         { *m = MsgCancelLimitOrderResponse{} }
+func (m *MsgCancelLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCancelLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{15}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgCancelLimitOrderResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgClaimLimitOrder struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgClaimLimitOrder) Reset()         { *m = MsgClaimLimitOrder{} }
+func (m *MsgClaimLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLimitOrder) ProtoMessage()    {}
+func (*MsgClaimLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{16}
+}
+func (m *MsgClaimLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLimitOrder.Merge(m, src)
+}
+func (m *MsgClaimLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLimitOrder proto.InternalMessageInfo
+
+func (m *MsgClaimLimitOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgClaimLimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgClaimLimitOrderResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimLimitOrderResponse) Reset()         { *m = MsgClaimLimitOrderResponse{} }
+func (m *MsgClaimLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLimitOrderResponse) ProtoMessage()    {}
+func (*MsgClaimLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_465d0426214d7c70, []int{17}
+}
+func (m *MsgClaimLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgClaimLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgClaimLimitOrderResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "sunrise.liquiditypool.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sunrise.liquiditypool.MsgUpdateParamsResponse")
@@ -700,78 +1016,94 @@ func init() {
 	proto.RegisterType((*MsgDecreaseLiquidityResponse)(nil), "sunrise.liquiditypool.MsgDecreaseLiquidityResponse")
 	proto.RegisterType((*MsgClaimRewards)(nil), "sunrise.liquiditypool.MsgClaimRewards")
 	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "sunrise.liquiditypool.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "sunrise.liquiditypool.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "sunrise.liquiditypool.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "sunrise.liquiditypool.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "sunrise.liquiditypool.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MsgClaimLimitOrder)(nil), "sunrise.liquiditypool.MsgClaimLimitOrder")
+	proto.RegisterType((*MsgClaimLimitOrderResponse)(nil), "sunrise.liquiditypool.MsgClaimLimitOrderResponse")
 }
 
 func init() { proto.RegisterFile("sunrise/liquiditypool/tx.proto", fileDescriptor_465d0426214d7c70) }
 
 var fileDescriptor_465d0426214d7c70 = []byte{
-	// 1049 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0x1b, 0x55,
-	0x10, 0xcf, 0xda, 0x8e, 0x53, 0x8f, 0x13, 0x97, 0xac, 0x12, 0xc5, 0x36, 0xad, 0x13, 0x2c, 0x44,
-	0xad, 0x40, 0x76, 0x93, 0x56, 0xf4, 0x90, 0x03, 0x6a, 0x9d, 0x08, 0xc9, 0x52, 0xa3, 0xd2, 0x6d,
-	0x91, 0x50, 0x2f, 0x66, 0xb3, 0xfb, 0xbc, 0x79, 0xb2, 0x77, 0x9f, 0xbb, 0xef, 0xb9, 0xad, 0x6f,
-	0x08, 0x6e, 0x48, 0x48, 0x9c, 0xf9, 0x04, 0xc0, 0x29, 0x87, 0x7e, 0x03, 0x24, 0xe8, 0xb1, 0xea,
-	0x09, 0x71, 0x28, 0x90, 0x1c, 0xf2, 0x05, 0xf8, 0x00, 0xe8, 0xfd, 0xf1, 0x7a, 0xfd, 0xb7, 0xc1,
-	0x4a, 0x0e, 0x5c, 0xe2, 0x78, 0x66, 0xde, 0x6f, 0x66, 0x7e, 0xef, 0x37, 0xbb, 0x63, 0x28, 0xd1,
-	0x4e, 0x10, 0x62, 0x8a, 0xcc, 0x16, 0x7e, 0xd2, 0xc1, 0x2e, 0x66, 0xdd, 0x36, 0x21, 0x2d, 0x93,
-	0x3d, 0x37, 0xda, 0x21, 0x61, 0x44, 0x5f, 0x55, 0x7e, 0x63, 0xc0, 0x5f, 0x5c, 0xb6, 0x7d, 0x1c,
-	0x10, 0x53, 0xfc, 0x95, 0x91, 0xc5, 0x35, 0x87, 0x50, 0x9f, 0x50, 0xd3, 0xa7, 0x9e, 0xf9, 0x74,
-	0x87, 0x7f, 0x28, 0x47, 0x41, 0x3a, 0xea, 0xe2, 0x9b, 0x29, 0xbf, 0x28, 0xd7, 0x8a, 0x47, 0x3c,
-	0x22, 0xed, 0xfc, 0x3f, 0x65, 0x2d, 0x29, 0xa4, 0x43, 0x9b, 0x22, 0xf3, 0xe9, 0xce, 0x21, 0x62,
-	0xf6, 0x8e, 0xe9, 0x10, 0x1c, 0x28, 0x7f, 0x79, 0x7c, 0xcd, 0x6d, 0x3b, 0xb4, 0x7d, 0x85, 0x5c,
-	0xfe, 0x4d, 0x83, 0xab, 0x07, 0xd4, 0xfb, 0xbc, 0xed, 0xda, 0x0c, 0x7d, 0x26, 0x3c, 0xfa, 0x6d,
-	0xc8, 0xd8, 0x1d, 0x76, 0x44, 0x42, 0xcc, 0xba, 0x79, 0x6d, 0x43, 0xab, 0x64, 0xaa, 0xf9, 0xd7,
-	0x2f, 0xb6, 0x56, 0x54, 0x49, 0x77, 0x5d, 0x37, 0x44, 0x94, 0x3e, 0x64, 0x21, 0x0e, 0x3c, 0xab,
-	0x1f, 0xaa, 0xdf, 0x81, 0xb4, 0xc4, 0xce, 0x27, 0x36, 0xb4, 0x4a, 0xf6, 0xe6, 0x75, 0x63, 0x2c,
-	0x29, 0x86, 0x4c, 0x53, 0xcd, 0xbc, 0x7c, 0xb3, 0x3e, 0xf7, 0xe3, 0xd9, 0xf1, 0xa6, 0x66, 0xa9,
-	0x73, 0xbb, 0xbb, 0x5f, 0x9f, 0x1d, 0x6f, 0xf6, 0x11, 0xbf, 0x3d, 0x3b, 0xde, 0xbc, 0xd1, 0x6b,
-	0xe2, 0xf9, 0x50, 0x1b, 0x43, 0x55, 0x97, 0x0b, 0xb0, 0x36, 0x64, 0xb2, 0x10, 0x6d, 0x93, 0x80,
-	0xa2, 0xf2, 0x3f, 0x1a, 0x2c, 0x1d, 0x50, 0x6f, 0x2f, 0x44, 0xdc, 0x47, 0x48, 0x6b, 0xe6, 0x16,
-	0xaf, 0x03, 0xb8, 0x28, 0x20, 0x7e, 0x9d, 0x73, 0x2e, 0xda, 0xcc, 0x58, 0x19, 0x61, 0xa9, 0xda,
-	0x14, 0xe9, 0xeb, 0x90, 0x95, 0xee, 0x27, 0x1d, 0xc2, 0x50, 0x3e, 0x29, 0xfc, 0xf2, 0xc4, 0x03,
-	0x6e, 0xd1, 0x0b, 0x70, 0xa5, 0x81, 0x50, 0x3d, 0xb4, 0x19, 0xca, 0xa7, 0x84, 0x77, 0xa1, 0x81,
-	0x90, 0x65, 0x33, 0x71, 0xb6, 0x1d, 0x62, 0x47, 0x38, 0x31, 0xc9, 0xcf, 0xcb, 0xb3, 0xc2, 0x64,
-	0x71, 0x0b, 0x0f, 0xe0, 0x59, 0xeb, 0xa4, 0xd1, 0xa0, 0x88, 0xe5, 0xd3, 0x32, 0x80, 0x9b, 0xee,
-	0x0b, 0xcb, 0x6e, 0x6e, 0x90, 0xbd, 0xf2, 0x0d, 0x58, 0x1d, 0xe8, 0xba, 0xc7, 0x87, 0x9e, 0x83,
-	0x04, 0x76, 0x45, 0xdb, 0x29, 0x2b, 0x81, 0xdd, 0xf2, 0xdf, 0x49, 0x58, 0x8e, 0x45, 0x52, 0xcc,
-	0x30, 0x09, 0xf4, 0x6d, 0x48, 0x53, 0x14, 0xb8, 0x28, 0x7c, 0x2b, 0x41, 0x2a, 0x4e, 0x5f, 0x83,
-	0x05, 0x7e, 0x35, 0x75, 0xec, 0x0a, 0x6a, 0x52, 0x56, 0x9a, 0x7f, 0xad, 0xb9, 0x9c, 0xb6, 0x16,
-	0x79, 0x86, 0xc2, 0x3a, 0xc3, 0x4e, 0x53, 0xd0, 0x92, 0xb4, 0x32, 0xc2, 0xf2, 0x08, 0x3b, 0x4d,
-	0xee, 0xee, 0xb4, 0xdb, 0x3d, 0x77, 0x4a, 0xba, 0x85, 0x45, 0xb8, 0x3f, 0x01, 0x60, 0xa4, 0x89,
-	0x02, 0x49, 0xfa, 0xbc, 0xd0, 0x56, 0xc1, 0x50, 0x95, 0x70, 0x9b, 0xa1, 0xc4, 0x6f, 0xec, 0x11,
-	0x1c, 0x54, 0x53, 0x5c, 0x57, 0x56, 0x46, 0x1c, 0x11, 0xb7, 0x72, 0x07, 0xb2, 0xf2, 0xbc, 0xbc,
-	0x95, 0xf4, 0xf9, 0x00, 0x64, 0x4e, 0x79, 0x6d, 0x5f, 0xc0, 0x55, 0x1f, 0x07, 0x75, 0xdb, 0x27,
-	0x9d, 0x80, 0xc9, 0x32, 0x16, 0x04, 0x27, 0xdb, 0x3c, 0xf4, 0x8f, 0x37, 0xeb, 0xab, 0x12, 0x8c,
-	0xba, 0x4d, 0x03, 0x13, 0xd3, 0xb7, 0xd9, 0x91, 0x51, 0x0b, 0xd8, 0xeb, 0x17, 0x5b, 0xa0, 0xb2,
-	0xd4, 0x02, 0x26, 0xa5, 0xbe, 0xe4, 0xe3, 0xe0, 0xae, 0xc0, 0x11, 0xb5, 0x3d, 0x86, 0x77, 0x62,
-	0xc8, 0xb2, 0xc0, 0x2b, 0x33, 0x42, 0xe7, 0x22, 0x68, 0x51, 0xf5, 0x6e, 0x96, 0xeb, 0x41, 0xdd,
-	0x4d, 0xf9, 0xa7, 0x04, 0x14, 0x46, 0xee, 0x78, 0x92, 0x22, 0xf4, 0x07, 0x90, 0x8d, 0x37, 0x9b,
-	0x98, 0xb1, 0x22, 0xb0, 0xfb, 0x9d, 0x3e, 0x84, 0xc5, 0x81, 0x2e, 0x93, 0x33, 0x62, 0xaa, 0xc2,
-	0xe4, 0xc5, 0x3c, 0x82, 0x4c, 0xf4, 0x54, 0x90, 0x03, 0x55, 0xbd, 0xad, 0x10, 0xdf, 0x1d, 0x45,
-	0xbc, 0x87, 0x3c, 0xdb, 0xe9, 0xee, 0x23, 0x27, 0x86, 0xbb, 0x8f, 0x1c, 0x89, 0xdb, 0x07, 0x2a,
-	0xff, 0x92, 0x84, 0x95, 0x03, 0xea, 0xd5, 0x02, 0x27, 0x44, 0x36, 0x45, 0xf7, 0x7a, 0x8e, 0x19,
-	0x46, 0x42, 0x12, 0x9b, 0x98, 0x44, 0x6c, 0xf2, 0x12, 0x88, 0x4d, 0x5d, 0x04, 0xb1, 0x63, 0x14,
-	0x3f, 0x7f, 0x79, 0x8a, 0x4f, 0x5f, 0x86, 0xe2, 0xcf, 0x34, 0xb8, 0x36, 0xee, 0x16, 0x23, 0xd1,
-	0xf3, 0x27, 0xae, 0x1a, 0x84, 0x7a, 0xa4, 0x7e, 0xe8, 0x99, 0x6a, 0xff, 0x9b, 0x29, 0x28, 0x7f,
-	0xa3, 0x09, 0xbd, 0xee, 0xa3, 0x8b, 0xd7, 0xeb, 0xb5, 0xf8, 0x80, 0xc9, 0xf7, 0x59, 0xdf, 0x30,
-	0xc8, 0xf7, 0xaf, 0x92, 0xef, 0x91, 0x2a, 0x22, 0xbe, 0x87, 0xe8, 0xd4, 0x2e, 0x81, 0xce, 0xc4,
-	0x45, 0xd0, 0x49, 0xc5, 0x4a, 0xb4, 0xd7, 0xb2, 0xb1, 0x6f, 0xa1, 0x67, 0x76, 0xe8, 0xd2, 0x19,
-	0x88, 0x7c, 0x0f, 0x16, 0x63, 0xe2, 0xe2, 0x2b, 0x51, 0xb2, 0x92, 0xb2, 0xb2, 0x7d, 0x75, 0xd1,
-	0x41, 0xf6, 0xbe, 0xd3, 0xc4, 0xfe, 0x12, 0xcf, 0x1a, 0x11, 0x17, 0x42, 0xce, 0x21, 0xad, 0x16,
-	0x72, 0x18, 0x72, 0xeb, 0x0d, 0x84, 0x68, 0x5e, 0xdb, 0x48, 0x4e, 0x7f, 0x87, 0x09, 0x0a, 0x7e,
-	0xfe, 0x73, 0xbd, 0xe2, 0x61, 0x76, 0xd4, 0x39, 0x34, 0x1c, 0xe2, 0xab, 0x95, 0x52, 0x7d, 0x6c,
-	0x51, 0xb7, 0x69, 0xb2, 0x6e, 0x1b, 0x51, 0x71, 0x80, 0x5a, 0x4b, 0x51, 0x8a, 0x4f, 0x11, 0xa2,
-	0x37, 0x7f, 0x98, 0x87, 0xe4, 0x01, 0xf5, 0xf4, 0x06, 0x2c, 0x0e, 0x2c, 0x87, 0x1f, 0x4c, 0x58,
-	0xea, 0x86, 0x76, 0xaf, 0xa2, 0x71, 0xbe, 0xb8, 0xa8, 0xc7, 0x2f, 0x01, 0x62, 0xfb, 0xd9, 0xfb,
-	0x93, 0x4f, 0xf7, 0xa3, 0x8a, 0x1f, 0x9d, 0x27, 0x2a, 0xca, 0xd0, 0x82, 0xdc, 0xd0, 0x86, 0x53,
-	0x79, 0xfb, 0x79, 0x19, 0x59, 0xdc, 0x3e, 0x6f, 0x64, 0x94, 0xad, 0x03, 0xcb, 0xa3, 0xef, 0x8f,
-	0x0f, 0x27, 0xc3, 0x8c, 0x04, 0x17, 0x6f, 0xfd, 0x87, 0xe0, 0x78, 0xda, 0xd1, 0xc7, 0xc0, 0x94,
-	0xb4, 0x23, 0xc1, 0xd3, 0xd2, 0x4e, 0x1e, 0xed, 0x06, 0x2c, 0x0e, 0xcc, 0xcb, 0x14, 0x95, 0xc4,
-	0xe3, 0xa6, 0xa9, 0x64, 0xdc, 0x24, 0x14, 0xe7, 0xbf, 0xe2, 0xe3, 0x5a, 0xbd, 0xff, 0xf2, 0xa4,
-	0xa4, 0xbd, 0x3a, 0x29, 0x69, 0x7f, 0x9d, 0x94, 0xb4, 0xef, 0x4f, 0x4b, 0x73, 0xaf, 0x4e, 0x4b,
-	0x73, 0xbf, 0x9f, 0x96, 0xe6, 0x1e, 0x7f, 0x1c, 0xd3, 0xbb, 0x82, 0x6e, 0xd9, 0x5d, 0x14, 0x9a,
-	0x93, 0x7e, 0x46, 0x88, 0x11, 0x38, 0x4c, 0x8b, 0x5f, 0x43, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xc2, 0xd8, 0x4e, 0x51, 0xe7, 0x0d, 0x00, 0x00,
+	// 1201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x8e, 0x53, 0x3f, 0xe7, 0x47, 0xb3, 0x24, 0x8a, 0xb3, 0x6d, 0x9d, 0x60, 0x21,
+	0x9a, 0x06, 0xb2, 0x4e, 0x52, 0xd1, 0x43, 0x0e, 0xa8, 0x75, 0x2c, 0x24, 0x4b, 0x89, 0xd2, 0x6e,
+	0x8b, 0x84, 0x7a, 0x31, 0x9b, 0xdd, 0xb1, 0x33, 0x8a, 0x77, 0xc7, 0xdd, 0x19, 0xb7, 0xcd, 0x0d,
+	0xca, 0x0d, 0x09, 0x89, 0x3f, 0x03, 0x38, 0xe5, 0xd0, 0x23, 0x37, 0x24, 0xe8, 0xb1, 0xea, 0x09,
+	0x71, 0x28, 0x90, 0x1c, 0x72, 0xe0, 0xc2, 0x9f, 0x80, 0x66, 0x66, 0xbd, 0x5e, 0xaf, 0xb3, 0x89,
+	0x63, 0x62, 0x24, 0x2e, 0xb6, 0xf7, 0xbd, 0x6f, 0xbe, 0xf7, 0xde, 0x37, 0x6f, 0x7e, 0xac, 0x21,
+	0x4f, 0x5b, 0xae, 0x87, 0x29, 0x2a, 0x36, 0xf0, 0x93, 0x16, 0xb6, 0x31, 0x3b, 0x68, 0x12, 0xd2,
+	0x28, 0xb2, 0xe7, 0x7a, 0xd3, 0x23, 0x8c, 0xa8, 0xb3, 0xbe, 0x5f, 0xef, 0xf2, 0x6b, 0xd3, 0xa6,
+	0x83, 0x5d, 0x52, 0x14, 0x9f, 0x12, 0xa9, 0xcd, 0x59, 0x84, 0x3a, 0x84, 0x16, 0x1d, 0x5a, 0x2f,
+	0x3e, 0x5d, 0xe3, 0x5f, 0xbe, 0x63, 0x5e, 0x3a, 0xaa, 0xe2, 0xa9, 0x28, 0x1f, 0x7c, 0xd7, 0x4c,
+	0x9d, 0xd4, 0x89, 0xb4, 0xf3, 0x5f, 0xbe, 0x35, 0xef, 0x33, 0xed, 0x9a, 0x14, 0x15, 0x9f, 0xae,
+	0xed, 0x22, 0x66, 0xae, 0x15, 0x2d, 0x82, 0x5d, 0xdf, 0x5f, 0x38, 0x3d, 0xe7, 0xa6, 0xe9, 0x99,
+	0x8e, 0xcf, 0x5c, 0xf8, 0x45, 0x81, 0xa9, 0x6d, 0x5a, 0xff, 0xb4, 0x69, 0x9b, 0x0c, 0xdd, 0x17,
+	0x1e, 0xf5, 0x0e, 0x64, 0xcc, 0x16, 0xdb, 0x23, 0x1e, 0x66, 0x07, 0x39, 0x65, 0x51, 0x59, 0xca,
+	0x94, 0x72, 0x6f, 0x5e, 0xae, 0xcc, 0xf8, 0x29, 0xdd, 0xb3, 0x6d, 0x0f, 0x51, 0xfa, 0x90, 0x79,
+	0xd8, 0xad, 0x1b, 0x1d, 0xa8, 0x7a, 0x17, 0xd2, 0x92, 0x3b, 0x97, 0x58, 0x54, 0x96, 0xb2, 0xeb,
+	0x37, 0xf4, 0x53, 0x45, 0xd1, 0x65, 0x98, 0x52, 0xe6, 0xd5, 0xdb, 0x85, 0x91, 0xef, 0x4e, 0x0e,
+	0x97, 0x15, 0xc3, 0x1f, 0xb7, 0xb1, 0xf1, 0xe2, 0xe4, 0x70, 0xb9, 0xc3, 0xf8, 0xf5, 0xc9, 0xe1,
+	0xf2, 0xcd, 0x76, 0x11, 0xcf, 0x23, 0x65, 0x44, 0xb2, 0x2e, 0xcc, 0xc3, 0x5c, 0xc4, 0x64, 0x20,
+	0xda, 0x24, 0x2e, 0x45, 0x85, 0xbf, 0x14, 0x98, 0xd8, 0xa6, 0xf5, 0x4d, 0x0f, 0x71, 0x1f, 0x21,
+	0x0d, 0x75, 0x15, 0xd2, 0x14, 0xb9, 0x36, 0xf2, 0xce, 0xad, 0xcf, 0xc7, 0xa9, 0x37, 0x00, 0x6c,
+	0xe4, 0x12, 0xa7, 0xca, 0xd5, 0x16, 0x05, 0x66, 0x8c, 0x8c, 0xb0, 0x94, 0x4c, 0x8a, 0xd4, 0x05,
+	0xc8, 0x4a, 0xf7, 0x93, 0x16, 0x61, 0x28, 0x97, 0x14, 0x7e, 0x39, 0xe2, 0x01, 0xb7, 0xa8, 0xf3,
+	0x70, 0xa5, 0x86, 0x50, 0xd5, 0x33, 0x19, 0xca, 0xa5, 0x84, 0x77, 0xac, 0x86, 0x90, 0x61, 0x32,
+	0x31, 0xb6, 0xe9, 0x61, 0x4b, 0x38, 0x31, 0xc9, 0x8d, 0xca, 0xb1, 0xc2, 0x64, 0x70, 0x0b, 0x07,
+	0xf0, 0xa8, 0x55, 0x52, 0xab, 0x51, 0xc4, 0x72, 0x69, 0x09, 0xe0, 0xa6, 0x1d, 0x61, 0xd9, 0xc8,
+	0x72, 0xdd, 0xfc, 0x4c, 0x0b, 0x37, 0x61, 0xb6, 0xab, 0xd8, 0xb6, 0x0c, 0xea, 0x24, 0x24, 0xb0,
+	0x2d, 0x0a, 0x4e, 0x19, 0x09, 0x6c, 0x17, 0xfe, 0x4c, 0xc2, 0x74, 0x08, 0x49, 0x31, 0xc3, 0xc4,
+	0x1d, 0x40, 0x9a, 0x39, 0x18, 0xe3, 0x33, 0x52, 0xc5, 0xb6, 0xd0, 0x25, 0x65, 0xa4, 0xf9, 0x63,
+	0xc5, 0xe6, 0x9a, 0x35, 0xc8, 0x33, 0xe4, 0x55, 0x19, 0xb6, 0xf6, 0x85, 0x26, 0x49, 0x23, 0x23,
+	0x2c, 0x8f, 0xb0, 0xb5, 0xcf, 0xdd, 0xad, 0x66, 0xb3, 0xed, 0x4e, 0x49, 0xb7, 0xb0, 0x08, 0xf7,
+	0xc7, 0x00, 0x8c, 0xec, 0x23, 0x57, 0x2a, 0x3e, 0x2a, 0x5a, 0x6a, 0x5e, 0xf7, 0x33, 0xe1, 0x36,
+	0xdd, 0xef, 0x79, 0x7d, 0x93, 0x60, 0xb7, 0x94, 0xe2, 0xed, 0x64, 0x64, 0xc4, 0x10, 0x31, 0x25,
+	0x77, 0x21, 0x2b, 0xc7, 0xcb, 0x29, 0x49, 0xf7, 0x47, 0x20, 0x63, 0xca, 0x39, 0xfb, 0x0c, 0xa6,
+	0x1c, 0xec, 0x56, 0x4d, 0x87, 0xb4, 0x5c, 0x26, 0xd3, 0x18, 0x13, 0x9a, 0xac, 0x72, 0xe8, 0x6f,
+	0x6f, 0x17, 0x66, 0x25, 0x19, 0xb5, 0xf7, 0x75, 0x4c, 0x8a, 0x8e, 0xc9, 0xf6, 0xf4, 0x8a, 0xcb,
+	0xde, 0xbc, 0x5c, 0x01, 0x3f, 0x4a, 0xc5, 0x65, 0xb2, 0xc3, 0x27, 0x1c, 0xec, 0xde, 0x13, 0x3c,
+	0x22, 0xb7, 0xc7, 0x70, 0x35, 0xc4, 0x2c, 0x13, 0xbc, 0x32, 0x20, 0xf5, 0x64, 0x40, 0x2d, 0xb2,
+	0xee, 0x6e, 0x86, 0xef, 0x13, 0x30, 0xdf, 0x33, 0xc7, 0x71, 0x1d, 0xa1, 0x3e, 0x80, 0x6c, 0xb8,
+	0xd8, 0xc4, 0x80, 0x19, 0x81, 0xd9, 0xa9, 0xf4, 0x21, 0x8c, 0x77, 0x55, 0x99, 0x1c, 0x90, 0x33,
+	0x6b, 0x76, 0x4a, 0x54, 0x1f, 0x41, 0x26, 0xd8, 0x0c, 0xe4, 0x6a, 0x2a, 0xdd, 0xf1, 0x19, 0xaf,
+	0xf5, 0x32, 0x6e, 0xa1, 0xba, 0x69, 0x1d, 0x94, 0x91, 0x15, 0xe2, 0x2d, 0x23, 0x4b, 0xf2, 0x76,
+	0x88, 0x0a, 0x3f, 0x25, 0x61, 0x66, 0x9b, 0xd6, 0x2b, 0xae, 0xe5, 0x21, 0x93, 0xa2, 0xad, 0xb6,
+	0x63, 0x80, 0x25, 0x21, 0x85, 0x4d, 0xc4, 0x09, 0x9b, 0x1c, 0x82, 0xb0, 0xa9, 0xcb, 0x10, 0xf6,
+	0x94, 0x8e, 0x1f, 0x1d, 0x5e, 0xc7, 0xa7, 0x87, 0xd1, 0xf1, 0x27, 0x0a, 0x5c, 0x3f, 0x6d, 0x16,
+	0x83, 0xa6, 0xe7, 0xdb, 0xad, 0xbf, 0x10, 0xaa, 0x41, 0xf7, 0x43, 0xdb, 0x54, 0xf9, 0xdf, 0xac,
+	0x82, 0xc2, 0x57, 0x8a, 0xe8, 0xd7, 0x32, 0xba, 0xfc, 0x7e, 0xbd, 0x1e, 0x5e, 0x60, 0xf2, 0x30,
+	0xeb, 0x18, 0xba, 0xf5, 0xfe, 0x59, 0xea, 0x5d, 0x46, 0x71, 0x7a, 0x47, 0xe4, 0x54, 0x86, 0x20,
+	0x67, 0xe2, 0x32, 0xe4, 0xa4, 0xe2, 0x26, 0xb4, 0xd9, 0x30, 0xb1, 0x63, 0xa0, 0x67, 0xa6, 0x67,
+	0xd3, 0x01, 0x84, 0x7c, 0x17, 0xc6, 0x43, 0xcd, 0xc5, 0x6f, 0x42, 0xc9, 0xa5, 0x94, 0x91, 0xed,
+	0x74, 0x17, 0xed, 0x56, 0xef, 0x1b, 0x05, 0xe6, 0x22, 0x51, 0x03, 0xe1, 0x3c, 0x98, 0xb4, 0x48,
+	0xa3, 0x81, 0x2c, 0x86, 0xec, 0x6a, 0x0d, 0x21, 0x9a, 0x53, 0x16, 0x93, 0x67, 0x9f, 0x61, 0x42,
+	0x82, 0x1f, 0x7e, 0x5f, 0x58, 0xaa, 0x63, 0xb6, 0xd7, 0xda, 0xd5, 0x2d, 0xe2, 0xf8, 0x37, 0x49,
+	0xff, 0x6b, 0x85, 0xda, 0xfb, 0x45, 0x76, 0xd0, 0x44, 0x54, 0x0c, 0xa0, 0xc6, 0x44, 0x10, 0xe2,
+	0x13, 0x84, 0x68, 0xe1, 0x47, 0x05, 0xd4, 0x6d, 0x5a, 0xbf, 0xdf, 0x30, 0x2d, 0xb4, 0x85, 0x1d,
+	0xcc, 0x76, 0x3c, 0x5e, 0xd6, 0x25, 0x5e, 0x0a, 0x54, 0x48, 0x85, 0xae, 0x03, 0xe2, 0xb7, 0xba,
+	0x01, 0x57, 0xe4, 0x51, 0x8d, 0x5d, 0xb1, 0x8f, 0xf5, 0x71, 0x4e, 0x8f, 0x89, 0x01, 0x15, 0xb7,
+	0x5b, 0xce, 0x3d, 0xd0, 0x7a, 0xb3, 0x8f, 0x3d, 0xee, 0xc2, 0x61, 0x13, 0x17, 0x0b, 0x5b, 0xb0,
+	0xe1, 0x1d, 0x3e, 0x6f, 0xa6, 0x6b, 0xa1, 0xc6, 0xbf, 0x12, 0x2a, 0xb2, 0xf4, 0xba, 0xeb, 0x79,
+	0xa1, 0xc0, 0xb5, 0x53, 0xc2, 0x04, 0x15, 0x59, 0x90, 0x96, 0x2d, 0x3c, 0x8c, 0xd6, 0xf0, 0xa9,
+	0x0b, 0x16, 0xa8, 0xed, 0x16, 0x1d, 0x5e, 0xa5, 0x5f, 0x2a, 0xa0, 0xf5, 0x46, 0xf9, 0x4f, 0x0b,
+	0x5d, 0xff, 0x7b, 0x0c, 0x92, 0xdb, 0xb4, 0xae, 0xd6, 0x60, 0xbc, 0xeb, 0x85, 0xe8, 0xfd, 0x98,
+	0x17, 0x99, 0xc8, 0xfb, 0x86, 0xa6, 0xf7, 0x87, 0x0b, 0x8a, 0xfa, 0x1c, 0x20, 0xf4, 0x4e, 0xf2,
+	0x5e, 0xfc, 0xe8, 0x0e, 0x4a, 0xfb, 0xb0, 0x1f, 0x54, 0x10, 0xa1, 0x01, 0x93, 0x91, 0xeb, 0xfd,
+	0xd2, 0xf9, 0xe3, 0x25, 0x52, 0x5b, 0xed, 0x17, 0x19, 0x44, 0x6b, 0xc1, 0x74, 0xef, 0xe5, 0xe9,
+	0x83, 0x78, 0x9a, 0x1e, 0xb0, 0x76, 0xfb, 0x02, 0xe0, 0x70, 0xd8, 0x32, 0xba, 0x40, 0xd8, 0x32,
+	0xba, 0x40, 0xd8, 0xf8, 0x73, 0xad, 0x06, 0xe3, 0x5d, 0x87, 0xc5, 0x19, 0x5d, 0x12, 0xc6, 0x69,
+	0x7a, 0x7f, 0xb8, 0x20, 0x0e, 0x81, 0xa9, 0xe8, 0x76, 0x7c, 0x2b, 0x9e, 0x22, 0x02, 0xd5, 0xd6,
+	0xfa, 0x86, 0x86, 0xce, 0x9d, 0xab, 0x3d, 0xfb, 0xda, 0xf2, 0x19, 0x49, 0x47, 0xb0, 0xda, 0x7a,
+	0xff, 0xd8, 0x70, 0x91, 0xd1, 0x0d, 0xe6, 0xd6, 0x39, 0x3a, 0xf5, 0x57, 0x64, 0xcc, 0x86, 0xa2,
+	0x8d, 0x7e, 0xc1, 0x6f, 0x00, 0xa5, 0x9d, 0x57, 0x47, 0x79, 0xe5, 0xf5, 0x51, 0x5e, 0xf9, 0xe3,
+	0x28, 0xaf, 0x7c, 0x7b, 0x9c, 0x1f, 0x79, 0x7d, 0x9c, 0x1f, 0xf9, 0xf5, 0x38, 0x3f, 0xf2, 0xf8,
+	0xa3, 0xd0, 0xf6, 0xe1, 0xb3, 0x37, 0xcc, 0x03, 0xe4, 0x15, 0xe3, 0xfe, 0x90, 0x10, 0x3b, 0xca,
+	0x6e, 0x5a, 0xfc, 0xaf, 0x72, 0xfb, 0x9f, 0x01, 0x00, 0xe9, 0x13, 0xf2, 0xd1, 0x31, 0x12, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -794,6 +1126,9 @@ type MsgClient interface {
 	IncreaseLiquidity(ctx context.Context, in *MsgIncreaseLiquidity, opts ...grpc.CallOption) (*MsgIncreaseLiquidityResponse, error)
 	DecreaseLiquidity(ctx context.Context, in *MsgDecreaseLiquidity, opts ...grpc.CallOption) (*MsgDecreaseLiquidityResponse, error)
 	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	ClaimLimitOrder(ctx context.Context, in *MsgClaimLimitOrder, opts ...grpc.CallOption) (*MsgClaimLimitOrderResponse, error)
 }
 
 type msgClient struct {
@@ -858,6 +1193,33 @@ func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts
 	return out, nil
 }
 
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimLimitOrder(ctx context.Context, in *MsgClaimLimitOrder, opts ...grpc.CallOption) (*MsgClaimLimitOrderResponse, error) {
+	out := new(MsgClaimLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.liquiditypool.Msg/ClaimLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -868,6 +1230,9 @@ type MsgServer interface {
 	IncreaseLiquidity(context.Context, *MsgIncreaseLiquidity) (*MsgIncreaseLiquidityResponse, error)
 	DecreaseLiquidity(context.Context, *MsgDecreaseLiquidity) (*MsgDecreaseLiquidityResponse, error)
 	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	ClaimLimitOrder(context.Context, *MsgClaimLimitOrder) (*MsgClaimLimitOrderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -892,6 +1257,15 @@ func (*UnimplementedMsgServer) DecreaseLiquidity(ctx context.Context, req *MsgDe
 func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) ClaimLimitOrder(ctx context.Context, req *MsgClaimLimitOrder) (*MsgClaimLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLimitOrder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1005,6 +1379,60 @@ func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.liquiditypool.Msg/ClaimLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLimitOrder(ctx, req.(*MsgClaimLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sunrise.liquiditypool.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1033,7 +1461,19 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRewards",
 			Handler:    _Msg_ClaimRewards_Handler,
 		},
-	},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "ClaimLimitOrder",
+			Handler:    _Msg_ClaimLimitOrder_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/liquiditypool/tx.proto",
 }
@@ -1156,10 +1596,10 @@ func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1630,6 +2070,238 @@ func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Tick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1671,7 +2343,7 @@ func (m *MsgCreatePool) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1866,17 +2538,114 @@ func (m *MsgClaimRewardsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovTx(uint64(m.Tick))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2068,7 +2837,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2096,7 +2865,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3659,6 +4428,631 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"math"
 	"time"
 
 	errors "cosmossdk.io/errors"
@@ -25,12 +26,26 @@ var (
 		RevisionHeight: 0,
 	}
 
-	// DefaultTransferPacketTimeoutTimestamp is the timeout timestamp following IBC defaults
-	DefaultTransferPacketTimeoutTimestamp = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp) * time.Nanosecond
+	// maxPacketTimeoutSeconds is the longest timeout of a packet, for its
+	// timeout timestamp not to overflow
+	maxPacketTimeoutSeconds = sdkmath.LegacyNewDec(math.MaxInt64 / int64(time.Second))
 )
 
-func timeoutTimestamp(ctx sdk.Context) uint64 {
-	return uint64(ctx.BlockTime().UnixNano()) + uint64(DefaultTransferPacketTimeoutTimestamp.Nanoseconds())
+// packetTimeoutTimestamp returns the timeout timestamp of a change or forward
+// packet resent the given number of times, its timeout growing by the retry
+// timeout multiplier each time it is resent.
+func (k Keeper) packetTimeoutTimestamp(ctx sdk.Context, resentCount uint32) uint64 {
+	params := k.GetParams(ctx)
+
+	timeout := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(params.PacketTimeoutSeconds))
+	for i := uint32(0); i < resentCount && timeout.LT(maxPacketTimeoutSeconds); i++ {
+		timeout = timeout.Mul(params.RetryTimeoutMultiplier)
+	}
+	if timeout.GT(maxPacketTimeoutSeconds) {
+		timeout = maxPacketTimeoutSeconds
+	}
+
+	return uint64(ctx.BlockTime().UnixNano()) + uint64(timeout.MulInt64(int64(time.Second)).TruncateInt64())
 }
 
 func (k Keeper) SwapIncomingFund(
@@ -110,15 +125,18 @@ func (k Keeper) ProcessSwappedFund(
 	incomingAck exported.Acknowledgement,
 ) (waitingPacket *types.IncomingInFlightPacket, err error) {
 	waitingPacket = &types.IncomingInFlightPacket{
-		Index:        types.NewPacketIndex(incomingPacket.DestinationPort, incomingPacket.DestinationChannel, incomingPacket.Sequence),
-		Data:         incomingPacket.Data,
-		SrcPortId:    incomingPacket.SourcePort,
-		SrcChannelId: incomingPacket.SourceChannel,
-		Ack:          incomingAck.Acknowledgement(),
-		Result:       result,
-		InterfaceFee: interfaceFee,
-		Change:       &types.IncomingInFlightPacket_AckChange{},  // default value is nil ack
-		Forward:      &types.IncomingInFlightPacket_AckForward{}, // default value is nil ack
+		Index:            types.NewPacketIndex(incomingPacket.DestinationPort, incomingPacket.DestinationChannel, incomingPacket.Sequence),
+		Data:             incomingPacket.Data,
+		SrcPortId:        incomingPacket.SourcePort,
+		SrcChannelId:     incomingPacket.SourceChannel,
+		TimeoutHeight:    incomingPacket.TimeoutHeight.String(),
+		TimeoutTimestamp: incomingPacket.TimeoutTimestamp,
+		Ack:              incomingAck.Acknowledgement(),
+		Result:           result,
+		InterfaceFee:     interfaceFee,
+		Change:           &types.IncomingInFlightPacket_AckChange{},  // default value is nil ack
+		Forward:          &types.IncomingInFlightPacket_AckForward{}, // default value is nil ack
+		CreatedHeight:    ctx.BlockHeight(),
 	}
 
 	maxAmountIn, ok := sdkmath.NewIntFromString(tokenData.Amount)
@@ -192,11 +210,15 @@ func (k Keeper) TransferAndCreateOutgoingInFlightPacket(
 	tokenOut sdk.Coin,
 	metadata packetforwardtypes.ForwardMetadata,
 ) (packet types.OutgoingInFlightPacket, err error) {
+	// The next metadata is passed to the receiving chain as the memo of the
+	// outgoing packet, so that it can be forwarded or swapped again there.
 	var memo string
 	if metadata.Next != nil {
-		if err := json.Unmarshal([]byte(memo), &metadata.Next); err != nil {
+		memoBz, err := json.Marshal(metadata.Next)
+		if err != nil {
 			return packet, err
 		}
+		memo = string(memoBz)
 	}
 
 	msgTransfer := transfertypes.MsgTransfer{
@@ -206,7 +228,7 @@ func (k Keeper) TransferAndCreateOutgoingInFlightPacket(
 		Sender:           sender,
 		Receiver:         metadata.Receiver,
 		TimeoutHeight:    DefaultTransferPacketTimeoutHeight,
-		TimeoutTimestamp: timeoutTimestamp(ctx),
+		TimeoutTimestamp: k.packetTimeoutTimestamp(ctx, 0),
 		Memo:             memo,
 	}
 	// forward token to receiver
@@ -215,11 +237,11 @@ func (k Keeper) TransferAndCreateOutgoingInFlightPacket(
 		return packet, err
 	}
 
-	var retries uint8
+	var retries int32
 	if metadata.Retries != nil {
-		retries = *metadata.Retries
+		retries = int32(*metadata.Retries)
 	} else {
-		retries = types.DefaultRetryCount
+		retries = int32(k.GetParams(ctx).RetryCount)
 	}
 
 	packet = types.OutgoingInFlightPacket{
@@ -229,7 +251,7 @@ func (k Keeper) TransferAndCreateOutgoingInFlightPacket(
 			res.Sequence,
 		),
 		AckWaitingIndex:  incomingIndex,
-		RetriesRemaining: int32(retries),
+		RetriesRemaining: retries,
 	}
 
 	k.SetOutgoingInFlightPacket(ctx, packet)
@@ -237,6 +259,11 @@ func (k Keeper) TransferAndCreateOutgoingInFlightPacket(
 	return packet, nil
 }
 
+// OnAcknowledgementOutgoingInFlightPacket records the acknowledgement of a
+// change or forward packet in its incoming packet. It must be called after the
+// transfer module has handled the acknowledgement, so that the tokens of a
+// failed packet are refunded to its sender and can be returned to the source
+// chain if the swap metadata asks for it.
 func (k Keeper) OnAcknowledgementOutgoingInFlightPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -250,116 +277,185 @@ func (k Keeper) OnAcknowledgementOutgoingInFlightPacket(
 		return nil
 	}
 
-	// The pattern of waitingPacket.Return == nil is not handled here
-	switch incomingPacket.Change.(type) {
-	case *types.IncomingInFlightPacket_OutgoingIndexChange:
-		incomingPacket.Change = &types.IncomingInFlightPacket_AckChange{
-			AckChange: acknowledgement,
-		}
-		break
-	case *types.IncomingInFlightPacket_AckChange:
-		break
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		acknowledgement = k.refundFailedOutgoingPacket(ctx, incomingPacket, packet, ack).Acknowledgement()
 	}
 
-	// The pattern of waitingPacket.Forward == nil is not handled here
-	switch incomingPacket.Forward.(type) {
-	case *types.IncomingInFlightPacket_OutgoingIndexForward:
-		incomingPacket.Forward = &types.IncomingInFlightPacket_AckForward{
-			AckForward: acknowledgement,
-		}
-		break
-	case *types.IncomingInFlightPacket_AckForward:
-		break
+	return k.completeOutgoingInFlightPacket(ctx, incomingPacket, outgoingPacket.Index, acknowledgement)
+}
+
+// RetryOutgoingInFlightPacket sends again a timed out change or forward
+// packet if it has retries remaining. It returns false once the retries are
+// exhausted, in which case the timeout must be handled by the transfer module
+// and OnTimeoutOutgoingInFlightPacket.
+func (k Keeper) RetryOutgoingInFlightPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	outgoingPacket types.OutgoingInFlightPacket,
+) (retried bool, err error) {
+	if outgoingPacket.RetriesRemaining <= 1 {
+		return false, nil
 	}
+	k.RemoveOutgoingInFlightPacket(ctx, outgoingPacket.Index.PortId, outgoingPacket.Index.ChannelId, outgoingPacket.Index.Sequence)
+	outgoingPacket.RetriesRemaining--
+	outgoingPacket.ResentCount++
 
-	deleted, err := k.ShouldDeleteCompletedWaitingPacket(ctx, incomingPacket)
+	// Resend packet, with a longer timeout than the previous one
+	timeout := k.packetTimeoutTimestamp(ctx, outgoingPacket.ResentCount)
+	_, chanCap, err := k.IbcKeeperFn().ChannelKeeper.LookupModuleByChannel(ctx, packet.SourcePort, packet.SourceChannel)
 	if err != nil {
-		return err
+		return false, errors.Wrap(err, "could not retrieve module from port-id")
 	}
-	if !deleted {
+	sequence, err := k.IbcKeeperFn().ChannelKeeper.SendPacket(
+		ctx,
+		chanCap,
+		packet.SourcePort,
+		packet.SourceChannel,
+		DefaultTransferPacketTimeoutHeight,
+		timeout,
+		packet.Data,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	// Set the new sequence number, in the incoming packet too as it is used
+	// to find which of the change or forward packet is acknowledged
+	index := outgoingPacket.Index
+	outgoingPacket.Index.Sequence = sequence
+	k.SetOutgoingInFlightPacket(ctx, outgoingPacket)
+
+	incomingPacket, found := k.GetIncomingInFlightPacket(ctx, outgoingPacket.AckWaitingIndex.PortId, outgoingPacket.AckWaitingIndex.ChannelId, outgoingPacket.AckWaitingIndex.Sequence)
+	if found {
+		if change, ok := incomingPacket.Change.(*types.IncomingInFlightPacket_OutgoingIndexChange); ok && change.OutgoingIndexChange.Equal(index) {
+			change.OutgoingIndexChange.Sequence = sequence
+		}
+		if forward, ok := incomingPacket.Forward.(*types.IncomingInFlightPacket_OutgoingIndexForward); ok && forward.OutgoingIndexForward.Equal(index) {
+			forward.OutgoingIndexForward.Sequence = sequence
+		}
 		k.SetIncomingInFlightPacket(ctx, incomingPacket)
 	}
 
-	return nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOutgoingInFlightPacketRetried{
+		Index:            outgoingPacket.Index,
+		PreviousIndex:    index,
+		TimeoutTimestamp: timeout,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
+// OnTimeoutOutgoingInFlightPacket records the failure of a change or forward
+// packet whose retries are exhausted in its incoming packet. It must be called
+// after the transfer module has refunded the tokens of the timed out packet.
 func (k Keeper) OnTimeoutOutgoingInFlightPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	outgoingPacket types.OutgoingInFlightPacket,
 ) error {
 	k.RemoveOutgoingInFlightPacket(ctx, outgoingPacket.Index.PortId, outgoingPacket.Index.ChannelId, outgoingPacket.Index.Sequence)
-	outgoingPacket.RetriesRemaining--
 
-	if outgoingPacket.RetriesRemaining > 0 {
-		// Resend packet
-		_, chanCap, err := k.IbcKeeperFn().ChannelKeeper.LookupModuleByChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
-		if err != nil {
-			return errors.Wrap(err, "could not retrieve module from port-id")
-		}
-		sequence, err := k.IbcKeeperFn().ChannelKeeper.SendPacket(
-			ctx,
-			chanCap,
-			packet.SourcePort,
-			packet.SourceChannel,
-			DefaultTransferPacketTimeoutHeight,
-			timeoutTimestamp(ctx),
-			packet.Data,
-		)
-		if err != nil {
-			return err
-		}
-
-		// Set the new sequence number
-		outgoingPacket.Index.Sequence = sequence
-		k.SetOutgoingInFlightPacket(ctx, outgoingPacket)
-	} else {
-		// If remaining retry count is zero:
-		// - Returning non error acknowledgement to the origin
-		// - However it contains error acknowledgement of change / forward packet
-		ack := channeltypes.NewErrorAcknowledgement(errors.Wrap(sdkerrors.ErrUnknownRequest, "Retry count on timeout exceeds"))
-
-		waitingPacket, found := k.GetIncomingInFlightPacket(ctx, outgoingPacket.AckWaitingIndex.PortId, outgoingPacket.AckWaitingIndex.ChannelId, outgoingPacket.AckWaitingIndex.Sequence)
-		if !found {
-			return nil
-		}
+	incomingPacket, found := k.GetIncomingInFlightPacket(ctx, outgoingPacket.AckWaitingIndex.PortId, outgoingPacket.AckWaitingIndex.ChannelId, outgoingPacket.AckWaitingIndex.Sequence)
+	if !found {
+		return nil
+	}
 
-		switch packetReturn := waitingPacket.Change.(type) {
-		case *types.IncomingInFlightPacket_OutgoingIndexChange:
-			if packetReturn.OutgoingIndexChange.Equal(outgoingPacket.Index) {
-				waitingPacket.Change = &types.IncomingInFlightPacket_AckChange{
-					AckChange: ack.Acknowledgement(),
-				}
-			}
-			break
-		case *types.IncomingInFlightPacket_AckChange:
-			break
-		}
+	// If remaining retry count is zero:
+	// - Returning non error acknowledgement to the origin
+	// - However it contains error acknowledgement of change / forward packet
+	ack := channeltypes.NewErrorAcknowledgement(errors.Wrap(sdkerrors.ErrUnknownRequest, "Retry count on timeout exceeds"))
+	ack = k.refundFailedOutgoingPacket(ctx, incomingPacket, packet, ack)
 
-		switch packetForward := waitingPacket.Forward.(type) {
-		case *types.IncomingInFlightPacket_OutgoingIndexForward:
-			if packetForward.OutgoingIndexForward.Equal(outgoingPacket.Index) {
-				waitingPacket.Forward = &types.IncomingInFlightPacket_AckForward{
-					AckForward: ack.Acknowledgement(),
-				}
-			}
-			break
-		case *types.IncomingInFlightPacket_AckForward:
-			break
-		}
+	return k.completeOutgoingInFlightPacket(ctx, incomingPacket, outgoingPacket.Index, ack.Acknowledgement())
+}
 
-		deleted, err := k.ShouldDeleteCompletedWaitingPacket(ctx, waitingPacket)
-		if err != nil {
-			return err
+// completeOutgoingInFlightPacket sets the acknowledgement of the change or
+// forward packet of the index in the incoming packet, and writes the
+// acknowledgement of the incoming packet once nothing is waited for anymore.
+func (k Keeper) completeOutgoingInFlightPacket(
+	ctx sdk.Context,
+	incomingPacket types.IncomingInFlightPacket,
+	index types.PacketIndex,
+	acknowledgement []byte,
+) error {
+	if change, ok := incomingPacket.Change.(*types.IncomingInFlightPacket_OutgoingIndexChange); ok && change.OutgoingIndexChange.Equal(index) {
+		incomingPacket.Change = &types.IncomingInFlightPacket_AckChange{
+			AckChange: acknowledgement,
 		}
-		if !deleted {
-			k.SetIncomingInFlightPacket(ctx, waitingPacket)
+	}
+	if forward, ok := incomingPacket.Forward.(*types.IncomingInFlightPacket_OutgoingIndexForward); ok && forward.OutgoingIndexForward.Equal(index) {
+		incomingPacket.Forward = &types.IncomingInFlightPacket_AckForward{
+			AckForward: acknowledgement,
 		}
 	}
 
+	deleted, err := k.ShouldDeleteCompletedWaitingPacket(ctx, incomingPacket)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		k.SetIncomingInFlightPacket(ctx, incomingPacket)
+	}
+
 	return nil
 }
 
+// refundFailedOutgoingPacket returns the tokens of a failed change or forward
+// packet, already refunded to its sender on this chain, to the sender of the
+// incoming packet along the incoming channel if its swap metadata has the
+// refund failure mode. It returns the acknowledgement to record for the
+// outgoing packet, which wraps ErrRefunded once the tokens are sent back.
+//
+// The acknowledgement of the incoming packet stays a success, as an error
+// would make the source chain refund the tokens which have been swapped.
+func (k Keeper) refundFailedOutgoingPacket(
+	ctx sdk.Context,
+	incomingPacket types.IncomingInFlightPacket,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) channeltypes.Acknowledgement {
+	var incomingData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(incomingPacket.Data, &incomingData); err != nil {
+		return ack
+	}
+	metadata := &types.PacketMetadata{}
+	if err := json.Unmarshal([]byte(incomingData.Memo), metadata); err != nil || metadata.Swap == nil || !metadata.Swap.RefundOnFailure() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data); err != nil {
+		return ack
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return ack
+	}
+
+	// The tokens are sent back in a cached context not to leave a partial
+	// transfer if it fails, in which case they are kept by the sender.
+	cacheCtx, write := ctx.CacheContext()
+	_, err := k.TransferKeeper.Transfer(cacheCtx, &transfertypes.MsgTransfer{
+		SourcePort:       incomingPacket.Index.PortId,
+		SourceChannel:    incomingPacket.Index.ChannelId,
+		Token:            sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount),
+		Sender:           data.Sender,
+		Receiver:         incomingData.Sender,
+		TimeoutHeight:    DefaultTransferPacketTimeoutHeight,
+		TimeoutTimestamp: k.packetTimeoutTimestamp(ctx, 0),
+	})
+	if err != nil {
+		k.Logger().Error("failed to refund the tokens of a failed outgoing packet", "error", err)
+		return ack
+	}
+	write()
+
+	return channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrRefunded, "%s", ack.GetError()))
+}
+
 func (k Keeper) ShouldDeleteCompletedWaitingPacket(
 	ctx sdk.Context,
 	packet types.IncomingInFlightPacket,
@@ -381,8 +477,10 @@ func (k Keeper) ShouldDeleteCompletedWaitingPacket(
 	fullAck := types.SwapAcknowledgement{
 		Result:      packet.Result,
 		IncomingAck: packet.Ack,
-		ChangeAck:   packet.Change.(*types.IncomingInFlightPacket_AckChange).AckChange,
-		ForwardAck:  packet.Forward.(*types.IncomingInFlightPacket_AckForward).AckForward,
+		// An empty ack of the change or the forward is not kept in the store,
+		// so the getters are used to read a nil ack as well.
+		ChangeAck:  packet.GetAckChange(),
+		ForwardAck: packet.GetAckForward(),
 	}
 	bz, err := fullAck.Acknowledgement()
 	if err != nil {
@@ -415,3 +513,62 @@ func (k Keeper) ShouldDeleteCompletedWaitingPacket(
 
 	return true, nil
 }
+
+// ExpireIncomingInFlightPackets acknowledges the incoming packets which have
+// been waiting for their change or forward for more than MaxInFlightBlocks,
+// e.g. because its channel has been closed and it will never be relayed.
+func (k Keeper) ExpireIncomingInFlightPackets(ctx sdk.Context) error {
+	maxInFlightBlocks := k.GetParams(ctx).MaxInFlightBlocks
+	if maxInFlightBlocks == 0 {
+		return nil
+	}
+
+	for _, packet := range k.GetIncomingInFlightPacketsCreatedBefore(ctx, ctx.BlockHeight()-int64(maxInFlightBlocks)) {
+		if err := k.expireIncomingInFlightPacket(ctx, packet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireIncomingInFlightPacket records an error acknowledgement for the change
+// and forward of the incoming packet still in flight, and writes the
+// acknowledgement of the incoming packet. As with a timed out change or
+// forward, the acknowledgement of the incoming packet stays a success since
+// the tokens have been swapped.
+//
+// The outgoing packets are forgotten, so that the transfer module alone
+// handles their acknowledgement or timeout if they are ever relayed, refunding
+// the tokens to the receiver on failure.
+func (k Keeper) expireIncomingInFlightPacket(ctx sdk.Context, packet types.IncomingInFlightPacket) error {
+	ack := channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrExpired, "pending since height %d", packet.CreatedHeight))
+
+	if change, ok := packet.Change.(*types.IncomingInFlightPacket_OutgoingIndexChange); ok {
+		k.RemoveOutgoingInFlightPacket(ctx, change.OutgoingIndexChange.PortId, change.OutgoingIndexChange.ChannelId, change.OutgoingIndexChange.Sequence)
+		packet.Change = &types.IncomingInFlightPacket_AckChange{
+			AckChange: ack.Acknowledgement(),
+		}
+	}
+	if forward, ok := packet.Forward.(*types.IncomingInFlightPacket_OutgoingIndexForward); ok {
+		k.RemoveOutgoingInFlightPacket(ctx, forward.OutgoingIndexForward.PortId, forward.OutgoingIndexForward.ChannelId, forward.OutgoingIndexForward.Sequence)
+		packet.Forward = &types.IncomingInFlightPacket_AckForward{
+			AckForward: ack.Acknowledgement(),
+		}
+	}
+
+	// The acknowledgement cannot be written if the incoming channel has been
+	// closed, in which case the packet is only removed.
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.ShouldDeleteCompletedWaitingPacket(cacheCtx, packet); err != nil {
+		k.Logger().Error("failed to acknowledge an expired incoming packet", "error", err)
+		k.RemoveIncomingInFlightPacket(ctx, packet.Index.PortId, packet.Index.ChannelId, packet.Index.Sequence)
+	} else {
+		write()
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIncomingInFlightPacketExpired{
+		Index:         packet.Index,
+		CreatedHeight: packet.CreatedHeight,
+	})
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestTransferAndCreateOutgoingInFlightPacket(t *testing.T) {
+	tests := []struct {
+		desc     string
+		metadata string
+		memo     string
+	}{
+		{
+			desc:     "no next",
+			metadata: `{"receiver":"receiver","port":"transfer","channel":"channel-1"}`,
+			memo:     "",
+		},
+		{
+			desc:     "next object in its key order",
+			metadata: `{"receiver":"receiver","port":"transfer","channel":"channel-1","next":{"swap":{"route":{}},"forward":{"receiver":"final","port":"transfer","channel":"channel-2"}}}`,
+			memo:     `{"swap":{"route":{}},"forward":{"receiver":"final","port":"transfer","channel":"channel-2"}}`,
+		},
+		{
+			desc:     "next escaped as a string",
+			metadata: `{"receiver":"receiver","port":"transfer","channel":"channel-1","next":"{\"forward\":{\"receiver\":\"final\",\"port\":\"transfer\",\"channel\":\"channel-2\"}}"}`,
+			memo:     `{"forward":{"receiver":"final","port":"transfer","channel":"channel-2"}}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+			ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+			var metadata packetforwardtypes.ForwardMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.metadata), &metadata))
+
+			mocks.TransferKeeper.EXPECT().Transfer(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+					require.Equal(t, "transfer", msg.SourcePort)
+					require.Equal(t, "channel-1", msg.SourceChannel)
+					require.Equal(t, "receiver", msg.Receiver)
+					require.Equal(t, tc.memo, msg.Memo)
+					require.Equal(t, uint64(ctx.BlockTime().Add(600*time.Second).UnixNano()), msg.TimeoutTimestamp)
+					return &transfertypes.MsgTransferResponse{Sequence: 5}, nil
+				},
+			)
+
+			incomingIndex := types.NewPacketIndex("transfer", "channel-0", 1)
+			packet, err := k.TransferAndCreateOutgoingInFlightPacket(ctx, incomingIndex, "sender", sdk.NewInt64Coin("stake", 100), metadata)
+			require.NoError(t, err)
+			require.Equal(t, types.NewPacketIndex("transfer", "channel-1", 5), packet.Index)
+			require.Equal(t, incomingIndex, packet.AckWaitingIndex)
+			require.Equal(t, int32(types.DefaultRetryCount), packet.RetriesRemaining)
+
+			stored, found := k.GetOutgoingInFlightPacket(ctx, "transfer", "channel-1", 5)
+			require.True(t, found)
+			require.Equal(t, packet, stored)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -10,13 +11,22 @@ import (
 )
 
 // SetIncomingInFlightPacket set a specific incomingPacket in the store from its index
+// and indexes it by its created height
 func (k Keeper) SetIncomingInFlightPacket(ctx context.Context, incomingPacket types.IncomingInFlightPacket) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketKeyPrefix))
+	key := types.IncomingInFlightPacketKey(incomingPacket.Index)
+
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketByHeightKeyPrefix))
+	if b := store.Get(key); b != nil {
+		var old types.IncomingInFlightPacket
+		k.cdc.MustUnmarshal(b, &old)
+		heightStore.Delete(types.IncomingInFlightPacketByHeightKey(old.CreatedHeight, old.Index))
+	}
+	heightStore.Set(types.IncomingInFlightPacketByHeightKey(incomingPacket.CreatedHeight, incomingPacket.Index), []byte{})
+
 	b := k.cdc.MustMarshal(&incomingPacket)
-	store.Set(types.IncomingInFlightPacketKey(
-		incomingPacket.Index,
-	), b)
+	store.Set(key, b)
 }
 
 // GetIncomingInFlightPacket returns a incomingPacket from its index
@@ -53,13 +63,24 @@ func (k Keeper) RemoveIncomingInFlightPacket(
 ) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketKeyPrefix))
-	store.Delete(types.IncomingInFlightPacketKey(
+	key := types.IncomingInFlightPacketKey(
 		types.NewPacketIndex(
 			srcPortId,
 			srcChannelId,
 			sequence,
 		),
-	))
+	)
+
+	b := store.Get(key)
+	if b == nil {
+		return
+	}
+	var incomingPacket types.IncomingInFlightPacket
+	k.cdc.MustUnmarshal(b, &incomingPacket)
+
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketByHeightKeyPrefix))
+	heightStore.Delete(types.IncomingInFlightPacketByHeightKey(incomingPacket.CreatedHeight, incomingPacket.Index))
+	store.Delete(key)
 }
 
 // GetIncomingInFlightPackets returns all incomingPacket
@@ -78,3 +99,33 @@ func (k Keeper) GetIncomingInFlightPackets(ctx context.Context) (list []types.In
 
 	return
 }
+
+// GetIncomingInFlightPacketsCreatedBefore returns the incomingPackets created
+// before the height, iterating only over them through the created height index
+func (k Keeper) GetIncomingInFlightPacketsCreatedBefore(ctx context.Context, height int64) (list []types.IncomingInFlightPacket) {
+	if height <= 0 {
+		return nil
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketKeyPrefix))
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IncomingInFlightPacketByHeightKeyPrefix))
+	iterator := heightStore.Iterator(nil, binary.BigEndian.AppendUint64(nil, uint64(height)))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var index types.PacketIndex
+		k.cdc.MustUnmarshal(iterator.Key()[8:], &index)
+
+		b := store.Get(types.IncomingInFlightPacketKey(index))
+		if b == nil {
+			continue
+		}
+		var val types.IncomingInFlightPacket
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	}
+
+	return
+}
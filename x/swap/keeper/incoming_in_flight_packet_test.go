@@ -35,6 +35,7 @@ func createNIncomingInFlightPacket(keeper keeper.Keeper, ctx context.Context, n
 			InterfaceFee:     math.NewInt(1),
 			Change:           nil,
 			Forward:          nil,
+			CreatedHeight:    int64(i + 1),
 		}
 		keeper.SetIncomingInFlightPacket(ctx, items[i])
 	}
@@ -79,3 +80,22 @@ func TestIncomingInFlightPacketGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetIncomingInFlightPackets(ctx)),
 	)
 }
+
+func TestIncomingInFlightPacketsCreatedBefore(t *testing.T) {
+	keeper, ctx := keepertest.SwapKeeper(t)
+	items := createNIncomingInFlightPacket(keeper, ctx, 10)
+	require.Empty(t, keeper.GetIncomingInFlightPacketsCreatedBefore(ctx, 1))
+	require.Equal(t,
+		nullify.Fill(items[:3]),
+		nullify.Fill(keeper.GetIncomingInFlightPacketsCreatedBefore(ctx, 4)),
+	)
+
+	// the index follows the removal and the update of the created height
+	keeper.RemoveIncomingInFlightPacket(ctx, items[0].Index.PortId, items[0].Index.ChannelId, items[0].Index.Sequence)
+	items[1].CreatedHeight = 20
+	keeper.SetIncomingInFlightPacket(ctx, items[1])
+	require.Equal(t,
+		nullify.Fill(items[2:3]),
+		nullify.Fill(keeper.GetIncomingInFlightPacketsCreatedBefore(ctx, 4)),
+	)
+}
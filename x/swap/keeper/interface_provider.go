@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// SetInterfaceProvider sets a registered interface provider
+func (k Keeper) SetInterfaceProvider(ctx context.Context, provider types.InterfaceProvider) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := k.cdc.MustMarshal(&provider)
+	store.Set(types.InterfaceProviderKey(provider.Address), bz)
+}
+
+// GetInterfaceProvider returns a registered interface provider from its address
+func (k Keeper) GetInterfaceProvider(ctx context.Context, address string) (val types.InterfaceProvider, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.InterfaceProviderKey(address))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetAllInterfaceProviders returns all the registered interface providers
+func (k Keeper) GetAllInterfaceProviders(ctx context.Context) (list []types.InterfaceProvider) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerStore := prefix.NewStore(store, types.KeyPrefix(types.InterfaceProviderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(providerStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InterfaceProvider
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetInterfaceProviderStats sets the stats of an interface provider
+func (k Keeper) SetInterfaceProviderStats(ctx context.Context, stats types.InterfaceProviderStats) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.InterfaceProviderStatsKey(stats.Address), bz)
+}
+
+// GetInterfaceProviderStats returns the stats of an interface provider, empty
+// if it has not routed any swap
+func (k Keeper) GetInterfaceProviderStats(ctx context.Context, address string) types.InterfaceProviderStats {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.InterfaceProviderStatsKey(address))
+	if bz == nil {
+		return types.InterfaceProviderStats{
+			Address: address,
+			Volume:  sdk.NewCoins(),
+			Fees:    sdk.NewCoins(),
+		}
+	}
+
+	var val types.InterfaceProviderStats
+	k.cdc.MustUnmarshal(bz, &val)
+	return val
+}
+
+// GetAllInterfaceProviderStats returns the stats of all the interface providers
+func (k Keeper) GetAllInterfaceProviderStats(ctx context.Context) (list []types.InterfaceProviderStats) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statsStore := prefix.NewStore(store, types.KeyPrefix(types.InterfaceProviderStatsKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(statsStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InterfaceProviderStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetInterfaceFeeRate returns the interface fee rate of the swaps routed by an
+// interface provider. A registered provider pays the rate approved for it,
+// capped by the max interface fee rate param, and any other provider the
+// interface fee rate param. No fee applies without a provider.
+func (k Keeper) GetInterfaceFeeRate(ctx context.Context, interfaceProvider string) math.LegacyDec {
+	if interfaceProvider == "" {
+		return math.LegacyZeroDec()
+	}
+
+	params := k.GetParams(ctx)
+	provider, found := k.GetInterfaceProvider(ctx, interfaceProvider)
+	if !found || provider.FeeRate == nil {
+		return params.InterfaceFeeRate
+	}
+
+	return math.LegacyMinDec(*provider.FeeRate, params.MaxInterfaceFeeRate)
+}
+
+// payInterfaceFee sends the interface fee of a swap from its sender to the
+// interface provider. If the provider is registered, the fee is split among its
+// fee shares and the swap is recorded in its stats.
+func (k Keeper) payInterfaceFee(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	interfaceProvider string,
+	tokenIn sdk.Coin,
+	fee sdk.Coin,
+) error {
+	// Validated in ValidateBasic
+	addr, err := sdk.AccAddressFromBech32(interfaceProvider)
+	if err != nil {
+		return err
+	}
+
+	provider, registered := k.GetInterfaceProvider(ctx, interfaceProvider)
+	if fee.IsPositive() {
+		rest := fee.Amount
+		for _, share := range provider.FeeShares {
+			amount := share.Weight.MulInt(fee.Amount).TruncateInt()
+			if !amount.IsPositive() {
+				continue
+			}
+			if err := k.BankKeeper.SendCoins(ctx, sender, sdk.MustAccAddressFromBech32(share.Address), sdk.NewCoins(sdk.NewCoin(fee.Denom, amount))); err != nil {
+				return err
+			}
+			rest = rest.Sub(amount)
+		}
+
+		if rest.IsPositive() {
+			if err := k.BankKeeper.SendCoins(ctx, sender, addr, sdk.NewCoins(sdk.NewCoin(fee.Denom, rest))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !registered {
+		return nil
+	}
+	stats := k.GetInterfaceProviderStats(ctx, interfaceProvider)
+	stats.Volume = stats.Volume.Add(tokenIn)
+	stats.Fees = stats.Fees.Add(fee)
+	stats.SwapCount++
+	k.SetInterfaceProviderStats(ctx, stats)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	"github.com/sunriselayer/sunrise/testutil/nullify"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/keeper"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func createNInterfaceProvider(keeper keeper.Keeper, ctx context.Context, n int) []types.InterfaceProvider {
+	items := make([]types.InterfaceProvider, n)
+	for i := range items {
+		items[i] = types.InterfaceProvider{
+			Address:  sample.AccAddress(),
+			Metadata: "interface",
+		}
+		keeper.SetInterfaceProvider(ctx, items[i])
+	}
+	return items
+}
+
+func TestInterfaceProviderGet(t *testing.T) {
+	keeper, ctx := keepertest.SwapKeeper(t)
+	items := createNInterfaceProvider(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetInterfaceProvider(ctx, item.Address)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&rst))
+	}
+	_, found := keeper.GetInterfaceProvider(ctx, sample.AccAddress())
+	require.False(t, found)
+}
+
+func TestInterfaceProviderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.SwapKeeper(t)
+	items := createNInterfaceProvider(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllInterfaceProviders(ctx)),
+	)
+}
+
+func TestGetInterfaceFeeRate(t *testing.T) {
+	k, ctx := keepertest.SwapKeeper(t)
+
+	params := types.DefaultParams()
+	params.MaxInterfaceFeeRate = math.LegacyNewDecWithPrec(3, 2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	rate := math.LegacyNewDecWithPrec(2, 2)
+	aboveMax := math.LegacyNewDecWithPrec(4, 2)
+	withRate := types.InterfaceProvider{Address: sample.AccAddress(), FeeRate: &rate}
+	withRateAboveMax := types.InterfaceProvider{Address: sample.AccAddress(), FeeRate: &aboveMax}
+	withoutRate := types.InterfaceProvider{Address: sample.AccAddress()}
+	for _, p := range []types.InterfaceProvider{withRate, withRateAboveMax, withoutRate} {
+		k.SetInterfaceProvider(ctx, p)
+	}
+
+	require.Equal(t, math.LegacyZeroDec(), k.GetInterfaceFeeRate(ctx, ""))
+	require.Equal(t, params.InterfaceFeeRate, k.GetInterfaceFeeRate(ctx, sample.AccAddress()))
+	require.Equal(t, params.InterfaceFeeRate, k.GetInterfaceFeeRate(ctx, withoutRate.Address))
+	require.Equal(t, rate, k.GetInterfaceFeeRate(ctx, withRate.Address))
+	require.Equal(t, params.MaxInterfaceFeeRate, k.GetInterfaceFeeRate(ctx, withRateAboveMax.Address))
+}
+
+func TestSwapExactAmountInInterfaceFeeShares(t *testing.T) {
+	k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+
+	sender := sdk.AccAddress("sender")
+	provider := sdk.AccAddress("provider")
+	shareA := sdk.AccAddress("share_a")
+	shareB := sdk.AccAddress("share_b")
+
+	rate := math.LegacyNewDecWithPrec(2, 2)
+	k.SetInterfaceProvider(ctx, types.InterfaceProvider{
+		Address: provider.String(),
+		FeeRate: &rate,
+		FeeShares: []types.FeeShare{
+			{Address: shareA.String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+			{Address: shareB.String(), Weight: math.LegacyNewDecWithPrec(3, 1)},
+		},
+	})
+
+	pool := lptypes.Pool{Id: 0, DenomBase: "base", DenomQuote: "quote"}
+	mocks.LiquidityPoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(0)).Return(pool, true).AnyTimes()
+	mocks.LiquidityPoolKeeper.EXPECT().SwapExactAmountInWithPriceLimit(gomock.Any(), sender, pool, sdk.NewInt64Coin("base", 10000), "quote", true, math.LegacyZeroDec()).
+		Return(math.NewInt(10000), math.NewInt(10000), nil).Times(2)
+
+	// 2% of 10000 is 200, split 100 / 60 / 40
+	mocks.BankKeeper.EXPECT().SendCoins(gomock.Any(), sender, shareA, sdk.NewCoins(sdk.NewInt64Coin("quote", 100))).Return(nil).Times(2)
+	mocks.BankKeeper.EXPECT().SendCoins(gomock.Any(), sender, shareB, sdk.NewCoins(sdk.NewInt64Coin("quote", 60))).Return(nil).Times(2)
+	mocks.BankKeeper.EXPECT().SendCoins(gomock.Any(), sender, provider, sdk.NewCoins(sdk.NewInt64Coin("quote", 40))).Return(nil).Times(2)
+
+	route := types.Route{
+		DenomIn:  "base",
+		DenomOut: "quote",
+		Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}},
+	}
+	for i := 0; i < 2; i++ {
+		_, interfaceFee, err := k.SwapExactAmountIn(ctx, sender, provider.String(), route, math.NewInt(10000), math.NewInt(9800))
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(200), interfaceFee)
+	}
+
+	stats := k.GetInterfaceProviderStats(ctx, provider.String())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("base", 20000)), stats.Volume)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("quote", 400)), stats.Fees)
+	require.Equal(t, uint64(2), stats.SwapCount)
+}
+
+func TestSwapExactAmountInUnregisteredInterfaceProvider(t *testing.T) {
+	k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+
+	sender := sdk.AccAddress("sender")
+	provider := sdk.AccAddress("provider")
+
+	pool := lptypes.Pool{Id: 0, DenomBase: "base", DenomQuote: "quote"}
+	mocks.LiquidityPoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(0)).Return(pool, true).AnyTimes()
+	mocks.LiquidityPoolKeeper.EXPECT().SwapExactAmountInWithPriceLimit(gomock.Any(), sender, pool, sdk.NewInt64Coin("base", 10000), "quote", true, math.LegacyZeroDec()).
+		Return(math.NewInt(10000), math.NewInt(10000), nil).Times(1)
+
+	fee := types.DefaultParams().InterfaceFeeRate.MulInt64(10000).TruncateInt()
+	mocks.BankKeeper.EXPECT().SendCoins(gomock.Any(), sender, provider, sdk.NewCoins(sdk.NewCoin("quote", fee))).Return(nil).Times(1)
+
+	route := types.Route{
+		DenomIn:  "base",
+		DenomOut: "quote",
+		Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}},
+	}
+	_, interfaceFee, err := k.SwapExactAmountIn(ctx, sender, provider.String(), route, math.NewInt(10000), math.NewInt(0))
+	require.NoError(t, err)
+	require.Equal(t, fee, interfaceFee)
+
+	// The fee is paid but no stats are recorded for an unregistered provider
+	require.Empty(t, k.GetAllInterfaceProviderStats(ctx))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func (k msgServer) RegisterInterfaceProvider(goCtx context.Context, msg *types.MsgRegisterInterfaceProvider) (*types.MsgRegisterInterfaceProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetInterfaceProvider(ctx, msg.Provider); found {
+		return nil, errorsmod.Wrap(types.ErrInterfaceProviderAlreadyRegistered, msg.Provider)
+	}
+
+	k.SetInterfaceProvider(ctx, types.InterfaceProvider{
+		Address:   msg.Provider,
+		Metadata:  msg.Metadata,
+		FeeShares: msg.FeeShares,
+	})
+
+	return &types.MsgRegisterInterfaceProviderResponse{}, nil
+}
+
+func (k msgServer) UpdateInterfaceProvider(goCtx context.Context, msg *types.MsgUpdateInterfaceProvider) (*types.MsgUpdateInterfaceProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, found := k.GetInterfaceProvider(ctx, msg.Provider)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrInterfaceProviderNotFound, msg.Provider)
+	}
+
+	// The fee rate is only changed by the authority
+	provider.Metadata = msg.Metadata
+	provider.FeeShares = msg.FeeShares
+	k.SetInterfaceProvider(ctx, provider)
+
+	return &types.MsgUpdateInterfaceProviderResponse{}, nil
+}
+
+func (k msgServer) SetInterfaceProviderFeeRate(goCtx context.Context, msg *types.MsgSetInterfaceProviderFeeRate) (*types.MsgSetInterfaceProviderFeeRateResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, found := k.GetInterfaceProvider(ctx, msg.Provider)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrInterfaceProviderNotFound, msg.Provider)
+	}
+
+	if msg.FeeRate != nil {
+		maxRate := k.GetParams(ctx).MaxInterfaceFeeRate
+		if msg.FeeRate.GT(maxRate) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFeeRate, "fee rate %s exceeds the max interface fee rate %s", msg.FeeRate, maxRate)
+		}
+	}
+
+	provider.FeeRate = msg.FeeRate
+	k.SetInterfaceProvider(ctx, provider)
+
+	return &types.MsgSetInterfaceProviderFeeRateResponse{}, nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/testutil/sample"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestMsgRegisterInterfaceProvider(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	provider := sample.AccAddress()
+	feeShares := []types.FeeShare{{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(5, 1)}}
+
+	_, err := ms.RegisterInterfaceProvider(ctx, types.NewMsgRegisterInterfaceProvider(provider, "interface", feeShares))
+	require.NoError(t, err)
+
+	val, found := k.GetInterfaceProvider(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, "interface", val.Metadata)
+	require.Equal(t, feeShares, val.FeeShares)
+	require.Nil(t, val.FeeRate)
+
+	_, err = ms.RegisterInterfaceProvider(ctx, types.NewMsgRegisterInterfaceProvider(provider, "interface", nil))
+	require.ErrorIs(t, err, types.ErrInterfaceProviderAlreadyRegistered)
+}
+
+func TestMsgUpdateInterfaceProvider(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	provider := sample.AccAddress()
+
+	_, err := ms.UpdateInterfaceProvider(ctx, types.NewMsgUpdateInterfaceProvider(provider, "updated", nil))
+	require.ErrorIs(t, err, types.ErrInterfaceProviderNotFound)
+
+	rate := math.LegacyNewDecWithPrec(2, 2)
+	k.SetInterfaceProvider(ctx, types.InterfaceProvider{Address: provider, Metadata: "interface", FeeRate: &rate})
+
+	_, err = ms.UpdateInterfaceProvider(ctx, types.NewMsgUpdateInterfaceProvider(provider, "updated", nil))
+	require.NoError(t, err)
+
+	val, found := k.GetInterfaceProvider(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, "updated", val.Metadata)
+	require.Equal(t, &rate, val.FeeRate)
+}
+
+func TestMsgSetInterfaceProviderFeeRate(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	provider := sample.AccAddress()
+	k.SetInterfaceProvider(ctx, types.InterfaceProvider{Address: provider})
+
+	rate := math.LegacyNewDecWithPrec(2, 2)
+	aboveMax := types.DefaultMaxInterfaceFeeRate.Add(math.LegacyNewDecWithPrec(1, 2))
+	testCases := []struct {
+		name   string
+		input  *types.MsgSetInterfaceProviderFeeRate
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgSetInterfaceProviderFeeRate(sample.AccAddress(), provider, &rate),
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "unregistered provider",
+			input:  types.NewMsgSetInterfaceProviderFeeRate(k.GetAuthority(), sample.AccAddress(), &rate),
+			expErr: types.ErrInterfaceProviderNotFound,
+		},
+		{
+			name:   "above max interface fee rate",
+			input:  types.NewMsgSetInterfaceProviderFeeRate(k.GetAuthority(), provider, &aboveMax),
+			expErr: types.ErrInvalidFeeRate,
+		},
+		{
+			name:  "set fee rate",
+			input: types.NewMsgSetInterfaceProviderFeeRate(k.GetAuthority(), provider, &rate),
+		},
+		{
+			name:  "unset fee rate",
+			input: types.NewMsgSetInterfaceProviderFeeRate(k.GetAuthority(), provider, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.SetInterfaceProviderFeeRate(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			val, found := k.GetInterfaceProvider(ctx, provider)
+			require.True(t, found)
+			require.Equal(t, tc.input.FeeRate, val.FeeRate)
+		})
+	}
+}
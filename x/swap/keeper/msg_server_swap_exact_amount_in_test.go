@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/keeper"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestMsgSwapExactAmountInPriceLimit(t *testing.T) {
+	k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress("sender")
+	priceLimit := math.LegacyMustNewDecFromStr("0.9995")
+
+	pool := lptypes.Pool{Id: 0, DenomBase: "base", DenomQuote: "quote"}
+	mocks.LiquidityPoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(0)).Return(pool, true).AnyTimes()
+	// Only 4000 of 10000 swapped before reaching the price limit
+	mocks.LiquidityPoolKeeper.EXPECT().SwapExactAmountInWithPriceLimit(gomock.Any(), sender, pool, sdk.NewInt64Coin("base", 10000), "quote", true, priceLimit).
+		Return(math.NewInt(4000), math.NewInt(3990), nil)
+
+	res, err := ms.SwapExactAmountIn(ctx, &types.MsgSwapExactAmountIn{
+		Sender: sender.String(),
+		Route: types.Route{
+			DenomIn:  "base",
+			DenomOut: "quote",
+			Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}},
+		},
+		AmountIn:     math.NewInt(10000),
+		MinAmountOut: math.NewInt(3000),
+		PriceLimit:   &priceLimit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("base", 4000), res.Result.TokenIn)
+	require.Equal(t, sdk.NewInt64Coin("quote", 3990), res.Result.TokenOut)
+	require.Equal(t, math.NewInt(3990), res.AmountOut)
+}
+
+func TestSwapExactAmountInPriceLimitSinglePool(t *testing.T) {
+	k, _, ctx := keepertest.SwapKeeperWithMocks(t)
+	priceLimit := math.LegacyMustNewDecFromStr("0.9995")
+
+	// A price limit does not apply to a route of several pools
+	_, _, err := k.SwapExactAmountInWithPriceLimit(ctx, sdk.AccAddress("sender"), "", types.Route{
+		DenomIn:  "base",
+		DenomOut: "other",
+		Strategy: &types.Route_Series{Series: &types.RouteSeries{Routes: []types.Route{
+			{DenomIn: "base", DenomOut: "quote", Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}}},
+			{DenomIn: "quote", DenomOut: "other", Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 1}}},
+		}}},
+	}, math.NewInt(10000), math.NewInt(3000), priceLimit)
+	require.ErrorIs(t, err, types.ErrInvalidPriceLimit)
+}
+
+func TestMsgSwapExactAmountInDeadline(t *testing.T) {
+	k, _, ctx := keepertest.SwapKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	deadline := ctx.BlockTime().Add(-time.Second)
+
+	_, err := ms.SwapExactAmountIn(ctx, &types.MsgSwapExactAmountIn{
+		Sender: sdk.AccAddress("sender").String(),
+		Route: types.Route{
+			DenomIn:  "base",
+			DenomOut: "quote",
+			Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}},
+		},
+		AmountIn:     math.NewInt(10000),
+		MinAmountOut: math.NewInt(3000),
+		Deadline:     &deadline,
+	})
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sunriselayer/sunrise/x/swap/types"
 )
@@ -15,7 +17,16 @@ func (k msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapE
 		return nil, err
 	}
 
-	result, interfaceProviderFee, err := k.Keeper.SwapExactAmountOut(ctx, sender, msg.InterfaceProvider, msg.Route, msg.MaxAmountIn, msg.AmountOut)
+	if msg.Deadline != nil && ctx.BlockTime().After(*msg.Deadline) {
+		return nil, errorsmod.Wrapf(types.ErrDeadlineExceeded, "block time %s is after the deadline %s", ctx.BlockTime(), msg.Deadline)
+	}
+
+	priceLimit := math.LegacyZeroDec()
+	if msg.PriceLimit != nil {
+		priceLimit = *msg.PriceLimit
+	}
+
+	result, interfaceProviderFee, err := k.Keeper.SwapExactAmountOutWithPriceLimit(ctx, sender, msg.InterfaceProvider, msg.Route, msg.MaxAmountIn, msg.AmountOut, priceLimit)
 	if err != nil {
 		return nil, err
 	}
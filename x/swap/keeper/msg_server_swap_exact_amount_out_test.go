@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/keeper"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestMsgSwapExactAmountOutPriceLimit(t *testing.T) {
+	k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress("sender")
+	priceLimit := math.LegacyMustNewDecFromStr("1.0005")
+
+	pool := lptypes.Pool{Id: 0, DenomBase: "base", DenomQuote: "quote"}
+	mocks.LiquidityPoolKeeper.EXPECT().GetPool(gomock.Any(), uint64(0)).Return(pool, true).AnyTimes()
+	mocks.LiquidityPoolKeeper.EXPECT().CalculateResultExactAmountOut(gomock.Any(), pool, sdk.NewInt64Coin("base", 10000), "quote", true).
+		Return(math.NewInt(10100), nil)
+	mocks.LiquidityPoolKeeper.EXPECT().SwapExactAmountOutWithPriceLimit(gomock.Any(), sender, pool, sdk.NewInt64Coin("base", 10000), "quote", true, priceLimit).
+		Return(math.Int{}, lptypes.ErrPriceLimitReached)
+
+	msg := &types.MsgSwapExactAmountOut{
+		Sender: sender.String(),
+		Route: types.Route{
+			DenomIn:  "quote",
+			DenomOut: "base",
+			Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 0}},
+		},
+		MaxAmountIn: math.NewInt(20000),
+		AmountOut:   math.NewInt(10000),
+		PriceLimit:  &priceLimit,
+	}
+	_, err := ms.SwapExactAmountOut(ctx, msg)
+	require.ErrorIs(t, err, lptypes.ErrPriceLimitReached)
+
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	deadline := ctx.BlockTime().Add(-time.Second)
+	msg.Deadline = &deadline
+	_, err = ms.SwapExactAmountOut(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+}
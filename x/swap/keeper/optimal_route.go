@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+var errRouteSearchOutOfGas = errors.New("route search out of gas")
+
+type routePath struct {
+	denoms  []string
+	poolIds []uint64
+}
+
+type routeCandidate struct {
+	path      routePath
+	route     types.Route
+	amountOut math.Int
+}
+
+// FindOptimalRouteExactAmountIn searches the pool graph for the route giving the largest output for amountIn.
+// Paths of up to maxHops pools are simulated, and the amount is split among up to maxSplits pool-disjoint paths.
+// The search stops at RouteSearchGasLimit and returns the best route found so far.
+func (k Keeper) FindOptimalRouteExactAmountIn(
+	ctx sdk.Context,
+	denomIn string,
+	denomOut string,
+	amountIn math.Int,
+	maxHops uint32,
+	maxSplits uint32,
+) (route types.Route, result types.RouteResult, err error) {
+	if denomIn == denomOut {
+		return route, result, errorsmod.Wrapf(types.ErrInvalidRoute, "same denom in and out: %s", denomIn)
+	}
+	if amountIn.IsNil() || !amountIn.IsPositive() {
+		return route, result, types.ErrInvalidAmount
+	}
+	if maxHops == 0 {
+		maxHops = types.DefaultRouteMaxHops
+	}
+	if maxHops > types.MaxRouteHops {
+		maxHops = types.MaxRouteHops
+	}
+	if maxSplits == 0 {
+		maxSplits = 1
+	}
+	if maxSplits > types.MaxRouteSplits {
+		maxSplits = types.MaxRouteSplits
+	}
+
+	paths := findRoutePaths(k.liquidityPoolKeeper.GetAllPools(ctx), denomIn, denomOut, int(maxHops))
+
+	// The simulations are metered apart from the query itself
+	searchCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.RouteSearchGasLimit))
+
+	candidates := []routeCandidate{}
+	for _, path := range paths {
+		candidate := routeCandidate{
+			path:  path,
+			route: types.NewPathRoute(path.denoms, path.poolIds),
+		}
+		candidate.amountOut, err = k.simulateRouteExactAmountIn(searchCtx, candidate.route, amountIn)
+		if errors.Is(err, errRouteSearchOutOfGas) {
+			break
+		}
+		if err != nil || !candidate.amountOut.IsPositive() {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) == 0 {
+		return route, result, errorsmod.Wrapf(types.ErrNoRouteFound, "%s to %s", denomIn, denomOut)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].amountOut.GT(candidates[j].amountOut)
+	})
+	best := candidates[0]
+
+	if maxSplits > 1 {
+		if split, found := k.splitRouteExactAmountIn(searchCtx, denomIn, denomOut, candidates, amountIn, int(maxSplits)); found && split.amountOut.GT(best.amountOut) {
+			best = split
+		}
+	}
+
+	result, err = k.calculateResultRouteExactAmountIn(ctx, best.route, amountIn)
+	if err != nil {
+		return route, result, err
+	}
+
+	return best.route, result, nil
+}
+
+// splitRouteExactAmountIn divides amountIn into RouteSplitParts parts and gives each part
+// to the pool-disjoint path with the largest marginal output.
+func (k Keeper) splitRouteExactAmountIn(
+	ctx sdk.Context,
+	denomIn string,
+	denomOut string,
+	candidates []routeCandidate,
+	amountIn math.Int,
+	maxSplits int,
+) (routeCandidate, bool) {
+	selected := []routeCandidate{}
+	usedPools := make(map[uint64]bool)
+	for _, candidate := range candidates {
+		if len(selected) == maxSplits {
+			break
+		}
+		disjoint := true
+		for _, poolId := range candidate.path.poolIds {
+			if usedPools[poolId] {
+				disjoint = false
+				break
+			}
+		}
+		if !disjoint {
+			continue
+		}
+		for _, poolId := range candidate.path.poolIds {
+			usedPools[poolId] = true
+		}
+		selected = append(selected, candidate)
+	}
+	if len(selected) < 2 {
+		return routeCandidate{}, false
+	}
+
+	parts := make([]int64, len(selected))
+	amountsOut := make([]math.Int, len(selected))
+	for i := range amountsOut {
+		amountsOut[i] = math.ZeroInt()
+	}
+
+	for n := 0; n < types.RouteSplitParts; n++ {
+		bestIndex := -1
+		var bestMarginal, bestAmountOut math.Int
+		for i, candidate := range selected {
+			amount := amountIn.MulRaw(parts[i] + 1).QuoRaw(types.RouteSplitParts)
+			amountOut, err := k.simulateRouteExactAmountIn(ctx, candidate.route, amount)
+			if errors.Is(err, errRouteSearchOutOfGas) {
+				return routeCandidate{}, false
+			}
+			if err != nil {
+				continue
+			}
+			marginal := amountOut.Sub(amountsOut[i])
+			if bestIndex < 0 || marginal.GT(bestMarginal) {
+				bestIndex, bestMarginal, bestAmountOut = i, marginal, amountOut
+			}
+		}
+		if bestIndex < 0 {
+			return routeCandidate{}, false
+		}
+		parts[bestIndex]++
+		amountsOut[bestIndex] = bestAmountOut
+	}
+
+	routes := []types.Route{}
+	weights := []math.LegacyDec{}
+	for i, candidate := range selected {
+		if parts[i] == 0 {
+			continue
+		}
+		routes = append(routes, candidate.route)
+		weights = append(weights, math.LegacyNewDec(parts[i]))
+	}
+	if len(routes) < 2 {
+		return routeCandidate{}, false
+	}
+
+	split := routeCandidate{route: types.NewParallelRoute(denomIn, denomOut, routes, weights)}
+	amountOut, err := k.simulateRouteExactAmountIn(ctx, split.route, amountIn)
+	if err != nil {
+		return routeCandidate{}, false
+	}
+	split.amountOut = amountOut
+
+	return split, true
+}
+
+// simulateRouteExactAmountIn returns the output of the route without committing any state change.
+// It returns errRouteSearchOutOfGas once the gas of the search is exhausted.
+func (k Keeper) simulateRouteExactAmountIn(ctx sdk.Context, route types.Route, amountIn math.Int) (amountOut math.Int, err error) {
+	if ctx.GasMeter().IsOutOfGas() {
+		return math.Int{}, errRouteSearchOutOfGas
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				amountOut, err = math.Int{}, errRouteSearchOutOfGas
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	result, err := k.calculateResultRouteExactAmountIn(cacheCtx, route, amountIn)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return result.TokenOut.Amount, nil
+}
+
+// findRoutePaths returns the paths from denomIn to denomOut of up to maxHops pools
+// without visiting a denom twice, shortest first.
+func findRoutePaths(pools []lptypes.Pool, denomIn, denomOut string, maxHops int) []routePath {
+	edges := make(map[string][]lptypes.Pool)
+	for _, pool := range pools {
+		edges[pool.DenomBase] = append(edges[pool.DenomBase], pool)
+		edges[pool.DenomQuote] = append(edges[pool.DenomQuote], pool)
+	}
+
+	paths := []routePath{}
+	visited := map[string]bool{denomIn: true}
+	denoms := []string{denomIn}
+	poolIds := []uint64{}
+
+	var search func(denom string)
+	search = func(denom string) {
+		if len(paths) >= types.MaxRouteCandidates || len(poolIds) >= maxHops {
+			return
+		}
+		for _, pool := range edges[denom] {
+			next := pool.DenomQuote
+			if next == denom {
+				next = pool.DenomBase
+			}
+			if visited[next] {
+				continue
+			}
+
+			denoms = append(denoms, next)
+			poolIds = append(poolIds, pool.Id)
+			if next == denomOut {
+				paths = append(paths, routePath{
+					denoms:  append([]string{}, denoms...),
+					poolIds: append([]uint64{}, poolIds...),
+				})
+			} else {
+				visited[next] = true
+				search(next)
+				visited[next] = false
+			}
+			denoms = denoms[:len(denoms)-1]
+			poolIds = poolIds[:len(poolIds)-1]
+
+			if len(paths) >= types.MaxRouteCandidates {
+				return
+			}
+		}
+	}
+	search(denomIn)
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i].poolIds) < len(paths[j].poolIds)
+	})
+	return paths
+}
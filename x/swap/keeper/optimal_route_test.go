@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/keeper"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// setupRouteKeeper mocks constant product pools at price 1 where the depth is the reserve of each denom
+func setupRouteKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	k, mocks, ctx := keepertest.SwapKeeperWithMocks(t)
+
+	pools := []lptypes.Pool{
+		{Id: 0, DenomBase: "atom", DenomQuote: "btc"},
+		{Id: 1, DenomBase: "btc", DenomQuote: "cro"},
+		{Id: 2, DenomBase: "atom", DenomQuote: "cro"},
+		{Id: 3, DenomBase: "dai", DenomQuote: "eth"},
+	}
+	depths := map[uint64]int64{0: 1000, 1: 1000, 2: 100, 3: 1000}
+
+	mocks.LiquidityPoolKeeper.EXPECT().GetAllPools(gomock.Any()).Return(pools).AnyTimes()
+	mocks.LiquidityPoolKeeper.EXPECT().GetPool(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, id uint64) (lptypes.Pool, bool) {
+			if id >= uint64(len(pools)) {
+				return lptypes.Pool{}, false
+			}
+			return pools[id], true
+		},
+	).AnyTimes()
+	mocks.LiquidityPoolKeeper.EXPECT().CalculateResultExactAmountIn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(
+		func(_ sdk.Context, pool lptypes.Pool, tokenIn sdk.Coin, denomOut string, _ bool) (math.Int, error) {
+			depth := math.NewInt(depths[pool.Id])
+			return tokenIn.Amount.Mul(depth).Quo(depth.Add(tokenIn.Amount)), nil
+		},
+	).AnyTimes()
+
+	return k, ctx
+}
+
+func TestFindOptimalRouteExactAmountIn(t *testing.T) {
+	tests := []struct {
+		desc         string
+		denomIn      string
+		denomOut     string
+		amount       int64
+		maxHops      uint32
+		maxSplits    uint32
+		expPoolIds   []uint64
+		expParallel  bool
+		expAmountOut int64
+		err          error
+	}{
+		{
+			desc:         "direct pool for small amount",
+			denomIn:      "atom",
+			denomOut:     "cro",
+			amount:       10,
+			expPoolIds:   []uint64{2},
+			expAmountOut: 9,
+		},
+		{
+			desc:         "deeper path for large amount",
+			denomIn:      "atom",
+			denomOut:     "cro",
+			amount:       1000,
+			expPoolIds:   []uint64{0, 1},
+			expAmountOut: 333,
+		},
+		{
+			desc:         "limited hops",
+			denomIn:      "atom",
+			denomOut:     "cro",
+			amount:       1000,
+			maxHops:      1,
+			expPoolIds:   []uint64{2},
+			expAmountOut: 90,
+		},
+		{
+			desc:        "split among paths",
+			denomIn:     "atom",
+			denomOut:    "cro",
+			amount:      1000,
+			maxSplits:   2,
+			expParallel: true,
+		},
+		{
+			desc:     "no path",
+			denomIn:  "atom",
+			denomOut: "eth",
+			amount:   1000,
+			err:      types.ErrNoRouteFound,
+		},
+		{
+			desc:     "same denom",
+			denomIn:  "atom",
+			denomOut: "atom",
+			amount:   1000,
+			err:      types.ErrInvalidRoute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := setupRouteKeeper(t)
+
+			route, result, err := k.FindOptimalRouteExactAmountIn(ctx, tc.denomIn, tc.denomOut, math.NewInt(tc.amount), tc.maxHops, tc.maxSplits)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, route.Validate())
+			require.Equal(t, sdk.NewInt64Coin(tc.denomIn, tc.amount), result.TokenIn)
+
+			if tc.expParallel {
+				parallel, ok := route.Strategy.(*types.Route_Parallel)
+				require.True(t, ok)
+				require.Len(t, parallel.Parallel.Routes, 2)
+				// Better than the best single path
+				require.True(t, result.TokenOut.Amount.GT(math.NewInt(333)))
+				return
+			}
+			require.Equal(t, types.NewPathRoute(pathDenoms(tc.denomIn, tc.denomOut, len(tc.expPoolIds)), tc.expPoolIds), route)
+			require.Equal(t, sdk.NewInt64Coin(tc.denomOut, tc.expAmountOut), result.TokenOut)
+		})
+	}
+}
+
+func TestOptimalRouteQuery(t *testing.T) {
+	k, ctx := setupRouteKeeper(t)
+
+	res, err := k.OptimalRoute(ctx, &types.QueryOptimalRouteRequest{DenomIn: "atom", DenomOut: "cro", Amount: "1000"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("cro", 333), res.Result.TokenOut)
+
+	_, err = k.OptimalRoute(ctx, &types.QueryOptimalRouteRequest{DenomIn: "atom", DenomOut: "cro", Amount: "abc"})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	_, err = k.OptimalRoute(ctx, nil)
+	require.Error(t, err)
+}
+
+func pathDenoms(denomIn, denomOut string, hops int) []string {
+	if hops == 1 {
+		return []string{denomIn, denomOut}
+	}
+	return []string{denomIn, "btc", denomOut}
+}
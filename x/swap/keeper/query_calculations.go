@@ -21,7 +21,7 @@ func (k Keeper) CalculationSwapExactAmountIn(goCtx context.Context, req *types.Q
 	if !ok {
 		return nil, types.ErrInvalidAmount
 	}
-	result, interfaceProviderFee, err := k.CalculateResultExactAmountIn(ctx, req.HasInterfaceFee, *req.Route, amountIn)
+	result, interfaceProviderFee, err := k.CalculateResultExactAmountIn(ctx, k.calculationInterfaceFeeRate(ctx, req.InterfaceProvider, req.HasInterfaceFee), *req.Route, amountIn)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func (k Keeper) CalculationSwapExactAmountOut(goCtx context.Context, req *types.
 	if !ok {
 		return nil, types.ErrInvalidAmount
 	}
-	result, interfaceProviderFee, err := k.CalculateResultExactAmountOut(ctx, req.HasInterfaceFee, *req.Route, amountOut)
+	result, interfaceProviderFee, err := k.CalculateResultExactAmountOut(ctx, k.calculationInterfaceFeeRate(ctx, req.InterfaceProvider, req.HasInterfaceFee), *req.Route, amountOut)
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +54,16 @@ func (k Keeper) CalculationSwapExactAmountOut(goCtx context.Context, req *types.
 		AmountIn:             result.TokenIn.Amount,
 	}, nil
 }
+
+// calculationInterfaceFeeRate returns the interface fee rate applied in the
+// calculation queries, preferring the rate of the given interface provider.
+func (k Keeper) calculationInterfaceFeeRate(ctx sdk.Context, interfaceProvider string, hasInterfaceFee bool) sdkmath.LegacyDec {
+	if interfaceProvider != "" {
+		return k.GetInterfaceFeeRate(ctx, interfaceProvider)
+	}
+	if hasInterfaceFee {
+		return k.GetParams(ctx).InterfaceFeeRate
+	}
+
+	return sdkmath.LegacyZeroDec()
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InterfaceProviders(ctx context.Context, req *types.QueryInterfaceProvidersRequest) (*types.QueryInterfaceProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var providers []types.InterfaceProvider
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerStore := prefix.NewStore(store, types.KeyPrefix(types.InterfaceProviderKeyPrefix))
+
+	pageRes, err := query.Paginate(providerStore, req.Pagination, func(key []byte, value []byte) error {
+		var provider types.InterfaceProvider
+		if err := k.cdc.Unmarshal(value, &provider); err != nil {
+			return err
+		}
+
+		providers = append(providers, provider)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterfaceProvidersResponse{InterfaceProviders: providers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InterfaceProvider(ctx context.Context, req *types.QueryInterfaceProviderRequest) (*types.QueryInterfaceProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetInterfaceProvider(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryInterfaceProviderResponse{InterfaceProvider: val}, nil
+}
+
+func (k Keeper) InterfaceProviderStats(ctx context.Context, req *types.QueryInterfaceProviderStatsRequest) (*types.QueryInterfaceProviderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryInterfaceProviderStatsResponse{Stats: k.GetInterfaceProviderStats(ctx, req.Address)}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func (k Keeper) OptimalRoute(goCtx context.Context, req *types.QueryOptimalRouteRequest) (*types.QueryOptimalRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amountIn, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidAmount
+	}
+	route, result, err := k.FindOptimalRouteExactAmountIn(ctx, req.DenomIn, req.DenomOut, amountIn, req.MaxHops, req.MaxSplits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOptimalRouteResponse{
+		Route:  route,
+		Result: result,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingIncomingInFlightPackets(ctx context.Context, req *types.QueryPendingIncomingInFlightPacketsRequest) (*types.QueryPendingIncomingInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	var incomingPackets []types.IncomingInFlightPacket
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	incomingPacketStore := prefix.NewStore(store, types.KeyPrefix(types.IncomingInFlightPacketKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(incomingPacketStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var incomingPacket types.IncomingInFlightPacket
+		if err := k.cdc.Unmarshal(value, &incomingPacket); err != nil {
+			return false, err
+		}
+
+		if height-incomingPacket.CreatedHeight < int64(req.MinPendingBlocks) {
+			return false, nil
+		}
+		if accumulate {
+			incomingPackets = append(incomingPackets, incomingPacket)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingIncomingInFlightPacketsResponse{Packets: incomingPackets, Pagination: pageRes}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sunriselayer/sunrise/x/swap/types"
@@ -12,17 +13,16 @@ import (
 
 func (k Keeper) calculateInterfaceFeeExactAmountIn(
 	ctx sdk.Context,
-	hasInterfaceFee bool,
+	interfaceFeeRate math.LegacyDec,
 	amountOutGross math.Int,
 ) (amountOutNet math.Int, interfaceFee math.Int) {
-	if !hasInterfaceFee {
+	if !interfaceFeeRate.IsPositive() {
 		return amountOutGross, math.ZeroInt()
 	}
 
-	params := k.GetParams(ctx)
 	// $ amountOutNet = amountOutGross - interfaceFee $
 	//                = amountOutGross * (1 - interfaceFeeRate) $
-	amountOutNet = math.LegacyNewDecFromInt(amountOutGross).Mul(math.LegacyOneDec().Sub(params.InterfaceFeeRate)).TruncateInt()
+	amountOutNet = math.LegacyNewDecFromInt(amountOutGross).Mul(math.LegacyOneDec().Sub(interfaceFeeRate)).TruncateInt()
 	interfaceFee = amountOutGross.Sub(amountOutNet)
 
 	return amountOutNet, interfaceFee
@@ -30,7 +30,7 @@ func (k Keeper) calculateInterfaceFeeExactAmountIn(
 
 func (k Keeper) CalculateResultExactAmountIn(
 	ctx sdk.Context,
-	hasInterfaceFee bool,
+	interfaceFeeRate math.LegacyDec,
 	route types.Route,
 	amountIn math.Int,
 ) (result types.RouteResult, interfaceFee math.Int, err error) {
@@ -43,7 +43,7 @@ func (k Keeper) CalculateResultExactAmountIn(
 		amountOutGross = result.TokenOut.Amount
 	)
 
-	_, interfaceFee = k.calculateInterfaceFeeExactAmountIn(ctx, hasInterfaceFee, amountOutGross)
+	_, interfaceFee = k.calculateInterfaceFeeExactAmountIn(ctx, interfaceFeeRate, amountOutGross)
 
 	return result, interfaceFee, nil
 }
@@ -56,35 +56,47 @@ func (k Keeper) SwapExactAmountIn(
 	amountIn math.Int,
 	minAmountOut math.Int,
 ) (result types.RouteResult, interfaceFee math.Int, err error) {
-	result, err = k.swapRouteExactAmountIn(ctx, sender, route, amountIn)
+	return k.SwapExactAmountInWithPriceLimit(ctx, sender, interfaceProvider, route, amountIn, minAmountOut, math.LegacyZeroDec())
+}
+
+// SwapExactAmountInWithPriceLimit swaps amountIn along the route until the spot price of the pool reaches
+// priceLimit, a zero priceLimit meaning no limit. The part of amountIn left once the limit is reached is
+// kept by the sender, and result.TokenIn is the part actually swapped.
+// A price limit is only available for a route of a single pool.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	interfaceProvider string,
+	route types.Route,
+	amountIn math.Int,
+	minAmountOut math.Int,
+	priceLimit math.LegacyDec,
+) (result types.RouteResult, interfaceFee math.Int, err error) {
+	if priceLimit.IsPositive() {
+		result, err = k.swapRoutePoolExactAmountInWithPriceLimit(ctx, sender, route, amountIn, priceLimit)
+	} else {
+		result, err = k.swapRouteExactAmountIn(ctx, sender, route, amountIn)
+	}
 	if err != nil {
 		return result, interfaceFee, err
 	}
 
 	var (
-		hasInterfaceFee = interfaceProvider != ""
-		amountOutNet    math.Int
-		amountOutGross  = result.TokenOut.Amount
+		interfaceFeeRate = k.GetInterfaceFeeRate(ctx, interfaceProvider)
+		amountOutNet     math.Int
+		amountOutGross   = result.TokenOut.Amount
 	)
 
-	amountOutNet, interfaceFee = k.calculateInterfaceFeeExactAmountIn(ctx, hasInterfaceFee, amountOutGross)
+	amountOutNet, interfaceFee = k.calculateInterfaceFeeExactAmountIn(ctx, interfaceFeeRate, amountOutGross)
 
 	if amountOutNet.LT(minAmountOut) {
 		return result, interfaceFee, fmt.Errorf("TODO")
 	}
 
-	if hasInterfaceFee {
-		// Validated in ValidateBasic
-		addr, err := sdk.AccAddressFromBech32(interfaceProvider)
-		if err != nil {
-			return result, interfaceFee, err
-		}
+	if interfaceProvider != "" {
 		fee := sdk.NewCoin(result.TokenOut.Denom, interfaceFee)
-
-		if fee.IsPositive() {
-			if err := k.BankKeeper.SendCoins(ctx, sender, addr, sdk.NewCoins(fee)); err != nil {
-				return result, interfaceFee, err
-			}
+		if err := k.payInterfaceFee(ctx, sender, interfaceProvider, result.TokenIn, fee); err != nil {
+			return result, interfaceFee, err
 		}
 	}
 
@@ -150,8 +162,9 @@ func (k Keeper) swapRouteExactAmountIn(
 ) (result types.RouteResult, err error) {
 	_, result, err = route.InspectRoute(
 		amountIn,
-		func(denomIn string, denomOut string, pool types.RoutePool, amountExact math.Int) (math.Int, error) {
-			return k.swapRoutePoolExactAmountIn(ctx, sender, pool.PoolId, denomIn, denomOut, amountExact)
+		func(denomIn string, denomOut string, pool types.RoutePool, amountExact math.Int) (amountOut math.Int, err error) {
+			_, amountOut, err = k.swapRoutePoolExactAmountIn(ctx, sender, pool.PoolId, denomIn, denomOut, amountExact, math.LegacyZeroDec())
+			return amountOut, err
 		},
 		generateResultExactAmountIn,
 		false,
@@ -160,6 +173,39 @@ func (k Keeper) swapRouteExactAmountIn(
 	return result, err
 }
 
+// swapRoutePoolExactAmountInWithPriceLimit swaps along a route of a single pool,
+// the only route a price limit applies to as it is the spot price of the pool
+func (k Keeper) swapRoutePoolExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route types.Route,
+	amountIn math.Int,
+	priceLimit math.LegacyDec,
+) (result types.RouteResult, err error) {
+	strategy, ok := route.Strategy.(*types.Route_Pool)
+	if !ok {
+		return result, errorsmod.Wrap(types.ErrInvalidPriceLimit, "price limit is only available for a route of a single pool")
+	}
+
+	swappedAmountIn, amountOut, err := k.swapRoutePoolExactAmountIn(ctx, sender, strategy.Pool.PoolId, route.DenomIn, route.DenomOut, amountIn, priceLimit)
+	if err != nil {
+		return result, err
+	}
+
+	// A price limit may leave a part of amountIn unswapped
+	tokenIn, tokenOut := generateResultExactAmountIn(route.DenomIn, route.DenomOut, swappedAmountIn, amountOut)
+
+	return types.RouteResult{
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		Strategy: &types.RouteResult_Pool{
+			Pool: &types.RouteResultPool{
+				PoolId: strategy.Pool.PoolId,
+			},
+		},
+	}, nil
+}
+
 func (k Keeper) swapRoutePoolExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -167,27 +213,29 @@ func (k Keeper) swapRoutePoolExactAmountIn(
 	denomIn string,
 	denomOut string,
 	amountIn math.Int,
-) (amountOut math.Int, err error) {
+	priceLimit math.LegacyDec,
+) (swappedAmountIn math.Int, amountOut math.Int, err error) {
 	pool, found := k.liquidityPoolKeeper.GetPool(ctx, poolId)
 	if !found {
-		return math.Int{}, lptypes.ErrPoolNotFound
+		return math.Int{}, math.Int{}, lptypes.ErrPoolNotFound
 	}
 
 	// No needs to validate the denom,
 	// as liquiditypool side is responsible for ensuring the denom exists in the pool.
 	tokenIn := sdk.NewCoin(denomIn, amountIn)
 
-	amountOut, err = k.liquidityPoolKeeper.SwapExactAmountIn(
+	swappedAmountIn, amountOut, err = k.liquidityPoolKeeper.SwapExactAmountInWithPriceLimit(
 		ctx,
 		sender,
 		pool,
 		tokenIn,
 		denomOut,
 		true,
+		priceLimit,
 	)
 	if err != nil {
-		return math.Int{}, err
+		return math.Int{}, math.Int{}, err
 	}
 
-	return amountOut, nil
+	return swappedAmountIn, amountOut, nil
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
@@ -11,25 +12,24 @@ import (
 
 func (k Keeper) calculateInterfaceFeeExactAmountOut(
 	ctx sdk.Context,
-	hasInterfaceFee bool,
+	interfaceFeeRate math.LegacyDec,
 	amountOutNet math.Int,
 ) (amountOutGross math.Int, interfaceFee math.Int) {
-	if !hasInterfaceFee {
+	if !interfaceFeeRate.IsPositive() {
 		return amountOutNet, math.ZeroInt()
 	}
 
-	params := k.GetParams(ctx)
 	// $ amountGross = amountOutNet + interfaceFee $
 	// $             = amountOutNet / (1 - interfaceFeeRate) $
-	amountOutGross = math.LegacyNewDecFromInt(amountOutNet).Quo(math.LegacyOneDec().Sub(params.InterfaceFeeRate)).TruncateInt()
+	amountOutGross = math.LegacyNewDecFromInt(amountOutNet).Quo(math.LegacyOneDec().Sub(interfaceFeeRate)).TruncateInt()
 	interfaceFee = amountOutGross.Sub(amountOutNet)
 
-	return amountOutNet, interfaceFee
+	return amountOutGross, interfaceFee
 }
 
 func (k Keeper) CalculateResultExactAmountOut(
 	ctx sdk.Context,
-	hasInterfaceFee bool,
+	interfaceFeeRate math.LegacyDec,
 	route types.Route,
 	amountOut math.Int,
 ) (result types.RouteResult, interfaceFee math.Int, err error) {
@@ -37,7 +37,7 @@ func (k Keeper) CalculateResultExactAmountOut(
 		amountOutGross math.Int
 	)
 
-	amountOutGross, interfaceFee = k.calculateInterfaceFeeExactAmountOut(ctx, hasInterfaceFee, amountOut)
+	amountOutGross, interfaceFee = k.calculateInterfaceFeeExactAmountOut(ctx, interfaceFeeRate, amountOut)
 
 	result, err = k.calculateResultRouteExactAmountOut(ctx, route, amountOutGross)
 	if err != nil {
@@ -54,17 +54,37 @@ func (k Keeper) SwapExactAmountOut(
 	route types.Route,
 	maxAmountIn math.Int,
 	amountOut math.Int,
+) (result types.RouteResult, interfaceFee math.Int, err error) {
+	return k.SwapExactAmountOutWithPriceLimit(ctx, sender, interfaceProvider, route, maxAmountIn, amountOut, math.LegacyZeroDec())
+}
+
+// SwapExactAmountOutWithPriceLimit swaps along the route for amountOut unless the spot price of the pool
+// reaches priceLimit before, a zero priceLimit meaning no limit.
+// A price limit is only available for a route of a single pool.
+func (k Keeper) SwapExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	interfaceProvider string,
+	route types.Route,
+	maxAmountIn math.Int,
+	amountOut math.Int,
+	priceLimit math.LegacyDec,
 ) (result types.RouteResult, interfaceFee math.Int, err error) {
 	var (
-		hasInterfaceFee = interfaceProvider != ""
+		interfaceFeeRate = k.GetInterfaceFeeRate(ctx, interfaceProvider)
 	)
 
-	result, interfaceFee, err = k.CalculateResultExactAmountOut(ctx, hasInterfaceFee, route, amountOut)
+	result, interfaceFee, err = k.CalculateResultExactAmountOut(ctx, interfaceFeeRate, route, amountOut)
 	if err != nil {
 		return result, interfaceFee, err
 	}
 
-	if err := k.swapRouteExactAmountOut(ctx, sender, result); err != nil {
+	if priceLimit.IsPositive() {
+		err = k.swapRoutePoolResultExactAmountOutWithPriceLimit(ctx, sender, result, priceLimit)
+	} else {
+		err = k.swapRouteExactAmountOut(ctx, sender, result)
+	}
+	if err != nil {
 		return result, interfaceFee, err
 	}
 
@@ -72,18 +92,10 @@ func (k Keeper) SwapExactAmountOut(
 		return result, interfaceFee, fmt.Errorf("TODO")
 	}
 
-	if hasInterfaceFee {
-		// Validated in ValidateBasic
-		addr, err := sdk.AccAddressFromBech32(interfaceProvider)
-		if err != nil {
-			return result, interfaceFee, err
-		}
+	if interfaceProvider != "" {
 		fee := sdk.NewCoin(result.TokenOut.Denom, interfaceFee)
-
-		if fee.IsPositive() {
-			if err := k.BankKeeper.SendCoins(ctx, sender, addr, sdk.NewCoins(fee)); err != nil {
-				return result, interfaceFee, err
-			}
+		if err := k.payInterfaceFee(ctx, sender, interfaceProvider, result.TokenIn, fee); err != nil {
+			return result, interfaceFee, err
 		}
 	}
 
@@ -148,23 +160,7 @@ func (k Keeper) swapRouteExactAmountOut(
 ) error {
 	switch strategy := result.Strategy.(type) {
 	case *types.RouteResult_Pool:
-		amountIn, err := k.swapRoutePoolExactAmountOut(
-			ctx,
-			sender,
-			strategy.Pool.PoolId,
-			result.TokenIn.Denom,
-			result.TokenOut.Denom,
-			result.TokenOut.Amount,
-		)
-		if err != nil {
-			return err
-		}
-
-		if !amountIn.Equal(result.TokenIn.Amount) {
-			return fmt.Errorf("TODO")
-		}
-
-		return nil
+		return k.swapRoutePoolResultExactAmountOut(ctx, sender, strategy.Pool.PoolId, result, math.LegacyZeroDec())
 
 	case *types.RouteResult_Series:
 		for _, r := range strategy.Series.RouteResults {
@@ -188,6 +184,49 @@ func (k Keeper) swapRouteExactAmountOut(
 	return fmt.Errorf("TODO")
 }
 
+// swapRoutePoolResultExactAmountOutWithPriceLimit swaps the result of a route of a single pool,
+// the only route a price limit applies to as it is the spot price of the pool
+func (k Keeper) swapRoutePoolResultExactAmountOutWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	result types.RouteResult,
+	priceLimit math.LegacyDec,
+) error {
+	strategy, ok := result.Strategy.(*types.RouteResult_Pool)
+	if !ok {
+		return errorsmod.Wrap(types.ErrInvalidPriceLimit, "price limit is only available for a route of a single pool")
+	}
+
+	return k.swapRoutePoolResultExactAmountOut(ctx, sender, strategy.Pool.PoolId, result, priceLimit)
+}
+
+func (k Keeper) swapRoutePoolResultExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	result types.RouteResult,
+	priceLimit math.LegacyDec,
+) error {
+	amountIn, err := k.swapRoutePoolExactAmountOut(
+		ctx,
+		sender,
+		poolId,
+		result.TokenIn.Denom,
+		result.TokenOut.Denom,
+		result.TokenOut.Amount,
+		priceLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !amountIn.Equal(result.TokenIn.Amount) {
+		return fmt.Errorf("TODO")
+	}
+
+	return nil
+}
+
 func (k Keeper) swapRoutePoolExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -195,6 +234,7 @@ func (k Keeper) swapRoutePoolExactAmountOut(
 	denomIn string,
 	denomOut string,
 	amountOut math.Int,
+	priceLimit math.LegacyDec,
 ) (amountIn math.Int, err error) {
 	pool, found := k.liquidityPoolKeeper.GetPool(ctx, poolId)
 	if !found {
@@ -205,13 +245,14 @@ func (k Keeper) swapRoutePoolExactAmountOut(
 	// as liquiditypool side is responsible for ensuring the denom exists in the pool.
 	tokenOut := sdk.NewCoin(denomOut, amountOut)
 
-	amountIn, err = k.liquidityPoolKeeper.SwapExactAmountOut(
+	amountIn, err = k.liquidityPoolKeeper.SwapExactAmountOutWithPriceLimit(
 		ctx,
 		sender,
 		pool,
 		tokenOut,
 		denomIn,
 		true,
+		priceLimit,
 	)
 	if err != nil {
 		return math.Int{}, err
@@ -33,12 +33,41 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "list-incoming-in-flight-packet-packet",
 					Short:     "List all incoming-in-flight-packet",
 				},
+				{
+					RpcMethod:      "PendingIncomingInFlightPackets",
+					Use:            "list-pending-incoming-in-flight-packet [min-pending-blocks]",
+					Short:          "List the incoming-in-flight-packet pending for at least a number of blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "min_pending_blocks"}},
+				},
 				{
 					RpcMethod:      "IncomingInFlightPacket",
 					Use:            "incoming-in-flight-packet [src-port] [src-channel] [sequence]",
 					Short:          "Shows an incoming-in-flight-packet",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "src_port_id"}, {ProtoField: "src_channel_id"}, {ProtoField: "sequence"}},
 				},
+				{
+					RpcMethod:      "OptimalRoute",
+					Use:            "optimal-route [denom-in] [denom-out] [amount]",
+					Short:          "Shows the route with the largest output for an exact input amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom_in"}, {ProtoField: "denom_out"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "InterfaceProviders",
+					Use:       "list-interface-provider",
+					Short:     "List all interface-provider",
+				},
+				{
+					RpcMethod:      "InterfaceProvider",
+					Use:            "show-interface-provider [address]",
+					Short:          "Shows an interface-provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "InterfaceProviderStats",
+					Use:            "interface-provider-stats [address]",
+					Short:          "Shows the swap volume, fees and count routed by an interface-provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -67,6 +96,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						// {ProtoField: "amount_out"},
 					},
 				},
+				{
+					RpcMethod:      "RegisterInterfaceProvider",
+					Use:            "register-interface-provider [metadata]",
+					Short:          "Register the sender as an interface-provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "UpdateInterfaceProvider",
+					Use:            "update-interface-provider [metadata]",
+					Short:          "Update the metadata and fee shares of the sender interface-provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod: "SetInterfaceProviderFeeRate",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
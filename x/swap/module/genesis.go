@@ -17,6 +17,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.OutgoingInFlightPacketList {
 		k.SetOutgoingInFlightPacket(ctx, elem)
 	}
+	// Set all the interfaceProvider
+	for _, elem := range genState.InterfaceProviders {
+		k.SetInterfaceProvider(ctx, elem)
+	}
+	// Set all the interfaceProviderStats
+	for _, elem := range genState.InterfaceProviderStats {
+		k.SetInterfaceProviderStats(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -30,6 +38,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.IncomingInFlightPacketList = k.GetIncomingInFlightPackets(ctx)
 	genesis.OutgoingInFlightPacketList = k.GetOutgoingInFlightPackets(ctx)
+	genesis.InterfaceProviders = k.GetAllInterfaceProviders(ctx)
+	genesis.InterfaceProviderStats = k.GetAllInterfaceProviderStats(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/sunriselayer/sunrise/testutil/keeper"
 	"github.com/sunriselayer/sunrise/testutil/nullify"
+	"github.com/sunriselayer/sunrise/testutil/sample"
 	swap "github.com/sunriselayer/sunrise/x/swap/module"
 	"github.com/sunriselayer/sunrise/x/swap/types"
 )
@@ -46,6 +47,19 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		InterfaceProviders: []types.InterfaceProvider{
+			{
+				Address: sample.AccAddress(),
+			},
+			{
+				Address: sample.AccAddress(),
+			},
+		},
+		InterfaceProviderStats: []types.InterfaceProviderStats{
+			{
+				Address: sample.AccAddress(),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -59,5 +73,7 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.OutgoingInFlightPacketList, got.OutgoingInFlightPacketList)
 	require.ElementsMatch(t, genesisState.IncomingInFlightPacketList, got.IncomingInFlightPacketList)
+	require.ElementsMatch(t, genesisState.InterfaceProviders, got.InterfaceProviders)
+	require.ElementsMatch(t, genesisState.InterfaceProviderStats, got.InterfaceProviderStats)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
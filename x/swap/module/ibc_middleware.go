@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -136,7 +138,7 @@ func (im IBCMiddleware) OnRecvPacket(
 		metadata,
 	)
 	if err != nil {
-		return channeltypes.NewErrorAcknowledgement(err)
+		return swapErrorAcknowledgement(metadata, err)
 	}
 
 	waitingPacket, err := im.keeper.ProcessSwappedFund(
@@ -151,7 +153,7 @@ func (im IBCMiddleware) OnRecvPacket(
 	)
 
 	if err != nil {
-		return channeltypes.NewErrorAcknowledgement(err)
+		return swapErrorAcknowledgement(metadata, err)
 	}
 
 	if waitingPacket != nil {
@@ -192,14 +194,13 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	}
 
-	err := im.keeper.OnAcknowledgementOutgoingInFlightPacket(ctx, packet, acknowledgement, inflightPacket)
-	if err != nil {
+	// The tokens of a failed packet are refunded to its sender first, so that
+	// they can be returned to the source chain.
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
 		return err
 	}
 
-	im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-
-	return nil
+	return im.keeper.OnAcknowledgementOutgoingInFlightPacket(ctx, packet, acknowledgement, inflightPacket)
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
@@ -218,9 +219,30 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
-	if err := im.keeper.OnTimeoutOutgoingInFlightPacket(ctx, packet, inflightPacket); err != nil {
+	retried, err := im.keeper.RetryOutgoingInFlightPacket(ctx, packet, inflightPacket)
+	if err != nil {
+		return err
+	}
+	if retried {
+		// The tokens are carried by the resent packet, so they must not be
+		// refunded.
+		return nil
+	}
+
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
 		return err
 	}
 
-	return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	return im.keeper.OnTimeoutOutgoingInFlightPacket(ctx, packet, inflightPacket)
+}
+
+// swapErrorAcknowledgement returns the error acknowledgement of a packet whose
+// swap failed. The tokens are refunded to the sender by the source chain in
+// any case, since the receive is reverted, and the error wraps ErrRefunded if
+// the swap metadata has the refund failure mode.
+func swapErrorAcknowledgement(metadata types.SwapMetadata, err error) channeltypes.Acknowledgement {
+	if metadata.RefundOnFailure() {
+		err = errorsmod.Wrapf(types.ErrRefunded, "%s", err)
+	}
+	return channeltypes.NewErrorAcknowledgement(err)
 }
@@ -0,0 +1,381 @@
+package swap_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/test/util"
+	lpkeeper "github.com/sunriselayer/sunrise/x/liquiditypool/keeper"
+	lptypes "github.com/sunriselayer/sunrise/x/liquiditypool/types"
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+// swapChains are three chains connected in a line, A to B and B to C, with a
+// pool on chain B swapping the token of chain A to the token of chain B, and a
+// pool on chain C swapping the token of chain B to the token of chain C.
+type swapChains struct {
+	coord                  *ibctesting.Coordinator
+	chainA, chainB, chainC *ibctesting.TestChain
+	pathAB, pathBC         *ibctesting.Path
+
+	// the token of chain A on chain B and the token of chain B on chain C
+	denomAOnB, denomBOnC string
+	poolB, poolC         uint64
+
+	receiverB, receiverC string
+}
+
+func setupSwapChains(t *testing.T) swapChains {
+	ibctesting.DefaultTestingAppInit = util.SetupTestingApp
+	coord := ibctesting.NewCoordinator(t, 3)
+	s := swapChains{
+		coord:     coord,
+		chainA:    coord.GetChain(ibctesting.GetChainID(1)),
+		chainB:    coord.GetChain(ibctesting.GetChainID(2)),
+		chainC:    coord.GetChain(ibctesting.GetChainID(3)),
+		receiverB: sdk.AccAddress("receiver_on_chain_b_").String(),
+		receiverC: sdk.AccAddress("receiver_on_chain_c_").String(),
+	}
+
+	s.pathAB = ibctesting.NewTransferPath(s.chainA, s.chainB)
+	coord.Setup(s.pathAB)
+	s.pathBC = ibctesting.NewTransferPath(s.chainB, s.chainC)
+	coord.Setup(s.pathBC)
+
+	s.denomAOnB = types.GetDenomForThisChain(
+		s.pathAB.EndpointB.ChannelConfig.PortID, s.pathAB.EndpointB.ChannelID,
+		s.pathAB.EndpointA.ChannelConfig.PortID, s.pathAB.EndpointA.ChannelID,
+		sdk.DefaultBondDenom,
+	)
+	s.denomBOnC = types.GetDenomForThisChain(
+		s.pathBC.EndpointB.ChannelConfig.PortID, s.pathBC.EndpointB.ChannelID,
+		s.pathBC.EndpointA.ChannelConfig.PortID, s.pathBC.EndpointA.ChannelID,
+		sdk.DefaultBondDenom,
+	)
+
+	// provide liquidity to the pools of chain B and chain C
+	transfer(t, s.pathAB, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000), s.chainB.SenderAccount.GetAddress().String())
+	transfer(t, s.pathBC, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000), s.chainC.SenderAccount.GetAddress().String())
+	s.poolB = createPool(t, s.chainB, s.denomAOnB, sdk.DefaultBondDenom)
+	s.poolC = createPool(t, s.chainC, s.denomBOnC, sdk.DefaultBondDenom)
+
+	return s
+}
+
+// sendSwap transfers the amount of the token of chain A to the receiver on
+// chain B with the memo, and returns the packet.
+func (s swapChains) sendSwap(t *testing.T, amount int64, memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		s.pathAB.EndpointA.ChannelConfig.PortID,
+		s.pathAB.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.receiverB,
+		clienttypes.NewHeight(1, 1000),
+		0,
+		memo,
+	)
+	res, err := s.chainA.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+// recvSwap receives the packet sent by sendSwap on chain B and returns the
+// result of the transaction.
+func (s swapChains) recvSwap(t *testing.T, packet channeltypes.Packet) *abci.ExecTxResult {
+	require.NoError(t, s.pathAB.EndpointB.UpdateClient())
+	res, err := s.pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	return res
+}
+
+// forwardMetadata returns the forward metadata of a swap on chain B to the
+// receiver on chain C, with the extra fields appended.
+func (s swapChains) forwardMetadata(extra string) string {
+	return fmt.Sprintf(
+		`{"receiver":"%s","port":"%s","channel":"%s"%s}`,
+		s.receiverC, s.pathBC.EndpointA.ChannelConfig.PortID, s.pathBC.EndpointA.ChannelID, extra,
+	)
+}
+
+// transfer sends the token from the sender of the source chain of the path to
+// the receiver on the destination chain and relays the packet.
+func transfer(t *testing.T, path *ibctesting.Path, token sdk.Coin, receiver string) {
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		token,
+		path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.NewHeight(1, 1000),
+		0,
+		"",
+	)
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+}
+
+// createPool creates a pool of the denoms on the chain and provides it with
+// liquidity from the sender of the chain.
+func createPool(t *testing.T, chain *ibctesting.TestChain, denomBase, denomQuote string) uint64 {
+	k := chain.App.(*util.TestingApp).App.LiquiditypoolKeeper
+	srv := lpkeeper.NewMsgServerImpl(k)
+	ctx := chain.GetContext()
+
+	res, err := srv.CreatePool(ctx, &lptypes.MsgCreatePool{
+		Sender:     k.GetAuthority(),
+		DenomBase:  denomBase,
+		DenomQuote: denomQuote,
+		FeeRate:    "0.01",
+		PriceRatio: "1.0001",
+		BaseOffset: "0",
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreatePosition(ctx, &lptypes.MsgCreatePosition{
+		Sender:         chain.SenderAccount.GetAddress().String(),
+		PoolId:         res.Id,
+		LowerTick:      -10,
+		UpperTick:      10,
+		TokenBase:      sdk.NewInt64Coin(denomBase, 1_000_000),
+		TokenQuote:     sdk.NewInt64Coin(denomQuote, 1_000_000),
+		MinAmountBase:  sdkmath.ZeroInt(),
+		MinAmountQuote: sdkmath.ZeroInt(),
+	})
+	require.NoError(t, err)
+
+	chain.Coordinator.CommitBlock(chain)
+	return res.Id
+}
+
+// timeoutPacket times out the packet sent from the endpoint once the time of
+// the counterparty chain is past its timeout, and returns the result of the
+// transaction.
+func timeoutPacket(t *testing.T, endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *abci.ExecTxResult {
+	counterparty := endpoint.Counterparty
+	endpoint.Chain.Coordinator.IncrementTimeBy(time.Hour)
+	endpoint.Chain.Coordinator.CommitBlock(counterparty.Chain)
+	require.NoError(t, endpoint.UpdateClient())
+
+	proof, proofHeight := counterparty.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(t, found)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	))
+	require.NoError(t, err)
+	return res
+}
+
+// TestOnRecvPacketChainedSwap transfers a token from chain A to chain B, where
+// it is swapped and forwarded to chain C with a next memo triggering another
+// swap there.
+func TestOnRecvPacketChainedSwap(t *testing.T) {
+	s := setupSwapChains(t)
+
+	next := fmt.Sprintf(
+		`{"swap":{"route":{"denom_in":"%s","denom_out":"%s","pool":{"pool_id":"%d"}},"exact_amount_in":{"min_amount_out":"1"}}}`,
+		s.denomBOnC, sdk.DefaultBondDenom, s.poolC,
+	)
+	memo := fmt.Sprintf(
+		`{"swap":{"route":{"denom_in":"%s","denom_out":"%s","pool":{"pool_id":"%d"}},"exact_amount_in":{"min_amount_out":"1"},"forward":%s}}`,
+		s.denomAOnB, sdk.DefaultBondDenom, s.poolB, s.forwardMetadata(`,"next":`+next),
+	)
+
+	// chain B swaps and forwards the swapped token to chain C, the
+	// acknowledgement is deferred until the forward is acknowledged
+	packetAB := s.sendSwap(t, 10_000, memo)
+	res := s.recvSwap(t, packetAB)
+	packetBC, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packetBC.GetData(), &data))
+	require.Equal(t, sdk.DefaultBondDenom, data.Denom)
+	require.Equal(t, s.receiverB, data.Sender)
+	require.Equal(t, s.receiverC, data.Receiver)
+	require.Equal(t, next, data.Memo)
+
+	appB := s.chainB.App.(*util.TestingApp).App
+	require.Len(t, appB.SwapKeeper.GetIncomingInFlightPackets(s.chainB.GetContext()), 1)
+	require.Len(t, appB.SwapKeeper.GetOutgoingInFlightPackets(s.chainB.GetContext()), 1)
+
+	// chain C swaps the forwarded token with the next memo
+	_, ack, err := s.pathBC.RelayPacketWithResults(packetBC)
+	require.NoError(t, err)
+	require.NotContains(t, string(ack), "error")
+
+	appC := s.chainC.App.(*util.TestingApp).App
+	receiverC := sdk.MustAccAddressFromBech32(s.receiverC)
+	require.True(t, appC.BankKeeper.GetBalance(s.chainC.GetContext(), receiverC, sdk.DefaultBondDenom).IsPositive())
+	require.True(t, appC.BankKeeper.GetBalance(s.chainC.GetContext(), receiverC, s.denomBOnC).IsZero())
+
+	// the acknowledgement of the forward completes the packet on chain B
+	require.Empty(t, appB.SwapKeeper.GetIncomingInFlightPackets(s.chainB.GetContext()))
+	require.Empty(t, appB.SwapKeeper.GetOutgoingInFlightPackets(s.chainB.GetContext()))
+	_, found := appB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		s.chainB.GetContext(), packetAB.DestinationPort, packetAB.DestinationChannel, packetAB.Sequence,
+	)
+	require.True(t, found)
+}
+
+// TestOnRecvPacketRefundOnSwapFailure checks that a failed swap is refunded to
+// the sender on chain A with an error acknowledgement.
+func TestOnRecvPacketRefundOnSwapFailure(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		onFailure string
+		refunded  bool
+	}{
+		{
+			desc: "default",
+		},
+		{
+			desc:      "refund",
+			onFailure: `,"on_failure":"refund"`,
+			refunded:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := setupSwapChains(t)
+			appA := s.chainA.App.(*util.TestingApp).App
+			sender := s.chainA.SenderAccount.GetAddress()
+			balance := appA.BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			// the pool has less liquidity than the min amount out
+			memo := fmt.Sprintf(
+				`{"swap":{"route":{"denom_in":"%s","denom_out":"%s","pool":{"pool_id":"%d"}},"exact_amount_in":{"min_amount_out":"10000000"}%s}}`,
+				s.denomAOnB, sdk.DefaultBondDenom, s.poolB, tc.onFailure,
+			)
+			packet := s.sendSwap(t, 10_000, memo)
+			_, ack, err := s.pathAB.RelayPacketWithResults(packet)
+			require.NoError(t, err)
+			require.Contains(t, string(ack), `"error"`)
+			refundedCode := fmt.Sprintf("ABCI code: %d:", types.ErrRefunded.ABCICode())
+			require.Equal(t, tc.refunded, strings.Contains(string(ack), refundedCode))
+
+			appB := s.chainB.App.(*util.TestingApp).App
+			receiverB := sdk.MustAccAddressFromBech32(s.receiverB)
+			require.True(t, appB.BankKeeper.GetAllBalances(s.chainB.GetContext(), receiverB).IsZero())
+			require.Equal(t, balance, appA.BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+// TestOnTimeoutPacketRefundAfterRetries checks that a forward timing out after
+// its retries are exhausted is returned to the sender on chain A.
+func TestOnTimeoutPacketRefundAfterRetries(t *testing.T) {
+	s := setupSwapChains(t)
+	appB := s.chainB.App.(*util.TestingApp).App
+	receiverB := sdk.MustAccAddressFromBech32(s.receiverB)
+
+	memo := fmt.Sprintf(
+		`{"swap":{"route":{"denom_in":"%s","denom_out":"%s","pool":{"pool_id":"%d"}},"exact_amount_in":{"min_amount_out":"1"},"forward":%s,"on_failure":"refund"}}`,
+		s.denomAOnB, sdk.DefaultBondDenom, s.poolB, s.forwardMetadata(`,"retries":2`),
+	)
+	packetAB := s.sendSwap(t, 10_000, memo)
+	res := s.recvSwap(t, packetAB)
+	packetBC, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packetBC.GetData(), &data))
+
+	// the first timeout resends the forward without refunding it
+	res = timeoutPacket(t, s.pathBC.EndpointA, packetBC)
+	resent, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.Equal(t, packetBC.Data, resent.Data)
+	require.NotEqual(t, packetBC.Sequence, resent.Sequence)
+	// the timeout of the resent packet is twice as long
+	timeout := time.Duration(resent.TimeoutTimestamp - uint64(s.coord.CurrentTime.UnixNano()))
+	require.InDelta(t, 2*time.Duration(types.DefaultPacketTimeoutSeconds)*time.Second, timeout, float64(time.Minute))
+	require.True(t, appB.BankKeeper.GetAllBalances(s.chainB.GetContext(), receiverB).IsZero())
+	require.Len(t, appB.SwapKeeper.GetOutgoingInFlightPackets(s.chainB.GetContext()), 1)
+
+	// the second timeout returns the swapped token to the sender on chain A
+	res = timeoutPacket(t, s.pathBC.EndpointA, resent)
+	refund, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.Equal(t, s.pathAB.EndpointB.ChannelID, refund.SourceChannel)
+	require.True(t, appB.BankKeeper.GetAllBalances(s.chainB.GetContext(), receiverB).IsZero())
+	require.Empty(t, appB.SwapKeeper.GetOutgoingInFlightPackets(s.chainB.GetContext()))
+	require.Empty(t, appB.SwapKeeper.GetIncomingInFlightPackets(s.chainB.GetContext()))
+	_, found := appB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		s.chainB.GetContext(), packetAB.DestinationPort, packetAB.DestinationChannel, packetAB.Sequence,
+	)
+	require.True(t, found)
+
+	require.NoError(t, s.pathAB.RelayPacket(refund))
+	appA := s.chainA.App.(*util.TestingApp).App
+	denomBOnA := types.GetDenomForThisChain(
+		s.pathAB.EndpointA.ChannelConfig.PortID, s.pathAB.EndpointA.ChannelID,
+		s.pathAB.EndpointB.ChannelConfig.PortID, s.pathAB.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)
+	require.Equal(t, data.Amount, appA.BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), denomBOnA).Amount.String())
+}
+
+// TestEndBlockerExpireIncomingInFlightPacket checks that an incoming packet
+// whose forward is never relayed is acknowledged once expired, the forwarded
+// token being refunded to the receiver on chain B when it times out.
+func TestEndBlockerExpireIncomingInFlightPacket(t *testing.T) {
+	s := setupSwapChains(t)
+	appB := s.chainB.App.(*util.TestingApp).App
+	receiverB := sdk.MustAccAddressFromBech32(s.receiverB)
+
+	params := appB.SwapKeeper.GetParams(s.chainB.GetContext())
+	params.MaxInFlightBlocks = 2
+	require.NoError(t, appB.SwapKeeper.SetParams(s.chainB.GetContext(), params))
+	s.coord.CommitBlock(s.chainB)
+
+	memo := fmt.Sprintf(
+		`{"swap":{"route":{"denom_in":"%s","denom_out":"%s","pool":{"pool_id":"%d"}},"exact_amount_in":{"min_amount_out":"1"},"forward":%s}}`,
+		s.denomAOnB, sdk.DefaultBondDenom, s.poolB, s.forwardMetadata(""),
+	)
+	packetAB := s.sendSwap(t, 10_000, memo)
+	res := s.recvSwap(t, packetAB)
+	packetBC, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packetBC.GetData(), &data))
+
+	pending, err := appB.SwapKeeper.PendingIncomingInFlightPackets(s.chainB.GetContext(), &types.QueryPendingIncomingInFlightPacketsRequest{MinPendingBlocks: 1})
+	require.NoError(t, err)
+	require.Len(t, pending.Packets, 1)
+	pending, err = appB.SwapKeeper.PendingIncomingInFlightPackets(s.chainB.GetContext(), &types.QueryPendingIncomingInFlightPacketsRequest{MinPendingBlocks: 100})
+	require.NoError(t, err)
+	require.Empty(t, pending.Packets)
+
+	// the incoming packet is acknowledged and the forward forgotten
+	s.coord.CommitNBlocks(s.chainB, 3)
+	require.Empty(t, appB.SwapKeeper.GetIncomingInFlightPackets(s.chainB.GetContext()))
+	require.Empty(t, appB.SwapKeeper.GetOutgoingInFlightPackets(s.chainB.GetContext()))
+	_, found := appB.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		s.chainB.GetContext(), packetAB.DestinationPort, packetAB.DestinationChannel, packetAB.Sequence,
+	)
+	require.True(t, found)
+
+	// the forward times out without being resent
+	res = timeoutPacket(t, s.pathBC.EndpointA, packetBC)
+	_, err = ibctesting.ParsePacketFromEvents(res.Events)
+	require.Error(t, err)
+	require.Equal(t, data.Amount, appB.BankKeeper.GetBalance(s.chainB.GetContext(), receiverB, sdk.DefaultBondDenom).Amount.String())
+}
@@ -282,6 +282,20 @@ func (mr *MockLiquidityPoolKeeperMockRecorder) CalculateResultExactAmountOut(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateResultExactAmountOut", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).CalculateResultExactAmountOut), ctx, pool, tokenOut, denomIn, feeEnabled)
 }
 
+// GetAllPools mocks base method.
+func (m *MockLiquidityPoolKeeper) GetAllPools(ctx context.Context) []types1.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPools", ctx)
+	ret0, _ := ret[0].([]types1.Pool)
+	return ret0
+}
+
+// GetAllPools indicates an expected call of GetAllPools.
+func (mr *MockLiquidityPoolKeeperMockRecorder) GetAllPools(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPools", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetAllPools), ctx)
+}
+
 // GetPool mocks base method.
 func (m *MockLiquidityPoolKeeper) GetPool(ctx context.Context, id uint64) (types1.Pool, bool) {
 	m.ctrl.T.Helper()
@@ -297,34 +311,35 @@ func (mr *MockLiquidityPoolKeeperMockRecorder) GetPool(ctx, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).GetPool), ctx, id)
 }
 
-// SwapExactAmountIn mocks base method.
-func (m *MockLiquidityPoolKeeper) SwapExactAmountIn(ctx types.Context, sender types.AccAddress, pool types1.Pool, tokenIn types.Coin, denomOut string, feeEnabled bool) (math.Int, error) {
+// SwapExactAmountInWithPriceLimit mocks base method.
+func (m *MockLiquidityPoolKeeper) SwapExactAmountInWithPriceLimit(ctx types.Context, sender types.AccAddress, pool types1.Pool, tokenIn types.Coin, denomOut string, feeEnabled bool, priceLimit math.LegacyDec) (math.Int, math.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SwapExactAmountIn", ctx, sender, pool, tokenIn, denomOut, feeEnabled)
+	ret := m.ctrl.Call(m, "SwapExactAmountInWithPriceLimit", ctx, sender, pool, tokenIn, denomOut, feeEnabled, priceLimit)
 	ret0, _ := ret[0].(math.Int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(math.Int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// SwapExactAmountIn indicates an expected call of SwapExactAmountIn.
-func (mr *MockLiquidityPoolKeeperMockRecorder) SwapExactAmountIn(ctx, sender, pool, tokenIn, denomOut, feeEnabled interface{}) *gomock.Call {
+// SwapExactAmountInWithPriceLimit indicates an expected call of SwapExactAmountInWithPriceLimit.
+func (mr *MockLiquidityPoolKeeperMockRecorder) SwapExactAmountInWithPriceLimit(ctx, sender, pool, tokenIn, denomOut, feeEnabled, priceLimit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountIn", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).SwapExactAmountIn), ctx, sender, pool, tokenIn, denomOut, feeEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountInWithPriceLimit", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).SwapExactAmountInWithPriceLimit), ctx, sender, pool, tokenIn, denomOut, feeEnabled, priceLimit)
 }
 
-// SwapExactAmountOut mocks base method.
-func (m *MockLiquidityPoolKeeper) SwapExactAmountOut(ctx types.Context, sender types.AccAddress, pool types1.Pool, tokenOut types.Coin, denomIn string, feeEnabled bool) (math.Int, error) {
+// SwapExactAmountOutWithPriceLimit mocks base method.
+func (m *MockLiquidityPoolKeeper) SwapExactAmountOutWithPriceLimit(ctx types.Context, sender types.AccAddress, pool types1.Pool, tokenOut types.Coin, denomIn string, feeEnabled bool, priceLimit math.LegacyDec) (math.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SwapExactAmountOut", ctx, sender, pool, tokenOut, denomIn, feeEnabled)
+	ret := m.ctrl.Call(m, "SwapExactAmountOutWithPriceLimit", ctx, sender, pool, tokenOut, denomIn, feeEnabled, priceLimit)
 	ret0, _ := ret[0].(math.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SwapExactAmountOut indicates an expected call of SwapExactAmountOut.
-func (mr *MockLiquidityPoolKeeperMockRecorder) SwapExactAmountOut(ctx, sender, pool, tokenOut, denomIn, feeEnabled interface{}) *gomock.Call {
+// SwapExactAmountOutWithPriceLimit indicates an expected call of SwapExactAmountOutWithPriceLimit.
+func (mr *MockLiquidityPoolKeeperMockRecorder) SwapExactAmountOutWithPriceLimit(ctx, sender, pool, tokenOut, denomIn, feeEnabled, priceLimit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountOut", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).SwapExactAmountOut), ctx, sender, pool, tokenOut, denomIn, feeEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapExactAmountOutWithPriceLimit", reflect.TypeOf((*MockLiquidityPoolKeeper)(nil).SwapExactAmountOutWithPriceLimit), ctx, sender, pool, tokenOut, denomIn, feeEnabled, priceLimit)
 }
 
 // MockParamSubspace is a mock of ParamSubspace interface.
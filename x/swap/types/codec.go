@@ -14,6 +14,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSwapExactAmountOut{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterInterfaceProvider{},
+		&MsgUpdateInterfaceProvider{},
+		&MsgSetInterfaceProviderFeeRate{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -12,4 +12,15 @@ var (
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
 	ErrInvalidRoute  = sdkerrors.Register(ModuleName, 1102, "invalid route")
 	ErrInvalidAmount = sdkerrors.Register(ModuleName, 1103, "invalid amount")
+	ErrNoRouteFound  = sdkerrors.Register(ModuleName, 1104, "no route found")
+	ErrRefunded      = sdkerrors.Register(ModuleName, 1105, "tokens refunded to the sender")
+	ErrExpired       = sdkerrors.Register(ModuleName, 1106, "in-flight packet expired")
+
+	ErrInterfaceProviderAlreadyRegistered = sdkerrors.Register(ModuleName, 1107, "interface provider already registered")
+	ErrInterfaceProviderNotFound          = sdkerrors.Register(ModuleName, 1108, "interface provider not found")
+	ErrInvalidFeeRate                     = sdkerrors.Register(ModuleName, 1109, "invalid interface fee rate")
+	ErrInvalidFeeShares                   = sdkerrors.Register(ModuleName, 1110, "invalid interface fee shares")
+
+	ErrInvalidPriceLimit = sdkerrors.Register(ModuleName, 1111, "invalid price limit")
+	ErrDeadlineExceeded  = sdkerrors.Register(ModuleName, 1112, "swap deadline exceeded")
 )
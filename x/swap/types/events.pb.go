@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/swap/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventOutgoingInFlightPacketRetried struct {
+	Index            PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index"`
+	PreviousIndex    PacketIndex `protobuf:"bytes,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index"`
+	TimeoutTimestamp uint64      `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *EventOutgoingInFlightPacketRetried) Reset()         { *m = EventOutgoingInFlightPacketRetried{} }
+func (m *EventOutgoingInFlightPacketRetried) String() string { return proto.CompactTextString(m) }
+func (*EventOutgoingInFlightPacketRetried) ProtoMessage()    {}
+func (*EventOutgoingInFlightPacketRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad640a92158a8e91, []int{0}
+}
+func (m *EventOutgoingInFlightPacketRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutgoingInFlightPacketRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutgoingInFlightPacketRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOutgoingInFlightPacketRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutgoingInFlightPacketRetried.Merge(m, src)
+}
+func (m *EventOutgoingInFlightPacketRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutgoingInFlightPacketRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutgoingInFlightPacketRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOutgoingInFlightPacketRetried proto.InternalMessageInfo
+
+func (m *EventOutgoingInFlightPacketRetried) GetIndex() PacketIndex {
+	if m != nil {
+		return m.Index
+	}
+	return PacketIndex{}
+}
+
+func (m *EventOutgoingInFlightPacketRetried) GetPreviousIndex() PacketIndex {
+	if m != nil {
+		return m.PreviousIndex
+	}
+	return PacketIndex{}
+}
+
+func (m *EventOutgoingInFlightPacketRetried) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+type EventIncomingInFlightPacketExpired struct {
+	Index         PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index"`
+	CreatedHeight int64       `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *EventIncomingInFlightPacketExpired) Reset()         { *m = EventIncomingInFlightPacketExpired{} }
+func (m *EventIncomingInFlightPacketExpired) String() string { return proto.CompactTextString(m) }
+func (*EventIncomingInFlightPacketExpired) ProtoMessage()    {}
+func (*EventIncomingInFlightPacketExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad640a92158a8e91, []int{1}
+}
+func (m *EventIncomingInFlightPacketExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIncomingInFlightPacketExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIncomingInFlightPacketExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIncomingInFlightPacketExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIncomingInFlightPacketExpired.Merge(m, src)
+}
+func (m *EventIncomingInFlightPacketExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIncomingInFlightPacketExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIncomingInFlightPacketExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIncomingInFlightPacketExpired proto.InternalMessageInfo
+
+func (m *EventIncomingInFlightPacketExpired) GetIndex() PacketIndex {
+	if m != nil {
+		return m.Index
+	}
+	return PacketIndex{}
+}
+
+func (m *EventIncomingInFlightPacketExpired) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventOutgoingInFlightPacketRetried)(nil), "sunrise.swap.EventOutgoingInFlightPacketRetried")
+	proto.RegisterType((*EventIncomingInFlightPacketExpired)(nil), "sunrise.swap.EventIncomingInFlightPacketExpired")
+}
+
+func init() { proto.RegisterFile("sunrise/swap/events.proto", fileDescriptor_ad640a92158a8e91) }
+
+var fileDescriptor_ad640a92158a8e91 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0x7f, 0xfb, 0xbb, 0x88, 0xb6, 0x68, 0x70, 0xd1, 0x76, 0x11, 0x4b, 0x45, 0x28,
+	0x28, 0x09, 0x28, 0xbe, 0x40, 0xa1, 0xc5, 0xae, 0x94, 0xe0, 0xca, 0x4d, 0x48, 0xd3, 0x6b, 0x3a,
+	0xd8, 0xcc, 0x0c, 0x33, 0x37, 0xb5, 0xdd, 0xfa, 0x04, 0x3e, 0x56, 0x97, 0x5d, 0xba, 0x12, 0x69,
+	0x5f, 0x44, 0x66, 0x26, 0x01, 0xc5, 0x8d, 0xb8, 0x4a, 0xe6, 0x9c, 0xef, 0xdc, 0xcb, 0xe1, 0xba,
+	0x6d, 0x55, 0x30, 0x49, 0x15, 0x84, 0xea, 0x39, 0x11, 0x21, 0x2c, 0x80, 0xa1, 0x0a, 0x84, 0xe4,
+	0xc8, 0xbd, 0x83, 0xd2, 0x0a, 0xb4, 0xd5, 0x39, 0xce, 0x78, 0xc6, 0x8d, 0x11, 0xea, 0x3f, 0xcb,
+	0x74, 0x4e, 0xbf, 0xc5, 0x29, 0x8b, 0x1f, 0xe7, 0x34, 0x9b, 0x61, 0x2c, 0x92, 0xf4, 0x09, 0xd0,
+	0x42, 0xbd, 0x0d, 0x71, 0x7b, 0x43, 0x3d, 0xf9, 0xb6, 0xc0, 0x8c, 0x53, 0x96, 0x8d, 0xd9, 0xc8,
+	0x60, 0x77, 0x86, 0x8a, 0x00, 0x25, 0x85, 0xa9, 0x77, 0xed, 0xfe, 0xa7, 0x6c, 0x0a, 0xcb, 0x16,
+	0xe9, 0x92, 0xfe, 0xfe, 0x65, 0x3b, 0xf8, 0xba, 0x3f, 0xb0, 0xec, 0x58, 0x03, 0x83, 0xfa, 0xfa,
+	0xfd, 0xc4, 0x89, 0x2c, 0xed, 0x8d, 0xdc, 0xa6, 0x90, 0xb0, 0xa0, 0xbc, 0x50, 0xb1, 0xcd, 0xff,
+	0xfb, 0x5d, 0xbe, 0x51, 0xc5, 0x8c, 0xe8, 0x9d, 0xbb, 0x47, 0x48, 0x73, 0xe0, 0x05, 0xc6, 0xfa,
+	0xab, 0x30, 0xc9, 0x45, 0xab, 0xd6, 0x25, 0xfd, 0x7a, 0x74, 0x58, 0x1a, 0xf7, 0x95, 0xde, 0x7b,
+	0xa9, 0x2a, 0x8d, 0x59, 0xca, 0xf3, 0x1f, 0x95, 0x86, 0x4b, 0x41, 0xe5, 0xdf, 0x2b, 0x9d, 0xb9,
+	0xcd, 0x54, 0x42, 0x82, 0x30, 0x8d, 0x67, 0xa0, 0xa7, 0x9a, 0x4a, 0xb5, 0xa8, 0x51, 0xaa, 0x37,
+	0x46, 0x1c, 0x8c, 0xd6, 0x5b, 0x9f, 0x6c, 0xb6, 0x3e, 0xf9, 0xd8, 0xfa, 0xe4, 0x75, 0xe7, 0x3b,
+	0x9b, 0x9d, 0xef, 0xbc, 0xed, 0x7c, 0xe7, 0xe1, 0x22, 0xa3, 0x38, 0x2b, 0x26, 0x41, 0xca, 0xf3,
+	0xb0, 0x5c, 0x39, 0x4f, 0x56, 0x20, 0xab, 0x47, 0xb8, 0xb4, 0x07, 0xc3, 0x95, 0x00, 0x35, 0xd9,
+	0x33, 0x67, 0xba, 0xfa, 0x1c, 0x00, 0xbc, 0xc6, 0xd5, 0x73, 0x0c, 0x02, 0x00, 0x00,
+}
+
+func (m *EventOutgoingInFlightPacketRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingInFlightPacketRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingInFlightPacketRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PreviousIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIncomingInFlightPacketExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIncomingInFlightPacketExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIncomingInFlightPacketExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOutgoingInFlightPacketRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Index.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PreviousIndex.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *EventIncomingInFlightPacketExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Index.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOutgoingInFlightPacketRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutgoingInFlightPacketRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutgoingInFlightPacketRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIncomingInFlightPacketExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIncomingInFlightPacketExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIncomingInFlightPacketExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
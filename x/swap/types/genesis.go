@@ -12,6 +12,8 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		IncomingInFlightPacketList: []IncomingInFlightPacket{},
 		OutgoingInFlightPacketList: []OutgoingInFlightPacket{},
+		InterfaceProviders:         []InterfaceProvider{},
+		InterfaceProviderStats:     []InterfaceProviderStats{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -40,6 +42,31 @@ func (gs GenesisState) Validate() error {
 		}
 		outgoingInFlightPacketIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated address in interfaceProviders
+	interfaceProviderIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.InterfaceProviders {
+		if _, ok := interfaceProviderIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for interfaceProvider")
+		}
+		interfaceProviderIndexMap[elem.Address] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if elem.FeeRate != nil && elem.FeeRate.GT(gs.Params.MaxInterfaceFeeRate) {
+			return fmt.Errorf("interface fee rate %s of %s exceeds the max interface fee rate", elem.FeeRate, elem.Address)
+		}
+	}
+	// Check for duplicated address in interfaceProviderStats
+	interfaceProviderStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.InterfaceProviderStats {
+		if _, ok := interfaceProviderStatsIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for interfaceProviderStats")
+		}
+		interfaceProviderStatsIndexMap[elem.Address] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params                     Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	IncomingInFlightPacketList []IncomingInFlightPacket `protobuf:"bytes,2,rep,name=incomingInFlightPacketList,proto3" json:"incomingInFlightPacketList"`
 	OutgoingInFlightPacketList []OutgoingInFlightPacket `protobuf:"bytes,3,rep,name=outgoingInFlightPacketList,proto3" json:"outgoingInFlightPacketList"`
+	InterfaceProviders         []InterfaceProvider      `protobuf:"bytes,4,rep,name=interface_providers,json=interfaceProviders,proto3" json:"interface_providers"`
+	InterfaceProviderStats     []InterfaceProviderStats `protobuf:"bytes,5,rep,name=interface_provider_stats,json=interfaceProviderStats,proto3" json:"interface_provider_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +88,20 @@ func (m *GenesisState) GetOutgoingInFlightPacketList() []OutgoingInFlightPacket
 	return nil
 }
 
+func (m *GenesisState) GetInterfaceProviders() []InterfaceProvider {
+	if m != nil {
+		return m.InterfaceProviders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInterfaceProviderStats() []InterfaceProviderStats {
+	if m != nil {
+		return m.InterfaceProviderStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.swap.GenesisState")
 }
@@ -93,26 +109,30 @@ func init() {
 func init() { proto.RegisterFile("sunrise/swap/genesis.proto", fileDescriptor_1fd15ebb865a4f63) }
 
 var fileDescriptor_1fd15ebb865a4f63 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xca, 0xe9, 0x81, 0xe4, 0xa4, 0x04, 0x13,
-	0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x81, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98,
-	0xa9, 0x0f, 0x62, 0x41, 0x45, 0x25, 0x51, 0x8c, 0x2c, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x9a, 0x28,
-	0xa5, 0x8c, 0x22, 0x95, 0x99, 0x17, 0x9f, 0x96, 0x93, 0x99, 0x9e, 0x51, 0x12, 0x5f, 0x90, 0x98,
-	0x9c, 0x9d, 0x5a, 0x02, 0x51, 0xa4, 0x34, 0x9f, 0x89, 0x8b, 0xc7, 0x1d, 0xe2, 0x90, 0xe0, 0x92,
-	0xc4, 0x92, 0x54, 0x21, 0x73, 0x2e, 0x36, 0x88, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46,
-	0x22, 0x7a, 0xc8, 0x0e, 0xd3, 0x0b, 0x00, 0xcb, 0x39, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2,
-	0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x72, 0xa1, 0x2c, 0x2e, 0xa9, 0xcc, 0xbc, 0xe4, 0xfc, 0xdc,
-	0xcc, 0xbc, 0x74, 0xcf, 0x3c, 0x37, 0xb0, 0x55, 0x01, 0x60, 0x9b, 0x7c, 0x32, 0x8b, 0x4b, 0x24,
-	0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x54, 0x50, 0x0d, 0xf3, 0xc4, 0xaa, 0xde, 0x89, 0x05, 0x64,
-	0x78, 0x10, 0x1e, 0xd3, 0x40, 0x76, 0xe5, 0x97, 0x96, 0xa4, 0xe7, 0x63, 0xb7, 0x8b, 0x19, 0x9b,
-	0x5d, 0xfe, 0x58, 0xd5, 0xc3, 0xec, 0xc2, 0x6d, 0x9a, 0x93, 0xdb, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
-	0xea, 0x43, 0xed, 0xca, 0x49, 0xac, 0x4c, 0x2d, 0x82, 0x71, 0xf4, 0x2b, 0x20, 0x41, 0x5f, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x70, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1,
-	0x39, 0xe4, 0x17, 0x05, 0x02, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x5b, 0x41, 0x12, 0x0b, 0x8b, 0x95, 0x98, 0xda, 0xa1, 0x10, 0xff, 0x24, 0xc4, 0x98,
+	0x36, 0xc1, 0xc1, 0x9d, 0x01, 0x43, 0x62, 0x22, 0xc1, 0x4d, 0x87, 0xe6, 0xa8, 0xc7, 0x71, 0x4a,
+	0xef, 0x9a, 0xbb, 0x43, 0xe5, 0x5b, 0xf8, 0x31, 0x1c, 0xfd, 0x18, 0x8c, 0x8c, 0x4e, 0xc6, 0xd0,
+	0x81, 0xaf, 0x61, 0x7a, 0x3d, 0x12, 0x5a, 0xaa, 0x4b, 0x73, 0xed, 0xf3, 0xcb, 0xf3, 0x3c, 0x7d,
+	0xef, 0x35, 0x6c, 0x3e, 0x25, 0x0c, 0x73, 0xe8, 0xf1, 0x57, 0x10, 0x79, 0x08, 0x12, 0xc8, 0x31,
+	0x77, 0x23, 0x46, 0x05, 0x35, 0x6b, 0x4a, 0x73, 0x13, 0xcd, 0xde, 0x07, 0x21, 0x26, 0xd4, 0x93,
+	0xcf, 0x14, 0xb0, 0xeb, 0x88, 0x22, 0x2a, 0x8f, 0x5e, 0x72, 0x52, 0x5f, 0x8f, 0x32, 0x96, 0x11,
+	0x60, 0x20, 0x54, 0x8e, 0xf6, 0x49, 0x46, 0xc2, 0xc4, 0x1f, 0x4d, 0x30, 0x1a, 0x0b, 0x3f, 0x02,
+	0xc1, 0x33, 0x14, 0x0a, 0x3a, 0xcb, 0x41, 0x02, 0xb2, 0x11, 0x08, 0xa0, 0x1f, 0x31, 0xfa, 0x82,
+	0x1f, 0x21, 0x4b, 0xb1, 0xe3, 0x55, 0xc9, 0xa8, 0x5d, 0xa7, 0x7d, 0xef, 0x04, 0x10, 0xd0, 0xbc,
+	0x32, 0x2a, 0x69, 0x98, 0xa5, 0x37, 0xf5, 0x56, 0xb5, 0x5d, 0x77, 0x37, 0xfb, 0xbb, 0x7d, 0xa9,
+	0x75, 0xf6, 0xe6, 0xdf, 0x0d, 0xed, 0x63, 0xf5, 0x79, 0xae, 0x0f, 0x14, 0x6e, 0x3e, 0x19, 0x36,
+	0x26, 0x01, 0x0d, 0x31, 0x41, 0x3d, 0xd2, 0x95, 0x8d, 0xfa, 0xb2, 0xd0, 0x0d, 0xe6, 0xc2, 0xda,
+	0x69, 0x96, 0x5a, 0xd5, 0xf6, 0x69, 0xd6, 0xac, 0x57, 0xc8, 0x77, 0xca, 0x89, 0xf9, 0xe0, 0x1f,
+	0xb7, 0x24, 0x8b, 0x4e, 0x05, 0xa2, 0xc5, 0x59, 0xa5, 0xa2, 0xac, 0xdb, 0x42, 0x7e, 0x9d, 0xf5,
+	0xb7, 0x9b, 0xf9, 0x60, 0x1c, 0x6c, 0x4f, 0x8f, 0x5b, 0x65, 0x19, 0xd2, 0xc8, 0xff, 0x90, 0x02,
+	0xfb, 0x8a, 0xdb, 0x1c, 0x94, 0x89, 0xf3, 0x2a, 0x37, 0xb1, 0x61, 0x6d, 0x9b, 0xfb, 0x5c, 0x00,
+	0xc1, 0xad, 0xdd, 0xe2, 0x91, 0xe5, 0x3c, 0x92, 0x5b, 0xcb, 0xdc, 0xc7, 0x21, 0x2e, 0x46, 0xba,
+	0xf3, 0xa5, 0xa3, 0x2f, 0x96, 0x8e, 0xfe, 0xb3, 0x74, 0xf4, 0xf7, 0xd8, 0xd1, 0x16, 0xb1, 0xa3,
+	0x7d, 0xc5, 0x8e, 0x76, 0x7f, 0x81, 0xb0, 0x18, 0x4f, 0x87, 0x6e, 0x40, 0x43, 0x4f, 0x85, 0x4d,
+	0xc0, 0x0c, 0xb2, 0xf5, 0x8b, 0xf7, 0x96, 0x2e, 0x91, 0x98, 0x45, 0x90, 0x0f, 0x2b, 0x72, 0x71,
+	0x2e, 0x7f, 0x07, 0x00, 0xb3, 0x78, 0xa4, 0x09, 0xf4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +155,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InterfaceProviderStats) > 0 {
+		for iNdEx := len(m.InterfaceProviderStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterfaceProviderStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InterfaceProviders) > 0 {
+		for iNdEx := len(m.InterfaceProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterfaceProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.OutgoingInFlightPacketList) > 0 {
 		for iNdEx := len(m.OutgoingInFlightPacketList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +255,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InterfaceProviders) > 0 {
+		for _, e := range m.InterfaceProviders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterfaceProviderStats) > 0 {
+		for _, e := range m.InterfaceProviderStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +406,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterfaceProviders = append(m.InterfaceProviders, InterfaceProvider{})
+			if err := m.InterfaceProviders[len(m.InterfaceProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterfaceProviderStats = append(m.InterfaceProviderStats, InterfaceProviderStats{})
+			if err := m.InterfaceProviderStats[len(m.InterfaceProviderStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
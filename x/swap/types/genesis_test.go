@@ -3,11 +3,17 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+	"github.com/sunriselayer/sunrise/testutil/sample"
 	"github.com/sunriselayer/sunrise/x/swap/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	provider := sample.AccAddress()
+	feeRate := math.LegacyNewDecWithPrec(2, 2)
+	aboveMaxFeeRate := types.DefaultMaxInterfaceFeeRate.Add(math.LegacyNewDecWithPrec(1, 2))
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,6 +27,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
 				IncomingInFlightPacketList: []types.IncomingInFlightPacket{
 					{
@@ -54,6 +61,23 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 					},
 				},
+				InterfaceProviders: []types.InterfaceProvider{
+					{
+						Address: sample.AccAddress(),
+					},
+					{
+						Address: sample.AccAddress(),
+						FeeRate: &feeRate,
+						FeeShares: []types.FeeShare{
+							{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+						},
+					},
+				},
+				InterfaceProviderStats: []types.InterfaceProviderStats{
+					{
+						Address: sample.AccAddress(),
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -102,6 +126,96 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "zero retry count",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultInterfaceFeeRate,
+					0,
+					types.DefaultPacketTimeoutSeconds,
+					types.DefaultRetryTimeoutMultiplier,
+					types.DefaultMaxInFlightBlocks,
+					types.DefaultMaxInterfaceFeeRate,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "retry timeout multiplier below one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultInterfaceFeeRate,
+					types.DefaultRetryCount,
+					types.DefaultPacketTimeoutSeconds,
+					math.LegacyMustNewDecFromStr("0.5"),
+					types.DefaultMaxInFlightBlocks,
+					types.DefaultMaxInterfaceFeeRate,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated interfaceProvider",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				InterfaceProviders: []types.InterfaceProvider{
+					{Address: provider},
+					{Address: provider},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "interface provider fee rate above max",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				InterfaceProviders: []types.InterfaceProvider{
+					{Address: provider, FeeRate: &aboveMaxFeeRate},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "interface provider fee shares above one",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				InterfaceProviders: []types.InterfaceProvider{
+					{
+						Address: provider,
+						FeeShares: []types.FeeShare{
+							{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(6, 1)},
+							{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(6, 1)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated interfaceProviderStats",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				InterfaceProviderStats: []types.InterfaceProviderStats{
+					{Address: provider},
+					{Address: provider},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "interface fee rate above max",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					aboveMaxFeeRate,
+					types.DefaultRetryCount,
+					types.DefaultPacketTimeoutSeconds,
+					types.DefaultRetryTimeoutMultiplier,
+					types.DefaultMaxInFlightBlocks,
+					types.DefaultMaxInterfaceFeeRate,
+				),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
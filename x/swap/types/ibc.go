@@ -11,7 +11,10 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
-const DefaultRetryCount uint8 = 3
+// OnFailureRefund is the failure mode of a swap metadata returning the tokens
+// to the sender on the source chain when the swap or its change or forward
+// fails.
+const OnFailureRefund = "refund"
 
 type PacketMetadata struct {
 	Swap *SwapMetadata `json:"swap"`
@@ -27,7 +30,14 @@ type SwapMetadata struct {
 		AmountOut sdkmath.Int                         `json:"amount_out"`
 		Change    *packetforwardtypes.ForwardMetadata `json:"change,omitempty"`
 	} `json:"exact_amount_out,omitempty"`
-	Forward *packetforwardtypes.ForwardMetadata `json:"forward,omitempty"`
+	Forward   *packetforwardtypes.ForwardMetadata `json:"forward,omitempty"`
+	OnFailure string                              `json:"on_failure,omitempty"`
+}
+
+// RefundOnFailure returns true if the tokens must be returned to the sender on
+// the source chain when the swap or its change or forward fails.
+func (m *SwapMetadata) RefundOnFailure() bool {
+	return m.OnFailure == OnFailureRefund
 }
 
 func (m *SwapMetadata) Validate() error {
@@ -63,6 +73,10 @@ func (m *SwapMetadata) Validate() error {
 		}
 	}
 
+	if m.OnFailure != "" && m.OnFailure != OnFailureRefund {
+		return fmt.Errorf("invalid on_failure: %s", m.OnFailure)
+	}
+
 	return nil
 }
 
@@ -104,6 +104,8 @@ type IncomingInFlightPacket struct {
 	//	*IncomingInFlightPacket_OutgoingIndexForward
 	//	*IncomingInFlightPacket_AckForward
 	Forward isIncomingInFlightPacket_Forward `protobuf_oneof:"forward"`
+	// Height of the block in which the packet was received
+	CreatedHeight int64 `protobuf:"varint,14,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
 }
 
 func (m *IncomingInFlightPacket) Reset()         { *m = IncomingInFlightPacket{} }
@@ -265,6 +267,13 @@ func (m *IncomingInFlightPacket) GetAckForward() []byte {
 	return nil
 }
 
+func (m *IncomingInFlightPacket) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*IncomingInFlightPacket) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -279,6 +288,8 @@ type OutgoingInFlightPacket struct {
 	Index            PacketIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index"`
 	AckWaitingIndex  PacketIndex `protobuf:"bytes,2,opt,name=ack_waiting_index,json=ackWaitingIndex,proto3" json:"ack_waiting_index"`
 	RetriesRemaining int32       `protobuf:"varint,3,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// Number of times the packet has been resent
+	ResentCount uint32 `protobuf:"varint,4,opt,name=resent_count,json=resentCount,proto3" json:"resent_count,omitempty"`
 }
 
 func (m *OutgoingInFlightPacket) Reset()         { *m = OutgoingInFlightPacket{} }
@@ -335,6 +346,13 @@ func (m *OutgoingInFlightPacket) GetRetriesRemaining() int32 {
 	return 0
 }
 
+func (m *OutgoingInFlightPacket) GetResentCount() uint32 {
+	if m != nil {
+		return m.ResentCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PacketIndex)(nil), "sunrise.swap.PacketIndex")
 	proto.RegisterType((*IncomingInFlightPacket)(nil), "sunrise.swap.IncomingInFlightPacket")
@@ -346,48 +364,50 @@ func init() {
 }
 
 var fileDescriptor_80eca52a3b33713a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0x5f, 0x9b, 0xb4, 0xb9, 0x49, 0xfb, 0x9a, 0x79, 0x6d, 0x9f, 0x5b, 0xe9, 0xa5, 0x7d,
-	0x05, 0xa4, 0x8a, 0x82, 0x8d, 0x60, 0x87, 0x58, 0xa5, 0x52, 0x54, 0xaf, 0x5a, 0x2c, 0x10, 0x12,
-	0x1b, 0x6b, 0x3a, 0x9e, 0x38, 0xa3, 0xc4, 0x33, 0x61, 0x66, 0xac, 0xb6, 0x7f, 0xc1, 0x57, 0x20,
-	0x96, 0x2c, 0xf8, 0x88, 0x2e, 0x2b, 0xd8, 0x20, 0x16, 0x15, 0x6a, 0x17, 0xfc, 0x06, 0x9a, 0x19,
-	0x3b, 0x4a, 0x11, 0x52, 0x59, 0xb0, 0x49, 0xe6, 0xde, 0x7b, 0xee, 0x3d, 0xe7, 0xd8, 0xd7, 0x03,
-	0x77, 0x54, 0xc1, 0x25, 0x53, 0x34, 0x54, 0x27, 0x78, 0x12, 0x32, 0x9e, 0x0c, 0xc6, 0x2c, 0x1b,
-	0xea, 0x64, 0x82, 0xc9, 0x88, 0xea, 0x60, 0x22, 0x85, 0x16, 0xa8, 0x5d, 0x82, 0x02, 0x03, 0xda,
-	0xec, 0xe0, 0x9c, 0x71, 0x11, 0xda, 0x5f, 0x07, 0xd8, 0xdc, 0x20, 0x42, 0xe5, 0x42, 0x25, 0x36,
-	0x0a, 0x5d, 0x50, 0x96, 0x56, 0x33, 0x91, 0x09, 0x97, 0x37, 0xa7, 0x32, 0xeb, 0xdf, 0xa0, 0x95,
-	0xa2, 0xd0, 0xd4, 0x55, 0x76, 0x30, 0xb4, 0x8e, 0x2c, 0x77, 0xc4, 0x53, 0x7a, 0x8a, 0xfe, 0x85,
-	0x85, 0x89, 0x90, 0x3a, 0x61, 0xa9, 0xef, 0x6d, 0x7b, 0xbb, 0xcd, 0xb8, 0x61, 0xc2, 0x28, 0x45,
-	0xff, 0x01, 0x90, 0x21, 0xe6, 0x9c, 0x8e, 0x4d, 0xed, 0x2f, 0x5b, 0x6b, 0x96, 0x99, 0x28, 0x45,
-	0x9b, 0xb0, 0xa8, 0xe8, 0x9b, 0x82, 0x72, 0x42, 0xfd, 0xb9, 0x6d, 0x6f, 0x77, 0x3e, 0x9e, 0xc6,
-	0x3b, 0xef, 0xea, 0xb0, 0x1e, 0x71, 0x22, 0x72, 0xc6, 0xb3, 0x88, 0xf7, 0xad, 0x61, 0xc7, 0x89,
-	0x9e, 0x42, 0x9d, 0x19, 0x5e, 0x4b, 0xd6, 0x7a, 0xbc, 0x11, 0xcc, 0x3a, 0x0f, 0x66, 0x84, 0xf5,
-	0x9a, 0xe7, 0x97, 0x5b, 0xb5, 0xf7, 0xdf, 0x3f, 0xdc, 0xf7, 0x62, 0xd7, 0x82, 0x10, 0xcc, 0xa7,
-	0x58, 0x63, 0xab, 0xa5, 0x1d, 0xdb, 0x33, 0xea, 0x42, 0x4b, 0x49, 0x92, 0x54, 0x16, 0xe6, 0x9c,
-	0x4c, 0x25, 0xc9, 0x91, 0x73, 0x71, 0x17, 0x96, 0x4d, 0x7d, 0xc6, 0xc9, 0xbc, 0x85, 0xb4, 0x95,
-	0x24, 0xfb, 0x53, 0x33, 0xf7, 0x60, 0x59, 0xb3, 0x9c, 0x8a, 0x42, 0x27, 0x43, 0x6a, 0xd4, 0xfa,
-	0x75, 0x8b, 0x5a, 0x2a, 0xb3, 0x07, 0x36, 0x89, 0xf6, 0xa0, 0x53, 0xc1, 0xcc, 0xbf, 0xd2, 0x38,
-	0x9f, 0xf8, 0x0d, 0x6b, 0x7e, 0xa5, 0x2c, 0xbc, 0xa8, 0xf2, 0x68, 0x05, 0xe6, 0x30, 0x19, 0xf9,
-	0x0b, 0x56, 0xac, 0x39, 0xa2, 0x67, 0xd0, 0x90, 0x54, 0x15, 0x63, 0xed, 0x2f, 0xfe, 0xca, 0x7c,
-	0x6c, 0x5e, 0x52, 0x6c, 0x01, 0xb3, 0xe6, 0xcb, 0x1e, 0xf4, 0x12, 0x96, 0x18, 0xd7, 0x54, 0x0e,
-	0x30, 0xa1, 0xc9, 0x80, 0x52, 0xbf, 0x69, 0x24, 0xf6, 0x1e, 0x19, 0xe4, 0xd7, 0xcb, 0xad, 0x35,
-	0xb7, 0x14, 0x2a, 0x1d, 0x05, 0x4c, 0x84, 0x39, 0xd6, 0xc3, 0x20, 0xe2, 0xfa, 0xd3, 0xc7, 0x87,
-	0x50, 0x6e, 0x4b, 0xc4, 0xb5, 0x1b, 0xd8, 0x9e, 0x8e, 0xe9, 0x53, 0x8a, 0x0e, 0x61, 0x4d, 0x14,
-	0x3a, 0x13, 0x8c, 0x67, 0x89, 0x7d, 0xcc, 0xf6, 0x59, 0x65, 0xd4, 0x87, 0x5b, 0x5e, 0xd0, 0x41,
-	0x2d, 0xfe, 0xa7, 0xea, 0xb4, 0x89, 0x7d, 0xdb, 0x87, 0xb6, 0x00, 0x30, 0x19, 0x55, 0x53, 0x5a,
-	0xc6, 0xfe, 0x41, 0x2d, 0x6e, 0x62, 0x32, 0x2a, 0x01, 0xcf, 0x61, 0xfd, 0x27, 0xc6, 0x81, 0x90,
-	0x27, 0x58, 0xa6, 0x7e, 0xfb, 0x36, 0x4a, 0x2f, 0x5e, 0xbd, 0x41, 0xd9, 0x77, 0x8d, 0xe8, 0x7f,
-	0x68, 0x19, 0xce, 0x6a, 0xce, 0x92, 0x25, 0xf5, 0x62, 0x23, 0xa4, 0x84, 0xf4, 0x16, 0xa1, 0xe1,
-	0x24, 0xf5, 0x9a, 0xb0, 0x50, 0x02, 0x77, 0x3e, 0x7b, 0xb0, 0x7e, 0x38, 0x1d, 0xf8, 0xc7, 0x16,
-	0xf5, 0x08, 0x3a, 0x46, 0xce, 0x09, 0x66, 0x7a, 0x6a, 0xd2, 0x6e, 0xed, 0xef, 0xce, 0xf9, 0x1b,
-	0x93, 0xd1, 0x2b, 0xd7, 0xed, 0xbe, 0xd2, 0x3d, 0xe8, 0x48, 0xaa, 0x25, 0xa3, 0x2a, 0x91, 0x34,
-	0xc7, 0x8c, 0x33, 0x9e, 0xd9, 0x65, 0xaf, 0xc7, 0x2b, 0x65, 0x21, 0xae, 0xf2, 0xbd, 0xfe, 0xf9,
-	0x55, 0xd7, 0xbb, 0xb8, 0xea, 0x7a, 0xdf, 0xae, 0xba, 0xde, 0xdb, 0xeb, 0x6e, 0xed, 0xe2, 0xba,
-	0x5b, 0xfb, 0x72, 0xdd, 0xad, 0xbd, 0x7e, 0x90, 0x31, 0x3d, 0x2c, 0x8e, 0x03, 0x22, 0xf2, 0xb0,
-	0xd4, 0x31, 0xc6, 0x67, 0x54, 0x56, 0x41, 0x78, 0xea, 0xee, 0x0b, 0x7d, 0x36, 0xa1, 0xea, 0xb8,
-	0x61, 0x2f, 0x8c, 0x27, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xa6, 0xe4, 0xe0, 0xc3, 0x04,
-	0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0x36, 0x4d, 0xda, 0xbc, 0xf9, 0xb0, 0x19, 0xdb, 0xba, 0x2d, 0x98, 0x7e, 0x68, 0xa1,
+	0x58, 0x4d, 0x44, 0x6f, 0xe2, 0x29, 0x85, 0xd0, 0x9c, 0x5a, 0x17, 0x45, 0xf0, 0xb2, 0x4c, 0x67,
+	0x27, 0x9b, 0x21, 0xd9, 0x99, 0x38, 0x33, 0x4b, 0xdb, 0x7f, 0xe1, 0xcf, 0xf0, 0xe8, 0xc1, 0x1f,
+	0xd1, 0x8b, 0x50, 0x3c, 0x89, 0x87, 0x22, 0xed, 0xc1, 0x7f, 0xe0, 0x59, 0x66, 0x66, 0x37, 0xa4,
+	0x22, 0x14, 0xc1, 0x4b, 0x32, 0xef, 0xf3, 0x7e, 0x3c, 0xcf, 0x33, 0x1f, 0x0b, 0x0f, 0x54, 0xca,
+	0x25, 0x53, 0xb4, 0xa3, 0x4e, 0xf0, 0xa4, 0xc3, 0x78, 0x38, 0x18, 0xb3, 0x78, 0xa8, 0xc3, 0x09,
+	0x26, 0x23, 0xaa, 0xdb, 0x13, 0x29, 0xb4, 0x40, 0xb5, 0xac, 0xa8, 0x6d, 0x8a, 0xd6, 0x9b, 0x38,
+	0x61, 0x5c, 0x74, 0xec, 0xaf, 0x2b, 0x58, 0x5f, 0x23, 0x42, 0x25, 0x42, 0x85, 0x36, 0xea, 0xb8,
+	0x20, 0x4b, 0x2d, 0xc7, 0x22, 0x16, 0x0e, 0x37, 0xab, 0x0c, 0xf5, 0x6f, 0xd0, 0x4a, 0x91, 0x6a,
+	0xea, 0x32, 0xdb, 0x18, 0xaa, 0x47, 0x96, 0xbb, 0xcf, 0x23, 0x7a, 0x8a, 0xee, 0xc1, 0xc2, 0x44,
+	0x48, 0x1d, 0xb2, 0xc8, 0xf7, 0x36, 0xbd, 0xdd, 0x4a, 0x50, 0x36, 0x61, 0x3f, 0x42, 0xf7, 0x01,
+	0xc8, 0x10, 0x73, 0x4e, 0xc7, 0x26, 0x37, 0x67, 0x73, 0x95, 0x0c, 0xe9, 0x47, 0x68, 0x1d, 0x16,
+	0x15, 0x7d, 0x9f, 0x52, 0x4e, 0xa8, 0x5f, 0xdc, 0xf4, 0x76, 0xe7, 0x83, 0x69, 0xbc, 0xfd, 0xa5,
+	0x04, 0xab, 0x7d, 0x4e, 0x44, 0xc2, 0x78, 0xdc, 0xe7, 0x3d, 0x6b, 0xd8, 0x71, 0xa2, 0x17, 0x50,
+	0x62, 0x86, 0xd7, 0x92, 0x55, 0x9f, 0xad, 0xb5, 0x67, 0x9d, 0xb7, 0x67, 0x84, 0x75, 0x2b, 0xe7,
+	0x97, 0x1b, 0x85, 0x8f, 0x3f, 0x3f, 0x3d, 0xf2, 0x02, 0xd7, 0x82, 0x10, 0xcc, 0x47, 0x58, 0x63,
+	0xab, 0xa5, 0x16, 0xd8, 0x35, 0x6a, 0x41, 0x55, 0x49, 0x12, 0xe6, 0x16, 0x8a, 0x4e, 0xa6, 0x92,
+	0xe4, 0xc8, 0xb9, 0x78, 0x08, 0x0d, 0x93, 0x9f, 0x71, 0x32, 0x6f, 0x4b, 0x6a, 0x4a, 0x92, 0xfd,
+	0xa9, 0x99, 0x1d, 0x68, 0x68, 0x96, 0x50, 0x91, 0xea, 0x70, 0x48, 0x8d, 0x5a, 0xbf, 0x64, 0xab,
+	0xea, 0x19, 0x7a, 0x60, 0x41, 0xb4, 0x07, 0xcd, 0xbc, 0xcc, 0xfc, 0x2b, 0x8d, 0x93, 0x89, 0x5f,
+	0xb6, 0xe6, 0x97, 0xb2, 0xc4, 0xeb, 0x1c, 0x47, 0x4b, 0x50, 0xc4, 0x64, 0xe4, 0x2f, 0x58, 0xb1,
+	0x66, 0x89, 0x5e, 0x42, 0x59, 0x52, 0x95, 0x8e, 0xb5, 0xbf, 0xf8, 0x37, 0xf3, 0x81, 0x39, 0xa4,
+	0xc0, 0x16, 0xcc, 0x9a, 0xcf, 0x7a, 0xd0, 0x1b, 0xa8, 0x33, 0xae, 0xa9, 0x1c, 0x60, 0x42, 0xc3,
+	0x01, 0xa5, 0x7e, 0xc5, 0x48, 0xec, 0x3e, 0x35, 0x95, 0xdf, 0x2f, 0x37, 0x56, 0xdc, 0xa5, 0x50,
+	0xd1, 0xa8, 0xcd, 0x44, 0x27, 0xc1, 0x7a, 0xd8, 0xee, 0x73, 0xfd, 0xf5, 0xf3, 0x13, 0xc8, 0x6e,
+	0x4b, 0x9f, 0x6b, 0x37, 0xb0, 0x36, 0x1d, 0xd3, 0xa3, 0x14, 0x1d, 0xc2, 0x8a, 0x48, 0x75, 0x2c,
+	0x18, 0x8f, 0x43, 0xbb, 0xcd, 0x76, 0xaf, 0x62, 0xea, 0xc3, 0x2d, 0x07, 0x74, 0x50, 0x08, 0xee,
+	0xe6, 0x9d, 0x16, 0xd8, 0xb7, 0x7d, 0x68, 0x03, 0x00, 0x93, 0x51, 0x3e, 0xa5, 0x6a, 0xec, 0x1f,
+	0x14, 0x82, 0x0a, 0x26, 0xa3, 0xac, 0xe0, 0x15, 0xac, 0xfe, 0xc1, 0x38, 0x10, 0xf2, 0x04, 0xcb,
+	0xc8, 0xaf, 0xdd, 0x46, 0xe9, 0x05, 0xcb, 0x37, 0x28, 0x7b, 0xae, 0x11, 0x6d, 0x41, 0xd5, 0x70,
+	0xe6, 0x73, 0xea, 0x96, 0xd4, 0x0b, 0x8c, 0x90, 0xbc, 0x64, 0x07, 0x1a, 0x44, 0x52, 0xac, 0x69,
+	0x94, 0x1f, 0x71, 0x63, 0xd3, 0xdb, 0x2d, 0x06, 0xf5, 0x0c, 0x75, 0x47, 0xdc, 0x5d, 0x84, 0xb2,
+	0x53, 0xde, 0xad, 0xc0, 0x42, 0x36, 0x6f, 0xfb, 0x97, 0x07, 0xab, 0x87, 0x53, 0xde, 0xff, 0x76,
+	0x9f, 0x8f, 0xa0, 0x69, 0x54, 0x9f, 0x60, 0xa6, 0xa7, 0x7b, 0xe1, 0xcf, 0xfd, 0xc3, 0x9c, 0x3b,
+	0x98, 0x8c, 0xde, 0xba, 0x6e, 0xf7, 0x98, 0xf7, 0xa0, 0x29, 0xa9, 0x96, 0x8c, 0xaa, 0x50, 0xd2,
+	0x04, 0x33, 0xce, 0x78, 0x6c, 0xdf, 0x44, 0x29, 0x58, 0xca, 0x12, 0x41, 0x8e, 0xa3, 0x2d, 0xa8,
+	0x49, 0xaa, 0x28, 0xd7, 0x21, 0x11, 0x29, 0xd7, 0xf6, 0x61, 0xd4, 0x83, 0xaa, 0xc3, 0xf6, 0x0d,
+	0xd4, 0xed, 0x9d, 0x5f, 0xb5, 0xbc, 0x8b, 0xab, 0x96, 0xf7, 0xe3, 0xaa, 0xe5, 0x7d, 0xb8, 0x6e,
+	0x15, 0x2e, 0xae, 0x5b, 0x85, 0x6f, 0xd7, 0xad, 0xc2, 0xbb, 0xc7, 0x31, 0xd3, 0xc3, 0xf4, 0xb8,
+	0x4d, 0x44, 0xd2, 0xc9, 0xa4, 0x8e, 0xf1, 0x19, 0x95, 0x79, 0xd0, 0x39, 0x75, 0x5f, 0x1e, 0x7d,
+	0x36, 0xa1, 0xea, 0xb8, 0x6c, 0x3f, 0x3d, 0xcf, 0x7f, 0x0f, 0x00, 0x14, 0x5b, 0x0a, 0x90, 0x0d,
+	0x05, 0x00, 0x00,
 }
 
 func (m *PacketIndex) Marshal() (dAtA []byte, err error) {
@@ -452,6 +472,11 @@ func (m *IncomingInFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintInFlightPacket(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Forward != nil {
 		{
 			size := m.Forward.Size()
@@ -637,6 +662,11 @@ func (m *OutgoingInFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ResentCount != 0 {
+		i = encodeVarintInFlightPacket(dAtA, i, uint64(m.ResentCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RetriesRemaining != 0 {
 		i = encodeVarintInFlightPacket(dAtA, i, uint64(m.RetriesRemaining))
 		i--
@@ -737,6 +767,9 @@ func (m *IncomingInFlightPacket) Size() (n int) {
 	if m.Forward != nil {
 		n += m.Forward.Size()
 	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovInFlightPacket(uint64(m.CreatedHeight))
+	}
 	return n
 }
 
@@ -801,6 +834,9 @@ func (m *OutgoingInFlightPacket) Size() (n int) {
 	if m.RetriesRemaining != 0 {
 		n += 1 + sovInFlightPacket(uint64(m.RetriesRemaining))
 	}
+	if m.ResentCount != 0 {
+		n += 1 + sovInFlightPacket(uint64(m.ResentCount))
+	}
 	return n
 }
 
@@ -1391,6 +1427,25 @@ func (m *IncomingInFlightPacket) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Forward = &IncomingInFlightPacket_AckForward{v}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInFlightPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInFlightPacket(dAtA[iNdEx:])
@@ -1526,6 +1581,25 @@ func (m *OutgoingInFlightPacket) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResentCount", wireType)
+			}
+			m.ResentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInFlightPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResentCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInFlightPacket(dAtA[iNdEx:])
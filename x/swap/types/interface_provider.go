@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxFeeShares is the max number of addresses an interface provider can share
+// its fees with, bounding the transfers of each swap
+const MaxFeeShares = 10
+
+// Validate validates an interface provider, its fee rate being checked against
+// the max interface fee rate param apart from it
+func (p InterfaceProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid interface provider address (%s)", err)
+	}
+	if p.FeeRate != nil {
+		if err := validateInterfaceFeeRate(*p.FeeRate); err != nil {
+			return errorsmod.Wrap(ErrInvalidFeeRate, err.Error())
+		}
+	}
+	return ValidateFeeShares(p.FeeShares)
+}
+
+// ValidateFeeShares validates the fee shares of an interface provider, whose
+// weights must be positive and sum up to 1 at most
+func ValidateFeeShares(shares []FeeShare) error {
+	if len(shares) > MaxFeeShares {
+		return errorsmod.Wrapf(ErrInvalidFeeShares, "%d fee shares, max is %d", len(shares), MaxFeeShares)
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(shares))
+	for _, share := range shares {
+		if _, err := sdk.AccAddressFromBech32(share.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeeShares, "invalid fee share address %s: %s", share.Address, err)
+		}
+		if seen[share.Address] {
+			return errorsmod.Wrapf(ErrInvalidFeeShares, "duplicate fee share address: %s", share.Address)
+		}
+		seen[share.Address] = true
+
+		if share.Weight.IsNil() || !share.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidFeeShares, "fee share weight must be positive: %s", share.Weight)
+		}
+		total = total.Add(share.Weight)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidFeeShares, "fee share weights sum up to more than 1: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,1067 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/swap/interface_provider.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterfaceProvider is an interface provider registered on chain, e.g. a
+// wallet or an aggregator, receiving the interface fee of the swaps it routes.
+type InterfaceProvider struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata describes the provider, e.g. its name and website
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// fee_rate is the interface fee rate approved by governance for the
+	// provider. The interface_fee_rate param applies if it is not set.
+	FeeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate,omitempty"`
+	// fee_shares split the interface fee among other addresses, the provider
+	// receiving the rest of it
+	FeeShares []FeeShare `protobuf:"bytes,4,rep,name=fee_shares,json=feeShares,proto3" json:"fee_shares"`
+}
+
+func (m *InterfaceProvider) Reset()         { *m = InterfaceProvider{} }
+func (m *InterfaceProvider) String() string { return proto.CompactTextString(m) }
+func (*InterfaceProvider) ProtoMessage()    {}
+func (*InterfaceProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d9cd9af52b505d1, []int{0}
+}
+func (m *InterfaceProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterfaceProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterfaceProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterfaceProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceProvider.Merge(m, src)
+}
+func (m *InterfaceProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterfaceProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceProvider proto.InternalMessageInfo
+
+func (m *InterfaceProvider) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InterfaceProvider) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *InterfaceProvider) GetFeeShares() []FeeShare {
+	if m != nil {
+		return m.FeeShares
+	}
+	return nil
+}
+
+// FeeShare is the share of the interface fee of a provider sent to an address.
+type FeeShare struct {
+	Address string                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *FeeShare) Reset()         { *m = FeeShare{} }
+func (m *FeeShare) String() string { return proto.CompactTextString(m) }
+func (*FeeShare) ProtoMessage()    {}
+func (*FeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d9cd9af52b505d1, []int{1}
+}
+func (m *FeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShare.Merge(m, src)
+}
+func (m *FeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeShare proto.InternalMessageInfo
+
+func (m *FeeShare) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// InterfaceProviderStats is the cumulative volume and fees of the swaps routed
+// by an interface provider.
+type InterfaceProviderStats struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// volume is the sum of the input tokens of the swaps
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+	// fees is the sum of the interface fees of the swaps
+	Fees      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	SwapCount uint64                                   `protobuf:"varint,4,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+}
+
+func (m *InterfaceProviderStats) Reset()         { *m = InterfaceProviderStats{} }
+func (m *InterfaceProviderStats) String() string { return proto.CompactTextString(m) }
+func (*InterfaceProviderStats) ProtoMessage()    {}
+func (*InterfaceProviderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d9cd9af52b505d1, []int{2}
+}
+func (m *InterfaceProviderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterfaceProviderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterfaceProviderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterfaceProviderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceProviderStats.Merge(m, src)
+}
+func (m *InterfaceProviderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterfaceProviderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceProviderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceProviderStats proto.InternalMessageInfo
+
+func (m *InterfaceProviderStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InterfaceProviderStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *InterfaceProviderStats) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *InterfaceProviderStats) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InterfaceProvider)(nil), "sunrise.swap.InterfaceProvider")
+	proto.RegisterType((*FeeShare)(nil), "sunrise.swap.FeeShare")
+	proto.RegisterType((*InterfaceProviderStats)(nil), "sunrise.swap.InterfaceProviderStats")
+}
+
+func init() {
+	proto.RegisterFile("sunrise/swap/interface_provider.proto", fileDescriptor_8d9cd9af52b505d1)
+}
+
+var fileDescriptor_8d9cd9af52b505d1 = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x34, 0x21, 0x4d, 0x46, 0x2f, 0x5d, 0x4a, 0xd9, 0x46, 0xdc, 0x84, 0x80, 0x10, 0xc4,
+	0xcc, 0x90, 0x8a, 0x9e, 0x35, 0x2d, 0x05, 0xa1, 0x88, 0x6c, 0x6e, 0x5e, 0xc2, 0x64, 0xf7, 0xed,
+	0xee, 0xd0, 0xee, 0x4e, 0x98, 0x99, 0xa4, 0xe6, 0x4f, 0x88, 0x3f, 0x43, 0x3c, 0x79, 0xe8, 0xcd,
+	0x3f, 0x90, 0x63, 0xe9, 0x49, 0x3c, 0x44, 0x49, 0x0e, 0xfe, 0x00, 0xff, 0x80, 0xcc, 0xce, 0x44,
+	0x0a, 0x9e, 0x2c, 0x78, 0xd9, 0x9d, 0xf7, 0xde, 0x37, 0xdf, 0xf7, 0xbd, 0x99, 0x37, 0xf8, 0x91,
+	0x9a, 0x15, 0x92, 0x2b, 0xa0, 0xea, 0x92, 0x4d, 0x29, 0x2f, 0x34, 0xc8, 0x84, 0x45, 0x30, 0x9e,
+	0x4a, 0x31, 0xe7, 0x31, 0x48, 0x32, 0x95, 0x42, 0x0b, 0xef, 0xbe, 0x83, 0x11, 0x03, 0x6b, 0xed,
+	0xb1, 0x9c, 0x17, 0x82, 0x96, 0x5f, 0x0b, 0x68, 0x05, 0x91, 0x50, 0xb9, 0x50, 0x74, 0xc2, 0x14,
+	0xd0, 0xf9, 0x60, 0x02, 0x9a, 0x0d, 0x68, 0x24, 0x78, 0xe1, 0xea, 0x87, 0xb6, 0x3e, 0x2e, 0x23,
+	0x6a, 0x03, 0x57, 0xda, 0x4f, 0x45, 0x2a, 0x6c, 0xde, 0xac, 0x6c, 0xb6, 0xfb, 0x0b, 0xe1, 0xbd,
+	0x57, 0x5b, 0x3b, 0x6f, 0x9c, 0x1b, 0xef, 0x08, 0xef, 0xb2, 0x38, 0x96, 0xa0, 0x94, 0x8f, 0x3a,
+	0xa8, 0xd7, 0x1c, 0xfa, 0x37, 0x57, 0xfd, 0x7d, 0x47, 0xf7, 0xd2, 0x56, 0x46, 0x5a, 0xf2, 0x22,
+	0x0d, 0xb7, 0x40, 0xaf, 0x85, 0x1b, 0x39, 0x68, 0x16, 0x33, 0xcd, 0xfc, 0x1d, 0xb3, 0x29, 0xfc,
+	0x13, 0x7b, 0x67, 0xb8, 0x91, 0x00, 0x8c, 0x25, 0xd3, 0xe0, 0x57, 0x4b, 0xc2, 0xc1, 0x72, 0xd5,
+	0x46, 0xdf, 0x56, 0xed, 0x07, 0x96, 0x54, 0xc5, 0xe7, 0x84, 0x0b, 0x9a, 0x33, 0x9d, 0x91, 0x33,
+	0x48, 0x59, 0xb4, 0x38, 0x81, 0xe8, 0xe6, 0xaa, 0x8f, 0x9d, 0xe6, 0x09, 0x44, 0xe1, 0x6e, 0x02,
+	0x10, 0x32, 0x0d, 0xde, 0x0b, 0x8c, 0x0d, 0x9b, 0xca, 0x98, 0x04, 0xe5, 0xd7, 0x3a, 0xd5, 0xde,
+	0xbd, 0xa3, 0x03, 0x72, 0xfb, 0xe8, 0xc8, 0x29, 0xc0, 0xc8, 0x94, 0x87, 0xcd, 0xe5, 0xaa, 0x5d,
+	0xf9, 0xf8, 0xf3, 0xf3, 0x63, 0x14, 0x36, 0x13, 0x97, 0x54, 0xdd, 0xf7, 0x08, 0x37, 0xb6, 0x90,
+	0x3b, 0x35, 0xfb, 0x1a, 0xd7, 0x2f, 0x81, 0xa7, 0x99, 0xb6, 0xad, 0x0e, 0x9f, 0x1b, 0x99, 0x7f,
+	0x6a, 0xc7, 0x7a, 0x72, 0x2c, 0xdd, 0x2f, 0x3b, 0xf8, 0xe0, 0xaf, 0x6b, 0x18, 0x69, 0xa6, 0xd5,
+	0x9d, 0xec, 0x65, 0xb8, 0x3e, 0x17, 0x17, 0xb3, 0x1c, 0xfc, 0x9d, 0xf2, 0x74, 0x0e, 0x89, 0xc3,
+	0x9b, 0xb9, 0x21, 0x6e, 0x6e, 0xc8, 0xb1, 0xe0, 0xc5, 0xf0, 0x99, 0x71, 0xfe, 0xe9, 0x7b, 0xbb,
+	0x97, 0x72, 0x9d, 0xcd, 0x26, 0x24, 0x12, 0xb9, 0x9b, 0x1b, 0xf7, 0xeb, 0xab, 0xf8, 0x9c, 0xea,
+	0xc5, 0x14, 0x54, 0xb9, 0x41, 0x39, 0xe3, 0x96, 0xdf, 0x8b, 0x71, 0x2d, 0x01, 0x50, 0x7e, 0xf5,
+	0x3f, 0xe9, 0x94, 0xec, 0xde, 0x43, 0x8c, 0xcd, 0xb5, 0x8e, 0x23, 0x31, 0x2b, 0xb4, 0x5f, 0xeb,
+	0xa0, 0x5e, 0x2d, 0x6c, 0x9a, 0xcc, 0xb1, 0x49, 0x0c, 0x4f, 0x97, 0xeb, 0x00, 0x5d, 0xaf, 0x03,
+	0xf4, 0x63, 0x1d, 0xa0, 0x0f, 0x9b, 0xa0, 0x72, 0xbd, 0x09, 0x2a, 0x5f, 0x37, 0x41, 0xe5, 0xed,
+	0x93, 0x5b, 0x6a, 0x6e, 0x40, 0x2e, 0xd8, 0x02, 0xe4, 0x36, 0xa0, 0xef, 0xec, 0x8b, 0x2c, 0x75,
+	0x27, 0xf5, 0xf2, 0x4d, 0x3c, 0xfd, 0x3d, 0x00, 0x0a, 0x90, 0xf5, 0x09, 0xae, 0x03, 0x00, 0x00,
+}
+
+func (m *InterfaceProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterfaceProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterfaceProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeShares) > 0 {
+		for iNdEx := len(m.FeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterfaceProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FeeRate != nil {
+		{
+			size := m.FeeRate.Size()
+			i -= size
+			if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintInterfaceProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterfaceProviderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterfaceProviderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterfaceProviderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterfaceProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterfaceProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterfaceProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterfaceProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterfaceProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterfaceProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterfaceProvider(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovInterfaceProvider(uint64(l))
+	}
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 1 + l + sovInterfaceProvider(uint64(l))
+	}
+	if len(m.FeeShares) > 0 {
+		for _, e := range m.FeeShares {
+			l = e.Size()
+			n += 1 + l + sovInterfaceProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterfaceProvider(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInterfaceProvider(uint64(l))
+	return n
+}
+
+func (m *InterfaceProviderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterfaceProvider(uint64(l))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovInterfaceProvider(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovInterfaceProvider(uint64(l))
+		}
+	}
+	if m.SwapCount != 0 {
+		n += 1 + sovInterfaceProvider(uint64(m.SwapCount))
+	}
+	return n
+}
+
+func sovInterfaceProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterfaceProvider(x uint64) (n int) {
+	return sovInterfaceProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterfaceProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterfaceProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterfaceProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterfaceProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.FeeRate = &v
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeShares = append(m.FeeShares, FeeShare{})
+			if err := m.FeeShares[len(m.FeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterfaceProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterfaceProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterfaceProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterfaceProviderStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterfaceProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterfaceProviderStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterfaceProviderStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterfaceProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterfaceProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterfaceProvider(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterfaceProvider
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterfaceProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterfaceProvider
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterfaceProvider
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterfaceProvider
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterfaceProvider        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterfaceProvider          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterfaceProvider = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,11 +2,13 @@ package types
 
 import "encoding/binary"
 
-var _ binary.ByteOrder
-
 const (
 	// IncomingInFlightPacketKeyPrefix is the prefix to retrieve all IncomingInFlightPacket
 	IncomingInFlightPacketKeyPrefix = "IncomingInFlightPacket/value/"
+
+	// IncomingInFlightPacketByHeightKeyPrefix is the prefix to retrieve the
+	// IncomingInFlightPacket indexes by created height
+	IncomingInFlightPacketByHeightKeyPrefix = "IncomingInFlightPacketByHeight/value/"
 )
 
 // IncomingInFlightPacketKey returns the store key to retrieve a IncomingInFlightPacket from the index fields
@@ -27,3 +29,17 @@ func IncomingInFlightPacketKey(
 	}
 	return append([]byte(IncomingInFlightPacketKeyPrefix), bz...)
 }
+
+// IncomingInFlightPacketByHeightKey returns the store key indexing a
+// IncomingInFlightPacket by its created height, relative to
+// IncomingInFlightPacketByHeightKeyPrefix
+func IncomingInFlightPacketByHeightKey(
+	createdHeight int64,
+	index PacketIndex,
+) []byte {
+	bz, err := index.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return append(binary.BigEndian.AppendUint64(nil, uint64(createdHeight)), bz...)
+}
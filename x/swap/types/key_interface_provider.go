@@ -0,0 +1,31 @@
+package types
+
+const (
+	// InterfaceProviderKeyPrefix is the prefix to retrieve all InterfaceProvider
+	InterfaceProviderKeyPrefix = "InterfaceProvider/value/"
+
+	// InterfaceProviderStatsKeyPrefix is the prefix to retrieve all InterfaceProviderStats
+	InterfaceProviderStatsKeyPrefix = "InterfaceProviderStats/value/"
+)
+
+// InterfaceProviderKey returns the store key to retrieve an InterfaceProvider
+// from its address
+func InterfaceProviderKey(address string) []byte {
+	var key []byte
+
+	key = append(key, []byte(InterfaceProviderKeyPrefix)...)
+	key = append(key, []byte(address)...)
+
+	return key
+}
+
+// InterfaceProviderStatsKey returns the store key to retrieve the
+// InterfaceProviderStats of an interface provider from its address
+func InterfaceProviderStatsKey(address string) []byte {
+	var key []byte
+
+	key = append(key, []byte(InterfaceProviderStatsKeyPrefix)...)
+	key = append(key, []byte(address)...)
+
+	return key
+}
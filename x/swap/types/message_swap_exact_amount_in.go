@@ -50,5 +50,21 @@ func (msg *MsgSwapExactAmountIn) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidAmount, "min amount out must be positive: %s", msg.MinAmountOut)
 	}
 
+	return validatePriceLimit(msg.PriceLimit, msg.Route)
+}
+
+// validatePriceLimit checks that the price limit of a swap, if any, is positive
+// and applies to a route of a single pool, the price of which it limits
+func validatePriceLimit(priceLimit *sdkmath.LegacyDec, route Route) error {
+	if priceLimit == nil {
+		return nil
+	}
+	if !priceLimit.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidPriceLimit, "price limit must be positive: %s", priceLimit)
+	}
+	if _, ok := route.Strategy.(*Route_Pool); !ok {
+		return errorsmod.Wrap(ErrInvalidPriceLimit, "price limit is only available for a route of a single pool")
+	}
+
 	return nil
 }
@@ -10,6 +10,8 @@ import (
 )
 
 func TestMsgSwapExactAmountIn_ValidateBasic(t *testing.T) {
+	priceLimit := math.LegacyMustNewDecFromStr("0.9")
+	zeroPriceLimit := math.LegacyZeroDec()
 	tests := []struct {
 		name string
 		msg  MsgSwapExactAmountIn
@@ -50,6 +52,63 @@ func TestMsgSwapExactAmountIn_ValidateBasic(t *testing.T) {
 				AmountIn:     math.NewInt(1000000),
 				MinAmountOut: math.NewInt(1000000),
 			},
+		}, {
+			name: "non positive price limit",
+			msg: MsgSwapExactAmountIn{
+				Sender: sample.AccAddress(),
+				Route: Route{
+					DenomIn:  "base",
+					DenomOut: "quote",
+					Strategy: &Route_Pool{
+						Pool: &RoutePool{
+							PoolId: 1,
+						},
+					},
+				},
+				AmountIn:     math.NewInt(1000000),
+				MinAmountOut: math.NewInt(1000000),
+				PriceLimit:   &zeroPriceLimit,
+			},
+			err: ErrInvalidPriceLimit,
+		}, {
+			name: "price limit on a route of several pools",
+			msg: MsgSwapExactAmountIn{
+				Sender: sample.AccAddress(),
+				Route: Route{
+					DenomIn:  "base",
+					DenomOut: "quote",
+					Strategy: &Route_Parallel{
+						Parallel: &RouteParallel{
+							Routes: []Route{
+								{DenomIn: "base", DenomOut: "quote", Strategy: &Route_Pool{Pool: &RoutePool{PoolId: 1}}},
+								{DenomIn: "base", DenomOut: "quote", Strategy: &Route_Pool{Pool: &RoutePool{PoolId: 2}}},
+							},
+							Weights: []math.LegacyDec{math.LegacyOneDec(), math.LegacyOneDec()},
+						},
+					},
+				},
+				AmountIn:     math.NewInt(1000000),
+				MinAmountOut: math.NewInt(1000000),
+				PriceLimit:   &priceLimit,
+			},
+			err: ErrInvalidPriceLimit,
+		}, {
+			name: "valid price limit",
+			msg: MsgSwapExactAmountIn{
+				Sender: sample.AccAddress(),
+				Route: Route{
+					DenomIn:  "base",
+					DenomOut: "quote",
+					Strategy: &Route_Pool{
+						Pool: &RoutePool{
+							PoolId: 1,
+						},
+					},
+				},
+				AmountIn:     math.NewInt(1000000),
+				MinAmountOut: math.NewInt(1000000),
+				PriceLimit:   &priceLimit,
+			},
 		},
 	}
 	for _, tt := range tests {
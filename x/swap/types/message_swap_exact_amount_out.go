@@ -37,5 +37,6 @@ func (msg *MsgSwapExactAmountOut) ValidateBasic() error {
 	if !msg.AmountOut.IsPositive() {
 		return errorsmod.Wrapf(ErrInvalidAmount, "amount out must be positive: %s", msg.AmountOut)
 	}
-	return nil
+
+	return validatePriceLimit(msg.PriceLimit, msg.Route)
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterInterfaceProvider{}
+
+func NewMsgRegisterInterfaceProvider(provider string, metadata string, feeShares []FeeShare) *MsgRegisterInterfaceProvider {
+	return &MsgRegisterInterfaceProvider{
+		Provider:  provider,
+		Metadata:  metadata,
+		FeeShares: feeShares,
+	}
+}
+
+func (msg *MsgRegisterInterfaceProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Provider); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	return ValidateFeeShares(msg.FeeShares)
+}
+
+var _ sdk.Msg = &MsgUpdateInterfaceProvider{}
+
+func NewMsgUpdateInterfaceProvider(provider string, metadata string, feeShares []FeeShare) *MsgUpdateInterfaceProvider {
+	return &MsgUpdateInterfaceProvider{
+		Provider:  provider,
+		Metadata:  metadata,
+		FeeShares: feeShares,
+	}
+}
+
+func (msg *MsgUpdateInterfaceProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Provider); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	return ValidateFeeShares(msg.FeeShares)
+}
+
+var _ sdk.Msg = &MsgSetInterfaceProviderFeeRate{}
+
+func NewMsgSetInterfaceProviderFeeRate(authority string, provider string, feeRate *math.LegacyDec) *MsgSetInterfaceProviderFeeRate {
+	return &MsgSetInterfaceProviderFeeRate{
+		Authority: authority,
+		Provider:  provider,
+		FeeRate:   feeRate,
+	}
+}
+
+func (msg *MsgSetInterfaceProviderFeeRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Provider); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	if msg.FeeRate != nil {
+		if err := validateInterfaceFeeRate(*msg.FeeRate); err != nil {
+			return errorsmod.Wrap(ErrInvalidFeeRate, err.Error())
+		}
+	}
+	return nil
+}
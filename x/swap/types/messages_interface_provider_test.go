@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/sunriselayer/sunrise/testutil/sample"
+)
+
+func TestMsgRegisterInterfaceProvider_ValidateBasic(t *testing.T) {
+	share := sample.AccAddress()
+	tooManyShares := make([]FeeShare, MaxFeeShares+1)
+	for i := range tooManyShares {
+		tooManyShares[i] = FeeShare{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(1, 2)}
+	}
+	tests := []struct {
+		name string
+		msg  MsgRegisterInterfaceProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRegisterInterfaceProvider{
+				Provider: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "non positive fee share weight",
+			msg: MsgRegisterInterfaceProvider{
+				Provider:  sample.AccAddress(),
+				FeeShares: []FeeShare{{Address: share, Weight: math.LegacyZeroDec()}},
+			},
+			err: ErrInvalidFeeShares,
+		}, {
+			name: "duplicated fee share address",
+			msg: MsgRegisterInterfaceProvider{
+				Provider: sample.AccAddress(),
+				FeeShares: []FeeShare{
+					{Address: share, Weight: math.LegacyNewDecWithPrec(1, 1)},
+					{Address: share, Weight: math.LegacyNewDecWithPrec(1, 1)},
+				},
+			},
+			err: ErrInvalidFeeShares,
+		}, {
+			name: "fee share weights above one",
+			msg: MsgRegisterInterfaceProvider{
+				Provider: sample.AccAddress(),
+				FeeShares: []FeeShare{
+					{Address: share, Weight: math.LegacyNewDecWithPrec(6, 1)},
+					{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+				},
+			},
+			err: ErrInvalidFeeShares,
+		}, {
+			name: "too many fee shares",
+			msg: MsgRegisterInterfaceProvider{
+				Provider:  sample.AccAddress(),
+				FeeShares: tooManyShares,
+			},
+			err: ErrInvalidFeeShares,
+		}, {
+			name: "valid address",
+			msg: MsgRegisterInterfaceProvider{
+				Provider: sample.AccAddress(),
+				FeeShares: []FeeShare{
+					{Address: share, Weight: math.LegacyNewDecWithPrec(5, 1)},
+					{Address: sample.AccAddress(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetInterfaceProviderFeeRate_ValidateBasic(t *testing.T) {
+	rate := math.LegacyNewDecWithPrec(2, 2)
+	negative := math.LegacyNewDecWithPrec(-2, 2)
+	tests := []struct {
+		name string
+		msg  MsgSetInterfaceProviderFeeRate
+		err  error
+	}{
+		{
+			name: "invalid provider address",
+			msg: MsgSetInterfaceProviderFeeRate{
+				Authority: sample.AccAddress(),
+				Provider:  "invalid_address",
+				FeeRate:   &rate,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative fee rate",
+			msg: MsgSetInterfaceProviderFeeRate{
+				Authority: sample.AccAddress(),
+				Provider:  sample.AccAddress(),
+				FeeRate:   &negative,
+			},
+			err: ErrInvalidFeeRate,
+		}, {
+			name: "unset fee rate",
+			msg: MsgSetInterfaceProviderFeeRate{
+				Authority: sample.AccAddress(),
+				Provider:  sample.AccAddress(),
+			},
+		}, {
+			name: "valid address",
+			msg: MsgSetInterfaceProviderFeeRate{
+				Authority: sample.AccAddress(),
+				Provider:  sample.AccAddress(),
+				FeeRate:   &rate,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,35 +1,175 @@
 package types
 
 import (
+	fmt "fmt"
+
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyInterfaceFeeRate                  = []byte("InterfaceFeeRate")
+	DefaultInterfaceFeeRate              = math.LegacyNewDecWithPrec(1, 2) // 1%
+	KeyRetryCount                        = []byte("RetryCount")
+	DefaultRetryCount             uint32 = 3
+	KeyPacketTimeoutSeconds              = []byte("PacketTimeoutSeconds")
+	DefaultPacketTimeoutSeconds   uint64 = 600 // following the IBC transfer default
+	KeyRetryTimeoutMultiplier            = []byte("RetryTimeoutMultiplier")
+	DefaultRetryTimeoutMultiplier        = math.LegacyNewDec(2)
+	KeyMaxInFlightBlocks                 = []byte("MaxInFlightBlocks")
+	DefaultMaxInFlightBlocks      uint64 = 100_800 // about a week with 6s blocks
+	KeyMaxInterfaceFeeRate               = []byte("MaxInterfaceFeeRate")
+	DefaultMaxInterfaceFeeRate           = math.LegacyNewDecWithPrec(5, 2) // 5%
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams(interfaceFeeRate math.LegacyDec) Params {
+func NewParams(
+	interfaceFeeRate math.LegacyDec,
+	retryCount uint32,
+	packetTimeoutSeconds uint64,
+	retryTimeoutMultiplier math.LegacyDec,
+	maxInFlightBlocks uint64,
+	maxInterfaceFeeRate math.LegacyDec,
+) Params {
 	return Params{
-		InterfaceFeeRate: interfaceFeeRate,
+		InterfaceFeeRate:       interfaceFeeRate,
+		RetryCount:             retryCount,
+		PacketTimeoutSeconds:   packetTimeoutSeconds,
+		RetryTimeoutMultiplier: retryTimeoutMultiplier,
+		MaxInFlightBlocks:      maxInFlightBlocks,
+		MaxInterfaceFeeRate:    maxInterfaceFeeRate,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(math.LegacyNewDecWithPrec(1, 2)) // 1%
+	return NewParams(
+		DefaultInterfaceFeeRate,
+		DefaultRetryCount,
+		DefaultPacketTimeoutSeconds,
+		DefaultRetryTimeoutMultiplier,
+		DefaultMaxInFlightBlocks,
+		DefaultMaxInterfaceFeeRate,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyInterfaceFeeRate, &p.InterfaceFeeRate, validateInterfaceFeeRate),
+		paramtypes.NewParamSetPair(KeyRetryCount, &p.RetryCount, validateRetryCount),
+		paramtypes.NewParamSetPair(KeyPacketTimeoutSeconds, &p.PacketTimeoutSeconds, validatePacketTimeoutSeconds),
+		paramtypes.NewParamSetPair(KeyRetryTimeoutMultiplier, &p.RetryTimeoutMultiplier, validateRetryTimeoutMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxInFlightBlocks, &p.MaxInFlightBlocks, validateMaxInFlightBlocks),
+		paramtypes.NewParamSetPair(KeyMaxInterfaceFeeRate, &p.MaxInterfaceFeeRate, validateInterfaceFeeRate),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	err := validateInterfaceFeeRate(p.InterfaceFeeRate)
+	if err != nil {
+		return err
+	}
+	err = validateRetryCount(p.RetryCount)
+	if err != nil {
+		return err
+	}
+	err = validatePacketTimeoutSeconds(p.PacketTimeoutSeconds)
+	if err != nil {
+		return err
+	}
+	err = validateRetryTimeoutMultiplier(p.RetryTimeoutMultiplier)
+	if err != nil {
+		return err
+	}
+	err = validateMaxInFlightBlocks(p.MaxInFlightBlocks)
+	if err != nil {
+		return err
+	}
+	err = validateInterfaceFeeRate(p.MaxInterfaceFeeRate)
+	if err != nil {
+		return err
+	}
+
+	if p.InterfaceFeeRate.GT(p.MaxInterfaceFeeRate) {
+		return fmt.Errorf("interface fee rate %s exceeds the max interface fee rate %s", p.InterfaceFeeRate, p.MaxInterfaceFeeRate)
+	}
+
+	return nil
+}
+
+// validateInterfaceFeeRate validates the InterfaceFeeRate and
+// MaxInterfaceFeeRate params
+func validateInterfaceFeeRate(v interface{}) error {
+	interfaceFeeRate, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if interfaceFeeRate.IsNil() || interfaceFeeRate.IsNegative() || interfaceFeeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("interface fee rate must be between 0 and 1: %s", interfaceFeeRate)
+	}
+
+	return nil
+}
+
+// validateRetryCount validates the RetryCount param
+func validateRetryCount(v interface{}) error {
+	retryCount, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if retryCount == 0 {
+		return fmt.Errorf("retry count cannot be zero")
+	}
+
+	return nil
+}
+
+// validatePacketTimeoutSeconds validates the PacketTimeoutSeconds param
+func validatePacketTimeoutSeconds(v interface{}) error {
+	packetTimeoutSeconds, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if packetTimeoutSeconds == 0 {
+		return fmt.Errorf("packet timeout seconds cannot be zero")
+	}
+
+	return nil
+}
+
+// validateRetryTimeoutMultiplier validates the RetryTimeoutMultiplier param
+func validateRetryTimeoutMultiplier(v interface{}) error {
+	retryTimeoutMultiplier, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if retryTimeoutMultiplier.IsNil() || retryTimeoutMultiplier.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("retry timeout multiplier must be at least 1: %s", retryTimeoutMultiplier)
+	}
+
+	return nil
+}
+
+// validateMaxInFlightBlocks validates the MaxInFlightBlocks param, zero
+// disables the expiry of the incoming in-flight packets
+func validateMaxInFlightBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
 	return nil
 }
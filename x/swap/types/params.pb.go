@@ -29,6 +29,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	InterfaceFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=interface_fee_rate,json=interfaceFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"interface_fee_rate"`
+	// Number of times a change or forward packet is sent when its metadata does
+	// not set the retries
+	RetryCount uint32 `protobuf:"varint,2,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Timeout in seconds of a change or forward packet when it is first sent
+	PacketTimeoutSeconds uint64 `protobuf:"varint,3,opt,name=packet_timeout_seconds,json=packetTimeoutSeconds,proto3" json:"packet_timeout_seconds,omitempty"`
+	// Factor by which the timeout of a change or forward packet grows each time
+	// it is resent
+	RetryTimeoutMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=retry_timeout_multiplier,json=retryTimeoutMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"retry_timeout_multiplier"`
+	// Number of blocks after which an incoming packet still waiting for its
+	// change or forward is acknowledged by the EndBlocker. Zero disables it.
+	MaxInFlightBlocks uint64 `protobuf:"varint,5,opt,name=max_in_flight_blocks,json=maxInFlightBlocks,proto3" json:"max_in_flight_blocks,omitempty"`
+	// Maximum interface fee rate, capping the rates approved for the registered
+	// interface providers
+	MaxInterfaceFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_interface_fee_rate,json=maxInterfaceFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_interface_fee_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,6 +78,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *Params) GetPacketTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.PacketTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInFlightBlocks() uint64 {
+	if m != nil {
+		return m.MaxInFlightBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sunrise.swap.Params")
 }
@@ -71,24 +106,34 @@ func init() {
 func init() { proto.RegisterFile("sunrise/swap/params.proto", fileDescriptor_74d8e4fb868b1de1) }
 
 var fileDescriptor_74d8e4fb868b1de1 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4a, 0xe9, 0x81, 0xa4, 0xa4, 0x04, 0x13, 0x73,
-	0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x81, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9,
-	0x0f, 0x62, 0x41, 0x45, 0x25, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12, 0x10, 0x0e,
-	0x44, 0x4a, 0xa9, 0x8f, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0x85, 0x50, 0x0a, 0x97, 0x50, 0x66, 0x5e,
-	0x49, 0x6a, 0x51, 0x5a, 0x62, 0x72, 0x6a, 0x7c, 0x5a, 0x6a, 0x6a, 0x7c, 0x51, 0x62, 0x49, 0xaa,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xd9, 0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b,
-	0x43, 0x34, 0x17, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0xe7, 0x26, 0x96, 0x64, 0xe8, 0xf9, 0xa4,
-	0xa6, 0x27, 0x26, 0x57, 0xba, 0xa4, 0x26, 0x5f, 0xda, 0xa2, 0xcb, 0x05, 0x35, 0xdb, 0x25, 0x35,
-	0x79, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x02, 0x70, 0x13, 0xdd, 0x52, 0x53, 0x83, 0x12, 0x4b,
-	0x52, 0xad, 0xe4, 0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x14, 0xe6, 0xcd, 0x0a,
-	0x88, 0x47, 0x21, 0xae, 0x70, 0x72, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0xde, 0x9c,
-	0xc4, 0xca, 0xd4, 0x22, 0x7d, 0x34, 0x83, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe,
-	0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x28, 0xb2, 0xe0, 0xea, 0x4e, 0x01, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0x63, 0x5a, 0x22, 0x61, 0x40, 0xa2, 0x26, 0x44, 0xd7, 0x22, 0xdd, 0x45, 0x4c, 0x51,
+	0x05, 0xe7, 0x01, 0xc4, 0xc0, 0x18, 0xaa, 0x48, 0x48, 0x20, 0xa1, 0x83, 0x89, 0xc5, 0x72, 0x9c,
+	0x97, 0x8b, 0x75, 0xe7, 0xf3, 0xc9, 0xf6, 0x89, 0xdc, 0x57, 0x60, 0xe2, 0x23, 0x30, 0x32, 0x76,
+	0xe0, 0x43, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x7c, 0x01, 0x76, 0x14, 0xfb, 0x5a,
+	0x09, 0x3a, 0x76, 0x39, 0xbd, 0x7b, 0x3f, 0xff, 0xff, 0xef, 0xf9, 0xf9, 0xe1, 0x7d, 0xdb, 0x54,
+	0x46, 0x5a, 0xa0, 0xf6, 0x23, 0xaf, 0x69, 0xcd, 0x0d, 0x57, 0x36, 0xad, 0x8d, 0x76, 0x9a, 0xdc,
+	0xe9, 0x50, 0xba, 0x45, 0x07, 0x7b, 0x5c, 0xc9, 0x4a, 0x53, 0xff, 0x0d, 0x07, 0x0e, 0x06, 0xb9,
+	0xce, 0xb5, 0x0f, 0xe9, 0x36, 0xea, 0xb2, 0xfb, 0x42, 0x5b, 0xa5, 0x2d, 0x0b, 0x20, 0xfc, 0x04,
+	0xf4, 0xe8, 0xcf, 0x0e, 0xee, 0xbf, 0xf5, 0x25, 0xc8, 0x1c, 0x13, 0x59, 0x39, 0x30, 0x0b, 0x2e,
+	0x80, 0x2d, 0x00, 0x98, 0xe1, 0x0e, 0x22, 0x34, 0x42, 0xe3, 0x5b, 0x93, 0xe7, 0x27, 0x67, 0x49,
+	0xef, 0xe7, 0x59, 0xf2, 0x30, 0x88, 0xed, 0xbc, 0x48, 0xa5, 0xa6, 0x8a, 0xbb, 0x65, 0xfa, 0x1a,
+	0x72, 0x2e, 0xda, 0x23, 0x10, 0xdf, 0xbf, 0x3d, 0xc1, 0x9d, 0xf7, 0x11, 0x88, 0xaf, 0xe7, 0xc7,
+	0x87, 0x28, 0xbb, 0x77, 0xe9, 0x38, 0x05, 0xc8, 0xb8, 0x03, 0x92, 0xe0, 0xdb, 0x06, 0x9c, 0x69,
+	0x99, 0xd0, 0x4d, 0xe5, 0xa2, 0x1b, 0x23, 0x34, 0xbe, 0x9b, 0x61, 0x9f, 0x7a, 0xb9, 0xcd, 0x90,
+	0x67, 0x78, 0x58, 0x73, 0x51, 0x80, 0x63, 0x4e, 0x2a, 0xd0, 0x8d, 0x63, 0x16, 0x84, 0xae, 0xe6,
+	0x36, 0xda, 0x19, 0xa1, 0xf1, 0x6e, 0x36, 0x08, 0xf4, 0x7d, 0x80, 0xef, 0x02, 0x23, 0x35, 0x8e,
+	0x82, 0xed, 0x85, 0x48, 0x35, 0xa5, 0x93, 0x75, 0x29, 0xc1, 0x44, 0xbb, 0xd7, 0xba, 0xc2, 0xd0,
+	0xfb, 0x76, 0xe5, 0xde, 0x5c, 0xba, 0x12, 0x8a, 0x07, 0x8a, 0xaf, 0x98, 0xac, 0xd8, 0xa2, 0x94,
+	0xf9, 0xd2, 0xb1, 0x59, 0xa9, 0x45, 0x61, 0xa3, 0x9b, 0xbe, 0xcb, 0x3d, 0xc5, 0x57, 0xaf, 0xaa,
+	0xa9, 0x27, 0x13, 0x0f, 0x48, 0x81, 0x87, 0x41, 0x70, 0x65, 0xc6, 0xfd, 0x6b, 0x35, 0x78, 0xdf,
+	0x97, 0xfa, 0x77, 0xcc, 0x2f, 0xe2, 0xdf, 0x5f, 0x12, 0xf4, 0xe9, 0xfc, 0xf8, 0xf0, 0xc1, 0xc5,
+	0x36, 0xad, 0xc2, 0x3e, 0x85, 0xc7, 0x9e, 0x4c, 0x4f, 0xd6, 0x31, 0x3a, 0x5d, 0xc7, 0xe8, 0xd7,
+	0x3a, 0x46, 0x9f, 0x37, 0x71, 0xef, 0x74, 0x13, 0xf7, 0x7e, 0x6c, 0xe2, 0xde, 0x87, 0xc7, 0xb9,
+	0x74, 0xcb, 0x66, 0x96, 0x0a, 0xad, 0x68, 0xa7, 0x2d, 0x79, 0x0b, 0x86, 0xfe, 0x67, 0xe4, 0xda,
+	0x1a, 0xec, 0xac, 0xef, 0xd7, 0xe8, 0xe9, 0xdf, 0x01, 0x00, 0xe7, 0x72, 0xa9, 0x06, 0xb5, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -113,6 +158,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.InterfaceFeeRate.Equal(that1.InterfaceFeeRate) {
 		return false
 	}
+	if this.RetryCount != that1.RetryCount {
+		return false
+	}
+	if this.PacketTimeoutSeconds != that1.PacketTimeoutSeconds {
+		return false
+	}
+	if !this.RetryTimeoutMultiplier.Equal(that1.RetryTimeoutMultiplier) {
+		return false
+	}
+	if this.MaxInFlightBlocks != that1.MaxInFlightBlocks {
+		return false
+	}
+	if !this.MaxInterfaceFeeRate.Equal(that1.MaxInterfaceFeeRate) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -135,6 +195,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxInterfaceFeeRate.Size()
+		i -= size
+		if _, err := m.MaxInterfaceFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxInFlightBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxInFlightBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RetryTimeoutMultiplier.Size()
+		i -= size
+		if _, err := m.RetryTimeoutMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PacketTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetryCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.InterfaceFeeRate.Size()
 		i -= size
@@ -167,6 +262,19 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.InterfaceFeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.RetryCount != 0 {
+		n += 1 + sovParams(uint64(m.RetryCount))
+	}
+	if m.PacketTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.PacketTimeoutSeconds))
+	}
+	l = m.RetryTimeoutMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxInFlightBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxInFlightBlocks))
+	}
+	l = m.MaxInterfaceFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -239,6 +347,131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutSeconds", wireType)
+			}
+			m.PacketTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryTimeoutMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryTimeoutMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightBlocks", wireType)
+			}
+			m.MaxInFlightBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlightBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInterfaceFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInterfaceFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
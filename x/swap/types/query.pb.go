@@ -316,6 +316,118 @@ func (m *QueryIncomingInFlightPacketsResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+type QueryPendingIncomingInFlightPacketsRequest struct {
+	MinPendingBlocks uint64             `protobuf:"varint,1,opt,name=min_pending_blocks,json=minPendingBlocks,proto3" json:"min_pending_blocks,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) Reset() {
+	*m = QueryPendingIncomingInFlightPacketsRequest{}
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingIncomingInFlightPacketsRequest) ProtoMessage() {}
+func (*QueryPendingIncomingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{6}
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingIncomingInFlightPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingIncomingInFlightPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingIncomingInFlightPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingIncomingInFlightPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) GetMinPendingBlocks() uint64 {
+	if m != nil {
+		return m.MinPendingBlocks
+	}
+	return 0
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingIncomingInFlightPacketsResponse struct {
+	Packets    []IncomingInFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) Reset() {
+	*m = QueryPendingIncomingInFlightPacketsResponse{}
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingIncomingInFlightPacketsResponse) ProtoMessage() {}
+func (*QueryPendingIncomingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{7}
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingIncomingInFlightPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingIncomingInFlightPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingIncomingInFlightPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingIncomingInFlightPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) GetPackets() []IncomingInFlightPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryOutgoingInFlightPacketRequest struct {
 	SrcPortId    string `protobuf:"bytes,1,opt,name=src_port_id,json=srcPortId,proto3" json:"src_port_id,omitempty"`
 	SrcChannelId string `protobuf:"bytes,2,opt,name=src_channel_id,json=srcChannelId,proto3" json:"src_channel_id,omitempty"`
@@ -326,7 +438,7 @@ func (m *QueryOutgoingInFlightPacketRequest) Reset()         { *m = QueryOutgoin
 func (m *QueryOutgoingInFlightPacketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOutgoingInFlightPacketRequest) ProtoMessage()    {}
 func (*QueryOutgoingInFlightPacketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{6}
+	return fileDescriptor_b10939fa85502f21, []int{8}
 }
 func (m *QueryOutgoingInFlightPacketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +496,7 @@ func (m *QueryOutgoingInFlightPacketResponse) Reset()         { *m = QueryOutgoi
 func (m *QueryOutgoingInFlightPacketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutgoingInFlightPacketResponse) ProtoMessage()    {}
 func (*QueryOutgoingInFlightPacketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{7}
+	return fileDescriptor_b10939fa85502f21, []int{9}
 }
 func (m *QueryOutgoingInFlightPacketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +540,7 @@ func (m *QueryOutgoingInFlightPacketsRequest) Reset()         { *m = QueryOutgoi
 func (m *QueryOutgoingInFlightPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOutgoingInFlightPacketsRequest) ProtoMessage()    {}
 func (*QueryOutgoingInFlightPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{8}
+	return fileDescriptor_b10939fa85502f21, []int{10}
 }
 func (m *QueryOutgoingInFlightPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +585,7 @@ func (m *QueryOutgoingInFlightPacketsResponse) Reset()         { *m = QueryOutgo
 func (m *QueryOutgoingInFlightPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOutgoingInFlightPacketsResponse) ProtoMessage()    {}
 func (*QueryOutgoingInFlightPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{9}
+	return fileDescriptor_b10939fa85502f21, []int{11}
 }
 func (m *QueryOutgoingInFlightPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,6 +632,9 @@ type QueryCalculationSwapExactAmountInRequest struct {
 	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
 	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
 	AmountIn        string `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	// interface_provider applies the interface fee rate of the provider,
+	// has_interface_fee the interface_fee_rate param if it is not set
+	InterfaceProvider string `protobuf:"bytes,4,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider,omitempty"`
 }
 
 func (m *QueryCalculationSwapExactAmountInRequest) Reset() {
@@ -528,7 +643,7 @@ func (m *QueryCalculationSwapExactAmountInRequest) Reset() {
 func (m *QueryCalculationSwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalculationSwapExactAmountInRequest) ProtoMessage()    {}
 func (*QueryCalculationSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{10}
+	return fileDescriptor_b10939fa85502f21, []int{12}
 }
 func (m *QueryCalculationSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,6 +693,13 @@ func (m *QueryCalculationSwapExactAmountInRequest) GetAmountIn() string {
 	return ""
 }
 
+func (m *QueryCalculationSwapExactAmountInRequest) GetInterfaceProvider() string {
+	if m != nil {
+		return m.InterfaceProvider
+	}
+	return ""
+}
+
 type QueryCalculationSwapExactAmountInResponse struct {
 	Result               RouteResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 	InterfaceProviderFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3,customtype=cosmossdk.io/math.Int" json:"interface_provider_fee"`
@@ -592,7 +714,7 @@ func (m *QueryCalculationSwapExactAmountInResponse) String() string {
 }
 func (*QueryCalculationSwapExactAmountInResponse) ProtoMessage() {}
 func (*QueryCalculationSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{11}
+	return fileDescriptor_b10939fa85502f21, []int{13}
 }
 func (m *QueryCalculationSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,6 +754,9 @@ type QueryCalculationSwapExactAmountOutRequest struct {
 	HasInterfaceFee bool   `protobuf:"varint,1,opt,name=has_interface_fee,json=hasInterfaceFee,proto3" json:"has_interface_fee,omitempty"`
 	Route           *Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
 	AmountOut       string `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	// interface_provider applies the interface fee rate of the provider,
+	// has_interface_fee the interface_fee_rate param if it is not set
+	InterfaceProvider string `protobuf:"bytes,4,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider,omitempty"`
 }
 
 func (m *QueryCalculationSwapExactAmountOutRequest) Reset() {
@@ -642,7 +767,7 @@ func (m *QueryCalculationSwapExactAmountOutRequest) String() string {
 }
 func (*QueryCalculationSwapExactAmountOutRequest) ProtoMessage() {}
 func (*QueryCalculationSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{12}
+	return fileDescriptor_b10939fa85502f21, []int{14}
 }
 func (m *QueryCalculationSwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,6 +817,13 @@ func (m *QueryCalculationSwapExactAmountOutRequest) GetAmountOut() string {
 	return ""
 }
 
+func (m *QueryCalculationSwapExactAmountOutRequest) GetInterfaceProvider() string {
+	if m != nil {
+		return m.InterfaceProvider
+	}
+	return ""
+}
+
 type QueryCalculationSwapExactAmountOutResponse struct {
 	Result               RouteResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 	InterfaceProviderFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3,customtype=cosmossdk.io/math.Int" json:"interface_provider_fee"`
@@ -706,7 +838,7 @@ func (m *QueryCalculationSwapExactAmountOutResponse) String() string {
 }
 func (*QueryCalculationSwapExactAmountOutResponse) ProtoMessage() {}
 func (*QueryCalculationSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b10939fa85502f21, []int{13}
+	return fileDescriptor_b10939fa85502f21, []int{15}
 }
 func (m *QueryCalculationSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,1215 +874,3503 @@ func (m *QueryCalculationSwapExactAmountOutResponse) GetResult() RouteResult {
 	return RouteResult{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.swap.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.swap.QueryParamsResponse")
-	proto.RegisterType((*QueryIncomingInFlightPacketRequest)(nil), "sunrise.swap.QueryIncomingInFlightPacketRequest")
-	proto.RegisterType((*QueryIncomingInFlightPacketResponse)(nil), "sunrise.swap.QueryIncomingInFlightPacketResponse")
-	proto.RegisterType((*QueryIncomingInFlightPacketsRequest)(nil), "sunrise.swap.QueryIncomingInFlightPacketsRequest")
-	proto.RegisterType((*QueryIncomingInFlightPacketsResponse)(nil), "sunrise.swap.QueryIncomingInFlightPacketsResponse")
-	proto.RegisterType((*QueryOutgoingInFlightPacketRequest)(nil), "sunrise.swap.QueryOutgoingInFlightPacketRequest")
-	proto.RegisterType((*QueryOutgoingInFlightPacketResponse)(nil), "sunrise.swap.QueryOutgoingInFlightPacketResponse")
-	proto.RegisterType((*QueryOutgoingInFlightPacketsRequest)(nil), "sunrise.swap.QueryOutgoingInFlightPacketsRequest")
-	proto.RegisterType((*QueryOutgoingInFlightPacketsResponse)(nil), "sunrise.swap.QueryOutgoingInFlightPacketsResponse")
-	proto.RegisterType((*QueryCalculationSwapExactAmountInRequest)(nil), "sunrise.swap.QueryCalculationSwapExactAmountInRequest")
-	proto.RegisterType((*QueryCalculationSwapExactAmountInResponse)(nil), "sunrise.swap.QueryCalculationSwapExactAmountInResponse")
-	proto.RegisterType((*QueryCalculationSwapExactAmountOutRequest)(nil), "sunrise.swap.QueryCalculationSwapExactAmountOutRequest")
-	proto.RegisterType((*QueryCalculationSwapExactAmountOutResponse)(nil), "sunrise.swap.QueryCalculationSwapExactAmountOutResponse")
+type QueryOptimalRouteRequest struct {
+	DenomIn  string `protobuf:"bytes,1,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty"`
+	DenomOut string `protobuf:"bytes,2,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// Exact amount of denom_in
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Maximum number of pools in a path, defaults to 3
+	MaxHops uint32 `protobuf:"varint,4,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// Maximum number of parallel paths the amount is split into, defaults to 1
+	MaxSplits uint32 `protobuf:"varint,5,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty"`
 }
 
-func init() { proto.RegisterFile("sunrise/swap/query.proto", fileDescriptor_b10939fa85502f21) }
-
-var fileDescriptor_b10939fa85502f21 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xb8, 0x6d, 0x88, 0xa7, 0x15, 0xa8, 0x53, 0x93, 0xa6, 0x26, 0x75, 0xc3, 0x36, 0x82,
-	0xd4, 0x2a, 0xbb, 0x49, 0xf8, 0x28, 0x2a, 0x5c, 0x70, 0x21, 0xc8, 0x07, 0x88, 0x59, 0x2a, 0x0e,
-	0x15, 0xd2, 0x6a, 0xb2, 0x9e, 0xac, 0x47, 0xb1, 0x67, 0xb6, 0x3b, 0xb3, 0x4d, 0x23, 0xd4, 0x0b,
-	0x07, 0xce, 0x48, 0xdc, 0x91, 0xe0, 0xc4, 0x11, 0xa1, 0x72, 0xe1, 0x17, 0x54, 0xe2, 0x40, 0x05,
-	0x17, 0x3e, 0xa4, 0x0a, 0x25, 0x48, 0xfc, 0x0d, 0x34, 0x1f, 0xb6, 0xb3, 0xca, 0x7a, 0xb3, 0xae,
-	0xa8, 0x04, 0x97, 0xc8, 0x3b, 0xef, 0xd7, 0xf3, 0xbc, 0xef, 0xa3, 0x79, 0x27, 0x70, 0x41, 0xa4,
-	0x2c, 0xa1, 0x82, 0x78, 0x62, 0x17, 0xc7, 0xde, 0xed, 0x94, 0x24, 0x7b, 0x6e, 0x9c, 0x70, 0xc9,
-	0xd1, 0x19, 0x6b, 0x71, 0x95, 0xa5, 0x7e, 0x16, 0x0f, 0x28, 0xe3, 0x9e, 0xfe, 0x6b, 0x1c, 0xea,
-	0xb5, 0x88, 0x47, 0x5c, 0xff, 0xf4, 0xd4, 0x2f, 0x7b, 0xba, 0x18, 0x71, 0x1e, 0xf5, 0x89, 0x87,
-	0x63, 0xea, 0x61, 0xc6, 0xb8, 0xc4, 0x92, 0x72, 0x26, 0xac, 0xb5, 0x19, 0x72, 0x31, 0xe0, 0xc2,
-	0xdb, 0xc2, 0x82, 0x98, 0x6a, 0xde, 0x9d, 0xb5, 0x2d, 0x22, 0xf1, 0x9a, 0x17, 0xe3, 0x88, 0x32,
-	0xed, 0x6c, 0x7d, 0x2f, 0x18, 0xdf, 0xc0, 0x94, 0x30, 0x1f, 0x43, 0x53, 0x06, 0x75, 0x8c, 0x13,
-	0x3c, 0x18, 0x9a, 0x2e, 0x67, 0x4c, 0x94, 0x05, 0xdb, 0x7d, 0x1a, 0xf5, 0x64, 0x10, 0xe3, 0x70,
-	0x87, 0x48, 0xeb, 0x94, 0x65, 0x9d, 0xf0, 0x54, 0x12, 0x63, 0x71, 0x6a, 0x10, 0x7d, 0xa0, 0x60,
-	0x75, 0x74, 0x4e, 0x9f, 0xdc, 0x4e, 0x89, 0x90, 0xce, 0xfb, 0xf0, 0x5c, 0xe6, 0x54, 0xc4, 0x9c,
-	0x09, 0x82, 0xae, 0xc1, 0x59, 0x53, 0x7b, 0x01, 0x2c, 0x81, 0x95, 0xd3, 0xeb, 0x35, 0xf7, 0x70,
-	0xcf, 0x5c, 0xe3, 0xdd, 0xaa, 0x3e, 0x78, 0x74, 0x69, 0xe6, 0x9b, 0xbf, 0xbf, 0x6d, 0x02, 0xdf,
-	0xba, 0x3b, 0x9f, 0x01, 0xe8, 0xe8, 0x84, 0x6d, 0x16, 0xf2, 0x01, 0x65, 0x51, 0x9b, 0x6d, 0x68,
-	0x98, 0x1d, 0x8d, 0xd2, 0x96, 0x45, 0x0d, 0x78, 0x5a, 0x24, 0x61, 0x10, 0xf3, 0x44, 0x06, 0xb4,
-	0xab, 0x8b, 0x54, 0xfd, 0xaa, 0x48, 0xc2, 0x0e, 0x4f, 0x64, 0xbb, 0x8b, 0x96, 0xe1, 0xd3, 0xca,
-	0x1e, 0xf6, 0x30, 0x63, 0xa4, 0xaf, 0x5c, 0x2a, 0xda, 0xe5, 0x8c, 0x48, 0xc2, 0x1b, 0xe6, 0xb0,
-	0xdd, 0x45, 0x75, 0x38, 0x27, 0x54, 0x42, 0x16, 0x92, 0x85, 0x13, 0x4b, 0x60, 0xe5, 0xa4, 0x3f,
-	0xfa, 0x76, 0x28, 0xbc, 0x5c, 0x88, 0xc3, 0x12, 0x6d, 0x29, 0xa2, 0xea, 0xc4, 0x12, 0x5d, 0xce,
-	0x12, 0xcd, 0x8f, 0x6e, 0x9d, 0x54, 0xc4, 0x7d, 0x1b, 0xe9, 0x0c, 0x0a, 0x4b, 0x0d, 0x5b, 0x8d,
-	0x36, 0x20, 0x1c, 0x2b, 0xc1, 0x96, 0x7b, 0xc1, 0xb5, 0xd3, 0x57, 0xb2, 0x71, 0x8d, 0x48, 0xad,
-	0x6c, 0xdc, 0x0e, 0x8e, 0x88, 0x8d, 0xf5, 0x0f, 0x45, 0x3a, 0xdf, 0x03, 0xb8, 0x5c, 0x5c, 0xcf,
-	0x72, 0x7b, 0x1b, 0x3e, 0x65, 0x10, 0xaa, 0x29, 0x9e, 0x98, 0x92, 0xdc, 0x30, 0x14, 0xbd, 0x9b,
-	0x81, 0x5d, 0xd1, 0xb0, 0x5f, 0x3c, 0x16, 0xb6, 0x81, 0x90, 0xc1, 0x3d, 0x92, 0xc6, 0x66, 0x2a,
-	0x23, 0xfe, 0x1f, 0x90, 0xc6, 0x24, 0x1c, 0xe5, 0xa4, 0x91, 0x1f, 0x3d, 0x41, 0x1a, 0xf9, 0xce,
-	0x4f, 0x4e, 0x1a, 0x13, 0xeb, 0x95, 0x94, 0x46, 0x21, 0xb9, 0x7f, 0x5f, 0x1a, 0x5f, 0x02, 0xb8,
-	0xa2, 0x71, 0xdf, 0xc0, 0xfd, 0x30, 0xed, 0xeb, 0xc3, 0x0f, 0x77, 0x71, 0xfc, 0xce, 0x5d, 0x1c,
-	0xca, 0xb7, 0x06, 0x3c, 0x65, 0xb2, 0xcd, 0x86, 0xcd, 0x6a, 0xc2, 0xb3, 0x3d, 0x2c, 0x02, 0xca,
-	0x24, 0x49, 0xb6, 0x71, 0x48, 0x82, 0x6d, 0x42, 0x74, 0xcf, 0xe6, 0xfc, 0x67, 0x7a, 0x58, 0xb4,
-	0x87, 0xe7, 0x1b, 0x84, 0xa0, 0x2b, 0xf0, 0x94, 0xbe, 0x03, 0x2d, 0xb8, 0x73, 0x59, 0x96, 0xbe,
-	0x32, 0xf9, 0xc6, 0x03, 0x3d, 0x07, 0xab, 0x58, 0x57, 0x0a, 0x28, 0xd3, 0x92, 0xa9, 0xfa, 0x73,
-	0xd8, 0x96, 0x76, 0xbe, 0xae, 0xc0, 0x2b, 0x25, 0x00, 0xda, 0xee, 0xbe, 0x09, 0x67, 0x13, 0x22,
-	0xd2, 0xfe, 0x50, 0x39, 0x17, 0xf2, 0xca, 0x6a, 0x87, 0xcc, 0x15, 0x6a, 0x62, 0xd0, 0x36, 0x9c,
-	0x1f, 0x73, 0x8b, 0x13, 0x7e, 0x87, 0x76, 0x49, 0xa2, 0x49, 0x6a, 0xa1, 0xb7, 0x56, 0x55, 0xc8,
-	0xef, 0x8f, 0x2e, 0x3d, 0x6b, 0x1a, 0x2d, 0xba, 0x3b, 0x2e, 0xe5, 0xde, 0x00, 0xcb, 0x9e, 0xdb,
-	0x66, 0xf2, 0xe7, 0xfb, 0x2f, 0x41, 0x3b, 0x81, 0x36, 0x93, 0x26, 0x73, 0x6d, 0x94, 0xaf, 0x63,
-	0xd3, 0xa9, 0xde, 0x6c, 0x42, 0x68, 0x09, 0xf3, 0x54, 0x1a, 0xc6, 0x8f, 0x91, 0xdb, 0x36, 0x6d,
-	0x33, 0x95, 0xce, 0x57, 0xe0, 0xd8, 0x26, 0x6d, 0xa6, 0xf2, 0x09, 0x8f, 0xf1, 0xe2, 0x51, 0x56,
-	0x19, 0x8c, 0x15, 0xd8, 0x2c, 0x83, 0xf1, 0x7f, 0x35, 0xc9, 0xf7, 0x8e, 0x48, 0xf7, 0x31, 0x52,
-	0x8f, 0xc4, 0xbe, 0xfe, 0x03, 0x84, 0xa7, 0x74, 0x8f, 0xd0, 0x0e, 0x9c, 0x35, 0xab, 0x1e, 0x2d,
-	0x65, 0x89, 0x1f, 0x7d, 0x49, 0xd4, 0x9f, 0x2f, 0xf0, 0x30, 0xdd, 0x74, 0x16, 0x3f, 0xfd, 0xe5,
-	0xaf, 0x2f, 0x2a, 0xf3, 0xa8, 0xe6, 0xe5, 0xbc, 0x72, 0xd0, 0x1f, 0x00, 0xce, 0xe7, 0xaf, 0x24,
-	0xb4, 0x9a, 0x93, 0xbb, 0xf0, 0x81, 0x51, 0x5f, 0x9b, 0x22, 0xc2, 0xa2, 0xfb, 0x58, 0xa3, 0xfb,
-	0x08, 0xdd, 0xcc, 0xa2, 0xc3, 0xe1, 0x4e, 0xb0, 0x8b, 0xa9, 0xa4, 0x2c, 0xb2, 0x4f, 0x2d, 0xe1,
-	0x51, 0x9b, 0xc9, 0xfb, 0xe4, 0xd0, 0xaa, 0xba, 0x67, 0xbe, 0xc6, 0x8b, 0x49, 0x1d, 0xd8, 0x9d,
-	0x73, 0x0f, 0xdd, 0x07, 0xf0, 0xfc, 0x84, 0x85, 0x8d, 0xca, 0x83, 0x1d, 0x75, 0x7b, 0x7d, 0x9a,
-	0x10, 0x4b, 0x70, 0x5d, 0x13, 0xbc, 0x8a, 0x9a, 0xe5, 0x09, 0xa2, 0xdf, 0x00, 0x9c, 0xcf, 0x5f,
-	0x06, 0xb9, 0x43, 0x29, 0x5c, 0xed, 0xb9, 0x43, 0x29, 0x5e, 0xc2, 0xce, 0x2d, 0x8d, 0xf9, 0x26,
-	0xf2, 0xbd, 0xc2, 0xd7, 0xaf, 0xf0, 0xb8, 0xcd, 0x33, 0xc5, 0x48, 0xbe, 0x03, 0xf0, 0xfc, 0x84,
-	0x45, 0x89, 0xca, 0x43, 0x2d, 0x1c, 0xc9, 0x31, 0x7b, 0xd8, 0x59, 0xd5, 0xf4, 0x9a, 0x68, 0xa5,
-	0x2c, 0x3d, 0xf4, 0x23, 0x80, 0x8b, 0x45, 0x4b, 0x08, 0xbd, 0x96, 0x03, 0xa3, 0xc4, 0x5a, 0xad,
-	0x5f, 0x9b, 0x3a, 0xce, 0x72, 0xb8, 0xae, 0x39, 0xbc, 0x72, 0x1d, 0x34, 0x1d, 0x2f, 0x4b, 0x23,
-	0x1c, 0x87, 0x0b, 0x7d, 0x12, 0x10, 0x95, 0x21, 0x18, 0x5d, 0x56, 0xe8, 0x27, 0x00, 0x2f, 0x16,
-	0xde, 0xc4, 0x68, 0x3a, 0x58, 0xe3, 0xfd, 0x52, 0x7f, 0x7d, 0xfa, 0x40, 0x4b, 0xe8, 0x0d, 0x4d,
-	0xe8, 0x55, 0x45, 0x68, 0x75, 0x2a, 0x42, 0x3c, 0x95, 0xad, 0x8d, 0x07, 0xfb, 0x0d, 0xf0, 0x70,
-	0xbf, 0x01, 0xfe, 0xdc, 0x6f, 0x80, 0xcf, 0x0f, 0x1a, 0x33, 0x0f, 0x0f, 0x1a, 0x33, 0xbf, 0x1e,
-	0x34, 0x66, 0x6e, 0x5d, 0x8d, 0xa8, 0xec, 0xa5, 0x5b, 0x6e, 0xc8, 0x07, 0xc3, 0xac, 0x7d, 0xbc,
-	0x47, 0x92, 0x51, 0x89, 0xbb, 0xa6, 0x88, 0xdc, 0x8b, 0x89, 0xd8, 0x9a, 0xd5, 0xff, 0xb5, 0xbd,
-	0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x71, 0x45, 0xdf, 0xc7, 0x0e, 0x00, 0x00,
+func (m *QueryOptimalRouteRequest) Reset()         { *m = QueryOptimalRouteRequest{} }
+func (m *QueryOptimalRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOptimalRouteRequest) ProtoMessage()    {}
+func (*QueryOptimalRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of IncomingInFlightPacket items.
-	IncomingInFlightPacket(ctx context.Context, in *QueryIncomingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketResponse, error)
-	IncomingInFlightPackets(ctx context.Context, in *QueryIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketsResponse, error)
-	// Queries a list of OutgoingInFlightPacket items.
-	OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error)
-	OutgoingInFlightPackets(ctx context.Context, in *QueryOutgoingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketsResponse, error)
-	// Queries a Calculation swap value.
-	CalculationSwapExactAmountIn(ctx context.Context, in *QueryCalculationSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountInResponse, error)
-	CalculationSwapExactAmountOut(ctx context.Context, in *QueryCalculationSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountOutResponse, error)
+func (m *QueryOptimalRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryOptimalRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOptimalRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryOptimalRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOptimalRouteRequest.Merge(m, src)
+}
+func (m *QueryOptimalRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOptimalRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOptimalRouteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOptimalRouteRequest proto.InternalMessageInfo
+
+func (m *QueryOptimalRouteRequest) GetDenomIn() string {
+	if m != nil {
+		return m.DenomIn
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) IncomingInFlightPacket(ctx context.Context, in *QueryIncomingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketResponse, error) {
-	out := new(QueryIncomingInFlightPacketResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/IncomingInFlightPacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOptimalRouteRequest) GetDenomOut() string {
+	if m != nil {
+		return m.DenomOut
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) IncomingInFlightPackets(ctx context.Context, in *QueryIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketsResponse, error) {
-	out := new(QueryIncomingInFlightPacketsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/IncomingInFlightPackets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOptimalRouteRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error) {
-	out := new(QueryOutgoingInFlightPacketResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/OutgoingInFlightPacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOptimalRouteRequest) GetMaxHops() uint32 {
+	if m != nil {
+		return m.MaxHops
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) OutgoingInFlightPackets(ctx context.Context, in *QueryOutgoingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketsResponse, error) {
-	out := new(QueryOutgoingInFlightPacketsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/OutgoingInFlightPackets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOptimalRouteRequest) GetMaxSplits() uint32 {
+	if m != nil {
+		return m.MaxSplits
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CalculationSwapExactAmountIn(ctx context.Context, in *QueryCalculationSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountInResponse, error) {
-	out := new(QueryCalculationSwapExactAmountInResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/CalculationSwapExactAmountIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryOptimalRouteResponse struct {
+	Route  Route       `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+	Result RouteResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryOptimalRouteResponse) Reset()         { *m = QueryOptimalRouteResponse{} }
+func (m *QueryOptimalRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOptimalRouteResponse) ProtoMessage()    {}
+func (*QueryOptimalRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{17}
+}
+func (m *QueryOptimalRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOptimalRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOptimalRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOptimalRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOptimalRouteResponse.Merge(m, src)
+}
+func (m *QueryOptimalRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOptimalRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOptimalRouteResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CalculationSwapExactAmountOut(ctx context.Context, in *QueryCalculationSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountOutResponse, error) {
-	out := new(QueryCalculationSwapExactAmountOutResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/CalculationSwapExactAmountOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOptimalRouteResponse proto.InternalMessageInfo
+
+func (m *QueryOptimalRouteResponse) GetRoute() Route {
+	if m != nil {
+		return m.Route
 	}
-	return out, nil
+	return Route{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of IncomingInFlightPacket items.
-	IncomingInFlightPacket(context.Context, *QueryIncomingInFlightPacketRequest) (*QueryIncomingInFlightPacketResponse, error)
-	IncomingInFlightPackets(context.Context, *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error)
-	// Queries a list of OutgoingInFlightPacket items.
-	OutgoingInFlightPacket(context.Context, *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error)
-	OutgoingInFlightPackets(context.Context, *QueryOutgoingInFlightPacketsRequest) (*QueryOutgoingInFlightPacketsResponse, error)
-	// Queries a Calculation swap value.
-	CalculationSwapExactAmountIn(context.Context, *QueryCalculationSwapExactAmountInRequest) (*QueryCalculationSwapExactAmountInResponse, error)
-	CalculationSwapExactAmountOut(context.Context, *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error)
+func (m *QueryOptimalRouteResponse) GetResult() RouteResult {
+	if m != nil {
+		return m.Result
+	}
+	return RouteResult{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryInterfaceProviderRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryInterfaceProviderRequest) Reset()         { *m = QueryInterfaceProviderRequest{} }
+func (m *QueryInterfaceProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProviderRequest) ProtoMessage()    {}
+func (*QueryInterfaceProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{18}
 }
-func (*UnimplementedQueryServer) IncomingInFlightPacket(ctx context.Context, req *QueryIncomingInFlightPacketRequest) (*QueryIncomingInFlightPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncomingInFlightPacket not implemented")
-}
-func (*UnimplementedQueryServer) IncomingInFlightPackets(ctx context.Context, req *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncomingInFlightPackets not implemented")
+func (m *QueryInterfaceProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OutgoingInFlightPacket(ctx context.Context, req *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutgoingInFlightPacket not implemented")
+func (m *QueryInterfaceProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OutgoingInFlightPackets(ctx context.Context, req *QueryOutgoingInFlightPacketsRequest) (*QueryOutgoingInFlightPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutgoingInFlightPackets not implemented")
+func (m *QueryInterfaceProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProviderRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CalculationSwapExactAmountIn(ctx context.Context, req *QueryCalculationSwapExactAmountInRequest) (*QueryCalculationSwapExactAmountInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculationSwapExactAmountIn not implemented")
+func (m *QueryInterfaceProviderRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CalculationSwapExactAmountOut(ctx context.Context, req *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculationSwapExactAmountOut not implemented")
+func (m *QueryInterfaceProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProviderRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryInterfaceProviderRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryInterfaceProviderRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IncomingInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIncomingInFlightPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncomingInFlightPacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/IncomingInFlightPacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncomingInFlightPacket(ctx, req.(*QueryIncomingInFlightPacketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryInterfaceProviderResponse struct {
+	InterfaceProvider InterfaceProvider `protobuf:"bytes,1,opt,name=interface_provider,json=interfaceProvider,proto3" json:"interface_provider"`
 }
 
-func _Query_IncomingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIncomingInFlightPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncomingInFlightPackets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/IncomingInFlightPackets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncomingInFlightPackets(ctx, req.(*QueryIncomingInFlightPacketsRequest))
+func (m *QueryInterfaceProviderResponse) Reset()         { *m = QueryInterfaceProviderResponse{} }
+func (m *QueryInterfaceProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProviderResponse) ProtoMessage()    {}
+func (*QueryInterfaceProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{19}
+}
+func (m *QueryInterfaceProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterfaceProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryInterfaceProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProviderResponse.Merge(m, src)
+}
+func (m *QueryInterfaceProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterfaceProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProviderResponse.DiscardUnknown(m)
 }
 
-func _Query_OutgoingInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutgoingInFlightPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutgoingInFlightPacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/OutgoingInFlightPacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutgoingInFlightPacket(ctx, req.(*QueryOutgoingInFlightPacketRequest))
+var xxx_messageInfo_QueryInterfaceProviderResponse proto.InternalMessageInfo
+
+func (m *QueryInterfaceProviderResponse) GetInterfaceProvider() InterfaceProvider {
+	if m != nil {
+		return m.InterfaceProvider
 	}
-	return interceptor(ctx, in, info, handler)
+	return InterfaceProvider{}
 }
 
-func _Query_OutgoingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutgoingInFlightPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutgoingInFlightPackets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/OutgoingInFlightPackets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutgoingInFlightPackets(ctx, req.(*QueryOutgoingInFlightPacketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryInterfaceProvidersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_CalculationSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalculationSwapExactAmountInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculationSwapExactAmountIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/CalculationSwapExactAmountIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculationSwapExactAmountIn(ctx, req.(*QueryCalculationSwapExactAmountInRequest))
+func (m *QueryInterfaceProvidersRequest) Reset()         { *m = QueryInterfaceProvidersRequest{} }
+func (m *QueryInterfaceProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProvidersRequest) ProtoMessage()    {}
+func (*QueryInterfaceProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{20}
+}
+func (m *QueryInterfaceProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterfaceProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryInterfaceProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProvidersRequest.Merge(m, src)
+}
+func (m *QueryInterfaceProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterfaceProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProvidersRequest.DiscardUnknown(m)
 }
 
-func _Query_CalculationSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalculationSwapExactAmountOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculationSwapExactAmountOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.swap.Query/CalculationSwapExactAmountOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculationSwapExactAmountOut(ctx, req.(*QueryCalculationSwapExactAmountOutRequest))
+var xxx_messageInfo_QueryInterfaceProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryInterfaceProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.swap.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "IncomingInFlightPacket",
-			Handler:    _Query_IncomingInFlightPacket_Handler,
-		},
-		{
-			MethodName: "IncomingInFlightPackets",
-			Handler:    _Query_IncomingInFlightPackets_Handler,
-		},
-		{
-			MethodName: "OutgoingInFlightPacket",
-			Handler:    _Query_OutgoingInFlightPacket_Handler,
-		},
-		{
-			MethodName: "OutgoingInFlightPackets",
-			Handler:    _Query_OutgoingInFlightPackets_Handler,
-		},
-		{
-			MethodName: "CalculationSwapExactAmountIn",
-			Handler:    _Query_CalculationSwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "CalculationSwapExactAmountOut",
-			Handler:    _Query_CalculationSwapExactAmountOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sunrise/swap/query.proto",
+type QueryInterfaceProvidersResponse struct {
+	InterfaceProviders []InterfaceProvider `protobuf:"bytes,1,rep,name=interface_providers,json=interfaceProviders,proto3" json:"interface_providers"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInterfaceProvidersResponse) Reset()         { *m = QueryInterfaceProvidersResponse{} }
+func (m *QueryInterfaceProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProvidersResponse) ProtoMessage()    {}
+func (*QueryInterfaceProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{21}
+}
+func (m *QueryInterfaceProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterfaceProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInterfaceProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProvidersResponse.Merge(m, src)
+}
+func (m *QueryInterfaceProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterfaceProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryInterfaceProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryInterfaceProvidersResponse) GetInterfaceProviders() []InterfaceProvider {
+	if m != nil {
+		return m.InterfaceProviders
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInterfaceProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryInterfaceProviderStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInterfaceProviderStatsRequest) Reset()         { *m = QueryInterfaceProviderStatsRequest{} }
+func (m *QueryInterfaceProviderStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProviderStatsRequest) ProtoMessage()    {}
+func (*QueryInterfaceProviderStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{22}
+}
+func (m *QueryInterfaceProviderStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterfaceProviderStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProviderStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryInterfaceProviderStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProviderStatsRequest.Merge(m, src)
+}
+func (m *QueryInterfaceProviderStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterfaceProviderStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProviderStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIncomingInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInterfaceProviderStatsRequest proto.InternalMessageInfo
+
+func (m *QueryInterfaceProviderStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryIncomingInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryInterfaceProviderStatsResponse struct {
+	Stats InterfaceProviderStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryIncomingInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SrcChannelId) > 0 {
-		i -= len(m.SrcChannelId)
-		copy(dAtA[i:], m.SrcChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SrcPortId) > 0 {
-		i -= len(m.SrcPortId)
-		copy(dAtA[i:], m.SrcPortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcPortId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryInterfaceProviderStatsResponse) Reset()         { *m = QueryInterfaceProviderStatsResponse{} }
+func (m *QueryInterfaceProviderStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterfaceProviderStatsResponse) ProtoMessage()    {}
+func (*QueryInterfaceProviderStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10939fa85502f21, []int{23}
+}
+func (m *QueryInterfaceProviderStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterfaceProviderStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterfaceProviderStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryInterfaceProviderStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterfaceProviderStatsResponse.Merge(m, src)
+}
+func (m *QueryInterfaceProviderStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterfaceProviderStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterfaceProviderStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIncomingInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInterfaceProviderStatsResponse proto.InternalMessageInfo
+
+func (m *QueryInterfaceProviderStatsResponse) GetStats() InterfaceProviderStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return InterfaceProviderStats{}
 }
 
-func (m *QueryIncomingInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.swap.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.swap.QueryParamsResponse")
+	proto.RegisterType((*QueryIncomingInFlightPacketRequest)(nil), "sunrise.swap.QueryIncomingInFlightPacketRequest")
+	proto.RegisterType((*QueryIncomingInFlightPacketResponse)(nil), "sunrise.swap.QueryIncomingInFlightPacketResponse")
+	proto.RegisterType((*QueryIncomingInFlightPacketsRequest)(nil), "sunrise.swap.QueryIncomingInFlightPacketsRequest")
+	proto.RegisterType((*QueryIncomingInFlightPacketsResponse)(nil), "sunrise.swap.QueryIncomingInFlightPacketsResponse")
+	proto.RegisterType((*QueryPendingIncomingInFlightPacketsRequest)(nil), "sunrise.swap.QueryPendingIncomingInFlightPacketsRequest")
+	proto.RegisterType((*QueryPendingIncomingInFlightPacketsResponse)(nil), "sunrise.swap.QueryPendingIncomingInFlightPacketsResponse")
+	proto.RegisterType((*QueryOutgoingInFlightPacketRequest)(nil), "sunrise.swap.QueryOutgoingInFlightPacketRequest")
+	proto.RegisterType((*QueryOutgoingInFlightPacketResponse)(nil), "sunrise.swap.QueryOutgoingInFlightPacketResponse")
+	proto.RegisterType((*QueryOutgoingInFlightPacketsRequest)(nil), "sunrise.swap.QueryOutgoingInFlightPacketsRequest")
+	proto.RegisterType((*QueryOutgoingInFlightPacketsResponse)(nil), "sunrise.swap.QueryOutgoingInFlightPacketsResponse")
+	proto.RegisterType((*QueryCalculationSwapExactAmountInRequest)(nil), "sunrise.swap.QueryCalculationSwapExactAmountInRequest")
+	proto.RegisterType((*QueryCalculationSwapExactAmountInResponse)(nil), "sunrise.swap.QueryCalculationSwapExactAmountInResponse")
+	proto.RegisterType((*QueryCalculationSwapExactAmountOutRequest)(nil), "sunrise.swap.QueryCalculationSwapExactAmountOutRequest")
+	proto.RegisterType((*QueryCalculationSwapExactAmountOutResponse)(nil), "sunrise.swap.QueryCalculationSwapExactAmountOutResponse")
+	proto.RegisterType((*QueryOptimalRouteRequest)(nil), "sunrise.swap.QueryOptimalRouteRequest")
+	proto.RegisterType((*QueryOptimalRouteResponse)(nil), "sunrise.swap.QueryOptimalRouteResponse")
+	proto.RegisterType((*QueryInterfaceProviderRequest)(nil), "sunrise.swap.QueryInterfaceProviderRequest")
+	proto.RegisterType((*QueryInterfaceProviderResponse)(nil), "sunrise.swap.QueryInterfaceProviderResponse")
+	proto.RegisterType((*QueryInterfaceProvidersRequest)(nil), "sunrise.swap.QueryInterfaceProvidersRequest")
+	proto.RegisterType((*QueryInterfaceProvidersResponse)(nil), "sunrise.swap.QueryInterfaceProvidersResponse")
+	proto.RegisterType((*QueryInterfaceProviderStatsRequest)(nil), "sunrise.swap.QueryInterfaceProviderStatsRequest")
+	proto.RegisterType((*QueryInterfaceProviderStatsResponse)(nil), "sunrise.swap.QueryInterfaceProviderStatsResponse")
 }
 
-func (m *QueryIncomingInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("sunrise/swap/query.proto", fileDescriptor_b10939fa85502f21) }
+
+var fileDescriptor_b10939fa85502f21 = []byte{
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xa4, 0xf9, 0x9c, 0xf6, 0x7d, 0x21, 0xd3, 0x90, 0x3a, 0x6e, 0xe3, 0xb4, 0x9b, 0xd2,
+	0xa6, 0x6e, 0xe2, 0x4d, 0xd2, 0x42, 0x3f, 0x40, 0x08, 0x52, 0x48, 0xf1, 0x01, 0x12, 0xdc, 0xaa,
+	0x87, 0x0a, 0x69, 0x35, 0x59, 0x4f, 0xec, 0x55, 0xbc, 0x3b, 0xdb, 0x9d, 0x71, 0x9b, 0x2a, 0xca,
+	0x05, 0x21, 0xae, 0x20, 0x71, 0xe5, 0x42, 0xe1, 0x80, 0x38, 0x21, 0x54, 0x24, 0x0e, 0x9c, 0x38,
+	0x55, 0x42, 0x88, 0x0a, 0x2e, 0x7c, 0x48, 0x15, 0x6a, 0x11, 0xfc, 0x1b, 0x68, 0x3e, 0xd6, 0xc9,
+	0xc6, 0xeb, 0xf5, 0x6e, 0xd4, 0x4a, 0xe5, 0x62, 0x79, 0x67, 0x9e, 0x8f, 0xdf, 0xef, 0x79, 0x9e,
+	0xd9, 0x79, 0x9e, 0x85, 0x39, 0xd6, 0xf4, 0x02, 0x87, 0x11, 0x93, 0xdd, 0xc2, 0xbe, 0x79, 0xa3,
+	0x49, 0x82, 0xdb, 0x25, 0x3f, 0xa0, 0x9c, 0xa2, 0x03, 0x7a, 0xa7, 0x24, 0x76, 0xf2, 0x23, 0xd8,
+	0x75, 0x3c, 0x6a, 0xca, 0x5f, 0x25, 0x90, 0x1f, 0xad, 0xd1, 0x1a, 0x95, 0x7f, 0x4d, 0xf1, 0x4f,
+	0xaf, 0x1e, 0xa9, 0x51, 0x5a, 0x6b, 0x10, 0x13, 0xfb, 0x8e, 0x89, 0x3d, 0x8f, 0x72, 0xcc, 0x1d,
+	0xea, 0x31, 0xbd, 0x5b, 0xb4, 0x29, 0x73, 0x29, 0x33, 0x57, 0x31, 0x23, 0xca, 0x9b, 0x79, 0x73,
+	0x7e, 0x95, 0x70, 0x3c, 0x6f, 0xfa, 0xb8, 0xe6, 0x78, 0x52, 0x58, 0xcb, 0x8e, 0x2b, 0x59, 0x4b,
+	0xb9, 0x50, 0x0f, 0xe1, 0x56, 0x04, 0xb5, 0x8f, 0x03, 0xec, 0x86, 0x5b, 0x53, 0x91, 0x2d, 0xc7,
+	0xb3, 0xd6, 0x1a, 0x4e, 0xad, 0xce, 0x2d, 0x1f, 0xdb, 0xeb, 0x84, 0x6b, 0xa1, 0xe7, 0x77, 0x09,
+	0x71, 0x12, 0xac, 0x61, 0x9b, 0x08, 0x57, 0x37, 0x9d, 0x2a, 0x09, 0xb4, 0x58, 0x34, 0x38, 0x01,
+	0x6d, 0x72, 0xa2, 0x76, 0x8c, 0x51, 0x88, 0xde, 0x11, 0xe8, 0x57, 0xa4, 0xeb, 0x0a, 0xb9, 0xd1,
+	0x24, 0x8c, 0x1b, 0x6f, 0xc3, 0x83, 0x91, 0x55, 0xe6, 0x53, 0x8f, 0x11, 0x74, 0x0e, 0x0e, 0x28,
+	0x88, 0x39, 0x70, 0x14, 0x4c, 0xef, 0x5f, 0x18, 0x2d, 0xed, 0x0c, 0x6d, 0x49, 0x49, 0x2f, 0x0e,
+	0xdf, 0x7b, 0x30, 0xd9, 0xf3, 0xc5, 0x3f, 0x5f, 0x15, 0x41, 0x45, 0x8b, 0x1b, 0x1f, 0x00, 0x68,
+	0x48, 0x83, 0x65, 0xcf, 0xa6, 0xae, 0xe3, 0xd5, 0xca, 0xde, 0x92, 0x64, 0xb3, 0x22, 0xc9, 0x68,
+	0xb7, 0xa8, 0x00, 0xf7, 0xb3, 0xc0, 0xb6, 0x7c, 0x1a, 0x70, 0xcb, 0xa9, 0x4a, 0x27, 0xc3, 0x95,
+	0x61, 0x16, 0xd8, 0x2b, 0x34, 0xe0, 0xe5, 0x2a, 0x3a, 0x0e, 0xff, 0x2f, 0xf6, 0xed, 0x3a, 0xf6,
+	0x3c, 0xd2, 0x10, 0x22, 0xbd, 0x52, 0xe4, 0x00, 0x0b, 0xec, 0x4b, 0x6a, 0xb1, 0x5c, 0x45, 0x79,
+	0x38, 0xc4, 0x84, 0x41, 0xcf, 0x26, 0xb9, 0x7d, 0x47, 0xc1, 0x74, 0x5f, 0xa5, 0xf5, 0x6c, 0x38,
+	0x70, 0x2a, 0x11, 0x87, 0x26, 0xba, 0x28, 0x88, 0x8a, 0x15, 0x4d, 0xf4, 0x78, 0x94, 0x68, 0xbc,
+	0xf6, 0x62, 0x9f, 0x20, 0x5e, 0xd1, 0x9a, 0x86, 0x9b, 0xe8, 0x2a, 0x0c, 0x35, 0x5a, 0x82, 0x70,
+	0xbb, 0x60, 0xb4, 0xbb, 0x13, 0x25, 0x5d, 0x24, 0xa2, 0xba, 0x4a, 0xaa, 0x96, 0x75, 0x75, 0x95,
+	0x56, 0x70, 0x8d, 0x68, 0xdd, 0xca, 0x0e, 0x4d, 0xe3, 0x1b, 0x00, 0x8f, 0x27, 0xfb, 0xd3, 0xdc,
+	0x5e, 0x87, 0x83, 0x0a, 0xa1, 0xc8, 0xe2, 0xbe, 0x8c, 0xe4, 0x42, 0x55, 0x74, 0x39, 0x02, 0xbb,
+	0x57, 0xc2, 0x3e, 0xd9, 0x15, 0xb6, 0x82, 0x10, 0xc1, 0x7d, 0x07, 0xc0, 0xa2, 0xaa, 0x35, 0xe2,
+	0x55, 0xa5, 0xdb, 0xc4, 0x70, 0xcd, 0x40, 0xe4, 0x3a, 0x9e, 0xe5, 0x2b, 0x61, 0x6b, 0xb5, 0x41,
+	0xed, 0x75, 0x55, 0x8e, 0x7d, 0x95, 0x67, 0x5d, 0xc7, 0xd3, 0x56, 0x16, 0xe5, 0x3a, 0x5a, 0x8a,
+	0x41, 0xb9, 0x97, 0xe0, 0x7e, 0x07, 0xe0, 0xe9, 0x54, 0x20, 0x9f, 0xce, 0x18, 0xb7, 0x8e, 0xdf,
+	0x72, 0x93, 0xd7, 0xe8, 0x53, 0x70, 0xfc, 0x3a, 0xe1, 0x48, 0x77, 0xfc, 0xe2, 0xb5, 0x3b, 0x1c,
+	0xbf, 0x78, 0xe1, 0x27, 0x77, 0xfc, 0x3a, 0xfa, 0x4b, 0x59, 0x1a, 0x89, 0xe4, 0x1e, 0x7f, 0x69,
+	0xfc, 0x08, 0xe0, 0xb4, 0xc4, 0x7d, 0x09, 0x37, 0xec, 0x66, 0x43, 0x2e, 0x5e, 0xb9, 0x85, 0xfd,
+	0x37, 0x36, 0xb0, 0xcd, 0x5f, 0x73, 0x69, 0xd3, 0xe3, 0x65, 0x2f, 0x0c, 0x56, 0x11, 0x8e, 0xd4,
+	0x31, 0xb3, 0xb6, 0xaf, 0x99, 0x35, 0x42, 0x64, 0xcc, 0x86, 0x2a, 0xcf, 0xd4, 0x31, 0x2b, 0x87,
+	0xeb, 0x4b, 0x84, 0xa0, 0x53, 0xb0, 0x5f, 0xde, 0x33, 0x1a, 0xdc, 0xc1, 0x28, 0xcb, 0x8a, 0xd8,
+	0xaa, 0x28, 0x09, 0x74, 0x18, 0x0e, 0x63, 0xe9, 0xc9, 0x72, 0x3c, 0x59, 0x32, 0xc3, 0x95, 0x21,
+	0xac, 0x5d, 0xa3, 0x59, 0x88, 0xda, 0xaf, 0xb5, 0x5c, 0x9f, 0x94, 0x1a, 0x69, 0xed, 0xac, 0xe8,
+	0x0d, 0xe3, 0x4e, 0x2f, 0x3c, 0x95, 0x82, 0x8f, 0x4e, 0xc6, 0xcb, 0x70, 0x20, 0x20, 0xac, 0xd9,
+	0x08, 0x0b, 0x6d, 0x3c, 0x0e, 0xa5, 0x14, 0x88, 0xdc, 0x6a, 0x4a, 0x07, 0xad, 0xc1, 0xb1, 0x76,
+	0x68, 0x32, 0x26, 0xf2, 0x5c, 0x2c, 0xce, 0x09, 0x95, 0xdf, 0x1f, 0x4c, 0x3e, 0xa7, 0xf2, 0xc2,
+	0xaa, 0xeb, 0x25, 0x87, 0x9a, 0x2e, 0xe6, 0xf5, 0x52, 0xd9, 0xe3, 0x3f, 0xdf, 0x9d, 0x85, 0x3a,
+	0x61, 0x65, 0x8f, 0x2b, 0xcb, 0xa3, 0x6d, 0x84, 0x44, 0x28, 0x97, 0x21, 0xd4, 0xf1, 0xa1, 0x4d,
+	0x9e, 0xdb, 0xb7, 0x47, 0xdb, 0x3a, 0xc6, 0xcb, 0x4d, 0x6e, 0xdc, 0x07, 0x5d, 0x83, 0xb4, 0xdc,
+	0xe4, 0x4f, 0x38, 0xeb, 0x13, 0xed, 0xac, 0x76, 0x60, 0xcc, 0x9a, 0xf7, 0x4f, 0x7b, 0x61, 0x31,
+	0x0d, 0xa5, 0xff, 0x54, 0xe2, 0xdf, 0x6a, 0x3b, 0x18, 0x7b, 0x30, 0xdd, 0x3a, 0x4a, 0xc6, 0xe7,
+	0x00, 0xe6, 0xd4, 0x3b, 0xca, 0xe7, 0x8e, 0x8b, 0x1b, 0x9a, 0xa5, 0xca, 0xf2, 0x38, 0x1c, 0xaa,
+	0x12, 0x8f, 0xba, 0xc2, 0x95, 0x7a, 0xf3, 0x0f, 0xca, 0xe7, 0xb2, 0x27, 0xce, 0xa7, 0xda, 0x12,
+	0x89, 0x52, 0xaf, 0x7c, 0x25, 0x2b, 0xf2, 0x34, 0x06, 0x07, 0x94, 0x03, 0x9d, 0x42, 0xfd, 0x24,
+	0xec, 0xb9, 0x78, 0xc3, 0xaa, 0x53, 0x9f, 0xc9, 0xac, 0xfd, 0xaf, 0x32, 0xe8, 0xe2, 0x8d, 0x37,
+	0xa9, 0xcf, 0x44, 0xe6, 0xc5, 0x16, 0xf3, 0x1b, 0x0e, 0x67, 0xb9, 0x7e, 0xb9, 0x39, 0xec, 0xe2,
+	0x8d, 0x2b, 0x72, 0xc1, 0xf8, 0x10, 0xc0, 0xf1, 0x18, 0x98, 0x3a, 0x73, 0x67, 0xc3, 0x0a, 0x03,
+	0x1d, 0x2b, 0x6c, 0x67, 0xca, 0x74, 0xb1, 0x6d, 0xe7, 0xbb, 0x37, 0x7b, 0xbe, 0x8d, 0x0b, 0x70,
+	0x42, 0xb7, 0x56, 0xbb, 0x92, 0x14, 0x06, 0x2f, 0x07, 0x07, 0x71, 0xb5, 0x1a, 0x10, 0xc6, 0xc2,
+	0xd8, 0xe9, 0x47, 0xe3, 0x26, 0x2c, 0x74, 0x52, 0xd5, 0x84, 0xae, 0xc6, 0x16, 0xba, 0x62, 0x37,
+	0xb9, 0xbb, 0x6d, 0xd8, 0x65, 0x44, 0x5f, 0x0b, 0x31, 0xe7, 0xa1, 0xde, 0xc9, 0xef, 0x63, 0xbf,
+	0xf9, 0xbe, 0x07, 0x70, 0xb2, 0xa3, 0x2b, 0xcd, 0xf1, 0x1a, 0x3c, 0xd8, 0xce, 0x31, 0xbc, 0x00,
+	0x53, 0x92, 0x44, 0x6d, 0x24, 0x1f, 0xe3, 0x35, 0xf8, 0x4a, 0x6b, 0x3e, 0xd9, 0xe5, 0xe3, 0x0a,
+	0xc7, 0x9c, 0x75, 0x4f, 0x73, 0x0d, 0x4e, 0x25, 0xea, 0xeb, 0x38, 0xbc, 0x0a, 0xfb, 0x99, 0x58,
+	0xe8, 0x34, 0x56, 0xc4, 0x29, 0x6b, 0xfa, 0x4a, 0x71, 0xe1, 0xcb, 0x11, 0xd8, 0x2f, 0x3d, 0xa1,
+	0x75, 0x38, 0xa0, 0x06, 0x2e, 0x74, 0x34, 0x6a, 0xa6, 0x7d, 0x9e, 0xcb, 0x1f, 0x4b, 0x90, 0x50,
+	0xd0, 0x8c, 0x23, 0xef, 0xfd, 0xf2, 0xd7, 0xc7, 0xbd, 0x63, 0x68, 0xd4, 0x8c, 0x19, 0x49, 0xd1,
+	0x1f, 0x00, 0x8e, 0xc5, 0x37, 0xad, 0x68, 0x2e, 0xc6, 0x76, 0xe2, 0x98, 0x97, 0x9f, 0xcf, 0xa0,
+	0xa1, 0xd1, 0xbd, 0x2b, 0xd1, 0x5d, 0x43, 0x57, 0xa3, 0xe8, 0xb0, 0xbd, 0x6e, 0xdd, 0xc2, 0x0e,
+	0x17, 0xa3, 0x80, 0x6e, 0x8d, 0x4c, 0x47, 0x5b, 0x32, 0x37, 0x77, 0x34, 0xb3, 0x5b, 0xe6, 0x66,
+	0xb4, 0x75, 0x15, 0x0b, 0xba, 0x2b, 0xdd, 0x42, 0x77, 0x01, 0x3c, 0x14, 0x0f, 0x80, 0xa1, 0xf4,
+	0x60, 0x5b, 0xd1, 0x5e, 0xc8, 0xa2, 0xa2, 0x09, 0x2e, 0x48, 0x82, 0x33, 0xa8, 0x98, 0x9e, 0x20,
+	0xfa, 0x1b, 0xc0, 0x42, 0xf2, 0x40, 0x82, 0xce, 0xc7, 0x25, 0x3e, 0xcd, 0xa0, 0x95, 0xbf, 0xb0,
+	0x07, 0x4d, 0xcd, 0x65, 0x59, 0x72, 0x29, 0xa3, 0xcb, 0xe9, 0xb9, 0x84, 0x03, 0x9d, 0xb9, 0xd9,
+	0x3e, 0xdd, 0x6d, 0xa1, 0xdf, 0x00, 0x1c, 0x8b, 0xef, 0x8b, 0x63, 0xab, 0x2f, 0x71, 0xca, 0xc9,
+	0xcf, 0x67, 0xd0, 0xd0, 0x84, 0xae, 0x4b, 0x42, 0x57, 0x51, 0xc5, 0x4c, 0xfc, 0x26, 0xc3, 0x4c,
+	0xaa, 0xed, 0x64, 0xa8, 0xbd, 0xaf, 0x01, 0x3c, 0x14, 0xef, 0x3e, 0xbe, 0xf6, 0x92, 0xe7, 0x99,
+	0xfc, 0x42, 0x16, 0x15, 0x4d, 0x6f, 0x4e, 0xd2, 0x2b, 0xa2, 0xe9, 0xb4, 0xf4, 0xd0, 0x0f, 0x00,
+	0x1e, 0x49, 0x6a, 0xb0, 0xd1, 0x8b, 0x31, 0x30, 0x52, 0x4c, 0x18, 0xf9, 0x73, 0x99, 0xf5, 0x34,
+	0x87, 0x8b, 0x92, 0xc3, 0xd9, 0x8b, 0xa0, 0x68, 0x98, 0x51, 0x1a, 0xf6, 0xb6, 0x3a, 0x93, 0x2b,
+	0x16, 0x11, 0x16, 0xac, 0x56, 0x67, 0x85, 0x7e, 0x02, 0x70, 0x22, 0xb1, 0x6d, 0x44, 0xd9, 0x60,
+	0x6d, 0xf7, 0xce, 0xf9, 0xf3, 0xd9, 0x15, 0x35, 0xa1, 0x97, 0x24, 0xa1, 0x17, 0x04, 0xa1, 0xb9,
+	0x4c, 0x84, 0x68, 0x93, 0xa3, 0xcf, 0x00, 0x1c, 0x69, 0xbb, 0x4d, 0xd0, 0xe9, 0xd8, 0xf7, 0x52,
+	0x7c, 0x4b, 0x93, 0x9f, 0x49, 0x27, 0xac, 0xd1, 0x9e, 0x91, 0x68, 0x67, 0xd1, 0x69, 0xb3, 0xcb,
+	0x07, 0x49, 0x66, 0x6e, 0xea, 0x3b, 0x73, 0x0b, 0x7d, 0x02, 0x20, 0x2a, 0xb7, 0x5f, 0xea, 0xa9,
+	0x3c, 0xb7, 0x0a, 0x7e, 0x36, 0xa5, 0xb4, 0x06, 0x7a, 0x4a, 0x02, 0x9d, 0x42, 0xc7, 0xba, 0x02,
+	0x45, 0xdf, 0xca, 0x3b, 0x2f, 0xee, 0x4a, 0xee, 0x70, 0xe7, 0x25, 0xb4, 0x0e, 0xf9, 0xf9, 0x0c,
+	0x1a, 0x1a, 0xea, 0x05, 0x09, 0xf5, 0x0c, 0x9a, 0xcf, 0x10, 0x53, 0x53, 0x76, 0x09, 0xe8, 0x7d,
+	0x00, 0x0f, 0xec, 0xec, 0x9e, 0xd1, 0x89, 0xb8, 0xd7, 0x42, 0xfb, 0x14, 0x90, 0x3f, 0xd9, 0x55,
+	0x4e, 0x83, 0x9b, 0x92, 0xe0, 0x26, 0xd0, 0xe1, 0x28, 0x38, 0xaa, 0x64, 0x2d, 0xd9, 0x75, 0x2f,
+	0x2e, 0xdd, 0x7b, 0x58, 0x00, 0xf7, 0x1f, 0x16, 0xc0, 0x9f, 0x0f, 0x0b, 0xe0, 0xa3, 0x47, 0x85,
+	0x9e, 0xfb, 0x8f, 0x0a, 0x3d, 0xbf, 0x3e, 0x2a, 0xf4, 0x5c, 0x9f, 0xa9, 0x39, 0xbc, 0xde, 0x5c,
+	0x2d, 0xd9, 0xd4, 0x0d, 0x0d, 0x34, 0xf0, 0x6d, 0x12, 0xb4, 0xac, 0x6d, 0x28, 0x7b, 0xfc, 0xb6,
+	0x4f, 0xd8, 0xea, 0x80, 0xfc, 0x56, 0x7d, 0xe6, 0xdf, 0x01, 0x00, 0x15, 0xe2, 0x1d, 0x45, 0xe4,
+	0x17, 0x00, 0x00,
 }
 
-func (m *QueryIncomingInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of IncomingInFlightPacket items.
+	IncomingInFlightPacket(ctx context.Context, in *QueryIncomingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketResponse, error)
+	IncomingInFlightPackets(ctx context.Context, in *QueryIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketsResponse, error)
+	// Queries the IncomingInFlightPacket items pending for at least a number of blocks
+	PendingIncomingInFlightPackets(ctx context.Context, in *QueryPendingIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIncomingInFlightPacketsResponse, error)
+	// Queries a list of OutgoingInFlightPacket items.
+	OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error)
+	OutgoingInFlightPackets(ctx context.Context, in *QueryOutgoingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketsResponse, error)
+	// Queries a Calculation swap value.
+	CalculationSwapExactAmountIn(ctx context.Context, in *QueryCalculationSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountInResponse, error)
+	CalculationSwapExactAmountOut(ctx context.Context, in *QueryCalculationSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountOutResponse, error)
+	// Queries an InterfaceProvider by its address.
+	InterfaceProvider(ctx context.Context, in *QueryInterfaceProviderRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderResponse, error)
+	// Queries a list of InterfaceProvider items.
+	InterfaceProviders(ctx context.Context, in *QueryInterfaceProvidersRequest, opts ...grpc.CallOption) (*QueryInterfaceProvidersResponse, error)
+	// Queries the cumulative volume and fees of the swaps of an interface provider.
+	InterfaceProviderStats(ctx context.Context, in *QueryInterfaceProviderStatsRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderStatsResponse, error)
+	// Queries the route with the largest output for an exact input amount
+	OptimalRoute(ctx context.Context, in *QueryOptimalRouteRequest, opts ...grpc.CallOption) (*QueryOptimalRouteResponse, error)
 }
 
-func (m *QueryIncomingInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryIncomingInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryIncomingInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIncomingInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncomingInFlightPacket(ctx context.Context, in *QueryIncomingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketResponse, error) {
+	out := new(QueryIncomingInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/IncomingInFlightPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIncomingInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Packets) > 0 {
-		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) IncomingInFlightPackets(ctx context.Context, in *QueryIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryIncomingInFlightPacketsResponse, error) {
+	out := new(QueryIncomingInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/IncomingInFlightPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingIncomingInFlightPackets(ctx context.Context, in *QueryPendingIncomingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryPendingIncomingInFlightPacketsResponse, error) {
+	out := new(QueryPendingIncomingInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/PendingIncomingInFlightPackets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OutgoingInFlightPacket(ctx context.Context, in *QueryOutgoingInFlightPacketRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketResponse, error) {
+	out := new(QueryOutgoingInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/OutgoingInFlightPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SrcChannelId) > 0 {
-		i -= len(m.SrcChannelId)
-		copy(dAtA[i:], m.SrcChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SrcPortId) > 0 {
-		i -= len(m.SrcPortId)
-		copy(dAtA[i:], m.SrcPortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcPortId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) OutgoingInFlightPackets(ctx context.Context, in *QueryOutgoingInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryOutgoingInFlightPacketsResponse, error) {
+	out := new(QueryOutgoingInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/OutgoingInFlightPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CalculationSwapExactAmountIn(ctx context.Context, in *QueryCalculationSwapExactAmountInRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountInResponse, error) {
+	out := new(QueryCalculationSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/CalculationSwapExactAmountIn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOutgoingInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) CalculationSwapExactAmountOut(ctx context.Context, in *QueryCalculationSwapExactAmountOutRequest, opts ...grpc.CallOption) (*QueryCalculationSwapExactAmountOutResponse, error) {
+	out := new(QueryCalculationSwapExactAmountOutResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/CalculationSwapExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InterfaceProvider(ctx context.Context, in *QueryInterfaceProviderRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderResponse, error) {
+	out := new(QueryInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/InterfaceProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InterfaceProviders(ctx context.Context, in *QueryInterfaceProvidersRequest, opts ...grpc.CallOption) (*QueryInterfaceProvidersResponse, error) {
+	out := new(QueryInterfaceProvidersResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/InterfaceProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) InterfaceProviderStats(ctx context.Context, in *QueryInterfaceProviderStatsRequest, opts ...grpc.CallOption) (*QueryInterfaceProviderStatsResponse, error) {
+	out := new(QueryInterfaceProviderStatsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/InterfaceProviderStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OptimalRoute(ctx context.Context, in *QueryOptimalRouteRequest, opts ...grpc.CallOption) (*QueryOptimalRouteResponse, error) {
+	out := new(QueryOptimalRouteResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Query/OptimalRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of IncomingInFlightPacket items.
+	IncomingInFlightPacket(context.Context, *QueryIncomingInFlightPacketRequest) (*QueryIncomingInFlightPacketResponse, error)
+	IncomingInFlightPackets(context.Context, *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error)
+	// Queries the IncomingInFlightPacket items pending for at least a number of blocks
+	PendingIncomingInFlightPackets(context.Context, *QueryPendingIncomingInFlightPacketsRequest) (*QueryPendingIncomingInFlightPacketsResponse, error)
+	// Queries a list of OutgoingInFlightPacket items.
+	OutgoingInFlightPacket(context.Context, *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error)
+	OutgoingInFlightPackets(context.Context, *QueryOutgoingInFlightPacketsRequest) (*QueryOutgoingInFlightPacketsResponse, error)
+	// Queries a Calculation swap value.
+	CalculationSwapExactAmountIn(context.Context, *QueryCalculationSwapExactAmountInRequest) (*QueryCalculationSwapExactAmountInResponse, error)
+	CalculationSwapExactAmountOut(context.Context, *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error)
+	// Queries an InterfaceProvider by its address.
+	InterfaceProvider(context.Context, *QueryInterfaceProviderRequest) (*QueryInterfaceProviderResponse, error)
+	// Queries a list of InterfaceProvider items.
+	InterfaceProviders(context.Context, *QueryInterfaceProvidersRequest) (*QueryInterfaceProvidersResponse, error)
+	// Queries the cumulative volume and fees of the swaps of an interface provider.
+	InterfaceProviderStats(context.Context, *QueryInterfaceProviderStatsRequest) (*QueryInterfaceProviderStatsResponse, error)
+	// Queries the route with the largest output for an exact input amount
+	OptimalRoute(context.Context, *QueryOptimalRouteRequest) (*QueryOptimalRouteResponse, error)
 }
 
-func (m *QueryOutgoingInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Packets) > 0 {
-		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryCalculationSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) IncomingInFlightPacket(ctx context.Context, req *QueryIncomingInFlightPacketRequest) (*QueryIncomingInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncomingInFlightPacket not implemented")
+}
+func (*UnimplementedQueryServer) IncomingInFlightPackets(ctx context.Context, req *QueryIncomingInFlightPacketsRequest) (*QueryIncomingInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncomingInFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) PendingIncomingInFlightPackets(ctx context.Context, req *QueryPendingIncomingInFlightPacketsRequest) (*QueryPendingIncomingInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingIncomingInFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) OutgoingInFlightPacket(ctx context.Context, req *QueryOutgoingInFlightPacketRequest) (*QueryOutgoingInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingInFlightPacket not implemented")
+}
+func (*UnimplementedQueryServer) OutgoingInFlightPackets(ctx context.Context, req *QueryOutgoingInFlightPacketsRequest) (*QueryOutgoingInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingInFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) CalculationSwapExactAmountIn(ctx context.Context, req *QueryCalculationSwapExactAmountInRequest) (*QueryCalculationSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculationSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) CalculationSwapExactAmountOut(ctx context.Context, req *QueryCalculationSwapExactAmountOutRequest) (*QueryCalculationSwapExactAmountOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculationSwapExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) InterfaceProvider(ctx context.Context, req *QueryInterfaceProviderRequest) (*QueryInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProvider not implemented")
+}
+func (*UnimplementedQueryServer) InterfaceProviders(ctx context.Context, req *QueryInterfaceProvidersRequest) (*QueryInterfaceProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProviders not implemented")
+}
+func (*UnimplementedQueryServer) InterfaceProviderStats(ctx context.Context, req *QueryInterfaceProviderStatsRequest) (*QueryInterfaceProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterfaceProviderStats not implemented")
+}
+func (*UnimplementedQueryServer) OptimalRoute(ctx context.Context, req *QueryOptimalRouteRequest) (*QueryOptimalRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimalRoute not implemented")
 }
 
-func (m *QueryCalculationSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryCalculationSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountIn) > 0 {
-		i -= len(m.AmountIn)
-		copy(dAtA[i:], m.AmountIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountIn)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.HasInterfaceFee {
-		i--
-		if m.HasInterfaceFee {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/Params",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IncomingInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncomingInFlightPacketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCalculationSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).IncomingInFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/IncomingInFlightPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncomingInFlightPacket(ctx, req.(*QueryIncomingInFlightPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AmountOut.Size()
-		i -= size
-		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_IncomingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncomingInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.InterfaceProviderFee.Size()
-		i -= size
-		if _, err := m.InterfaceProviderFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).IncomingInFlightPackets(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/IncomingInFlightPackets",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncomingInFlightPackets(ctx, req.(*QueryIncomingInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingIncomingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingIncomingInFlightPacketsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCalculationSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCalculationSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountOut) > 0 {
-		i -= len(m.AmountOut)
-		copy(dAtA[i:], m.AmountOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountOut)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).PendingIncomingInFlightPackets(ctx, in)
 	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/PendingIncomingInFlightPackets",
 	}
-	if m.HasInterfaceFee {
-		i--
-		if m.HasInterfaceFee {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingIncomingInFlightPackets(ctx, req.(*QueryPendingIncomingInFlightPacketsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutgoingInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutgoingInFlightPacketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingInFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/OutgoingInFlightPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingInFlightPacket(ctx, req.(*QueryOutgoingInFlightPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OutgoingInFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutgoingInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingInFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/OutgoingInFlightPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingInFlightPackets(ctx, req.(*QueryOutgoingInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculationSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CalculationSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculationSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.InterfaceProviderFee.Size()
-		i -= size
-		if _, err := m.InterfaceProviderFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).CalculationSwapExactAmountIn(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/CalculationSwapExactAmountIn",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculationSwapExactAmountIn(ctx, req.(*QueryCalculationSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_CalculationSwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculationSwapExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).CalculationSwapExactAmountOut(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/CalculationSwapExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculationSwapExactAmountOut(ctx, req.(*QueryCalculationSwapExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_InterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/InterfaceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProvider(ctx, req.(*QueryInterfaceProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIncomingInFlightPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_InterfaceProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SrcPortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProviders(ctx, in)
 	}
-	l = len(m.SrcChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/InterfaceProviders",
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProviders(ctx, req.(*QueryInterfaceProvidersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIncomingInFlightPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_InterfaceProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterfaceProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryIncomingInFlightPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIncomingInFlightPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).InterfaceProviderStats(ctx, in)
 	}
-	var l int
-	_ = l
-	if len(m.Packets) > 0 {
-		for _, e := range m.Packets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/InterfaceProviderStats",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterfaceProviderStats(ctx, req.(*QueryInterfaceProviderStatsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOutgoingInFlightPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OptimalRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOptimalRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SrcPortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OptimalRoute(ctx, in)
 	}
-	l = len(m.SrcChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Query/OptimalRoute",
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptimalRoute(ctx, req.(*QueryOptimalRouteRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOutgoingInFlightPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.swap.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "IncomingInFlightPacket",
+			Handler:    _Query_IncomingInFlightPacket_Handler,
+		},
+		{
+			MethodName: "IncomingInFlightPackets",
+			Handler:    _Query_IncomingInFlightPackets_Handler,
+		},
+		{
+			MethodName: "PendingIncomingInFlightPackets",
+			Handler:    _Query_PendingIncomingInFlightPackets_Handler,
+		},
+		{
+			MethodName: "OutgoingInFlightPacket",
+			Handler:    _Query_OutgoingInFlightPacket_Handler,
+		},
+		{
+			MethodName: "OutgoingInFlightPackets",
+			Handler:    _Query_OutgoingInFlightPackets_Handler,
+		},
+		{
+			MethodName: "CalculationSwapExactAmountIn",
+			Handler:    _Query_CalculationSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "CalculationSwapExactAmountOut",
+			Handler:    _Query_CalculationSwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "InterfaceProvider",
+			Handler:    _Query_InterfaceProvider_Handler,
+		},
+		{
+			MethodName: "InterfaceProviders",
+			Handler:    _Query_InterfaceProviders_Handler,
+		},
+		{
+			MethodName: "InterfaceProviderStats",
+			Handler:    _Query_InterfaceProviderStats_Handler,
+		},
+		{
+			MethodName: "OptimalRoute",
+			Handler:    _Query_OptimalRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/swap/query.proto",
 }
 
-func (m *QueryOutgoingInFlightPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutgoingInFlightPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Packets) > 0 {
-		for _, e := range m.Packets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncomingInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomingInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasInterfaceFee {
-		n += 2
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SrcChannelId) > 0 {
+		i -= len(m.SrcChannelId)
+		copy(dAtA[i:], m.SrcChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AmountIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SrcPortId) > 0 {
+		i -= len(m.SrcPortId)
+		copy(dAtA[i:], m.SrcPortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcPortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculationSwapExactAmountInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncomingInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Result.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.InterfaceProviderFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AmountOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCalculationSwapExactAmountOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncomingInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasInterfaceFee {
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomingInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomingInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomingInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomingInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomingInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MinPendingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPendingBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutgoingInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SrcChannelId) > 0 {
+		i -= len(m.SrcChannelId)
+		copy(dAtA[i:], m.SrcChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcPortId) > 0 {
+		i -= len(m.SrcPortId)
+		copy(dAtA[i:], m.SrcPortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutgoingInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutgoingInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutgoingInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterfaceProvider) > 0 {
+		i -= len(m.InterfaceProvider)
+		copy(dAtA[i:], m.InterfaceProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterfaceProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountIn) > 0 {
+		i -= len(m.AmountIn)
+		copy(dAtA[i:], m.AmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasInterfaceFee {
+		i--
+		if m.HasInterfaceFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InterfaceProviderFee.Size()
+		i -= size
+		if _, err := m.InterfaceProviderFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterfaceProvider) > 0 {
+		i -= len(m.InterfaceProvider)
+		copy(dAtA[i:], m.InterfaceProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterfaceProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountOut) > 0 {
+		i -= len(m.AmountOut)
+		copy(dAtA[i:], m.AmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasInterfaceFee {
+		i--
+		if m.HasInterfaceFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculationSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculationSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculationSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InterfaceProviderFee.Size()
+		i -= size
+		if _, err := m.InterfaceProviderFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOptimalRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOptimalRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOptimalRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomIn) > 0 {
+		i -= len(m.DenomIn)
+		copy(dAtA[i:], m.DenomIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOptimalRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOptimalRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOptimalRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InterfaceProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterfaceProviders) > 0 {
+		for iNdEx := len(m.InterfaceProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterfaceProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProviderStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProviderStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProviderStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterfaceProviderStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterfaceProviderStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterfaceProviderStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncomingInFlightPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcPortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryIncomingInFlightPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncomingInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncomingInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingIncomingInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinPendingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MinPendingBlocks))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingIncomingInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutgoingInFlightPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcPortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryOutgoingInFlightPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutgoingInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutgoingInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculationSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasInterfaceFee {
+		n += 2
+	}
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterfaceProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculationSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InterfaceProviderFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalculationSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasInterfaceFee {
 		n += 2
 	}
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterfaceProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculationSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InterfaceProviderFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOptimalRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *QueryOptimalRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInterfaceProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterfaceProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InterfaceProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInterfaceProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterfaceProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterfaceProviders) > 0 {
+		for _, e := range m.InterfaceProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterfaceProviderStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterfaceProviderStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncomingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, IncomingInFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingIncomingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPendingBlocks", wireType)
+			}
+			m.MinPendingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPendingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingIncomingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, IncomingInFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.AmountOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutgoingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryCalculationSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Result.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.InterfaceProviderFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AmountIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryOutgoingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutgoingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,16 +4389,86 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, OutgoingInFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2000,7 +4490,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalculationSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2023,15 +4513,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasInterfaceFee = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2058,10 +4568,77 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Route == nil {
+				m.Route = &Route{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterfaceProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2083,7 +4660,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,17 +4683,17 @@ func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2126,27 +4703,28 @@ func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2174,13 +4752,15 @@ func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.InterfaceProviderFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2190,11 +4770,26 @@ func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2216,7 +4811,7 @@ func (m *QueryIncomingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncomingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalculationSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,15 +4834,35 @@ func (m *QueryIncomingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasInterfaceFee = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2267,16 +4882,83 @@ func (m *QueryIncomingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &Route{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InterfaceProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2299,7 +4981,7 @@ func (m *QueryIncomingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncomingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalculationSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2322,15 +5004,15 @@ func (m *QueryIncomingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2357,68 +5039,15 @@ func (m *QueryIncomingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncomingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2428,31 +5057,31 @@ func (m *QueryIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Packets = append(m.Packets, IncomingInFlightPacket{})
-			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InterfaceProviderFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2462,25 +5091,23 @@ func (m *QueryIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2505,7 +5132,7 @@ func (m *QueryIncomingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOptimalRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,15 +5155,15 @@ func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOptimalRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOptimalRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,11 +5191,11 @@ func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcPortId = string(dAtA[iNdEx:postIndex])
+			m.DenomIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2596,13 +5223,45 @@ func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcChannelId = string(dAtA[iNdEx:postIndex])
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
 			}
-			m.Sequence = 0
+			m.MaxHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2612,7 +5271,26 @@ func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.MaxHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2638,7 +5316,7 @@ func (m *QueryOutgoingInFlightPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOptimalRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2661,15 +5339,15 @@ func (m *QueryOutgoingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOptimalRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOptimalRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2696,7 +5374,40 @@ func (m *QueryOutgoingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2721,7 +5432,7 @@ func (m *QueryOutgoingInFlightPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2744,17 +5455,17 @@ func (m *QueryOutgoingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2764,27 +5475,23 @@ func (m *QueryOutgoingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2807,7 +5514,7 @@ func (m *QueryOutgoingInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2830,49 +5537,15 @@ func (m *QueryOutgoingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Packets = append(m.Packets, OutgoingInFlightPacket{})
-			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2899,10 +5572,7 @@ func (m *QueryOutgoingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InterfaceProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2927,7 +5597,7 @@ func (m *QueryOutgoingInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculationSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2950,35 +5620,15 @@ func (m *QueryCalculationSwapExactAmountInRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasInterfaceFee = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3005,45 +5655,13 @@ func (m *QueryCalculationSwapExactAmountInRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &Route{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmountIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3065,7 +5683,7 @@ func (m *QueryCalculationSwapExactAmountInRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3088,15 +5706,15 @@ func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3123,15 +5741,16 @@ func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterfaceProviders = append(m.InterfaceProviders, InterfaceProvider{})
+			if err := m.InterfaceProviders[len(m.InterfaceProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3141,57 +5760,25 @@ func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterfaceProviderFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3216,7 +5803,7 @@ func (m *QueryCalculationSwapExactAmountInResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryCalculationSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProviderStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3239,71 +5826,15 @@ func (m *QueryCalculationSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProviderStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProviderStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasInterfaceFee", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasInterfaceFee = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Route == nil {
-				m.Route = &Route{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,7 +5862,7 @@ func (m *QueryCalculationSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountOut = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3354,7 +5885,7 @@ func (m *QueryCalculationSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryCalculationSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterfaceProviderStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3377,15 +5908,15 @@ func (m *QueryCalculationSwapExactAmountOutResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterfaceProviderStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculationSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterfaceProviderStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3412,75 +5943,7 @@ func (m *QueryCalculationSwapExactAmountOutResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceProviderFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InterfaceProviderFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
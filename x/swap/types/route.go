@@ -201,6 +201,7 @@ func (route *Route) InspectRoute(
 
 		for i, w := range strategy.Parallel.Weights[:length-1] {
 			amountsExact[i] = w.MulInt(amountExact).Quo(weightSum).TruncateInt()
+			amountsExactSum = amountsExactSum.Add(amountsExact[i])
 		}
 		// For avoiding rounding errors
 		amountsExact[length-1] = amountExact.Sub(amountsExactSum)
@@ -234,3 +235,58 @@ func (route *Route) InspectRoute(
 
 	return amountResult, routeResult, fmt.Errorf("unknown strategy: %s", route.Strategy)
 }
+
+const (
+	// DefaultRouteMaxHops is the maximum number of pools in a path of the optimal route search if unspecified
+	DefaultRouteMaxHops = 3
+	// MaxRouteHops is the upper bound of the number of pools in a path of the optimal route search
+	MaxRouteHops = 5
+	// MaxRouteSplits is the upper bound of the number of parallel paths of the optimal route search
+	MaxRouteSplits = 5
+	// MaxRouteCandidates is the maximum number of paths simulated in the optimal route search
+	MaxRouteCandidates = 100
+	// RouteSplitParts is the number of parts the amount is divided into when splitting it among paths
+	RouteSplitParts = 10
+	// RouteSearchGasLimit is the gas available for the simulations of the optimal route search
+	RouteSearchGasLimit uint64 = 50_000_000
+)
+
+// NewPathRoute returns a route through the pools in order, where denoms[i] and denoms[i+1]
+// are the input and output denoms of poolIds[i]
+func NewPathRoute(denoms []string, poolIds []uint64) Route {
+	routes := make([]Route, len(poolIds))
+	for i, poolId := range poolIds {
+		routes[i] = Route{
+			DenomIn:  denoms[i],
+			DenomOut: denoms[i+1],
+			Strategy: &Route_Pool{
+				Pool: &RoutePool{PoolId: poolId},
+			},
+		}
+	}
+	if len(routes) == 1 {
+		return routes[0]
+	}
+
+	return Route{
+		DenomIn:  denoms[0],
+		DenomOut: denoms[len(denoms)-1],
+		Strategy: &Route_Series{
+			Series: &RouteSeries{Routes: routes},
+		},
+	}
+}
+
+// NewParallelRoute returns a route splitting the input among the routes by the weights
+func NewParallelRoute(denomIn, denomOut string, routes []Route, weights []math.LegacyDec) Route {
+	return Route{
+		DenomIn:  denomIn,
+		DenomOut: denomOut,
+		Strategy: &Route_Parallel{
+			Parallel: &RouteParallel{
+				Routes:  routes,
+				Weights: weights,
+			},
+		},
+	}
+}
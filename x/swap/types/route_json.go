@@ -0,0 +1,25 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+)
+
+// MarshalJSON marshals the route with the protobuf JSON mapping, so that the
+// strategy oneof is written under the key of its field, as in the swap memo
+// of an ICS20 packet.
+func (route Route) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, &route); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals the route with the protobuf JSON mapping. The
+// strategy oneof cannot be decoded by encoding/json.
+func (route *Route) UnmarshalJSON(bz []byte) error {
+	return jsonpb.Unmarshal(bytes.NewReader(bz), route)
+}
@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunriselayer/sunrise/x/swap/types"
+)
+
+func TestPacketMetadataRouteJSON(t *testing.T) {
+	memo := `{"swap":{"route":{"denom_in":"a","denom_out":"c","series":{"routes":[{"denom_in":"a","denom_out":"b","pool":{"pool_id":"1"}},{"denom_in":"b","denom_out":"c","pool":{"pool_id":2}}]}},"exact_amount_in":{"min_amount_out":"1"}}}`
+
+	var metadata types.PacketMetadata
+	require.NoError(t, json.Unmarshal([]byte(memo), &metadata))
+	require.Equal(t, types.Route{
+		DenomIn:  "a",
+		DenomOut: "c",
+		Strategy: &types.Route_Series{
+			Series: &types.RouteSeries{
+				Routes: []types.Route{
+					{DenomIn: "a", DenomOut: "b", Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 1}}},
+					{DenomIn: "b", DenomOut: "c", Strategy: &types.Route_Pool{Pool: &types.RoutePool{PoolId: 2}}},
+				},
+			},
+		},
+	}, metadata.Swap.Route)
+	require.NoError(t, metadata.Swap.Validate())
+
+	bz, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	var decoded types.PacketMetadata
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, metadata.Swap.Route, decoded.Swap.Route)
+}
@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -131,6 +135,11 @@ type MsgSwapExactAmountIn struct {
 	Route             Route                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route"`
 	AmountIn          cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in"`
 	MinAmountOut      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+	// price_limit stops the swap at the given spot price of the pool, the rest
+	// of amount_in being left to the sender. Only for a route of a single pool.
+	PriceLimit *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=price_limit,json=priceLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_limit,omitempty"`
+	// deadline is the block time after which the swap fails.
+	Deadline *time.Time `protobuf:"bytes,7,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -187,6 +196,13 @@ func (m *MsgSwapExactAmountIn) GetRoute() Route {
 	return Route{}
 }
 
+func (m *MsgSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountInResponse struct {
 	Result               RouteResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 	InterfaceProviderFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3,customtype=cosmossdk.io/math.Int" json:"interface_provider_fee"`
@@ -239,6 +255,11 @@ type MsgSwapExactAmountOut struct {
 	Route             Route                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route"`
 	MaxAmountIn       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_amount_in,json=maxAmountIn,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_in"`
 	AmountOut         cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out"`
+	// price_limit makes the swap fail if the spot price of the pool reaches it
+	// before amount_out. Only for a route of a single pool.
+	PriceLimit *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=price_limit,json=priceLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_limit,omitempty"`
+	// deadline is the block time after which the swap fails.
+	Deadline *time.Time `protobuf:"bytes,7,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -295,6 +316,13 @@ func (m *MsgSwapExactAmountOut) GetRoute() Route {
 	return Route{}
 }
 
+func (m *MsgSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	Result               RouteResult           `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 	InterfaceProviderFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=interface_provider_fee,json=interfaceProviderFee,proto3,customtype=cosmossdk.io/math.Int" json:"interface_provider_fee"`
@@ -341,6 +369,303 @@ func (m *MsgSwapExactAmountOutResponse) GetResult() RouteResult {
 	return RouteResult{}
 }
 
+// MsgRegisterInterfaceProvider is the Msg/RegisterInterfaceProvider request
+// type.
+type MsgRegisterInterfaceProvider struct {
+	Provider  string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Metadata  string     `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FeeShares []FeeShare `protobuf:"bytes,3,rep,name=fee_shares,json=feeShares,proto3" json:"fee_shares"`
+}
+
+func (m *MsgRegisterInterfaceProvider) Reset()         { *m = MsgRegisterInterfaceProvider{} }
+func (m *MsgRegisterInterfaceProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterInterfaceProvider) ProtoMessage()    {}
+func (*MsgRegisterInterfaceProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{6}
+}
+func (m *MsgRegisterInterfaceProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterInterfaceProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterInterfaceProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterInterfaceProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterInterfaceProvider.Merge(m, src)
+}
+func (m *MsgRegisterInterfaceProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterInterfaceProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterInterfaceProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterInterfaceProvider proto.InternalMessageInfo
+
+func (m *MsgRegisterInterfaceProvider) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgRegisterInterfaceProvider) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgRegisterInterfaceProvider) GetFeeShares() []FeeShare {
+	if m != nil {
+		return m.FeeShares
+	}
+	return nil
+}
+
+// MsgRegisterInterfaceProviderResponse defines the
+// Msg/RegisterInterfaceProvider response type.
+type MsgRegisterInterfaceProviderResponse struct {
+}
+
+func (m *MsgRegisterInterfaceProviderResponse) Reset()         { *m = MsgRegisterInterfaceProviderResponse{} }
+func (m *MsgRegisterInterfaceProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterInterfaceProviderResponse) ProtoMessage()    {}
+func (*MsgRegisterInterfaceProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{7}
+}
+func (m *MsgRegisterInterfaceProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterInterfaceProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterInterfaceProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterInterfaceProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterInterfaceProviderResponse.Merge(m, src)
+}
+func (m *MsgRegisterInterfaceProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterInterfaceProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterInterfaceProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterInterfaceProviderResponse proto.InternalMessageInfo
+
+// MsgUpdateInterfaceProvider is the Msg/UpdateInterfaceProvider request type.
+type MsgUpdateInterfaceProvider struct {
+	Provider  string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Metadata  string     `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FeeShares []FeeShare `protobuf:"bytes,3,rep,name=fee_shares,json=feeShares,proto3" json:"fee_shares"`
+}
+
+func (m *MsgUpdateInterfaceProvider) Reset()         { *m = MsgUpdateInterfaceProvider{} }
+func (m *MsgUpdateInterfaceProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInterfaceProvider) ProtoMessage()    {}
+func (*MsgUpdateInterfaceProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{8}
+}
+func (m *MsgUpdateInterfaceProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInterfaceProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInterfaceProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInterfaceProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInterfaceProvider.Merge(m, src)
+}
+func (m *MsgUpdateInterfaceProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInterfaceProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInterfaceProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInterfaceProvider proto.InternalMessageInfo
+
+func (m *MsgUpdateInterfaceProvider) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgUpdateInterfaceProvider) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgUpdateInterfaceProvider) GetFeeShares() []FeeShare {
+	if m != nil {
+		return m.FeeShares
+	}
+	return nil
+}
+
+// MsgUpdateInterfaceProviderResponse defines the Msg/UpdateInterfaceProvider
+// response type.
+type MsgUpdateInterfaceProviderResponse struct {
+}
+
+func (m *MsgUpdateInterfaceProviderResponse) Reset()         { *m = MsgUpdateInterfaceProviderResponse{} }
+func (m *MsgUpdateInterfaceProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInterfaceProviderResponse) ProtoMessage()    {}
+func (*MsgUpdateInterfaceProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{9}
+}
+func (m *MsgUpdateInterfaceProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInterfaceProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInterfaceProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInterfaceProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInterfaceProviderResponse.Merge(m, src)
+}
+func (m *MsgUpdateInterfaceProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInterfaceProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInterfaceProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInterfaceProviderResponse proto.InternalMessageInfo
+
+// MsgSetInterfaceProviderFeeRate is the Msg/SetInterfaceProviderFeeRate
+// request type.
+type MsgSetInterfaceProviderFeeRate struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// fee_rate is the interface fee rate of the provider, the interface_fee_rate
+	// param applying again if it is not set
+	FeeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate,omitempty"`
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) Reset()         { *m = MsgSetInterfaceProviderFeeRate{} }
+func (m *MsgSetInterfaceProviderFeeRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInterfaceProviderFeeRate) ProtoMessage()    {}
+func (*MsgSetInterfaceProviderFeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{10}
+}
+func (m *MsgSetInterfaceProviderFeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInterfaceProviderFeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInterfaceProviderFeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInterfaceProviderFeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInterfaceProviderFeeRate.Merge(m, src)
+}
+func (m *MsgSetInterfaceProviderFeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInterfaceProviderFeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInterfaceProviderFeeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInterfaceProviderFeeRate proto.InternalMessageInfo
+
+func (m *MsgSetInterfaceProviderFeeRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// MsgSetInterfaceProviderFeeRateResponse defines the
+// Msg/SetInterfaceProviderFeeRate response type.
+type MsgSetInterfaceProviderFeeRateResponse struct {
+}
+
+func (m *MsgSetInterfaceProviderFeeRateResponse) Reset() {
+	*m = MsgSetInterfaceProviderFeeRateResponse{}
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInterfaceProviderFeeRateResponse) ProtoMessage()    {}
+func (*MsgSetInterfaceProviderFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e76ee8abdea714, []int{11}
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInterfaceProviderFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInterfaceProviderFeeRateResponse.Merge(m, src)
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInterfaceProviderFeeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInterfaceProviderFeeRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "sunrise.swap.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sunrise.swap.MsgUpdateParamsResponse")
@@ -348,54 +673,81 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "sunrise.swap.MsgSwapExactAmountInResponse")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "sunrise.swap.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "sunrise.swap.MsgSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgRegisterInterfaceProvider)(nil), "sunrise.swap.MsgRegisterInterfaceProvider")
+	proto.RegisterType((*MsgRegisterInterfaceProviderResponse)(nil), "sunrise.swap.MsgRegisterInterfaceProviderResponse")
+	proto.RegisterType((*MsgUpdateInterfaceProvider)(nil), "sunrise.swap.MsgUpdateInterfaceProvider")
+	proto.RegisterType((*MsgUpdateInterfaceProviderResponse)(nil), "sunrise.swap.MsgUpdateInterfaceProviderResponse")
+	proto.RegisterType((*MsgSetInterfaceProviderFeeRate)(nil), "sunrise.swap.MsgSetInterfaceProviderFeeRate")
+	proto.RegisterType((*MsgSetInterfaceProviderFeeRateResponse)(nil), "sunrise.swap.MsgSetInterfaceProviderFeeRateResponse")
 }
 
 func init() { proto.RegisterFile("sunrise/swap/tx.proto", fileDescriptor_41e76ee8abdea714) }
 
 var fileDescriptor_41e76ee8abdea714 = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x2e, 0xdf, 0x36, 0xdf, 0xbe, 0xa2, 0x86, 0xb1, 0xc8, 0xd2, 0xc8, 0x42, 0x6a, 0x4c,
-	0x48, 0x95, 0x5d, 0x40, 0xa3, 0x09, 0xf1, 0x02, 0x89, 0x18, 0x0e, 0x4d, 0x49, 0x41, 0x0f, 0x5e,
-	0x9a, 0xa1, 0x9d, 0x2e, 0x1b, 0xd9, 0x99, 0xcd, 0xce, 0x2c, 0x94, 0x9b, 0xf1, 0xe8, 0xc9, 0x8b,
-	0xfe, 0x0d, 0xde, 0xe4, 0x80, 0xff, 0x03, 0x47, 0xc2, 0xc9, 0x78, 0x20, 0x06, 0x0e, 0xfc, 0x11,
-	0x7a, 0x30, 0xbb, 0x33, 0x2d, 0x6c, 0xdb, 0x58, 0x85, 0x93, 0xf1, 0xd2, 0xee, 0xbe, 0xf7, 0xf9,
-	0xbc, 0x1f, 0x9f, 0xf7, 0x76, 0x06, 0x46, 0x79, 0x48, 0x03, 0x97, 0x13, 0x9b, 0xef, 0x60, 0xdf,
-	0x16, 0x2d, 0xcb, 0x0f, 0x98, 0x60, 0x68, 0x58, 0x99, 0xad, 0xc8, 0x5c, 0x18, 0xc1, 0x9e, 0x4b,
-	0x99, 0x1d, 0xff, 0x4a, 0x40, 0x61, 0xac, 0xce, 0xb8, 0xc7, 0xb8, 0xed, 0x71, 0xc7, 0xde, 0x9e,
-	0x8b, 0xfe, 0x94, 0x63, 0x5c, 0x3a, 0x6a, 0xf1, 0x9b, 0x2d, 0x5f, 0x94, 0x2b, 0xef, 0x30, 0x87,
-	0x49, 0x7b, 0xf4, 0xd4, 0x26, 0x24, 0x2a, 0xf0, 0x71, 0x80, 0xbd, 0x36, 0xc1, 0x48, 0xb8, 0x02,
-	0x16, 0x0a, 0x22, 0x3d, 0xc5, 0xcf, 0x1a, 0xdc, 0x28, 0x73, 0xe7, 0xb9, 0xdf, 0xc0, 0x82, 0xac,
-	0xc6, 0x1c, 0xf4, 0x08, 0xb2, 0x38, 0x14, 0x9b, 0x2c, 0x70, 0xc5, 0xae, 0xa1, 0x4d, 0x69, 0xd3,
-	0xd9, 0x25, 0xe3, 0x68, 0x7f, 0x26, 0xaf, 0x6a, 0x58, 0x6c, 0x34, 0x02, 0xc2, 0xf9, 0x9a, 0x08,
-	0x5c, 0xea, 0x54, 0xcf, 0xa1, 0xe8, 0x31, 0x64, 0x64, 0x56, 0x43, 0x9f, 0xd2, 0xa6, 0x73, 0xf3,
-	0x79, 0xeb, 0x62, 0xf3, 0x96, 0x8c, 0xbe, 0x94, 0x3d, 0x38, 0x9e, 0x4c, 0x7d, 0x3c, 0xdb, 0x2b,
-	0x69, 0x55, 0x05, 0x5f, 0x98, 0x7b, 0x73, 0xb6, 0x57, 0x3a, 0x0f, 0xf4, 0xf6, 0x6c, 0xaf, 0x64,
-	0xb6, 0x2b, 0x6e, 0xc9, 0x9a, 0xbb, 0x6a, 0x2c, 0x8e, 0xc3, 0x58, 0x97, 0xa9, 0x4a, 0xb8, 0xcf,
-	0x28, 0x27, 0xc5, 0xef, 0x3a, 0xe4, 0xcb, 0xdc, 0x59, 0xdb, 0xc1, 0xfe, 0xd3, 0x16, 0xae, 0x8b,
-	0x45, 0x8f, 0x85, 0x54, 0xac, 0x50, 0x34, 0x0b, 0x19, 0x4e, 0x68, 0x83, 0x04, 0x03, 0x9b, 0x52,
-	0x38, 0xf4, 0x0c, 0x90, 0x4b, 0x05, 0x09, 0x9a, 0xb8, 0x4e, 0xa2, 0x41, 0x6c, 0xbb, 0x11, 0x5b,
-	0x1f, 0xc0, 0x1e, 0xe9, 0x70, 0x56, 0x15, 0x05, 0x3d, 0x84, 0x74, 0xac, 0xba, 0x31, 0x14, 0x2b,
-	0x73, 0x33, 0xa9, 0x4c, 0x35, 0x72, 0x5d, 0x14, 0x46, 0x82, 0x51, 0x19, 0xb2, 0x38, 0x2e, 0xbe,
-	0xe6, 0x52, 0xe3, 0xbf, 0x38, 0xeb, 0x6c, 0x04, 0xfa, 0x7a, 0x3c, 0x39, 0x2a, 0x33, 0xf3, 0xc6,
-	0x2b, 0xcb, 0x65, 0xb6, 0x87, 0xc5, 0xa6, 0xb5, 0x42, 0xc5, 0xd1, 0xfe, 0x0c, 0xa8, 0x92, 0x56,
-	0xa8, 0x90, 0xb1, 0xfe, 0xc7, 0xed, 0xfe, 0x5f, 0xc0, 0x75, 0xcf, 0xa5, 0x35, 0x15, 0x92, 0x85,
-	0xc2, 0x48, 0x5f, 0x32, 0xe6, 0xb0, 0xe7, 0x52, 0x29, 0x6b, 0x25, 0x14, 0x0b, 0xb9, 0x68, 0x7c,
-	0x4a, 0xb2, 0xe2, 0x7b, 0x1d, 0x6e, 0xf7, 0x53, 0xbf, 0x3d, 0x1e, 0xf4, 0x04, 0x32, 0x01, 0xe1,
-	0xe1, 0x96, 0x88, 0xa7, 0x90, 0x9b, 0x1f, 0xef, 0xa3, 0x45, 0x35, 0x06, 0x24, 0x56, 0x45, 0x72,
-	0x50, 0x13, 0x6e, 0xf5, 0x4e, 0xa4, 0xd6, 0x24, 0x44, 0x4d, 0xe5, 0xcf, 0x7b, 0xc9, 0xf7, 0x4c,
-	0x6b, 0x99, 0x10, 0x54, 0x01, 0xb8, 0xa0, 0xd3, 0xd0, 0x25, 0x63, 0xab, 0xf1, 0x55, 0x42, 0x51,
-	0xfc, 0xa1, 0xc3, 0x68, 0xaf, 0x2e, 0x95, 0x50, 0xfc, 0x7d, 0x6b, 0xb9, 0x0e, 0xd7, 0x3c, 0xdc,
-	0xaa, 0x5d, 0x7d, 0x35, 0x73, 0x1e, 0x6e, 0x75, 0xbe, 0xce, 0xa4, 0xe2, 0xe9, 0x2b, 0x2b, 0x9e,
-	0x5c, 0xcb, 0x0f, 0x3a, 0x4c, 0xf4, 0x95, 0xff, 0x1f, 0xdf, 0xcb, 0xf9, 0x4f, 0x3a, 0x0c, 0x95,
-	0xb9, 0x83, 0xd6, 0x61, 0x38, 0x71, 0x09, 0x4c, 0x24, 0xdb, 0xef, 0x3a, 0x6c, 0x0b, 0x77, 0x7f,
-	0xe9, 0xee, 0x88, 0x5a, 0x87, 0x91, 0xde, 0x73, 0xb8, 0xd8, 0xc3, 0xed, 0xc1, 0x14, 0x4a, 0x83,
-	0x31, 0x9d, 0x24, 0x4d, 0x40, 0x7d, 0x3e, 0xab, 0x3b, 0x83, 0x22, 0x54, 0x42, 0x51, 0xb8, 0xf7,
-	0x1b, 0xa0, 0x76, 0x9e, 0x42, 0xfa, 0x75, 0x24, 0xde, 0xd2, 0xf2, 0xc1, 0x89, 0xa9, 0x1d, 0x9e,
-	0x98, 0xda, 0xb7, 0x13, 0x53, 0x7b, 0x77, 0x6a, 0xa6, 0x0e, 0x4f, 0xcd, 0xd4, 0x97, 0x53, 0x33,
-	0xf5, 0xf2, 0xbe, 0xe3, 0x8a, 0xcd, 0x70, 0xc3, 0xaa, 0x33, 0xcf, 0x56, 0x71, 0xb7, 0xf0, 0x2e,
-	0x09, 0xec, 0xae, 0xcb, 0x4c, 0xec, 0xfa, 0x84, 0x6f, 0x64, 0xe2, 0x1b, 0xf8, 0xc1, 0xcf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe6, 0x0b, 0x6f, 0x53, 0x3a, 0x08, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0xd8, 0xb1, 0x6b, 0x1f, 0x07, 0x50, 0x06, 0xa7, 0x99, 0x4c, 0xa9, 0x1d, 0x99, 0xb6,
+	0x32, 0x69, 0x33, 0x93, 0x98, 0x0a, 0xa4, 0x00, 0x52, 0x1a, 0x95, 0x20, 0x4b, 0x89, 0x52, 0x4d,
+	0x02, 0x0b, 0x36, 0xd6, 0x8d, 0xe7, 0x78, 0x32, 0xc2, 0xf3, 0xd0, 0xdc, 0x3b, 0xad, 0x23, 0x36,
+	0xc0, 0x92, 0x55, 0x41, 0x82, 0x25, 0x5b, 0x58, 0x66, 0x51, 0xfe, 0x43, 0x96, 0x55, 0x57, 0xa8,
+	0x8b, 0x80, 0x92, 0x45, 0x56, 0x2c, 0xf8, 0x07, 0x68, 0x9e, 0xf5, 0x64, 0xfc, 0x08, 0xee, 0x2a,
+	0x62, 0x93, 0x78, 0xee, 0xf9, 0xee, 0x77, 0xce, 0xfd, 0xce, 0xe3, 0x5e, 0x98, 0xa3, 0xae, 0xe9,
+	0xe8, 0x14, 0x65, 0xfa, 0x84, 0xd8, 0x32, 0xeb, 0x49, 0xb6, 0x63, 0x31, 0x8b, 0x9f, 0x09, 0x97,
+	0x25, 0x6f, 0x59, 0x9c, 0x25, 0x86, 0x6e, 0x5a, 0xb2, 0xff, 0x37, 0x00, 0x88, 0xf3, 0x6d, 0x8b,
+	0x1a, 0x16, 0x95, 0x0d, 0xaa, 0xc9, 0x8f, 0x57, 0xbd, 0x7f, 0xa1, 0x61, 0x21, 0x30, 0xb4, 0xfc,
+	0x2f, 0x39, 0xf8, 0x08, 0x4d, 0x65, 0xcd, 0xd2, 0xac, 0x60, 0xdd, 0xfb, 0x15, 0xae, 0x56, 0x35,
+	0xcb, 0xd2, 0xba, 0x28, 0xfb, 0x5f, 0xfb, 0x6e, 0x47, 0x66, 0xba, 0x81, 0x94, 0x11, 0xc3, 0x8e,
+	0x18, 0x13, 0x21, 0xda, 0xc4, 0x21, 0x46, 0xc4, 0x78, 0x3b, 0x61, 0xd2, 0x4d, 0x86, 0x4e, 0x87,
+	0xb4, 0xd1, 0x73, 0xfe, 0x58, 0x57, 0xd1, 0x09, 0x61, 0x42, 0x02, 0xe6, 0x58, 0x2e, 0xc3, 0xc0,
+	0x52, 0xfb, 0x9d, 0x83, 0xb7, 0xb6, 0xa9, 0xf6, 0xb9, 0xad, 0x12, 0x86, 0x8f, 0x7c, 0x6a, 0xfe,
+	0x03, 0x28, 0x12, 0x97, 0x1d, 0x58, 0x8e, 0xce, 0x0e, 0x05, 0x6e, 0x91, 0xab, 0x17, 0x37, 0x84,
+	0x17, 0xcf, 0x96, 0xcb, 0xe1, 0x59, 0x1e, 0xa8, 0xaa, 0x83, 0x94, 0xee, 0x32, 0x47, 0x37, 0x35,
+	0xe5, 0x15, 0x94, 0xff, 0x10, 0xf2, 0x41, 0x70, 0x42, 0x66, 0x91, 0xab, 0x97, 0x1a, 0x65, 0xa9,
+	0x5f, 0x44, 0x29, 0x60, 0xdf, 0x28, 0x1e, 0x9f, 0x54, 0xa7, 0x7e, 0x3b, 0x3f, 0x5a, 0xe2, 0x94,
+	0x10, 0xbe, 0xb6, 0xfa, 0xdd, 0xf9, 0xd1, 0xd2, 0x2b, 0xa2, 0xef, 0xcf, 0x8f, 0x96, 0x2a, 0x51,
+	0xc4, 0xbd, 0x20, 0xe6, 0x0b, 0x31, 0xd6, 0x16, 0x60, 0xfe, 0xc2, 0x92, 0x82, 0xd4, 0xb6, 0x4c,
+	0x8a, 0xb5, 0x1f, 0xa6, 0xa1, 0xbc, 0x4d, 0xb5, 0xdd, 0x27, 0xc4, 0xfe, 0xb4, 0x47, 0xda, 0xec,
+	0x81, 0x61, 0xb9, 0x26, 0x6b, 0x9a, 0xfc, 0x0a, 0xe4, 0x29, 0x9a, 0x2a, 0x3a, 0x63, 0x0f, 0x15,
+	0xe2, 0xf8, 0xcf, 0x80, 0x4f, 0x6b, 0x2a, 0x64, 0xc6, 0xec, 0x9e, 0x8d, 0xf7, 0x3c, 0x0a, 0xb7,
+	0xf0, 0xf7, 0x21, 0xe7, 0xab, 0x2e, 0x64, 0x7d, 0x65, 0xde, 0x4e, 0x2a, 0xa3, 0x78, 0xa6, 0x7e,
+	0x61, 0x02, 0x30, 0xbf, 0x0d, 0x45, 0xe2, 0x07, 0xdf, 0xd2, 0x4d, 0x61, 0xda, 0xf7, 0xba, 0xe2,
+	0x81, 0x5e, 0x9e, 0x54, 0xe7, 0x02, 0xcf, 0x54, 0xfd, 0x4a, 0xd2, 0x2d, 0xd9, 0x20, 0xec, 0x40,
+	0x6a, 0x9a, 0xec, 0xc5, 0xb3, 0x65, 0x08, 0x43, 0x6a, 0x9a, 0x2c, 0xe0, 0x2a, 0x90, 0xe8, 0xfc,
+	0x5f, 0xc0, 0x9b, 0x86, 0x6e, 0xb6, 0x42, 0x4a, 0xcb, 0x65, 0x42, 0x6e, 0x42, 0xce, 0x19, 0x43,
+	0x37, 0x03, 0x59, 0x77, 0x5c, 0xc6, 0x2b, 0x50, 0xb2, 0x1d, 0xbd, 0x8d, 0xad, 0xae, 0x6e, 0xe8,
+	0x4c, 0xc8, 0xfb, 0xa4, 0xab, 0xc7, 0x27, 0x55, 0xee, 0xe5, 0x49, 0xf5, 0x46, 0x9a, 0x74, 0x0b,
+	0x35, 0xd2, 0x3e, 0x7c, 0x88, 0xed, 0x3e, 0xea, 0x87, 0xd8, 0x56, 0xc0, 0x67, 0xd9, 0xf2, 0x48,
+	0xf8, 0x75, 0x28, 0xa8, 0x48, 0xd4, 0xae, 0x6e, 0xa2, 0x70, 0xcd, 0xd7, 0x4c, 0x94, 0x82, 0x3e,
+	0x91, 0xa2, 0x3e, 0x91, 0xf6, 0xa2, 0x3e, 0xd9, 0x28, 0x78, 0xce, 0x9e, 0xfe, 0x59, 0xe5, 0x94,
+	0x78, 0xd7, 0x5a, 0xc9, 0x2b, 0xaa, 0x30, 0x91, 0xb5, 0x9f, 0x32, 0xf0, 0xce, 0xa0, 0x9a, 0x88,
+	0x8a, 0x86, 0xff, 0x18, 0xf2, 0x0e, 0x52, 0xb7, 0xcb, 0xfc, 0xda, 0x28, 0x35, 0x16, 0x06, 0x64,
+	0x48, 0xf1, 0x01, 0x89, 0x02, 0x0e, 0xf6, 0xf0, 0x1d, 0xb8, 0x9e, 0xae, 0x93, 0x56, 0x07, 0x51,
+	0xc8, 0x4c, 0xa8, 0x70, 0x39, 0x55, 0x43, 0x9b, 0x88, 0xfc, 0x0e, 0x40, 0x5f, 0xf6, 0xb2, 0x13,
+	0x72, 0x87, 0x45, 0xb5, 0xe3, 0xb2, 0xda, 0x8f, 0xd3, 0x30, 0x97, 0xd6, 0xc5, 0x4b, 0xea, 0x95,
+	0x6b, 0x96, 0x3d, 0x78, 0xc3, 0x20, 0xbd, 0xd6, 0xeb, 0x37, 0x4c, 0xc9, 0x20, 0xbd, 0x78, 0x66,
+	0x24, 0x15, 0xcf, 0xbd, 0xb6, 0xe2, 0x57, 0xa1, 0x59, 0x7e, 0xce, 0xc0, 0xcd, 0x81, 0x45, 0xf1,
+	0x7f, 0xef, 0x96, 0x7f, 0x38, 0x7f, 0x8a, 0x28, 0xa8, 0xe9, 0x94, 0xa1, 0xd3, 0x1c, 0x50, 0xb9,
+	0x85, 0xb8, 0xf0, 0xc7, 0xb5, 0x4d, 0x8c, 0xe4, 0x45, 0x28, 0x18, 0xc8, 0x88, 0x4a, 0x18, 0x09,
+	0x14, 0x50, 0xe2, 0x6f, 0x7e, 0x1d, 0xa0, 0x83, 0xd8, 0xa2, 0x07, 0xc4, 0x41, 0x2a, 0x64, 0x17,
+	0xb3, 0xf5, 0x52, 0xe3, 0x7a, 0x52, 0xed, 0x4d, 0xc4, 0x5d, 0xcf, 0xdc, 0x2f, 0x75, 0xb1, 0x13,
+	0x2e, 0xd2, 0xb5, 0x4f, 0xbc, 0xd4, 0xc6, 0xce, 0xbc, 0xbb, 0xf5, 0x6e, 0xfa, 0x6e, 0x1d, 0x7a,
+	0xa4, 0xda, 0x1d, 0xb8, 0x35, 0xca, 0x1e, 0xdf, 0xba, 0x7f, 0x73, 0x20, 0xc6, 0x37, 0xf2, 0xd5,
+	0x50, 0xe6, 0xa3, 0x94, 0x32, 0xef, 0x0d, 0x7b, 0x75, 0xa4, 0x75, 0xb9, 0x05, 0xb5, 0xe1, 0xd6,
+	0x58, 0x95, 0x5f, 0x32, 0x50, 0xf1, 0x5a, 0x09, 0x59, 0x73, 0x40, 0x85, 0x2a, 0x84, 0xe1, 0xc4,
+	0xaf, 0xad, 0x7e, 0x45, 0x33, 0x97, 0x56, 0x74, 0x0b, 0x0a, 0x9e, 0x6a, 0x0e, 0x09, 0xc7, 0xeb,
+	0x44, 0xb3, 0xe7, 0x5a, 0x27, 0x88, 0x7d, 0x6d, 0x3d, 0xfd, 0x70, 0x5b, 0x4e, 0x4b, 0x38, 0xe2,
+	0xf4, 0xb5, 0x3a, 0xdc, 0x19, 0x8d, 0x88, 0xa4, 0x6c, 0xfc, 0x9a, 0x83, 0xec, 0x36, 0xd5, 0xf8,
+	0x3d, 0x98, 0x49, 0xbc, 0x56, 0x6f, 0x26, 0x73, 0x7e, 0xe1, 0x55, 0x28, 0xde, 0x1e, 0x69, 0x8e,
+	0x27, 0x5a, 0x1b, 0x66, 0xd3, 0x0f, 0xc6, 0x5a, 0x6a, 0x6f, 0x0a, 0x23, 0x2e, 0x8d, 0xc7, 0xc4,
+	0x4e, 0x3a, 0xc0, 0x0f, 0xb8, 0x69, 0xdf, 0x1d, 0xc7, 0xb0, 0xe3, 0x32, 0xf1, 0xee, 0x25, 0x40,
+	0xb1, 0x9f, 0xaf, 0x61, 0x61, 0xf8, 0x8c, 0x4a, 0x07, 0x3c, 0x14, 0x2b, 0x36, 0x2e, 0x8f, 0x8d,
+	0x9d, 0xbb, 0x30, 0x3f, 0x6c, 0x08, 0xd4, 0x87, 0xe4, 0x22, 0xed, 0x78, 0xe5, 0xb2, 0xc8, 0xd8,
+	0xed, 0xb7, 0x1c, 0xdc, 0x18, 0xd5, 0x66, 0xf7, 0xd2, 0x02, 0x0e, 0x47, 0x8b, 0xf7, 0xff, 0x0b,
+	0x3a, 0x8a, 0x41, 0xcc, 0x7d, 0xe3, 0x8d, 0x98, 0x8d, 0xcd, 0xe3, 0xd3, 0x0a, 0xf7, 0xfc, 0xb4,
+	0xc2, 0xfd, 0x75, 0x5a, 0xe1, 0x9e, 0x9e, 0x55, 0xa6, 0x9e, 0x9f, 0x55, 0xa6, 0xfe, 0x38, 0xab,
+	0x4c, 0x7d, 0x79, 0x4f, 0xd3, 0xd9, 0x81, 0xbb, 0x2f, 0xb5, 0x2d, 0x43, 0x0e, 0x1d, 0x74, 0xc9,
+	0x21, 0x3a, 0xf2, 0x85, 0xa6, 0x61, 0x87, 0x36, 0xd2, 0xfd, 0xbc, 0x7f, 0x79, 0xbf, 0xff, 0xef,
+	0x00, 0xab, 0x8e, 0x25, 0x23, 0xa3, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -415,6 +767,14 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	SwapExactAmountIn(ctx context.Context, in *MsgSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
+	// RegisterInterfaceProvider registers the signer as an interface provider.
+	RegisterInterfaceProvider(ctx context.Context, in *MsgRegisterInterfaceProvider, opts ...grpc.CallOption) (*MsgRegisterInterfaceProviderResponse, error)
+	// UpdateInterfaceProvider updates the metadata and the fee shares of an
+	// interface provider.
+	UpdateInterfaceProvider(ctx context.Context, in *MsgUpdateInterfaceProvider, opts ...grpc.CallOption) (*MsgUpdateInterfaceProviderResponse, error)
+	// SetInterfaceProviderFeeRate defines a (governance) operation for setting
+	// the interface fee rate of a registered interface provider.
+	SetInterfaceProviderFeeRate(ctx context.Context, in *MsgSetInterfaceProviderFeeRate, opts ...grpc.CallOption) (*MsgSetInterfaceProviderFeeRateResponse, error)
 }
 
 type msgClient struct {
@@ -452,6 +812,33 @@ func (c *msgClient) SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmou
 	return out, nil
 }
 
+func (c *msgClient) RegisterInterfaceProvider(ctx context.Context, in *MsgRegisterInterfaceProvider, opts ...grpc.CallOption) (*MsgRegisterInterfaceProviderResponse, error) {
+	out := new(MsgRegisterInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Msg/RegisterInterfaceProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInterfaceProvider(ctx context.Context, in *MsgUpdateInterfaceProvider, opts ...grpc.CallOption) (*MsgUpdateInterfaceProviderResponse, error) {
+	out := new(MsgUpdateInterfaceProviderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Msg/UpdateInterfaceProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetInterfaceProviderFeeRate(ctx context.Context, in *MsgSetInterfaceProviderFeeRate, opts ...grpc.CallOption) (*MsgSetInterfaceProviderFeeRateResponse, error) {
+	out := new(MsgSetInterfaceProviderFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.swap.Msg/SetInterfaceProviderFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -459,6 +846,14 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
+	// RegisterInterfaceProvider registers the signer as an interface provider.
+	RegisterInterfaceProvider(context.Context, *MsgRegisterInterfaceProvider) (*MsgRegisterInterfaceProviderResponse, error)
+	// UpdateInterfaceProvider updates the metadata and the fee shares of an
+	// interface provider.
+	UpdateInterfaceProvider(context.Context, *MsgUpdateInterfaceProvider) (*MsgUpdateInterfaceProviderResponse, error)
+	// SetInterfaceProviderFeeRate defines a (governance) operation for setting
+	// the interface fee rate of a registered interface provider.
+	SetInterfaceProviderFeeRate(context.Context, *MsgSetInterfaceProviderFeeRate) (*MsgSetInterfaceProviderFeeRateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -474,6 +869,15 @@ func (*UnimplementedMsgServer) SwapExactAmountIn(ctx context.Context, req *MsgSw
 func (*UnimplementedMsgServer) SwapExactAmountOut(ctx context.Context, req *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) RegisterInterfaceProvider(ctx context.Context, req *MsgRegisterInterfaceProvider) (*MsgRegisterInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterfaceProvider not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInterfaceProvider(ctx context.Context, req *MsgUpdateInterfaceProvider) (*MsgUpdateInterfaceProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterfaceProvider not implemented")
+}
+func (*UnimplementedMsgServer) SetInterfaceProviderFeeRate(ctx context.Context, req *MsgSetInterfaceProviderFeeRate) (*MsgSetInterfaceProviderFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceProviderFeeRate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -533,6 +937,60 @@ func _Msg_SwapExactAmountOut_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterInterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterInterfaceProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterInterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Msg/RegisterInterfaceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterInterfaceProvider(ctx, req.(*MsgRegisterInterfaceProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInterfaceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInterfaceProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInterfaceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Msg/UpdateInterfaceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInterfaceProvider(ctx, req.(*MsgUpdateInterfaceProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInterfaceProviderFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInterfaceProviderFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInterfaceProviderFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.swap.Msg/SetInterfaceProviderFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInterfaceProviderFeeRate(ctx, req.(*MsgSetInterfaceProviderFeeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sunrise.swap.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -549,6 +1007,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapExactAmountOut",
 			Handler:    _Msg_SwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "RegisterInterfaceProvider",
+			Handler:    _Msg_RegisterInterfaceProvider_Handler,
+		},
+		{
+			MethodName: "UpdateInterfaceProvider",
+			Handler:    _Msg_UpdateInterfaceProvider_Handler,
+		},
+		{
+			MethodName: "SetInterfaceProviderFeeRate",
+			Handler:    _Msg_SetInterfaceProviderFeeRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/swap/tx.proto",
@@ -637,6 +1107,28 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deadline != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PriceLimit != nil {
+		{
+			size := m.PriceLimit.Size()
+			i -= size
+			if _, err := m.PriceLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.MinAmountOut.Size()
 		i -= size
@@ -757,8 +1249,30 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.AmountOut.Size()
+	if m.Deadline != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PriceLimit != nil {
+		{
+			size := m.PriceLimit.Size()
+			i -= size
+			if _, err := m.PriceLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.AmountOut.Size()
 		i -= size
 		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -857,6 +1371,226 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterInterfaceProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterInterfaceProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterInterfaceProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeShares) > 0 {
+		for iNdEx := len(m.FeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterInterfaceProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterInterfaceProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterInterfaceProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterfaceProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterfaceProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterfaceProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeShares) > 0 {
+		for iNdEx := len(m.FeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterfaceProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterfaceProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterfaceProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeRate != nil {
+		{
+			size := m.FeeRate.Size()
+			i -= size
+			if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInterfaceProviderFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInterfaceProviderFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInterfaceProviderFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -912,6 +1646,14 @@ func (m *MsgSwapExactAmountIn) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinAmountOut.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PriceLimit != nil {
+		l = m.PriceLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -950,6 +1692,14 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.AmountOut.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PriceLimit != nil {
+		l = m.PriceLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -968,6 +1718,100 @@ func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterInterfaceProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeShares) > 0 {
+		for _, e := range m.FeeShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterInterfaceProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateInterfaceProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeShares) > 0 {
+		for _, e := range m.FeeShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateInterfaceProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInterfaceProviderFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRate != nil {
+		l = m.FeeRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetInterfaceProviderFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1333,16 +2177,88 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PriceLimit = &v
+			if err := m.PriceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1699,6 +2615,78 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PriceLimit = &v
+			if err := m.PriceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1871,6 +2859,602 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterInterfaceProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterfaceProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterfaceProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeShares = append(m.FeeShares, FeeShare{})
+			if err := m.FeeShares[len(m.FeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterfaceProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterfaceProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterfaceProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterfaceProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterfaceProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterfaceProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeShares = append(m.FeeShares, FeeShare{})
+			if err := m.FeeShares[len(m.FeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterfaceProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterfaceProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterfaceProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetInterfaceProviderFeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetInterfaceProviderFeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetInterfaceProviderFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.FeeRate = &v
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetInterfaceProviderFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetInterfaceProviderFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetInterfaceProviderFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0